@@ -25,7 +25,6 @@ import (
 
 	rc "github.com/milvus-io/milvus/internal/distributed/rootcoord"
 	"github.com/milvus-io/milvus/internal/msgstream"
-	"github.com/opentracing/opentracing-go"
 )
 
 // RootCoord implements RoodCoord grpc server
@@ -33,7 +32,6 @@ type RootCoord struct {
 	ctx context.Context
 	svr *rc.Server
 
-	tracer opentracing.Tracer
 	closer io.Closer
 }
 
@@ -26,6 +26,9 @@ import (
 	"strings"
 	"syscall"
 
+	// drivers of the sql metastore
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/cmd/roles"
@@ -42,6 +45,7 @@ const (
 	roleQueryNode  = "querynode"
 	roleIndexNode  = "indexnode"
 	roleDataNode   = "datanode"
+	roleRocksMQ    = "rocksmq"
 	roleMixture    = "mixture"
 	roleStandalone = "standalone"
 )
@@ -213,11 +217,12 @@ func main() {
 	var svrAlias string
 	flags.StringVar(&svrAlias, "alias", "", "set alias")
 
-	var enableRootCoord, enableQueryCoord, enableIndexCoord, enableDataCoord bool
+	var enableRootCoord, enableQueryCoord, enableIndexCoord, enableDataCoord, enableRocksMQ bool
 	flags.BoolVar(&enableRootCoord, roleRootCoord, false, "enable root coordinator")
 	flags.BoolVar(&enableQueryCoord, roleQueryCoord, false, "enable query coordinator")
 	flags.BoolVar(&enableIndexCoord, roleIndexCoord, false, "enable index coordinator")
 	flags.BoolVar(&enableDataCoord, roleDataCoord, false, "enable data coordinator")
+	flags.BoolVar(&enableRocksMQ, roleRocksMQ, false, "enable rocksmq server")
 
 	flags.Usage = func() {
 		fmt.Fprintf(flags.Output(), "Usage of %s:\n", os.Args[0])
@@ -259,11 +264,14 @@ func main() {
 		role.EnableIndexCoord = true
 	case roleIndexNode:
 		role.EnableIndexNode = true
+	case roleRocksMQ:
+		role.EnableRocksMQ = true
 	case roleMixture:
 		role.EnableRootCoord = enableRootCoord
 		role.EnableQueryCoord = enableQueryCoord
 		role.EnableDataCoord = enableDataCoord
 		role.EnableIndexCoord = enableIndexCoord
+		role.EnableRocksMQ = enableRocksMQ
 	case roleStandalone:
 		role.EnableRootCoord = true
 		role.EnableProxy = true
@@ -0,0 +1,364 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	grpcdatacoordclient "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/healthz"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+const (
+	// the read-only views of the management api are served beside healthz when management.enabled is set
+	managementSessionsRouterPath = "/management/sessions"
+	managementChannelsRouterPath = "/management/datacoord/channels"
+	managementSegmentsRouterPath = "/management/querycoord/segments"
+
+	// the actions change the cluster, they are only served when management.actions.enabled is set too
+	managementLoadBalanceRouterPath = "/management/actions/querycoord/load_balance"
+
+	contentTypeJSON = "application/json"
+
+	managementRequestTimeout = 10 * time.Second
+)
+
+type sessionLister interface {
+	GetSessions(prefix string) (map[string]*sessionutil.Session, int64, error)
+}
+
+type dataCoordManager interface {
+	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+}
+
+type queryCoordManager interface {
+	ShowCollections(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error)
+	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
+	LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error)
+}
+
+type sessionView struct {
+	ServerID   int64  `json:"server_id"`
+	ServerName string `json:"server_name"`
+	Address    string `json:"address"`
+	Exclusive  bool   `json:"exclusive"`
+}
+
+type sessionsView struct {
+	Sessions []sessionView `json:"sessions"`
+}
+
+type segmentView struct {
+	SegmentID    int64  `json:"segment_id"`
+	CollectionID int64  `json:"collection_id"`
+	PartitionID  int64  `json:"partition_id"`
+	Channel      string `json:"channel"`
+	NumRows      int64  `json:"num_rows"`
+	MemSize      int64  `json:"mem_size"`
+	IndexName    string `json:"index_name"`
+	State        string `json:"state"`
+}
+
+type nodeSegmentsView struct {
+	NodeID   int64         `json:"node_id"`
+	Segments []segmentView `json:"segments"`
+}
+
+type segmentDistributionView struct {
+	Nodes []nodeSegmentsView `json:"nodes"`
+}
+
+type loadBalanceRequest struct {
+	SourceNodeIDs    []int64 `json:"source_node_ids"`
+	DstNodeIDs       []int64 `json:"dst_node_ids"`
+	SealedSegmentIDs []int64 `json:"sealed_segment_ids"`
+}
+
+type managementError struct {
+	Error string `json:"error"`
+}
+
+// managementHandler serves the management api of the cluster, the views and actions are fetched from the
+// coordinators by grpc, so any role can serve it.
+type managementHandler struct {
+	sessions   sessionLister
+	dataCoord  dataCoordManager
+	queryCoord queryCoordManager
+}
+
+// newManagementHandler creates a management handler with the sessions in etcd and the grpc clients of coordinators.
+func newManagementHandler(ctx context.Context) (*managementHandler, error) {
+	paramtable.Params.Init()
+	session := sessionutil.NewSession(ctx, paramtable.Params.MetaRootPath, paramtable.Params.EtcdEndpoints)
+	if session == nil {
+		return nil, errors.New("failed to connect to etcd")
+	}
+	dataCoord, err := grpcdatacoordclient.NewClient(ctx, paramtable.Params.MetaRootPath, paramtable.Params.EtcdEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	if err = dataCoord.Init(); err != nil {
+		return nil, err
+	}
+	queryCoord, err := grpcquerycoordclient.NewClient(ctx, paramtable.Params.MetaRootPath, paramtable.Params.EtcdEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	if err = queryCoord.Init(); err != nil {
+		return nil, err
+	}
+	return &managementHandler{
+		sessions:   session,
+		dataCoord:  dataCoord,
+		queryCoord: queryCoord,
+	}, nil
+}
+
+// registerViews registers the read-only views of the cluster.
+func (handler *managementHandler) registerViews(mux *http.ServeMux) {
+	mux.HandleFunc(managementSessionsRouterPath, handler.handleSessions)
+	mux.HandleFunc(managementChannelsRouterPath, handler.handleChannels)
+	mux.HandleFunc(managementSegmentsRouterPath, handler.handleSegments)
+}
+
+// registerActions registers the actions that change the cluster.
+func (handler *managementHandler) registerActions(mux *http.ServeMux) {
+	mux.HandleFunc(managementLoadBalanceRouterPath, handler.handleLoadBalance)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set(healthz.ContentTypeHeader, contentTypeJSON)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warn("failed to send response",
+			zap.Error(err))
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, managementError{Error: err.Error()})
+}
+
+func checkMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed, expect %s", r.Method, method))
+		return false
+	}
+	return true
+}
+
+func statusError(status *commonpb.Status) error {
+	if status == nil {
+		return fmt.Errorf("empty status")
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return fmt.Errorf("%s: %s", status.ErrorCode.String(), status.Reason)
+	}
+	return nil
+}
+
+// handleSessions lists the sessions registered in etcd, the role is filtered by the "role" parameter.
+func (handler *managementHandler) handleSessions(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r, http.MethodGet) {
+		return
+	}
+	sessions, _, err := handler.sessions.GetSessions(r.URL.Query().Get("role"))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	view := sessionsView{Sessions: make([]sessionView, 0, len(sessions))}
+	for _, session := range sessions {
+		view.Sessions = append(view.Sessions, sessionView{
+			ServerID:   session.ServerID,
+			ServerName: session.ServerName,
+			Address:    session.Address,
+			Exclusive:  session.Exclusive,
+		})
+	}
+	sort.Slice(view.Sessions, func(i, j int) bool {
+		if view.Sessions[i].ServerName != view.Sessions[j].ServerName {
+			return view.Sessions[i].ServerName < view.Sessions[j].ServerName
+		}
+		return view.Sessions[i].ServerID < view.Sessions[j].ServerID
+	})
+	writeJSON(w, http.StatusOK, view)
+}
+
+// handleChannels shows the channels assigned to data nodes by data coordinator.
+func (handler *managementHandler) handleChannels(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r, http.MethodGet) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), managementRequestTimeout)
+	defer cancel()
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.ChannelAssignmentMetrics)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	resp, err := handler.dataCoord.GetMetrics(ctx, req)
+	if err == nil {
+		err = statusError(resp.GetStatus())
+	}
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	var assignments metricsinfo.ChannelAssignments
+	if err := metricsinfo.UnmarshalComponentInfos(resp.Response, &assignments); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, assignments)
+}
+
+// handleSegments shows the sealed segments loaded by query nodes, grouped by node. Only the collection of
+// the "collection_id" parameter is shown if it's specified, otherwise all the loaded collections.
+func (handler *managementHandler) handleSegments(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r, http.MethodGet) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), managementRequestTimeout)
+	defer cancel()
+
+	var collectionIDs []int64
+	if s := r.URL.Query().Get("collection_id"); s != "" {
+		collectionID, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid collection_id %s", s))
+			return
+		}
+		collectionIDs = []int64{collectionID}
+	} else {
+		resp, err := handler.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ShowCollections,
+			},
+		})
+		if err == nil {
+			err = statusError(resp.GetStatus())
+		}
+		if err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		collectionIDs = resp.CollectionIDs
+	}
+
+	nodeSegments := make(map[int64][]segmentView)
+	for _, collectionID := range collectionIDs {
+		resp, err := handler.queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_SegmentInfo,
+			},
+			CollectionID: collectionID,
+		})
+		if err == nil {
+			err = statusError(resp.GetStatus())
+		}
+		if err != nil {
+			writeError(w, http.StatusServiceUnavailable, fmt.Errorf("failed to get segments of collection %d, %s", collectionID, err.Error()))
+			return
+		}
+		for _, info := range resp.Infos {
+			nodeSegments[info.NodeID] = append(nodeSegments[info.NodeID], segmentView{
+				SegmentID:    info.SegmentID,
+				CollectionID: info.CollectionID,
+				PartitionID:  info.PartitionID,
+				Channel:      info.ChannelID,
+				NumRows:      info.NumRows,
+				MemSize:      info.MemSize,
+				IndexName:    info.IndexName,
+				State:        info.State.String(),
+			})
+		}
+	}
+
+	view := segmentDistributionView{Nodes: make([]nodeSegmentsView, 0, len(nodeSegments))}
+	for nodeID, segments := range nodeSegments {
+		sort.Slice(segments, func(i, j int) bool {
+			return segments[i].SegmentID < segments[j].SegmentID
+		})
+		view.Nodes = append(view.Nodes, nodeSegmentsView{
+			NodeID:   nodeID,
+			Segments: segments,
+		})
+	}
+	sort.Slice(view.Nodes, func(i, j int) bool {
+		return view.Nodes[i].NodeID < view.Nodes[j].NodeID
+	})
+	writeJSON(w, http.StatusOK, view)
+}
+
+// handleLoadBalance triggers a load balance of query coordinator, the sealed segments of the source nodes are
+// moved to the destination nodes.
+func (handler *managementHandler) handleLoadBalance(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r, http.MethodPost) {
+		return
+	}
+	var req loadBalanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body, %s", err.Error()))
+		return
+	}
+	if len(req.SourceNodeIDs) == 0 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("source_node_ids is required"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), managementRequestTimeout)
+	defer cancel()
+	log.Info("management api triggers load balance", zap.Any("req", req))
+	status, err := handler.queryCoord.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadBalanceSegments,
+		},
+		SourceNodeIDs:    req.SourceNodeIDs,
+		DstNodeIDs:       req.DstNodeIDs,
+		BalanceReason:    querypb.TriggerCondition_grpcRequest,
+		SealedSegmentIDs: req.SealedSegmentIDs,
+	})
+	if err == nil {
+		err = statusError(status)
+	}
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, struct{}{})
+}
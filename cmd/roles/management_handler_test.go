@@ -0,0 +1,249 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+type mockSessionLister struct {
+	sessions map[string]*sessionutil.Session
+	err      error
+}
+
+func (m *mockSessionLister) GetSessions(prefix string) (map[string]*sessionutil.Session, int64, error) {
+	if m.err != nil {
+		return nil, 0, m.err
+	}
+	ret := make(map[string]*sessionutil.Session)
+	for key, session := range m.sessions {
+		if prefix == "" || session.ServerName == prefix {
+			ret[key] = session
+		}
+	}
+	return ret, 0, nil
+}
+
+type mockDataCoordManager struct {
+	resp *milvuspb.GetMetricsResponse
+	err  error
+}
+
+func (m *mockDataCoordManager) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	return m.resp, m.err
+}
+
+type mockQueryCoordManager struct {
+	collectionIDs []int64
+	segments      []*querypb.SegmentInfo
+	balanceReq    *querypb.LoadBalanceRequest
+	status        *commonpb.Status
+	err           error
+}
+
+func (m *mockQueryCoordManager) ShowCollections(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
+	return &querypb.ShowCollectionsResponse{Status: m.status, CollectionIDs: m.collectionIDs}, m.err
+}
+
+func (m *mockQueryCoordManager) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+	infos := make([]*querypb.SegmentInfo, 0)
+	for _, info := range m.segments {
+		if info.CollectionID == req.CollectionID {
+			infos = append(infos, info)
+		}
+	}
+	return &querypb.GetSegmentInfoResponse{Status: m.status, Infos: infos}, m.err
+}
+
+func (m *mockQueryCoordManager) LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+	m.balanceReq = req
+	return m.status, m.err
+}
+
+func successStatus() *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func serveManagement(handler *managementHandler, method, target string, body []byte) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	handler.registerViews(mux)
+	handler.registerActions(mux)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(method, target, bytes.NewReader(body)))
+	return w
+}
+
+func TestManagementHandler_Sessions(t *testing.T) {
+	lister := &mockSessionLister{
+		sessions: map[string]*sessionutil.Session{
+			"querynode-2": {ServerID: 2, ServerName: "querynode", Address: "localhost:21124"},
+			"querynode-1": {ServerID: 1, ServerName: "querynode", Address: "localhost:21123"},
+			"rootcoord":   {ServerID: 3, ServerName: "rootcoord", Address: "localhost:53100", Exclusive: true},
+		},
+	}
+	handler := &managementHandler{sessions: lister}
+
+	w := serveManagement(handler, http.MethodGet, managementSessionsRouterPath, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, contentTypeJSON, w.Header().Get("Content-Type"))
+	var view sessionsView
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &view))
+	assert.Equal(t, []sessionView{
+		{ServerID: 1, ServerName: "querynode", Address: "localhost:21123"},
+		{ServerID: 2, ServerName: "querynode", Address: "localhost:21124"},
+		{ServerID: 3, ServerName: "rootcoord", Address: "localhost:53100", Exclusive: true},
+	}, view.Sessions)
+
+	w = serveManagement(handler, http.MethodGet, managementSessionsRouterPath+"?role=rootcoord", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &view))
+	assert.Equal(t, 1, len(view.Sessions))
+
+	w = serveManagement(handler, http.MethodPost, managementSessionsRouterPath, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	lister.err = errors.New("etcd is down")
+	w = serveManagement(handler, http.MethodGet, managementSessionsRouterPath, nil)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	var mErr managementError
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &mErr))
+	assert.Equal(t, "etcd is down", mErr.Error)
+}
+
+func TestManagementHandler_Channels(t *testing.T) {
+	assignments := metricsinfo.ChannelAssignments{
+		Name: "datacoord1",
+		Nodes: []metricsinfo.NodeChannelAssignments{
+			{NodeID: 1, Channels: []metricsinfo.ChannelAssignment{{Name: "ch1", CollectionID: 100}}},
+		},
+		Buffer: []metricsinfo.ChannelAssignment{{Name: "ch2", CollectionID: 100}},
+	}
+	resp, err := metricsinfo.MarshalComponentInfos(assignments)
+	assert.NoError(t, err)
+	dataCoord := &mockDataCoordManager{
+		resp: &milvuspb.GetMetricsResponse{Status: successStatus(), Response: resp},
+	}
+	handler := &managementHandler{dataCoord: dataCoord}
+
+	w := serveManagement(handler, http.MethodGet, managementChannelsRouterPath, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var view metricsinfo.ChannelAssignments
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &view))
+	assert.Equal(t, assignments, view)
+
+	dataCoord.resp = &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: metricsinfo.MsgUnimplementedMetric},
+	}
+	w = serveManagement(handler, http.MethodGet, managementChannelsRouterPath, nil)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	dataCoord.err = errors.New("datacoord is down")
+	w = serveManagement(handler, http.MethodGet, managementChannelsRouterPath, nil)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestManagementHandler_Segments(t *testing.T) {
+	queryCoord := &mockQueryCoordManager{
+		collectionIDs: []int64{100, 200},
+		segments: []*querypb.SegmentInfo{
+			{SegmentID: 3, CollectionID: 100, NodeID: 1, NumRows: 10},
+			{SegmentID: 1, CollectionID: 100, NodeID: 1, NumRows: 20},
+			{SegmentID: 2, CollectionID: 200, NodeID: 2, NumRows: 30},
+		},
+		status: successStatus(),
+	}
+	handler := &managementHandler{queryCoord: queryCoord}
+
+	w := serveManagement(handler, http.MethodGet, managementSegmentsRouterPath, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var view segmentDistributionView
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &view))
+	assert.Equal(t, 2, len(view.Nodes))
+	assert.Equal(t, int64(1), view.Nodes[0].NodeID)
+	assert.Equal(t, 2, len(view.Nodes[0].Segments))
+	assert.Equal(t, int64(1), view.Nodes[0].Segments[0].SegmentID)
+	assert.Equal(t, int64(3), view.Nodes[0].Segments[1].SegmentID)
+	assert.Equal(t, int64(2), view.Nodes[1].NodeID)
+
+	w = serveManagement(handler, http.MethodGet, managementSegmentsRouterPath+"?collection_id=200", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &view))
+	assert.Equal(t, 1, len(view.Nodes))
+	assert.Equal(t, int64(30), view.Nodes[0].Segments[0].NumRows)
+
+	w = serveManagement(handler, http.MethodGet, managementSegmentsRouterPath+"?collection_id=abc", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	queryCoord.status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "not healthy"}
+	w = serveManagement(handler, http.MethodGet, managementSegmentsRouterPath, nil)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestManagementHandler_LoadBalance(t *testing.T) {
+	queryCoord := &mockQueryCoordManager{status: successStatus()}
+	handler := &managementHandler{queryCoord: queryCoord}
+
+	body, err := json.Marshal(loadBalanceRequest{
+		SourceNodeIDs:    []int64{1},
+		DstNodeIDs:       []int64{2},
+		SealedSegmentIDs: []int64{10, 11},
+	})
+	assert.NoError(t, err)
+	w := serveManagement(handler, http.MethodPost, managementLoadBalanceRouterPath, body)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []int64{1}, queryCoord.balanceReq.SourceNodeIDs)
+	assert.Equal(t, []int64{2}, queryCoord.balanceReq.DstNodeIDs)
+	assert.Equal(t, []int64{10, 11}, queryCoord.balanceReq.SealedSegmentIDs)
+	assert.Equal(t, querypb.TriggerCondition_grpcRequest, queryCoord.balanceReq.BalanceReason)
+
+	w = serveManagement(handler, http.MethodGet, managementLoadBalanceRouterPath, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = serveManagement(handler, http.MethodPost, managementLoadBalanceRouterPath, []byte("{"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serveManagement(handler, http.MethodPost, managementLoadBalanceRouterPath, []byte("{}"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	queryCoord.status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "node not found"}
+	w = serveManagement(handler, http.MethodPost, managementLoadBalanceRouterPath, body)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var mErr managementError
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &mErr))
+	assert.Contains(t, mErr.Error, "node not found")
+
+	// the actions are not served with the views only
+	mux := http.NewServeMux()
+	handler.registerViews(mux)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, managementLoadBalanceRouterPath, bytes.NewReader(body)))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -19,6 +19,7 @@ package roles
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -46,14 +47,29 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoord"
 	"github.com/milvus-io/milvus/internal/querynode"
 	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
 func newMsgFactory(localMsg bool) msgstream.Factory {
-	if localMsg {
+	paramtable.Params.Init()
+	// the rocksmq replicated by raft serves the cluster without pulsar
+	if localMsg || len(paramtable.Params.RocksmqRaftPeers) > 0 || rocksmq.Rmq != nil {
 		return msgstream.NewRmsFactory()
 	}
+	// the processes of a small cluster share the rocksmq of the rocksmq role
+	if paramtable.Params.RocksmqServerAddress != "" {
+		return msgstream.NewRemoteRmsFactory([]string{paramtable.Params.RocksmqServerAddress})
+	}
+	// kafka replaces pulsar if kafka brokers are configured
+	if len(paramtable.Params.KafkaBrokerList) > 0 {
+		return msgstream.NewKmsFactory(mqclient.KafkaBrokerOptions{
+			Brokers:           paramtable.Params.KafkaBrokerList,
+			ReplicationFactor: paramtable.Params.KafkaReplicationFactor,
+			MaxMessageBytes:   paramtable.Params.KafkaMaxMessageSize,
+		})
+	}
 	return msgstream.NewPmsFactory()
 }
 
@@ -66,6 +82,21 @@ func stopRocksmq() {
 	rocksmq.CloseRocksMQ()
 }
 
+// defaultRocksmqServerPort is listened on by the rocksmq role if rocksmq.server.address is not set
+const defaultRocksmqServerPort = "19540"
+
+func startRocksmqService() error {
+	port := defaultRocksmqServerPort
+	if address := paramtable.Params.RocksmqServerAddress; address != "" {
+		var err error
+		// listen on all the interfaces, the address may not be resolved to a local interface
+		if _, port, err = net.SplitHostPort(address); err != nil {
+			return err
+		}
+	}
+	return rocksmq.StartRocksMQService(":" + port)
+}
+
 // MilvusRoles determines to run which components.
 type MilvusRoles struct {
 	EnableRootCoord  bool `env:"ENABLE_ROOT_COORD"`
@@ -76,6 +107,7 @@ type MilvusRoles struct {
 	EnableDataNode   bool `env:"ENABLE_DATA_NODE"`
 	EnableIndexCoord bool `env:"ENABLE_INDEX_COORD"`
 	EnableIndexNode  bool `env:"ENABLE_INDEX_NODE"`
+	EnableRocksMQ    bool `env:"ENABLE_ROCKSMQ"`
 }
 
 // EnvValue not used now.
@@ -344,11 +376,30 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 			panic(err)
 		}
 		defer stopRocksmq()
+		// serve the rocksmq for inspection if its address is configured
+		if paramtable.Params.RocksmqServerAddress != "" {
+			if err := startRocksmqService(); err != nil {
+				panic(err)
+			}
+		}
 	} else {
 		err := os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode)
 		if err != nil {
 			log.Error("Failed to set deploy mode: ", zap.Error(err))
 		}
+		// join the raft group of rocksmq or connect it
+		paramtable.Params.Init()
+		if len(paramtable.Params.RocksmqRaftPeers) > 0 || mr.EnableRocksMQ {
+			if err := initRocksmq(); err != nil {
+				panic(err)
+			}
+			defer stopRocksmq()
+		}
+		if mr.EnableRocksMQ {
+			if err := startRocksmqService(); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	var rc *components.RootCoord
@@ -448,6 +499,19 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		http.HandleFunc(healthz.HealthzRouterPath, standaloneHealthzHandler)
 	}
 
+	paramtable.Params.Init()
+	if paramtable.Params.ManagementEnabled {
+		managementHandler, err := newManagementHandler(ctx)
+		if err != nil {
+			log.Warn("failed to create the management handler, the management api is not served", zap.Error(err))
+		} else {
+			managementHandler.registerViews(http.DefaultServeMux)
+			if paramtable.Params.ManagementActionsEnabled {
+				managementHandler.registerActions(http.DefaultServeMux)
+			}
+		}
+	}
+
 	metrics.ServeHTTP()
 
 	sc := make(chan os.Signal, 1)
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	// drivers of the sql metastore
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
+)
+
+var (
+	etcdAddr = flag.String("etcd", "127.0.0.1:2379", "Etcd endpoints to copy meta from, separated by comma")
+	prefixes = flag.String("prefix", "by-dev/meta,by-dev/kv", "Root paths to copy, separated by comma")
+	excludes = flag.String("exclude", "session,channelwatch,gid,tso", "Sub paths under the root paths which are kept in etcd, separated by comma")
+	driver   = flag.String("driver", "mysql", "Driver of the sql metastore, mysql or sqlite3")
+	dsn      = flag.String("dsn", "", "Data source name of the sql metastore")
+	batch    = flag.Int("batch", 128, "Number of keys saved in one transaction")
+	dryRun   = flag.Bool("dry-run", false, "Only list the number of keys to copy")
+)
+
+// metaSource is the etcd kv the meta is copied from
+type metaSource interface {
+	LoadWithPrefix(key string) ([]string, []string, error)
+}
+
+func split(s string) []string {
+	result := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func excluded(key, prefix string, subPaths []string) bool {
+	for _, sub := range subPaths {
+		if p := path.Join(prefix, sub); key == p || strings.HasPrefix(key, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// migrate copies the keys under prefixes from src to dst, except those under subPaths of the prefixes.
+// dst is not touched if dryRun is set.
+func migrate(src metaSource, dst *sqlkv.SQLKV, prefixes, subPaths []string, batch int, dryRun bool) error {
+	for _, prefix := range prefixes {
+		keys, values, err := src.LoadWithPrefix(prefix)
+		if err != nil {
+			return fmt.Errorf("failed to load %s from etcd: %w", prefix, err)
+		}
+
+		kvs := make(map[string]string)
+		copied, skipped := 0, 0
+		for i, key := range keys {
+			if excluded(key, prefix, subPaths) {
+				skipped++
+				continue
+			}
+			copied++
+			if dryRun {
+				continue
+			}
+			kvs[key] = values[i]
+			if len(kvs) >= batch {
+				if err := dst.MultiSave(kvs); err != nil {
+					return fmt.Errorf("failed to save keys of %s: %w", prefix, err)
+				}
+				kvs = make(map[string]string)
+			}
+		}
+		if len(kvs) > 0 {
+			if err := dst.MultiSave(kvs); err != nil {
+				return fmt.Errorf("failed to save keys of %s: %w", prefix, err)
+			}
+		}
+		fmt.Printf("%s: %d keys copied, %d keys skipped\n", prefix, copied, skipped)
+
+		if dryRun {
+			continue
+		}
+		// verify the copied keys
+		for i, key := range keys {
+			if excluded(key, prefix, subPaths) {
+				continue
+			}
+			value, err := dst.Load(key)
+			if err != nil || value != values[i] {
+				return fmt.Errorf("key %s is not copied correctly: %v", key, err)
+			}
+		}
+	}
+	return nil
+}
+
+func run() error {
+	etcd, err := etcdkv.NewEtcdKV(split(*etcdAddr), "")
+	if err != nil {
+		return fmt.Errorf("failed to connect etcd %s: %w", *etcdAddr, err)
+	}
+	defer etcd.Close()
+
+	var store *sqlkv.SQLKV
+	if !*dryRun {
+		if store, err = sqlkv.NewSQLKV(*driver, *dsn, ""); err != nil {
+			return fmt.Errorf("failed to open sql metastore: %w", err)
+		}
+		defer store.Close()
+	}
+
+	return migrate(etcd, store, split(*prefixes), split(*excludes), *batch, *dryRun)
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
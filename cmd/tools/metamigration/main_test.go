@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
+)
+
+// mockEtcd serves LoadWithPrefix from a map like etcd does
+type mockEtcd map[string]string
+
+func (m mockEtcd) LoadWithPrefix(key string) ([]string, []string, error) {
+	if key == "error" {
+		return nil, nil, errors.New("mock error")
+	}
+	keys := make([]string, 0)
+	for k := range m {
+		if strings.HasPrefix(k, key) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, m[k])
+	}
+	return keys, values, nil
+}
+
+func TestMigrate(t *testing.T) {
+	src := mockEtcd{
+		"by-dev/meta/root-coord/collection/1": "collection",
+		"by-dev/meta/root-coord/segment/2":    "segment",
+		"by-dev/meta/session/querynode-1":     "session",
+		"by-dev/meta/sessions":                "not excluded",
+		"by-dev/meta/gid":                     "gid",
+		"by-dev/kv/tso":                       "tso",
+		"by-dev/kv/datacoord/3":               "datacoord",
+		"other/meta/4":                        "other",
+	}
+	subPaths := []string{"session", "gid", "tso"}
+
+	open := func(t *testing.T) *sqlkv.SQLKV {
+		store, err := sqlkv.NewSQLKV("sqlite3", filepath.Join(t.TempDir(), "meta.db"), "")
+		require.NoError(t, err)
+		t.Cleanup(store.Close)
+		return store
+	}
+
+	t.Run("copy", func(t *testing.T) {
+		store := open(t)
+		// a small batch saves the keys in several transactions
+		err := migrate(src, store, []string{"by-dev/meta", "by-dev/kv"}, subPaths, 2, false)
+		assert.NoError(t, err)
+
+		keys, values, err := store.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			"by-dev/meta/root-coord/collection/1",
+			"by-dev/meta/root-coord/segment/2",
+			"by-dev/meta/sessions",
+			"by-dev/kv/datacoord/3",
+		}, keys)
+		for i, key := range keys {
+			assert.Equal(t, src[key], values[i])
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		err := migrate(src, nil, []string{"by-dev/meta", "by-dev/kv"}, subPaths, 2, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("load error", func(t *testing.T) {
+		err := migrate(src, open(t), []string{"error"}, subPaths, 2, false)
+		assert.Error(t, err)
+	})
+}
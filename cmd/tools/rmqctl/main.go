@@ -0,0 +1,156 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/rocksmq/server/rocksmq"
+)
+
+var (
+	address = flag.String("address", "127.0.0.1:19540", "Address of the rocksmq server to connect")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage of %s:
+  %s [flags] topics                          list the topics
+  %s [flags] stats [topic...]                show the messages, pages, retention and consumer groups of the topics, all topics by default
+  %s [flags] retention <topic> <min> <mb>    override the retention of a topic, -1 retains forever, 0 0 resets to the global retention
+  %s [flags] disk                            show the disk usage
+Flags:
+`, os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0])
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	rmq, err := rocksmq.NewRemoteRocksMQ([]string{*address})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect rocksmq server %s: %s\n", *address, err.Error())
+		os.Exit(1)
+	}
+	defer rmq.Close()
+
+	switch args[0] {
+	case "topics":
+		err = listTopics(rmq)
+	case "stats":
+		err = printTopicStats(rmq, args[1:])
+	case "retention":
+		err = setTopicRetention(rmq, args[1:])
+	case "disk":
+		err = printDiskUsage(rmq)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+func listTopics(admin rocksmq.RocksMQAdmin) error {
+	topics, err := admin.ListTopics()
+	if err != nil {
+		return err
+	}
+	for _, topic := range topics {
+		fmt.Println(topic)
+	}
+	return nil
+}
+
+const (
+	tsPrintFormat = "2006-01-02 15:04:05 -0700"
+)
+
+func printTopicStats(admin rocksmq.RocksMQAdmin, topics []string) error {
+	if len(topics) == 0 {
+		var err error
+		if topics, err = admin.ListTopics(); err != nil {
+			return err
+		}
+	}
+	for _, topic := range topics {
+		stats, err := admin.GetTopicStats(topic)
+		if err != nil {
+			return err
+		}
+		fmt.Println("================================================================================")
+		fmt.Printf("Topic: %s\n", stats.Topic)
+		overridden := ""
+		if stats.RetentionOverridden {
+			overridden = " (overridden)"
+		}
+		fmt.Printf("Retention Time: %s\t\tRetention Size: %s%s\n", formatRetention(stats.RetentionTimeInMinutes, "minutes"),
+			formatRetention(stats.RetentionSizeInMB, "MB"), overridden)
+		fmt.Printf("Messages: %d\t\tMessage Size: %s\n", stats.MessageCount, formatSize(stats.MessageSize))
+		fmt.Printf("Acked Size: %s\t\tDisk Usage: %s\n", formatSize(stats.AckedSize), formatSize(stats.DiskUsage))
+		fmt.Printf("Pages: %d\n", len(stats.Pages))
+		for _, page := range stats.Pages {
+			acked := "not acked"
+			if page.AckedTs != 0 {
+				acked = "acked at " + time.Unix(page.AckedTs, 0).Format(tsPrintFormat)
+			}
+			fmt.Printf("  End ID: %d\tSize: %s\t%s\n", page.EndID, formatSize(page.Size), acked)
+		}
+		fmt.Printf("Consumer Groups: %d\n", len(stats.Groups))
+		for _, group := range stats.Groups {
+			fmt.Printf("  %s\tCurrent ID: %d\tAcked ID: %d\n", group.Group, group.CurrentID, group.AckedID)
+		}
+	}
+	return nil
+}
+
+func setTopicRetention(admin rocksmq.RocksMQAdmin, args []string) error {
+	if len(args) != 3 {
+		return fmt.Errorf("usage: %s retention <topic> <min> <mb>", os.Args[0])
+	}
+	retentionTime, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	retentionSize, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return err
+	}
+	return admin.SetTopicRetention(args[0], retentionTime, retentionSize)
+}
+
+func printDiskUsage(admin rocksmq.RocksMQAdmin) error {
+	usage, err := admin.GetDiskUsage()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Message Store: %s\n", formatSize(usage.StoreSize))
+	fmt.Printf("Meta: %s\n", formatSize(usage.MetaSize))
+	return nil
+}
+
+func formatRetention(value int64, unit string) string {
+	if value == -1 {
+		return "forever"
+	}
+	return strconv.FormatInt(value, 10) + " " + unit
+}
+
+func formatSize(size int64) string {
+	switch {
+	case size >= rocksmq.MB:
+		return fmt.Sprintf("%.2f MB", float64(size)/rocksmq.MB)
+	case size >= 1024:
+		return fmt.Sprintf("%.2f KB", float64(size)/1024)
+	}
+	return fmt.Sprintf("%d B", size)
+}
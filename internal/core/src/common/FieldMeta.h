@@ -38,8 +38,14 @@ datatype_sizeof(DataType data_type, int dim = 1) {
             return sizeof(float);
         case DataType::DOUBLE:
             return sizeof(double);
+        case DataType::STRING:
+            // strings take no bytes in the fixed-size rows, their columns are kept apart
+            return 0;
         case DataType::VECTOR_FLOAT:
             return sizeof(float) * dim;
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16:
+            return sizeof(uint16_t) * dim;
         case DataType::VECTOR_BINARY: {
             Assert(dim % 8 == 0);
             return dim / 8;
@@ -68,8 +74,14 @@ datatype_name(DataType data_type) {
             return "float";
         case DataType::DOUBLE:
             return "double";
+        case DataType::STRING:
+            return "string";
         case DataType::VECTOR_FLOAT:
             return "vector_float";
+        case DataType::VECTOR_FLOAT16:
+            return "vector_float16";
+        case DataType::VECTOR_BFLOAT16:
+            return "vector_bfloat16";
         case DataType::VECTOR_BINARY: {
             return "vector_binary";
         }
@@ -80,9 +92,15 @@ datatype_name(DataType data_type) {
     }
 }
 
+inline bool
+datatype_is_half_float_vector(DataType datatype) {
+    return datatype == DataType::VECTOR_FLOAT16 || datatype == DataType::VECTOR_BFLOAT16;
+}
+
 inline bool
 datatype_is_vector(DataType datatype) {
-    return datatype == DataType::VECTOR_BINARY || datatype == DataType::VECTOR_FLOAT;
+    return datatype == DataType::VECTOR_BINARY || datatype == DataType::VECTOR_FLOAT ||
+           datatype_is_half_float_vector(datatype);
 }
 
 inline bool
@@ -131,7 +149,7 @@ class FieldMeta {
     bool
     is_vector() const {
         Assert(type_ != DataType::NONE);
-        return type_ == DataType::VECTOR_BINARY || type_ == DataType::VECTOR_FLOAT;
+        return datatype_is_vector(type_);
     }
 
     int64_t
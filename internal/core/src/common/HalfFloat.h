@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+#include <cstdint>
+#include <cstring>
+
+namespace milvus {
+
+// converts an IEEE 754 half precision value to float
+inline float
+Float16ToFloat32(uint16_t h) {
+    uint32_t sign = static_cast<uint32_t>(h >> 15) << 31;
+    uint32_t exp = (h >> 10) & 0x1f;
+    uint32_t mant = h & 0x3ff;
+
+    uint32_t bits;
+    if (exp == 0 && mant == 0) {
+        bits = sign;
+    } else if (exp == 0) {
+        // subnormal, normalize it
+        uint32_t e = 127 - 15 + 1;
+        while ((mant & 0x400) == 0) {
+            mant <<= 1;
+            e--;
+        }
+        mant &= 0x3ff;
+        bits = sign | e << 23 | mant << 13;
+    } else if (exp == 0x1f) {
+        bits = sign | 0xffu << 23 | mant << 13;
+    } else {
+        bits = sign | (exp + 127 - 15) << 23 | mant << 13;
+    }
+
+    float f;
+    std::memcpy(&f, &bits, sizeof(f));
+    return f;
+}
+
+// bfloat16 keeps the upper half of a float
+inline float
+BFloat16ToFloat32(uint16_t b) {
+    uint32_t bits = static_cast<uint32_t>(b) << 16;
+    float f;
+    std::memcpy(&f, &bits, sizeof(f));
+    return f;
+}
+
+}  // namespace milvus
@@ -18,8 +18,12 @@
 
 struct LoadIndexInfo {
     int64_t field_id;
+    // several named indexes could be loaded on a vector field, the first one is searched by default
+    std::string index_name;
     std::map<std::string, std::string> index_params;
     milvus::knowhere::VecIndexPtr index;
+    // set instead of index when the index is built on a scalar field
+    milvus::knowhere::IndexPtr scalar_index;
 };
 
 // NOTE: field_id can be system field
@@ -28,6 +32,8 @@ struct LoadFieldDataInfo {
     int64_t field_id;
     const void* blob = nullptr;
     int64_t row_count = -1;
+    // only set for strings, which are passed as a serialized schema.StringArray
+    int64_t blob_size = -1;
 };
 
 struct LoadDeletedRecordInfo {
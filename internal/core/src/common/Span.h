@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #pragma once
+#include <string>
 #include <type_traits>
 #include "common/Types.h"
 #include <cassert>
@@ -49,7 +50,7 @@ class Span;
 
 // TODO: refine Span to support T=FloatVector
 template <typename T>
-class Span<T, typename std::enable_if_t<std::is_fundamental_v<T>>> {
+class Span<T, typename std::enable_if_t<std::is_fundamental_v<T> || std::is_same_v<T, std::string>>> {
  public:
     using embeded_type = T;
     explicit Span(const T* data, int64_t row_count) : data_(data), row_count_(row_count) {
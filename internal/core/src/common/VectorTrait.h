@@ -28,12 +28,21 @@ class BinaryVector : public VectorTrait {
     static constexpr auto metric_type = DataType::VECTOR_BINARY;
 };
 
+// float16 and bfloat16 vectors are both kept as raw 16-bit elements, the field type tells them apart
+class HalfFloatVector : public VectorTrait {
+ public:
+    using embedded_type = uint16_t;
+    static constexpr auto metric_type = DataType::VECTOR_FLOAT16;
+};
+
 template <typename VectorType>
 inline constexpr int64_t
 element_sizeof(int64_t dim) {
     static_assert(std::is_base_of_v<VectorType, VectorTrait>);
     if constexpr (std::is_same_v<VectorType, FloatVector>) {
         return dim * sizeof(float);
+    } else if constexpr (std::is_same_v<VectorType, HalfFloatVector>) {
+        return dim * sizeof(uint16_t);
     } else {
         return dim / 8;
     }
@@ -55,7 +64,7 @@ struct EmbeddedTypeImpl<T, std::enable_if_t<IsScalar<T>>> {
 
 template <typename T>
 struct EmbeddedTypeImpl<T, std::enable_if_t<IsVector<T>>> {
-    using type = std::conditional_t<std::is_same_v<T, FloatVector>, float, uint8_t>;
+    using type = typename T::embedded_type;
 };
 
 template <typename T>
@@ -45,6 +45,7 @@ typedef struct CLoadFieldDataInfo {
     int64_t field_id;
     void* blob;
     int64_t row_count;
+    int64_t blob_size;
 } CLoadFieldDataInfo;
 
 typedef struct CLoadDeletedRecordInfo {
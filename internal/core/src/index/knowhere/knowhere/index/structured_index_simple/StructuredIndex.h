@@ -11,9 +11,13 @@
 
 #pragma once
 
+#include <cstring>
 #include <map>
 #include <memory>
+#include <stdexcept>
 #include <string>
+#include <utility>
+#include <vector>
 #include "faiss/utils/ConcurrentBitset.h"
 #include "knowhere/index/Index.h"
 #include <boost/dynamic_bitset.hpp>
@@ -30,7 +34,7 @@ static std::map<std::string, OperatorType> s_map_operator_type = {
 
 template <typename T>
 struct IndexStructure {
-    IndexStructure() : a_(0), idx_(0) {
+    IndexStructure() : a_(), idx_(0) {
     }
     explicit IndexStructure(const T a) : a_(a), idx_(0) {
     }
@@ -62,6 +66,45 @@ struct IndexStructure {
 using TargetBitmap = boost::dynamic_bitset<>;
 using TargetBitmapPtr = std::unique_ptr<TargetBitmap>;
 
+// std::string can't be copied bytewise, string keys are serialized as (length, bytes) pairs
+inline std::pair<std::shared_ptr<uint8_t[]>, size_t>
+PackStrings(const std::vector<std::string>& strs) {
+    size_t size = 0;
+    for (auto& str : strs) {
+        size += sizeof(size_t) + str.size();
+    }
+    std::shared_ptr<uint8_t[]> data(new uint8_t[size]);
+    auto p = data.get();
+    for (auto& str : strs) {
+        auto len = str.size();
+        memcpy(p, &len, sizeof(size_t));
+        p += sizeof(size_t);
+        memcpy(p, str.data(), len);
+        p += len;
+    }
+    return std::make_pair(data, size);
+}
+
+inline std::vector<std::string>
+UnpackStrings(const uint8_t* data, size_t size) {
+    std::vector<std::string> strs;
+    size_t pos = 0;
+    while (pos < size) {
+        if (pos + sizeof(size_t) > size) {
+            throw std::runtime_error("string keys are corrupted");
+        }
+        size_t len;
+        memcpy(&len, data + pos, sizeof(size_t));
+        pos += sizeof(size_t);
+        if (pos + len > size) {
+            throw std::runtime_error("string keys are corrupted");
+        }
+        strs.emplace_back(reinterpret_cast<const char*>(data + pos), len);
+        pos += len;
+    }
+    return strs;
+}
+
 template <typename T>
 class StructuredIndex : public Index {
  public:
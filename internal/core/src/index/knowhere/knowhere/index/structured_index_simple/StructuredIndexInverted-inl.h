@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <algorithm>
+#include <memory>
+#include <utility>
+#include "knowhere/common/Log.h"
+#include "knowhere/index/structured_index_simple/StructuredIndexInverted.h"
+
+namespace milvus {
+namespace knowhere::scalar {
+
+template <typename T>
+StructuredIndexInverted<T>::StructuredIndexInverted() : is_built_(false), count_(0) {
+}
+
+template <typename T>
+StructuredIndexInverted<T>::StructuredIndexInverted(const size_t n, const T* values) : is_built_(false), count_(0) {
+    StructuredIndexInverted<T>::Build(n, values);
+}
+
+template <typename T>
+StructuredIndexInverted<T>::~StructuredIndexInverted() {
+}
+
+template <typename T>
+void
+StructuredIndexInverted<T>::Build(const size_t n, const T* values) {
+    if (is_built_)
+        return;
+    if (n == 0) {
+        KNOWHERE_THROW_MSG("StructuredIndexInverted cannot build null values!");
+    }
+    std::vector<IndexStructure<T>> data;
+    data.reserve(n);
+    for (size_t i = 0; i < n; ++i) {
+        data.emplace_back(IndexStructure<T>(values[i], i));
+    }
+    // keep the offsets of the same value in ascending order
+    std::stable_sort(data.begin(), data.end());
+
+    keys_.clear();
+    offsets_.clear();
+    ids_.clear();
+    ids_.reserve(n);
+    for (size_t i = 0; i < n; ++i) {
+        if (i == 0 || data[i].a_ != data[i - 1].a_) {
+            keys_.push_back(data[i].a_);
+            offsets_.push_back(i);
+        }
+        ids_.push_back(data[i].idx_);
+    }
+    offsets_.push_back(n);
+    count_ = n;
+    is_built_ = true;
+}
+
+template <typename T>
+BinarySet
+StructuredIndexInverted<T>::Serialize(const milvus::knowhere::Config& config) {
+    if (!is_built_) {
+        KNOWHERE_THROW_MSG("StructuredIndexInverted is not built yet!");
+    }
+
+    auto keys_size = keys_.size() * sizeof(T);
+    std::shared_ptr<uint8_t[]> index_keys(new uint8_t[keys_size]);
+    memcpy(index_keys.get(), keys_.data(), keys_size);
+
+    auto offsets_size = offsets_.size() * sizeof(size_t);
+    std::shared_ptr<uint8_t[]> index_offsets(new uint8_t[offsets_size]);
+    memcpy(index_offsets.get(), offsets_.data(), offsets_size);
+
+    auto ids_size = ids_.size() * sizeof(size_t);
+    std::shared_ptr<uint8_t[]> index_ids(new uint8_t[ids_size]);
+    memcpy(index_ids.get(), ids_.data(), ids_size);
+
+    std::shared_ptr<uint8_t[]> index_length(new uint8_t[sizeof(size_t)]);
+    memcpy(index_length.get(), &count_, sizeof(size_t));
+
+    BinarySet res_set;
+    res_set.Append("index_keys", index_keys, keys_size);
+    res_set.Append("index_offsets", index_offsets, offsets_size);
+    res_set.Append("index_ids", index_ids, ids_size);
+    res_set.Append("index_length", index_length, sizeof(size_t));
+    return res_set;
+}
+
+template <typename T>
+void
+StructuredIndexInverted<T>::Load(const milvus::knowhere::BinarySet& index_binary) {
+    try {
+        auto index_length = index_binary.GetByName("index_length");
+        memcpy(&count_, index_length->data.get(), sizeof(size_t));
+
+        auto index_keys = index_binary.GetByName("index_keys");
+        keys_.resize((size_t)index_keys->size / sizeof(T));
+        memcpy(keys_.data(), index_keys->data.get(), (size_t)index_keys->size);
+
+        auto index_offsets = index_binary.GetByName("index_offsets");
+        offsets_.resize((size_t)index_offsets->size / sizeof(size_t));
+        memcpy(offsets_.data(), index_offsets->data.get(), (size_t)index_offsets->size);
+
+        auto index_ids = index_binary.GetByName("index_ids");
+        ids_.resize((size_t)index_ids->size / sizeof(size_t));
+        memcpy(ids_.data(), index_ids->data.get(), (size_t)index_ids->size);
+
+        if (offsets_.size() != keys_.size() + 1 || ids_.size() != count_) {
+            KNOWHERE_THROW_MSG("StructuredIndexInverted index data is corrupted!");
+        }
+        is_built_ = true;
+    } catch (...) {
+        KNOHWERE_ERROR_MSG("StructuredIndexInverted Load failed!");
+    }
+}
+
+template <>
+inline BinarySet
+StructuredIndexInverted<std::string>::Serialize(const milvus::knowhere::Config& config) {
+    if (!is_built_) {
+        KNOWHERE_THROW_MSG("StructuredIndexInverted is not built yet!");
+    }
+
+    auto [index_keys, keys_size] = PackStrings(keys_);
+
+    auto offsets_size = offsets_.size() * sizeof(size_t);
+    std::shared_ptr<uint8_t[]> index_offsets(new uint8_t[offsets_size]);
+    memcpy(index_offsets.get(), offsets_.data(), offsets_size);
+
+    auto ids_size = ids_.size() * sizeof(size_t);
+    std::shared_ptr<uint8_t[]> index_ids(new uint8_t[ids_size]);
+    memcpy(index_ids.get(), ids_.data(), ids_size);
+
+    std::shared_ptr<uint8_t[]> index_length(new uint8_t[sizeof(size_t)]);
+    memcpy(index_length.get(), &count_, sizeof(size_t));
+
+    BinarySet res_set;
+    res_set.Append("index_keys", index_keys, keys_size);
+    res_set.Append("index_offsets", index_offsets, offsets_size);
+    res_set.Append("index_ids", index_ids, ids_size);
+    res_set.Append("index_length", index_length, sizeof(size_t));
+    return res_set;
+}
+
+template <>
+inline void
+StructuredIndexInverted<std::string>::Load(const milvus::knowhere::BinarySet& index_binary) {
+    try {
+        auto index_length = index_binary.GetByName("index_length");
+        memcpy(&count_, index_length->data.get(), sizeof(size_t));
+
+        auto index_keys = index_binary.GetByName("index_keys");
+        keys_ = UnpackStrings(index_keys->data.get(), (size_t)index_keys->size);
+
+        auto index_offsets = index_binary.GetByName("index_offsets");
+        offsets_.resize((size_t)index_offsets->size / sizeof(size_t));
+        memcpy(offsets_.data(), index_offsets->data.get(), (size_t)index_offsets->size);
+
+        auto index_ids = index_binary.GetByName("index_ids");
+        ids_.resize((size_t)index_ids->size / sizeof(size_t));
+        memcpy(ids_.data(), index_ids->data.get(), (size_t)index_ids->size);
+
+        if (offsets_.size() != keys_.size() + 1 || ids_.size() != count_) {
+            KNOWHERE_THROW_MSG("StructuredIndexInverted index data is corrupted!");
+        }
+        is_built_ = true;
+    } catch (...) {
+        KNOHWERE_ERROR_MSG("StructuredIndexInverted Load failed!");
+    }
+}
+
+template <typename T>
+void
+StructuredIndexInverted<T>::set_postings(TargetBitmap& bitset, size_t first, size_t last, bool value) {
+    if (first >= last) {
+        return;
+    }
+    for (auto i = offsets_[first]; i < offsets_[last]; ++i) {
+        bitset[ids_[i]] = value;
+    }
+}
+
+template <typename T>
+const TargetBitmapPtr
+StructuredIndexInverted<T>::In(const size_t n, const T* values) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(count_);
+    for (size_t i = 0; i < n; ++i) {
+        auto it = std::lower_bound(keys_.begin(), keys_.end(), values[i]);
+        if (it != keys_.end() && *it == values[i]) {
+            auto pos = (size_t)(it - keys_.begin());
+            set_postings(*bitset, pos, pos + 1, true);
+        }
+    }
+    return bitset;
+}
+
+template <typename T>
+const TargetBitmapPtr
+StructuredIndexInverted<T>::NotIn(const size_t n, const T* values) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(count_);
+    bitset->set();
+    for (size_t i = 0; i < n; ++i) {
+        auto it = std::lower_bound(keys_.begin(), keys_.end(), values[i]);
+        if (it != keys_.end() && *it == values[i]) {
+            auto pos = (size_t)(it - keys_.begin());
+            set_postings(*bitset, pos, pos + 1, false);
+        }
+    }
+    return bitset;
+}
+
+template <typename T>
+const TargetBitmapPtr
+StructuredIndexInverted<T>::Range(const T value, const OperatorType op) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(count_);
+    size_t first = 0;
+    size_t last = keys_.size();
+    switch (op) {
+        case OperatorType::LT:
+            last = std::lower_bound(keys_.begin(), keys_.end(), value) - keys_.begin();
+            break;
+        case OperatorType::LE:
+            last = std::upper_bound(keys_.begin(), keys_.end(), value) - keys_.begin();
+            break;
+        case OperatorType::GT:
+            first = std::upper_bound(keys_.begin(), keys_.end(), value) - keys_.begin();
+            break;
+        case OperatorType::GE:
+            first = std::lower_bound(keys_.begin(), keys_.end(), value) - keys_.begin();
+            break;
+        default:
+            KNOWHERE_THROW_MSG("Invalid OperatorType:" + std::to_string((int)op) + "!");
+    }
+    set_postings(*bitset, first, last, true);
+    return bitset;
+}
+
+template <typename T>
+const TargetBitmapPtr
+StructuredIndexInverted<T>::Range(T lower_bound_value, bool lb_inclusive, T upper_bound_value, bool ub_inclusive) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(count_);
+    if (lower_bound_value > upper_bound_value) {
+        std::swap(lower_bound_value, upper_bound_value);
+        std::swap(lb_inclusive, ub_inclusive);
+    }
+    size_t first = lb_inclusive ? std::lower_bound(keys_.begin(), keys_.end(), lower_bound_value) - keys_.begin()
+                                : std::upper_bound(keys_.begin(), keys_.end(), lower_bound_value) - keys_.begin();
+    size_t last = ub_inclusive ? std::upper_bound(keys_.begin(), keys_.end(), upper_bound_value) - keys_.begin()
+                               : std::lower_bound(keys_.begin(), keys_.end(), upper_bound_value) - keys_.begin();
+    set_postings(*bitset, first, last, true);
+    return bitset;
+}
+
+}  // namespace knowhere::scalar
+}  // namespace milvus
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+
+#include <memory>
+#include <utility>
+#include <vector>
+#include "knowhere/common/Exception.h"
+#include "knowhere/index/structured_index_simple/StructuredIndex.h"
+
+namespace milvus {
+namespace knowhere::scalar {
+
+// StructuredIndexInverted keeps the distinct values in order, every value has a posting list of the offsets
+// holding it, so both term and range filters only touch the matched offsets.
+template <typename T>
+class StructuredIndexInverted : public StructuredIndex<T> {
+ public:
+    StructuredIndexInverted();
+    StructuredIndexInverted(const size_t n, const T* values);
+    ~StructuredIndexInverted();
+
+    BinarySet
+    Serialize(const Config& config = Config()) override;
+
+    void
+    Load(const BinarySet& index_binary) override;
+
+    void
+    Build(const size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    In(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    NotIn(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    Range(T value, OperatorType op) override;
+
+    const TargetBitmapPtr
+    Range(T lower_bound_value, bool lb_inclusive, T upper_bound_value, bool ub_inclusive) override;
+
+    int64_t
+    Size() override {
+        return (int64_t)count_;
+    }
+
+    size_t
+    Cardinality() const {
+        return keys_.size();
+    }
+
+    bool
+    IsBuilt() const {
+        return is_built_;
+    }
+
+ private:
+    // set the posting lists of the distinct values in [first, last)
+    void
+    set_postings(TargetBitmap& bitset, size_t first, size_t last, bool value);
+
+ private:
+    bool is_built_;
+    size_t count_;
+    // distinct values in ascending order
+    std::vector<T> keys_;
+    // posting list of keys_[i] is ids_[offsets_[i], offsets_[i + 1])
+    std::vector<size_t> offsets_;
+    std::vector<size_t> ids_;
+};
+
+template <typename T>
+using StructuredIndexInvertedPtr = std::shared_ptr<StructuredIndexInverted<T>>;
+}  // namespace knowhere::scalar
+}  // namespace milvus
+
+#include "knowhere/index/structured_index_simple/StructuredIndexInverted-inl.h"
@@ -93,6 +93,61 @@ StructuredIndexSort<T>::Load(const milvus::knowhere::BinarySet& index_binary) {
     }
 }
 
+template <>
+inline BinarySet
+StructuredIndexSort<std::string>::Serialize(const milvus::knowhere::Config& config) {
+    if (!is_built_) {
+        build();
+    }
+
+    std::vector<std::string> keys;
+    keys.reserve(data_.size());
+    auto ids_size = data_.size() * sizeof(size_t);
+    std::shared_ptr<uint8_t[]> index_ids(new uint8_t[ids_size]);
+    auto ids = reinterpret_cast<size_t*>(index_ids.get());
+    for (size_t i = 0; i < data_.size(); ++i) {
+        keys.push_back(data_[i].a_);
+        ids[i] = data_[i].idx_;
+    }
+    auto [index_data, index_data_size] = PackStrings(keys);
+
+    std::shared_ptr<uint8_t[]> index_length(new uint8_t[sizeof(size_t)]);
+    auto index_size = data_.size();
+    memcpy(index_length.get(), &index_size, sizeof(size_t));
+
+    BinarySet res_set;
+    res_set.Append("index_data", index_data, index_data_size);
+    res_set.Append("index_ids", index_ids, ids_size);
+    res_set.Append("index_length", index_length, sizeof(size_t));
+    return res_set;
+}
+
+template <>
+inline void
+StructuredIndexSort<std::string>::Load(const milvus::knowhere::BinarySet& index_binary) {
+    try {
+        size_t index_size;
+        auto index_length = index_binary.GetByName("index_length");
+        memcpy(&index_size, index_length->data.get(), (size_t)index_length->size);
+
+        auto index_data = index_binary.GetByName("index_data");
+        auto keys = UnpackStrings(index_data->data.get(), (size_t)index_data->size);
+        auto index_ids = index_binary.GetByName("index_ids");
+        if (keys.size() != index_size || (size_t)index_ids->size != index_size * sizeof(size_t)) {
+            KNOWHERE_THROW_MSG("StructuredIndexSort index data is corrupted!");
+        }
+        auto ids = reinterpret_cast<const size_t*>(index_ids->data.get());
+        data_.clear();
+        data_.reserve(index_size);
+        for (size_t i = 0; i < index_size; ++i) {
+            data_.emplace_back(std::move(keys[i]), ids[i]);
+        }
+        is_built_ = true;
+    } catch (...) {
+        KNOHWERE_ERROR_MSG("StructuredIndexSort Load failed!");
+    }
+}
+
 template <typename T>
 const TargetBitmapPtr
 StructuredIndexSort<T>::In(const size_t n, const T* values) {
@@ -19,6 +19,7 @@
 #include "exceptions/EasyAssert.h"
 #include "IndexWrapper.h"
 #include "indexbuilder/utils.h"
+#include "query/ScalarIndex.h"
 #include "index/knowhere/knowhere/index/vector_index/ConfAdapterMgr.h"
 #include "index/knowhere/knowhere/common/Timer.h"
 #include "index/knowhere/knowhere/common/Utils.h"
@@ -34,6 +35,12 @@ IndexWrapper::IndexWrapper(const char* serialized_type_params, const char* seria
 
     auto index_mode = get_index_mode();
     auto index_type = get_index_type();
+    if (query::is_scalar_index_type(index_type)) {
+        scalar_index_ = query::create_scalar_index(index_type, get_data_type());
+        AssertInfo(scalar_index_ != nullptr, "[IndexWrapper]Scalar index is null after create index");
+        return;
+    }
+
     auto metric_type = get_metric_type();
     AssertInfo(!is_unsupported(index_type, metric_type), index_type + " doesn't support metric: " + metric_type);
 
@@ -156,6 +163,7 @@ IndexWrapper::dim() {
 
 void
 IndexWrapper::BuildWithoutIds(const knowhere::DatasetPtr& dataset) {
+    AssertInfo(!IsScalarIndex(), "[IndexWrapper]Scalar index can't be built with vectors");
     auto index_type = get_index_type();
     auto index_mode = get_index_mode();
     config_[knowhere::meta::ROWS] = dataset->Get<int64_t>(knowhere::meta::ROWS);
@@ -188,6 +196,28 @@ IndexWrapper::BuildWithoutIds(const knowhere::DatasetPtr& dataset) {
     rc.ElapseFromBegin("Done");
 }
 
+bool
+IndexWrapper::IsScalarIndex() {
+    return scalar_index_ != nullptr;
+}
+
+void
+IndexWrapper::BuildScalar(const void* data, int64_t row_count) {
+    AssertInfo(IsScalarIndex(), "[IndexWrapper]" + get_index_type() + " is not a scalar index");
+    knowhere::TimeRecorder rc("BuildScalar", 1);
+    query::build_scalar_index(scalar_index_.get(), get_data_type(), data, row_count);
+    rc.ElapseFromBegin("Done");
+}
+
+void
+IndexWrapper::BuildString(const uint8_t* serialized_strings, int64_t size) {
+    AssertInfo(IsScalarIndex(), "[IndexWrapper]" + get_index_type() + " is not a scalar index");
+    AssertInfo(get_data_type() == DataType::STRING, "[IndexWrapper]Scalar index isn't built on strings");
+    knowhere::TimeRecorder rc("BuildString", 1);
+    query::build_string_index(scalar_index_.get(), serialized_strings, size);
+    rc.ElapseFromBegin("Done");
+}
+
 void
 IndexWrapper::BuildWithIds(const knowhere::DatasetPtr& dataset) {
     AssertInfo(dataset->data().find(milvus::knowhere::meta::IDS) != dataset->data().end(),
@@ -235,7 +265,7 @@ IndexWrapper::StoreRawData(const knowhere::DatasetPtr& dataset) {
  */
 std::unique_ptr<IndexWrapper::Binary>
 IndexWrapper::Serialize() {
-    auto binarySet = index_->Serialize(config_);
+    auto binarySet = IsScalarIndex() ? scalar_index_->Serialize(config_) : index_->Serialize(config_);
     auto index_type = get_index_type();
     if (is_in_nm_list(index_type)) {
         std::shared_ptr<uint8_t[]> raw_data(new uint8_t[raw_data_.size()], std::default_delete<uint8_t[]>());
@@ -286,6 +316,10 @@ IndexWrapper::Load(const char* serialized_sliced_blob_buffer, int32_t size) {
         binarySet.Append(binary.key(), bptr);
     }
 
+    if (IsScalarIndex()) {
+        scalar_index_->Load(binarySet);
+        return;
+    }
     index_->Load(binarySet);
 }
 
@@ -331,6 +365,13 @@ IndexWrapper::get_index_file_slice_size() {
     return 4;  // by default
 }
 
+DataType
+IndexWrapper::get_data_type() {
+    auto type = get_config_by_name<std::string>("data_type");
+    AssertInfo(type.has_value(), "[IndexWrapper]Can't find data_type of scalar index in params");
+    return DataType(std::stoi(type.value()));
+}
+
 std::unique_ptr<IndexWrapper::QueryResult>
 IndexWrapper::Query(const knowhere::DatasetPtr& dataset) {
     return std::move(QueryImpl(dataset, config_));
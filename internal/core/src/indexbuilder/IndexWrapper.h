@@ -15,6 +15,7 @@
 #include <vector>
 #include <memory>
 #include "knowhere/index/vector_index/VecIndex.h"
+#include "common/Types.h"
 
 namespace milvus {
 namespace indexbuilder {
@@ -29,6 +30,15 @@ class IndexWrapper {
     void
     BuildWithoutIds(const knowhere::DatasetPtr& dataset);
 
+    bool
+    IsScalarIndex();
+
+    void
+    BuildScalar(const void* data, int64_t row_count);
+
+    void
+    BuildString(const uint8_t* serialized_strings, int64_t size);
+
     struct Binary {
         std::vector<char> data;
     };
@@ -68,6 +78,9 @@ class IndexWrapper {
     int64_t
     get_index_file_slice_size();
 
+    DataType
+    get_data_type();
+
     template <typename T>
     std::optional<T>
     get_config_by_name(std::string name);
@@ -98,6 +111,8 @@ class IndexWrapper {
 
  private:
     knowhere::VecIndexPtr index_ = nullptr;
+    // only one of index_ and scalar_index_ is created, depending on the index type
+    std::unique_ptr<knowhere::Index> scalar_index_ = nullptr;
     std::string type_params_;
     std::string index_params_;
     milvus::json type_config_;
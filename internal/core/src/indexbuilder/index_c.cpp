@@ -84,6 +84,36 @@ BuildBinaryVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* ve
     return status;
 }
 
+CStatus
+BuildScalarIndex(CIndex index, int64_t row_count, const void* data) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::IndexWrapper*)index;
+        cIndex->BuildScalar(data, row_count);
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
+CStatus
+BuildStringIndex(CIndex index, int64_t data_size, const uint8_t* serialized_strings) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::IndexWrapper*)index;
+        cIndex->BuildString(serialized_strings, data_size);
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
 CStatus
 SerializeToSlicedBuffer(CIndex index, CBinary* c_binary) {
     auto status = CStatus();
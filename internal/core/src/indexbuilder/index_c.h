@@ -49,6 +49,14 @@ BuildFloatVecIndexWithoutIds(CIndex index, int64_t float_value_num, const float*
 CStatus
 BuildBinaryVecIndexWithoutIds(CIndex index, int64_t data_size, const uint8_t* vectors);
 
+// Note: data is a packed array of row_count scalars, its type is the data_type in index params
+CStatus
+BuildScalarIndex(CIndex index, int64_t row_count, const void* data);
+
+// Note: serialized_strings is a serialized schema.StringArray
+CStatus
+BuildStringIndex(CIndex index, int64_t data_size, const uint8_t* serialized_strings);
+
 CStatus
 SerializeToSlicedBuffer(CIndex index, CBinary* c_binary);
 
@@ -229,7 +229,8 @@ Parser::ParseVecNode(const Json& out_body) {
     auto vec_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         auto& field_meta = schema.operator[](field_name);
         auto data_type = field_meta.get_data_type();
-        if (data_type == DataType::VECTOR_FLOAT) {
+        // half float vectors are searched with float queries
+        if (data_type == DataType::VECTOR_FLOAT || datatype_is_half_float_vector(data_type)) {
             return std::make_unique<FloatVectorANNS>();
         } else {
             return std::make_unique<BinaryVectorANNS>();
@@ -369,7 +370,12 @@ ParsePlaceholderGroup(const Plan* plan, const std::string& blob) {
         AssertInfo(element.num_of_queries_, "must have queries");
         Assert(element.num_of_queries_ > 0);
         element.line_sizeof_ = info.values().Get(0).size();
-        Assert(field_meta.get_sizeof() == element.line_sizeof_);
+        // the queries of half float vectors are float vectors
+        if (datatype_is_half_float_vector(field_meta.get_data_type())) {
+            Assert(field_meta.get_dim() * sizeof(float) == element.line_sizeof_);
+        } else {
+            Assert(field_meta.get_sizeof() == element.line_sizeof_);
+        }
         auto& target = element.blob_;
         target.reserve(element.line_sizeof_ * element.num_of_queries_);
         for (auto& line : info.values()) {
@@ -549,6 +555,11 @@ GetTopK(const Plan* plan) {
     return plan->plan_node_->search_info_.topk_;
 }
 
+void
+SetIndexName(Plan* plan, const std::string& index_name) {
+    plan->plan_node_->search_info_.index_name_ = index_name;
+}
+
 int64_t
 GetNumOfQueries(const PlaceholderGroup* group) {
     return group->at(0).num_of_queries_;
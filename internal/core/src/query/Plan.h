@@ -49,6 +49,9 @@ CreateRetrievePlanByExpr(const Schema& schema, const char* serialized_expr_plan,
 int64_t
 GetTopK(const Plan*);
 
+void
+SetIndexName(Plan* plan, const std::string& index_name);
+
 }  // namespace milvus::query
 
 #include "PlanImpl.h"
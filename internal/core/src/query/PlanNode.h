@@ -36,6 +36,8 @@ struct SearchInfo {
     FieldOffset field_offset_;
     MetricType metric_type_;
     nlohmann::json search_params_;
+    // the named index searched on sealed segments, the default index is searched if it's empty
+    std::string index_name_;
 };
 
 struct VectorPlanNode : PlanNode {
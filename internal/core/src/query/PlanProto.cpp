@@ -21,7 +21,7 @@ namespace planpb = milvus::proto::plan;
 template <typename T>
 std::unique_ptr<TermExprImpl<T>>
 ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::TermExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<TermExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -37,6 +37,9 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
         } else if constexpr (std::is_floating_point_v<T>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             result->terms_.emplace_back(static_cast<T>(value_proto.float_val()));
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            result->terms_.emplace_back(value_proto.string_val());
         } else {
             static_assert(always_false<T>);
         }
@@ -324,6 +327,9 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractTermExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractTermExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
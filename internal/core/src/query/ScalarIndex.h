@@ -11,9 +11,12 @@
 
 #pragma once
 #include "knowhere/index/structured_index_simple/StructuredIndexSort.h"
+#include "knowhere/index/structured_index_simple/StructuredIndexInverted.h"
 #include "common/Span.h"
 #include "common/FieldMeta.h"
+#include "pb/schema.pb.h"
 #include <memory>
+#include <string>
 
 namespace milvus::query {
 
@@ -43,9 +46,95 @@ generate_scalar_index(SpanBase data, DataType data_type) {
             return generate_scalar_index(Span<float>(data));
         case DataType::DOUBLE:
             return generate_scalar_index(Span<double>(data));
+        case DataType::STRING:
+            return generate_scalar_index(Span<std::string>(data));
         default:
             PanicInfo("unsupported type");
     }
 }
 
+// index types which can be built on scalar fields
+constexpr const char* SCALAR_INDEX_SORT = "SORT";
+constexpr const char* SCALAR_INDEX_INVERTED = "INVERTED";
+
+inline bool
+is_scalar_index_type(const std::string& index_type) {
+    return index_type == SCALAR_INDEX_SORT || index_type == SCALAR_INDEX_INVERTED;
+}
+
+template <typename T>
+inline std::unique_ptr<knowhere::scalar::StructuredIndex<T>>
+create_scalar_index(const std::string& index_type) {
+    if (index_type == SCALAR_INDEX_SORT) {
+        return std::make_unique<knowhere::scalar::StructuredIndexSort<T>>();
+    } else if (index_type == SCALAR_INDEX_INVERTED) {
+        return std::make_unique<knowhere::scalar::StructuredIndexInverted<T>>();
+    }
+    PanicInfo("unsupported scalar index type: " + index_type);
+}
+
+// create an empty scalar index, it is filled by Build or Load later
+inline std::unique_ptr<knowhere::Index>
+create_scalar_index(const std::string& index_type, DataType data_type) {
+    switch (data_type) {
+        case DataType::INT8:
+            return create_scalar_index<int8_t>(index_type);
+        case DataType::INT16:
+            return create_scalar_index<int16_t>(index_type);
+        case DataType::INT32:
+            return create_scalar_index<int32_t>(index_type);
+        case DataType::INT64:
+            return create_scalar_index<int64_t>(index_type);
+        case DataType::FLOAT:
+            return create_scalar_index<float>(index_type);
+        case DataType::DOUBLE:
+            return create_scalar_index<double>(index_type);
+        case DataType::STRING:
+            return create_scalar_index<std::string>(index_type);
+        default:
+            PanicInfo("unsupported data type of scalar index: " + std::to_string((int)data_type));
+    }
+}
+
+template <typename T>
+inline void
+build_scalar_index(knowhere::Index* index, const void* data, int64_t row_count) {
+    auto indexing = dynamic_cast<knowhere::scalar::StructuredIndex<T>*>(index);
+    AssertInfo(indexing != nullptr, "scalar index doesn't match the data type");
+    indexing->Build(row_count, reinterpret_cast<const T*>(data));
+}
+
+inline void
+build_scalar_index(knowhere::Index* index, DataType data_type, const void* data, int64_t row_count) {
+    switch (data_type) {
+        case DataType::INT8:
+            return build_scalar_index<int8_t>(index, data, row_count);
+        case DataType::INT16:
+            return build_scalar_index<int16_t>(index, data, row_count);
+        case DataType::INT32:
+            return build_scalar_index<int32_t>(index, data, row_count);
+        case DataType::INT64:
+            return build_scalar_index<int64_t>(index, data, row_count);
+        case DataType::FLOAT:
+            return build_scalar_index<float>(index, data, row_count);
+        case DataType::DOUBLE:
+            return build_scalar_index<double>(index, data, row_count);
+        default:
+            PanicInfo("unsupported data type of scalar index: " + std::to_string((int)data_type));
+    }
+}
+
+// strings are not fixed-size, they are passed as a serialized schema.StringArray
+inline void
+build_string_index(knowhere::Index* index, const uint8_t* data, int64_t size) {
+    auto indexing = dynamic_cast<knowhere::scalar::StructuredIndex<std::string>*>(index);
+    AssertInfo(indexing != nullptr, "scalar index isn't built on strings");
+    proto::schema::StringArray strings;
+    auto ok = strings.ParseFromArray(data, size);
+    AssertInfo(ok, "failed to parse the strings of scalar index");
+    AssertInfo(strings.data_size() > 0, "scalar index can't be built on empty strings");
+    std::vector<std::string> values(strings.data().begin(), strings.data().end());
+    indexing->Build(values.size(), values.data());
+}
+
 }  // namespace milvus::query
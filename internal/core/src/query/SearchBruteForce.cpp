@@ -17,6 +17,7 @@
 #include <string>
 #include "SubSearchResult.h"
 #include "segcore/Utils.h"
+#include "common/HalfFloat.h"
 
 #include <faiss/utils/distances.h>
 #include <faiss/utils/BinaryDistance.h>
@@ -115,6 +116,42 @@ FloatSearchBruteForce(const dataset::SearchDataset& dataset,
     }
 }
 
+SubSearchResult
+HalfFloatSearchBruteForce(const dataset::SearchDataset& dataset,
+                          const void* chunk_data_raw,
+                          int64_t size_per_chunk,
+                          const faiss::BitsetView& bitset,
+                          DataType data_type) {
+    AssertInfo(datatype_is_half_float_vector(data_type), "[HalfFloatSearchBruteForce]Data type isn't half float vector");
+    auto to_float = data_type == DataType::VECTOR_FLOAT16 ? Float16ToFloat32 : BFloat16ToFloat32;
+    auto dim = dataset.dim;
+    auto chunk_data = reinterpret_cast<const uint16_t*>(chunk_data_raw);
+
+    // the vectors are widened block by block, so only a block of them takes float memory at a time
+    constexpr int64_t block_size = 4096;
+    SubSearchResult final_qr(dataset.num_queries, dataset.topk, dataset.metric_type, dataset.round_decimal);
+    std::vector<float> block_data(std::min(block_size, size_per_chunk) * dim);
+    for (int64_t block_begin = 0; block_begin < size_per_chunk; block_begin += block_size) {
+        auto block_rows = std::min(block_size, size_per_chunk - block_begin);
+        auto src = chunk_data + block_begin * dim;
+        for (int64_t i = 0; i < block_rows * dim; ++i) {
+            block_data[i] = to_float(src[i]);
+        }
+
+        auto sub_view = BitsetSubView(bitset, block_begin, block_rows);
+        auto sub_qr = FloatSearchBruteForce(dataset, block_data.data(), block_rows, sub_view);
+
+        // convert block uid to chunk uid
+        for (auto& x : sub_qr.mutable_ids()) {
+            if (x != -1) {
+                x += block_begin;
+            }
+        }
+        final_qr.merge(sub_qr);
+    }
+    return final_qr;
+}
+
 SubSearchResult
 BinarySearchBruteForce(const dataset::SearchDataset& dataset,
                        const void* chunk_data_raw,
@@ -30,4 +30,12 @@ FloatSearchBruteForce(const dataset::SearchDataset& dataset,
                       int64_t size_per_chunk,
                       const faiss::BitsetView& bitset);
 
+// searches float16 or bfloat16 vectors with float queries
+SubSearchResult
+HalfFloatSearchBruteForce(const dataset::SearchDataset& dataset,
+                          const void* chunk_data_raw,
+                          int64_t size_per_chunk,
+                          const faiss::BitsetView& bitset,
+                          DataType data_type);
+
 }  // namespace milvus::query
@@ -172,6 +172,62 @@ BinarySearch(const segcore::SegmentGrowingImpl& segment,
     return Status::OK();
 }
 
+Status
+HalfFloatSearch(const segcore::SegmentGrowingImpl& segment,
+                const query::SearchInfo& info,
+                const float* query_data,
+                int64_t num_queries,
+                int64_t ins_barrier,
+                const faiss::BitsetView& bitset,
+                SearchResult& results) {
+    auto& schema = segment.get_schema();
+    auto& record = segment.get_insert_record();
+    auto metric_type = info.metric_type_;
+
+    // step 1: get which vector field to search
+    auto vecfield_offset = info.field_offset_;
+    auto& field = schema[vecfield_offset];
+
+    AssertInfo(datatype_is_half_float_vector(field.get_data_type()),
+               "[HalfFloatSearch]Field data type isn't half float vector");
+    auto dim = field.get_dim();
+    auto topk = info.topk_;
+    auto round_decimal = info.round_decimal_;
+    query::dataset::SearchDataset search_dataset{metric_type, num_queries, topk, round_decimal, dim, query_data};
+
+    auto vec_ptr = record.get_field_data<HalfFloatVector>(vecfield_offset);
+
+    // step 2: brute force search, half float vectors have no small indexing
+    auto vec_size_per_chunk = vec_ptr->get_size_per_chunk();
+    auto max_chunk = upper_div(ins_barrier, vec_size_per_chunk);
+    SubSearchResult final_result(num_queries, topk, metric_type, round_decimal);
+    for (int chunk_id = 0; chunk_id < max_chunk; ++chunk_id) {
+        auto& chunk = vec_ptr->get_chunk(chunk_id);
+        auto element_begin = chunk_id * vec_size_per_chunk;
+        auto element_end = std::min(ins_barrier, (chunk_id + 1) * vec_size_per_chunk);
+        auto nsize = element_end - element_begin;
+
+        auto sub_view = BitsetSubView(bitset, element_begin, nsize);
+        auto sub_result =
+            HalfFloatSearchBruteForce(search_dataset, chunk.data(), nsize, sub_view, field.get_data_type());
+
+        // convert chunk uid to segment uid
+        for (auto& x : sub_result.mutable_ids()) {
+            if (x != -1) {
+                x += chunk_id * vec_size_per_chunk;
+            }
+        }
+        final_result.merge(sub_result);
+    }
+
+    results.distances_ = std::move(final_result.mutable_distances());
+    results.ids_ = std::move(final_result.mutable_ids());
+    results.topk_ = topk;
+    results.num_queries_ = num_queries;
+
+    return Status::OK();
+}
+
 // TODO: refactor and merge this into one
 void
 SearchOnGrowing(const segcore::SegmentGrowingImpl& segment,
@@ -187,6 +243,10 @@ SearchOnGrowing(const segcore::SegmentGrowingImpl& segment,
     if (data_type == DataType::VECTOR_FLOAT) {
         auto typed_data = reinterpret_cast<const float*>(query_data);
         FloatSearch(segment, info, typed_data, num_queries, ins_barrier, bitset, results);
+    } else if (datatype_is_half_float_vector(data_type)) {
+        // the queries of half float vectors are float vectors
+        auto typed_data = reinterpret_cast<const float*>(query_data);
+        HalfFloatSearch(segment, info, typed_data, num_queries, ins_barrier, bitset, results);
     } else {
         auto typed_data = reinterpret_cast<const uint8_t*>(query_data);
         BinarySearch(segment, info, typed_data, num_queries, ins_barrier, bitset, results);
@@ -14,6 +14,7 @@
 #include <boost/variant.hpp>
 #include <utility>
 #include <deque>
+#include <type_traits>
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/ExprImpl.h"
 #include "query/generated/ExecExprVisitor.h"
@@ -469,12 +470,26 @@ ExecExprVisitor::ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType {
     auto num_chunk = upper_div(row_count_, size_per_chunk);
     std::deque<RetType> bitsets;
     std::sort(expr.terms_.begin(), expr.terms_.end());
-    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+
+    int64_t indexing_barrier = 0;
+    // std::vector<bool> has no contiguous storage to pass to the index
+    if constexpr (!std::is_same_v<T, bool>) {
+        indexing_barrier = segment_.num_chunk_index(field_offset);
+        using Index = knowhere::scalar::StructuredIndex<T>;
+        for (int64_t chunk_id = 0; chunk_id < indexing_barrier; ++chunk_id) {
+            const Index& indexing = segment_.chunk_scalar_index<T>(field_offset, chunk_id);
+            // NOTE: knowhere is not const-ready
+            auto data = const_cast<Index*>(&indexing)->In(expr.terms_.size(), expr.terms_.data());
+            AssertInfo(data->size() == size_per_chunk, "[ExecExprVisitor]Data size not equal to size_per_chunk");
+            bitsets.emplace_back(std::move(*data));
+        }
+    }
+    for (int64_t chunk_id = indexing_barrier; chunk_id < num_chunk; ++chunk_id) {
         Span<T> chunk = segment_.chunk_data<T>(field_offset, chunk_id);
         auto size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
         boost::dynamic_bitset<> bitset(size);
         for (int i = 0; i < size; ++i) {
-            auto value = chunk.data()[i];
+            const auto& value = chunk.data()[i];
             bool is_in = std::binary_search(expr.terms_.begin(), expr.terms_.end(), value);
             bitset[i] = is_in;
         }
@@ -520,6 +535,10 @@ ExecExprVisitor::visit(TermExpr& expr) {
             res = ExecTermVisitorImpl<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecTermVisitorImpl<std::string>(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
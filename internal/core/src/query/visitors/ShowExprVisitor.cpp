@@ -127,6 +127,8 @@ ShowExprVisitor::visit(TermExpr& expr) {
                 return TermExtract<double>(expr);
             case DataType::FLOAT:
                 return TermExtract<float>(expr);
+            case DataType::STRING:
+                return TermExtract<std::string>(expr);
             default:
                 PanicInfo("unsupported type");
         }
@@ -16,6 +16,7 @@
 #include <deque>
 #include <mutex>
 #include <shared_mutex>
+#include <string>
 #include <utility>
 #include <vector>
 
@@ -109,8 +110,12 @@ class ConcurrentVectorImpl : public VectorBase {
     ConcurrentVectorImpl&
     operator=(const ConcurrentVectorImpl&) = delete;
 
-    using TraitType =
-        std::conditional_t<is_scalar, Type, std::conditional_t<std::is_same_v<Type, float>, FloatVector, BinaryVector>>;
+    using TraitType = std::conditional_t<
+        is_scalar,
+        Type,
+        std::conditional_t<std::is_same_v<Type, float>,
+                           FloatVector,
+                           std::conditional_t<std::is_same_v<Type, uint16_t>, HalfFloatVector, BinaryVector>>>;
 
  public:
     explicit ConcurrentVectorImpl(ssize_t dim, int64_t size_per_chunk)
@@ -240,6 +245,20 @@ class ConcurrentVector : public ConcurrentVectorImpl<Type, true> {
     }
 };
 
+// strings take no bytes in the fixed-size rows of insert requests, the inserted rows get empty strings
+template <>
+class ConcurrentVector<std::string> : public ConcurrentVectorImpl<std::string, true> {
+ public:
+    explicit ConcurrentVector(int64_t size_per_chunk)
+        : ConcurrentVectorImpl<std::string, true>::ConcurrentVectorImpl(1, size_per_chunk) {
+    }
+
+    void
+    set_data_raw(ssize_t element_offset, const void* source, ssize_t element_count) override {
+        this->grow_to_at_least(element_offset + element_count);
+    }
+};
+
 template <>
 class ConcurrentVector<FloatVector> : public ConcurrentVectorImpl<float, false> {
  public:
@@ -248,6 +267,14 @@ class ConcurrentVector<FloatVector> : public ConcurrentVectorImpl<float, false>
     }
 };
 
+template <>
+class ConcurrentVector<HalfFloatVector> : public ConcurrentVectorImpl<uint16_t, false> {
+ public:
+    ConcurrentVector(int64_t dim, int64_t size_per_chunk)
+        : ConcurrentVectorImpl<uint16_t, false>::ConcurrentVectorImpl(dim, size_per_chunk) {
+    }
+};
+
 template <>
 class ConcurrentVector<BinaryVector> : public ConcurrentVectorImpl<uint8_t, false> {
  public:
@@ -125,11 +125,19 @@ class IndexingRecord {
                 if (field.get_data_type() == DataType::VECTOR_BINARY) {
                     continue;
                 }
+                // half float vectors of growing segments are searched by brute force
+                if (datatype_is_half_float_vector(field.get_data_type())) {
+                    continue;
+                }
                 // flat should be skipped
                 if (!field.get_metric_type().has_value()) {
                     continue;
                 }
             }
+            // strings of growing segments are filtered by scanning their raw data
+            if (field.get_data_type() == DataType::STRING) {
+                continue;
+            }
 
             field_indexings_.try_emplace(offset, CreateIndex(field, segcore_config_));
         }
@@ -20,6 +20,9 @@ InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk)
             if (field.get_data_type() == DataType::VECTOR_FLOAT) {
                 this->append_field_data<FloatVector>(field.get_dim(), size_per_chunk);
                 continue;
+            } else if (datatype_is_half_float_vector(field.get_data_type())) {
+                this->append_field_data<HalfFloatVector>(field.get_dim(), size_per_chunk);
+                continue;
             } else if (field.get_data_type() == DataType::VECTOR_BINARY) {
                 this->append_field_data<BinaryVector>(field.get_dim(), size_per_chunk);
                 continue;
@@ -56,6 +59,10 @@ InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk)
                 this->append_field_data<double>(size_per_chunk);
                 break;
             }
+            case DataType::STRING: {
+                this->append_field_data<std::string>(size_per_chunk);
+                break;
+            }
             default: {
                 PanicInfo("unsupported");
             }
@@ -12,6 +12,7 @@
 #pragma once
 
 #include <memory>
+#include <string>
 #include <vector>
 
 #include "common/Schema.h"
@@ -60,7 +61,7 @@ struct InsertRecord {
     template <typename Type>
     void
     append_field_data(int64_t size_per_chunk) {
-        static_assert(std::is_fundamental_v<Type>);
+        static_assert(std::is_fundamental_v<Type> || std::is_same_v<Type, std::string>);
         fields_data_.emplace_back(std::make_unique<ConcurrentVector<Type>>(size_per_chunk));
     }
 
@@ -14,6 +14,7 @@
 #include <map>
 #include <memory>
 #include <shared_mutex>
+#include <string>
 #include <utility>
 #include <tbb/concurrent_hash_map.h>
 
@@ -28,29 +29,55 @@ struct SealedIndexingEntry {
     knowhere::VecIndexPtr indexing_;
 };
 
-using SealedIndexingEntryPtr = std::unique_ptr<SealedIndexingEntry>;
+using SealedIndexingEntryPtr = std::shared_ptr<SealedIndexingEntry>;
 
 struct SealedIndexingRecord {
+    // the first index appended to a field is its default index,
+    // the others are only searched when a search specifies their names
     void
-    append_field_indexing(FieldOffset field_offset, MetricType metric_type, knowhere::VecIndexPtr indexing) {
-        auto ptr = std::make_unique<SealedIndexingEntry>();
+    append_field_indexing(FieldOffset field_offset,
+                          MetricType metric_type,
+                          knowhere::VecIndexPtr indexing,
+                          const std::string& index_name = "") {
+        auto ptr = std::make_shared<SealedIndexingEntry>();
         ptr->indexing_ = indexing;
         ptr->metric_type_ = metric_type;
         std::unique_lock lck(mutex_);
-        field_indexings_[field_offset] = std::move(ptr);
+        if (!field_indexings_.count(field_offset)) {
+            field_indexings_[field_offset] = ptr;
+        }
+        if (!index_name.empty()) {
+            named_field_indexings_[field_offset][index_name] = ptr;
+        }
     }
 
+    // returns the index named index_name, or the default index if index_name is empty,
+    // a named index is never substituted by another index of the field
     const SealedIndexingEntry*
-    get_field_indexing(FieldOffset field_offset) const {
+    get_field_indexing(FieldOffset field_offset, const std::string& index_name = "") const {
         std::shared_lock lck(mutex_);
         AssertInfo(field_indexings_.count(field_offset), "field_offset not found");
+        if (!index_name.empty()) {
+            auto iter = named_field_indexings_.find(field_offset);
+            AssertInfo(iter != named_field_indexings_.end() && iter->second.count(index_name),
+                       "index " + index_name + " is not loaded");
+            return iter->second.at(index_name).get();
+        }
         return field_indexings_.at(field_offset).get();
     }
 
+    bool
+    has_field_indexing(FieldOffset field_offset, const std::string& index_name) const {
+        std::shared_lock lck(mutex_);
+        auto iter = named_field_indexings_.find(field_offset);
+        return iter != named_field_indexings_.end() && iter->second.count(index_name);
+    }
+
     void
     drop_field_indexing(FieldOffset field_offset) {
         std::unique_lock lck(mutex_);
         field_indexings_.erase(field_offset);
+        named_field_indexings_.erase(field_offset);
     }
 
     bool
@@ -62,6 +89,8 @@ struct SealedIndexingRecord {
  private:
     // field_offset -> SealedIndexingEntry
     std::map<FieldOffset, SealedIndexingEntryPtr> field_indexings_;
+    // field_offset -> index name -> SealedIndexingEntry
+    std::map<FieldOffset, std::map<std::string, SealedIndexingEntryPtr>> named_field_indexings_;
     mutable std::shared_mutex mutex_;
 };
 
@@ -305,6 +305,8 @@ SegmentGrowingImpl::bulk_subscript(FieldOffset field_offset,
     if (field_meta.is_vector()) {
         if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
             bulk_subscript_impl<FloatVector>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
+        } else if (datatype_is_half_float_vector(field_meta.get_data_type())) {
+            bulk_subscript_impl<HalfFloatVector>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
         } else if (field_meta.get_data_type() == DataType::VECTOR_BINARY) {
             bulk_subscript_impl<BinaryVector>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
         } else {
@@ -343,6 +345,10 @@ SegmentGrowingImpl::bulk_subscript(FieldOffset field_offset,
             bulk_subscript_impl<double>(*vec_ptr, seg_offsets, count, -1.0, output);
             break;
         }
+        case DataType::STRING: {
+            bulk_subscript_impl<std::string>(*vec_ptr, seg_offsets, count, std::string(), output);
+            break;
+        }
         default: {
             PanicInfo("unsupported type");
         }
@@ -374,7 +380,7 @@ template <typename T>
 void
 SegmentGrowingImpl::bulk_subscript_impl(
     const VectorBase& vec_raw, const int64_t* seg_offsets, int64_t count, T default_value, void* output_raw) const {
-    static_assert(IsScalar<T>);
+    static_assert(IsScalar<T> || std::is_same_v<T, std::string>);
     auto vec_ptr = dynamic_cast<const ConcurrentVector<T>*>(&vec_raw);
     AssertInfo(vec_ptr, "Pointer of vec_raw is nullptr");
     auto& vec = *vec_ptr;
@@ -98,6 +98,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     // return count of index that has index, i.e., [0, num_chunk_index) have built index
     int64_t
     num_chunk_index(FieldOffset field_offset) const final {
+        if (!indexing_record_.is_in(field_offset)) {
+            return 0;
+        }
         return indexing_record_.get_finished_ack();
     }
 
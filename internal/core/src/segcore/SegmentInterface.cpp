@@ -69,6 +69,9 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     // fill other entries except primary key
     for (auto field_offset : plan->target_entries_) {
         auto& field_meta = get_schema()[field_offset];
+        // strings don't fit in the fixed-size rows of search results
+        AssertInfo(field_meta.get_data_type() != DataType::STRING,
+                   "string field " + field_meta.get_name().get() + " can't be output by search");
         auto element_sizeof = field_meta.get_sizeof();
         aligned_vector<char> blob(size * element_sizeof);
         bulk_subscript(field_offset, results.ids_.data(), size, blob.data());
@@ -155,6 +158,14 @@ CreateScalarArrayFrom(const void* data_raw, int64_t count, DataType data_type) {
             obj->mutable_data()->Add(data, data + count);
             break;
         }
+        case DataType::STRING: {
+            auto data = reinterpret_cast<const std::string*>(data_raw);
+            auto obj = scalar_array->mutable_string_data();
+            for (int64_t i = 0; i < count; ++i) {
+                obj->add_data(data[i]);
+            }
+            break;
+        }
         default: {
             PanicInfo("unsupported datatype");
         }
@@ -192,6 +203,20 @@ CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_
                 obj->assign(data, num_bytes);
                 break;
             }
+            case DataType::VECTOR_FLOAT16: {
+                auto num_bytes = count * dim * sizeof(uint16_t);
+                auto data = reinterpret_cast<const char*>(data_raw);
+                auto obj = vector_array->mutable_float16_vector();
+                obj->assign(data, num_bytes);
+                break;
+            }
+            case DataType::VECTOR_BFLOAT16: {
+                auto num_bytes = count * dim * sizeof(uint16_t);
+                auto data = reinterpret_cast<const char*>(data_raw);
+                auto obj = vector_array->mutable_bfloat16_vector();
+                obj->assign(data, num_bytes);
+                break;
+            }
             default: {
                 PanicInfo("unsupported datatype");
             }
@@ -204,6 +229,12 @@ std::unique_ptr<DataArray>
 SegmentInternalInterface::BulkSubScript(FieldOffset field_offset, const SegOffset* seg_offsets, int64_t count) const {
     if (field_offset.get() >= 0) {
         auto& field_meta = get_schema()[field_offset];
+        if (field_meta.get_data_type() == DataType::STRING) {
+            // strings are not fixed-size, they are copied out as objects
+            std::vector<std::string> data(count);
+            bulk_subscript(field_offset, (const int64_t*)seg_offsets, count, data.data());
+            return CreateDataArrayFrom(data.data(), count, field_meta);
+        }
         aligned_vector<char> data(field_meta.get_sizeof() * count);
         bulk_subscript(field_offset, (const int64_t*)seg_offsets, count, data.data());
         return CreateDataArrayFrom(data.data(), count, field_meta);
@@ -76,7 +76,7 @@ class SegmentInternalInterface : public SegmentInterface {
     template <typename T>
     const knowhere::scalar::StructuredIndex<T>&
     chunk_scalar_index(FieldOffset field_offset, int64_t chunk_id) const {
-        static_assert(IsScalar<T>);
+        static_assert(IsScalar<T> || std::is_same_v<T, std::string>);
         using IndexType = knowhere::scalar::StructuredIndex<T>;
         auto base_ptr = chunk_index_impl(field_offset, chunk_id);
         auto ptr = dynamic_cast<const IndexType*>(base_ptr);
@@ -38,6 +38,10 @@ SegmentSealedImpl::LoadIndex(const LoadIndexInfo& info) {
     // NOTE: lock only when data is ready to avoid starvation
     auto field_id = FieldId(info.field_id);
     auto field_offset = schema_->get_offset(field_id);
+    if (!schema_->operator[](field_offset).is_vector()) {
+        LoadScalarIndex(info);
+        return;
+    }
 
     AssertInfo(info.index_params.count("metric_type"), "Can't get metric_type in index_params");
     auto metric_type_str = info.index_params.at("metric_type");
@@ -45,20 +49,49 @@ SegmentSealedImpl::LoadIndex(const LoadIndexInfo& info) {
     AssertInfo(row_count > 0, "Index count is 0");
 
     std::unique_lock lck(mutex_);
-    AssertInfo(!get_bit(vecindex_ready_bitset_, field_offset),
-               "Can't get bitset element at " + std::to_string(field_offset.get()));
+    if (get_bit(vecindex_ready_bitset_, field_offset)) {
+        // another named index of an indexed field
+        AssertInfo(!info.index_name.empty(), "vec index of field " + std::to_string(field_id.get()) + " is loaded");
+        AssertInfo(!vecindexs_.has_field_indexing(field_offset, info.index_name),
+                   "vec index " + info.index_name + " is loaded");
+        AssertInfo(row_count_opt_.value() == row_count, "load data has different row count from other columns");
+        vecindexs_.append_field_indexing(field_offset, GetMetricType(metric_type_str), info.index, info.index_name);
+        return;
+    }
     if (row_count_opt_.has_value()) {
         AssertInfo(row_count_opt_.value() == row_count, "load data has different row count from other columns");
     } else {
         row_count_opt_ = row_count;
     }
     AssertInfo(!vecindexs_.is_ready(field_offset), "vec index is not ready");
-    vecindexs_.append_field_indexing(field_offset, GetMetricType(metric_type_str), info.index);
+    vecindexs_.append_field_indexing(field_offset, GetMetricType(metric_type_str), info.index, info.index_name);
 
     set_bit(vecindex_ready_bitset_, field_offset, true);
     lck.unlock();
 }
 
+void
+SegmentSealedImpl::LoadScalarIndex(const LoadIndexInfo& info) {
+    auto field_id = FieldId(info.field_id);
+    auto field_offset = schema_->get_offset(field_id);
+    AssertInfo(info.scalar_index, "Scalar index of field " + std::to_string(field_id.get()) + " is null");
+    auto row_count = info.scalar_index->Size();
+    AssertInfo(row_count > 0, "Index count is 0");
+
+    std::unique_lock lck(mutex_);
+    AssertInfo(!get_bit(scalar_index_ready_bitset_, field_offset),
+               "scalar index of field " + std::to_string(field_id.get()) + " already exists");
+    if (row_count_opt_.has_value()) {
+        AssertInfo(row_count_opt_.value() == row_count, "load data has different row count from other columns");
+    } else {
+        row_count_opt_ = row_count;
+    }
+    // the loaded index replaces the one generated from field data
+    scalar_indexings_[field_offset.get()] = info.scalar_index;
+    set_bit(scalar_index_ready_bitset_, field_offset, true);
+    lck.unlock();
+}
+
 void
 SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     // NOTE: lock only when data is ready to avoid starvation
@@ -126,6 +159,10 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         auto field_offset = schema_->get_offset(field_id);
         auto& field_meta = schema_->operator[](field_offset);
         // Assert(!field_meta.is_vector());
+        if (field_meta.get_data_type() == DataType::STRING) {
+            LoadStringFieldData(info);
+            return;
+        }
         auto element_sizeof = field_meta.get_sizeof();
         auto span = SpanBase(info.blob, info.row_count, element_sizeof);
         auto length_in_bytes = element_sizeof * info.row_count;
@@ -152,9 +189,12 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
             AssertInfo(!vecindexs_.is_ready(field_offset), "field data can't be loaded when indexing exists");
             fields_data_[field_offset.get()] = std::move(vec_data);
         } else {
-            AssertInfo(!scalar_indexings_[field_offset.get()], "scalar indexing not cleared");
             fields_data_[field_offset.get()] = std::move(vec_data);
-            scalar_indexings_[field_offset.get()] = std::move(index);
+            // keep the scalar index loaded before the field data
+            if (!get_bit(scalar_index_ready_bitset_, field_offset)) {
+                AssertInfo(!scalar_indexings_[field_offset.get()], "scalar indexing not cleared");
+                scalar_indexings_[field_offset.get()] = std::move(index);
+            }
         }
 
         if (schema_->get_primary_key_offset() == field_offset) {
@@ -165,6 +205,33 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     }
 }
 
+void
+SegmentSealedImpl::LoadStringFieldData(const LoadFieldDataInfo& info) {
+    auto field_offset = schema_->get_offset(FieldId(info.field_id));
+    // strings are not fixed-size, they are passed as a serialized schema.StringArray
+    proto::schema::StringArray strings;
+    auto ok = strings.ParseFromArray(info.blob, info.blob_size);
+    AssertInfo(ok, "failed to parse the strings of field " + std::to_string(info.field_id));
+    AssertInfo(strings.data_size() == info.row_count, "row count of the strings doesn't match the field data");
+    std::vector<std::string> vec_data(strings.data().begin(), strings.data().end());
+
+    // generate scalar index
+    auto span = SpanBase(vec_data.data(), info.row_count, sizeof(std::string));
+    auto index = query::generate_scalar_index(span, DataType::STRING);
+
+    // write data under lock
+    std::unique_lock lck(mutex_);
+    update_row_count(info.row_count);
+    AssertInfo(string_fields_data_[field_offset.get()].empty(), "field data already exists");
+    string_fields_data_[field_offset.get()] = std::move(vec_data);
+    // keep the scalar index loaded before the field data
+    if (!get_bit(scalar_index_ready_bitset_, field_offset)) {
+        AssertInfo(!scalar_indexings_[field_offset.get()], "scalar indexing not cleared");
+        scalar_indexings_[field_offset.get()] = std::move(index);
+    }
+    set_bit(field_data_ready_bitset_, field_offset, true);
+}
+
 void
 SegmentSealedImpl::LoadDeletedRecord(const LoadDeletedRecordInfo& info) {
     AssertInfo(info.row_count > 0, "The row count of deleted record is 0");
@@ -201,7 +268,16 @@ SegmentSealedImpl::chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) c
     std::shared_lock lck(mutex_);
     AssertInfo(get_bit(field_data_ready_bitset_, field_offset),
                "Can't get bitset element at " + std::to_string(field_offset.get()));
+    return field_data_span(field_offset);
+}
+
+SpanBase
+SegmentSealedImpl::field_data_span(FieldOffset field_offset) const {
     auto& field_meta = schema_->operator[](field_offset);
+    if (field_meta.get_data_type() == DataType::STRING) {
+        auto& strings = string_fields_data_[field_offset.get()];
+        return SpanBase(strings.data(), row_count_opt_.value(), sizeof(std::string));
+    }
     auto element_sizeof = field_meta.get_sizeof();
     SpanBase base(fields_data_[field_offset.get()].data(), row_count_opt_.value(), element_sizeof);
     return base;
@@ -210,7 +286,6 @@ SegmentSealedImpl::chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) c
 const knowhere::Index*
 SegmentSealedImpl::chunk_index_impl(FieldOffset field_offset, int64_t chunk_id) const {
     AssertInfo(chunk_id == 0, "Chunk_id is not equal to 0");
-    // TODO: support scalar index
     auto ptr = scalar_indexings_[field_offset.get()].get();
     AssertInfo(ptr, "Scalar index of " + std::to_string(field_offset.get()) + " is null");
     return ptr;
@@ -318,11 +393,18 @@ SegmentSealedImpl::vector_search(int64_t vec_count,
     auto& field_meta = schema_->operator[](field_offset);
 
     AssertInfo(field_meta.is_vector(), "The meta type of vector field is not vector type");
+    auto& index_name = search_info.index_name_;
     if (get_bit(vecindex_ready_bitset_, field_offset)) {
         AssertInfo(vecindexs_.is_ready(field_offset),
                    "vector indexes isn't ready for field " + std::to_string(field_offset.get()));
-        query::SearchOnSealed(*schema_, vecindexs_, search_info, query_data, query_count, bitset, output);
-        return;
+        if (index_name.empty() || vecindexs_.has_field_indexing(field_offset, index_name)) {
+            query::SearchOnSealed(*schema_, vecindexs_, search_info, query_data, query_count, bitset, output);
+            return;
+        }
+        // the query node loads a named index before searching it, the raw data is searched by brute force
+        // instead of another index of the field only if it has been loaded
+        AssertInfo(get_bit(field_data_ready_bitset_, field_offset),
+                   "index " + index_name + " is not loaded on the segment");
     } else if (!get_bit(field_data_ready_bitset_, field_offset)) {
         PanicInfo("Field Data is not loaded");
     }
@@ -345,6 +427,8 @@ SegmentSealedImpl::vector_search(int64_t vec_count,
     auto sub_qr = [&] {
         if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
             return query::FloatSearchBruteForce(dataset, chunk_data, row_count, bitset);
+        } else if (datatype_is_half_float_vector(field_meta.get_data_type())) {
+            return query::HalfFloatSearchBruteForce(dataset, chunk_data, row_count, bitset, field_meta.get_data_type());
         } else {
             return query::BinarySearchBruteForce(dataset, chunk_data, row_count, bitset);
         }
@@ -379,6 +463,11 @@ SegmentSealedImpl::DropFieldData(const FieldId field_id) {
         std::unique_lock lck(mutex_);
         set_bit(field_data_ready_bitset_, field_offset, false);
         auto vec = std::move(fields_data_[field_offset.get()]);
+        auto strings = std::move(string_fields_data_[field_offset.get()]);
+        if (!field_meta.is_vector() && !get_bit(scalar_index_ready_bitset_, field_offset)) {
+            // the generated index goes with the field data
+            scalar_indexings_[field_offset.get()] = nullptr;
+        }
         lck.unlock();
 
         vec.clear();
@@ -391,8 +480,19 @@ SegmentSealedImpl::DropIndex(const FieldId field_id) {
                "Field id:" + std::to_string(field_id.get()) + " isn't one of system type when drop index");
     auto field_offset = schema_->get_offset(field_id);
     auto& field_meta = schema_->operator[](field_offset);
-    AssertInfo(field_meta.is_vector(),
-               "Field meta of offset:" + std::to_string(field_offset.get()) + " is not vector type");
+    if (!field_meta.is_vector()) {
+        // fall back to the index generated from field data
+        std::unique_lock lck(mutex_);
+        AssertInfo(get_bit(scalar_index_ready_bitset_, field_offset),
+                   "scalar index of field " + std::to_string(field_id.get()) + " is not loaded");
+        scalar_indexings_[field_offset.get()] = nullptr;
+        if (get_bit(field_data_ready_bitset_, field_offset)) {
+            auto span = field_data_span(field_offset);
+            scalar_indexings_[field_offset.get()] = query::generate_scalar_index(span, field_meta.get_data_type());
+        }
+        set_bit(scalar_index_ready_bitset_, field_offset, false);
+        return;
+    }
 
     std::unique_lock lck(mutex_);
     vecindexs_.drop_field_indexing(field_offset);
@@ -409,7 +509,7 @@ SegmentSealedImpl::check_search(const query::Plan* plan) const {
     }
 
     auto& request_fields = plan->extra_info_opt_.value().involved_fields_;
-    auto field_ready_bitset = field_data_ready_bitset_ | vecindex_ready_bitset_;
+    auto field_ready_bitset = field_data_ready_bitset_ | vecindex_ready_bitset_ | scalar_index_ready_bitset_;
     AssertInfo(request_fields.size() == field_ready_bitset.size(),
                "Request fields size not equal to field ready bitset size when check search");
     auto absent_fields = request_fields - field_ready_bitset;
@@ -424,8 +524,10 @@ SegmentSealedImpl::check_search(const query::Plan* plan) const {
 SegmentSealedImpl::SegmentSealedImpl(SchemaPtr schema)
     : schema_(schema),
       fields_data_(schema->size()),
+      string_fields_data_(schema->size()),
       field_data_ready_bitset_(schema->size()),
       vecindex_ready_bitset_(schema->size()),
+      scalar_index_ready_bitset_(schema->size()),
       scalar_indexings_(schema->size()) {
 }
 void
@@ -504,8 +606,19 @@ SegmentSealedImpl::bulk_subscript(FieldOffset field_offset,
             bulk_subscript_impl<double>(src_vec, seg_offsets, count, output);
             break;
         }
+        case DataType::STRING: {
+            auto& src = string_fields_data_[field_offset.get()];
+            auto dst = reinterpret_cast<std::string*>(output);
+            for (int64_t i = 0; i < count; ++i) {
+                auto offset = seg_offsets[i];
+                dst[i] = (offset == INVALID_SEG_OFFSET ? std::string() : src[offset]);
+            }
+            break;
+        }
 
         case DataType::VECTOR_FLOAT:
+        case DataType::VECTOR_FLOAT16:
+        case DataType::VECTOR_BFLOAT16:
         case DataType::VECTOR_BINARY: {
             bulk_subscript_impl(field_meta.get_sizeof(), src_vec, seg_offsets, count, output);
             break;
@@ -523,7 +636,7 @@ SegmentSealedImpl::HasIndex(FieldId field_id) const {
     AssertInfo(!SystemProperty::Instance().IsSystem(field_id),
                "Field id:" + std::to_string(field_id.get()) + " isn't one of system type when drop index");
     auto field_offset = schema_->get_offset(field_id);
-    return get_bit(vecindex_ready_bitset_, field_offset);
+    return get_bit(vecindex_ready_bitset_, field_offset) || get_bit(scalar_index_ready_bitset_, field_offset);
 }
 
 bool
@@ -111,6 +111,15 @@ class SegmentSealedImpl : public SegmentSealed {
     get_active_count(Timestamp ts) const override;
 
  private:
+    void
+    LoadScalarIndex(const LoadIndexInfo& info);
+
+    void
+    LoadStringFieldData(const LoadFieldDataInfo& info);
+
+    SpanBase
+    field_data_span(FieldOffset field_offset) const;
+
     template <typename T>
     static void
     bulk_subscript_impl(const void* src_raw, const int64_t* seg_offsets, int64_t count, void* dst_raw);
@@ -169,6 +178,7 @@ class SegmentSealedImpl : public SegmentSealed {
     // segment loading state
     boost::dynamic_bitset<> field_data_ready_bitset_;
     boost::dynamic_bitset<> vecindex_ready_bitset_;
+    boost::dynamic_bitset<> scalar_index_ready_bitset_;
     std::atomic<int> system_ready_count_ = 0;
     // segment datas
 
@@ -177,10 +187,12 @@ class SegmentSealedImpl : public SegmentSealed {
 
     // TODO: use protobuf format
     // TODO: remove duplicated indexing
-    std::vector<std::unique_ptr<knowhere::Index>> scalar_indexings_;
+    std::vector<knowhere::IndexPtr> scalar_indexings_;
     std::unique_ptr<ScalarIndexBase> primary_key_index_;
 
     std::vector<aligned_vector<char>> fields_data_;
+    // strings are not fixed-size, they are kept apart from the other fields
+    std::vector<std::vector<std::string>> string_fields_data_;
     mutable DeletedRecord deleted_record_;
 
     SealedIndexingRecord vecindexs_;
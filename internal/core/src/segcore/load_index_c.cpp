@@ -13,6 +13,7 @@
 #include "exceptions/EasyAssert.h"
 #include "index/knowhere/knowhere/common/BinarySet.h"
 #include "index/knowhere/knowhere/index/vector_index/VecIndexFactory.h"
+#include "query/ScalarIndex.h"
 #include "segcore/load_index_c.h"
 
 CStatus
@@ -76,6 +77,24 @@ AppendFieldInfo(CLoadIndexInfo c_load_index_info, int64_t field_id) {
     }
 }
 
+CStatus
+AppendIndexName(CLoadIndexInfo c_load_index_info, const char* index_name) {
+    try {
+        auto load_index_info = (LoadIndexInfo*)c_load_index_info;
+        load_index_info->index_name = std::string(index_name);
+
+        auto status = CStatus();
+        status.error_code = Success;
+        status.error_msg = "";
+        return status;
+    } catch (std::exception& e) {
+        auto status = CStatus();
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+        return status;
+    }
+}
+
 CStatus
 AppendIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set) {
     try {
@@ -85,6 +104,16 @@ AppendIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set) {
         bool find_index_type = index_params.count("index_type") > 0 ? true : false;
         bool find_index_mode = index_params.count("index_mode") > 0 ? true : false;
         AssertInfo(find_index_type == true, "Can't find index type in index_params");
+        if (milvus::query::is_scalar_index_type(index_params["index_type"])) {
+            AssertInfo(index_params.count("data_type") > 0, "Can't find data type of scalar index in index_params");
+            auto data_type = milvus::DataType(std::stoi(index_params["data_type"]));
+            load_index_info->scalar_index = milvus::query::create_scalar_index(index_params["index_type"], data_type);
+            load_index_info->scalar_index->Load(*binary_set);
+            auto status = CStatus();
+            status.error_code = Success;
+            status.error_msg = "";
+            return status;
+        }
         milvus::knowhere::IndexMode mode;
         if (find_index_mode) {
             mode = index_params["index_mode"] == "CPU" ? milvus::knowhere::IndexMode::MODE_CPU
@@ -35,6 +35,9 @@ AppendIndexParam(CLoadIndexInfo c_load_index_info, const char* index_key, const
 CStatus
 AppendFieldInfo(CLoadIndexInfo c_load_index_info, int64_t field_id);
 
+CStatus
+AppendIndexName(CLoadIndexInfo c_load_index_info, const char* index_name);
+
 CStatus
 AppendIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set);
 
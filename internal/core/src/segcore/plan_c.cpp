@@ -116,6 +116,11 @@ GetMetricType(CSearchPlan plan) {
     return strdup(metric_str.c_str());
 }
 
+void
+SetIndexName(CSearchPlan plan, const char* index_name) {
+    milvus::query::SetIndexName((milvus::query::Plan*)plan, std::string(index_name));
+}
+
 void
 DeleteSearchPlan(CSearchPlan cPlan) {
     auto plan = (milvus::query::Plan*)cPlan;
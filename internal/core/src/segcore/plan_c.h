@@ -45,6 +45,9 @@ GetTopK(CSearchPlan plan);
 const char*
 GetMetricType(CSearchPlan plan);
 
+void
+SetIndexName(CSearchPlan plan, const char* index_name);
+
 void
 DeleteSearchPlan(CSearchPlan plan);
 
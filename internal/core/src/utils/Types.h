@@ -46,6 +46,8 @@ enum class DataType {
 
     VECTOR_BINARY = 100,
     VECTOR_FLOAT = 101,
+    VECTOR_FLOAT16 = 102,
+    VECTOR_BFLOAT16 = 103,
 };
 
 }  // namespace milvus::engine
@@ -14,6 +14,7 @@
 #include <regex>
 
 #include "query/Expr.h"
+#include "query/ExprImpl.h"
 #include "query/Plan.h"
 #include "query/PlanNode.h"
 #include "query/generated/ExprVisitor.h"
@@ -408,6 +409,40 @@ TEST(Expr, TestTerm) {
     }
 }
 
+TEST(Expr, TestStringTerm) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->AddDebugField("age", DataType::INT32);
+    schema->AddDebugField("brand", DataType::STRING);
+    auto brand_offset = FieldOffset(2);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    auto raw_data = DataGen(schema, N);
+    seg->PreInsert(N);
+    seg->Insert(0, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+
+    // strings of growing segments are not indexed, their raw data is scanned,
+    // the rows of insert requests carry no strings and get empty ones
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ASSERT_EQ(seg_promote->num_chunk_index(brand_offset), 0);
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    TermExprImpl<std::string> expr;
+    expr.field_offset_ = brand_offset;
+    expr.data_type_ = DataType::STRING;
+    expr.terms_ = {"nike", "puma"};
+    auto final = visitor.call_child(expr);
+    ASSERT_EQ(final.size(), N);
+    ASSERT_TRUE(final.none());
+
+    expr.terms_ = {"", "nike"};
+    final = visitor.call_child(expr);
+    ASSERT_EQ(final.size(), N);
+    ASSERT_TRUE(final.all());
+}
+
 TEST(Expr, TestSimpleDsl) {
     using namespace milvus::query;
     using namespace milvus::segcore;
@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <gtest/gtest.h>
+#include <algorithm>
+#include <random>
+#include <string>
+#include <vector>
+
+#include "query/ScalarIndex.h"
+
+using namespace milvus;
+using namespace milvus::knowhere::scalar;
+
+namespace {
+std::vector<int64_t>
+GenValues(int64_t n, int64_t range) {
+    std::default_random_engine e(42);
+    std::uniform_int_distribution<int64_t> dist(0, range - 1);
+    std::vector<int64_t> values(n);
+    for (auto& v : values) {
+        v = dist(e);
+    }
+    return values;
+}
+}  // namespace
+
+TEST(ScalarIndex, Inverted) {
+    int64_t n = 1000;
+    auto values = GenValues(n, 100);
+    StructuredIndexInverted<int64_t> index(n, values.data());
+    ASSERT_TRUE(index.IsBuilt());
+    ASSERT_EQ(index.Size(), n);
+    ASSERT_LE(index.Cardinality(), 100);
+
+    std::vector<int64_t> terms{3, 17, 42, 1000};
+    auto in = index.In(terms.size(), terms.data());
+    auto not_in = index.NotIn(terms.size(), terms.data());
+    for (int64_t i = 0; i < n; ++i) {
+        auto expected = std::find(terms.begin(), terms.end(), values[i]) != terms.end();
+        ASSERT_EQ(in->test(i), expected);
+        ASSERT_EQ(not_in->test(i), !expected);
+    }
+
+    auto lt = index.Range(50, OperatorType::LT);
+    auto le = index.Range(50, OperatorType::LE);
+    auto gt = index.Range(50, OperatorType::GT);
+    auto ge = index.Range(50, OperatorType::GE);
+    auto between = index.Range(80, false, 20, true);
+    for (int64_t i = 0; i < n; ++i) {
+        ASSERT_EQ(lt->test(i), values[i] < 50);
+        ASSERT_EQ(le->test(i), values[i] <= 50);
+        ASSERT_EQ(gt->test(i), values[i] > 50);
+        ASSERT_EQ(ge->test(i), values[i] >= 50);
+        ASSERT_EQ(between->test(i), values[i] >= 20 && values[i] < 80);
+    }
+}
+
+TEST(ScalarIndex, SerializeAndLoad) {
+    int64_t n = 1000;
+    auto values = GenValues(n, 100);
+    for (auto index_type : {query::SCALAR_INDEX_SORT, query::SCALAR_INDEX_INVERTED}) {
+        ASSERT_TRUE(query::is_scalar_index_type(index_type));
+        auto index = query::create_scalar_index(index_type, DataType::INT64);
+        query::build_scalar_index(index.get(), DataType::INT64, values.data(), n);
+        auto binary_set = index->Serialize();
+
+        auto loaded = query::create_scalar_index(index_type, DataType::INT64);
+        loaded->Load(binary_set);
+        ASSERT_EQ(loaded->Size(), n);
+
+        auto ptr = dynamic_cast<StructuredIndex<int64_t>*>(loaded.get());
+        ASSERT_NE(ptr, nullptr);
+        std::vector<int64_t> terms{values[0], values[n - 1]};
+        auto in = ptr->In(terms.size(), terms.data());
+        auto ge = ptr->Range(30, OperatorType::GE);
+        for (int64_t i = 0; i < n; ++i) {
+            ASSERT_EQ(in->test(i), values[i] == terms[0] || values[i] == terms[1]);
+            ASSERT_EQ(ge->test(i), values[i] >= 30);
+        }
+    }
+    ASSERT_FALSE(query::is_scalar_index_type("IVF_FLAT"));
+    ASSERT_ANY_THROW(query::create_scalar_index("IVF_FLAT", DataType::INT64));
+    ASSERT_ANY_THROW(query::create_scalar_index(query::SCALAR_INDEX_SORT, DataType::VECTOR_FLOAT));
+}
+
+TEST(ScalarIndex, String) {
+    std::vector<std::string> brands{"nike", "adidas", "puma", "", "nike", "asics", "adidas", "nike"};
+    int64_t n = brands.size();
+    proto::schema::StringArray strings;
+    for (auto& brand : brands) {
+        strings.add_data(brand);
+    }
+    auto serialized = strings.SerializeAsString();
+
+    for (auto index_type : {query::SCALAR_INDEX_SORT, query::SCALAR_INDEX_INVERTED}) {
+        auto index = query::create_scalar_index(index_type, DataType::STRING);
+        query::build_string_index(index.get(), reinterpret_cast<const uint8_t*>(serialized.data()),
+                                  serialized.size());
+        auto binary_set = index->Serialize();
+
+        auto loaded = query::create_scalar_index(index_type, DataType::STRING);
+        loaded->Load(binary_set);
+        ASSERT_EQ(loaded->Size(), n);
+
+        auto ptr = dynamic_cast<StructuredIndex<std::string>*>(loaded.get());
+        ASSERT_NE(ptr, nullptr);
+        std::vector<std::string> terms{"adidas", "nike", "reebok"};
+        auto in = ptr->In(terms.size(), terms.data());
+        auto not_in = ptr->NotIn(terms.size(), terms.data());
+        auto lt = ptr->Range(std::string("b"), OperatorType::LT);
+        for (int64_t i = 0; i < n; ++i) {
+            auto expected = std::find(terms.begin(), terms.end(), brands[i]) != terms.end();
+            ASSERT_EQ(in->test(i), expected);
+            ASSERT_EQ(not_in->test(i), !expected);
+            ASSERT_EQ(lt->test(i), brands[i] < "b");
+        }
+    }
+
+    auto numeric = query::create_scalar_index(query::SCALAR_INDEX_SORT, DataType::INT64);
+    ASSERT_ANY_THROW(query::build_string_index(numeric.get(), reinterpret_cast<const uint8_t*>(serialized.data()),
+                                               serialized.size()));
+}
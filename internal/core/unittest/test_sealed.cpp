@@ -14,6 +14,11 @@
 #include "knowhere/index/vector_index/IndexIVF.h"
 #include "knowhere/index/vector_index/VecIndex.h"
 #include "knowhere/index/vector_index/adapter/VectorAdapter.h"
+#include "common/HalfFloat.h"
+#include "pb/plan.pb.h"
+#include "query/ExprImpl.h"
+#include "query/PlanImpl.h"
+#include "query/ScalarIndex.h"
 #include "segcore/SegmentSealedImpl.h"
 #include "test_utils/DataGen.h"
 
@@ -385,3 +390,259 @@ TEST(Sealed, Delete) {
     segment->Delete(reserved_offset, new_count, reinterpret_cast<const int64_t*>(new_pks.data()),
                     reinterpret_cast<const Timestamp*>(new_timestamps.data()));
 }
+
+TEST(Sealed, NamedIndex) {
+    auto dim = 16;
+    auto N = ROW_COUNT;
+    auto metric_type = MetricType::METRIC_L2;
+    auto schema = std::make_shared<Schema>();
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    schema->AddDebugField("counter", DataType::INT64);
+
+    auto dataset = DataGen(schema, N);
+    auto fakevec = dataset.get_col<float>(0);
+    auto default_indexing = GenIndexing(N, dim, fakevec.data());
+    auto named_indexing = GenIndexing(N, dim, fakevec.data());
+
+    SealedIndexingRecord record;
+    record.append_field_indexing(FieldOffset(0), metric_type, default_indexing, "ivf");
+    record.append_field_indexing(FieldOffset(0), metric_type, named_indexing, "ivf2");
+    ASSERT_EQ(record.get_field_indexing(FieldOffset(0))->indexing_, default_indexing);
+    ASSERT_EQ(record.get_field_indexing(FieldOffset(0), "ivf")->indexing_, default_indexing);
+    ASSERT_EQ(record.get_field_indexing(FieldOffset(0), "ivf2")->indexing_, named_indexing);
+    // unknown names never fall back to the default index
+    ASSERT_ANY_THROW(record.get_field_indexing(FieldOffset(0), "hnsw"));
+    record.drop_field_indexing(FieldOffset(0));
+    ASSERT_FALSE(record.is_ready(FieldOffset(0)));
+    ASSERT_FALSE(record.has_field_indexing(FieldOffset(0), "ivf2"));
+
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+    segment->DropFieldData(fakevec_id);
+
+    LoadIndexInfo vec_info;
+    vec_info.field_id = fakevec_id.get();
+    vec_info.index_name = "ivf";
+    vec_info.index = default_indexing;
+    vec_info.index_params["metric_type"] = milvus::knowhere::Metric::L2;
+    segment->LoadIndex(vec_info);
+    // the same index can't be loaded twice
+    ASSERT_ANY_THROW(segment->LoadIndex(vec_info));
+
+    vec_info.index_name = "ivf2";
+    vec_info.index = named_indexing;
+    segment->LoadIndex(vec_info);
+
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 5,
+                        "round_decimal": 3
+                    }
+                }
+            }
+            ]
+        }
+    })";
+    Timestamp time = 1000000;
+    auto plan = CreatePlan(*schema, dsl);
+    auto num_queries = 5;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+
+    auto sr = segment->Search(plan.get(), *ph_group, time);
+    SetIndexName(plan.get(), "ivf2");
+    auto sr2 = segment->Search(plan.get(), *ph_group, time);
+    ASSERT_EQ(SearchResultToJson(*sr).dump(-2), SearchResultToJson(*sr2).dump(-2));
+
+    // the index is not loaded and the raw data is dropped, so the search fails
+    SetIndexName(plan.get(), "hnsw");
+    ASSERT_ANY_THROW(segment->Search(plan.get(), *ph_group, time));
+}
+
+TEST(Sealed, StringIndex) {
+    auto dim = 16;
+    auto N = ROW_COUNT;
+    auto metric_type = MetricType::METRIC_L2;
+    auto schema = std::make_shared<Schema>();
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    schema->AddDebugField("counter", DataType::INT64);
+    auto brand_id = schema->AddDebugField("brand", DataType::STRING);
+
+    auto dataset = DataGen(schema, N);
+    std::vector<std::string> candidates{"nike", "adidas", "puma", "asics"};
+    proto::schema::StringArray brands;
+    for (int64_t i = 0; i < N; ++i) {
+        brands.add_data(candidates[i % candidates.size()]);
+    }
+
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+
+    proto::plan::PlanNode plan_node;
+    auto anns = plan_node.mutable_vector_anns();
+    anns->set_field_id(fakevec_id.get());
+    anns->set_placeholder_tag("$0");
+    auto query_info = anns->mutable_query_info();
+    query_info->set_topk(5);
+    query_info->set_metric_type("L2");
+    query_info->set_search_params(R"({"nprobe": 10})");
+    query_info->set_round_decimal(3);
+    auto term = anns->mutable_predicates()->mutable_term_expr();
+    term->mutable_column_info()->set_field_id(brand_id.get());
+    term->mutable_column_info()->set_data_type(proto::schema::DataType::String);
+    term->add_values()->set_string_val("nike");
+    term->add_values()->set_string_val("puma");
+    auto serialized_plan = plan_node.SerializeAsString();
+    auto plan = CreatePlanByExpr(*schema, serialized_plan.data(), serialized_plan.size());
+
+    Timestamp time = 1000000;
+    auto num_queries = 5;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+    // the string field isn't loaded yet
+    ASSERT_ANY_THROW(segment->Search(plan.get(), *ph_group, time));
+
+    auto check_hits = [&](const SearchResult& sr) {
+        int64_t hits = 0;
+        for (auto offset : sr.ids_) {
+            if (offset < 0) {
+                continue;
+            }
+            auto& brand = brands.data(offset);
+            ASSERT_TRUE(brand == "nike" || brand == "puma");
+            ++hits;
+        }
+        ASSERT_GT(hits, 0);
+    };
+
+    // the raw strings are filtered through the index generated from them
+    auto serialized = brands.SerializeAsString();
+    LoadFieldDataInfo brand_data;
+    brand_data.field_id = brand_id.get();
+    brand_data.row_count = N;
+    brand_data.blob = serialized.data();
+    brand_data.blob_size = serialized.size();
+    segment->LoadFieldData(brand_data);
+    auto sr = segment->Search(plan.get(), *ph_group, time);
+    check_hits(*sr);
+
+    // the raw strings are output by retrieve
+    auto retrieve_plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<std::string>>();
+    term_expr->field_offset_ = schema->get_offset(brand_id);
+    term_expr->data_type_ = DataType::STRING;
+    term_expr->terms_.emplace_back("asics");
+    retrieve_plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    retrieve_plan->plan_node_->predicate_ = std::move(term_expr);
+    retrieve_plan->field_offsets_ = {schema->get_offset(brand_id)};
+    auto retrieve_results = segment->Retrieve(retrieve_plan.get(), time);
+    ASSERT_EQ(retrieve_results->fields_data_size(), 1);
+    auto& brand_column = retrieve_results->fields_data(0).scalars().string_data();
+    ASSERT_EQ(brand_column.data_size(), std::count(brands.data().begin(), brands.data().end(), "asics"));
+    for (auto& brand : brand_column.data()) {
+        ASSERT_EQ(brand, "asics");
+    }
+
+    auto index = query::create_scalar_index(query::SCALAR_INDEX_INVERTED, DataType::STRING);
+    query::build_string_index(index.get(), reinterpret_cast<const uint8_t*>(serialized.data()), serialized.size());
+    LoadIndexInfo brand_info;
+    brand_info.field_id = brand_id.get();
+    brand_info.scalar_index = std::move(index);
+    segment->LoadIndex(brand_info);
+    auto sr2 = segment->Search(plan.get(), *ph_group, time);
+    ASSERT_EQ(SearchResultToJson(*sr).dump(-2), SearchResultToJson(*sr2).dump(-2));
+
+    // dropping the loaded index falls back to the generated one
+    segment->DropIndex(brand_id);
+    auto sr3 = segment->Search(plan.get(), *ph_group, time);
+    ASSERT_EQ(SearchResultToJson(*sr).dump(-2), SearchResultToJson(*sr3).dump(-2));
+}
+
+TEST(Sealed, HalfFloatVector) {
+    auto dim = 16;
+    auto N = 1000;
+    auto num_queries = 5;
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 1,
+                        "round_decimal": 3
+                    }
+                }
+            }
+            ]
+        }
+    })";
+
+    for (auto data_type : {DataType::VECTOR_FLOAT16, DataType::VECTOR_BFLOAT16}) {
+        auto schema = std::make_shared<Schema>();
+        schema->AddDebugField("fakevec", data_type, dim, MetricType::METRIC_L2);
+        schema->AddDebugField("counter", DataType::INT64);
+        ASSERT_EQ(schema->operator[](FieldOffset(0)).get_sizeof(), dim * 2);
+
+        auto dataset = DataGen(schema, N);
+        auto fakevec = dataset.get_col<uint16_t>(0);
+        auto to_float = data_type == DataType::VECTOR_FLOAT16 ? Float16ToFloat32 : BFloat16ToFloat32;
+        std::vector<float> queries(num_queries * dim);
+        for (int64_t i = 0; i < num_queries * dim; ++i) {
+            queries[i] = to_float(fakevec[i]);
+        }
+
+        auto plan = CreatePlan(*schema, dsl);
+        auto ph_group_raw = CreatePlaceholderGroupFromBlob(num_queries, dim, queries.data());
+        auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+        Timestamp time = 1000000;
+
+        // the half float vectors are searched with the float queries widened from them
+        auto check_hits = [&](const SearchResult& sr) {
+            ASSERT_EQ(sr.ids_.size(), static_cast<size_t>(num_queries));
+            for (int64_t i = 0; i < num_queries; ++i) {
+                ASSERT_EQ(sr.ids_[i], i);
+                ASSERT_EQ(sr.distances_[i], 0);
+            }
+        };
+
+        auto growing = CreateGrowingSegment(schema);
+        growing->PreInsert(N);
+        growing->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+        check_hits(*growing->Search(plan.get(), *ph_group, time));
+
+        auto sealed = CreateSealedSegment(schema);
+        SealedLoader(dataset, *sealed);
+        check_hits(*sealed->Search(plan.get(), *ph_group, time));
+
+        // the raw half float vectors are output by retrieve
+        auto retrieve_plan = std::make_unique<query::RetrievePlan>(*schema);
+        auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>();
+        term_expr->field_offset_ = FieldOffset(1);
+        term_expr->data_type_ = DataType::INT64;
+        term_expr->terms_ = {1, 3};
+        retrieve_plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+        retrieve_plan->plan_node_->predicate_ = std::move(term_expr);
+        retrieve_plan->field_offsets_ = {FieldOffset(0)};
+        auto retrieve_results = sealed->Retrieve(retrieve_plan.get(), time);
+        ASSERT_EQ(retrieve_results->fields_data_size(), 1);
+        auto& vectors = retrieve_results->fields_data(0).vectors();
+        auto& raw = data_type == DataType::VECTOR_FLOAT16 ? vectors.float16_vector() : vectors.bfloat16_vector();
+        ASSERT_EQ(raw.size(), 2 * dim * sizeof(uint16_t));
+        ASSERT_EQ(memcmp(raw.data(), fakevec.data() + dim, dim * sizeof(uint16_t)), 0);
+        ASSERT_EQ(memcmp(raw.data() + dim * sizeof(uint16_t), fakevec.data() + 3 * dim, dim * sizeof(uint16_t)), 0);
+    }
+}
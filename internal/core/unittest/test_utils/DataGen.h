@@ -86,6 +86,25 @@ GeneratedData::generate_rows(int64_t N, SchemaPtr schema) {
     raw_.count = N;
 }
 
+// truncates a float to float16 or bfloat16, values too small for float16 are flushed to zero
+inline uint16_t
+FloatToHalfFloat(float f, engine::DataType data_type) {
+    uint32_t bits;
+    memcpy(&bits, &f, sizeof(bits));
+    if (data_type == engine::DataType::VECTOR_BFLOAT16) {
+        return static_cast<uint16_t>(bits >> 16);
+    }
+    auto sign = static_cast<uint16_t>((bits >> 16) & 0x8000);
+    int32_t exp = static_cast<int32_t>((bits >> 23) & 0xff) - 127 + 15;
+    if (exp <= 0) {
+        return sign;
+    }
+    if (exp >= 0x1f) {
+        return sign | 0x7c00;
+    }
+    return sign | static_cast<uint16_t>(exp << 10) | static_cast<uint16_t>((bits & 0x7fffff) >> 13);
+}
+
 inline GeneratedData
 DataGen(SchemaPtr schema, int64_t N, uint64_t seed = 42, uint64_t ts_offset = 0) {
     using std::vector;
@@ -131,6 +150,16 @@ DataGen(SchemaPtr schema, int64_t N, uint64_t seed = 42, uint64_t ts_offset = 0)
                 insert_cols(final);
                 break;
             }
+            case engine::DataType::VECTOR_FLOAT16:
+            case engine::DataType::VECTOR_BFLOAT16: {
+                auto dim = field.get_dim();
+                vector<uint16_t> data(dim * N);
+                for (auto& x : data) {
+                    x = FloatToHalfFloat(distr(er) + offset, field.get_data_type());
+                }
+                insert_cols(data);
+                break;
+            }
             case engine::DataType::VECTOR_BINARY: {
                 auto dim = field.get_dim();
                 Assert(dim % 8 == 0);
@@ -184,6 +213,11 @@ DataGen(SchemaPtr schema, int64_t N, uint64_t seed = 42, uint64_t ts_offset = 0)
                 insert_cols(data);
                 break;
             }
+            case engine::DataType::STRING: {
+                // strings take no bytes in the fixed-size rows
+                cols.emplace_back();
+                break;
+            }
             default: {
                 throw std::runtime_error("unimplemented");
             }
@@ -317,6 +351,11 @@ SealedLoader(const GeneratedData& dataset, SegmentSealed& seg) {
     }
     int field_offset = 0;
     for (auto& meta : seg.get_schema().get_fields()) {
+        // the generated rows carry no strings
+        if (meta.get_data_type() == engine::DataType::STRING) {
+            ++field_offset;
+            continue;
+        }
         LoadFieldDataInfo info;
         info.field_id = meta.get_id().get();
         info.row_count = row_count;
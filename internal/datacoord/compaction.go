@@ -24,7 +24,9 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"go.uber.org/zap"
 )
@@ -194,6 +196,8 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	}
 
 	plan := c.plans[planID].plan
+	// the history is built before the result is applied, which changes the source segments
+	history := c.newCompactionHistory(c.plans[planID], result)
 	switch plan.GetType() {
 	case datapb.CompactionType_InnerCompaction:
 		if err := c.handleInnerCompactionResult(plan, result); err != nil {
@@ -203,19 +207,80 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		if err := c.handleMergeCompactionResult(plan, result); err != nil {
 			return err
 		}
+	case datapb.CompactionType_ClusteringCompaction:
+		if err := c.handleClusteringCompactionResult(plan, result); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
 	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result))
 	c.executingTaskNum--
-	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction {
+	switch c.plans[planID].plan.GetType() {
+	case datapb.CompactionType_MergeCompaction:
 		c.flushCh <- result.GetSegmentID()
+	case datapb.CompactionType_ClusteringCompaction:
+		for _, s := range result.GetSegments() {
+			c.flushCh <- s.GetSegmentID()
+		}
 	}
 	// TODO: when to clean task list
 
+	if err := c.meta.AddCompactionHistory(history); err != nil {
+		log.Warn("failed to save compaction history", zap.Int64("planID", planID), zap.Error(err))
+	}
+	observeCompactionHistory(history)
 	return nil
 }
 
+// newCompactionHistory builds the history of a compaction task with its result
+func (c *compactionPlanHandler) newCompactionHistory(task *compactionTask, result *datapb.CompactionResult) *milvuspb.CompactionHistory {
+	plan := task.plan
+	history := &milvuspb.CompactionHistory{
+		PlanID:       plan.GetPlanID(),
+		Type:         plan.GetType().String(),
+		Channel:      plan.GetChannel(),
+		BytesRead:    result.GetBytesRead(),
+		BytesWritten: result.GetBytesWritten(),
+		TimeCostMs:   result.GetTimeCostMs(),
+		NodeID:       task.dataNodeID,
+		CompleteTime: uint64(time.Now().UnixNano() / int64(time.Millisecond)),
+	}
+	for _, binlogs := range plan.GetSegmentBinlogs() {
+		history.Sources = append(history.Sources, binlogs.GetSegmentID())
+		if segment := c.meta.GetSegment(binlogs.GetSegmentID()); segment != nil {
+			history.CollectionID = segment.GetCollectionID()
+			history.RowsBefore += segment.GetNumOfRows()
+		}
+	}
+
+	switch plan.GetType() {
+	case datapb.CompactionType_ClusteringCompaction:
+		for _, s := range result.GetSegments() {
+			history.Results = append(history.Results, s.GetSegmentID())
+			history.RowsAfter += s.GetNumOfRows()
+		}
+	default:
+		history.Results = []int64{result.GetSegmentID()}
+		history.RowsAfter = result.GetNumOfRows()
+	}
+	// compactions only drop the deleted rows
+	if history.RowsBefore > history.RowsAfter {
+		history.DeletedRows = history.RowsBefore - history.RowsAfter
+	}
+	return history
+}
+
+// observeCompactionHistory records the metrics of a completed compaction
+func observeCompactionHistory(history *milvuspb.CompactionHistory) {
+	metrics.DataCoordCompactionCounter.WithLabelValues(history.GetType()).Inc()
+	metrics.DataCoordCompactionRowsCounter.WithLabelValues(history.GetType()).Add(float64(history.GetRowsBefore()))
+	metrics.DataCoordCompactionDeletedRowsCounter.WithLabelValues(history.GetType()).Add(float64(history.GetDeletedRows()))
+	metrics.DataCoordCompactionBytesCounter.WithLabelValues(history.GetType(), "read").Add(float64(history.GetBytesRead()))
+	metrics.DataCoordCompactionBytesCounter.WithLabelValues(history.GetType(), "written").Add(float64(history.GetBytesWritten()))
+	metrics.DataCoordCompactionLatency.WithLabelValues(history.GetType()).Observe(float64(history.GetTimeCostMs()) / 1000)
+}
+
 func (c *compactionPlanHandler) handleInnerCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
 	return c.meta.CompleteInnerCompaction(plan.GetSegmentBinlogs()[0], result)
 }
@@ -224,6 +289,13 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	return c.meta.CompleteMergeCompaction(plan.GetSegmentBinlogs(), result)
 }
 
+func (c *compactionPlanHandler) handleClusteringCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+	if len(result.GetSegments()) == 0 {
+		return fmt.Errorf("clustering compaction plan %d has no result segments", plan.GetPlanID())
+	}
+	return c.meta.CompleteClusteringCompaction(plan.GetSegmentBinlogs(), result)
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
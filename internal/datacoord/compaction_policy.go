@@ -1,9 +1,14 @@
 package datacoord
 
 import (
+	"fmt"
 	"sort"
+	"strconv"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 type singleCompactionPolicy interface {
@@ -111,3 +116,429 @@ func greedyGeneratePlans(sortedSegments []*SegmentInfo, timetravel *timetravel)
 
 	return plans
 }
+
+type clusteringCompactionPolicy interface {
+	// generatePlan generates a compaction plan rewriting the segments by ranges of the clustering key, return nil if no plan can be generated.
+	generatePlan(segments []*SegmentInfo, clusteringFieldID int64, timetravel *timetravel) *datapb.CompactionPlan
+}
+
+type clusteringCompactionFunc func(segments []*SegmentInfo, clusteringFieldID int64, timetravel *timetravel) *datapb.CompactionPlan
+
+func (f clusteringCompactionFunc) generatePlan(segments []*SegmentInfo, clusteringFieldID int64, timetravel *timetravel) *datapb.CompactionPlan {
+	return f(segments, clusteringFieldID, timetravel)
+}
+
+// isClustered returns true if the segment is written by clustering compaction on the field
+func isClustered(segment *SegmentInfo, clusteringFieldID int64) bool {
+	keyRange := segment.GetClusteringKeyRange()
+	return keyRange != nil && keyRange.GetFieldID() == clusteringFieldID
+}
+
+// clusteringKeyRangesOverlap returns true if any two of the clustered segments may hold the same key,
+// a key uses either the int bounds or the float bounds so the other pair is always zero
+func clusteringKeyRangesOverlap(segments []*SegmentInfo) bool {
+	ranges := make([]*datapb.ClusteringKeyRange, 0, len(segments))
+	for _, s := range segments {
+		ranges = append(ranges, s.GetClusteringKeyRange())
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].GetMinInt() != ranges[j].GetMinInt() {
+			return ranges[i].GetMinInt() < ranges[j].GetMinInt()
+		}
+		return ranges[i].GetMinFloat() < ranges[j].GetMinFloat()
+	})
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].GetMinInt() <= ranges[i-1].GetMaxInt() && ranges[i].GetMinFloat() <= ranges[i-1].GetMaxFloat() {
+			return true
+		}
+	}
+	return false
+}
+
+// clusterSegments rewrites all the segments of a channel-partition in one plan, the segments which are not clustered yet
+// go first if there're more than maxClusteringSegmentNum segments.
+func clusterSegments(segments []*SegmentInfo, clusteringFieldID int64, timetravel *timetravel) *datapb.CompactionPlan {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	var unclustered, clustered []*SegmentInfo
+	for _, s := range segments {
+		if isClustered(s, clusteringFieldID) {
+			clustered = append(clustered, s)
+		} else {
+			unclustered = append(unclustered, s)
+		}
+	}
+	// nothing to do if every segment already covers its own range of the key
+	if len(unclustered) == 0 && !clusteringKeyRangesOverlap(clustered) {
+		return nil
+	}
+
+	candidates := append(unclustered, clustered...)
+	if len(candidates) > maxClusteringSegmentNum {
+		candidates = candidates[:maxClusteringSegmentNum]
+	}
+
+	plan := &datapb.CompactionPlan{
+		Timetravel:        timetravel.time,
+		Type:              datapb.CompactionType_ClusteringCompaction,
+		Channel:           candidates[0].GetInsertChannel(),
+		ClusteringFieldID: clusteringFieldID,
+		MaxSegmentRows:    candidates[0].GetMaxRowNum(),
+	}
+	for _, s := range candidates {
+		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:           s.GetID(),
+			FieldBinlogs:        s.GetBinlogs(),
+			Field2StatslogPaths: s.GetStatslogs(),
+			Deltalogs:           s.GetDeltalogs(),
+		})
+	}
+	return plan
+}
+
+// compactionPolicy decides when and how the segments of a collection are compacted, a collection chooses
+// one of the registered policies by name with its own thresholds, and the default policy if it never chooses
+type compactionPolicy interface {
+	// generateSinglePlan generates a plan compacting the binlogs of the segment, return nil if no plan can be generated.
+	generateSinglePlan(segment *SegmentInfo, timetravel *timetravel, isForce bool) *datapb.CompactionPlan
+	// generateMergePlans generates plans merging the segments of a channel-partition, return nil if no plan can be generated.
+	generateMergePlans(segments []*SegmentInfo, timetravel *timetravel, isForce bool) []*datapb.CompactionPlan
+}
+
+const (
+	defaultCompactionPolicyName       = "default"
+	sizeTieredCompactionPolicyName    = "size_tiered"
+	deletionRatioCompactionPolicyName = "deletion_ratio"
+	timeWindowCompactionPolicyName    = "time_window"
+	noopCompactionPolicyName          = "none"
+
+	defaultSizeTieredMinThreshold = 4
+	defaultSizeTieredMaxThreshold = 32
+	defaultSizeTieredBucketLow    = 0.5
+	defaultSizeTieredBucketHigh   = 1.5
+	defaultTimeWindowInSeconds    = 24 * 60 * 60
+	defaultTimeWindowMinThreshold = 4
+)
+
+type compactionPolicyFactory func(params map[string]string) (compactionPolicy, error)
+
+var compactionPolicyFactories = map[string]compactionPolicyFactory{
+	defaultCompactionPolicyName:       newDefaultCompactionPolicy,
+	sizeTieredCompactionPolicyName:    newSizeTieredCompactionPolicy,
+	deletionRatioCompactionPolicyName: newDeletionRatioCompactionPolicy,
+	timeWindowCompactionPolicyName:    newTimeWindowCompactionPolicy,
+	noopCompactionPolicyName:          newNoopCompactionPolicy,
+}
+
+// newCompactionPolicy creates the registered policy with the params, unknown params are rejected
+func newCompactionPolicy(name string, params []*commonpb.KeyValuePair) (compactionPolicy, error) {
+	factory, ok := compactionPolicyFactories[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown compaction policy %q", name)
+	}
+	kvs := make(map[string]string, len(params))
+	for _, kv := range params {
+		kvs[kv.GetKey()] = kv.GetValue()
+	}
+	return factory(kvs)
+}
+
+// checkPolicyParams returns error if any param is not one of the keys
+func checkPolicyParams(params map[string]string, keys ...string) error {
+	for key := range params {
+		if !funcutil.SliceContain(keys, key) {
+			return fmt.Errorf("unknown compaction policy param %q", key)
+		}
+	}
+	return nil
+}
+
+func getIntPolicyParam(params map[string]string, key string, defaultValue int64) (int64, error) {
+	value, ok := params[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("compaction policy param %s should be a positive integer, got %q", key, value)
+	}
+	return v, nil
+}
+
+func getFloatPolicyParam(params map[string]string, key string, defaultValue float64) (float64, error) {
+	value, ok := params[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("compaction policy param %s should be a positive number, got %q", key, value)
+	}
+	return v, nil
+}
+
+// shouldDoSingleCompaction returns true if enough rows of the segment are deleted before the timetravel
+func shouldDoSingleCompaction(segment *SegmentInfo, timetravel *timetravel, ratioThreshold float64, deltaLogMaxSize int64) bool {
+	// single compaction only merge insert and delta log beyond the timetravel
+	// segment's insert binlogs dont have time range info, so we wait until the segment's last expire time is less than timetravel
+	// to ensure that all insert logs is beyond the timetravel.
+	// TODO: add meta in insert binlog
+	if segment.LastExpireTime >= timetravel.time {
+		return false
+	}
+
+	totalDeletedRows := 0
+	totalDeleteLogSize := int64(0)
+	for _, l := range segment.GetDeltalogs() {
+		if l.TimestampTo < timetravel.time {
+			totalDeletedRows += int(l.GetRecordEntries())
+			totalDeleteLogSize += l.GetDeltaLogSize()
+		}
+	}
+
+	// currently delta log size and delete ratio policy is applied
+	return float64(totalDeletedRows)/float64(segment.NumOfRows) >= ratioThreshold || totalDeleteLogSize > deltaLogMaxSize
+}
+
+// shouldDoMergeCompaction returns true if there're enough segments less than half full
+func shouldDoMergeCompaction(segments []*SegmentInfo, segmentThreshold int) bool {
+	littleSegmentNum := 0
+	for _, s := range segments {
+		if s.GetNumOfRows() < s.GetMaxRowNum()/2 {
+			littleSegmentNum++
+		}
+	}
+	return littleSegmentNum >= segmentThreshold
+}
+
+// defaultCompactionPolicy compacts the segments with enough deleted rows, and merges the little segments greedily
+type defaultCompactionPolicy struct {
+	singleCompactionPolicy          singleCompactionPolicy
+	mergeCompactionPolicy           mergeCompactionPolicy
+	singleCompactionRatioThreshold  float64
+	singleCompactionDeltaLogMaxSize int64
+	mergeCompactionSegmentThreshold int
+}
+
+func newDefaultCompactionPolicy(params map[string]string) (compactionPolicy, error) {
+	if err := checkPolicyParams(params); err != nil {
+		return nil, err
+	}
+	return &defaultCompactionPolicy{
+		singleCompactionPolicy:          (singleCompactionFunc)(chooseAllBinlogs),
+		mergeCompactionPolicy:           (mergeCompactionFunc)(greedyMergeCompaction),
+		singleCompactionRatioThreshold:  singleCompactionRatioThreshold,
+		singleCompactionDeltaLogMaxSize: singleCompactionDeltaLogMaxSize,
+		mergeCompactionSegmentThreshold: maxLittleSegmentNum,
+	}, nil
+}
+
+func (p *defaultCompactionPolicy) generateSinglePlan(segment *SegmentInfo, timetravel *timetravel, isForce bool) *datapb.CompactionPlan {
+	if !isForce && !shouldDoSingleCompaction(segment, timetravel, p.singleCompactionRatioThreshold, p.singleCompactionDeltaLogMaxSize) {
+		return nil
+	}
+	return p.singleCompactionPolicy.generatePlan(segment, timetravel)
+}
+
+func (p *defaultCompactionPolicy) generateMergePlans(segments []*SegmentInfo, timetravel *timetravel, isForce bool) []*datapb.CompactionPlan {
+	if !isForce && !shouldDoMergeCompaction(segments, p.mergeCompactionSegmentThreshold) {
+		return nil
+	}
+	return p.mergeCompactionPolicy.generatePlan(segments, timetravel)
+}
+
+// deletionRatioCompactionPolicy only compacts the segments with enough deleted rows and never merges segments.
+// Param ratio is the ratio of deleted rows to trigger the compaction, 0.2 by default,
+// and max_delta_size is the size in bytes of delta logs to trigger the compaction, 10MiB by default.
+type deletionRatioCompactionPolicy struct {
+	ratio           float64
+	deltaLogMaxSize int64
+}
+
+func newDeletionRatioCompactionPolicy(params map[string]string) (compactionPolicy, error) {
+	if err := checkPolicyParams(params, "ratio", "max_delta_size"); err != nil {
+		return nil, err
+	}
+	ratio, err := getFloatPolicyParam(params, "ratio", singleCompactionRatioThreshold)
+	if err != nil {
+		return nil, err
+	}
+	deltaLogMaxSize, err := getIntPolicyParam(params, "max_delta_size", singleCompactionDeltaLogMaxSize)
+	if err != nil {
+		return nil, err
+	}
+	return &deletionRatioCompactionPolicy{ratio: ratio, deltaLogMaxSize: deltaLogMaxSize}, nil
+}
+
+func (p *deletionRatioCompactionPolicy) generateSinglePlan(segment *SegmentInfo, timetravel *timetravel, isForce bool) *datapb.CompactionPlan {
+	if !isForce && !shouldDoSingleCompaction(segment, timetravel, p.ratio, p.deltaLogMaxSize) {
+		return nil
+	}
+	return chooseAllBinlogs(segment, timetravel)
+}
+
+func (p *deletionRatioCompactionPolicy) generateMergePlans(segments []*SegmentInfo, timetravel *timetravel, isForce bool) []*datapb.CompactionPlan {
+	return nil
+}
+
+// sizeTieredCompactionPolicy puts the segments of similar row numbers into a bucket, and merges the segments of a bucket
+// once it has enough segments, so a row is rewritten only a few times, which fits write-heavy collections.
+// Param min_threshold is the number of segments in a bucket to trigger the compaction, 4 by default,
+// max_threshold is the max number of segments of a bucket compacted at a time, 32 by default,
+// and a segment goes into a bucket if its row number is within [bucket_low, bucket_high] times the average
+// row number of the bucket, which are 0.5 and 1.5 by default.
+type sizeTieredCompactionPolicy struct {
+	minThreshold int64
+	maxThreshold int64
+	bucketLow    float64
+	bucketHigh   float64
+}
+
+func newSizeTieredCompactionPolicy(params map[string]string) (compactionPolicy, error) {
+	if err := checkPolicyParams(params, "min_threshold", "max_threshold", "bucket_low", "bucket_high"); err != nil {
+		return nil, err
+	}
+	p := &sizeTieredCompactionPolicy{}
+	var err error
+	if p.minThreshold, err = getIntPolicyParam(params, "min_threshold", defaultSizeTieredMinThreshold); err != nil {
+		return nil, err
+	}
+	if p.maxThreshold, err = getIntPolicyParam(params, "max_threshold", defaultSizeTieredMaxThreshold); err != nil {
+		return nil, err
+	}
+	if p.bucketLow, err = getFloatPolicyParam(params, "bucket_low", defaultSizeTieredBucketLow); err != nil {
+		return nil, err
+	}
+	if p.bucketHigh, err = getFloatPolicyParam(params, "bucket_high", defaultSizeTieredBucketHigh); err != nil {
+		return nil, err
+	}
+	if p.minThreshold < 2 || p.minThreshold > p.maxThreshold {
+		return nil, fmt.Errorf("min_threshold should be within [2, max_threshold], got %d", p.minThreshold)
+	}
+	if p.bucketLow > 1 || p.bucketHigh < 1 {
+		return nil, fmt.Errorf("bucket_low should be at most 1 and bucket_high at least 1, got %v and %v", p.bucketLow, p.bucketHigh)
+	}
+	return p, nil
+}
+
+func (p *sizeTieredCompactionPolicy) generateSinglePlan(segment *SegmentInfo, timetravel *timetravel, isForce bool) *datapb.CompactionPlan {
+	return nil
+}
+
+func (p *sizeTieredCompactionPolicy) generateMergePlans(segments []*SegmentInfo, timetravel *timetravel, isForce bool) []*datapb.CompactionPlan {
+	if len(segments) == 0 {
+		return nil
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].NumOfRows < segments[j].NumOfRows
+	})
+
+	plans := make([]*datapb.CompactionPlan, 0)
+	for _, bucket := range p.buckets(segments) {
+		if int64(len(bucket)) < p.minThreshold && !isForce {
+			continue
+		}
+		if int64(len(bucket)) > p.maxThreshold {
+			bucket = bucket[:p.maxThreshold]
+		}
+		plans = append(plans, greedyGeneratePlans(bucket, timetravel)...)
+	}
+	return plans
+}
+
+// buckets splits the segments sorted by row number into buckets of similar row numbers
+func (p *sizeTieredCompactionPolicy) buckets(sortedSegments []*SegmentInfo) [][]*SegmentInfo {
+	buckets := make([][]*SegmentInfo, 0)
+	var bucket []*SegmentInfo
+	var totalRows int64
+	for _, s := range sortedSegments {
+		if len(bucket) > 0 {
+			avg := float64(totalRows) / float64(len(bucket))
+			rows := float64(s.GetNumOfRows())
+			if rows < avg*p.bucketLow || rows > avg*p.bucketHigh {
+				buckets = append(buckets, bucket)
+				bucket, totalRows = nil, 0
+			}
+		}
+		bucket = append(bucket, s)
+		totalRows += s.GetNumOfRows()
+	}
+	if len(bucket) > 0 {
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// timeWindowCompactionPolicy only merges the segments started in the same time window, so the old data is not
+// rewritten again and again with the new data, which fits collections of time series.
+// Param window is the length of a time window in seconds, one day by default,
+// and min_threshold is the number of segments in a window to trigger the compaction, 4 by default.
+type timeWindowCompactionPolicy struct {
+	window       int64
+	minThreshold int64
+}
+
+func newTimeWindowCompactionPolicy(params map[string]string) (compactionPolicy, error) {
+	if err := checkPolicyParams(params, "window", "min_threshold"); err != nil {
+		return nil, err
+	}
+	window, err := getIntPolicyParam(params, "window", defaultTimeWindowInSeconds)
+	if err != nil {
+		return nil, err
+	}
+	minThreshold, err := getIntPolicyParam(params, "min_threshold", defaultTimeWindowMinThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return &timeWindowCompactionPolicy{window: window, minThreshold: minThreshold}, nil
+}
+
+func (p *timeWindowCompactionPolicy) generateSinglePlan(segment *SegmentInfo, timetravel *timetravel, isForce bool) *datapb.CompactionPlan {
+	return nil
+}
+
+func (p *timeWindowCompactionPolicy) generateMergePlans(segments []*SegmentInfo, timetravel *timetravel, isForce bool) []*datapb.CompactionPlan {
+	windows := make(map[int64][]*SegmentInfo)
+	for _, s := range segments {
+		physical, _ := tsoutil.ParseTS(s.GetStartPosition().GetTimestamp())
+		window := physical.Unix() / p.window
+		windows[window] = append(windows[window], s)
+	}
+
+	// generate plans from the oldest window
+	keys := make([]int64, 0, len(windows))
+	for window := range windows {
+		keys = append(keys, window)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	plans := make([]*datapb.CompactionPlan, 0)
+	for _, window := range keys {
+		if int64(len(windows[window])) < p.minThreshold && !isForce {
+			continue
+		}
+		plans = append(plans, greedyMergeCompaction(windows[window], timetravel)...)
+	}
+	return plans
+}
+
+// noopCompactionPolicy never compacts the segments
+type noopCompactionPolicy struct{}
+
+func newNoopCompactionPolicy(params map[string]string) (compactionPolicy, error) {
+	if err := checkPolicyParams(params); err != nil {
+		return nil, err
+	}
+	return &noopCompactionPolicy{}, nil
+}
+
+func (p *noopCompactionPolicy) generateSinglePlan(segment *SegmentInfo, timetravel *timetravel, isForce bool) *datapb.CompactionPlan {
+	return nil
+}
+
+func (p *noopCompactionPolicy) generateMergePlans(segments []*SegmentInfo, timetravel *timetravel, isForce bool) []*datapb.CompactionPlan {
+	return nil
+}
@@ -2,8 +2,12 @@ package datacoord
 
 import (
 	"testing"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -139,3 +143,210 @@ func Test_chooseAllBinlogs(t *testing.T) {
 		})
 	}
 }
+
+func Test_clusterSegments(t *testing.T) {
+	keyRange := func(min, max int64) *datapb.ClusteringKeyRange {
+		return &datapb.ClusteringKeyRange{FieldID: 101, MinInt: min, MaxInt: max}
+	}
+
+	t.Run("test no segment", func(t *testing.T) {
+		assert.Nil(t, clusterSegments(nil, 101, &timetravel{1000}))
+	})
+
+	t.Run("test unclustered segments go first", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			{SegmentInfo: &datapb.SegmentInfo{ID: 1, MaxRowNum: 100, InsertChannel: "ch1", ClusteringKeyRange: keyRange(0, 9)}},
+			{SegmentInfo: &datapb.SegmentInfo{ID: 2, MaxRowNum: 100, InsertChannel: "ch1"}},
+			// clustered by another field
+			{SegmentInfo: &datapb.SegmentInfo{ID: 3, MaxRowNum: 100, InsertChannel: "ch1", ClusteringKeyRange: &datapb.ClusteringKeyRange{FieldID: 102}}},
+		}
+		plan := clusterSegments(segments, 101, &timetravel{1000})
+		assert.NotNil(t, plan)
+		assert.Equal(t, datapb.CompactionType_ClusteringCompaction, plan.GetType())
+		assert.EqualValues(t, 101, plan.GetClusteringFieldID())
+		assert.EqualValues(t, 100, plan.GetMaxSegmentRows())
+		assert.EqualValues(t, 1000, plan.GetTimetravel())
+		assert.Equal(t, "ch1", plan.GetChannel())
+		segmentIDs := make([]int64, 0, len(plan.GetSegmentBinlogs()))
+		for _, binlogs := range plan.GetSegmentBinlogs() {
+			segmentIDs = append(segmentIDs, binlogs.GetSegmentID())
+		}
+		assert.Equal(t, []int64{2, 3, 1}, segmentIDs)
+	})
+
+	t.Run("test clustered segments without overlap", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			{SegmentInfo: &datapb.SegmentInfo{ID: 1, ClusteringKeyRange: keyRange(10, 19)}},
+			{SegmentInfo: &datapb.SegmentInfo{ID: 2, ClusteringKeyRange: keyRange(0, 9)}},
+		}
+		assert.Nil(t, clusterSegments(segments, 101, &timetravel{1000}))
+	})
+
+	t.Run("test clustered segments with overlap", func(t *testing.T) {
+		segments := []*SegmentInfo{
+			{SegmentInfo: &datapb.SegmentInfo{ID: 1, ClusteringKeyRange: keyRange(5, 19)}},
+			{SegmentInfo: &datapb.SegmentInfo{ID: 2, ClusteringKeyRange: keyRange(0, 9)}},
+		}
+		plan := clusterSegments(segments, 101, &timetravel{1000})
+		assert.NotNil(t, plan)
+		assert.Equal(t, 2, len(plan.GetSegmentBinlogs()))
+	})
+
+	t.Run("test too many segments", func(t *testing.T) {
+		segments := make([]*SegmentInfo, 0, maxClusteringSegmentNum+1)
+		for i := 0; i <= maxClusteringSegmentNum; i++ {
+			segments = append(segments, &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: int64(i)}})
+		}
+		plan := clusterSegments(segments, 101, &timetravel{1000})
+		assert.NotNil(t, plan)
+		assert.Equal(t, maxClusteringSegmentNum, len(plan.GetSegmentBinlogs()))
+	})
+}
+
+func Test_clusteringKeyRangesOverlap(t *testing.T) {
+	floatRange := func(min, max float64) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ClusteringKeyRange: &datapb.ClusteringKeyRange{FieldID: 101, MinFloat: min, MaxFloat: max}}}
+	}
+	assert.False(t, clusteringKeyRangesOverlap(nil))
+	assert.False(t, clusteringKeyRangesOverlap([]*SegmentInfo{floatRange(0, 1)}))
+	assert.False(t, clusteringKeyRangesOverlap([]*SegmentInfo{floatRange(1.5, 2), floatRange(0, 1)}))
+	assert.True(t, clusteringKeyRangesOverlap([]*SegmentInfo{floatRange(1, 2), floatRange(0, 1)}))
+	assert.True(t, clusteringKeyRangesOverlap([]*SegmentInfo{floatRange(-1, 0.5), floatRange(0, 1)}))
+}
+
+func Test_newCompactionPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		params  []*commonpb.KeyValuePair
+		wantErr bool
+	}{
+		{"test default", defaultCompactionPolicyName, nil, false},
+		{"test default with params", defaultCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "ratio", Value: "0.5"}}, true},
+		{"test size tiered", sizeTieredCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "min_threshold", Value: "2"}, {Key: "bucket_high", Value: "2"}}, false},
+		{"test size tiered with min over max", sizeTieredCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "min_threshold", Value: "8"}, {Key: "max_threshold", Value: "4"}}, true},
+		{"test size tiered with invalid bucket", sizeTieredCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "bucket_low", Value: "1.5"}}, true},
+		{"test deletion ratio", deletionRatioCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "ratio", Value: "0.5"}}, false},
+		{"test deletion ratio with invalid ratio", deletionRatioCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "ratio", Value: "abc"}}, true},
+		{"test time window", timeWindowCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "window", Value: "3600"}}, false},
+		{"test time window with negative window", timeWindowCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "window", Value: "-1"}}, true},
+		{"test none", noopCompactionPolicyName, nil, false},
+		{"test unknown param", noopCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "window", Value: "3600"}}, true},
+		{"test unknown policy", "leveled", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := newCompactionPolicy(tt.policy, tt.params)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantErr, policy == nil)
+		})
+	}
+}
+
+func Test_deletionRatioCompactionPolicy(t *testing.T) {
+	policy, err := newCompactionPolicy(deletionRatioCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "ratio", Value: "0.5"}})
+	assert.Nil(t, err)
+
+	segment := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		ID:        1,
+		NumOfRows: 100,
+		Deltalogs: []*datapb.DeltaLogInfo{{RecordEntries: 30, TimestampTo: 500, DeltaLogPath: "deltalog1"}},
+	}}
+	// 30% of rows are deleted
+	assert.Nil(t, policy.generateSinglePlan(segment, &timetravel{1000}, false))
+	assert.NotNil(t, policy.generateSinglePlan(segment, &timetravel{1000}, true))
+
+	segment.Deltalogs = append(segment.Deltalogs, &datapb.DeltaLogInfo{RecordEntries: 30, TimestampTo: 600, DeltaLogPath: "deltalog2"})
+	plan := policy.generateSinglePlan(segment, &timetravel{1000}, false)
+	assert.NotNil(t, plan)
+	assert.Equal(t, datapb.CompactionType_InnerCompaction, plan.GetType())
+
+	segments := []*SegmentInfo{
+		{SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 1, MaxRowNum: 100}},
+		{SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 1, MaxRowNum: 100}},
+	}
+	assert.Empty(t, policy.generateMergePlans(segments, &timetravel{1000}, true))
+}
+
+func Test_sizeTieredCompactionPolicy(t *testing.T) {
+	policy, err := newCompactionPolicy(sizeTieredCompactionPolicyName, []*commonpb.KeyValuePair{
+		{Key: "min_threshold", Value: "3"},
+		{Key: "max_threshold", Value: "3"},
+	})
+	assert.Nil(t, err)
+
+	newSegment := func(id, rows int64) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: id, NumOfRows: rows, MaxRowNum: 1000}}
+	}
+	getSources := func(plans []*datapb.CompactionPlan) [][]int64 {
+		sources := make([][]int64, 0, len(plans))
+		for _, plan := range plans {
+			ids := make([]int64, 0)
+			for _, binlogs := range plan.GetSegmentBinlogs() {
+				ids = append(ids, binlogs.GetSegmentID())
+			}
+			sources = append(sources, ids)
+		}
+		return sources
+	}
+
+	// buckets: [10, 11, 12, 13], [100, 110]
+	segments := []*SegmentInfo{
+		newSegment(1, 100), newSegment(2, 10), newSegment(3, 110),
+		newSegment(4, 12), newSegment(5, 11), newSegment(6, 13),
+	}
+	plans := policy.generateMergePlans(segments, &timetravel{1000}, false)
+	assert.Equal(t, [][]int64{{2, 5, 4}}, getSources(plans))
+	assert.Equal(t, datapb.CompactionType_MergeCompaction, plans[0].GetType())
+	assert.EqualValues(t, 1000, plans[0].GetTimetravel())
+
+	// small buckets are merged when forced
+	plans = policy.generateMergePlans(segments, &timetravel{1000}, true)
+	assert.Equal(t, [][]int64{{2, 5, 4}, {1, 3}}, getSources(plans))
+
+	assert.Nil(t, policy.generateSinglePlan(newSegment(1, 100), &timetravel{1000}, true))
+	assert.Nil(t, policy.generateMergePlans(nil, &timetravel{1000}, true))
+}
+
+func Test_timeWindowCompactionPolicy(t *testing.T) {
+	policy, err := newCompactionPolicy(timeWindowCompactionPolicyName, []*commonpb.KeyValuePair{
+		{Key: "window", Value: "3600"},
+		{Key: "min_threshold", Value: "2"},
+	})
+	assert.Nil(t, err)
+
+	newSegment := func(id int64, startTime time.Time) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID:            id,
+			NumOfRows:     1,
+			MaxRowNum:     100,
+			StartPosition: &internalpb.MsgPosition{Timestamp: tsoutil.ComposeTS(startTime.UnixNano()/int64(time.Millisecond), 0)},
+		}}
+	}
+	base := time.Unix(3600*1000, 0)
+	segments := []*SegmentInfo{
+		newSegment(1, base.Add(2*time.Hour)),
+		newSegment(2, base),
+		newSegment(3, base.Add(30*time.Minute)),
+		newSegment(4, base.Add(time.Hour)),
+		newSegment(5, base.Add(time.Hour+time.Minute)),
+	}
+	plans := policy.generateMergePlans(segments, &timetravel{1000}, false)
+	assert.Equal(t, 2, len(plans))
+	assert.ElementsMatch(t, []int64{2, 3}, []int64{plans[0].GetSegmentBinlogs()[0].GetSegmentID(), plans[0].GetSegmentBinlogs()[1].GetSegmentID()})
+	assert.ElementsMatch(t, []int64{4, 5}, []int64{plans[1].GetSegmentBinlogs()[0].GetSegmentID(), plans[1].GetSegmentBinlogs()[1].GetSegmentID()})
+
+	assert.Nil(t, policy.generateSinglePlan(segments[0], &timetravel{1000}, true))
+}
+
+func Test_noopCompactionPolicy(t *testing.T) {
+	policy, err := newCompactionPolicy(noopCompactionPolicyName, nil)
+	assert.Nil(t, err)
+
+	segments := []*SegmentInfo{
+		{SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 1, MaxRowNum: 100, Deltalogs: []*datapb.DeltaLogInfo{{RecordEntries: 1, TimestampTo: 500}}}},
+		{SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 1, MaxRowNum: 100}},
+	}
+	assert.Nil(t, policy.generateSinglePlan(segments[0], &timetravel{1000}, true))
+	assert.Nil(t, policy.generateMergePlans(segments, &timetravel{1000}, true))
+}
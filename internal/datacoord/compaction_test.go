@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
@@ -216,6 +218,94 @@ func Test_compactionPlanHandler_completeCompaction(t *testing.T) {
 				},
 			},
 		},
+		{
+			"test complete clustering compaction",
+			fields{
+				map[int64]*compactionTask{
+					1: {
+						triggerInfo: &compactionSignal{id: 1},
+						state:       executing,
+						plan: &datapb.CompactionPlan{
+							PlanID: 1,
+							SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+								{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}},
+								{SegmentID: 2, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}},
+							},
+							Type:              datapb.CompactionType_ClusteringCompaction,
+							ClusteringFieldID: 101,
+						},
+					},
+				},
+				nil,
+				&meta{
+					client: memkv.NewMemoryKV(),
+					segments: &SegmentsInfo{
+						map[int64]*SegmentInfo{
+							1: {SegmentInfo: &datapb.SegmentInfo{ID: 1, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}}},
+							2: {SegmentInfo: &datapb.SegmentInfo{ID: 2, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}}},
+						},
+					},
+				},
+				make(chan UniqueID, 2),
+			},
+			args{
+				result: &datapb.CompactionResult{
+					PlanID: 1,
+					Segments: []*datapb.CompactionSegment{
+						{SegmentID: 3, InsertLogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}},
+						{SegmentID: 4, InsertLogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log4"}}}},
+					},
+				},
+			},
+			false,
+			&compactionTask{
+				triggerInfo: &compactionSignal{id: 1},
+				state:       completed,
+				plan: &datapb.CompactionPlan{
+					PlanID: 1,
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+						{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}},
+						{SegmentID: 2, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}},
+					},
+					Type:              datapb.CompactionType_ClusteringCompaction,
+					ClusteringFieldID: 101,
+				},
+			},
+		},
+		{
+			"test complete clustering compaction without segments",
+			fields{
+				map[int64]*compactionTask{
+					1: {
+						triggerInfo: &compactionSignal{id: 1},
+						state:       executing,
+						plan: &datapb.CompactionPlan{
+							PlanID: 1,
+							SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+								{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}},
+							},
+							Type:              datapb.CompactionType_ClusteringCompaction,
+							ClusteringFieldID: 101,
+						},
+					},
+				},
+				nil,
+				&meta{
+					client: memkv.NewMemoryKV(),
+					segments: &SegmentsInfo{
+						map[int64]*SegmentInfo{
+							1: {SegmentInfo: &datapb.SegmentInfo{ID: 1, Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}}},
+						},
+					},
+				},
+				make(chan UniqueID, 1),
+			},
+			args{
+				result: &datapb.CompactionResult{PlanID: 1},
+			},
+			true,
+			nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -231,6 +321,106 @@ func Test_compactionPlanHandler_completeCompaction(t *testing.T) {
 	}
 }
 
+func Test_compactionPlanHandler_completeCompactionHistory(t *testing.T) {
+	newHandler := func(planType datapb.CompactionType) *compactionPlanHandler {
+		return &compactionPlanHandler{
+			plans: map[int64]*compactionTask{
+				1: {
+					triggerInfo: &compactionSignal{id: 1},
+					state:       executing,
+					dataNodeID:  10,
+					plan: &datapb.CompactionPlan{
+						PlanID: 1,
+						SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+							{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}},
+							{SegmentID: 2, FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}},
+						},
+						Type:    planType,
+						Channel: "ch1",
+					},
+				},
+			},
+			meta: &meta{
+				client: memkv.NewMemoryKV(),
+				segments: &SegmentsInfo{
+					map[int64]*SegmentInfo{
+						1: {SegmentInfo: &datapb.SegmentInfo{ID: 1, CollectionID: 100, NumOfRows: 100,
+							Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}}},
+						2: {SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: 100, NumOfRows: 50,
+							Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}}},
+					},
+				},
+			},
+			flushCh: make(chan UniqueID, 2),
+		}
+	}
+
+	t.Run("test merge compaction history", func(t *testing.T) {
+		c := newHandler(datapb.CompactionType_MergeCompaction)
+		err := c.completeCompaction(&datapb.CompactionResult{
+			PlanID:       1,
+			SegmentID:    3,
+			NumOfRows:    120,
+			InsertLogs:   []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}},
+			BytesRead:    1024,
+			BytesWritten: 512,
+			TimeCostMs:   100,
+		})
+		assert.Nil(t, err)
+
+		histories := c.meta.GetCompactionHistories(100, 0)
+		assert.Equal(t, 1, len(histories))
+		history := histories[0]
+		assert.EqualValues(t, 1, history.GetPlanID())
+		assert.Equal(t, datapb.CompactionType_MergeCompaction.String(), history.GetType())
+		assert.Equal(t, "ch1", history.GetChannel())
+		assert.Equal(t, []int64{1, 2}, history.GetSources())
+		assert.Equal(t, []int64{3}, history.GetResults())
+		assert.EqualValues(t, 150, history.GetRowsBefore())
+		assert.EqualValues(t, 120, history.GetRowsAfter())
+		assert.EqualValues(t, 30, history.GetDeletedRows())
+		assert.EqualValues(t, 1024, history.GetBytesRead())
+		assert.EqualValues(t, 512, history.GetBytesWritten())
+		assert.EqualValues(t, 100, history.GetTimeCostMs())
+		assert.EqualValues(t, 10, history.GetNodeID())
+		assert.NotZero(t, history.GetCompleteTime())
+	})
+
+	t.Run("test clustering compaction history", func(t *testing.T) {
+		c := newHandler(datapb.CompactionType_ClusteringCompaction)
+		err := c.completeCompaction(&datapb.CompactionResult{
+			PlanID: 1,
+			Segments: []*datapb.CompactionSegment{
+				{SegmentID: 3, NumOfRows: 70, InsertLogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}},
+				{SegmentID: 4, NumOfRows: 80, InsertLogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log4"}}}},
+			},
+		})
+		assert.Nil(t, err)
+
+		histories := c.meta.GetCompactionHistories(100, 0)
+		assert.Equal(t, 1, len(histories))
+		assert.True(t, proto.Equal(&milvuspb.CompactionHistory{
+			PlanID:       1,
+			CollectionID: 100,
+			Type:         datapb.CompactionType_ClusteringCompaction.String(),
+			Channel:      "ch1",
+			Sources:      []int64{1, 2},
+			Results:      []int64{3, 4},
+			RowsBefore:   150,
+			RowsAfter:    150,
+			NodeID:       10,
+			CompleteTime: histories[0].GetCompleteTime(),
+		}, histories[0]))
+	})
+
+	t.Run("test failed compaction has no history", func(t *testing.T) {
+		c := newHandler(datapb.CompactionType_ClusteringCompaction)
+		err := c.completeCompaction(&datapb.CompactionResult{PlanID: 1})
+		assert.NotNil(t, err)
+		assert.Empty(t, c.meta.GetCompactionHistories(100, 0))
+	})
+}
+
 func Test_compactionPlanHandler_getCompaction(t *testing.T) {
 	type fields struct {
 		plans    map[int64]*compactionTask
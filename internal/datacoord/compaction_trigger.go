@@ -9,6 +9,7 @@ import (
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"go.uber.org/zap"
 )
 
@@ -19,6 +20,12 @@ const (
 	singleCompactionRatioThreshold  = 0.2
 	singleCompactionDeltaLogMaxSize = 10 * 1024 * 1024 //10MiB
 	globalCompactionInterval        = 60 * time.Second
+
+	// clustering compaction starts when there're enough segments not clustered in a channel-partition,
+	// and rewrites at most maxClusteringSegmentNum segments at a time
+	minUnclusteredSegmentNum                = 4
+	maxClusteringSegmentNum                 = 32
+	maxClusteringCompactionTimeoutInSeconds = 600
 )
 
 type timetravel struct {
@@ -34,6 +41,9 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, timetravel *timetravel) error
 	// forceTriggerCompaction force to start a compaction
 	forceTriggerCompaction(collectionID int64, timetravel *timetravel) (UniqueID, error)
+	// dryRunCompaction returns the plans the policy would generate for a collection without executing them,
+	// the policy chosen by the collection is used if policy is nil
+	dryRunCompaction(collectionID int64, policy compactionPolicy, timetravel *timetravel) []*datapb.CompactionPlan
 }
 
 type compactionSignal struct {
@@ -50,28 +60,34 @@ type compactionSignal struct {
 var _ trigger = (*compactionTrigger)(nil)
 
 type compactionTrigger struct {
-	meta                            *meta
-	allocator                       allocator
-	signals                         chan *compactionSignal
-	singleCompactionPolicy          singleCompactionPolicy
-	mergeCompactionPolicy           mergeCompactionPolicy
-	compactionHandler               compactionPlanContext
-	globalTrigger                   *time.Ticker
-	forceMu                         sync.Mutex
-	mergeCompactionSegmentThreshold int
-	quit                            chan struct{}
-	wg                              sync.WaitGroup
+	meta                                 *meta
+	allocator                            allocator
+	signals                              chan *compactionSignal
+	singleCompactionPolicy               singleCompactionPolicy
+	mergeCompactionPolicy                mergeCompactionPolicy
+	clusteringCompactionPolicy           clusteringCompactionPolicy
+	compactionHandler                    compactionPlanContext
+	globalTrigger                        *time.Ticker
+	forceMu                              sync.Mutex
+	mergeCompactionSegmentThreshold      int
+	clusteringCompactionSegmentThreshold int
+	deferWindows                         []timeWindow // automatic compactions are deferred in these windows
+	quit                                 chan struct{}
+	wg                                   sync.WaitGroup
 }
 
 func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, allocator allocator) *compactionTrigger {
 	return &compactionTrigger{
-		meta:                            meta,
-		allocator:                       allocator,
-		signals:                         make(chan *compactionSignal, signalBufferSize),
-		singleCompactionPolicy:          (singleCompactionFunc)(chooseAllBinlogs),
-		mergeCompactionPolicy:           (mergeCompactionFunc)(greedyMergeCompaction),
-		compactionHandler:               compactionHandler,
-		mergeCompactionSegmentThreshold: maxLittleSegmentNum,
+		meta:                                 meta,
+		allocator:                            allocator,
+		signals:                              make(chan *compactionSignal, signalBufferSize),
+		singleCompactionPolicy:               (singleCompactionFunc)(chooseAllBinlogs),
+		mergeCompactionPolicy:                (mergeCompactionFunc)(greedyMergeCompaction),
+		clusteringCompactionPolicy:           (clusteringCompactionFunc)(clusterSegments),
+		compactionHandler:                    compactionHandler,
+		mergeCompactionSegmentThreshold:      maxLittleSegmentNum,
+		clusteringCompactionSegmentThreshold: minUnclusteredSegmentNum,
+		deferWindows:                         Params.CompactionDeferWindows,
 	}
 }
 
@@ -185,6 +201,48 @@ func (t *compactionTrigger) forceTriggerCompaction(collectionID int64, timetrave
 	return id, nil
 }
 
+// dryRunCompaction returns the plans the policy would generate for a collection without executing them,
+// the policy chosen by the collection is used if policy is nil,
+// the segments in single compaction plans are not merged like they're compacting.
+func (t *compactionTrigger) dryRunCompaction(collectionID int64, policy compactionPolicy, timetravel *timetravel) []*datapb.CompactionPlan {
+	if policy == nil {
+		policy = t.getCompactionPolicy(collectionID)
+	}
+
+	plans := make([]*datapb.CompactionPlan, 0)
+	compacting := make(map[UniqueID]struct{})
+	for _, segment := range t.meta.GetSegmentsOfCollection(collectionID) {
+		if segment.isCompacting {
+			continue
+		}
+		if plan := policy.generateSinglePlan(segment, timetravel, false); plan != nil {
+			plans = append(plans, plan)
+			compacting[segment.GetID()] = struct{}{}
+		}
+	}
+
+	key := t.getClusteringKey(collectionID)
+	for _, chanPart := range t.getChanPartSegments(collectionID) {
+		segments := make([]*SegmentInfo, 0, len(chanPart.segments))
+		for _, s := range chanPart.segments {
+			if _, ok := compacting[s.GetID()]; !ok {
+				segments = append(segments, s)
+			}
+		}
+
+		if key != nil {
+			if t.shouldDoClusteringCompaction(segments, key.GetFieldID()) {
+				if plan := t.clusteringCompactionPolicy.generatePlan(segments, key.GetFieldID(), timetravel); plan != nil {
+					plans = append(plans, plan)
+				}
+			}
+			continue
+		}
+		plans = append(plans, policy.generateMergePlans(segments, timetravel, false)...)
+	}
+	return plans
+}
+
 func (t *compactionTrigger) allocSignalID() (UniqueID, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -207,6 +265,11 @@ func (t *compactionTrigger) handleForceSignal(signal *compactionSignal) {
 	if len(mergeCompactionPlans) != 0 {
 		log.Debug("force merge compaction plans", zap.Int64("signalID", signal.id), zap.Int64s("planIDs", getPlanIDs(mergeCompactionPlans)))
 	}
+
+	clusteringCompactionPlans := t.globalClusteringCompaction(signal, true, signal.collectionID)
+	if len(clusteringCompactionPlans) != 0 {
+		log.Debug("force clustering compaction plans", zap.Int64("signalID", signal.id), zap.Int64s("planIDs", getPlanIDs(clusteringCompactionPlans)))
+	}
 	log.Info("handle force signal cost", zap.Int64("milliseconds", time.Since(t1).Milliseconds()),
 		zap.Int64("collectionID", signal.collectionID), zap.Int64("signalID", signal.id))
 }
@@ -219,12 +282,21 @@ func getPlanIDs(plans []*datapb.CompactionPlan) []int64 {
 	return ids
 }
 
+// isDeferred returns whether automatic compactions should be deferred at the moment
+func (t *compactionTrigger) isDeferred(now time.Time) bool {
+	return inTimeWindows(t.deferWindows, now)
+}
+
 func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 	t.forceMu.Lock()
 	defer t.forceMu.Unlock()
 
 	// 1. try global single compaction
 	t1 := time.Now()
+	if t.isDeferred(t1) {
+		log.Debug("global compaction is deferred by the defer windows", zap.Int64("signalID", signal.id))
+		return
+	}
 	if t.compactionHandler.isFull() {
 		return
 	}
@@ -244,6 +316,16 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 		log.Debug("global merge compaction plans", zap.Int64("signalID", signal.id), zap.Int64s("plans", getPlanIDs(mergeCompactionPlans)))
 	}
 
+	// 3. try global clustering compaction
+	if t.compactionHandler.isFull() {
+		return
+	}
+
+	clusteringCompactionPlans := t.globalClusteringCompaction(signal, false)
+	if len(clusteringCompactionPlans) != 0 {
+		log.Debug("global clustering compaction plans", zap.Int64("signalID", signal.id), zap.Int64s("plans", getPlanIDs(clusteringCompactionPlans)))
+	}
+
 	log.Info("handle global compaction cost", zap.Int64("millliseconds", time.Since(t1).Milliseconds()))
 }
 
@@ -252,6 +334,10 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 	defer t.forceMu.Unlock()
 
 	t1 := time.Now()
+	if !signal.isForce && t.isDeferred(t1) {
+		log.Debug("compaction is deferred by the defer windows", zap.Int64("signalID", signal.id), zap.Int64("segmentID", signal.segmentID))
+		return
+	}
 	// 1. check whether segment's binlogs should be compacted or not
 	if t.compactionHandler.isFull() {
 		return
@@ -276,7 +362,18 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 
 	segments := t.getCandidateSegments(channel, partitionID)
 
-	plans := t.mergeCompaction(segments, signal, false)
+	// segments of collections with clustering key are merged by clustering compaction instead
+	if key := t.getClusteringKey(segment.GetCollectionID()); key != nil {
+		plan, err := t.clusteringCompaction(segments, key.GetFieldID(), signal, false)
+		if err != nil {
+			log.Warn("failed to do clustering compaction", zap.Int64("segmentID", segment.ID), zap.Error(err))
+		} else if plan != nil {
+			log.Debug("clustering compaction plan", zap.Int64("signalID", signal.id), zap.Int64("planID", plan.GetPlanID()))
+		}
+		return
+	}
+
+	plans := t.mergeCompaction(segment.GetCollectionID(), segments, signal, false)
 	if len(plans) != 0 {
 		log.Debug("merge compaction plans", zap.Int64("signalID", signal.id), zap.Int64s("plans", getPlanIDs(plans)))
 	}
@@ -285,36 +382,123 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 	// 	zap.String("channel", channel), zap.Int64("partitionID", partitionID))
 }
 
-func (t *compactionTrigger) globalMergeCompaction(signal *compactionSignal, isForce bool, collections ...UniqueID) []*datapb.CompactionPlan {
+// getChanPartSegments returns the flushed segments not compacting now organized in channel-partition dimension,
+// which are of the collections if any is provided
+func (t *compactionTrigger) getChanPartSegments(collections ...UniqueID) []*chanPartSegments {
 	colls := make(map[int64]struct{})
 	for _, collID := range collections {
 		colls[collID] = struct{}{}
 	}
-	m := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
+	return t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
 		_, has := colls[segment.GetCollectionID()]
 		return (has || len(collections) == 0) && // if filters collection
 			isSegmentHealthy(segment) &&
 			segment.State == commonpb.SegmentState_Flushed && // flushed only
 			!segment.isCompacting // not compacting now
-	}) // m is list of chanPartSegments, which is channel-partition organized segments
+	})
+}
+
+func (t *compactionTrigger) globalMergeCompaction(signal *compactionSignal, isForce bool, collections ...UniqueID) []*datapb.CompactionPlan {
+	m := t.getChanPartSegments(collections...) // m is list of chanPartSegments, which is channel-partition organized segments
 	plans := make([]*datapb.CompactionPlan, 0)
 	for _, segments := range m {
 		if !isForce && t.compactionHandler.isFull() {
 			return plans
 		}
-		mplans := t.mergeCompaction(segments.segments, signal, isForce)
+		// merging would mix up the ranges of clustered segments
+		if t.getClusteringKey(segments.collecionID) != nil {
+			continue
+		}
+		mplans := t.mergeCompaction(segments.collecionID, segments.segments, signal, isForce)
 		plans = append(plans, mplans...)
 	}
 
 	return plans
 }
 
-func (t *compactionTrigger) mergeCompaction(segments []*SegmentInfo, signal *compactionSignal, isForce bool) []*datapb.CompactionPlan {
-	if !isForce && !t.shouldDoMergeCompaction(segments) {
-		return nil
+func (t *compactionTrigger) globalClusteringCompaction(signal *compactionSignal, isForce bool, collections ...UniqueID) []*datapb.CompactionPlan {
+	m := t.getChanPartSegments(collections...)
+	plans := make([]*datapb.CompactionPlan, 0)
+	for _, segments := range m {
+		if !isForce && t.compactionHandler.isFull() {
+			return plans
+		}
+		key := t.getClusteringKey(segments.collecionID)
+		if key == nil {
+			continue
+		}
+		plan, err := t.clusteringCompaction(segments.segments, key.GetFieldID(), signal, isForce)
+		if err != nil {
+			log.Warn("failed to exec clustering compaction", zap.Error(err))
+			continue
+		}
+		if plan != nil {
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans
+}
+
+// getCompactionPolicy returns the compaction policy chosen by the collection, the default one of the trigger if none
+func (t *compactionTrigger) getCompactionPolicy(collectionID UniqueID) compactionPolicy {
+	if info := t.meta.GetCompactionPolicy(collectionID); info != nil {
+		policy, err := newCompactionPolicy(info.GetPolicy(), info.GetParams())
+		if err == nil {
+			return policy
+		}
+		log.Warn("invalid compaction policy, use the default one instead", zap.Int64("collectionID", collectionID),
+			zap.String("policy", info.GetPolicy()), zap.Error(err))
+	}
+	return &defaultCompactionPolicy{
+		singleCompactionPolicy:          t.singleCompactionPolicy,
+		mergeCompactionPolicy:           t.mergeCompactionPolicy,
+		singleCompactionRatioThreshold:  singleCompactionRatioThreshold,
+		singleCompactionDeltaLogMaxSize: singleCompactionDeltaLogMaxSize,
+		mergeCompactionSegmentThreshold: t.mergeCompactionSegmentThreshold,
+	}
+}
+
+// getClusteringKey returns the clustering key field of the collection, nil if the collection has no clustering key
+// or its schema is not cached by datacoord yet
+func (t *compactionTrigger) getClusteringKey(collectionID UniqueID) *schemapb.FieldSchema {
+	for _, field := range t.meta.GetCollection(collectionID).GetSchema().GetFields() {
+		if field.GetIsClusteringKey() {
+			return field
+		}
+	}
+	return nil
+}
+
+func (t *compactionTrigger) clusteringCompaction(segments []*SegmentInfo, clusteringFieldID int64, signal *compactionSignal, isForce bool) (*datapb.CompactionPlan, error) {
+	if !isForce && !t.shouldDoClusteringCompaction(segments, clusteringFieldID) {
+		return nil, nil
+	}
+
+	plan := t.clusteringCompactionPolicy.generatePlan(segments, clusteringFieldID, signal.timetravel)
+	if plan == nil {
+		return nil, nil
 	}
 
-	plans := t.mergeCompactionPolicy.generatePlan(segments, signal.timetravel)
+	if err := t.fillOriginPlan(plan); err != nil {
+		return nil, err
+	}
+	log.Debug("exec clustering compaction plan", zap.Any("plan", plan))
+	return plan, t.compactionHandler.execCompactionPlan(signal, plan)
+}
+
+func (t *compactionTrigger) shouldDoClusteringCompaction(segments []*SegmentInfo, clusteringFieldID int64) bool {
+	unclusteredSegmentNum := 0
+	for _, s := range segments {
+		if !isClustered(s, clusteringFieldID) {
+			unclusteredSegmentNum++
+		}
+	}
+	return unclusteredSegmentNum >= t.clusteringCompactionSegmentThreshold
+}
+
+func (t *compactionTrigger) mergeCompaction(collectionID UniqueID, segments []*SegmentInfo, signal *compactionSignal, isForce bool) []*datapb.CompactionPlan {
+	plans := t.getCompactionPolicy(collectionID).generateMergePlans(segments, signal.timetravel, isForce)
 	if len(plans) == 0 {
 		return nil
 	}
@@ -353,16 +537,6 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 	return res
 }
 
-func (t *compactionTrigger) shouldDoMergeCompaction(segments []*SegmentInfo) bool {
-	littleSegmentNum := 0
-	for _, s := range segments {
-		if s.GetNumOfRows() < s.GetMaxRowNum()/2 {
-			littleSegmentNum++
-		}
-	}
-	return littleSegmentNum >= t.mergeCompactionSegmentThreshold
-}
-
 func (t *compactionTrigger) fillOriginPlan(plan *datapb.CompactionPlan) error {
 	// TODO context
 	id, err := t.allocator.allocID(context.Background())
@@ -371,29 +545,11 @@ func (t *compactionTrigger) fillOriginPlan(plan *datapb.CompactionPlan) error {
 	}
 	plan.PlanID = id
 	plan.TimeoutInSeconds = maxCompactionTimeoutInSeconds
-	return nil
-}
-
-func (t *compactionTrigger) shouldDoSingleCompaction(segment *SegmentInfo, timetravel *timetravel) bool {
-	// single compaction only merge insert and delta log beyond the timetravel
-	// segment's insert binlogs dont have time range info, so we wait until the segment's last expire time is less than timetravel
-	// to ensure that all insert logs is beyond the timetravel.
-	// TODO: add meta in insert binlog
-	if segment.LastExpireTime >= timetravel.time {
-		return false
-	}
-
-	totalDeletedRows := 0
-	totalDeleteLogSize := int64(0)
-	for _, l := range segment.GetDeltalogs() {
-		if l.TimestampTo < timetravel.time {
-			totalDeletedRows += int(l.GetRecordEntries())
-			totalDeleteLogSize += l.GetDeltaLogSize()
-		}
+	if plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		// clustering compaction rewrites many more segments than the others
+		plan.TimeoutInSeconds = maxClusteringCompactionTimeoutInSeconds
 	}
-
-	// currently delta log size and delete ratio policy is applied
-	return float32(totalDeletedRows)/float32(segment.NumOfRows) >= singleCompactionRatioThreshold || totalDeleteLogSize > singleCompactionDeltaLogMaxSize
+	return nil
 }
 
 func (t *compactionTrigger) globalSingleCompaction(segments []*SegmentInfo, isForce bool, signal *compactionSignal) []*datapb.CompactionPlan {
@@ -420,11 +576,7 @@ func (t *compactionTrigger) singleCompaction(segment *SegmentInfo, isForce bool,
 		return nil, nil
 	}
 
-	if !isForce && !t.shouldDoSingleCompaction(segment, signal.timetravel) {
-		return nil, nil
-	}
-
-	plan := t.singleCompactionPolicy.generatePlan(segment, signal.timetravel)
+	plan := t.getCompactionPolicy(segment.GetCollectionID()).generateSinglePlan(segment, signal.timetravel, isForce)
 	if plan == nil {
 		return nil, nil
 	}
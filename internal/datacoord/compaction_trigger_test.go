@@ -731,6 +731,89 @@ func Test_compactionTrigger_singleTriggerCompaction(t *testing.T) {
 	}
 }
 
+func Test_compactionTrigger_dryRunCompaction(t *testing.T) {
+	newSegment := func(id int64, deltalogs []*datapb.DeltaLogInfo) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  1,
+				PartitionID:   10,
+				InsertChannel: "test_chan_01",
+				NumOfRows:     100,
+				State:         commonpb.SegmentState_Flushed,
+				MaxRowNum:     12000,
+				Deltalogs:     deltalogs,
+			},
+		}
+	}
+	newTrigger := func(policies map[UniqueID]*datapb.CompactionPolicyInfo) (*compactionTrigger, chan *datapb.CompactionPlan) {
+		spyChan := make(chan *datapb.CompactionPlan, 10)
+		return &compactionTrigger{
+			meta: &meta{
+				segments: &SegmentsInfo{
+					map[int64]*SegmentInfo{
+						1: newSegment(1, []*datapb.DeltaLogInfo{{RecordEntries: 50, TimestampTo: 10}}),
+						2: newSegment(2, nil),
+						3: newSegment(3, nil),
+					},
+				},
+				compactionPolicies: policies,
+			},
+			allocator:                       newMockAllocator(),
+			singleCompactionPolicy:          (singleCompactionFunc)(chooseAllBinlogs),
+			mergeCompactionPolicy:           (mergeCompactionFunc)(greedyMergeCompaction),
+			compactionHandler:               &spyCompactionHandler{spyChan: spyChan},
+			mergeCompactionSegmentThreshold: 2,
+		}, spyChan
+	}
+	getSources := func(plan *datapb.CompactionPlan) []int64 {
+		ids := make([]int64, 0)
+		for _, binlogs := range plan.GetSegmentBinlogs() {
+			ids = append(ids, binlogs.GetSegmentID())
+		}
+		return ids
+	}
+
+	t.Run("test dry run the default policy", func(t *testing.T) {
+		tr, spyChan := newTrigger(nil)
+		plans := tr.dryRunCompaction(1, nil, &timetravel{100})
+		assert.Equal(t, 2, len(plans))
+		assert.Equal(t, datapb.CompactionType_InnerCompaction, plans[0].GetType())
+		assert.Equal(t, []int64{1}, getSources(plans[0]))
+		// segment 1 is not merged since it's in the single compaction plan
+		assert.Equal(t, datapb.CompactionType_MergeCompaction, plans[1].GetType())
+		assert.ElementsMatch(t, []int64{2, 3}, getSources(plans[1]))
+		// plans are not executed
+		assert.Equal(t, 0, len(spyChan))
+		assert.EqualValues(t, 0, plans[0].GetPlanID())
+	})
+
+	t.Run("test dry run the policy of collection", func(t *testing.T) {
+		tr, _ := newTrigger(map[UniqueID]*datapb.CompactionPolicyInfo{
+			1: {CollectionID: 1, Policy: noopCompactionPolicyName},
+		})
+		assert.Empty(t, tr.dryRunCompaction(1, nil, &timetravel{100}))
+	})
+
+	t.Run("test dry run another policy", func(t *testing.T) {
+		tr, _ := newTrigger(nil)
+		policy, err := newCompactionPolicy(sizeTieredCompactionPolicyName, []*commonpb.KeyValuePair{{Key: "min_threshold", Value: "3"}})
+		assert.Nil(t, err)
+		plans := tr.dryRunCompaction(1, policy, &timetravel{100})
+		assert.Equal(t, 1, len(plans))
+		assert.ElementsMatch(t, []int64{1, 2, 3}, getSources(plans[0]))
+	})
+
+	t.Run("test force compaction with the policy of collection", func(t *testing.T) {
+		tr, spyChan := newTrigger(map[UniqueID]*datapb.CompactionPolicyInfo{
+			1: {CollectionID: 1, Policy: noopCompactionPolicyName},
+		})
+		_, err := tr.forceTriggerCompaction(1, &timetravel{100})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(spyChan))
+	})
+}
+
 func Test_newCompactionTrigger(t *testing.T) {
 	type args struct {
 		meta              *meta
@@ -759,3 +842,68 @@ func Test_newCompactionTrigger(t *testing.T) {
 		})
 	}
 }
+
+func Test_compactionTrigger_deferWindows(t *testing.T) {
+	newSegment := func(id int64, deltalogs []*datapb.DeltaLogInfo) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            id,
+				CollectionID:  1,
+				PartitionID:   10,
+				InsertChannel: "test_chan_01",
+				NumOfRows:     100,
+				State:         commonpb.SegmentState_Flushed,
+				MaxRowNum:     12000,
+				Deltalogs:     deltalogs,
+			},
+		}
+	}
+	// the windows cover the whole day
+	allDay := []timeWindow{{start: 0, end: 720}, {start: 720, end: 0}}
+	newTrigger := func(windows []timeWindow) (*compactionTrigger, chan *datapb.CompactionPlan) {
+		spyChan := make(chan *datapb.CompactionPlan, 10)
+		return &compactionTrigger{
+			meta: &meta{
+				segments: &SegmentsInfo{
+					map[int64]*SegmentInfo{
+						1: newSegment(1, []*datapb.DeltaLogInfo{{RecordEntries: 50, TimestampTo: 10}}),
+						2: newSegment(2, nil),
+						3: newSegment(3, nil),
+					},
+				},
+			},
+			allocator:                       newMockAllocator(),
+			singleCompactionPolicy:          (singleCompactionFunc)(chooseAllBinlogs),
+			mergeCompactionPolicy:           (mergeCompactionFunc)(greedyMergeCompaction),
+			compactionHandler:               &spyCompactionHandler{spyChan: spyChan},
+			mergeCompactionSegmentThreshold: 2,
+			deferWindows:                    windows,
+		}, spyChan
+	}
+
+	t.Run("test global signal not deferred", func(t *testing.T) {
+		tr, spyChan := newTrigger(nil)
+		tr.handleGlobalSignal(&compactionSignal{id: 1, isGlobal: true, timetravel: &timetravel{100}})
+		assert.NotEqual(t, 0, len(spyChan))
+	})
+
+	t.Run("test global signal deferred", func(t *testing.T) {
+		tr, spyChan := newTrigger(allDay)
+		tr.handleGlobalSignal(&compactionSignal{id: 1, isGlobal: true, timetravel: &timetravel{100}})
+		assert.Equal(t, 0, len(spyChan))
+	})
+
+	t.Run("test segment signal deferred", func(t *testing.T) {
+		tr, spyChan := newTrigger(allDay)
+		tr.handleSignal(&compactionSignal{id: 1, collectionID: 1, partitionID: 10, segmentID: 1,
+			channel: "test_chan_01", timetravel: &timetravel{100}})
+		assert.Equal(t, 0, len(spyChan))
+	})
+
+	t.Run("test manual compaction not deferred", func(t *testing.T) {
+		tr, spyChan := newTrigger(allDay)
+		_, err := tr.forceTriggerCompaction(1, &timetravel{100})
+		assert.Nil(t, err)
+		assert.NotEqual(t, 0, len(spyChan))
+	})
+}
@@ -17,14 +17,13 @@
 package datacoord
 
 import (
-	"context"
 	"path"
 	"sync"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
-	"github.com/minio/minio-go/v7"
+	"github.com/milvus-io/milvus/internal/storage"
 	"go.uber.org/zap"
 )
 
@@ -37,12 +36,11 @@ const (
 
 // GcOption garbage collection options
 type GcOption struct {
-	cli              *minio.Client // OSS client
-	enabled          bool          // enable switch
-	checkInterval    time.Duration // each interval
-	missingTolerance time.Duration // key missing in meta tolerace time
-	dropTolerance    time.Duration // dropped segment related key tolerance time
-	bucketName       string
+	cli              storage.ChunkManager // OSS client
+	enabled          bool                 // enable switch
+	checkInterval    time.Duration        // each interval
+	missingTolerance time.Duration        // key missing in meta tolerace time
+	dropTolerance    time.Duration        // dropped segment related key tolerance time
 	rootPath         string
 }
 
@@ -123,21 +121,23 @@ func (gc *garbageCollector) scan() {
 	prefixes = append(prefixes, path.Join(gc.option.rootPath, deltaLogPrefix))
 
 	for _, prefix := range prefixes {
-		for info := range gc.option.cli.ListObjects(context.TODO(), gc.option.bucketName, minio.ListObjectsOptions{
-			Prefix:    prefix,
-			Recursive: true,
-		}) {
-			_, has := vm[info.Key]
+		keys, modTimes, err := gc.option.cli.ListWithPrefix(prefix)
+		if err != nil {
+			log.Warn("failed to list files", zap.String("prefix", prefix), zap.Error(err))
+			continue
+		}
+		for i, key := range keys {
+			_, has := vm[key]
 			if has {
 				v++
 				continue
 			}
 			m++
 			// not found in meta, check last modified time exceeds tolerance duration
-			if time.Since(info.LastModified) > gc.option.missingTolerance {
+			if time.Since(modTimes[i]) > gc.option.missingTolerance {
 				e++
 				// ignore error since it could be cleaned up next time
-				_ = gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, info.Key, minio.RemoveObjectOptions{})
+				_ = gc.option.cli.Remove(key)
 			}
 		}
 	}
@@ -149,6 +149,9 @@ func (gc *garbageCollector) clearEtcd() {
 		return segment.GetState() == commonpb.SegmentState_Dropped
 	})
 
+	// collections whose segments dropped with the channels are purged can't be recovered any more,
+	// so their compaction policies and histories are removed
+	droppedCollections := make(map[UniqueID]struct{})
 	for _, sinfo := range drops {
 		if !gc.isExpire(sinfo.GetDroppedAt()) {
 			continue
@@ -156,6 +159,18 @@ func (gc *garbageCollector) clearEtcd() {
 		logs := getLogs(sinfo)
 		if gc.removeLogs(logs) {
 			_ = gc.meta.DropSegment(sinfo.GetID())
+			if sinfo.GetDroppedWithChannel() {
+				droppedCollections[sinfo.GetCollectionID()] = struct{}{}
+			}
+		}
+	}
+
+	for collectionID := range droppedCollections {
+		if err := gc.meta.RemoveCompactionPolicy(collectionID); err != nil {
+			log.Warn("failed to remove compaction policy", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+		if err := gc.meta.RemoveCompactionHistories(collectionID); err != nil {
+			log.Warn("failed to remove compaction histories", zap.Int64("collectionID", collectionID), zap.Error(err))
 		}
 	}
 }
@@ -184,9 +199,9 @@ func getLogs(sinfo *SegmentInfo) []string {
 func (gc *garbageCollector) removeLogs(logs []string) bool {
 	delFlag := true
 	for _, l := range logs {
-		err := gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, l, minio.RemoveObjectOptions{})
-		errResp := minio.ToErrorResponse(err)
-		if errResp.Code != "" && errResp.Code != "NoSuchKey" {
+		// removing a missing file is not an error
+		err := gc.option.cli.Remove(l)
+		if err != nil {
 			delFlag = false
 		}
 	}
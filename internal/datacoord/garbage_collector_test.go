@@ -26,6 +26,8 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -37,8 +39,9 @@ func Test_garbageCollector_basic(t *testing.T) {
 	bucketName := `datacoord-ut` + strings.ToLower(funcutil.RandomString(8))
 	rootPath := `gc` + funcutil.RandomString(8)
 	//TODO change to Params
-	cli, _, _, _, _, err := initUtOSSEnv(bucketName, rootPath, 0)
+	_, _, _, _, _, err := initUtOSSEnv(bucketName, rootPath, 0)
 	require.NoError(t, err)
+	cm := newUtChunkManager(t, bucketName)
 
 	mockAllocator := newMockAllocator()
 	meta, err := newMemoryMeta(mockAllocator)
@@ -46,12 +49,11 @@ func Test_garbageCollector_basic(t *testing.T) {
 
 	t.Run("normal gc", func(t *testing.T) {
 		gc := newGarbageCollector(meta, GcOption{
-			cli:              cli,
+			cli:              cm,
 			enabled:          true,
 			checkInterval:    time.Millisecond * 10,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.start()
@@ -69,7 +71,6 @@ func Test_garbageCollector_basic(t *testing.T) {
 			checkInterval:    time.Millisecond * 10,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		assert.NotPanics(t, func() {
@@ -97,6 +98,7 @@ func Test_garbageCollector_scan(t *testing.T) {
 	//TODO change to Params
 	cli, inserts, stats, delta, others, err := initUtOSSEnv(bucketName, rootPath, 4)
 	require.NoError(t, err)
+	cm := newUtChunkManager(t, bucketName)
 
 	mockAllocator := newMockAllocator()
 	meta, err := newMemoryMeta(mockAllocator)
@@ -104,12 +106,11 @@ func Test_garbageCollector_scan(t *testing.T) {
 
 	t.Run("missing all but save tolerance", func(t *testing.T) {
 		gc := newGarbageCollector(meta, GcOption{
-			cli:              cli,
+			cli:              cm,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.scan()
@@ -131,12 +132,11 @@ func Test_garbageCollector_scan(t *testing.T) {
 		require.NoError(t, err)
 
 		gc := newGarbageCollector(meta, GcOption{
-			cli:              cli,
+			cli:              cm,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.start()
@@ -160,12 +160,11 @@ func Test_garbageCollector_scan(t *testing.T) {
 		require.NoError(t, err)
 
 		gc := newGarbageCollector(meta, GcOption{
-			cli:              cli,
+			cli:              cm,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    0,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.clearEtcd()
@@ -176,14 +175,59 @@ func Test_garbageCollector_scan(t *testing.T) {
 
 		gc.close()
 	})
+	t.Run("dropped with collection", func(t *testing.T) {
+		// dropped by compaction
+		compacted := buildSegment(2, 20, 200, "ch2")
+		compacted.State = commonpb.SegmentState_Dropped
+		compacted.DroppedAt = uint64(time.Now().Add(-time.Hour).UnixNano())
+		err = meta.AddSegment(compacted)
+		require.NoError(t, err)
+		// dropped with the channel
+		dropped := buildSegment(3, 30, 300, "ch3")
+		dropped.State = commonpb.SegmentState_Dropped
+		dropped.DroppedAt = uint64(time.Now().Add(-time.Hour).UnixNano())
+		dropped.DroppedWithChannel = true
+		err = meta.AddSegment(dropped)
+		require.NoError(t, err)
+
+		err = meta.SetCompactionPolicy(&datapb.CompactionPolicyInfo{CollectionID: 2, Policy: noopCompactionPolicyName})
+		require.NoError(t, err)
+		err = meta.SetCompactionPolicy(&datapb.CompactionPolicyInfo{CollectionID: 3, Policy: noopCompactionPolicyName})
+		require.NoError(t, err)
+		err = meta.AddCompactionHistory(&milvuspb.CompactionHistory{PlanID: 1, CollectionID: 3, CompleteTime: 1})
+		require.NoError(t, err)
+
+		gc := newGarbageCollector(meta, GcOption{
+			cli:              cm,
+			enabled:          true,
+			checkInterval:    time.Minute * 30,
+			missingTolerance: time.Hour * 24,
+			dropTolerance:    time.Hour * 24,
+			rootPath:         rootPath,
+		})
+		// kept within the drop tolerance
+		gc.clearEtcd()
+		assert.NotNil(t, meta.GetSegment(300))
+		assert.NotNil(t, meta.GetCompactionPolicy(3))
+		assert.Equal(t, 1, len(meta.GetCompactionHistories(3, 0)))
+
+		gc.option.dropTolerance = 0
+		gc.clearEtcd()
+		assert.Nil(t, meta.GetSegment(200))
+		assert.Nil(t, meta.GetSegment(300))
+		assert.NotNil(t, meta.GetCompactionPolicy(2))
+		assert.Nil(t, meta.GetCompactionPolicy(3))
+		assert.Empty(t, meta.GetCompactionHistories(3, 0))
+
+		gc.close()
+	})
 	t.Run("missing gc all", func(t *testing.T) {
 		gc := newGarbageCollector(meta, GcOption{
-			cli:              cli,
+			cli:              cm,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
 			missingTolerance: 0,
 			dropTolerance:    0,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.start()
@@ -200,6 +244,18 @@ func Test_garbageCollector_scan(t *testing.T) {
 	cleanupOSS(cli, bucketName, rootPath)
 }
 
+func newUtChunkManager(t *testing.T, bucket string) storage.ChunkManager {
+	cm, err := storage.NewChunkManager(context.TODO(), &storage.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		BucketName:        bucket,
+	})
+	require.NoError(t, err)
+	return cm
+}
+
 // initialize unit test sso env
 func initUtOSSEnv(bucket, root string, n int) (cli *minio.Client, inserts []string, stats []string, delta []string, other []string, err error) {
 	Params.Init()
@@ -19,6 +19,7 @@ package datacoord
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -30,16 +31,22 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
 const (
-	metaPrefix           = "datacoord-meta"
-	segmentPrefix        = metaPrefix + "/s"
-	channelRemovePrefix  = metaPrefix + "/channel-removal"
-	handoffSegmentPrefix = "querycoord-handoff"
+	metaPrefix              = "datacoord-meta"
+	segmentPrefix           = metaPrefix + "/s"
+	channelRemovePrefix     = metaPrefix + "/channel-removal"
+	compactionPolicyPrefix  = metaPrefix + "/compaction-policy"
+	compactionHistoryPrefix = metaPrefix + "/compaction-history"
+	handoffSegmentPrefix    = "querycoord-handoff"
 
 	removeFlagTomestone = "removed"
+
+	// maxCompactionHistoryNum is the max number of compaction histories kept for each collection
+	maxCompactionHistoryNum = 100
 )
 
 type meta struct {
@@ -47,6 +54,9 @@ type meta struct {
 	client      kv.TxnKV                            // client of a reliable kv service, i.e. etcd client
 	collections map[UniqueID]*datapb.CollectionInfo // collection id to collection info
 	segments    *SegmentsInfo                       // segment id to segment info
+
+	compactionPolicies  map[UniqueID]*datapb.CompactionPolicyInfo  // collection id to the compaction policy it chooses
+	compactionHistories map[UniqueID][]*milvuspb.CompactionHistory // collection id to the histories of completed compactions, oldest first
 }
 
 // NewMeta create meta from provided `kv.TxnKV`
@@ -55,6 +65,9 @@ func newMeta(kv kv.TxnKV) (*meta, error) {
 		client:      kv,
 		collections: make(map[UniqueID]*datapb.CollectionInfo),
 		segments:    NewSegmentsInfo(),
+
+		compactionPolicies:  make(map[UniqueID]*datapb.CompactionPolicyInfo),
+		compactionHistories: make(map[UniqueID][]*milvuspb.CompactionHistory),
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -79,6 +92,35 @@ func (m *meta) reloadFromKV() error {
 		m.segments.SetSegment(segmentInfo.GetID(), NewSegmentInfo(segmentInfo))
 	}
 
+	_, values, err = m.client.LoadWithPrefix(compactionPolicyPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		policy := &datapb.CompactionPolicyInfo{}
+		if err := proto.Unmarshal([]byte(value), policy); err != nil {
+			return fmt.Errorf("DataCoord reloadFromKV UnMarshal datapb.CompactionPolicyInfo err:%w", err)
+		}
+		m.compactionPolicies[policy.GetCollectionID()] = policy
+	}
+
+	_, values, err = m.client.LoadWithPrefix(compactionHistoryPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		history := &milvuspb.CompactionHistory{}
+		if err := proto.Unmarshal([]byte(value), history); err != nil {
+			return fmt.Errorf("DataCoord reloadFromKV UnMarshal milvuspb.CompactionHistory err:%w", err)
+		}
+		m.compactionHistories[history.GetCollectionID()] = append(m.compactionHistories[history.GetCollectionID()], history)
+	}
+	for _, histories := range m.compactionHistories {
+		sort.Slice(histories, func(i, j int) bool {
+			return histories[i].GetCompleteTime() < histories[j].GetCompleteTime()
+		})
+	}
+
 	return nil
 }
 
@@ -101,6 +143,99 @@ func (m *meta) GetCollection(collectionID UniqueID) *datapb.CollectionInfo {
 	return collection
 }
 
+// SetCompactionPolicy saves the compaction policy chosen by a collection into etcd and the local cache
+func (m *meta) SetCompactionPolicy(policy *datapb.CompactionPolicyInfo) error {
+	m.Lock()
+	defer m.Unlock()
+	value, err := proto.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("DataCoord SetCompactionPolicy marshal failed: %w", err)
+	}
+	if err := m.client.Save(buildCompactionPolicyPath(policy.GetCollectionID()), string(value)); err != nil {
+		return err
+	}
+	if m.compactionPolicies == nil {
+		m.compactionPolicies = make(map[UniqueID]*datapb.CompactionPolicyInfo)
+	}
+	m.compactionPolicies[policy.GetCollectionID()] = policy
+	return nil
+}
+
+// RemoveCompactionPolicy removes the compaction policy chosen by a dropped collection from etcd and the local cache
+func (m *meta) RemoveCompactionPolicy(collectionID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.client.Remove(buildCompactionPolicyPath(collectionID)); err != nil {
+		return err
+	}
+	delete(m.compactionPolicies, collectionID)
+	return nil
+}
+
+// GetCompactionPolicy returns the compaction policy chosen by a collection, nil if the collection never chooses one
+func (m *meta) GetCompactionPolicy(collectionID UniqueID) *datapb.CompactionPolicyInfo {
+	m.RLock()
+	defer m.RUnlock()
+	return m.compactionPolicies[collectionID]
+}
+
+// AddCompactionHistory saves the history of a completed compaction,
+// the oldest histories of the collection are removed if there're more than maxCompactionHistoryNum
+func (m *meta) AddCompactionHistory(history *milvuspb.CompactionHistory) error {
+	m.Lock()
+	defer m.Unlock()
+	value, err := proto.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("DataCoord AddCompactionHistory marshal failed: %w", err)
+	}
+	if m.compactionHistories == nil {
+		m.compactionHistories = make(map[UniqueID][]*milvuspb.CompactionHistory)
+	}
+
+	histories := append(m.compactionHistories[history.GetCollectionID()], history)
+	var removals []string
+	for len(histories) > maxCompactionHistoryNum {
+		removals = append(removals, buildCompactionHistoryPath(histories[0].GetCollectionID(), histories[0].GetPlanID()))
+		histories = histories[1:]
+	}
+	saves := map[string]string{
+		buildCompactionHistoryPath(history.GetCollectionID(), history.GetPlanID()): string(value),
+	}
+	if err := m.client.MultiSaveAndRemove(saves, removals); err != nil {
+		return err
+	}
+	m.compactionHistories[history.GetCollectionID()] = histories
+	return nil
+}
+
+// RemoveCompactionHistories removes the histories of completed compactions of a dropped collection
+// from etcd and the local cache
+func (m *meta) RemoveCompactionHistories(collectionID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.client.RemoveWithPrefix(buildCompactionHistoryCollectionPath(collectionID)); err != nil {
+		return err
+	}
+	delete(m.compactionHistories, collectionID)
+	return nil
+}
+
+// GetCompactionHistories returns the latest limit histories of completed compactions of a collection,
+// latest first, all the histories are returned if limit is not positive
+func (m *meta) GetCompactionHistories(collectionID UniqueID, limit int) []*milvuspb.CompactionHistory {
+	m.RLock()
+	defer m.RUnlock()
+	histories := m.compactionHistories[collectionID]
+	if limit <= 0 || limit > len(histories) {
+		limit = len(histories)
+	}
+	ret := make([]*milvuspb.CompactionHistory, 0, limit)
+	for i := len(histories) - 1; i >= len(histories)-limit; i-- {
+		ret = append(ret, histories[i])
+	}
+	return ret
+}
+
 type chanPartSegments struct {
 	collecionID UniqueID
 	partitionID UniqueID
@@ -344,10 +479,13 @@ func (m *meta) UpdateDropChannelSegmentInfo(channel string, segments []*SegmentI
 	defer m.Unlock()
 
 	modSegments := make(map[UniqueID]*SegmentInfo)
+	droppedAt := uint64(time.Now().UnixNano())
 
 	for _, seg2Drop := range segments {
 		segment := m.mergeDropSegment(seg2Drop)
 		if segment != nil {
+			segment.DroppedAt = droppedAt
+			segment.DroppedWithChannel = true
 			modSegments[seg2Drop.GetID()] = segment
 		}
 	}
@@ -358,9 +496,12 @@ func (m *meta) UpdateDropChannelSegmentInfo(channel string, segments []*SegmentI
 		}
 		_, ok := modSegments[seg.ID]
 		// seg inf mod segments are all in dropped state
-		if !ok {
+		// segments already dropped by compaction keep their drop time and shall not be recovered with the channel
+		if !ok && seg.GetState() != commonpb.SegmentState_Dropped {
 			clonedSeg := seg.Clone()
 			clonedSeg.State = commonpb.SegmentState_Dropped
+			clonedSeg.DroppedAt = droppedAt
+			clonedSeg.DroppedWithChannel = true
 			modSegments[seg.ID] = clonedSeg
 		}
 	}
@@ -423,6 +564,9 @@ func (m *meta) mergeDropSegment(seg2Drop *SegmentInfo) *SegmentInfo {
 	if seg2Drop.GetDmlPosition() != nil {
 		clonedSegment.DmlPosition = seg2Drop.GetDmlPosition()
 	}
+	if seg2Drop.GetNumOfRows() > 0 {
+		clonedSegment.NumOfRows = seg2Drop.GetNumOfRows()
+	}
 	clonedSegment.currRows = seg2Drop.currRows
 	return clonedSegment
 }
@@ -491,6 +635,70 @@ func (m *meta) saveDropSegmentAndRemove(channel string, modSegments map[int64]*S
 	return nil
 }
 
+// RecoverDroppedSegments restores the segments dropped together with the channels of a collection
+// segments with binlogs are flushed again with checkpoints moved to the new start positions of the collection,
+// so that the drop collection message will not be consumed again once the channels are watched
+// segments without binlogs stay dropped and are left to garbage collection
+func (m *meta) RecoverDroppedSegments(collection *datapb.CollectionInfo, dropTolerance time.Duration) error {
+	m.Lock()
+	defer m.Unlock()
+
+	modSegments := make(map[UniqueID]*SegmentInfo)
+	for _, segment := range m.segments.segments {
+		if segment.GetCollectionID() != collection.GetID() ||
+			segment.GetState() != commonpb.SegmentState_Dropped || !segment.GetDroppedWithChannel() {
+			continue
+		}
+		if time.Since(time.Unix(0, int64(segment.GetDroppedAt()))) > dropTolerance {
+			return fmt.Errorf("segment %d of collection %d is dropped longer than %v", segment.GetID(), collection.GetID(), dropTolerance)
+		}
+		cloned := segment.Clone()
+		cloned.DroppedWithChannel = false
+		if len(cloned.GetBinlogs()) > 0 {
+			position := getCollectionStartPosition(cloned.GetInsertChannel(), collection)
+			if position == nil {
+				return fmt.Errorf("start position of channel %s not found in collection %d", cloned.GetInsertChannel(), collection.GetID())
+			}
+			cloned.State = commonpb.SegmentState_Flushed
+			cloned.DroppedAt = 0
+			cloned.DmlPosition = position
+		}
+		modSegments[segment.GetID()] = cloned
+	}
+
+	// the limitation of etcd operations number per transaction is 128, so the saves are split into batches
+	kv := make(map[string]string)
+	update := make([]*SegmentInfo, 0, maxOperationsPerTxn)
+	flush := func() error {
+		if err := m.saveKvTxn(kv); err != nil {
+			return err
+		}
+		for _, s := range update {
+			m.segments.SetSegment(s.GetID(), s)
+		}
+		kv = make(map[string]string)
+		update = update[:0]
+		return nil
+	}
+	for _, s := range modSegments {
+		key, value, err := m.marshal(s)
+		if err != nil {
+			return err
+		}
+		kv[key] = value
+		update = append(update, s)
+		if len(kv) == maxOperationsPerTxn {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(kv) > 0 {
+		return flush()
+	}
+	return nil
+}
+
 // FinishRemoveChannel removes channel remove flag after whole procedure is finished
 func (m *meta) FinishRemoveChannel(channel string) error {
 	key := buildChannelRemovePath(channel)
@@ -690,10 +898,9 @@ func (m *meta) SetSegmentCompacting(segmentID UniqueID, compacting bool) {
 	m.segments.SetIsCompacting(segmentID, compacting)
 }
 
-func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) error {
-	m.Lock()
-	defer m.Unlock()
-
+// compactedSegments clones the segments compacted by a plan as dropped, and returns them with the positions covering them all
+// and the delta logs added to them while compacting
+func (m *meta) compactedSegments(compactionLogs []*datapb.CompactionSegmentBinlogs) ([]*SegmentInfo, *internalpb.MsgPosition, *internalpb.MsgPosition, []*datapb.DeltaLogInfo) {
 	segments := make([]*SegmentInfo, 0, len(compactionLogs))
 	for _, cl := range compactionLogs {
 		if segment := m.segments.GetSegment(cl.GetSegmentID()); segment != nil {
@@ -727,6 +934,14 @@ func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmen
 	}
 
 	newAddedDeltalogs := m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
+	return segments, startPosition, dmlPosition, newAddedDeltalogs
+}
+
+func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segments, startPosition, dmlPosition, newAddedDeltalogs := m.compactedSegments(compactionLogs)
 	deltalogs := append(result.GetDeltalogs(), newAddedDeltalogs...)
 
 	compactionFrom := make([]UniqueID, 0, len(segments))
@@ -781,6 +996,76 @@ func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmen
 	return nil
 }
 
+// CompleteClusteringCompaction drops the compacted segments and adds the segments written by clustering compaction.
+// All the new segments record the segments compacted from and each other, so that querycoord releases the compacted
+// segments only after all the new segments are handed off.
+func (m *meta) CompleteClusteringCompaction(compactionLogs []*datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segments, startPosition, dmlPosition, newAddedDeltalogs := m.compactedSegments(compactionLogs)
+	if len(segments) == 0 {
+		return fmt.Errorf("segments of clustering compaction are not found")
+	}
+
+	compactionFrom := make([]UniqueID, 0, len(segments))
+	for _, s := range segments {
+		compactionFrom = append(compactionFrom, s.GetID())
+	}
+
+	compactionOutputs := make([]UniqueID, 0, len(result.GetSegments()))
+	for _, cs := range result.GetSegments() {
+		compactionOutputs = append(compactionOutputs, cs.GetSegmentID())
+	}
+
+	newSegments := make([]*SegmentInfo, 0, len(result.GetSegments()))
+	for _, cs := range result.GetSegments() {
+		segment := &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:                  cs.GetSegmentID(),
+				CollectionID:        segments[0].CollectionID,
+				PartitionID:         segments[0].PartitionID,
+				InsertChannel:       segments[0].InsertChannel,
+				NumOfRows:           cs.GetNumOfRows(),
+				State:               commonpb.SegmentState_Flushing,
+				MaxRowNum:           segments[0].MaxRowNum,
+				Binlogs:             cs.GetInsertLogs(),
+				Statslogs:           cs.GetField2StatslogPaths(),
+				Deltalogs:           append(cs.GetDeltalogs(), newAddedDeltalogs...),
+				StartPosition:       startPosition,
+				DmlPosition:         dmlPosition,
+				CreatedByCompaction: true,
+				CompactionFrom:      compactionFrom,
+				ClusteringKeyRange:  cs.GetClusteringKeyRange(),
+				CompactionOutputs:   compactionOutputs,
+			},
+			isCompacting: false,
+		}
+		newSegments = append(newSegments, segment)
+	}
+
+	data := make(map[string]string)
+	for _, s := range append(segments, newSegments...) {
+		k, v, err := m.marshal(s)
+		if err != nil {
+			return err
+		}
+		data[k] = v
+	}
+
+	if err := m.saveKvTxn(data); err != nil {
+		return err
+	}
+
+	for _, s := range segments {
+		m.segments.DropSegment(s.GetID())
+	}
+	for _, s := range newSegments {
+		m.segments.SetSegment(s.GetID(), s)
+	}
+	return nil
+}
+
 func (m *meta) CompleteInnerCompaction(segmentBinlogs *datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) error {
 	m.Lock()
 	defer m.Unlock()
@@ -896,6 +1181,7 @@ func (m *meta) saveSegmentInfo(segment *SegmentInfo) error {
 			SegmentState:        querypb.SegmentState_sealed,
 			CreatedByCompaction: segment.GetCreatedByCompaction(),
 			CompactionFrom:      segment.GetCompactionFrom(),
+			CompactionOutputs:   segment.GetCompactionOutputs(),
 		}
 		handoffSegBytes, err := proto.Marshal(handoffSegmentInfo)
 		if err != nil {
@@ -931,6 +1217,21 @@ func buildQuerySegmentPath(collectionID UniqueID, partitionID UniqueID, segmentI
 	return fmt.Sprintf("%s/%d/%d/%d", handoffSegmentPrefix, collectionID, partitionID, segmentID)
 }
 
+// buildCompactionPolicyPath builds the key of the compaction policy of a collection
+func buildCompactionPolicyPath(collectionID UniqueID) string {
+	return fmt.Sprintf("%s/%d", compactionPolicyPrefix, collectionID)
+}
+
+// buildCompactionHistoryPath builds the key of the history of a compaction plan
+func buildCompactionHistoryPath(collectionID UniqueID, planID UniqueID) string {
+	return fmt.Sprintf("%s%d", buildCompactionHistoryCollectionPath(collectionID), planID)
+}
+
+// buildCompactionHistoryCollectionPath builds the prefix of the compaction histories of a collection
+func buildCompactionHistoryCollectionPath(collectionID UniqueID) string {
+	return fmt.Sprintf("%s/%d/", compactionHistoryPrefix, collectionID)
+}
+
 // buildChannelRemovePat builds vchannel remove flag path
 func buildChannelRemovePath(channel string) string {
 	return fmt.Sprintf("%s/%s", channelRemovePrefix, channel)
@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/kv"
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -417,6 +419,96 @@ func Test_meta_CompleteMergeCompaction(t *testing.T) {
 	}
 }
 
+func Test_meta_CompleteClusteringCompaction(t *testing.T) {
+	newMeta := func() *meta {
+		return &meta{
+			client: memkv.NewMemoryKV(),
+			segments: &SegmentsInfo{map[int64]*SegmentInfo{
+				1: {SegmentInfo: &datapb.SegmentInfo{
+					ID:           1,
+					CollectionID: 100,
+					PartitionID:  10,
+					MaxRowNum:    100,
+					Binlogs:      []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}},
+					Deltalogs:    []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog1"}},
+				}},
+				2: {SegmentInfo: &datapb.SegmentInfo{
+					ID:           2,
+					CollectionID: 100,
+					PartitionID:  10,
+					MaxRowNum:    100,
+					Binlogs:      []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}},
+					Deltalogs:    []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog2"}, {DeltaLogPath: "deltalog3"}},
+				}},
+			}},
+		}
+	}
+	compactionLogs := []*datapb.CompactionSegmentBinlogs{
+		{
+			SegmentID:    1,
+			FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}},
+			Deltalogs:    []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog1"}},
+		},
+		{
+			SegmentID:    2,
+			FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}},
+			Deltalogs:    []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog2"}},
+		},
+	}
+	result := &datapb.CompactionResult{
+		Segments: []*datapb.CompactionSegment{
+			{
+				SegmentID:          3,
+				NumOfRows:          100,
+				InsertLogs:         []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log4"}}},
+				ClusteringKeyRange: &datapb.ClusteringKeyRange{FieldID: 101, MinInt: 0, MaxInt: 9},
+			},
+			{
+				SegmentID:          4,
+				NumOfRows:          50,
+				InsertLogs:         []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log5"}}},
+				Deltalogs:          []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog4"}},
+				ClusteringKeyRange: &datapb.ClusteringKeyRange{FieldID: 101, MinInt: 10, MaxInt: 19},
+			},
+		},
+	}
+
+	t.Run("test normal clustering", func(t *testing.T) {
+		m := newMeta()
+		err := m.CompleteClusteringCompaction(compactionLogs, result)
+		assert.Nil(t, err)
+
+		assert.Nil(t, m.GetSegment(1))
+		assert.Nil(t, m.GetSegment(2))
+
+		segment := m.GetSegment(3)
+		assert.NotNil(t, segment)
+		assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+		assert.EqualValues(t, 100, segment.GetNumOfRows())
+		assert.EqualValues(t, 100, segment.GetCollectionID())
+		assert.EqualValues(t, result.Segments[0].GetClusteringKeyRange(), segment.GetClusteringKeyRange())
+		// deltalog3 is added during the compaction
+		assert.EqualValues(t, []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog3"}}, segment.GetDeltalogs())
+		// all the new segments are compacted from the origin segments
+		assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+		assert.ElementsMatch(t, []UniqueID{3, 4}, segment.GetCompactionOutputs())
+
+		segment = m.GetSegment(4)
+		assert.NotNil(t, segment)
+		assert.EqualValues(t, 50, segment.GetNumOfRows())
+		assert.EqualValues(t, []*datapb.DeltaLogInfo{{DeltaLogPath: "deltalog4"}, {DeltaLogPath: "deltalog3"}}, segment.GetDeltalogs())
+		assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+		assert.ElementsMatch(t, []UniqueID{3, 4}, segment.GetCompactionOutputs())
+	})
+
+	t.Run("test segments not found", func(t *testing.T) {
+		m := &meta{client: memkv.NewMemoryKV(), segments: NewSegmentsInfo()}
+		err := m.CompleteClusteringCompaction(compactionLogs, result)
+		assert.NotNil(t, err)
+		assert.Nil(t, m.GetSegment(3))
+	})
+}
+
 func Test_meta_CompleteInnerCompaction(t *testing.T) {
 	type fields struct {
 		client      kv.TxnKV
@@ -493,6 +585,158 @@ func Test_meta_CompleteInnerCompaction(t *testing.T) {
 	}
 }
 
+func Test_meta_SetCompactionPolicy(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, err := newMeta(kv)
+	assert.Nil(t, err)
+	assert.Nil(t, m.GetCompactionPolicy(1))
+
+	policy := &datapb.CompactionPolicyInfo{
+		CollectionID: 1,
+		Policy:       timeWindowCompactionPolicyName,
+		Params:       []*commonpb.KeyValuePair{{Key: "window", Value: "3600"}},
+	}
+	err = m.SetCompactionPolicy(policy)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(policy, m.GetCompactionPolicy(1)))
+
+	// the policy is reloaded from kv
+	m, err = newMeta(kv)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(policy, m.GetCompactionPolicy(1)))
+	assert.Nil(t, m.GetCompactionPolicy(2))
+
+	// the policy of collection 10 isn't removed with collection 1
+	err = m.SetCompactionPolicy(&datapb.CompactionPolicyInfo{CollectionID: 10, Policy: noopCompactionPolicyName})
+	assert.Nil(t, err)
+	err = m.RemoveCompactionPolicy(1)
+	assert.Nil(t, err)
+	assert.Nil(t, m.GetCompactionPolicy(1))
+	m, err = newMeta(kv)
+	assert.Nil(t, err)
+	assert.Nil(t, m.GetCompactionPolicy(1))
+	assert.NotNil(t, m.GetCompactionPolicy(10))
+}
+
+func Test_meta_CompactionHistory(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, err := newMeta(kv)
+	assert.Nil(t, err)
+	assert.Empty(t, m.GetCompactionHistories(1, 0))
+
+	for i := 1; i <= maxCompactionHistoryNum+1; i++ {
+		err = m.AddCompactionHistory(&milvuspb.CompactionHistory{PlanID: int64(i), CollectionID: 1, CompleteTime: uint64(i)})
+		assert.Nil(t, err)
+	}
+	err = m.AddCompactionHistory(&milvuspb.CompactionHistory{PlanID: 1000, CollectionID: 2, CompleteTime: 1})
+	assert.Nil(t, err)
+
+	// the oldest history is removed
+	histories := m.GetCompactionHistories(1, 0)
+	assert.Equal(t, maxCompactionHistoryNum, len(histories))
+	assert.EqualValues(t, maxCompactionHistoryNum+1, histories[0].GetPlanID())
+	assert.EqualValues(t, 2, histories[len(histories)-1].GetPlanID())
+	value, err := kv.Load(buildCompactionHistoryPath(1, 1))
+	assert.Nil(t, err)
+	assert.Empty(t, value)
+
+	histories = m.GetCompactionHistories(1, 2)
+	assert.Equal(t, 2, len(histories))
+	assert.EqualValues(t, maxCompactionHistoryNum+1, histories[0].GetPlanID())
+	assert.EqualValues(t, maxCompactionHistoryNum, histories[1].GetPlanID())
+
+	// the histories are reloaded from kv
+	m, err = newMeta(kv)
+	assert.Nil(t, err)
+	histories = m.GetCompactionHistories(1, 1)
+	assert.Equal(t, 1, len(histories))
+	assert.EqualValues(t, maxCompactionHistoryNum+1, histories[0].GetPlanID())
+	assert.Equal(t, maxCompactionHistoryNum, len(m.GetCompactionHistories(1, 0)))
+	assert.Equal(t, 1, len(m.GetCompactionHistories(2, 0)))
+
+	// the histories of collection 10 aren't removed with collection 1
+	err = m.AddCompactionHistory(&milvuspb.CompactionHistory{PlanID: 2000, CollectionID: 10, CompleteTime: 1})
+	assert.Nil(t, err)
+	err = m.RemoveCompactionHistories(1)
+	assert.Nil(t, err)
+	assert.Empty(t, m.GetCompactionHistories(1, 0))
+	m, err = newMeta(kv)
+	assert.Nil(t, err)
+	assert.Empty(t, m.GetCompactionHistories(1, 0))
+	assert.Equal(t, 1, len(m.GetCompactionHistories(2, 0)))
+	assert.Equal(t, 1, len(m.GetCompactionHistories(10, 0)))
+}
+
+func Test_meta_RecoverDroppedSegments(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, err := newMeta(kv)
+	assert.Nil(t, err)
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 1, InsertChannel: "ch_0", State: commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log1"}}}},
+		{ID: 2, CollectionID: 1, InsertChannel: "ch_0", State: commonpb.SegmentState_Growing},
+		{ID: 3, CollectionID: 1, InsertChannel: "ch_0", State: commonpb.SegmentState_Dropped,
+			DroppedAt: uint64(time.Now().UnixNano()), Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log3"}}}},
+		{ID: 4, CollectionID: 2, InsertChannel: "ch_1", State: commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log4"}}}},
+	}
+	for _, segment := range segments {
+		err = m.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+	}
+	err = m.UpdateDropChannelSegmentInfo("ch_0", []*SegmentInfo{
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 2, NumOfRows: 10,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}}}),
+	})
+	assert.Nil(t, err)
+
+	// segment dropped by compaction is not marked
+	assert.False(t, m.GetSegment(3).GetDroppedWithChannel())
+	for _, id := range []UniqueID{1, 2} {
+		segment := m.GetSegment(id)
+		assert.Equal(t, commonpb.SegmentState_Dropped, segment.GetState())
+		assert.True(t, segment.GetDroppedWithChannel())
+		assert.NotZero(t, segment.GetDroppedAt())
+	}
+	assert.EqualValues(t, 10, m.GetSegment(2).GetNumOfRows())
+
+	collection := &datapb.CollectionInfo{
+		ID: 1,
+		StartPositions: []*commonpb.KeyDataPair{
+			{Key: "ch", Data: []byte{1, 2, 3}},
+		},
+	}
+	// recovery is rejected beyond the drop tolerance
+	err = m.RecoverDroppedSegments(collection, 0)
+	assert.NotNil(t, err)
+	assert.Equal(t, commonpb.SegmentState_Dropped, m.GetSegment(1).GetState())
+
+	err = m.RecoverDroppedSegments(collection, time.Hour)
+	assert.Nil(t, err)
+	for _, id := range []UniqueID{1, 2} {
+		segment := m.GetSegment(id)
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.False(t, segment.GetDroppedWithChannel())
+		assert.Zero(t, segment.GetDroppedAt())
+		assert.Equal(t, []byte{1, 2, 3}, segment.GetDmlPosition().GetMsgID())
+	}
+	assert.Equal(t, commonpb.SegmentState_Dropped, m.GetSegment(3).GetState())
+	assert.Equal(t, commonpb.SegmentState_Flushed, m.GetSegment(4).GetState())
+
+	// the recovered segments are reloaded from kv
+	m, err = newMeta(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.SegmentState_Flushed, m.GetSegment(2).GetState())
+	assert.False(t, m.GetSegment(2).GetDroppedWithChannel())
+
+	// the start position of the channel is required
+	err = m.UpdateDropChannelSegmentInfo("ch_1", nil)
+	assert.Nil(t, err)
+	err = m.RecoverDroppedSegments(&datapb.CollectionInfo{ID: 2}, time.Hour)
+	assert.NotNil(t, err)
+}
+
 func Test_meta_SetSegmentCompacting(t *testing.T) {
 	type fields struct {
 		client   kv.TxnKV
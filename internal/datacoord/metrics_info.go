@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"errors"
+	"sort"
 
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
@@ -87,6 +88,55 @@ func (s *Server) getSystemInfoMetrics(
 	return resp, nil
 }
 
+// getChannelAssignmentMetrics composes the channels assigned to data nodes by channel manager
+func (s *Server) getChannelAssignmentMetrics() (*milvuspb.GetMetricsResponse, error) {
+	toAssignments := func(channels []*channel) []metricsinfo.ChannelAssignment {
+		ret := make([]metricsinfo.ChannelAssignment, 0, len(channels))
+		for _, ch := range channels {
+			ret = append(ret, metricsinfo.ChannelAssignment{
+				Name:         ch.Name,
+				CollectionID: ch.CollectionID,
+			})
+		}
+		return ret
+	}
+
+	assignments := metricsinfo.ChannelAssignments{
+		Name:   metricsinfo.ConstructComponentName(typeutil.DataCoordRole, Params.NodeID),
+		Nodes:  make([]metricsinfo.NodeChannelAssignments, 0),
+		Buffer: make([]metricsinfo.ChannelAssignment, 0),
+	}
+	for _, info := range s.channelManager.GetChannels() {
+		assignments.Nodes = append(assignments.Nodes, metricsinfo.NodeChannelAssignments{
+			NodeID:   info.NodeID,
+			Channels: toAssignments(info.Channels),
+		})
+	}
+	sort.Slice(assignments.Nodes, func(i, j int) bool {
+		return assignments.Nodes[i].NodeID < assignments.Nodes[j].NodeID
+	})
+	if buffer := s.channelManager.GetBuffer(); buffer != nil {
+		assignments.Buffer = toAssignments(buffer.Channels)
+	}
+
+	resp := &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+		Response:      "",
+		ComponentName: assignments.Name,
+	}
+	var err error
+	resp.Response, err = metricsinfo.MarshalComponentInfos(assignments)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 // getDataCoordMetrics composes datacoord infos
 func (s *Server) getDataCoordMetrics() metricsinfo.DataCoordInfos {
 	ret := metricsinfo.DataCoordInfos{
@@ -222,6 +222,18 @@ func (m *mockRootCoordService) AlterAlias(ctx context.Context, req *milvuspb.Alt
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) RecoverCollection(ctx context.Context, req *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) RecoverPartition(ctx context.Context, req *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func newMockRootCoordService() *mockRootCoordService {
 	return &mockRootCoordService{state: internalpb.StateCode_Healthy}
 }
@@ -565,6 +577,16 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64, tt *t
 	panic("not implemented")
 }
 
+// dryRunCompaction returns the plans the policy would generate for a collection without executing them
+func (t *mockCompactionTrigger) dryRunCompaction(collectionID int64, policy compactionPolicy, tt *timetravel) []*datapb.CompactionPlan {
+	if f, ok := t.methods["dryRunCompaction"]; ok {
+		if ff, ok := f.(func(collectionID int64, policy compactionPolicy, tt *timetravel) []*datapb.CompactionPlan); ok {
+			return ff(collectionID, policy, tt)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
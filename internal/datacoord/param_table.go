@@ -17,11 +17,13 @@
 package datacoord
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -44,12 +46,18 @@ type ParamTable struct {
 	CollectionBinlogSubPath string
 	ChannelWatchSubPath     string
 
+	// --- Metastore ---
+	MetaStoreType      string
+	MetaStoreSQLDriver string
+	MetaStoreSQLDSN    string
+
 	// --- MinIO ---
 	MinioAddress         string
 	MinioAccessKeyID     string
 	MinioSecretAccessKey string
 	MinioUseSSL          bool
 	MinioBucketName      string
+	Storage              paramtable.StorageConfig
 	MinioRootPath        string
 
 	// --- Pulsar ---
@@ -81,6 +89,8 @@ type ParamTable struct {
 	EnableGarbageCollection bool
 
 	CompactionRetentionDuration int64
+	// daily windows of local time in which automatic compactions are deferred
+	CompactionDeferWindows []timeWindow
 
 	// Garbage Collection
 	GCInterval         time.Duration
@@ -105,6 +115,7 @@ func (p *ParamTable) Init() {
 	p.initSegmentBinlogSubPath()
 	p.initCollectionBinlogSubPath()
 	p.initChannelWatchPrefix()
+	p.initMetaStore()
 
 	p.initPulsarAddress()
 	p.initRocksmqPath()
@@ -132,9 +143,11 @@ func (p *ParamTable) Init() {
 	p.initMinioSecretAccessKey()
 	p.initMinioUseSSL()
 	p.initMinioBucketName()
+	p.initStorage()
 	p.initMinioRootPath()
 
 	p.initCompactionRetentionDuration()
+	p.initCompactionDeferWindows()
 
 	p.initEnableGarbageCollection()
 	p.initGCInterval()
@@ -197,6 +210,16 @@ func (p *ParamTable) initKvRootPath() {
 	p.KvRootPath = rootPath + "/" + subPath
 }
 
+// the metastore of dataCoord falls back to the global one if not set
+func (p *ParamTable) initMetaStore() {
+	p.MetaStoreType = p.LoadWithDefault("dataCoord.metastore", p.LoadWithDefault("metastore.type", kv.MetaStoreEtcd))
+	if p.MetaStoreType != kv.MetaStoreEtcd && p.MetaStoreType != kv.MetaStoreSQL {
+		panic(fmt.Sprintf("unsupported metastore type %s", p.MetaStoreType))
+	}
+	p.MetaStoreSQLDriver = p.LoadWithDefault("metastore.sql.driver", "mysql")
+	p.MetaStoreSQLDSN = p.LoadWithDefault("metastore.sql.dsn", "")
+}
+
 func (p *ParamTable) initSegmentBinlogSubPath() {
 	subPath, err := p.Load("etcd.segmentBinlogSubPath")
 	if err != nil {
@@ -367,6 +390,10 @@ func (p *ParamTable) initMinioBucketName() {
 	p.MinioBucketName = bucketName
 }
 
+func (p *ParamTable) initStorage() {
+	p.Storage = p.LoadStorageConfig()
+}
+
 func (p *ParamTable) initMinioRootPath() {
 	rootPath, err := p.Load("minio.rootPath")
 	if err != nil {
@@ -378,3 +405,11 @@ func (p *ParamTable) initMinioRootPath() {
 func (p *ParamTable) initCompactionRetentionDuration() {
 	p.CompactionRetentionDuration = p.ParseInt64WithDefault("dataCoord.compaction.retentionDuration", 432000)
 }
+
+func (p *ParamTable) initCompactionDeferWindows() {
+	windows, err := parseTimeWindows(p.LoadWithDefault("dataCoord.compaction.deferWindows", ""))
+	if err != nil {
+		panic(err)
+	}
+	p.CompactionDeferWindows = windows
+}
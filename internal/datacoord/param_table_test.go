@@ -19,6 +19,7 @@ package datacoord
 import (
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,4 +42,13 @@ func TestParamTable(t *testing.T) {
 	assert.Equal(t, Params.DataCoordSubscriptionName, "by-dev-dataCoord")
 	t.Logf("data coord subscription channel = %s", Params.DataCoordSubscriptionName)
 
+	assert.Empty(t, Params.CompactionDeferWindows)
+
+	assert.Equal(t, kv.MetaStoreEtcd, Params.MetaStoreType)
+	t.Logf("data coord metastore = %s", Params.MetaStoreType)
+
+	Params.Save("dataCoord.metastore", "unknown")
+	assert.Panics(t, func() { Params.initMetaStore() })
+	Params.Remove("dataCoord.metastore")
+
 }
@@ -222,7 +222,7 @@ func (s *SegmentManager) loadSegmentsFromMeta() {
 func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID,
 	partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -298,7 +298,7 @@ func (s *SegmentManager) genExpireTs(ctx context.Context) (Timestamp, error) {
 
 func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string) (*SegmentInfo, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	id, err := s.allocator.allocID(ctx)
 	if err != nil {
 		return nil, err
@@ -343,7 +343,7 @@ func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error
 // DropSegment drop the segment from manager.
 func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	for i, id := range s.segments {
@@ -366,7 +366,7 @@ func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 // SealAllSegments seals all segmetns of collection with collectionID and return sealed segments
 func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	ret := make([]UniqueID, 0)
@@ -396,7 +396,7 @@ func (s *SegmentManager) GetFlushableSegments(ctx context.Context, channel strin
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	if err := s.tryToSealSegment(t, channel); err != nil {
 		return nil, err
 	}
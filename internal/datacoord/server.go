@@ -32,13 +32,14 @@ import (
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv/metastore"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -316,33 +317,27 @@ func (s *Server) stopCompactionTrigger() {
 }
 
 func (s *Server) initGarbageCollection() error {
-	var cli *minio.Client
+	var cli storage.ChunkManager
 	var err error
 	if Params.EnableGarbageCollection {
-		cli, err = minio.New(Params.MinioAddress, &minio.Options{
-			Creds:  credentials.NewStaticV4(Params.MinioAccessKeyID, Params.MinioSecretAccessKey, ""),
-			Secure: Params.MinioUseSSL,
+		cli, err = storage.NewChunkManager(s.ctx, &storage.Option{
+			StorageConfig:     Params.Storage,
+			Address:           Params.MinioAddress,
+			AccessKeyID:       Params.MinioAccessKeyID,
+			SecretAccessKeyID: Params.MinioSecretAccessKey,
+			UseSSL:            Params.MinioUseSSL,
+			BucketName:        Params.MinioBucketName,
+			CreateBucket:      true,
 		})
 		if err != nil {
 			return err
 		}
-		has, err := cli.BucketExists(context.TODO(), Params.MinioBucketName)
-		if err != nil {
-			return err
-		}
-		if !has {
-			err = cli.MakeBucket(context.TODO(), Params.MinioBucketName, minio.MakeBucketOptions{})
-			if err != nil {
-				return err
-			}
-		}
 	}
 
 	s.garbageCollector = newGarbageCollector(s.meta, GcOption{
-		cli:        cli,
-		enabled:    Params.EnableGarbageCollection,
-		bucketName: Params.MinioBucketName,
-		rootPath:   Params.MinioRootPath,
+		cli:      cli,
+		enabled:  Params.EnableGarbageCollection,
+		rootPath: Params.MinioRootPath,
 
 		checkInterval:    Params.GCInterval,
 		missingTolerance: Params.GCMissingTolerance,
@@ -388,7 +383,13 @@ func (s *Server) initMeta() error {
 		}
 
 		s.kvClient = etcdKV
-		s.meta, err = newMeta(s.kvClient)
+		// channel watch info is always kept in etcd since datanodes watch it
+		metaKV, err := metastore.NewMetaKV(Params.MetaStoreType, Params.MetaStoreSQLDriver, Params.MetaStoreSQLDSN,
+			Params.EtcdEndpoints, Params.MetaRootPath)
+		if err != nil {
+			return err
+		}
+		s.meta, err = newMeta(metaKV)
 		if err != nil {
 			return err
 		}
@@ -731,6 +732,11 @@ func (s *Server) Stop() error {
 // CleanMeta only for test
 func (s *Server) CleanMeta() error {
 	log.Debug("clean meta", zap.Any("kv", s.kvClient))
+	if Params.MetaStoreType == kv.MetaStoreSQL {
+		if err := s.meta.client.RemoveWithPrefix(""); err != nil {
+			return err
+		}
+	}
 	return s.kvClient.RemoveWithPrefix("")
 }
 
@@ -22,6 +22,7 @@ import (
 	"math/rand"
 	"os"
 	"path"
+	"path/filepath"
 	"strconv"
 	"sync/atomic"
 	"testing"
@@ -29,7 +30,9 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -47,6 +50,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	_ "github.com/mattn/go-sqlite3"
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
@@ -720,6 +724,34 @@ func TestServer_getSystemInfoMetrics(t *testing.T) {
 	}
 }
 
+func TestServer_getChannelAssignmentMetrics(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	err := svr.channelManager.AddNode(1)
+	assert.Nil(t, err)
+	err = svr.channelManager.Watch(&channel{"ch1", 2})
+	assert.Nil(t, err)
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.ChannelAssignmentMetrics)
+	assert.Nil(t, err)
+	resp, err := svr.GetMetrics(svr.ctx, req)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+	var assignments metricsinfo.ChannelAssignments
+	err = metricsinfo.UnmarshalComponentInfos(resp.Response, &assignments)
+	assert.Nil(t, err)
+	assert.Equal(t, resp.ComponentName, assignments.Name)
+	assert.Equal(t, 0, len(assignments.Buffer))
+	assert.Equal(t, []metricsinfo.NodeChannelAssignments{
+		{
+			NodeID:   1,
+			Channels: []metricsinfo.ChannelAssignment{{Name: "ch1", CollectionID: 2}},
+		},
+	}, assignments.Nodes)
+}
+
 func TestChannel(t *testing.T) {
 	svr := newTestServer(t, nil)
 	defer closeTestServer(t, svr)
@@ -1028,9 +1060,16 @@ func TestDropVirtualChannel(t *testing.T) {
 			}
 			req.Segments = append(req.Segments, seg2Drop)
 		}
+		err = svr.meta.SetCompactionPolicy(&datapb.CompactionPolicyInfo{CollectionID: 0, Policy: noopCompactionPolicyName})
+		require.Nil(t, err)
+		err = svr.meta.AddCompactionHistory(&milvuspb.CompactionHistory{PlanID: 1, CollectionID: 0, CompleteTime: 1})
+		require.Nil(t, err)
 		resp, err := svr.DropVirtualChannel(ctx, req)
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		// the compaction policy and histories are kept for recovery until garbage collection
+		assert.NotNil(t, svr.meta.GetCompactionPolicy(0))
+		assert.Equal(t, 1, len(svr.meta.GetCompactionHistories(0, 0)))
 
 		<-spyCh
 
@@ -1069,6 +1108,93 @@ func TestDropVirtualChannel(t *testing.T) {
 	})
 }
 
+func TestRecoverDroppedSegments(t *testing.T) {
+	t.Run("normal RecoverDroppedSegments", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		// the mocked rootcoord describes collection 1314 with no start positions
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:                 1,
+			CollectionID:       1314,
+			InsertChannel:      "vchan1",
+			State:              commonpb.SegmentState_Dropped,
+			DroppedAt:          uint64(time.Now().UnixNano()),
+			DroppedWithChannel: true,
+		}))
+		assert.Nil(t, err)
+
+		resp, err := svr.RecoverDroppedSegments(context.TODO(), &datapb.RecoverDroppedSegmentsRequest{CollectionID: 1314})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.NotNil(t, svr.meta.GetCollection(1314))
+		segment := svr.meta.GetSegment(1)
+		assert.Equal(t, commonpb.SegmentState_Dropped, segment.GetState())
+		assert.False(t, segment.GetDroppedWithChannel())
+
+		err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:                 2,
+			CollectionID:       1314,
+			InsertChannel:      "vchan1",
+			State:              commonpb.SegmentState_Dropped,
+			DroppedAt:          uint64(time.Now().UnixNano()),
+			DroppedWithChannel: true,
+			Binlogs:            []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []string{"log2"}}},
+		}))
+		assert.Nil(t, err)
+		resp, err = svr.RecoverDroppedSegments(context.TODO(), &datapb.RecoverDroppedSegmentsRequest{CollectionID: 1314})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("compaction history of recovered collection", func(t *testing.T) {
+		spyCh := make(chan struct{}, 1)
+		svr := newTestServer(t, nil, SetSegmentManager(&spySegmentManager{spyCh: spyCh}))
+		defer closeTestServer(t, svr)
+
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            1,
+			CollectionID:  1314,
+			InsertChannel: "vchan1",
+			State:         commonpb.SegmentState_Growing,
+		}))
+		require.Nil(t, err)
+		err = svr.channelManager.AddNode(0)
+		require.Nil(t, err)
+		err = svr.channelManager.Watch(&channel{"vchan1", 1314})
+		require.Nil(t, err)
+		err = svr.meta.AddCompactionHistory(&milvuspb.CompactionHistory{PlanID: 1, CollectionID: 1314, CompleteTime: 1})
+		require.Nil(t, err)
+
+		dropResp, err := svr.DropVirtualChannel(context.TODO(), &datapb.DropVirtualChannelRequest{
+			Base:        &commonpb.MsgBase{Timestamp: uint64(time.Now().Unix())},
+			ChannelName: "vchan1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, dropResp.GetStatus().GetErrorCode())
+		<-spyCh
+
+		resp, err := svr.RecoverDroppedSegments(context.TODO(), &datapb.RecoverDroppedSegmentsRequest{CollectionID: 1314})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+
+		historyResp, err := svr.GetCompactionHistory(context.TODO(), &milvuspb.GetCompactionHistoryRequest{CollectionID: 1314})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, historyResp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(historyResp.GetHistories()))
+		assert.EqualValues(t, 1, historyResp.GetHistories()[0].GetPlanID())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.RecoverDroppedSegments(context.TODO(), &datapb.RecoverDroppedSegmentsRequest{CollectionID: 1314})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.GetReason())
+	})
+}
+
 func TestDataNodeTtChannel(t *testing.T) {
 	genMsg := func(msgType commonpb.MsgType, ch string, t Timestamp) *msgstream.DataNodeTtMsg {
 		return &msgstream.DataNodeTtMsg{
@@ -1964,6 +2090,192 @@ func TestGetCompactionStateWithPlans(t *testing.T) {
 	})
 }
 
+func TestSetCompactionPolicy(t *testing.T) {
+	t.Run("test set compaction policy successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.meta = &meta{client: memkv.NewMemoryKV(), collections: make(map[UniqueID]*datapb.CollectionInfo)}
+		svr.rootCoordClient = newMockRootCoordService()
+
+		resp, err := svr.SetCompactionPolicy(context.TODO(), &milvuspb.SetCompactionPolicyRequest{
+			CollectionID: 1,
+			Policy:       sizeTieredCompactionPolicyName,
+			Params:       []*commonpb.KeyValuePair{{Key: "min_threshold", Value: "2"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+		assert.Equal(t, sizeTieredCompactionPolicyName, svr.meta.GetCompactionPolicy(1).GetPolicy())
+	})
+
+	t.Run("test set invalid compaction policy", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.meta = &meta{client: memkv.NewMemoryKV()}
+
+		resp, err := svr.SetCompactionPolicy(context.TODO(), &milvuspb.SetCompactionPolicyRequest{
+			CollectionID: 1,
+			Policy:       sizeTieredCompactionPolicyName,
+			Params:       []*commonpb.KeyValuePair{{Key: "window", Value: "2"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+		assert.Nil(t, svr.meta.GetCompactionPolicy(1))
+	})
+
+	t.Run("test set compaction policy of not existed collection", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.meta = &meta{client: memkv.NewMemoryKV(), collections: make(map[UniqueID]*datapb.CollectionInfo)}
+		svr.rootCoordClient = &mockDescribeCollRoot{
+			RootCoord: newMockRootCoordService(),
+			collID:    1,
+		}
+
+		resp, err := svr.SetCompactionPolicy(context.TODO(), &milvuspb.SetCompactionPolicyRequest{
+			CollectionID: 2,
+			Policy:       noopCompactionPolicyName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+		assert.Nil(t, svr.meta.GetCompactionPolicy(2))
+	})
+
+	t.Run("test set compaction policy with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.SetCompactionPolicy(context.TODO(), &milvuspb.SetCompactionPolicyRequest{
+			CollectionID: 1,
+			Policy:       noopCompactionPolicyName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.Reason)
+	})
+}
+
+func TestDryRunCompaction(t *testing.T) {
+	Params.EnableCompaction = true
+	newServer := func(policies map[UniqueID]*datapb.CompactionPolicyInfo) (*Server, *compactionPolicy) {
+		var usedPolicy compactionPolicy
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.meta = &meta{compactionPolicies: policies}
+		svr.allocator = newMockAllocator()
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"dryRunCompaction": func(collectionID int64, policy compactionPolicy, tt *timetravel) []*datapb.CompactionPlan {
+					usedPolicy = policy
+					return []*datapb.CompactionPlan{
+						{
+							SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}},
+							Type:           datapb.CompactionType_MergeCompaction,
+							Channel:        "ch1",
+						},
+					}
+				},
+			},
+		}
+		return svr, &usedPolicy
+	}
+
+	t.Run("test dry run the policy of collection", func(t *testing.T) {
+		svr, usedPolicy := newServer(map[UniqueID]*datapb.CompactionPolicyInfo{
+			1: {CollectionID: 1, Policy: noopCompactionPolicyName},
+		})
+		resp, err := svr.DryRunCompaction(context.TODO(), &milvuspb.DryRunCompactionRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, noopCompactionPolicyName, resp.Policy)
+		assert.Nil(t, *usedPolicy)
+		assert.Equal(t, 1, len(resp.Plans))
+		assert.Equal(t, datapb.CompactionType_MergeCompaction.String(), resp.Plans[0].Type)
+		assert.Equal(t, "ch1", resp.Plans[0].Channel)
+		assert.Equal(t, []int64{1, 2}, resp.Plans[0].Sources)
+	})
+
+	t.Run("test dry run the default policy", func(t *testing.T) {
+		svr, _ := newServer(nil)
+		resp, err := svr.DryRunCompaction(context.TODO(), &milvuspb.DryRunCompactionRequest{CollectionID: 1, Timetravel: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, defaultCompactionPolicyName, resp.Policy)
+	})
+
+	t.Run("test dry run another policy", func(t *testing.T) {
+		svr, usedPolicy := newServer(nil)
+		resp, err := svr.DryRunCompaction(context.TODO(), &milvuspb.DryRunCompactionRequest{
+			CollectionID: 1,
+			Policy:       timeWindowCompactionPolicyName,
+			Params:       []*commonpb.KeyValuePair{{Key: "window", Value: "60"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, timeWindowCompactionPolicyName, resp.Policy)
+		assert.IsType(t, &timeWindowCompactionPolicy{}, *usedPolicy)
+	})
+
+	t.Run("test dry run invalid policy", func(t *testing.T) {
+		svr, _ := newServer(nil)
+		resp, err := svr.DryRunCompaction(context.TODO(), &milvuspb.DryRunCompactionRequest{
+			CollectionID: 1,
+			Policy:       "leveled",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("test dry run compaction with closed server", func(t *testing.T) {
+		svr, _ := newServer(nil)
+		svr.isServing = ServerStateStopped
+		resp, err := svr.DryRunCompaction(context.TODO(), &milvuspb.DryRunCompactionRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.Status.Reason)
+	})
+}
+
+func TestGetCompactionHistory(t *testing.T) {
+	newServer := func() *Server {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.meta = &meta{client: memkv.NewMemoryKV()}
+		for i := 1; i <= 3; i++ {
+			err := svr.meta.AddCompactionHistory(&milvuspb.CompactionHistory{PlanID: int64(i), CollectionID: 1, CompleteTime: uint64(i)})
+			assert.Nil(t, err)
+		}
+		return svr
+	}
+
+	t.Run("test get compaction history", func(t *testing.T) {
+		svr := newServer()
+		resp, err := svr.GetCompactionHistory(context.TODO(), &milvuspb.GetCompactionHistoryRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, 3, len(resp.Histories))
+		assert.EqualValues(t, 3, resp.Histories[0].PlanID)
+
+		resp, err = svr.GetCompactionHistory(context.TODO(), &milvuspb.GetCompactionHistoryRequest{CollectionID: 1, Limit: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, 1, len(resp.Histories))
+
+		resp, err = svr.GetCompactionHistory(context.TODO(), &milvuspb.GetCompactionHistoryRequest{CollectionID: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Empty(t, resp.Histories)
+	})
+
+	t.Run("test get compaction history with closed server", func(t *testing.T) {
+		svr := newServer()
+		svr.isServing = ServerStateStopped
+		resp, err := svr.GetCompactionHistory(context.TODO(), &milvuspb.GetCompactionHistoryRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.Status.Reason)
+	})
+}
+
 func TestOptions(t *testing.T) {
 	t.Run("SetRootCoordCreator", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -2247,8 +2559,45 @@ func TestGetFlushState(t *testing.T) {
 	})
 }
 
+func TestServer_SQLMetaStore(t *testing.T) {
+	Params.Init()
+	defer Params.Init()
+	Params.MetaStoreType = kv.MetaStoreSQL
+	Params.MetaStoreSQLDriver = "sqlite3"
+	Params.MetaStoreSQLDSN = filepath.Join(t.TempDir(), "meta.db")
+	svr := startTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	segment := &datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  0,
+		PartitionID:   0,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Growing,
+	}
+	err := svr.meta.AddSegment(NewSegmentInfo(segment))
+	assert.Nil(t, err)
+
+	// the segment meta is kept in sql rather than etcd
+	metaKV, err := sqlkv.NewSQLKV("sqlite3", Params.MetaStoreSQLDSN, Params.MetaRootPath)
+	require.Nil(t, err)
+	defer metaKV.Close()
+	m, err := newMeta(metaKV)
+	require.Nil(t, err)
+	assert.NotNil(t, m.GetSegment(segment.GetID()))
+
+	_, values, err := svr.kvClient.LoadWithPrefix(segmentPrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+}
+
 func newTestServer(t *testing.T, receiveCh chan interface{}, opts ...Option) *Server {
 	Params.Init()
+	return startTestServer(t, receiveCh, opts...)
+}
+
+// startTestServer starts a server with the current Params
+func startTestServer(t *testing.T, receiveCh chan interface{}, opts ...Option) *Server {
 	Params.TimeTickChannelName = Params.TimeTickChannelName + strconv.Itoa(rand.Int())
 	Params.StatisticsChannelName = Params.StatisticsChannelName + strconv.Itoa(rand.Int())
 	var err error
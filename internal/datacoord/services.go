@@ -67,7 +67,7 @@ func (s *Server) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResp
 func (s *Server) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	log.Debug("receive flush request", zap.Int64("dbID", req.GetDbID()), zap.Int64("collectionID", req.GetCollectionID()))
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "DataCoord-Flush")
-	defer sp.Finish()
+	defer sp.End()
 	resp := &datapb.FlushResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -502,6 +502,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	segment2StatsBinlogs := make(map[UniqueID][]*datapb.FieldBinlog)
 	segment2DeltaBinlogs := make(map[UniqueID][]*datapb.DeltaLogInfo)
 	segmentsNumOfRows := make(map[UniqueID]int64)
+	segment2KeyRange := make(map[UniqueID]*datapb.ClusteringKeyRange)
 
 	flushedIDs := make(map[int64]struct{})
 	for _, id := range segmentIDs {
@@ -535,6 +536,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		}
 
 		segmentsNumOfRows[id] = segment.NumOfRows
+		segment2KeyRange[id] = segment.GetClusteringKeyRange()
 
 		statsBinlogs := segment.GetStatslogs()
 		field2StatsBinlog := make(map[UniqueID][]string)
@@ -561,6 +563,8 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			FieldBinlogs: segment2Binlogs[segmentID],
 			Statslogs:    segment2StatsBinlogs[segmentID],
 			Deltalogs:    segment2DeltaBinlogs[segmentID],
+
+			ClusteringKeyRange: segment2KeyRange[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
@@ -698,6 +702,10 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.ChannelAssignmentMetrics {
+		return s.getChannelAssignmentMetrics()
+	}
+
 	log.Debug("DataCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.NodeID),
 		zap.String("req", req.Request),
@@ -845,6 +853,161 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return resp, nil
 }
 
+// SetCompactionPolicy sets the compaction policy of a collection
+func (s *Server) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	log.Debug("receive set compaction policy request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("policy", req.GetPolicy()), zap.Any("params", req.GetParams()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to set compaction policy", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if _, err := newCompactionPolicy(req.GetPolicy(), req.GetParams()); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+		log.Error("failed to load collection from rootcoord", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	err := s.meta.SetCompactionPolicy(&datapb.CompactionPolicyInfo{
+		CollectionID: req.GetCollectionID(),
+		Policy:       req.GetPolicy(),
+		Params:       req.GetParams(),
+	})
+	if err != nil {
+		log.Error("failed to save compaction policy", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// DryRunCompaction returns the compaction plans a policy would generate for a collection without executing them
+func (s *Server) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	log.Debug("receive dry run compaction request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("policy", req.GetPolicy()))
+	resp := &milvuspb.DryRunCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to dry run compaction", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	var policy compactionPolicy
+	resp.Policy, resp.Params = req.GetPolicy(), req.GetParams()
+	if req.GetPolicy() != "" {
+		var err error
+		if policy, err = newCompactionPolicy(req.GetPolicy(), req.GetParams()); err != nil {
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+	} else if info := s.meta.GetCompactionPolicy(req.GetCollectionID()); info != nil {
+		resp.Policy, resp.Params = info.GetPolicy(), info.GetParams()
+	} else {
+		resp.Policy = defaultCompactionPolicyName
+	}
+
+	tt := &timetravel{req.GetTimetravel()}
+	if tt.time == 0 {
+		var err error
+		if tt, err = getTimetravelReverseTime(ctx, s.allocator); err != nil {
+			log.Warn("failed to get compaction timetravel", zap.Error(err))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+	}
+
+	for _, plan := range s.compactionTrigger.dryRunCompaction(req.GetCollectionID(), policy, tt) {
+		info := &milvuspb.CompactionPlanInfo{
+			Type:    plan.GetType().String(),
+			Channel: plan.GetChannel(),
+		}
+		for _, binlogs := range plan.GetSegmentBinlogs() {
+			info.Sources = append(info.Sources, binlogs.GetSegmentID())
+		}
+		resp.Plans = append(resp.Plans, info)
+	}
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetCompactionHistory returns the records of completed compaction plans of a collection, latest first
+func (s *Server) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	log.Debug("receive get compaction history request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("limit", req.GetLimit()))
+	resp := &milvuspb.GetCompactionHistoryResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get compaction history", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	resp.Histories = s.meta.GetCompactionHistories(req.GetCollectionID(), int(req.GetLimit()))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// RecoverDroppedSegments restores the segments dropped together with the channels of a collection
+// the collection shall be recovered in RootCoord first, so that its new start positions could be loaded
+func (s *Server) RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest) (*commonpb.Status, error) {
+	log.Debug("receive recover dropped segments request", zap.Int64("collectionID", req.GetCollectionID()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to recover dropped segments", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+		log.Error("failed to load collection from rootcoord", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	if err := s.meta.RecoverDroppedSegments(s.meta.GetCollection(req.GetCollectionID()), Params.GCDropTolerance); err != nil {
+		log.Error("failed to recover dropped segments", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 func getCompactionMergeInfo(task *compactionTask) *milvuspb.CompactionMergeInfo {
 	segments := task.plan.GetSegmentBinlogs()
 	var sources []int64
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -116,6 +117,67 @@ func (c *LongTermChecker) Stop() {
 	close(c.ch)
 }
 
+// timeWindow is a daily window of local time in minutes of the day,
+// the window crosses midnight if end is before start
+type timeWindow struct {
+	start int
+	end   int
+}
+
+// parseTimeWindows parses comma separated windows like "09:00-12:00,22:00-02:00"
+func parseTimeWindows(s string) ([]timeWindow, error) {
+	var windows []timeWindow
+	for _, w := range strings.Split(s, ",") {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		parts := strings.Split(w, "-")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid time window %s", w)
+		}
+		start, err := parseMinuteOfDay(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time window %s: %w", w, err)
+		}
+		end, err := parseMinuteOfDay(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time window %s: %w", w, err)
+		}
+		if start == end {
+			return nil, fmt.Errorf("invalid time window %s: empty window", w)
+		}
+		windows = append(windows, timeWindow{start: start, end: end})
+	}
+	return windows, nil
+}
+
+func parseMinuteOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (w timeWindow) contains(t time.Time) bool {
+	m := t.Hour()*60 + t.Minute()
+	if w.start < w.end {
+		return m >= w.start && m < w.end
+	}
+	return m >= w.start || m < w.end
+}
+
+// inTimeWindows returns whether t is in any of the windows
+func inTimeWindows(windows []timeWindow, t time.Time) bool {
+	for _, w := range windows {
+		if w.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
 func getTimetravelReverseTime(ctx context.Context, allocator allocator) (*timetravel, error) {
 	ts, err := allocator.allocTimestamp(ctx)
 	if err != nil {
@@ -111,6 +111,43 @@ func TestVerifyResponse(t *testing.T) {
 	}
 }
 
+func Test_parseTimeWindows(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    []timeWindow
+		wantErr bool
+	}{
+		{"test empty", "", nil, false},
+		{"test one window", "09:00-12:30", []timeWindow{{540, 750}}, false},
+		{"test windows", " 09:00-12:00 , 22:00-02:00", []timeWindow{{540, 720}, {1320, 120}}, false},
+		{"test no end", "09:00", nil, true},
+		{"test invalid time", "09:00-25:00", nil, true},
+		{"test empty window", "09:00-09:00", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTimeWindows(tt.s)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_inTimeWindows(t *testing.T) {
+	windows := []timeWindow{{540, 720}, {1320, 120}}
+	at := func(hour, min int) time.Time {
+		return time.Date(2021, 11, 15, hour, min, 0, 0, time.Local)
+	}
+	assert.True(t, inTimeWindows(windows, at(9, 0)))
+	assert.True(t, inTimeWindows(windows, at(11, 59)))
+	assert.False(t, inTimeWindows(windows, at(12, 0)))
+	assert.True(t, inTimeWindows(windows, at(23, 30)))
+	assert.True(t, inTimeWindows(windows, at(1, 30)))
+	assert.False(t, inTimeWindows(windows, at(2, 0)))
+	assert.False(t, inTimeWindows(nil, at(9, 0)))
+}
+
 func Test_getTimetravelReverseTime(t *testing.T) {
 	Params.Init()
 	Params.CompactionRetentionDuration = 43200 // 5 days
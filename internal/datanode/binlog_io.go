@@ -33,6 +33,7 @@ import (
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 )
 
 var (
@@ -104,6 +105,7 @@ type cpaths struct {
 	inPaths    []*datapb.FieldBinlog
 	statsPaths []*datapb.FieldBinlog
 	deltaInfo  *datapb.DeltaLogInfo
+	size       int64 // bytes uploaded
 }
 
 func (b *binlogIO) upload(
@@ -150,6 +152,10 @@ func (b *binlogIO) upload(
 		p.deltaInfo.DeltaLogPath = k
 	}
 
+	for _, v := range kvs {
+		p.size += int64(len(v))
+	}
+
 	var err = errStart
 	g, gCtx := errgroup.WithContext(ctx)
 	g.Go(func() error {
@@ -176,6 +182,62 @@ func (b *binlogIO) upload(
 	return p, nil
 }
 
+// rateLimitedKV limits the bytes per second loaded from and saved into the blob storage,
+// the limiter is shared by all the compactions of a datanode so they don't starve the flushes.
+type rateLimitedKV struct {
+	kv.BaseKV
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+func newRateLimitedKV(ctx context.Context, base kv.BaseKV, limiter *rate.Limiter) *rateLimitedKV {
+	return &rateLimitedKV{
+		BaseKV:  base,
+		ctx:     ctx,
+		limiter: limiter,
+	}
+}
+
+// MultiLoad waits for the bytes loaded after loading, as the size is unknown before
+func (r *rateLimitedKV) MultiLoad(keys []string) ([]string, error) {
+	values, err := r.BaseKV.MultiLoad(keys)
+	if err != nil {
+		return nil, err
+	}
+	size := 0
+	for _, v := range values {
+		size += len(v)
+	}
+	return values, r.wait(size)
+}
+
+// MultiSave waits for the bytes to save before saving
+func (r *rateLimitedKV) MultiSave(kvs map[string]string) error {
+	size := 0
+	for _, v := range kvs {
+		size += len(v)
+	}
+	if err := r.wait(size); err != nil {
+		return err
+	}
+	return r.BaseKV.MultiSave(kvs)
+}
+
+// wait waits for n bytes in pieces no larger than the burst of the limiter
+func (r *rateLimitedKV) wait(n int) error {
+	for n > 0 {
+		piece := n
+		if piece > r.limiter.Burst() {
+			piece = r.limiter.Burst()
+		}
+		if err := r.limiter.WaitN(r.ctx, piece); err != nil {
+			return err
+		}
+		n -= piece
+	}
+	return nil
+}
+
 // genDeltaBlobs returns key, value
 func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueID) (string, []byte, error) {
 	dCodec := storage.NewDeleteCodec()
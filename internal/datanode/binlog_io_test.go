@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
 func TestBinlogIOInterfaceMethods(t *testing.T) {
@@ -50,6 +51,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		p, err := b.upload(context.TODO(), 1, 10, []*InsertData{iData}, dData, meta)
 		assert.NoError(t, err)
 		assert.Equal(t, 11, len(p.inPaths))
+		assert.Greater(t, p.size, int64(0))
 		assert.Equal(t, 3, len(p.statsPaths))
 		assert.NotNil(t, p.deltaInfo.GetDeltaLogPath())
 
@@ -164,6 +166,43 @@ func prepareBlob(kv kv.BaseKV, key string) ([]byte, string, error) {
 	return blob, k, nil
 }
 
+func TestRateLimitedKV(t *testing.T) {
+	t.Run("Test save and load", func(t *testing.T) {
+		limiter := rate.NewLimiter(rate.Limit(1024), 4)
+		rkv := newRateLimitedKV(context.TODO(), memkv.NewMemoryKV(), limiter)
+
+		err := rkv.MultiSave(map[string]string{"a": "12345", "b": "67890"})
+		assert.NoError(t, err)
+
+		values, err := rkv.MultiLoad([]string{"a", "b"})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"12345", "67890"}, values)
+	})
+
+	t.Run("Test canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		mkv := memkv.NewMemoryKV()
+		rkv := newRateLimitedKV(ctx, mkv, rate.NewLimiter(rate.Limit(1), 1))
+
+		err := rkv.MultiSave(map[string]string{"a": "12345"})
+		assert.Error(t, err)
+		value, err := mkv.Load("a")
+		assert.NoError(t, err)
+		assert.Empty(t, value)
+	})
+
+	t.Run("Test throttled", func(t *testing.T) {
+		rkv := newRateLimitedKV(context.TODO(), memkv.NewMemoryKV(), rate.NewLimiter(rate.Limit(10), 10))
+
+		start := time.Now()
+		err := rkv.MultiSave(map[string]string{"a": "0123456789", "b": "0123456789"})
+		assert.NoError(t, err)
+		// the first 10 bytes are the burst, the others wait for 1 second
+		assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	})
+}
+
 func TestBinlogIOInnerMethods(t *testing.T) {
 	alloc := NewAllocatorFactory()
 	b := &binlogIO{
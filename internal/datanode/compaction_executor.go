@@ -55,6 +55,11 @@ func newCompactionExecutor() *compactionExecutor {
 	}
 }
 
+// setMaxParallel sets the max number of compactions executed in parallel, it should be called before start
+func (c *compactionExecutor) setMaxParallel(n int) {
+	c.parallelCh = make(chan struct{}, n)
+}
+
 func (c *compactionExecutor) execute(task compactor) {
 	c.taskCh <- task
 }
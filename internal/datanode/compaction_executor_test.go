@@ -45,6 +45,13 @@ func TestCompactionExecutor(t *testing.T) {
 		go ex.start(ctx)
 	})
 
+	t.Run("Test setMaxParallel", func(t *testing.T) {
+		ex := newCompactionExecutor()
+		assert.Equal(t, maxParallelCompactionNum, cap(ex.parallelCh))
+		ex.setMaxParallel(5)
+		assert.Equal(t, 5, cap(ex.parallelCh))
+	})
+
 	t.Run("Test excuteTask", func(t *testing.T) {
 		tests := []struct {
 			isvalid bool
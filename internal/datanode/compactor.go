@@ -23,11 +23,13 @@ import (
 	"math"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -73,6 +75,10 @@ type compactionTask struct {
 	cancel context.CancelFunc
 
 	wg sync.WaitGroup
+
+	// statistics reported with the compaction result
+	startTime time.Time
+	bytesRead int64 // accessed atomically
 }
 
 // check if compactionTask implements compactor
@@ -116,6 +122,21 @@ func (t *compactionTask) getChannelName() string {
 	return t.plan.GetChannel()
 }
 
+// fillStatistics fills the statistics of the compaction into the result
+func (t *compactionTask) fillStatistics(result *datapb.CompactionResult, bytesWritten int64) {
+	result.BytesRead = atomic.LoadInt64(&t.bytesRead)
+	result.BytesWritten = bytesWritten
+	result.TimeCostMs = time.Since(t.startTime).Milliseconds()
+}
+
+func getBlobsSize(blobs []*Blob) int64 {
+	var size int64
+	for _, b := range blobs {
+		size += int64(len(b.Value))
+	}
+	return size
+}
+
 func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelTs Timestamp) (map[UniqueID]Timestamp, *DelDataBuf, error) {
 
 	dCodec := storage.NewDeleteCodec()
@@ -167,16 +188,55 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 }
 
 func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp, schema *schemapb.CollectionSchema) ([]*InsertData, int64, error) {
+	fID2Content := make(map[UniqueID][]interface{})
+
+	for mergeItr.HasNext() {
+		//  no error if HasNext() returns true
+		vInter, _ := mergeItr.Next()
+
+		v, ok := vInter.(*storage.Value)
+		if !ok {
+			log.Warn("transfer interface to Value wrong")
+			return nil, 0, errors.New("Unexpected error")
+		}
 
+		if _, ok := delta[v.PK]; ok {
+			continue
+		}
+
+		row, ok := v.Value.(map[UniqueID]interface{})
+		if !ok {
+			log.Warn("transfer interface to map wrong")
+			return nil, 0, errors.New("Unexpected error")
+		}
+
+		for fID, vInter := range row {
+			if _, ok := fID2Content[fID]; !ok {
+				fID2Content[fID] = make([]interface{}, 0)
+			}
+			fID2Content[fID] = append(fID2Content[fID], vInter)
+		}
+	}
+
+	iDatas, numRows, err := toInsertData(fID2Content, schema)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	log.Debug("merge end", zap.Int64("planID", t.getPlanID()), zap.Int64("remaining insert numRows", numRows))
+	return iDatas, numRows, nil
+}
+
+// toInsertData splits the merged field contents into insert data of binlog size
+func toInsertData(fID2Content map[UniqueID][]interface{}, schema *schemapb.CollectionSchema) ([]*InsertData, int64, error) {
 	var (
 		dim int // dimension of vector field
 		num int // numOfRows in each binlog
 		n   int // binlog number
 		err error
 
-		iDatas      = make([]*InsertData, 0)
-		fID2Type    = make(map[UniqueID]schemapb.DataType)
-		fID2Content = make(map[UniqueID][]interface{})
+		iDatas   = make([]*InsertData, 0)
+		fID2Type = make(map[UniqueID]schemapb.DataType)
 	)
 
 	// get dim
@@ -196,33 +256,30 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 		}
 	}
 
-	for mergeItr.HasNext() {
-		//  no error if HasNext() returns true
-		vInter, _ := mergeItr.Next()
-
-		v, ok := vInter.(*storage.Value)
-		if !ok {
-			log.Warn("transfer interface to Value wrong")
-			return nil, 0, errors.New("Unexpected error")
-		}
-
-		if _, ok := delta[v.PK]; ok {
-			continue
-		}
-
-		row, ok := v.Value.(map[UniqueID]interface{})
-		if !ok {
-			log.Warn("transfer interface to map wrong")
-			return nil, 0, errors.New("Unexpected error")
-		}
-
-		for fID, vInter := range row {
-			if _, ok := fID2Content[fID]; !ok {
-				fID2Content[fID] = make([]interface{}, 0)
+	if dim == 0 {
+		// half float vectors take half the memory of float vectors of the same dimension
+		for _, fs := range schema.GetFields() {
+			if !typeutil.IsHalfFloatVectorType(fs.GetDataType()) {
+				continue
 			}
-			fID2Content[fID] = append(fID2Content[fID], vInter)
+			for _, t := range fs.GetTypeParams() {
+				if t.Key == "dim" {
+					if dim, err = strconv.Atoi(t.Value); err != nil {
+						log.Warn("strconv wrong on get dim", zap.Error(err))
+						return nil, 0, err
+					}
+					break
+				}
+			}
+			if dim = dim / 2; dim == 0 {
+				dim = 1
+			}
+			break
 		}
 	}
+	if dim == 0 && typeutil.HasSparseVectorField(schema) {
+		dim = sparseFloatVectorEstimatedDim
+	}
 
 	// calculate numRows from rowID field, fieldID 0
 	numRows := int64(len(fID2Content[0]))
@@ -260,13 +317,13 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 
 	}
 
-	log.Debug("merge end", zap.Int64("planID", t.getPlanID()), zap.Int64("remaining insert numRows", numRows))
 	return iDatas, numRows, nil
 }
 
 func (t *compactionTask) compact() error {
 	t.wg.Add(1)
 	defer t.wg.Done()
+	t.startTime = time.Now()
 	ctxTimeout, cancelAll := context.WithTimeout(t.ctx, time.Duration(t.plan.GetTimeoutInSeconds())*time.Second)
 	defer cancelAll()
 
@@ -351,6 +408,7 @@ func (t *compactionTask) compact() error {
 					log.Warn("download insertlogs wrong")
 					return err
 				}
+				atomic.AddInt64(&t.bytesRead, getBlobsSize(bs))
 
 				itr, err := storage.NewInsertBinlogIterator(bs, PKfieldID)
 				if err != nil {
@@ -375,6 +433,7 @@ func (t *compactionTask) compact() error {
 					log.Warn("download deltalogs wrong")
 					return err
 				}
+				atomic.AddInt64(&t.bytesRead, getBlobsSize(bs))
 
 				dmu.Lock()
 				dblobs[segID] = append(dblobs[segID], bs...)
@@ -397,6 +456,23 @@ func (t *compactionTask) compact() error {
 		return err
 	}
 
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		targetSegIDs, err := t.clusteringCompact(ctxTimeout, mergeItr, deltaPk2Ts, deltaBuf.delData, collID, partID, meta, PKfieldID)
+		if err != nil {
+			log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return err
+		}
+
+		// flushes of the compacted segments are taken over by the first new segment
+		targetSegID = targetSegIDs[0]
+		for _, seg := range segIDs {
+			t.removeSegment(seg)
+		}
+		ti.injectDone(true)
+		log.Info("clustering compaction done", zap.Int64("planID", t.plan.GetPlanID()), zap.Int64s("segmentIDs", targetSegIDs))
+		return nil
+	}
+
 	iDatas, numRows, err := t.merge(mergeItr, deltaPk2Ts, meta.GetSchema())
 	if err != nil {
 		log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
@@ -426,6 +502,7 @@ func (t *compactionTask) compact() error {
 		NumOfRows:           numRows,
 		Deltalogs:           deltaLogs,
 	}
+	t.fillStatistics(pack, cpaths.size)
 
 	status, err := t.dc.CompleteCompaction(ctxTimeout, pack)
 	if err != nil {
@@ -608,6 +685,55 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 		data.Dim = len(data.Data) * 8 / int(numRows)
 		rst = data
 
+	case schemapb.DataType_Float16Vector:
+		var data = &storage.Float16VectorFieldData{
+			NumRows: numOfRows,
+			Data:    []byte{},
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r...)
+		}
+
+		data.Dim = len(data.Data) / 2 / int(numRows)
+		rst = data
+
+	case schemapb.DataType_BFloat16Vector:
+		var data = &storage.BFloat16VectorFieldData{
+			NumRows: numOfRows,
+			Data:    []byte{},
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r...)
+		}
+
+		data.Dim = len(data.Data) / 2 / int(numRows)
+		rst = data
+
+	case schemapb.DataType_SparseFloatVector:
+		var data = &storage.SparseFloatVectorFieldData{
+			NumRows:  numOfRows,
+			Contents: make([][]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.AppendRow(r)
+		}
+		rst = data
+
 	default:
 		return nil, errUnknownDataType
 	}
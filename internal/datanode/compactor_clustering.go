@@ -0,0 +1,318 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"go.uber.org/zap"
+)
+
+// clusteredSegment is the rows of one segment written by clustering compaction
+type clusteredSegment struct {
+	fID2Content map[UniqueID][]interface{}
+	numRows     int64
+	keyRange    *datapb.ClusteringKeyRange
+}
+
+// cluster merges the rows like merge does, then sorts them by the clustering key and splits them into
+// segments of at most maxRows rows, so each segment covers a narrow range of the key.
+// There's always one segment even if no row is left.
+func (t *compactionTask) cluster(mergeItr iterator, delta map[UniqueID]Timestamp, schema *schemapb.CollectionSchema,
+	keyFieldID UniqueID, maxRows int64) ([]*clusteredSegment, error) {
+
+	var keyField *schemapb.FieldSchema
+	for _, fs := range schema.GetFields() {
+		if fs.GetFieldID() == keyFieldID {
+			keyField = fs
+			break
+		}
+	}
+	if keyField == nil {
+		return nil, fmt.Errorf("clustering key %d is not in the schema", keyFieldID)
+	}
+
+	rows := make([]map[UniqueID]interface{}, 0)
+	for mergeItr.HasNext() {
+		//  no error if HasNext() returns true
+		vInter, _ := mergeItr.Next()
+
+		v, ok := vInter.(*storage.Value)
+		if !ok {
+			log.Warn("transfer interface to Value wrong")
+			return nil, errors.New("Unexpected error")
+		}
+
+		if _, ok := delta[v.PK]; ok {
+			continue
+		}
+
+		row, ok := v.Value.(map[UniqueID]interface{})
+		if !ok {
+			log.Warn("transfer interface to map wrong")
+			return nil, errors.New("Unexpected error")
+		}
+		rows = append(rows, row)
+	}
+
+	var sortErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		less, err := clusteringKeyLess(rows[i][keyFieldID], rows[j][keyFieldID])
+		if err != nil {
+			sortErr = err
+		}
+		return less
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	if maxRows <= 0 {
+		maxRows = int64(len(rows))
+	}
+	segments := make([]*clusteredSegment, 0)
+	for start := int64(0); start < int64(len(rows)) || start == 0; start += maxRows {
+		end := start + maxRows
+		if end > int64(len(rows)) {
+			end = int64(len(rows))
+		}
+
+		segment := &clusteredSegment{
+			fID2Content: make(map[UniqueID][]interface{}),
+			numRows:     end - start,
+		}
+		for _, row := range rows[start:end] {
+			for fID, v := range row {
+				segment.fID2Content[fID] = append(segment.fID2Content[fID], v)
+			}
+		}
+		if end > start {
+			keyRange, err := newClusteringKeyRange(keyField, rows[start][keyFieldID], rows[end-1][keyFieldID])
+			if err != nil {
+				return nil, err
+			}
+			segment.keyRange = keyRange
+		}
+		segments = append(segments, segment)
+
+		if end == int64(len(rows)) {
+			break
+		}
+	}
+
+	log.Debug("cluster end", zap.Int64("planID", t.getPlanID()), zap.Int("remaining insert numRows", len(rows)),
+		zap.Int("number of segments", len(segments)))
+	return segments, nil
+}
+
+// clusteringKeyLess compares two values of the clustering key
+func clusteringKeyLess(a, b interface{}) (bool, error) {
+	switch av := a.(type) {
+	case int8:
+		bv, ok := b.(int8)
+		return av < bv, checkTransfer(ok)
+	case int16:
+		bv, ok := b.(int16)
+		return av < bv, checkTransfer(ok)
+	case int32:
+		bv, ok := b.(int32)
+		return av < bv, checkTransfer(ok)
+	case int64:
+		bv, ok := b.(int64)
+		return av < bv, checkTransfer(ok)
+	case float32:
+		bv, ok := b.(float32)
+		return av < bv, checkTransfer(ok)
+	case float64:
+		bv, ok := b.(float64)
+		return av < bv, checkTransfer(ok)
+	default:
+		return false, errTransferType
+	}
+}
+
+func checkTransfer(ok bool) error {
+	if !ok {
+		return errTransferType
+	}
+	return nil
+}
+
+// newClusteringKeyRange builds the key range of a segment from the min and max values of the clustering key
+func newClusteringKeyRange(keyField *schemapb.FieldSchema, min, max interface{}) (*datapb.ClusteringKeyRange, error) {
+	keyRange := &datapb.ClusteringKeyRange{FieldID: keyField.GetFieldID()}
+	switch keyField.GetDataType() {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		minInt, ok1 := toInt64(min)
+		maxInt, ok2 := toInt64(max)
+		if !ok1 || !ok2 {
+			return nil, errTransferType
+		}
+		keyRange.MinInt, keyRange.MaxInt = minInt, maxInt
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		minFloat, ok1 := toFloat64(min)
+		maxFloat, ok2 := toFloat64(max)
+		if !ok1 || !ok2 {
+			return nil, errTransferType
+		}
+		keyRange.MinFloat, keyRange.MaxFloat = minFloat, maxFloat
+	default:
+		return nil, fmt.Errorf("data type %s can't be the clustering key", keyField.GetDataType().String())
+	}
+	return keyRange, nil
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+// clusteringCompact writes the merged rows into new segments by ranges of the clustering key,
+// reports them to datacoord and adds them into the replica, returns the IDs of the new segments
+func (t *compactionTask) clusteringCompact(ctx context.Context, mergeItr iterator, delta map[UniqueID]Timestamp, delData *DeleteData,
+	collID, partID UniqueID, meta *etcdpb.CollectionMeta, pkFieldID UniqueID) ([]UniqueID, error) {
+
+	segments, err := t.cluster(mergeItr, delta, meta.GetSchema(), t.plan.GetClusteringFieldID(), t.plan.GetMaxSegmentRows())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &datapb.CompactionResult{
+		PlanID: t.plan.GetPlanID(),
+	}
+	segmentRowIDs := make([][]int64, 0, len(segments))
+	var bytesWritten int64
+	for _, segment := range segments {
+		segID, err := t.allocID()
+		if err != nil {
+			return nil, err
+		}
+
+		iDatas, numRows, err := toInsertData(segment.fID2Content, meta.GetSchema())
+		if err != nil {
+			return nil, err
+		}
+
+		// the deletions after timetravel are kept by the segment holding the entity
+		pks := make(map[int64]struct{}, numRows)
+		for _, pk := range segment.fID2Content[pkFieldID] {
+			if v, ok := pk.(int64); ok {
+				pks[v] = struct{}{}
+			}
+		}
+		dbuff := &DelDataBuf{
+			delData: &DeleteData{},
+			tsFrom:  math.MaxUint64,
+			tsTo:    0,
+		}
+		for i, pk := range delData.Pks {
+			if _, ok := pks[pk]; !ok {
+				continue
+			}
+			ts := delData.Tss[i]
+			dbuff.delData.Append(pk, ts)
+			if ts < dbuff.tsFrom {
+				dbuff.tsFrom = ts
+			}
+			if ts > dbuff.tsTo {
+				dbuff.tsTo = ts
+			}
+		}
+		dbuff.updateSize(dbuff.delData.RowCount)
+
+		cpaths, err := t.upload(ctx, segID, partID, iDatas, dbuff.delData, meta)
+		if err != nil {
+			return nil, err
+		}
+		bytesWritten += cpaths.size
+
+		var deltaLogs []*datapb.DeltaLogInfo
+		if len(cpaths.deltaInfo.GetDeltaLogPath()) > 0 {
+			cpaths.deltaInfo.DeltaLogSize = dbuff.size
+			cpaths.deltaInfo.TimestampFrom = dbuff.tsFrom
+			cpaths.deltaInfo.TimestampTo = dbuff.tsTo
+
+			deltaLogs = append(deltaLogs, cpaths.deltaInfo)
+		}
+
+		result.Segments = append(result.Segments, &datapb.CompactionSegment{
+			SegmentID:           segID,
+			NumOfRows:           numRows,
+			InsertLogs:          cpaths.inPaths,
+			Field2StatslogPaths: cpaths.statsPaths,
+			Deltalogs:           deltaLogs,
+			ClusteringKeyRange:  segment.keyRange,
+		})
+
+		rowIDs := make([]int64, 0, numRows)
+		for _, iData := range iDatas {
+			if fd, ok := iData.Data[0].(*storage.Int64FieldData); ok {
+				rowIDs = append(rowIDs, fd.Data...)
+			}
+		}
+		segmentRowIDs = append(segmentRowIDs, rowIDs)
+	}
+
+	t.fillStatistics(result, bytesWritten)
+	status, err := t.dc.CompleteCompaction(ctx, result)
+	if err != nil {
+		log.Error("complete compaction rpc wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+		return nil, err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		log.Error("complete compaction wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.String("reason", status.GetReason()))
+		return nil, fmt.Errorf("complete comapction wrong: %s", status.GetReason())
+	}
+
+	segIDs := make([]UniqueID, 0, len(result.Segments))
+	for i, s := range result.Segments {
+		t.addFlushedSegmentWithPKs(s.GetSegmentID(), collID, partID, t.plan.GetChannel(), s.GetNumOfRows(), segmentRowIDs[i])
+		segIDs = append(segIDs, s.GetSegmentID())
+	}
+	return segIDs, nil
+}
@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactionTaskCluster(t *testing.T) {
+	newMergeItr := func(t *testing.T) iterator {
+		iData := genInsertData()
+		// key 105 is in the reverse order of pk
+		iData.Data[105] = &storage.Int32FieldData{
+			NumRows: []int64{2},
+			Data:    []int32{10, 9},
+		}
+		meta := NewMetaFactory().GetCollectionMeta(1, "test")
+
+		iblobs, err := getInsertBlobs(100, iData, meta)
+		require.NoError(t, err)
+
+		iitr, err := storage.NewInsertBinlogIterator(iblobs, 106)
+		require.NoError(t, err)
+
+		return storage.NewMergeIterator([]iterator{iitr})
+	}
+	schema := NewMetaFactory().GetCollectionMeta(1, "test").GetSchema()
+	ct := &compactionTask{}
+
+	t.Run("Test split by max rows", func(t *testing.T) {
+		segments, err := ct.cluster(newMergeItr(t), map[UniqueID]Timestamp{}, schema, 105, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(segments))
+
+		assert.Equal(t, int64(1), segments[0].numRows)
+		assert.Equal(t, int64(105), segments[0].keyRange.GetFieldID())
+		assert.Equal(t, int64(9), segments[0].keyRange.GetMinInt())
+		assert.Equal(t, int64(9), segments[0].keyRange.GetMaxInt())
+		assert.Equal(t, []interface{}{int64(2)}, segments[0].fID2Content[106])
+
+		assert.Equal(t, int64(1), segments[1].numRows)
+		assert.Equal(t, int64(10), segments[1].keyRange.GetMinInt())
+		assert.Equal(t, int64(10), segments[1].keyRange.GetMaxInt())
+		assert.Equal(t, []interface{}{int64(1)}, segments[1].fID2Content[106])
+	})
+
+	t.Run("Test no max rows", func(t *testing.T) {
+		segments, err := ct.cluster(newMergeItr(t), map[UniqueID]Timestamp{}, schema, 105, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(segments))
+		assert.Equal(t, int64(2), segments[0].numRows)
+		assert.Equal(t, int64(9), segments[0].keyRange.GetMinInt())
+		assert.Equal(t, int64(10), segments[0].keyRange.GetMaxInt())
+	})
+
+	t.Run("Test with deletes", func(t *testing.T) {
+		segments, err := ct.cluster(newMergeItr(t), map[UniqueID]Timestamp{1: 10000}, schema, 105, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(segments))
+		assert.Equal(t, int64(1), segments[0].numRows)
+		assert.Equal(t, int64(9), segments[0].keyRange.GetMinInt())
+	})
+
+	t.Run("Test all rows deleted", func(t *testing.T) {
+		segments, err := ct.cluster(newMergeItr(t), map[UniqueID]Timestamp{1: 10000, 2: 10000}, schema, 105, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(segments))
+		assert.Equal(t, int64(0), segments[0].numRows)
+		assert.Nil(t, segments[0].keyRange)
+	})
+
+	t.Run("Test float key", func(t *testing.T) {
+		segments, err := ct.cluster(newMergeItr(t), map[UniqueID]Timestamp{}, schema, 107, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(segments))
+		assert.InDelta(t, 2.333, segments[0].keyRange.GetMinFloat(), 1e-6)
+		assert.InDelta(t, 2.334, segments[0].keyRange.GetMaxFloat(), 1e-6)
+	})
+
+	t.Run("Test key not in schema", func(t *testing.T) {
+		_, err := ct.cluster(newMergeItr(t), map[UniqueID]Timestamp{}, schema, 999, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("Test key of unsupported type", func(t *testing.T) {
+		_, err := ct.cluster(newMergeItr(t), map[UniqueID]Timestamp{}, schema, 102, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestClusteringKeyRange(t *testing.T) {
+	less, err := clusteringKeyLess(int8(1), int8(2))
+	assert.NoError(t, err)
+	assert.True(t, less)
+
+	less, err = clusteringKeyLess(2.0, 1.0)
+	assert.NoError(t, err)
+	assert.False(t, less)
+
+	_, err = clusteringKeyLess(int32(1), int64(2))
+	assert.Error(t, err)
+
+	_, err = clusteringKeyLess(true, false)
+	assert.Error(t, err)
+
+	keyRange, err := newClusteringKeyRange(&schemapb.FieldSchema{FieldID: 100, DataType: schemapb.DataType_Int16}, int16(-1), int16(7))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), keyRange.GetMinInt())
+	assert.Equal(t, int64(7), keyRange.GetMaxInt())
+
+	keyRange, err = newClusteringKeyRange(&schemapb.FieldSchema{FieldID: 100, DataType: schemapb.DataType_Double}, 1.5, 2.5)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, keyRange.GetMinFloat())
+	assert.Equal(t, 2.5, keyRange.GetMaxFloat())
+
+	_, err = newClusteringKeyRange(&schemapb.FieldSchema{FieldID: 100, DataType: schemapb.DataType_Int64}, 1.5, 2.5)
+	assert.Error(t, err)
+
+	_, err = newClusteringKeyRange(&schemapb.FieldSchema{FieldID: 100, DataType: schemapb.DataType_Bool}, true, false)
+	assert.Error(t, err)
+}
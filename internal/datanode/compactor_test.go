@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,6 +79,9 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			{true, schemapb.DataType_Double, []interface{}{float64(1), float64(2)}, "valid float64"},
 			{true, schemapb.DataType_FloatVector, []interface{}{[]float32{1.0, 2.0}}, "valid floatvector"},
 			{true, schemapb.DataType_BinaryVector, []interface{}{[]byte{255}}, "valid binaryvector"},
+			{true, schemapb.DataType_Float16Vector, []interface{}{[]byte{0, 0x3c, 0, 0x40}}, "valid float16vector"},
+			{true, schemapb.DataType_BFloat16Vector, []interface{}{[]byte{0x80, 0x3f, 0, 0x40}}, "valid bfloat16vector"},
+			{true, schemapb.DataType_SparseFloatVector, []interface{}{typeutil.CreateSparseFloatRow([]uint32{1}, []float32{1}), []byte{}}, "valid sparsefloatvector"},
 			{false, schemapb.DataType_Bool, []interface{}{1, 2}, "invalid bool"},
 			{false, schemapb.DataType_Int8, []interface{}{nil, nil}, "invalid int8"},
 			{false, schemapb.DataType_Int16, []interface{}{nil, nil}, "invalid int16"},
@@ -87,6 +91,9 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			{false, schemapb.DataType_Double, []interface{}{nil, nil}, "invalid float64"},
 			{false, schemapb.DataType_FloatVector, []interface{}{nil, nil}, "invalid floatvector"},
 			{false, schemapb.DataType_BinaryVector, []interface{}{nil, nil}, "invalid binaryvector"},
+			{false, schemapb.DataType_Float16Vector, []interface{}{nil, nil}, "invalid float16vector"},
+			{false, schemapb.DataType_BFloat16Vector, []interface{}{nil, nil}, "invalid bfloat16vector"},
+			{false, schemapb.DataType_SparseFloatVector, []interface{}{nil, nil}, "invalid sparsefloatvector"},
 			{false, schemapb.DataType_String, nil, "invalid data type"},
 		}
 
@@ -374,6 +381,8 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		task := newCompactionTask(context.TODO(), mockbIO, mockbIO, replica, mockfm, alloc, dc, plan)
 		err = task.compact()
 		assert.NoError(t, err)
+		assert.Greater(t, dc.compactionResult.GetBytesRead(), int64(0))
+		assert.Greater(t, dc.compactionResult.GetBytesWritten(), int64(0))
 
 		updates, err := replica.getSegmentStatisticsUpdates(segID)
 		assert.NoError(t, err)
@@ -39,15 +39,16 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -109,6 +110,8 @@ type DataNode struct {
 	clearSignal        chan string // vchannel name
 	segmentCache       *Cache
 	compactionExecutor *compactionExecutor
+	// compactionIOLimiter limits the binlog IO of all the compactions, nil means unlimited
+	compactionIOLimiter *rate.Limiter
 
 	rootCoord types.RootCoord
 	dataCoord types.DataCoord
@@ -383,6 +386,19 @@ func (node *DataNode) ReleaseDataSyncService(vchanName string) {
 	log.Debug("Release flowgraph resources end", zap.String("Vchannel", vchanName))
 }
 
+// newChunkManager creates the ChunkManager binlogs are kept in.
+func newChunkManager(ctx context.Context) (storage.ChunkManager, error) {
+	return storage.NewChunkManager(ctx, &storage.Option{
+		StorageConfig:     Params.Storage,
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	})
+}
+
 // FilterThreshold is the start time ouf DataNode
 var FilterThreshold Timestamp
 
@@ -416,21 +432,12 @@ func (node *DataNode) Start() error {
 		return errors.New("DataNode fail to connect etcd")
 	}
 
-	option := &miniokv.Option{
-		Address:           Params.MinioAddress,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSL,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
-	}
-
-	kv, err := miniokv.NewMinIOKV(node.ctx, option)
+	cm, err := newChunkManager(node.ctx)
 	if err != nil {
 		return err
 	}
 
-	node.blobKv = kv
+	node.blobKv = storage.NewChunkManagerKV(cm)
 
 	if rep.Status.ErrorCode != commonpb.ErrorCode_Success || err != nil {
 		return errors.New("DataNode fail to start")
@@ -440,6 +447,10 @@ func (node *DataNode) Start() error {
 
 	go node.BackGroundGC(node.clearSignal)
 
+	if Params.CompactionIORateLimit > 0 {
+		node.compactionIOLimiter = rate.NewLimiter(rate.Limit(Params.CompactionIORateLimit), int(Params.CompactionIORateLimit))
+	}
+	node.compactionExecutor.setMaxParallel(Params.CompactionMaxParallel)
 	go node.compactionExecutor.start(node.ctx)
 
 	Params.CreatedTime = time.Now()
@@ -602,6 +613,7 @@ func (node *DataNode) FlushSegments(ctx context.Context, req *datapb.FlushSegmen
 				segmentID:    id,
 				collectionID: req.CollectionID,
 				flushed:      flushed,
+				traceCtx:     ctx,
 			}
 		}
 		log.Debug("Flowgraph flushSegment tasks triggered", zap.Bool("flushed", flushed),
@@ -760,7 +772,11 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 		return status, nil
 	}
 
-	binlogIO := &binlogIO{node.blobKv, ds.idAllocator}
+	blobKv := node.blobKv
+	if node.compactionIOLimiter != nil {
+		blobKv = newRateLimitedKV(node.ctx, blobKv, node.compactionIOLimiter)
+	}
+	binlogIO := &binlogIO{blobKv, ds.idAllocator}
 	task := newCompactionTask(
 		node.ctx,
 		binlogIO, binlogIO,
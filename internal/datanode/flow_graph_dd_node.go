@@ -31,7 +31,6 @@ import (
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
 )
 
 // make sure ddNode implements flowgraph.Node
@@ -86,7 +85,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 		return []Msg{}
 	}
 
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range msMsg.TsMessages() {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -172,7 +171,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 	fgMsg.endPositions = append(fgMsg.endPositions, msMsg.EndPositions()...)
 
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 
 	return []Msg{&fgMsg}
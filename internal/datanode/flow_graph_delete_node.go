@@ -28,7 +28,6 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
 )
 
 type (
@@ -189,7 +188,7 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 		return nil
 	}
 
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range fgMsg.deleteMessages {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -239,7 +238,7 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 	}
 
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 	return nil
 }
@@ -26,7 +26,8 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -183,7 +185,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		ibNode.flushManager.startDropping()
 	}
 
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range fgMsg.insertMessages {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -257,6 +259,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		segmentID UniqueID
 		flushed   bool
 		dropped   bool
+		traceCtx  context.Context
 	}
 
 	var (
@@ -324,6 +327,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 			for i, task := range flushTaskList {
 				if task.segmentID == fmsg.segmentID {
 					flushTaskList[i].flushed = fmsg.flushed
+					flushTaskList[i].traceCtx = fmsg.traceCtx
 					dup = true
 					break
 				}
@@ -341,6 +345,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 					segmentID: currentSegID,
 					flushed:   fmsg.flushed,
 					dropped:   false,
+					traceCtx:  fmsg.traceCtx,
 				})
 			}
 		default:
@@ -348,7 +353,22 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 	}
 
 	for _, task := range flushTaskList {
+		// auto flush is traced as a new trace
+		traceCtx := task.traceCtx
+		if traceCtx == nil {
+			traceCtx = context.Background()
+		}
+		sp, _ := trace.StartSpanFromContextWithOperationName(traceCtx, "DataNode-Flush", oteltrace.WithAttributes(
+			attribute.String("vchannel", ibNode.channelName),
+			attribute.Int64("segmentID", task.segmentID),
+			attribute.Bool("flushed", task.flushed),
+			attribute.Bool("dropped", task.dropped)))
+		if task.buffer != nil {
+			sp.SetAttributes(attribute.Int64("rows", task.buffer.size))
+		}
 		err := ibNode.flushManager.flushBufferData(task.buffer, task.segmentID, task.flushed, task.dropped, endPositions[0])
+		trace.LogError(sp, err)
+		sp.End()
 		if err != nil {
 			log.Warn("failed to invoke flushBufferData", zap.Error(err))
 		} else {
@@ -371,7 +391,7 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 	}
 
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 
 	// send delete msg to DeleteNode
@@ -455,6 +475,31 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			break
 		}
 	}
+	if dimension == 0 {
+		// half float vectors take half the memory of float vectors of the same dimension
+		for _, field := range collSchema.Fields {
+			if !typeutil.IsHalfFloatVectorType(field.DataType) {
+				continue
+			}
+			for _, t := range field.TypeParams {
+				if t.Key == "dim" {
+					dimension, err = strconv.Atoi(t.Value)
+					if err != nil {
+						log.Error("strconv wrong on get dim", zap.Error(err))
+						return err
+					}
+					break
+				}
+			}
+			if dimension = dimension / 2; dimension == 0 {
+				dimension = 1
+			}
+			break
+		}
+	}
+	if dimension == 0 && typeutil.HasSparseVectorField(collSchema) {
+		dimension = sparseFloatVectorEstimatedDim
+	}
 
 	newbd, err := newBufferData(int64(dimension))
 	if err != nil {
@@ -543,6 +588,72 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
 
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			var dim int
+			for _, t := range field.TypeParams {
+				if t.Key == "dim" {
+					dim, err = strconv.Atoi(t.Value)
+					if err != nil {
+						log.Error("strconv wrong on get dim", zap.Error(err))
+						return err
+					}
+					break
+				}
+			}
+
+			var data []byte
+			for _, r := range blobReaders {
+				var v []byte = make([]byte, dim*2)
+				readBinary(r, &v, field.DataType)
+
+				data = append(data, v...)
+			}
+
+			if field.DataType == schemapb.DataType_Float16Vector {
+				if _, ok := idata.Data[field.FieldID]; !ok {
+					idata.Data[field.FieldID] = &storage.Float16VectorFieldData{
+						NumRows: make([]int64, 0, 1),
+						Data:    make([]byte, 0),
+						Dim:     dim,
+					}
+				}
+				fieldData := idata.Data[field.FieldID].(*storage.Float16VectorFieldData)
+				fieldData.Data = append(fieldData.Data, data...)
+				fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			} else {
+				if _, ok := idata.Data[field.FieldID]; !ok {
+					idata.Data[field.FieldID] = &storage.BFloat16VectorFieldData{
+						NumRows: make([]int64, 0, 1),
+						Data:    make([]byte, 0),
+						Dim:     dim,
+					}
+				}
+				fieldData := idata.Data[field.FieldID].(*storage.BFloat16VectorFieldData)
+				fieldData.Data = append(fieldData.Data, data...)
+				fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			}
+
+		case schemapb.DataType_SparseFloatVector:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.SparseFloatVectorFieldData{
+					NumRows:  make([]int64, 0, 1),
+					Contents: make([][]byte, 0),
+				}
+			}
+			fieldData := idata.Data[field.FieldID].(*storage.SparseFloatVectorFieldData)
+
+			for _, r := range blobReaders {
+				// a sparse row is prefixed with the number of its non-zero elements
+				var nnz uint32
+				readBinary(r, &nnz, field.DataType)
+				var v []byte = make([]byte, nnz*8)
+				readBinary(r, &v, field.DataType)
+
+				fieldData.AppendRow(v)
+			}
+
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
 		case schemapb.DataType_Bool:
 			if _, ok := idata.Data[field.FieldID]; !ok {
 				idata.Data[field.FieldID] = &storage.BoolFieldData{
@@ -698,6 +809,10 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 	return nil
 }
 
+// sparseFloatVectorEstimatedDim is used to size the buffer of a collection whose only vector field is sparse,
+// it matches the 400 bytes per row estimated by typeutil.EstimateSizePerRecord.
+const sparseFloatVectorEstimatedDim = 100
+
 // readBinary read data in bytes and write it into receiver.
 //  The receiver can be any type in int8, int16, int32, int64, float32, float64 and bool
 //  readBinary uses LittleEndian ByteOrder.
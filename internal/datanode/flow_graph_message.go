@@ -17,6 +17,8 @@
 package datanode
 
 import (
+	"context"
+
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
@@ -52,4 +54,5 @@ type flushMsg struct {
 	segmentID    UniqueID
 	collectionID UniqueID
 	flushed      bool
+	traceCtx     context.Context // carries the span of the flush request
 }
@@ -162,6 +162,7 @@ type DataCoordFactory struct {
 
 	CompleteCompactionError      bool
 	CompleteCompactionNotSuccess bool
+	compactionResult             *datapb.CompactionResult // the last completed compaction result
 
 	DropVirtualChannelError      bool
 	DropVirtualChannelNotSuccess bool
@@ -175,6 +176,7 @@ func (ds *DataCoordFactory) CompleteCompaction(ctx context.Context, req *datapb.
 		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}, nil
 	}
 
+	ds.compactionResult = req
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
@@ -41,6 +41,8 @@ type ParamTable struct {
 	FlowGraphMaxQueueLength int32
 	FlowGraphMaxParallelism int32
 	FlushInsertBufferSize   int64
+	CompactionMaxParallel   int
+	CompactionIORateLimit   int64 // bytes per second of binlog IO of compactions, 0 means unlimited
 	InsertBinlogRootPath    string
 	StatsBinlogRootPath     string
 	DeleteBinlogRootPath    string
@@ -79,6 +81,7 @@ type ParamTable struct {
 	MinioSecretAccessKey string
 	MinioUseSSL          bool
 	MinioBucketName      string
+	Storage              paramtable.StorageConfig
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -107,6 +110,8 @@ func (p *ParamTable) Init() {
 	p.initFlowGraphMaxQueueLength()
 	p.initFlowGraphMaxParallelism()
 	p.initFlushInsertBufferSize()
+	p.initCompactionMaxParallel()
+	p.initCompactionIORateLimit()
 	p.initInsertBinlogRootPath()
 	p.initStatsBinlogRootPath()
 	p.initDeleteBinlogRootPath()
@@ -128,6 +133,7 @@ func (p *ParamTable) Init() {
 	p.initMinioSecretAccessKey()
 	p.initMinioUseSSL()
 	p.initMinioBucketName()
+	p.initStorage()
 
 	p.initDmlChannelName()
 	p.initDeltaChannelName()
@@ -147,6 +153,17 @@ func (p *ParamTable) initFlushInsertBufferSize() {
 	p.FlushInsertBufferSize = p.ParseInt64("_DATANODE_INSERTBUFSIZE")
 }
 
+func (p *ParamTable) initCompactionMaxParallel() {
+	p.CompactionMaxParallel = p.ParseIntWithDefault("dataNode.compaction.maxParallel", 2)
+	if p.CompactionMaxParallel <= 0 {
+		panic("dataNode.compaction.maxParallel should be positive")
+	}
+}
+
+func (p *ParamTable) initCompactionIORateLimit() {
+	p.CompactionIORateLimit = p.ParseInt64WithDefault("dataNode.compaction.ioRateLimit", 0) * 1024 * 1024
+}
+
 func (p *ParamTable) initInsertBinlogRootPath() {
 	// GOOSE TODO: rootPath change to  TenentID
 	rootPath, err := p.Load("minio.rootPath")
@@ -288,6 +305,10 @@ func (p *ParamTable) initMinioBucketName() {
 	p.MinioBucketName = bucketName
 }
 
+func (p *ParamTable) initStorage() {
+	p.Storage = p.LoadStorageConfig()
+}
+
 func (p *ParamTable) initRoleName() {
 	p.RoleName = "datanode"
 }
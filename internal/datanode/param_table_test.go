@@ -55,6 +55,14 @@ func TestParamTable(t *testing.T) {
 		log.Println("FlushInsertBufferSize:", size)
 	})
 
+	t.Run("Test CompactionMaxParallel", func(t *testing.T) {
+		assert.Equal(t, 2, Params.CompactionMaxParallel)
+	})
+
+	t.Run("Test CompactionIORateLimit", func(t *testing.T) {
+		assert.Equal(t, int64(0), Params.CompactionIORateLimit)
+	})
+
 	t.Run("Test InsertBinlogRootPath", func(t *testing.T) {
 		path := Params.InsertBinlogRootPath
 		log.Println("InsertBinlogRootPath:", path)
@@ -28,7 +28,6 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -124,17 +123,7 @@ func (s *Segment) updatePKRange(pks []int64) {
 var _ Replica = &SegmentReplica{}
 
 func newReplica(ctx context.Context, rc types.RootCoord, collID UniqueID) (*SegmentReplica, error) {
-	// MinIO
-	option := &miniokv.Option{
-		Address:           Params.MinioAddress,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSL,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
-	}
-
-	minIOKV, err := miniokv.NewMinIOKV(ctx, option)
+	cm, err := newChunkManager(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +138,7 @@ func newReplica(ctx context.Context, rc types.RootCoord, collID UniqueID) (*Segm
 		flushedSegments: make(map[UniqueID]*Segment),
 
 		metaService: metaService,
-		minIOKV:     minIOKV,
+		minIOKV:     storage.NewChunkManagerKV(cm),
 	}
 
 	return replica, nil
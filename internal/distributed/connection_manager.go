@@ -24,7 +24,6 @@ import (
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -382,7 +381,6 @@ func (bct *buildClientTask) Run() {
 	go func() {
 		defer bct.finish()
 		connectGrpcFunc := func() error {
-			opts := trace.GetInterceptorOpts()
 			log.Debug("Grpc connect ", zap.String("Address", bct.sess.Address))
 			conn, err := grpc.DialContext(bct.ctx, bct.sess.Address,
 				grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(30*time.Second),
@@ -393,7 +391,7 @@ func (bct *buildClientTask) Run() {
 							grpc_retry.WithMax(3),
 							grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 						),
-						grpc_opentracing.UnaryClientInterceptor(opts...),
+						trace.UnaryClientInterceptor(),
 					)),
 				grpc.WithStreamInterceptor(
 					grpc_middleware.ChainStreamClient(
@@ -401,7 +399,7 @@ func (bct *buildClientTask) Run() {
 							grpc_retry.WithMax(3),
 							grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 						),
-						grpc_opentracing.StreamClientInterceptor(opts...),
+						trace.StreamClientInterceptor(),
 					)),
 			)
 			if err != nil {
@@ -437,6 +437,62 @@ func (c *Client) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return ret.(*milvuspb.GetCompactionPlansResponse), err
 }
 
+// SetCompactionPolicy sets the compaction policy of a collection
+func (c *Client) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).SetCompactionPolicy(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// DryRunCompaction returns the compaction plans a policy would generate for a collection without executing them
+func (c *Client) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).DryRunCompaction(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.DryRunCompactionResponse), err
+}
+
+// GetCompactionHistory returns the records of completed compaction plans of a collection
+func (c *Client) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).GetCompactionHistory(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.GetCompactionHistoryResponse), err
+}
+
+// RecoverDroppedSegments restores the segments dropped together with the channels of a collection
+func (c *Client) RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).RecoverDroppedSegments(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
 // WatchChannels notifies DataCoord to watch vchannels of a collection
 func (c *Client) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
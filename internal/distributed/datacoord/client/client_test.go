@@ -118,6 +118,18 @@ func Test_NewClient(t *testing.T) {
 
 		r21, err := client.DropVirtualChannel(ctx, nil)
 		retCheck(retNotNil, r21, err)
+
+		r22, err := client.SetCompactionPolicy(ctx, nil)
+		retCheck(retNotNil, r22, err)
+
+		r23, err := client.DryRunCompaction(ctx, nil)
+		retCheck(retNotNil, r23, err)
+
+		r24, err := client.GetCompactionHistory(ctx, nil)
+		retCheck(retNotNil, r24, err)
+
+		r25, err := client.RecoverDroppedSegments(ctx, nil)
+		retCheck(retNotNil, r25, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
@@ -32,7 +32,6 @@ import (
 
 	"google.golang.org/grpc"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/milvus-io/milvus/internal/datacoord"
 	"github.com/milvus-io/milvus/internal/log"
@@ -141,16 +140,15 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		Timeout: 10 * time.Second, // Wait 10 second for the ping ack before assuming the connection is dead
 	}
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
+			trace.UnaryServerInterceptor()),
 		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+			trace.StreamServerInterceptor()))
 	//grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor))
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
 	grpc_prometheus.Register(s.grpcServer)
@@ -299,6 +297,26 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return s.dataCoord.GetCompactionStateWithPlans(ctx, req)
 }
 
+// SetCompactionPolicy sets the compaction policy of a collection
+func (s *Server) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	return s.dataCoord.SetCompactionPolicy(ctx, req)
+}
+
+// DryRunCompaction returns the compaction plans a policy would generate for a collection without executing them
+func (s *Server) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	return s.dataCoord.DryRunCompaction(ctx, req)
+}
+
+// GetCompactionHistory returns the records of completed compaction plans of a collection
+func (s *Server) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	return s.dataCoord.GetCompactionHistory(ctx, req)
+}
+
+// RecoverDroppedSegments restores the segments dropped together with the channels of a collection
+func (s *Server) RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.RecoverDroppedSegments(ctx, req)
+}
+
 // WatchChannels starts watch channels by give request
 func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return s.dataCoord.WatchChannels(ctx, req)
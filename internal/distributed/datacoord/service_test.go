@@ -51,6 +51,8 @@ type MockDataCoord struct {
 	compactionStateResp  *milvuspb.GetCompactionStateResponse
 	manualCompactionResp *milvuspb.ManualCompactionResponse
 	compactionPlansResp  *milvuspb.GetCompactionPlansResponse
+	dryRunCompactionResp *milvuspb.DryRunCompactionResponse
+	historyResp          *milvuspb.GetCompactionHistoryResponse
 	watchChannelsResp    *datapb.WatchChannelsResponse
 	getFlushStateResp    *milvuspb.GetFlushStateResponse
 	dropVChanResp        *datapb.DropVirtualChannelResponse
@@ -148,6 +150,22 @@ func (m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *mi
 	return m.compactionPlansResp, m.err
 }
 
+func (m *MockDataCoord) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	return m.dryRunCompactionResp, m.err
+}
+
+func (m *MockDataCoord) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	return m.historyResp, m.err
+}
+
+func (m *MockDataCoord) RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return m.watchChannelsResp, m.err
 }
@@ -335,6 +353,15 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("RecoverDroppedSegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.RecoverDroppedSegments(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
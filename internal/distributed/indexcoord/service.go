@@ -30,7 +30,6 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 
-	ot "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/indexcoord"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -179,6 +178,21 @@ func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsReq
 	return s.indexcoord.GetMetrics(ctx, request)
 }
 
+// ListIndexBuildTasks lists the index build tasks recorded in IndexCoord.
+func (s *Server) ListIndexBuildTasks(ctx context.Context, req *indexpb.ListIndexBuildTasksRequest) (*indexpb.ListIndexBuildTasksResponse, error) {
+	return s.indexcoord.ListIndexBuildTasks(ctx, req)
+}
+
+// CancelIndexBuild cancels an index build task in IndexCoord.
+func (s *Server) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	return s.indexcoord.CancelIndexBuild(ctx, req)
+}
+
+// RetryIndexBuild requeues the failed index build tasks in IndexCoord.
+func (s *Server) RetryIndexBuild(ctx context.Context, req *indexpb.RetryIndexBuildRequest) (*commonpb.Status, error) {
+	return s.indexcoord.RetryIndexBuild(ctx, req)
+}
+
 // startGrpcLoop starts the grep loop of IndexCoord component.
 func (s *Server) startGrpcLoop(grpcPort int) {
 
@@ -204,14 +218,13 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.loopCtx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(trace.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(trace.StreamServerInterceptor()))
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -0,0 +1,392 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// fieldDataJSON is the JSON form of a schemapb.FieldData column accepted by the insert API.
+// Values holds one element per row, encoded by the data type:
+//   - Bool, Int8, Int16, Int32, Int64, Float, Double, String: JSON scalars
+//   - FloatVector, Float16Vector, BFloat16Vector: arrays of numbers
+//   - BinaryVector: arrays of byte values in [0, 255], dim/8 bytes per row
+//   - SparseFloatVector: objects like {"indices": [1, 5], "values": [0.1, 0.2]}
+type fieldDataJSON struct {
+	FieldName string          `json:"field_name"`
+	Type      string          `json:"type,omitempty"`
+	Values    json.RawMessage `json:"values"`
+}
+
+// fieldColumn is the JSON form of a schemapb.FieldData column returned to clients,
+// with the same per row encoding as fieldDataJSON.
+type fieldColumn struct {
+	FieldName string        `json:"field_name"`
+	Type      string        `json:"type"`
+	Values    []interface{} `json:"values"`
+}
+
+// slice returns the rows in [start, end) of the column.
+func (c *fieldColumn) slice(start, end int) *fieldColumn {
+	return &fieldColumn{
+		FieldName: c.FieldName,
+		Type:      c.Type,
+		Values:    c.Values[start:end],
+	}
+}
+
+type sparseRowJSON struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+// parseDataType parses the name of a schemapb.DataType, such as "Int64" or "FloatVector".
+func parseDataType(name string) (schemapb.DataType, error) {
+	v, ok := schemapb.DataType_value[name]
+	if !ok || schemapb.DataType(v) == schemapb.DataType_None {
+		return schemapb.DataType_None, fmt.Errorf("invalid data type: %s", name)
+	}
+	return schemapb.DataType(v), nil
+}
+
+// decodeFieldData converts the JSON column into a schemapb.FieldData of dataType,
+// it also returns the number of rows in the column.
+func decodeFieldData(field *fieldDataJSON, dataType schemapb.DataType) (*schemapb.FieldData, int, error) {
+	fd := &schemapb.FieldData{
+		Type:      dataType,
+		FieldName: field.FieldName,
+	}
+	var rows int
+	var err error
+	switch dataType {
+	case schemapb.DataType_Bool:
+		var data []bool
+		err = json.Unmarshal(field.Values, &data)
+		rows = len(data)
+		fd.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}})
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		var data []int32
+		err = json.Unmarshal(field.Values, &data)
+		rows = len(data)
+		fd.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}})
+	case schemapb.DataType_Int64:
+		var data []int64
+		err = json.Unmarshal(field.Values, &data)
+		rows = len(data)
+		fd.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}})
+	case schemapb.DataType_Float:
+		var data []float32
+		err = json.Unmarshal(field.Values, &data)
+		rows = len(data)
+		fd.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}})
+	case schemapb.DataType_Double:
+		var data []float64
+		err = json.Unmarshal(field.Values, &data)
+		rows = len(data)
+		fd.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}})
+	case schemapb.DataType_String:
+		var data []string
+		err = json.Unmarshal(field.Values, &data)
+		rows = len(data)
+		fd.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}})
+	case schemapb.DataType_FloatVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		var data []float32
+		var dim int
+		data, dim, err = decodeFloatRows(field.Values)
+		if err != nil {
+			break
+		}
+		if dim > 0 {
+			rows = len(data) / dim
+		}
+		vf := &schemapb.VectorField{Dim: int64(dim)}
+		switch dataType {
+		case schemapb.DataType_FloatVector:
+			vf.Data = &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}}
+		case schemapb.DataType_Float16Vector:
+			vf.Data = &schemapb.VectorField_Float16Vector{Float16Vector: typeutil.Float32sToFloat16Bytes(data)}
+		default:
+			vf.Data = &schemapb.VectorField_Bfloat16Vector{Bfloat16Vector: typeutil.Float32sToBFloat16Bytes(data)}
+		}
+		fd.Field = &schemapb.FieldData_Vectors{Vectors: vf}
+	case schemapb.DataType_BinaryVector:
+		var data []byte
+		var dim int
+		data, dim, err = decodeBinaryRows(field.Values)
+		if dim > 0 {
+			rows = len(data) / (dim / 8)
+		}
+		fd.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  int64(dim),
+			Data: &schemapb.VectorField_BinaryVector{BinaryVector: data},
+		}}
+	case schemapb.DataType_SparseFloatVector:
+		var contents [][]byte
+		var dim int64
+		contents, dim, err = decodeSparseRows(field.Values)
+		rows = len(contents)
+		fd.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim: dim,
+			Data: &schemapb.VectorField_SparseFloatVector{SparseFloatVector: &schemapb.SparseFloatArray{
+				Contents: contents,
+				Dim:      dim,
+			}},
+		}}
+	default:
+		return nil, 0, fmt.Errorf("unsupported data type %s of field %s", dataType.String(), field.FieldName)
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to decode values of field %s as %s: %w", field.FieldName, dataType.String(), err)
+	}
+	return fd, rows, nil
+}
+
+func scalarField(sf *schemapb.ScalarField) *schemapb.FieldData_Scalars {
+	return &schemapb.FieldData_Scalars{Scalars: sf}
+}
+
+// decodeFloatRows decodes an array of float rows into a flat slice, all rows must have the same dimension.
+func decodeFloatRows(raw json.RawMessage) ([]float32, int, error) {
+	var rows [][]float32
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, 0, nil
+	}
+	dim := len(rows[0])
+	data := make([]float32, 0, len(rows)*dim)
+	for i, row := range rows {
+		if len(row) != dim || dim == 0 {
+			return nil, 0, fmt.Errorf("dimension of row %d is %d, expected %d", i, len(row), dim)
+		}
+		data = append(data, row...)
+	}
+	return data, dim, nil
+}
+
+// decodeBinaryRows decodes an array of byte value rows into a flat slice, the dimension is 8 bits per byte.
+// The rows are not decoded as []byte since encoding/json takes them for base64 strings.
+func decodeBinaryRows(raw json.RawMessage) ([]byte, int, error) {
+	var rows [][]int
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, 0, nil
+	}
+	width := len(rows[0])
+	data := make([]byte, 0, len(rows)*width)
+	for i, row := range rows {
+		if len(row) != width || width == 0 {
+			return nil, 0, fmt.Errorf("length of row %d is %d, expected %d", i, len(row), width)
+		}
+		for _, v := range row {
+			if v < 0 || v > 255 {
+				return nil, 0, fmt.Errorf("row %d contains invalid byte value: %d", i, v)
+			}
+			data = append(data, byte(v))
+		}
+	}
+	return data, width * 8, nil
+}
+
+// decodeSparseRows decodes an array of sparse rows, the returned dimension is the max dimension of all rows.
+func decodeSparseRows(raw json.RawMessage) ([][]byte, int64, error) {
+	var rows []sparseRowJSON
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return nil, 0, err
+	}
+	contents := make([][]byte, 0, len(rows))
+	var dim int64
+	for i, row := range rows {
+		if len(row.Indices) != len(row.Values) {
+			return nil, 0, fmt.Errorf("row %d has %d indices but %d values", i, len(row.Indices), len(row.Values))
+		}
+		content := typeutil.CreateSparseFloatRow(row.Indices, row.Values)
+		if err := typeutil.ValidateSparseFloatRows(content); err != nil {
+			return nil, 0, fmt.Errorf("row %d is invalid: %w", i, err)
+		}
+		if rowDim := typeutil.SparseFloatRowDim(content); rowDim > dim {
+			dim = rowDim
+		}
+		contents = append(contents, content)
+	}
+	return contents, dim, nil
+}
+
+// encodeFieldData converts a schemapb.FieldData into its JSON column.
+func encodeFieldData(fd *schemapb.FieldData) (*fieldColumn, error) {
+	col := &fieldColumn{
+		FieldName: fd.GetFieldName(),
+		Type:      fd.GetType().String(),
+	}
+	switch fd.GetType() {
+	case schemapb.DataType_Bool:
+		for _, v := range fd.GetScalars().GetBoolData().GetData() {
+			col.Values = append(col.Values, v)
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		for _, v := range fd.GetScalars().GetIntData().GetData() {
+			col.Values = append(col.Values, v)
+		}
+	case schemapb.DataType_Int64:
+		for _, v := range fd.GetScalars().GetLongData().GetData() {
+			col.Values = append(col.Values, v)
+		}
+	case schemapb.DataType_Float:
+		for _, v := range fd.GetScalars().GetFloatData().GetData() {
+			col.Values = append(col.Values, v)
+		}
+	case schemapb.DataType_Double:
+		for _, v := range fd.GetScalars().GetDoubleData().GetData() {
+			col.Values = append(col.Values, v)
+		}
+	case schemapb.DataType_String:
+		for _, v := range fd.GetScalars().GetStringData().GetData() {
+			col.Values = append(col.Values, v)
+		}
+	case schemapb.DataType_FloatVector:
+		col.Values = splitFloatRows(fd.GetVectors().GetFloatVector().GetData(), fd.GetVectors().GetDim())
+	case schemapb.DataType_Float16Vector:
+		data := typeutil.Float16BytesToFloat32s(fd.GetVectors().GetFloat16Vector())
+		col.Values = splitFloatRows(data, fd.GetVectors().GetDim())
+	case schemapb.DataType_BFloat16Vector:
+		data := typeutil.BFloat16BytesToFloat32s(fd.GetVectors().GetBfloat16Vector())
+		col.Values = splitFloatRows(data, fd.GetVectors().GetDim())
+	case schemapb.DataType_BinaryVector:
+		data := fd.GetVectors().GetBinaryVector()
+		width := int(fd.GetVectors().GetDim() / 8)
+		if width <= 0 {
+			break
+		}
+		for i := 0; i+width <= len(data); i += width {
+			row := make([]int, width)
+			for j := range row {
+				row[j] = int(data[i+j])
+			}
+			col.Values = append(col.Values, row)
+		}
+	case schemapb.DataType_SparseFloatVector:
+		for _, content := range fd.GetVectors().GetSparseFloatVector().GetContents() {
+			n := typeutil.SparseFloatRowElementCount(content)
+			row := sparseRowJSON{
+				Indices: make([]uint32, n),
+				Values:  make([]float32, n),
+			}
+			for i := 0; i < n; i++ {
+				row.Indices[i] = typeutil.SparseFloatRowIndexAt(content, i)
+				row.Values[i] = typeutil.SparseFloatRowValueAt(content, i)
+			}
+			col.Values = append(col.Values, row)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field %s", fd.GetType().String(), fd.GetFieldName())
+	}
+	if col.Values == nil {
+		col.Values = []interface{}{}
+	}
+	return col, nil
+}
+
+func splitFloatRows(data []float32, dim int64) []interface{} {
+	if dim <= 0 {
+		return nil
+	}
+	rows := make([]interface{}, 0, int64(len(data))/dim)
+	for i := int64(0); i+dim <= int64(len(data)); i += dim {
+		rows = append(rows, data[i:i+dim])
+	}
+	return rows
+}
+
+// encodeIDs converts the primary keys into a JSON array.
+func encodeIDs(ids *schemapb.IDs) []interface{} {
+	values := make([]interface{}, 0)
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		for _, id := range ids.GetIntId().GetData() {
+			values = append(values, id)
+		}
+	case *schemapb.IDs_StrId:
+		for _, id := range ids.GetStrId().GetData() {
+			values = append(values, id)
+		}
+	}
+	return values
+}
+
+// encodePlaceholderGroup converts the JSON query vectors of dataType into a
+// milvuspb.PlaceholderGroup, the vectors are encoded the same way as the insert values.
+func encodePlaceholderGroup(raw json.RawMessage, dataType schemapb.DataType) (*milvuspb.PlaceholderGroup, error) {
+	if !typeutil.IsVectorType(dataType) {
+		return nil, fmt.Errorf("cannot search on field of type %s", dataType.String())
+	}
+	fd, _, err := decodeFieldData(&fieldDataJSON{FieldName: "vectors", Values: raw}, dataType)
+	if err != nil {
+		return nil, err
+	}
+	vf := fd.GetVectors()
+	var values [][]byte
+	switch dataType {
+	case schemapb.DataType_FloatVector:
+		data := vf.GetFloatVector().GetData()
+		for i := int64(0); i < int64(len(data)); i += vf.GetDim() {
+			row := make([]byte, 0, vf.GetDim()*4)
+			for _, v := range data[i : i+vf.GetDim()] {
+				row = append(row, typeutil.Float32ToBytes(v)...)
+			}
+			values = append(values, row)
+		}
+	case schemapb.DataType_Float16Vector:
+		values = splitBytes(vf.GetFloat16Vector(), int(vf.GetDim())*2)
+	case schemapb.DataType_BFloat16Vector:
+		values = splitBytes(vf.GetBfloat16Vector(), int(vf.GetDim())*2)
+	case schemapb.DataType_BinaryVector:
+		values = splitBytes(vf.GetBinaryVector(), int(vf.GetDim())/8)
+	case schemapb.DataType_SparseFloatVector:
+		values = vf.GetSparseFloatVector().GetContents()
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no query vectors")
+	}
+	return &milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{
+				Tag:    "$0",
+				Type:   milvuspb.PlaceholderType(dataType),
+				Values: values,
+			},
+		},
+	}, nil
+}
+
+func splitBytes(data []byte, width int) [][]byte {
+	if width <= 0 {
+		return nil
+	}
+	rows := make([][]byte, 0, len(data)/width)
+	for i := 0; i+width <= len(data); i += width {
+		rows = append(rows, data[i:i+width])
+	}
+	return rows
+}
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDataType(t *testing.T) {
+	dataType, err := parseDataType("FloatVector")
+	assert.Nil(t, err)
+	assert.Equal(t, schemapb.DataType_FloatVector, dataType)
+
+	_, err = parseDataType("None")
+	assert.NotNil(t, err)
+
+	_, err = parseDataType("Vector")
+	assert.NotNil(t, err)
+}
+
+func TestFieldDataCodec(t *testing.T) {
+	cases := []struct {
+		dataType schemapb.DataType
+		values   string
+		rows     int
+	}{
+		{schemapb.DataType_Bool, `[true,false]`, 2},
+		{schemapb.DataType_Int8, `[1,2,3]`, 3},
+		{schemapb.DataType_Int16, `[1,2,3]`, 3},
+		{schemapb.DataType_Int32, `[1,2,3]`, 3},
+		{schemapb.DataType_Int64, `[1,9007199254740993]`, 2},
+		{schemapb.DataType_Float, `[1.5,2.5]`, 2},
+		{schemapb.DataType_Double, `[1.5,2.5]`, 2},
+		{schemapb.DataType_String, `["a","b"]`, 2},
+		{schemapb.DataType_FloatVector, `[[1,2],[3,4]]`, 2},
+		{schemapb.DataType_Float16Vector, `[[1,2],[3,4]]`, 2},
+		{schemapb.DataType_BFloat16Vector, `[[1,2],[3,4]]`, 2},
+		{schemapb.DataType_BinaryVector, `[[1,255],[0,128]]`, 2},
+		{schemapb.DataType_SparseFloatVector, `[{"indices":[1,5],"values":[0.5,1.5]},{"indices":[],"values":[]}]`, 2},
+	}
+	for _, c := range cases {
+		fd, rows, err := decodeFieldData(&fieldDataJSON{FieldName: "f", Values: json.RawMessage(c.values)}, c.dataType)
+		assert.Nil(t, err, c.dataType.String())
+		assert.Equal(t, c.rows, rows, c.dataType.String())
+		assert.Equal(t, c.dataType, fd.GetType())
+		assert.Equal(t, "f", fd.GetFieldName())
+
+		col, err := encodeFieldData(fd)
+		assert.Nil(t, err)
+		assert.Equal(t, c.dataType.String(), col.Type)
+		assert.Equal(t, c.rows, len(col.Values))
+		out, err := json.Marshal(col.Values)
+		assert.Nil(t, err)
+		assert.JSONEq(t, c.values, string(out), c.dataType.String())
+	}
+
+	fd, _, err := decodeFieldData(&fieldDataJSON{FieldName: "f", Values: json.RawMessage(`[[1,2],[3,4]]`)}, schemapb.DataType_FloatVector)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), fd.GetVectors().GetDim())
+	fd, _, err = decodeFieldData(&fieldDataJSON{FieldName: "f", Values: json.RawMessage(`[[1,2],[3,4]]`)}, schemapb.DataType_BinaryVector)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(16), fd.GetVectors().GetDim())
+	fd, _, err = decodeFieldData(&fieldDataJSON{FieldName: "f", Values: json.RawMessage(`[{"indices":[1,5],"values":[0.5,1.5]}]`)}, schemapb.DataType_SparseFloatVector)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), fd.GetVectors().GetSparseFloatVector().GetDim())
+}
+
+func TestDecodeFieldData_Invalid(t *testing.T) {
+	cases := []struct {
+		dataType schemapb.DataType
+		values   string
+	}{
+		{schemapb.DataType_Int64, `["a"]`},
+		{schemapb.DataType_FloatVector, `[[1,2],[3]]`},
+		{schemapb.DataType_FloatVector, `[[]]`},
+		{schemapb.DataType_BinaryVector, `[[256]]`},
+		{schemapb.DataType_BinaryVector, `"AQI="`},
+		{schemapb.DataType_SparseFloatVector, `[{"indices":[1],"values":[]}]`},
+		{schemapb.DataType_SparseFloatVector, `[{"indices":[5,1],"values":[1,2]}]`},
+		{schemapb.DataType_None, `[]`},
+	}
+	for _, c := range cases {
+		_, _, err := decodeFieldData(&fieldDataJSON{FieldName: "f", Values: json.RawMessage(c.values)}, c.dataType)
+		assert.NotNil(t, err, c.values)
+	}
+}
+
+func TestFieldColumn_slice(t *testing.T) {
+	col := &fieldColumn{FieldName: "f", Type: "Int64", Values: []interface{}{1, 2, 3}}
+	s := col.slice(1, 3)
+	assert.Equal(t, "f", s.FieldName)
+	assert.Equal(t, []interface{}{2, 3}, s.Values)
+}
+
+func TestEncodeIDs(t *testing.T) {
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, encodeIDs(&schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}},
+	}))
+	assert.Equal(t, []interface{}{"a"}, encodeIDs(&schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a"}}},
+	}))
+	assert.Equal(t, []interface{}{}, encodeIDs(nil))
+}
+
+func TestEncodePlaceholderGroup(t *testing.T) {
+	group, err := encodePlaceholderGroup(json.RawMessage(`[[1,2],[3,4],[5,6]]`), schemapb.DataType_FloatVector)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(group.GetPlaceholders()))
+	placeholder := group.GetPlaceholders()[0]
+	assert.Equal(t, "$0", placeholder.GetTag())
+	assert.Equal(t, milvuspb.PlaceholderType_FloatVector, placeholder.GetType())
+	assert.Equal(t, 3, len(placeholder.GetValues()))
+	assert.Equal(t, float32(3), typeutil.BytesToFloat32(placeholder.GetValues()[1][:4]))
+
+	group, err = encodePlaceholderGroup(json.RawMessage(`[[1,2]]`), schemapb.DataType_Float16Vector)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{1, 2}, typeutil.Float16BytesToFloat32s(group.GetPlaceholders()[0].GetValues()[0]))
+
+	group, err = encodePlaceholderGroup(json.RawMessage(`[[1,2],[3,4]]`), schemapb.DataType_BinaryVector)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{{1, 2}, {3, 4}}, group.GetPlaceholders()[0].GetValues())
+
+	group, err = encodePlaceholderGroup(json.RawMessage(`[{"indices":[3],"values":[0.5]}]`), schemapb.DataType_SparseFloatVector)
+	assert.Nil(t, err)
+	assert.Equal(t, milvuspb.PlaceholderType_SparseFloatVector, group.GetPlaceholders()[0].GetType())
+	assert.Equal(t, typeutil.CreateSparseFloatRow([]uint32{3}, []float32{0.5}), group.GetPlaceholders()[0].GetValues()[0])
+
+	_, err = encodePlaceholderGroup(json.RawMessage(`[1,2]`), schemapb.DataType_Int64)
+	assert.NotNil(t, err)
+	_, err = encodePlaceholderGroup(json.RawMessage(`[]`), schemapb.DataType_FloatVector)
+	assert.NotNil(t, err)
+	_, err = encodePlaceholderGroup(json.RawMessage(`[[1],[2,3]]`), schemapb.DataType_FloatVector)
+	assert.NotNil(t, err)
+}
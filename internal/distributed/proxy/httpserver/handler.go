@@ -0,0 +1,708 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// APIPrefix is the path prefix of the RESTful data-plane API.
+const APIPrefix = "/api/v1/"
+
+const collectionsPath = APIPrefix + "collections"
+
+// errBodyTooLarge is the error message of http.MaxBytesReader when the request body exceeds the limit
+const errBodyTooLarge = "http: request body too large"
+
+// ProxyAPI is the subset of Proxy methods served through the RESTful API,
+// types.ProxyComponent satisfies it.
+type ProxyAPI interface {
+	CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+	HasCollection(ctx context.Context, request *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error)
+	LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)
+	ReleaseCollection(ctx context.Context, request *milvuspb.ReleaseCollectionRequest) (*commonpb.Status, error)
+	DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	GetCollectionStatistics(ctx context.Context, request *milvuspb.GetCollectionStatisticsRequest) (*milvuspb.GetCollectionStatisticsResponse, error)
+	ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
+	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
+	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+	Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error)
+	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+	Get(ctx context.Context, request *milvuspb.GetRequest) (*milvuspb.QueryResults, error)
+}
+
+// Handler serves the RESTful data-plane API of Proxy. Requests and responses are JSON,
+// and every request is translated into a call of the Proxy methods:
+//
+//	GET    /api/v1/collections                      ShowCollections
+//	POST   /api/v1/collections                      CreateCollection
+//	GET    /api/v1/collections/{name}               DescribeCollection
+//	DELETE /api/v1/collections/{name}               DropCollection
+//	GET    /api/v1/collections/{name}/existence     HasCollection
+//	GET    /api/v1/collections/{name}/statistics    GetCollectionStatistics
+//	POST   /api/v1/collections/{name}/load          LoadCollection
+//	POST   /api/v1/collections/{name}/release       ReleaseCollection
+//	POST   /api/v1/collections/{name}/flush         Flush
+//	POST   /api/v1/collections/{name}/entities      Insert
+//	POST   /api/v1/collections/{name}/delete        Delete
+//	POST   /api/v1/collections/{name}/search        Search
+//	POST   /api/v1/collections/{name}/query         Query
+//	POST   /api/v1/collections/{name}/get           Get
+//
+// A failed request is answered with a non 2xx code and a body like
+// {"error_code": "UnexpectedError", "reason": "..."}.
+type Handler struct {
+	proxy       ProxyAPI
+	maxBodySize int64
+}
+
+// NewHandler creates a Handler serving the RESTful API with the given Proxy,
+// request bodies larger than maxBodySize bytes are rejected.
+func NewHandler(proxy ProxyAPI, maxBodySize int64) *Handler {
+	return &Handler{proxy: proxy, maxBodySize: maxBodySize}
+}
+
+type collectionRoute func(h *Handler, w http.ResponseWriter, r *http.Request, collection string)
+
+var collectionRoutes = map[string]map[string]collectionRoute{
+	"": {
+		http.MethodGet:    (*Handler).describeCollection,
+		http.MethodDelete: (*Handler).dropCollection,
+	},
+	"existence":  {http.MethodGet: (*Handler).hasCollection},
+	"statistics": {http.MethodGet: (*Handler).getCollectionStatistics},
+	"load":       {http.MethodPost: (*Handler).loadCollection},
+	"release":    {http.MethodPost: (*Handler).releaseCollection},
+	"flush":      {http.MethodPost: (*Handler).flush},
+	"entities":   {http.MethodPost: (*Handler).insert},
+	"delete":     {http.MethodPost: (*Handler).delete},
+	"search":     {http.MethodPost: (*Handler).search},
+	"query":      {http.MethodPost: (*Handler).query},
+	"get":        {http.MethodPost: (*Handler).get},
+}
+
+// Register registers the handler on mux for all the paths under APIPrefix.
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.Handle(APIPrefix, h)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBodySize)
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	if path == collectionsPath {
+		switch r.Method {
+		case http.MethodGet:
+			h.showCollections(w, r)
+		case http.MethodPost:
+			h.createCollection(w, r)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, commonpb.ErrorCode_UnexpectedError, "method "+r.Method+" not allowed")
+		}
+		return
+	}
+
+	if !strings.HasPrefix(path, collectionsPath+"/") {
+		writeError(w, http.StatusNotFound, commonpb.ErrorCode_UnexpectedError, "path "+r.URL.Path+" not found")
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(path, collectionsPath+"/"), "/")
+	if len(parts) > 2 || parts[0] == "" {
+		writeError(w, http.StatusNotFound, commonpb.ErrorCode_UnexpectedError, "path "+r.URL.Path+" not found")
+		return
+	}
+	action := ""
+	if len(parts) == 2 {
+		action = parts[1]
+	}
+	methods, ok := collectionRoutes[action]
+	if !ok {
+		writeError(w, http.StatusNotFound, commonpb.ErrorCode_UnexpectedError, "path "+r.URL.Path+" not found")
+		return
+	}
+	route, ok := methods[r.Method]
+	if !ok {
+		writeError(w, http.StatusMethodNotAllowed, commonpb.ErrorCode_UnexpectedError, "method "+r.Method+" not allowed")
+		return
+	}
+	route(h, w, r, parts[0])
+}
+
+func (h *Handler) showCollections(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.proxy.ShowCollections(r.Context(), &milvuspb.ShowCollectionsRequest{})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"collection_names":       nonNilStrings(resp.GetCollectionNames()),
+		"collection_ids":         resp.GetCollectionIds(),
+		"created_utc_timestamps": resp.GetCreatedUtcTimestamps(),
+	})
+}
+
+type createCollectionRequest struct {
+	CollectionName string          `json:"collection_name"`
+	ShardsNum      int32           `json:"shards_num"`
+	Schema         json.RawMessage `json:"schema"`
+}
+
+func (h *Handler) createCollection(w http.ResponseWriter, r *http.Request) {
+	req := &createCollectionRequest{}
+	if !decodeBody(w, r, req) {
+		return
+	}
+	// the schema follows the protobuf JSON mapping of schemapb.CollectionSchema
+	schema := &schemapb.CollectionSchema{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(req.Schema), schema); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, "invalid schema: "+err.Error())
+		return
+	}
+	if schema.Name == "" {
+		schema.Name = req.CollectionName
+	}
+	blob, err := proto.Marshal(schema)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, "invalid schema: "+err.Error())
+		return
+	}
+	status, err := h.proxy.CreateCollection(r.Context(), &milvuspb.CreateCollectionRequest{
+		CollectionName: req.CollectionName,
+		Schema:         blob,
+		ShardsNum:      req.ShardsNum,
+	})
+	if !checkResponse(w, status, err) {
+		return
+	}
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (h *Handler) describeCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	resp, err := h.proxy.DescribeCollection(r.Context(), &milvuspb.DescribeCollectionRequest{
+		CollectionName: collection,
+	})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	schema, err := marshaler.MarshalToString(resp.GetSchema())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, commonpb.ErrorCode_UnexpectedError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"collection_name":        collection,
+		"collectionID":           resp.GetCollectionID(),
+		"schema":                 json.RawMessage(schema),
+		"virtual_channel_names":  nonNilStrings(resp.GetVirtualChannelNames()),
+		"physical_channel_names": nonNilStrings(resp.GetPhysicalChannelNames()),
+		"created_utc_timestamp":  resp.GetCreatedUtcTimestamp(),
+		"shards_num":             resp.GetShardsNum(),
+		"aliases":                nonNilStrings(resp.GetAliases()),
+	})
+}
+
+func (h *Handler) dropCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	status, err := h.proxy.DropCollection(r.Context(), &milvuspb.DropCollectionRequest{
+		CollectionName: collection,
+	})
+	if !checkResponse(w, status, err) {
+		return
+	}
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (h *Handler) hasCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	resp, err := h.proxy.HasCollection(r.Context(), &milvuspb.HasCollectionRequest{
+		CollectionName: collection,
+	})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"value": resp.GetValue()})
+}
+
+func (h *Handler) getCollectionStatistics(w http.ResponseWriter, r *http.Request, collection string) {
+	resp, err := h.proxy.GetCollectionStatistics(r.Context(), &milvuspb.GetCollectionStatisticsRequest{
+		CollectionName: collection,
+	})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+	stats := make(map[string]string)
+	for _, kv := range resp.GetStats() {
+		stats[kv.GetKey()] = kv.GetValue()
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"stats": stats})
+}
+
+func (h *Handler) loadCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	status, err := h.proxy.LoadCollection(r.Context(), &milvuspb.LoadCollectionRequest{
+		CollectionName: collection,
+	})
+	if !checkResponse(w, status, err) {
+		return
+	}
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (h *Handler) releaseCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	status, err := h.proxy.ReleaseCollection(r.Context(), &milvuspb.ReleaseCollectionRequest{
+		CollectionName: collection,
+	})
+	if !checkResponse(w, status, err) {
+		return
+	}
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (h *Handler) flush(w http.ResponseWriter, r *http.Request, collection string) {
+	resp, err := h.proxy.Flush(r.Context(), &milvuspb.FlushRequest{
+		CollectionNames: []string{collection},
+	})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"segmentIDs": nonNilInt64s(resp.GetCollSegIDs()[collection].GetData()),
+	})
+}
+
+type insertRequest struct {
+	PartitionName string           `json:"partition_name"`
+	FieldsData    []*fieldDataJSON `json:"fields_data"`
+}
+
+func (h *Handler) insert(w http.ResponseWriter, r *http.Request, collection string) {
+	req := &insertRequest{}
+	if !decodeBody(w, r, req) {
+		return
+	}
+	if len(req.FieldsData) == 0 {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, "fields_data is empty")
+		return
+	}
+
+	// the type of a field can be omitted, it's taken from the collection schema then
+	var schema *schemapb.CollectionSchema
+	fieldsData := make([]*schemapb.FieldData, 0, len(req.FieldsData))
+	numRows := -1
+	for _, field := range req.FieldsData {
+		var dataType schemapb.DataType
+		var err error
+		if field.Type != "" {
+			dataType, err = parseDataType(field.Type)
+		} else {
+			if schema == nil {
+				if schema, err = h.describeSchema(r.Context(), w, collection); err != nil {
+					return
+				}
+			}
+			dataType, err = fieldDataType(schema, field.FieldName)
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+			return
+		}
+		fd, rows, err := decodeFieldData(field, dataType)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+			return
+		}
+		if numRows >= 0 && rows != numRows {
+			writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument,
+				fmt.Sprintf("field %s has %d rows, expected %d", field.FieldName, rows, numRows))
+			return
+		}
+		numRows = rows
+		fieldsData = append(fieldsData, fd)
+	}
+
+	resp, err := h.proxy.Insert(r.Context(), &milvuspb.InsertRequest{
+		CollectionName: collection,
+		PartitionName:  req.PartitionName,
+		FieldsData:     fieldsData,
+		NumRows:        uint32(numRows),
+	})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+	writeMutationResult(w, resp)
+}
+
+type deleteRequest struct {
+	PartitionName string `json:"partition_name"`
+	Expr          string `json:"expr"`
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, collection string) {
+	req := &deleteRequest{}
+	if !decodeBody(w, r, req) {
+		return
+	}
+	resp, err := h.proxy.Delete(r.Context(), &milvuspb.DeleteRequest{
+		CollectionName: collection,
+		PartitionName:  req.PartitionName,
+		Expr:           req.Expr,
+	})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+	writeMutationResult(w, resp)
+}
+
+type searchRequest struct {
+	PartitionNames     []string        `json:"partition_names"`
+	Expr               string          `json:"expr"`
+	AnnsField          string          `json:"anns_field"`
+	TopK               int64           `json:"topk"`
+	MetricType         string          `json:"metric_type"`
+	Params             json.RawMessage `json:"params"`
+	RoundDecimal       *int64          `json:"round_decimal"`
+	Vectors            json.RawMessage `json:"vectors"`
+	IDs                []int64         `json:"ids"`
+	ExcludeSeed        bool            `json:"exclude_seed"`
+	OutputFields       []string        `json:"output_fields"`
+	TravelTimestamp    uint64          `json:"travel_timestamp"`
+	GuaranteeTimestamp uint64          `json:"guarantee_timestamp"`
+}
+
+type searchHits struct {
+	IDs        []interface{}  `json:"ids"`
+	Scores     []float32      `json:"scores"`
+	FieldsData []*fieldColumn `json:"fields_data"`
+}
+
+func (h *Handler) search(w http.ResponseWriter, r *http.Request, collection string) {
+	req := &searchRequest{}
+	if !decodeBody(w, r, req) {
+		return
+	}
+	schema, err := h.describeSchema(r.Context(), w, collection)
+	if err != nil {
+		return
+	}
+	annsField, dataType, err := searchField(schema, req.AnnsField)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	// searching by ids lets the proxy fetch the vectors of the given entities
+	var placeholderBlob []byte
+	var seedIDs *schemapb.IDs
+	if len(req.IDs) > 0 {
+		if len(req.Vectors) > 0 {
+			writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, "vectors and ids cannot be specified at the same time")
+			return
+		}
+		seedIDs = &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: req.IDs}}}
+	} else {
+		placeholderGroup, err := encodePlaceholderGroup(req.Vectors, dataType)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, "invalid vectors: "+err.Error())
+			return
+		}
+		placeholderBlob, err = proto.Marshal(placeholderGroup)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, commonpb.ErrorCode_UnexpectedError, err.Error())
+			return
+		}
+	}
+	params := "{}"
+	if len(req.Params) > 0 {
+		params = string(req.Params)
+	}
+	roundDecimal := int64(-1)
+	if req.RoundDecimal != nil {
+		roundDecimal = *req.RoundDecimal
+	}
+
+	resp, err := h.proxy.Search(r.Context(), &milvuspb.SearchRequest{
+		CollectionName:   collection,
+		PartitionNames:   req.PartitionNames,
+		Dsl:              req.Expr,
+		DslType:          commonpb.DslType_BoolExprV1,
+		PlaceholderGroup: placeholderBlob,
+		OutputFields:     req.OutputFields,
+		SearchParams: []*commonpb.KeyValuePair{
+			{Key: "anns_field", Value: annsField},
+			{Key: "topk", Value: strconv.FormatInt(req.TopK, 10)},
+			{Key: "metric_type", Value: req.MetricType},
+			{Key: "params", Value: params},
+			{Key: "round_decimal", Value: strconv.FormatInt(roundDecimal, 10)},
+		},
+		TravelTimestamp:    req.TravelTimestamp,
+		GuaranteeTimestamp: req.GuaranteeTimestamp,
+		Ids:                seedIDs,
+		ExcludeSeed:        req.ExcludeSeed,
+	})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+
+	results := resp.GetResults()
+	columns, err := encodeFieldsData(results.GetFieldsData())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, commonpb.ErrorCode_UnexpectedError, err.Error())
+		return
+	}
+	ids := encodeIDs(results.GetIds())
+	scores := results.GetScores()
+	hits := make([]*searchHits, 0, len(results.GetTopks()))
+	offset := 0
+	for _, topk := range results.GetTopks() {
+		end := offset + int(topk)
+		if end > len(ids) || end > len(scores) {
+			writeError(w, http.StatusInternalServerError, commonpb.ErrorCode_IllegalSearchResult, "search results are inconsistent with topks")
+			return
+		}
+		hit := &searchHits{
+			IDs:        ids[offset:end],
+			Scores:     scores[offset:end],
+			FieldsData: make([]*fieldColumn, 0, len(columns)),
+		}
+		for _, col := range columns {
+			if end <= len(col.Values) {
+				hit.FieldsData = append(hit.FieldsData, col.slice(offset, end))
+			}
+		}
+		hits = append(hits, hit)
+		offset = end
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"num_queries": results.GetNumQueries(),
+		"top_k":       results.GetTopK(),
+		"results":     hits,
+	})
+}
+
+type queryRequest struct {
+	PartitionNames     []string `json:"partition_names"`
+	Expr               string   `json:"expr"`
+	OutputFields       []string `json:"output_fields"`
+	TravelTimestamp    uint64   `json:"travel_timestamp"`
+	GuaranteeTimestamp uint64   `json:"guarantee_timestamp"`
+}
+
+func (h *Handler) query(w http.ResponseWriter, r *http.Request, collection string) {
+	req := &queryRequest{}
+	if !decodeBody(w, r, req) {
+		return
+	}
+	resp, err := h.proxy.Query(r.Context(), &milvuspb.QueryRequest{
+		CollectionName:     collection,
+		PartitionNames:     req.PartitionNames,
+		Expr:               req.Expr,
+		OutputFields:       req.OutputFields,
+		TravelTimestamp:    req.TravelTimestamp,
+		GuaranteeTimestamp: req.GuaranteeTimestamp,
+	})
+	writeQueryResults(w, resp, err)
+}
+
+type getRequest struct {
+	PartitionNames     []string `json:"partition_names"`
+	IDs                []int64  `json:"ids"`
+	OutputFields       []string `json:"output_fields"`
+	TravelTimestamp    uint64   `json:"travel_timestamp"`
+	GuaranteeTimestamp uint64   `json:"guarantee_timestamp"`
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request, collection string) {
+	req := &getRequest{}
+	if !decodeBody(w, r, req) {
+		return
+	}
+	if len(req.IDs) == 0 {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, "ids is empty")
+		return
+	}
+	resp, err := h.proxy.Get(r.Context(), &milvuspb.GetRequest{
+		CollectionName:     collection,
+		PartitionNames:     req.PartitionNames,
+		Ids:                &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: req.IDs}}},
+		OutputFields:       req.OutputFields,
+		TravelTimestamp:    req.TravelTimestamp,
+		GuaranteeTimestamp: req.GuaranteeTimestamp,
+	})
+	writeQueryResults(w, resp, err)
+}
+
+func writeQueryResults(w http.ResponseWriter, resp *milvuspb.QueryResults, err error) {
+	if !checkResponse(w, resp.GetStatus(), err) {
+		return
+	}
+	columns, err := encodeFieldsData(resp.GetFieldsData())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, commonpb.ErrorCode_UnexpectedError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"fields_data": columns})
+}
+
+// describeSchema gets the schema of collection, the error is written to w if it fails.
+func (h *Handler) describeSchema(ctx context.Context, w http.ResponseWriter, collection string) (*schemapb.CollectionSchema, error) {
+	resp, err := h.proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		CollectionName: collection,
+	})
+	if !checkResponse(w, resp.GetStatus(), err) {
+		if err == nil {
+			err = errors.New(resp.GetStatus().GetReason())
+		}
+		return nil, err
+	}
+	return resp.GetSchema(), nil
+}
+
+func fieldDataType(schema *schemapb.CollectionSchema, fieldName string) (schemapb.DataType, error) {
+	for _, field := range schema.GetFields() {
+		if field.GetName() == fieldName {
+			return field.GetDataType(), nil
+		}
+	}
+	return schemapb.DataType_None, fmt.Errorf("field %s not found in collection %s", fieldName, schema.GetName())
+}
+
+// searchField returns the vector field to search on, annsField can be omitted if
+// the collection has only one vector field.
+func searchField(schema *schemapb.CollectionSchema, annsField string) (string, schemapb.DataType, error) {
+	if annsField != "" {
+		dataType, err := fieldDataType(schema, annsField)
+		return annsField, dataType, err
+	}
+	var vectorFields []*schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if typeutil.IsVectorType(field.GetDataType()) {
+			vectorFields = append(vectorFields, field)
+		}
+	}
+	if len(vectorFields) != 1 {
+		return "", schemapb.DataType_None, errors.New("anns_field should be specified")
+	}
+	return vectorFields[0].GetName(), vectorFields[0].GetDataType(), nil
+}
+
+func encodeFieldsData(fieldsData []*schemapb.FieldData) ([]*fieldColumn, error) {
+	columns := make([]*fieldColumn, 0, len(fieldsData))
+	for _, fd := range fieldsData {
+		col, err := encodeFieldData(fd)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+func writeMutationResult(w http.ResponseWriter, result *milvuspb.MutationResult) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"ids":        encodeIDs(result.GetIDs()),
+		"insert_cnt": result.GetInsertCnt(),
+		"delete_cnt": result.GetDeleteCnt(),
+		"succ_index": nonNilUint32s(result.GetSuccIndex()),
+		"err_index":  nonNilUint32s(result.GetErrIndex()),
+		"timestamp":  result.GetTimestamp(),
+	})
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		if strings.Contains(err.Error(), errBodyTooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, commonpb.ErrorCode_IllegalArgument, "invalid request body: "+err.Error())
+			return false
+		}
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// checkResponse writes the error to w and returns false if the Proxy call failed.
+func checkResponse(w http.ResponseWriter, status *commonpb.Status, err error) bool {
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, commonpb.ErrorCode_UnexpectedError, err.Error())
+		return false
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		writeError(w, statusHTTPCode(status.GetErrorCode()), status.GetErrorCode(), status.GetReason())
+		return false
+	}
+	return true
+}
+
+func statusHTTPCode(code commonpb.ErrorCode) int {
+	switch code {
+	case commonpb.ErrorCode_CollectionNotExists:
+		return http.StatusNotFound
+	case commonpb.ErrorCode_IllegalArgument, commonpb.ErrorCode_IllegalDimension, commonpb.ErrorCode_IllegalIndexType,
+		commonpb.ErrorCode_IllegalCollectionName, commonpb.ErrorCode_IllegalTOPK, commonpb.ErrorCode_IllegalRowRecord,
+		commonpb.ErrorCode_IllegalVectorID, commonpb.ErrorCode_IllegalNLIST, commonpb.ErrorCode_IllegalMetricType:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, errorCode commonpb.ErrorCode, reason string) {
+	writeJSON(w, code, map[string]string{
+		"error_code": errorCode.String(),
+		"reason":     reason,
+	})
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func nonNilInt64s(s []int64) []int64 {
+	if s == nil {
+		return []int64{}
+	}
+	return s
+}
+
+func nonNilUint32s(s []uint32) []uint32 {
+	if s == nil {
+		return []uint32{}
+	}
+	return s
+}
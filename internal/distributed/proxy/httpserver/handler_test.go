@@ -0,0 +1,401 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+type mockProxy struct {
+	schema *schemapb.CollectionSchema
+
+	createReq *milvuspb.CreateCollectionRequest
+	insertReq *milvuspb.InsertRequest
+	deleteReq *milvuspb.DeleteRequest
+	searchReq *milvuspb.SearchRequest
+	queryReq  *milvuspb.QueryRequest
+	getReq    *milvuspb.GetRequest
+
+	searchResults *schemapb.SearchResultData
+	queryResults  []*schemapb.FieldData
+	err           error
+}
+
+func successStatus() *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func (m *mockProxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	m.createReq = request
+	return successStatus(), m.err
+}
+
+func (m *mockProxy) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	return successStatus(), m.err
+}
+
+func (m *mockProxy) HasCollection(ctx context.Context, request *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	return &milvuspb.BoolResponse{Status: successStatus(), Value: request.CollectionName == m.schema.GetName()}, m.err
+}
+
+func (m *mockProxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error) {
+	return successStatus(), m.err
+}
+
+func (m *mockProxy) ReleaseCollection(ctx context.Context, request *milvuspb.ReleaseCollectionRequest) (*commonpb.Status, error) {
+	return successStatus(), m.err
+}
+
+func (m *mockProxy) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	if request.CollectionName != m.schema.GetName() {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists, Reason: "collection not found"},
+		}, nil
+	}
+	return &milvuspb.DescribeCollectionResponse{Status: successStatus(), Schema: m.schema, CollectionID: 1}, m.err
+}
+
+func (m *mockProxy) GetCollectionStatistics(ctx context.Context, request *milvuspb.GetCollectionStatisticsRequest) (*milvuspb.GetCollectionStatisticsResponse, error) {
+	return &milvuspb.GetCollectionStatisticsResponse{
+		Status: successStatus(),
+		Stats:  []*commonpb.KeyValuePair{{Key: "row_count", Value: "10"}},
+	}, m.err
+}
+
+func (m *mockProxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	return &milvuspb.ShowCollectionsResponse{
+		Status:          successStatus(),
+		CollectionNames: []string{m.schema.GetName()},
+		CollectionIds:   []int64{1},
+	}, m.err
+}
+
+func (m *mockProxy) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	m.insertReq = request
+	return &milvuspb.MutationResult{
+		Status:    successStatus(),
+		IDs:       &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}},
+		InsertCnt: int64(request.NumRows),
+	}, m.err
+}
+
+func (m *mockProxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	m.deleteReq = request
+	return &milvuspb.MutationResult{Status: successStatus(), DeleteCnt: 1}, m.err
+}
+
+func (m *mockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	m.searchReq = request
+	return &milvuspb.SearchResults{Status: successStatus(), Results: m.searchResults}, m.err
+}
+
+func (m *mockProxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
+	return &milvuspb.FlushResponse{
+		Status:     successStatus(),
+		CollSegIDs: map[string]*schemapb.LongArray{request.CollectionNames[0]: {Data: []int64{100}}},
+	}, m.err
+}
+
+func (m *mockProxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	m.queryReq = request
+	return &milvuspb.QueryResults{Status: successStatus(), FieldsData: m.queryResults}, m.err
+}
+
+func (m *mockProxy) Get(ctx context.Context, request *milvuspb.GetRequest) (*milvuspb.QueryResults, error) {
+	m.getReq = request
+	return &milvuspb.QueryResults{Status: successStatus(), FieldsData: m.queryResults}, m.err
+}
+
+func newMockProxy() *mockProxy {
+	return &mockProxy{
+		schema: &schemapb.CollectionSchema{
+			Name: "coll",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+				{
+					FieldID:    102,
+					Name:       "vec",
+					DataType:   schemapb.DataType_FloatVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+				},
+			},
+		},
+	}
+}
+
+const testMaxBodySize = 1024
+
+func serve(h *Handler, method, path, body string) (int, map[string]interface{}) {
+	mux := http.NewServeMux()
+	h.Register(mux)
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	resp := make(map[string]interface{})
+	_ = json.Unmarshal(rec.Body.Bytes(), &resp)
+	return rec.Code, resp
+}
+
+func TestHandler_Routes(t *testing.T) {
+	h := NewHandler(newMockProxy(), testMaxBodySize)
+
+	code, resp := serve(h, http.MethodGet, "/api/v1/collections", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []interface{}{"coll"}, resp["collection_names"])
+
+	code, resp = serve(h, http.MethodGet, "/api/v1/collections/coll/existence", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, true, resp["value"])
+
+	code, resp = serve(h, http.MethodGet, "/api/v1/collections/coll/statistics", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]interface{}{"row_count": "10"}, resp["stats"])
+
+	for _, action := range []string{"load", "release"} {
+		code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/"+action, "")
+		assert.Equal(t, http.StatusOK, code)
+	}
+
+	code, resp = serve(h, http.MethodPost, "/api/v1/collections/coll/flush", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []interface{}{float64(100)}, resp["segmentIDs"])
+
+	code, _ = serve(h, http.MethodDelete, "/api/v1/collections/coll/", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	code, resp = serve(h, http.MethodPut, "/api/v1/collections/coll", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+	assert.Equal(t, "UnexpectedError", resp["error_code"])
+
+	code, _ = serve(h, http.MethodDelete, "/api/v1/collections", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+
+	code, _ = serve(h, http.MethodGet, "/api/v1/collections/coll/unknown", "")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = serve(h, http.MethodGet, "/api/v1/collections/coll/load/more", "")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = serve(h, http.MethodGet, "/api/v1/partitions", "")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestHandler_CreateDescribeCollection(t *testing.T) {
+	proxy := newMockProxy()
+	h := NewHandler(proxy, testMaxBodySize)
+
+	body := `{"collection_name": "coll", "shards_num": 2, "schema": {"fields": [
+		{"name": "pk", "is_primary_key": true, "data_type": "Int64"},
+		{"name": "vec", "data_type": "FloatVector", "type_params": [{"key": "dim", "value": "2"}]}
+	]}}`
+	code, _ := serve(h, http.MethodPost, "/api/v1/collections", body)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "coll", proxy.createReq.CollectionName)
+	assert.Equal(t, int32(2), proxy.createReq.ShardsNum)
+	schema := &schemapb.CollectionSchema{}
+	assert.Nil(t, proto.Unmarshal(proxy.createReq.Schema, schema))
+	assert.Equal(t, "coll", schema.Name)
+	assert.Equal(t, 2, len(schema.Fields))
+	assert.True(t, schema.Fields[0].IsPrimaryKey)
+	assert.Equal(t, schemapb.DataType_FloatVector, schema.Fields[1].DataType)
+
+	code, resp := serve(h, http.MethodPost, "/api/v1/collections", `{"collection_name": "coll", "schema": {"fields": 1}}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "IllegalArgument", resp["error_code"])
+
+	code, resp = serve(h, http.MethodGet, "/api/v1/collections/coll", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "coll", resp["collection_name"])
+	fields := resp["schema"].(map[string]interface{})["fields"].([]interface{})
+	assert.Equal(t, 3, len(fields))
+	assert.Equal(t, "FloatVector", fields[2].(map[string]interface{})["data_type"])
+
+	code, resp = serve(h, http.MethodGet, "/api/v1/collections/other", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "CollectionNotExists", resp["error_code"])
+
+	proxy.err = errors.New("mock error")
+	code, resp = serve(h, http.MethodGet, "/api/v1/collections", "")
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, "mock error", resp["reason"])
+}
+
+func TestHandler_InsertDelete(t *testing.T) {
+	proxy := newMockProxy()
+	h := NewHandler(proxy, testMaxBodySize)
+
+	body := `{"partition_name": "p", "fields_data": [
+		{"field_name": "pk", "type": "Int64", "values": [1, 2]},
+		{"field_name": "age", "values": [10, 20]},
+		{"field_name": "vec", "values": [[0.1, 0.2], [0.3, 0.4]]}
+	]}`
+	code, resp := serve(h, http.MethodPost, "/api/v1/collections/coll/entities", body)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []interface{}{float64(1), float64(2)}, resp["ids"])
+	assert.Equal(t, float64(2), resp["insert_cnt"])
+	assert.Equal(t, "p", proxy.insertReq.PartitionName)
+	assert.Equal(t, uint32(2), proxy.insertReq.NumRows)
+	assert.Equal(t, 3, len(proxy.insertReq.FieldsData))
+	assert.Equal(t, schemapb.DataType_Int32, proxy.insertReq.FieldsData[1].Type)
+	assert.Equal(t, int64(2), proxy.insertReq.FieldsData[2].GetVectors().GetDim())
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/entities", `{"fields_data": [
+		{"field_name": "pk", "values": [1, 2]},
+		{"field_name": "age", "values": [10]}
+	]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/entities", `{"fields_data": [{"field_name": "unknown", "values": [1]}]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/entities", `{"fields_data": []}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/entities", `not json`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/other/entities", `{"fields_data": [{"field_name": "pk", "values": [1]}]}`)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, resp = serve(h, http.MethodPost, "/api/v1/collections/coll/delete", `{"expr": "pk in [1]"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(1), resp["delete_cnt"])
+	assert.Equal(t, "pk in [1]", proxy.deleteReq.Expr)
+}
+
+func TestHandler_Search(t *testing.T) {
+	proxy := newMockProxy()
+	proxy.searchResults = &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       2,
+		Scores:     []float32{0.1, 0.2, 0.3},
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+		Topks:      []int64{2, 1},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int32,
+				FieldName: "age",
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{10, 20, 30}}},
+				}},
+			},
+		},
+	}
+	h := NewHandler(proxy, testMaxBodySize)
+
+	body := `{"expr": "age > 1", "topk": 2, "metric_type": "L2", "params": {"nprobe": 10},
+		"vectors": [[0.1, 0.2], [0.3, 0.4]], "output_fields": ["age"]}`
+	code, resp := serve(h, http.MethodPost, "/api/v1/collections/coll/search", body)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(2), resp["num_queries"])
+	results := resp["results"].([]interface{})
+	assert.Equal(t, 2, len(results))
+	first := results[0].(map[string]interface{})
+	assert.Equal(t, []interface{}{float64(1), float64(2)}, first["ids"])
+	second := results[1].(map[string]interface{})
+	assert.Equal(t, []interface{}{float64(3)}, second["ids"])
+	fields := second["fields_data"].([]interface{})
+	assert.Equal(t, []interface{}{float64(30)}, fields[0].(map[string]interface{})["values"])
+
+	assert.Equal(t, "age > 1", proxy.searchReq.Dsl)
+	assert.Equal(t, commonpb.DslType_BoolExprV1, proxy.searchReq.DslType)
+	params := make(map[string]string)
+	for _, kv := range proxy.searchReq.SearchParams {
+		params[kv.Key] = kv.Value
+	}
+	assert.Equal(t, "vec", params["anns_field"])
+	assert.Equal(t, "2", params["topk"])
+	assert.Equal(t, "L2", params["metric_type"])
+	assert.JSONEq(t, `{"nprobe": 10}`, params["params"])
+	assert.Equal(t, "-1", params["round_decimal"])
+	group := &milvuspb.PlaceholderGroup{}
+	assert.Nil(t, proto.Unmarshal(proxy.searchReq.PlaceholderGroup, group))
+	assert.Equal(t, 2, len(group.Placeholders[0].Values))
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/search", `{"anns_field": "age", "vectors": [[1]]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/search", `{"vectors": [1, 2]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/search", `{"topk": 2, "ids": [3, 4], "exclude_seed": true}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []int64{3, 4}, proxy.searchReq.GetIds().GetIntId().GetData())
+	assert.True(t, proxy.searchReq.ExcludeSeed)
+	assert.Nil(t, proxy.searchReq.PlaceholderGroup)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/search", `{"ids": [3], "vectors": [[1, 2]]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestHandler_QueryGet(t *testing.T) {
+	proxy := newMockProxy()
+	proxy.queryResults = []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_Int64,
+			FieldName: "pk",
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+			}},
+		},
+	}
+	h := NewHandler(proxy, testMaxBodySize)
+
+	code, resp := serve(h, http.MethodPost, "/api/v1/collections/coll/query", `{"expr": "pk > 0", "output_fields": ["pk"]}`)
+	assert.Equal(t, http.StatusOK, code)
+	fields := resp["fields_data"].([]interface{})
+	assert.Equal(t, []interface{}{float64(1), float64(2)}, fields[0].(map[string]interface{})["values"])
+	assert.Equal(t, "pk > 0", proxy.queryReq.Expr)
+
+	code, resp = serve(h, http.MethodPost, "/api/v1/collections/coll/get", `{"ids": [1, 2], "output_fields": ["age"]}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 1, len(resp["fields_data"].([]interface{})))
+	assert.Equal(t, "coll", proxy.getReq.CollectionName)
+	assert.Equal(t, []int64{1, 2}, proxy.getReq.Ids.GetIntId().GetData())
+	assert.Equal(t, []string{"age"}, proxy.getReq.OutputFields)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/get", `{"ids": ["a"]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve(h, http.MethodPost, "/api/v1/collections/coll/get", `{"ids": []}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestHandler_BodyTooLarge(t *testing.T) {
+	proxy := newMockProxy()
+	h := NewHandler(proxy, testMaxBodySize)
+
+	expr := strings.Repeat("pk > 0 && ", testMaxBodySize/10) + "pk > 0"
+	code, resp := serve(h, http.MethodPost, "/api/v1/collections/coll/query", `{"expr": "`+expr+`"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, code)
+	assert.Equal(t, "IllegalArgument", resp["error_code"])
+	assert.Nil(t, proxy.queryReq)
+}
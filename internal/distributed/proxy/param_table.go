@@ -39,6 +39,9 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	HTTPEnabled bool
+	HTTPPort    int
 }
 
 // Params is a package scoped variable of type ParamTable.
@@ -69,6 +72,8 @@ func (pt *ParamTable) initParams() {
 	pt.initPort()
 	pt.initServerMaxSendSize()
 	pt.initServerMaxRecvSize()
+	pt.initHTTPEnabled()
+	pt.initHTTPPort()
 }
 
 func (pt *ParamTable) initPort() {
@@ -121,3 +126,11 @@ func (pt *ParamTable) initServerMaxRecvSize() {
 	log.Debug("initServerMaxRecvSize",
 		zap.Int("proxy.grpc.serverMaxRecvSize", pt.ServerMaxRecvSize))
 }
+
+func (pt *ParamTable) initHTTPEnabled() {
+	pt.HTTPEnabled = pt.ParseBool("proxy.http.enabled", false)
+}
+
+func (pt *ParamTable) initHTTPPort() {
+	pt.HTTPPort = pt.ParseIntWithDefault("proxy.http.port", 19121)
+}
@@ -40,6 +40,20 @@ func TestParamTable(t *testing.T) {
 	Params.initServerMaxRecvSize()
 	assert.Equal(t, Params.ServerMaxRecvSize, grpcconfigs.DefaultServerMaxRecvSize)
 
+	Params.Save("proxy.http.enabled", "true")
+	Params.Save("proxy.http.port", "19122")
+	Params.initHTTPEnabled()
+	Params.initHTTPPort()
+	assert.True(t, Params.HTTPEnabled)
+	assert.Equal(t, 19122, Params.HTTPPort)
+
+	Params.Remove("proxy.http.enabled")
+	Params.Remove("proxy.http.port")
+	Params.initHTTPEnabled()
+	Params.initHTTPPort()
+	assert.False(t, Params.HTTPEnabled)
+	assert.Equal(t, 19121, Params.HTTPPort)
+
 	Params.loadFromEnv()
 	assert.Equal(t, Params.IP, funcutil.GetLocalIP())
 }
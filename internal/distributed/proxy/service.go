@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
@@ -30,11 +31,11 @@ import (
 
 	grpcdatacoordclient "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	grpcindexcoordclient "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	"github.com/milvus-io/milvus/internal/distributed/proxy/httpserver"
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/types"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -44,7 +45,6 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/keepalive"
 )
 
@@ -59,6 +59,7 @@ type Server struct {
 	wg         sync.WaitGroup
 	proxy      types.ProxyComponent
 	grpcServer *grpc.Server
+	httpServer *http.Server
 
 	grpcErrChan chan error
 
@@ -67,7 +68,6 @@ type Server struct {
 	queryCooedClient types.QueryCoord
 	indexCoordClient types.IndexCoord
 
-	tracer opentracing.Tracer
 	closer io.Closer
 }
 
@@ -112,7 +112,6 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
@@ -120,9 +119,9 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
 		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
+			trace.UnaryServerInterceptor()),
 		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+			trace.StreamServerInterceptor()))
 	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
 
@@ -264,7 +263,38 @@ func (s *Server) init() error {
 }
 
 func (s *Server) start() error {
-	return s.proxy.Start()
+	if err := s.proxy.Start(); err != nil {
+		return err
+	}
+	if Params.HTTPEnabled {
+		return s.startHTTPServer(Params.HTTPPort)
+	}
+	return nil
+}
+
+// startHTTPServer serves the RESTful data-plane API of proxy on httpPort, request bodies are limited
+// to the max receive size of grpc.
+func (s *Server) startHTTPServer(httpPort int) error {
+	lis, err := net.Listen("tcp", ":"+strconv.Itoa(httpPort))
+	if err != nil {
+		log.Warn("proxy failed to listen on http port", zap.Int("http port", httpPort), zap.Error(err))
+		return err
+	}
+	mux := http.NewServeMux()
+	httpserver.NewHandler(s.proxy, int64(Params.ServerMaxRecvSize)).Register(mux)
+	s.httpServer = &http.Server{
+		Handler: mux,
+	}
+	log.Debug("proxy", zap.Int("http port", httpPort))
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if err := s.httpServer.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Warn("proxy http server failed", zap.Error(err))
+		}
+	}()
+	return nil
 }
 
 // Stop stop the Proxy Server
@@ -281,6 +311,12 @@ func (s *Server) Stop() error {
 		s.grpcServer.GracefulStop()
 	}
 
+	if s.httpServer != nil {
+		if err = s.httpServer.Shutdown(context.Background()); err != nil {
+			log.Warn("Proxy failed to shutdown http server", zap.Error(err))
+		}
+	}
+
 	err = s.proxy.Stop()
 	if err != nil {
 		return err
@@ -419,6 +455,11 @@ func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*mi
 	return s.proxy.Query(ctx, request)
 }
 
+// Get retrieves the entities by primary keys.
+func (s *Server) Get(ctx context.Context, request *milvuspb.GetRequest) (*milvuspb.QueryResults, error) {
+	return s.proxy.Get(ctx, request)
+}
+
 func (s *Server) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return s.proxy.CalcDistance(ctx, request)
 }
@@ -464,6 +505,18 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.proxy.AlterAlias(ctx, request)
 }
 
+func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.RenameCollection(ctx, request)
+}
+
+func (s *Server) RecoverCollection(ctx context.Context, request *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.RecoverCollection(ctx, request)
+}
+
+func (s *Server) RecoverPartition(ctx context.Context, request *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	return s.proxy.RecoverPartition(ctx, request)
+}
+
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return s.proxy.GetCompactionState(ctx, req)
 }
@@ -476,6 +529,18 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return s.proxy.GetCompactionStateWithPlans(ctx, req)
 }
 
+func (s *Server) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.SetCompactionPolicy(ctx, req)
+}
+
+func (s *Server) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	return s.proxy.DryRunCompaction(ctx, req)
+}
+
+func (s *Server) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	return s.proxy.GetCompactionHistory(ctx, req)
+}
+
 func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
 	return s.proxy.GetFlushState(ctx, req)
 }
@@ -18,6 +18,7 @@ package grpcproxy
 
 import (
 	"context"
+	"net"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -136,6 +137,18 @@ func (m *MockRootCoord) AlterAlias(ctx context.Context, req *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockRootCoord) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RecoverCollection(ctx context.Context, req *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RecoverPartition(ctx context.Context, req *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	return nil, nil
 }
@@ -213,6 +226,18 @@ func (m *MockIndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetric
 	return nil, nil
 }
 
+func (m *MockIndexCoord) ListIndexBuildTasks(ctx context.Context, req *indexpb.ListIndexBuildTasksRequest) (*indexpb.ListIndexBuildTasksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockIndexCoord) RetryIndexBuild(ctx context.Context, req *indexpb.RetryIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockQueryCoord struct {
 	MockBase
@@ -395,6 +420,22 @@ func (m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *mi
 	return nil, nil
 }
 
+func (m *MockDataCoord) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return nil, nil
 }
@@ -541,6 +582,10 @@ func (m *MockProxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (
 	return nil, nil
 }
 
+func (m *MockProxy) Get(ctx context.Context, request *milvuspb.GetRequest) (*milvuspb.QueryResults, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return nil, nil
 }
@@ -585,6 +630,18 @@ func (m *MockProxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockProxy) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RecoverCollection(ctx context.Context, request *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RecoverPartition(ctx context.Context, request *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) SetRootCoordClient(rootCoord types.RootCoord) {
 
 }
@@ -617,6 +674,18 @@ func (m *MockProxy) GetCompactionStateWithPlans(ctx context.Context, req *milvus
 	return nil, nil
 }
 
+func (m *MockProxy) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
 	return nil, nil
 }
@@ -784,6 +853,11 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Get", func(t *testing.T) {
+		_, err := server.Get(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("CalcDistance", func(t *testing.T) {
 		_, err := server.CalcDistance(ctx, nil)
 		assert.Nil(t, err)
@@ -839,6 +913,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("RenameCollection", func(t *testing.T) {
+		_, err := server.RenameCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RecoverCollection", func(t *testing.T) {
+		_, err := server.RecoverCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RecoverPartition", func(t *testing.T) {
+		_, err := server.RecoverPartition(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("GetCompactionState", func(t *testing.T) {
 		_, err := server.GetCompactionState(ctx, nil)
 		assert.Nil(t, err)
@@ -854,6 +943,33 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("SetCompactionPolicy", func(t *testing.T) {
+		_, err := server.SetCompactionPolicy(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DryRunCompaction", func(t *testing.T) {
+		_, err := server.DryRunCompaction(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetCompactionHistory", func(t *testing.T) {
+		_, err := server.GetCompactionHistory(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
+
+func Test_StartHTTPServer(t *testing.T) {
+	lis, err := net.Listen("tcp", ":0")
+	assert.Nil(t, err)
+	defer lis.Close()
+
+	// the port is held by lis, so the http server fails to start
+	server := &Server{proxy: &MockProxy{}}
+	err = server.startHTTPServer(lis.Addr().(*net.TCPAddr).Port)
+	assert.NotNil(t, err)
+	assert.Nil(t, server.httpServer)
+}
@@ -27,7 +27,6 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	isc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
 	pnc "github.com/milvus-io/milvus/internal/distributed/proxy/client"
@@ -85,6 +84,21 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.rootCoord.AlterAlias(ctx, request)
 }
 
+// RenameCollection renames the specified collection.
+func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RenameCollection(ctx, request)
+}
+
+// RecoverCollection recovers the specified dropped collection.
+func (s *Server) RecoverCollection(ctx context.Context, request *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RecoverCollection(ctx, request)
+}
+
+// RecoverPartition recovers the specified dropped partition.
+func (s *Server) RecoverPartition(ctx context.Context, request *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RecoverPartition(ctx, request)
+}
+
 // NewServer create a new RootCoord grpc server.
 func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error) {
 	ctx1, cancel := context.WithCancel(ctx)
@@ -239,14 +253,13 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(trace.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(trace.StreamServerInterceptor()))
 	rootcoordpb.RegisterRootCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -267,7 +280,7 @@ func (s *Server) Stop() error {
 	log.Debug("Rootcoord stop", zap.String("Address", Params.Address))
 	if s.closer != nil {
 		if err := s.closer.Close(); err != nil {
-			log.Error("Failed to close opentracing", zap.Error(err))
+			log.Error("Failed to close tracing", zap.Error(err))
 		}
 	}
 	if s.indexCoord != nil {
@@ -168,6 +168,9 @@ func TestGrpcService(t *testing.T) {
 	core.CallWatchChannels = func(ctx context.Context, collectionID int64, channelNames []string) error {
 		return nil
 	}
+	core.CallRecoverDroppedSegments = func(ctx context.Context, collectionID int64) error {
+		return nil
+	}
 
 	segs := []typeutil.UniqueID{}
 	segLock := sync.Mutex{}
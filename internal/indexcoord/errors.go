@@ -32,3 +32,7 @@ func msgIndexCoordIsUnhealthy(coordID UniqueID) string {
 func errIndexCoordIsUnhealthy(coordID UniqueID) error {
 	return errors.New(msgIndexCoordIsUnhealthy(coordID))
 }
+
+func msgIndexBuildCanceled(indexBuildID UniqueID) string {
+	return fmt.Sprintf("index build task %d has been canceled", indexBuildID)
+}
@@ -30,18 +30,19 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/kv/metastore"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
@@ -134,11 +135,11 @@ func (i *IndexCoord) Init() error {
 		i.UpdateStateCode(internalpb.StateCode_Initializing)
 
 		connectEtcdFn := func() error {
-			etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+			metaKV, err := metastore.NewMetaKV(Params.MetaStoreType, Params.MetaStoreSQLDriver, Params.MetaStoreSQLDSN, Params.EtcdEndpoints, Params.MetaRootPath)
 			if err != nil {
 				return err
 			}
-			metakv, err := NewMetaTable(etcdKV)
+			metakv, err := NewMetaTable(metaKV)
 			if err != nil {
 				return err
 			}
@@ -194,7 +195,8 @@ func (i *IndexCoord) Init() error {
 			return
 		}
 
-		option := &miniokv.Option{
+		option := &storage.Option{
+			StorageConfig:     Params.Storage,
 			Address:           Params.MinIOAddress,
 			AccessKeyID:       Params.MinIOAccessKeyID,
 			SecretAccessKeyID: Params.MinIOSecretAccessKey,
@@ -203,13 +205,14 @@ func (i *IndexCoord) Init() error {
 			CreateBucket:      true,
 		}
 
-		i.kv, err = miniokv.NewMinIOKV(i.loopCtx, option)
+		cm, err := storage.NewChunkManager(i.loopCtx, option)
 		if err != nil {
-			log.Error("IndexCoord new minio kv failed", zap.Error(err))
+			log.Error("IndexCoord new chunk manager failed", zap.Error(err))
 			initErr = err
 			return
 		}
-		log.Debug("IndexCoord new minio kv success")
+		i.kv = storage.NewChunkManagerKV(cm)
+		log.Debug("IndexCoord new chunk manager success", zap.String("storage", Params.Storage.Type))
 
 		i.sched, err = NewTaskScheduler(i.loopCtx, i.idAllocator, i.kv, i.metaTable)
 		if err != nil {
@@ -373,7 +376,7 @@ func (i *IndexCoord) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequ
 		}, err
 	}
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
-	defer sp.Finish()
+	defer sp.End()
 	hasIndex, indexBuildID := i.metaTable.HasSameReq(req)
 	if hasIndex {
 		log.Debug("IndexCoord", zap.Int64("hasIndex true", indexBuildID), zap.Strings("data paths", req.DataPaths))
@@ -426,7 +429,7 @@ func (i *IndexCoord) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequ
 		ret.Status.Reason = err.Error()
 		return ret, nil
 	}
-	sp.SetTag("IndexCoord-IndexBuildID", strconv.FormatInt(t.indexBuildID, 10))
+	sp.SetAttributes(attribute.Int64("IndexCoord-IndexBuildID", t.indexBuildID))
 	ret.Status.ErrorCode = commonpb.ErrorCode_Success
 	ret.IndexBuildID = t.indexBuildID
 	return ret, nil
@@ -435,7 +438,7 @@ func (i *IndexCoord) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequ
 // GetIndexStates gets the index states from IndexCoord.
 func (i *IndexCoord) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
-	defer sp.Finish()
+	defer sp.End()
 	var (
 		cntNone       = 0
 		cntUnissued   = 0
@@ -477,7 +480,7 @@ func (i *IndexCoord) GetIndexStates(ctx context.Context, req *indexpb.GetIndexSt
 func (i *IndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 	log.Debug("IndexCoord DropIndex", zap.Int64("IndexID", req.IndexID))
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
-	defer sp.Finish()
+	defer sp.End()
 
 	ret := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -506,7 +509,7 @@ func (i *IndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReques
 func (i *IndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
 	log.Debug("IndexCoord GetIndexFilePaths", zap.Int64s("IndexBuildIds", req.IndexBuildIDs))
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
-	defer sp.Finish()
+	defer sp.End()
 	var indexPaths []*indexpb.IndexFilePathInfo = nil
 
 	for _, indexID := range req.IndexBuildIDs {
@@ -534,6 +537,109 @@ func (i *IndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetInde
 	return ret, nil
 }
 
+// ListIndexBuildTasks lists the index build tasks with their IndexNode, state, retry count and failure reason.
+func (i *IndexCoord) ListIndexBuildTasks(ctx context.Context, req *indexpb.ListIndexBuildTasksRequest) (*indexpb.ListIndexBuildTasksResponse, error) {
+	log.Debug("IndexCoord ListIndexBuildTasks", zap.Int64("IndexID", req.IndexID), zap.Any("States", req.States))
+	if !i.isHealthy() {
+		return &indexpb.ListIndexBuildTasksResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgIndexCoordIsUnhealthy(i.session.ServerID),
+			},
+		}, nil
+	}
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-ListIndexBuildTasks")
+	defer sp.End()
+
+	tasks := i.metaTable.ListIndexBuildTasks(req.IndexID, req.States)
+	log.Debug("IndexCoord ListIndexBuildTasks success", zap.Int("tasks num", len(tasks)))
+	return &indexpb.ListIndexBuildTasksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Tasks: tasks,
+	}, nil
+}
+
+// CancelIndexBuild aborts the index build task with the IndexBuildID in the request. The task is marked as failed
+// in Meta, and the IndexNode which is building it is notified to stop. Even if the notification fails, the result of
+// the IndexNode will be discarded because the version of the task has been increased.
+func (i *IndexCoord) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	log.Debug("IndexCoord CancelIndexBuild", zap.Int64("IndexBuildID", req.IndexBuildID), zap.String("Reason", req.Reason))
+	if !i.isHealthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgIndexCoordIsUnhealthy(i.session.ServerID),
+		}, nil
+	}
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-CancelIndexBuild")
+	defer sp.End()
+
+	reason := req.Reason
+	if reason == "" {
+		reason = msgIndexBuildCanceled(req.IndexBuildID)
+	}
+	nodeID, err := i.metaTable.CancelIndexBuild(req.IndexBuildID, reason)
+	if err != nil {
+		log.Warn("IndexCoord CancelIndexBuild failed", zap.Int64("IndexBuildID", req.IndexBuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	if nodeID != 0 {
+		i.nodeManager.pq.IncPriority(nodeID, -1)
+		if client, ok := i.nodeManager.GetClientByID(nodeID); ok {
+			ctx, cancel := context.WithTimeout(ctx, i.reqTimeoutInterval)
+			defer cancel()
+			resp, err := client.CancelIndexBuild(ctx, &indexpb.CancelIndexBuildRequest{
+				Base:         req.Base,
+				IndexBuildID: req.IndexBuildID,
+				Reason:       reason,
+			})
+			if err != nil || resp.ErrorCode != commonpb.ErrorCode_Success {
+				log.Warn("IndexCoord CancelIndexBuild notify IndexNode failed, its result will be discarded",
+					zap.Int64("IndexBuildID", req.IndexBuildID), zap.Int64("nodeID", nodeID),
+					zap.Any("resp", resp), zap.Error(err))
+			}
+		}
+	}
+
+	log.Debug("IndexCoord CancelIndexBuild success", zap.Int64("IndexBuildID", req.IndexBuildID), zap.Int64("nodeID", nodeID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// RetryIndexBuild requeues the failed index build tasks, assignTaskLoop will assign them to IndexNode again.
+func (i *IndexCoord) RetryIndexBuild(ctx context.Context, req *indexpb.RetryIndexBuildRequest) (*commonpb.Status, error) {
+	log.Debug("IndexCoord RetryIndexBuild", zap.Int64s("IndexBuildIDs", req.IndexBuildIDs))
+	if !i.isHealthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgIndexCoordIsUnhealthy(i.session.ServerID),
+		}, nil
+	}
+	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-RetryIndexBuild")
+	defer sp.End()
+
+	for _, indexBuildID := range req.IndexBuildIDs {
+		if err := i.metaTable.RetryIndexBuild(indexBuildID); err != nil {
+			log.Warn("IndexCoord RetryIndexBuild failed", zap.Int64("IndexBuildID", indexBuildID), zap.Error(err))
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			}, nil
+		}
+	}
+
+	log.Debug("IndexCoord RetryIndexBuild success", zap.Int64s("IndexBuildIDs", req.IndexBuildIDs))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // GetMetrics gets the metrics info of IndexCoord.
 func (i *IndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	log.Debug("IndexCoord.GetMetrics",
@@ -727,7 +833,7 @@ func (i *IndexCoord) watchNodeLoop() {
 	}
 }
 
-// watchMetaLoop is used to monitor whether the Meta in ETCD has been changed.
+// watchMetaLoop is used to monitor whether the Meta in the metastore has been changed.
 func (i *IndexCoord) watchMetaLoop() {
 	ctx, cancel := context.WithCancel(i.loopCtx)
 
@@ -735,7 +841,13 @@ func (i *IndexCoord) watchMetaLoop() {
 	defer i.loopWg.Done()
 	log.Debug("IndexCoord watchMetaLoop start")
 
-	watchChan := i.metaTable.client.WatchWithPrefix("indexes")
+	watchKV, ok := i.metaTable.client.(kv.MetaKv)
+	if !ok {
+		// the sql metastore can't be watched, poll it instead
+		i.pollMeta(ctx)
+		return
+	}
+	watchChan := watchKV.WatchWithPrefix("indexes")
 
 	for {
 		select {
@@ -752,14 +864,7 @@ func (i *IndexCoord) watchMetaLoop() {
 					zap.Any("event.V", indexMeta), zap.Int64("IndexBuildID", indexBuildID), zap.Error(err))
 				switch event.Type {
 				case mvccpb.PUT:
-					reload := i.metaTable.LoadMetaFromETCD(indexBuildID, eventRevision)
-					log.Debug("IndexCoord watchMetaLoop PUT", zap.Int64("IndexBuildID", indexBuildID), zap.Bool("reload", reload))
-					if reload {
-						log.Debug("This task has finished", zap.Int64("indexBuildID", indexBuildID),
-							zap.Int64("Finish by IndexNode", indexMeta.NodeID),
-							zap.Int64("The version of the task", indexMeta.Version))
-						i.nodeManager.pq.IncPriority(indexMeta.NodeID, -1)
-					}
+					i.reloadIndexMeta(indexMeta, eventRevision)
 				case mvccpb.DELETE:
 					log.Debug("IndexCoord watchMetaLoop DELETE", zap.Int64("The meta has been deleted of indexBuildID", indexBuildID))
 				}
@@ -768,6 +873,45 @@ func (i *IndexCoord) watchMetaLoop() {
 	}
 }
 
+// pollMeta periodically loads the Meta from the metastore and reloads the ones updated by IndexNode.
+func (i *IndexCoord) pollMeta(ctx context.Context) {
+	ticker := time.NewTicker(i.assignTaskInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, values, versions, err := i.metaTable.client.LoadWithPrefix2("indexes")
+			if err != nil {
+				log.Warn("IndexCoord pollMeta failed to load meta", zap.Error(err))
+				continue
+			}
+			for idx, value := range values {
+				indexMeta := &indexpb.IndexMeta{}
+				if err := proto.Unmarshal([]byte(value), indexMeta); err != nil {
+					log.Warn("IndexCoord pollMeta failed to unmarshal meta", zap.Error(err))
+					continue
+				}
+				i.reloadIndexMeta(indexMeta, versions[idx])
+			}
+		}
+	}
+}
+
+// reloadIndexMeta reloads the Meta if its revision in the metastore is newer, which means the task has finished.
+func (i *IndexCoord) reloadIndexMeta(indexMeta *indexpb.IndexMeta, revision int64) {
+	indexBuildID := indexMeta.IndexBuildID
+	reload := i.metaTable.LoadMetaFromETCD(indexBuildID, revision)
+	log.Debug("IndexCoord reloadIndexMeta", zap.Int64("IndexBuildID", indexBuildID), zap.Bool("reload", reload))
+	if reload {
+		log.Debug("This task has finished", zap.Int64("indexBuildID", indexBuildID),
+			zap.Int64("Finish by IndexNode", indexMeta.NodeID),
+			zap.Int64("The version of the task", indexMeta.Version))
+		i.nodeManager.pq.IncPriority(indexMeta.NodeID, -1)
+	}
+}
+
 // assignTask sends the index task to the IndexNode, it has a timeout interval, if the IndexNode doesn't respond within
 // the interval, it is considered that the task sending failed.
 func (i *IndexCoord) assignTask(builderClient types.IndexNode, req *indexpb.CreateIndexRequest) bool {
@@ -187,6 +187,46 @@ func (icm *Mock) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStates
 	}, nil
 }
 
+// ListIndexBuildTasks lists the index build tasks, if Param `Failure` is true, it will return an error.
+func (icm *Mock) ListIndexBuildTasks(ctx context.Context, req *indexpb.ListIndexBuildTasksRequest) (*indexpb.ListIndexBuildTasksResponse, error) {
+	if icm.Failure {
+		return &indexpb.ListIndexBuildTasksResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, errors.New("IndexCoordinate ListIndexBuildTasks failed")
+	}
+	return &indexpb.ListIndexBuildTasksResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+// CancelIndexBuild cancels an index build task, if Param `Failure` is true, it will return an error.
+func (icm *Mock) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinate CancelIndexBuild failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// RetryIndexBuild requeues failed index build tasks, if Param `Failure` is true, it will return an error.
+func (icm *Mock) RetryIndexBuild(ctx context.Context, req *indexpb.RetryIndexBuildRequest) (*commonpb.Status, error) {
+	if icm.Failure {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexCoordinate RetryIndexBuild failed")
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // GetIndexFilePaths gets the index file paths, if Param `Failure` is true, it will return an error.
 func (icm *Mock) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
 	if icm.Failure {
@@ -19,11 +19,18 @@ package indexcoord
 import (
 	"context"
 	"math/rand"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+	_ "github.com/mattn/go-sqlite3"
+
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 
@@ -211,6 +218,61 @@ func TestIndexCoord(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestIndexCoord_SQLMetaStore(t *testing.T) {
+	ctx := context.Background()
+	Params.InitOnce()
+	Params.MetaStoreType = kv.MetaStoreSQL
+	Params.MetaStoreSQLDriver = "sqlite3"
+	Params.MetaStoreSQLDSN = filepath.Join(t.TempDir(), "meta.db")
+	defer func() {
+		Params.MetaStoreType = kv.MetaStoreEtcd
+	}()
+	ic, err := NewIndexCoord(ctx)
+	assert.Nil(t, err)
+	ic.reqTimeoutInterval = time.Second * 10
+	ic.durationInterval = time.Second
+	ic.assignTaskInterval = 200 * time.Millisecond
+	ic.taskLimit = 20
+	err = ic.Register()
+	assert.Nil(t, err)
+	err = ic.Init()
+	assert.Nil(t, err)
+	err = ic.Start()
+	assert.Nil(t, err)
+	defer ic.Stop()
+
+	resp, err := ic.BuildIndex(ctx, &indexpb.BuildIndexRequest{
+		IndexID:   int64(rand.Int()),
+		DataPaths: []string{"DataPath-1", "DataPath-2"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	indexBuildID := resp.IndexBuildID
+
+	// the index meta is kept in sql rather than etcd
+	metaKV, err := sqlkv.NewSQLKV("sqlite3", Params.MetaStoreSQLDSN, Params.MetaRootPath)
+	assert.Nil(t, err)
+	defer metaKV.Close()
+	key := "indexes/" + strconv.FormatInt(indexBuildID, 10)
+	_, values, versions, err := metaKV.LoadWithPrefix2(key)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(values))
+
+	// finish the task as an IndexNode does, IndexCoord polls the meta since sql can't be watched
+	indexMeta := &indexpb.IndexMeta{}
+	err = proto.Unmarshal([]byte(values[0]), indexMeta)
+	assert.Nil(t, err)
+	indexMeta.State = commonpb.IndexState_Finished
+	value, err := proto.Marshal(indexMeta)
+	assert.Nil(t, err)
+	err = metaKV.CompareVersionAndSwap(key, versions[0], string(value))
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		resp, err := ic.GetIndexStates(ctx, &indexpb.GetIndexStatesRequest{IndexBuildIDs: []UniqueID{indexBuildID}})
+		return err == nil && resp.States[0].State == commonpb.IndexState_Finished
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
 func TestIndexCoord_watchNodeLoop(t *testing.T) {
 	ech := make(chan *sessionutil.SessionEvent)
 	in := &IndexCoord{
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -28,7 +29,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -44,16 +45,16 @@ type Meta struct {
 
 // metaTable records the mapping of IndexBuildID to Meta.
 type metaTable struct {
-	client            *etcdkv.EtcdKV    // client of a reliable kv service, i.e. etcd client
+	client            kv.VersionedKV    // client of the metastore, i.e. etcd or sql
 	indexBuildID2Meta map[UniqueID]Meta // index build id to index meta
 
 	lock sync.RWMutex
 }
 
 // NewMetaTable is used to create a new meta table.
-func NewMetaTable(kv *etcdkv.EtcdKV) (*metaTable, error) {
+func NewMetaTable(client kv.VersionedKV) (*metaTable, error) {
 	mt := &metaTable{
-		client: kv,
+		client: client,
 		lock:   sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
@@ -215,9 +216,13 @@ func (mt *metaTable) UpdateVersion(indexBuildID UniqueID) error {
 	//	return fmt.Errorf("can not set lease key, index with ID = %d state is %d", indexBuildID, meta.indexMeta.State)
 	//}
 
+	// every assignment after the first one is a retry of the task
+	if meta.indexMeta.Version > 0 {
+		meta.indexMeta.RetryCount++
+	}
 	meta.indexMeta.Version = meta.indexMeta.Version + 1
 	log.Debug("IndexCoord metaTable update UpdateVersion", zap.Any("IndexBuildId", indexBuildID),
-		zap.Any("Version", meta.indexMeta.Version))
+		zap.Any("Version", meta.indexMeta.Version), zap.Int64("RetryCount", meta.indexMeta.RetryCount))
 
 	err := mt.saveIndexMeta(&meta)
 	if err != nil {
@@ -226,6 +231,9 @@ func (mt *metaTable) UpdateVersion(indexBuildID UniqueID) error {
 			if m == nil {
 				return err
 			}
+			if m.indexMeta.Version > 0 {
+				m.indexMeta.RetryCount++
+			}
 			m.indexMeta.Version = m.indexMeta.Version + 1
 
 			return mt.saveIndexMeta(m)
@@ -270,6 +278,136 @@ func (mt *metaTable) MarkIndexAsDeleted(indexID UniqueID) error {
 	return nil
 }
 
+// CancelIndexBuild marks the index build task as failed with the reason, and increases the version of the task so that
+// the IndexNode which is still building it can't write back its result. It returns the ID of the IndexNode the task
+// was running on, or 0 if the task was not in progress.
+func (mt *metaTable) CancelIndexBuild(indexBuildID UniqueID, reason string) (UniqueID, error) {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	log.Debug("IndexCoord metaTable CancelIndexBuild", zap.Int64("indexBuildID", indexBuildID), zap.String("reason", reason))
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	if !ok || meta.indexMeta.MarkDeleted {
+		return 0, fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	switch meta.indexMeta.State {
+	case commonpb.IndexState_Finished:
+		return 0, fmt.Errorf("index with ID = %d has been built, can not be canceled", indexBuildID)
+	case commonpb.IndexState_Failed:
+		log.Debug("IndexCoord metaTable CancelIndexBuild, the task has already failed", zap.Int64("indexBuildID", indexBuildID))
+		return 0, nil
+	}
+
+	var nodeID UniqueID
+	if meta.indexMeta.State == commonpb.IndexState_InProgress {
+		nodeID = meta.indexMeta.NodeID
+	}
+	meta.indexMeta.State = commonpb.IndexState_Failed
+	meta.indexMeta.FailReason = reason
+	meta.indexMeta.Version = meta.indexMeta.Version + 1
+	if err := mt.saveIndexMeta(&meta); err != nil {
+		fn := func() error {
+			m, err := mt.reloadMeta(meta.indexMeta.IndexBuildID)
+			if m == nil {
+				return err
+			}
+			if m.indexMeta.State == commonpb.IndexState_Finished {
+				return retry.Unrecoverable(fmt.Errorf("index with ID = %d has been built, can not be canceled", indexBuildID))
+			}
+			m.indexMeta.State = commonpb.IndexState_Failed
+			m.indexMeta.FailReason = reason
+			m.indexMeta.Version = m.indexMeta.Version + 1
+			return mt.saveIndexMeta(m)
+		}
+		err2 := retry.Do(context.TODO(), fn, retry.Attempts(5))
+		if err2 != nil {
+			log.Error("IndexCoord metaTable CancelIndexBuild failed", zap.Error(err2))
+			return 0, err2
+		}
+	}
+
+	return nodeID, nil
+}
+
+// RetryIndexBuild requeues the failed index build task, assignTaskLoop will assign it to an IndexNode again.
+func (mt *metaTable) RetryIndexBuild(indexBuildID UniqueID) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	log.Debug("IndexCoord metaTable RetryIndexBuild", zap.Int64("indexBuildID", indexBuildID))
+	meta, ok := mt.indexBuildID2Meta[indexBuildID]
+	if !ok || meta.indexMeta.MarkDeleted {
+		return fmt.Errorf("index not exists with ID = %d", indexBuildID)
+	}
+	if meta.indexMeta.State != commonpb.IndexState_Failed {
+		return fmt.Errorf("index with ID = %d is not failed, state is %s", indexBuildID, meta.indexMeta.State.String())
+	}
+
+	meta.indexMeta.State = commonpb.IndexState_Unissued
+	meta.indexMeta.FailReason = ""
+	if err := mt.saveIndexMeta(&meta); err != nil {
+		fn := func() error {
+			m, err := mt.reloadMeta(meta.indexMeta.IndexBuildID)
+			if m == nil {
+				return err
+			}
+			if m.indexMeta.State != commonpb.IndexState_Failed {
+				return retry.Unrecoverable(fmt.Errorf("index with ID = %d is not failed, state is %s", indexBuildID, m.indexMeta.State.String()))
+			}
+			m.indexMeta.State = commonpb.IndexState_Unissued
+			m.indexMeta.FailReason = ""
+			return mt.saveIndexMeta(m)
+		}
+		err2 := retry.Do(context.TODO(), fn, retry.Attempts(5))
+		if err2 != nil {
+			log.Error("IndexCoord metaTable RetryIndexBuild failed", zap.Error(err2))
+			return err2
+		}
+	}
+
+	return nil
+}
+
+// ListIndexBuildTasks lists the index build tasks which are not deleted. If indexID is not 0, only the tasks of the
+// index are returned. If states is not empty, only the tasks in these states are returned.
+func (mt *metaTable) ListIndexBuildTasks(indexID UniqueID, states []commonpb.IndexState) []*indexpb.IndexBuildTaskInfo {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	wantedStates := make(map[commonpb.IndexState]struct{}, len(states))
+	for _, state := range states {
+		wantedStates[state] = struct{}{}
+	}
+
+	var tasks []*indexpb.IndexBuildTaskInfo
+	for _, meta := range mt.indexBuildID2Meta {
+		if meta.indexMeta.MarkDeleted {
+			continue
+		}
+		if indexID != 0 && meta.indexMeta.Req.IndexID != indexID {
+			continue
+		}
+		if _, ok := wantedStates[meta.indexMeta.State]; len(wantedStates) > 0 && !ok {
+			continue
+		}
+		tasks = append(tasks, &indexpb.IndexBuildTaskInfo{
+			IndexBuildID: meta.indexMeta.IndexBuildID,
+			IndexID:      meta.indexMeta.Req.IndexID,
+			IndexName:    meta.indexMeta.Req.IndexName,
+			NodeID:       meta.indexMeta.NodeID,
+			State:        meta.indexMeta.State,
+			Version:      meta.indexMeta.Version,
+			RetryCount:   meta.indexMeta.RetryCount,
+			FailReason:   meta.indexMeta.FailReason,
+			DataPaths:    meta.indexMeta.Req.DataPaths,
+		})
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].IndexBuildID < tasks[j].IndexBuildID
+	})
+	return tasks
+}
+
 func (mt *metaTable) GetIndexStates(indexBuildIDs []UniqueID) []*indexpb.IndexInfo {
 	mt.lock.Lock()
 	defer mt.lock.Unlock()
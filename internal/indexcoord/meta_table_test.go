@@ -287,6 +287,87 @@ func TestMetaTable(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMetaTable_CancelAndRetryIndexBuild(t *testing.T) {
+	Params.Init()
+	etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	err = etcdKV.RemoveWithPrefix("indexes/")
+	assert.Nil(t, err)
+
+	metaTable, err := NewMetaTable(etcdKV)
+	assert.Nil(t, err)
+
+	req := &indexpb.BuildIndexRequest{
+		IndexBuildID: 10,
+		IndexName:    "test_index",
+		IndexID:      100,
+		DataPaths:    []string{"DataPath-10-1"},
+	}
+	err = metaTable.AddIndex(req.IndexBuildID, req)
+	assert.Nil(t, err)
+
+	t.Run("cancel not exist", func(t *testing.T) {
+		_, err = metaTable.CancelIndexBuild(11, "canceled")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("retry not failed", func(t *testing.T) {
+		err = metaTable.RetryIndexBuild(req.IndexBuildID)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("cancel in progress", func(t *testing.T) {
+		err = metaTable.UpdateVersion(req.IndexBuildID)
+		assert.Nil(t, err)
+		err = metaTable.BuildIndex(req.IndexBuildID, 3)
+		assert.Nil(t, err)
+
+		nodeID, err := metaTable.CancelIndexBuild(req.IndexBuildID, "canceled")
+		assert.Nil(t, err)
+		assert.Equal(t, UniqueID(3), nodeID)
+
+		indexMeta := metaTable.GetIndexMetaByIndexBuildID(req.IndexBuildID)
+		assert.Equal(t, commonpb.IndexState_Failed, indexMeta.State)
+		assert.Equal(t, "canceled", indexMeta.FailReason)
+		assert.Equal(t, int64(2), indexMeta.Version)
+
+		nodeID, err = metaTable.CancelIndexBuild(req.IndexBuildID, "canceled again")
+		assert.Nil(t, err)
+		assert.Equal(t, UniqueID(0), nodeID)
+	})
+
+	t.Run("list tasks", func(t *testing.T) {
+		tasks := metaTable.ListIndexBuildTasks(0, nil)
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, "canceled", tasks[0].FailReason)
+		assert.Equal(t, int64(3), tasks[0].NodeID)
+
+		tasks = metaTable.ListIndexBuildTasks(req.IndexID, []commonpb.IndexState{commonpb.IndexState_Finished})
+		assert.Equal(t, 0, len(tasks))
+
+		tasks = metaTable.ListIndexBuildTasks(req.IndexID+1, nil)
+		assert.Equal(t, 0, len(tasks))
+	})
+
+	t.Run("retry failed", func(t *testing.T) {
+		err = metaTable.RetryIndexBuild(req.IndexBuildID)
+		assert.Nil(t, err)
+
+		metas := metaTable.GetUnassignedTasks(nil)
+		assert.Equal(t, 1, len(metas))
+
+		err = metaTable.UpdateVersion(req.IndexBuildID)
+		assert.Nil(t, err)
+		tasks := metaTable.ListIndexBuildTasks(req.IndexID, []commonpb.IndexState{commonpb.IndexState_Unissued})
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, int64(1), tasks[0].RetryCount)
+		assert.Equal(t, "", tasks[0].FailReason)
+	})
+
+	err = etcdKV.RemoveWithPrefix("indexes/")
+	assert.Nil(t, err)
+}
+
 func TestMetaTable_Error(t *testing.T) {
 	Params.Init()
 	etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
@@ -107,6 +107,15 @@ func (nm *NodeManager) PeekClient() (UniqueID, types.IndexNode) {
 	return nodeID, client
 }
 
+// GetClientByID returns the client of the IndexNode with the nodeID.
+func (nm *NodeManager) GetClientByID(nodeID UniqueID) (types.IndexNode, bool) {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+
+	client, ok := nm.nodeClients[nodeID]
+	return client, ok
+}
+
 func (nm *NodeManager) ListNode() []UniqueID {
 	nm.lock.Lock()
 	defer nm.lock.Unlock()
@@ -17,12 +17,14 @@
 package indexcoord
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -38,11 +40,16 @@ type ParamTable struct {
 	MetaRootPath         string
 	IndexStorageRootPath string
 
+	MetaStoreType      string
+	MetaStoreSQLDriver string
+	MetaStoreSQLDSN    string
+
 	MinIOAddress         string
 	MinIOAccessKeyID     string
 	MinIOSecretAccessKey string
 	MinIOUseSSL          bool
 	MinioBucketName      string
+	Storage              paramtable.StorageConfig
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -59,11 +66,13 @@ func (pt *ParamTable) Init() {
 	pt.initEtcdEndpoints()
 	pt.initMetaRootPath()
 	pt.initKvRootPath()
+	pt.initMetaStore()
 	pt.initMinIOAddress()
 	pt.initMinIOAccessKeyID()
 	pt.initMinIOSecretAccessKey()
 	pt.initMinIOUseSSL()
 	pt.initMinioBucketName()
+	pt.initStorage()
 	pt.initIndexStorageRootPath()
 	pt.initRoleName()
 }
@@ -75,6 +84,16 @@ func (pt *ParamTable) InitOnce() {
 	})
 }
 
+// initMetaStore initializes the store which the index meta is kept in, it falls back to the global one if not set.
+func (pt *ParamTable) initMetaStore() {
+	pt.MetaStoreType = pt.LoadWithDefault("indexCoord.metastore", pt.LoadWithDefault("metastore.type", kv.MetaStoreEtcd))
+	if pt.MetaStoreType != kv.MetaStoreEtcd && pt.MetaStoreType != kv.MetaStoreSQL {
+		panic(fmt.Sprintf("unsupported metastore type %s", pt.MetaStoreType))
+	}
+	pt.MetaStoreSQLDriver = pt.LoadWithDefault("metastore.sql.driver", "mysql")
+	pt.MetaStoreSQLDSN = pt.LoadWithDefault("metastore.sql.dsn", "")
+}
+
 // initEtcdEndpoints initializes the etcd address of configuration items.
 func (pt *ParamTable) initEtcdEndpoints() {
 	endpoints, err := pt.Load("_EtcdEndpoints")
@@ -157,6 +176,11 @@ func (pt *ParamTable) initMinioBucketName() {
 	pt.MinioBucketName = bucketName
 }
 
+// initStorage initializes the storage binlogs and index files are kept in.
+func (pt *ParamTable) initStorage() {
+	pt.Storage = pt.LoadStorageConfig()
+}
+
 // initIndexStorageRootPath initializes the root path of index files.
 func (pt *ParamTable) initIndexStorageRootPath() {
 	rootPath, err := pt.Load("minio.rootPath")
@@ -19,6 +19,9 @@ package indexcoord
 import (
 	"testing"
 	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParamTable(t *testing.T) {
@@ -77,6 +80,15 @@ func TestParamTable(t *testing.T) {
 	t.Run("initIndexStorageRootPath", func(t *testing.T) {
 		t.Logf("IndexStorageRootPath: %v", Params.IndexStorageRootPath)
 	})
+
+	t.Run("MetaStore", func(t *testing.T) {
+		assert.Equal(t, kv.MetaStoreEtcd, Params.MetaStoreType)
+		t.Logf("MetaStoreType: %v", Params.MetaStoreType)
+
+		Params.Save("indexCoord.metastore", "unknown")
+		assert.Panics(t, func() { Params.initMetaStore() })
+		Params.Remove("indexCoord.metastore")
+	})
 }
 
 //TODO: Params Load should be return error when key does not exist.
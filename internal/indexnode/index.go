@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexcgopb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 )
 
@@ -52,6 +53,7 @@ type Index interface {
 	Load([]*Blob) error
 	BuildFloatVecIndexWithoutIds(vectors []float32) error
 	BuildBinaryVecIndexWithoutIds(vectors []byte) error
+	BuildScalarIndex(data storage.FieldData) error
 	Delete() error
 }
 
@@ -133,6 +135,52 @@ func (index *CIndex) BuildBinaryVecIndexWithoutIds(vectors []byte) error {
 	return HandleCStatus(&status, "BuildBinaryVecIndexWithoutIds failed")
 }
 
+// BuildScalarIndex builds indexes for numeric and string scalar field data.
+func (index *CIndex) BuildScalarIndex(data storage.FieldData) error {
+	if data.RowNum() == 0 {
+		return errors.New("scalar index can't be built on empty field data")
+	}
+	var ptr unsafe.Pointer
+	switch fieldData := data.(type) {
+	case *storage.StringFieldData:
+		return index.buildStringIndex(fieldData.Data)
+	case *storage.Int8FieldData:
+		ptr = unsafe.Pointer(&fieldData.Data[0])
+	case *storage.Int16FieldData:
+		ptr = unsafe.Pointer(&fieldData.Data[0])
+	case *storage.Int32FieldData:
+		ptr = unsafe.Pointer(&fieldData.Data[0])
+	case *storage.Int64FieldData:
+		ptr = unsafe.Pointer(&fieldData.Data[0])
+	case *storage.FloatFieldData:
+		ptr = unsafe.Pointer(&fieldData.Data[0])
+	case *storage.DoubleFieldData:
+		ptr = unsafe.Pointer(&fieldData.Data[0])
+	default:
+		return fmt.Errorf("scalar index can't be built on %T", data)
+	}
+	/*
+		CStatus
+		BuildScalarIndex(CIndex index, int64_t row_count, const void* data);
+	*/
+	status := C.BuildScalarIndex(index.indexPtr, (C.int64_t)(data.RowNum()), ptr)
+	return HandleCStatus(&status, "BuildScalarIndex failed")
+}
+
+// buildStringIndex passes the strings to 'C' as a serialized schema.StringArray since they are not fixed-size.
+func (index *CIndex) buildStringIndex(data []string) error {
+	serialized, err := proto.Marshal(&schemapb.StringArray{Data: data})
+	if err != nil {
+		return err
+	}
+	/*
+		CStatus
+		BuildStringIndex(CIndex index, int64_t data_size, const uint8_t* serialized_strings);
+	*/
+	status := C.BuildStringIndex(index.indexPtr, (C.int64_t)(len(serialized)), (*C.uint8_t)(&serialized[0]))
+	return HandleCStatus(&status, "BuildStringIndex failed")
+}
+
 // Delete removes the pointer to build the index in 'C'.
 func (index *CIndex) Delete() error {
 	/*
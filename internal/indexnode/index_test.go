@@ -22,6 +22,8 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -313,6 +315,84 @@ func TestCIndex_Codec(t *testing.T) {
 	}
 }
 
+func TestCIndex_BuildScalarIndex(t *testing.T) {
+	for _, indexType := range []string{"SORT", "INVERTED"} {
+		typeParams := map[string]string{}
+		indexParams := map[string]string{
+			"index_type":           indexType,
+			scalarIndexDataTypeKey: strconv.Itoa(int(schemapb.DataType_Int64)),
+		}
+
+		index, err := NewCIndex(typeParams, indexParams)
+		assert.Equal(t, err, nil)
+		assert.NotEqual(t, index, nil)
+
+		data := &storage.Int64FieldData{NumRows: []int64{nb}, Data: make([]int64, nb)}
+		for i := range data.Data {
+			data.Data[i] = rand.Int63n(100)
+		}
+		err = index.BuildScalarIndex(data)
+		assert.Equal(t, err, nil)
+
+		err = index.BuildScalarIndex(&storage.BoolFieldData{NumRows: []int64{1}, Data: []bool{true}})
+		assert.NotEqual(t, err, nil)
+
+		err = index.BuildScalarIndex(&storage.Int64FieldData{})
+		assert.NotEqual(t, err, nil)
+
+		blobs, err := index.Serialize()
+		assert.Equal(t, err, nil)
+
+		copyIndex, err := NewCIndex(typeParams, indexParams)
+		assert.Equal(t, err, nil)
+		err = copyIndex.Load(blobs)
+		assert.Equal(t, err, nil)
+
+		err = index.Delete()
+		assert.Equal(t, err, nil)
+		err = copyIndex.Delete()
+		assert.Equal(t, err, nil)
+	}
+}
+
+func TestCIndex_BuildStringIndex(t *testing.T) {
+	for _, indexType := range []string{"SORT", "INVERTED"} {
+		typeParams := map[string]string{}
+		indexParams := map[string]string{
+			"index_type":           indexType,
+			scalarIndexDataTypeKey: strconv.Itoa(int(schemapb.DataType_String)),
+		}
+
+		index, err := NewCIndex(typeParams, indexParams)
+		assert.Equal(t, err, nil)
+		assert.NotEqual(t, index, nil)
+
+		brands := []string{"nike", "adidas", "puma", "asics"}
+		data := &storage.StringFieldData{NumRows: []int64{nb}, Data: make([]string, nb)}
+		for i := range data.Data {
+			data.Data[i] = brands[rand.Intn(len(brands))]
+		}
+		err = index.BuildScalarIndex(data)
+		assert.Equal(t, err, nil)
+
+		err = index.BuildScalarIndex(&storage.StringFieldData{})
+		assert.NotEqual(t, err, nil)
+
+		blobs, err := index.Serialize()
+		assert.Equal(t, err, nil)
+
+		copyIndex, err := NewCIndex(typeParams, indexParams)
+		assert.Equal(t, err, nil)
+		err = copyIndex.Load(blobs)
+		assert.Equal(t, err, nil)
+
+		err = index.Delete()
+		assert.Equal(t, err, nil)
+		err = copyIndex.Delete()
+		assert.Equal(t, err, nil)
+	}
+}
+
 func TestCIndex_Delete(t *testing.T) {
 	for _, c := range generateTestCases() {
 		typeParams, indexParams := generateParams(c.indexType, c.metricType)
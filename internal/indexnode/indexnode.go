@@ -44,16 +44,17 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/kv/metastore"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -84,7 +85,7 @@ type IndexNode struct {
 	startCallbacks []func()
 	closeCallbacks []func()
 
-	etcdKV        *etcdkv.EtcdKV
+	metaKV        kv.VersionedKV // client of the metastore shared with indexCoord
 	finishedTasks map[UniqueID]commonpb.IndexState
 
 	closer io.Closer
@@ -142,9 +143,12 @@ func (i *IndexNode) Init() error {
 		i.UpdateStateCode(internalpb.StateCode_Initializing)
 		log.Debug("IndexNode init", zap.Any("State", internalpb.StateCode_Initializing))
 		connectEtcdFn := func() error {
-			etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
-			i.etcdKV = etcdKV
-			return err
+			metaKV, err := metastore.NewMetaKV(Params.MetaStoreType, Params.MetaStoreSQLDriver, Params.MetaStoreSQLDSN, Params.EtcdEndpoints, Params.MetaRootPath)
+			if err != nil {
+				return err
+			}
+			i.metaKV = metaKV
+			return nil
 		}
 		err := retry.Do(i.loopCtx, connectEtcdFn, retry.Attempts(300))
 		if err != nil {
@@ -154,7 +158,8 @@ func (i *IndexNode) Init() error {
 		}
 		log.Debug("IndexNode connected to etcd successfully")
 
-		option := &miniokv.Option{
+		option := &storage.Option{
+			StorageConfig:     Params.Storage,
 			Address:           Params.MinIOAddress,
 			AccessKeyID:       Params.MinIOAccessKeyID,
 			SecretAccessKeyID: Params.MinIOSecretAccessKey,
@@ -162,16 +167,16 @@ func (i *IndexNode) Init() error {
 			BucketName:        Params.MinioBucketName,
 			CreateBucket:      true,
 		}
-		kv, err := miniokv.NewMinIOKV(i.loopCtx, option)
+		cm, err := storage.NewChunkManager(i.loopCtx, option)
 		if err != nil {
-			log.Error("IndexNode NewMinIOKV failed", zap.Error(err))
+			log.Error("IndexNode NewChunkManager failed", zap.Error(err))
 			initErr = err
 			return
 		}
 
-		i.kv = kv
+		i.kv = storage.NewChunkManagerKV(cm)
 
-		log.Debug("IndexNode NewMinIOKV succeeded")
+		log.Debug("IndexNode NewChunkManager succeeded", zap.String("storage", Params.Storage.Type))
 		i.closer = trace.InitTracing("index_node")
 
 		i.initKnowhere()
@@ -261,17 +266,19 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 		zap.Any("IndexParams", request.IndexParams))
 
 	sp, ctx2 := trace.StartSpanFromContextWithOperationName(i.loopCtx, "IndexNode-CreateIndex")
-	defer sp.Finish()
-	sp.SetTag("IndexBuildID", strconv.FormatInt(request.IndexBuildID, 10))
+	defer sp.End()
+	sp.SetAttributes(attribute.Int64("IndexBuildID", request.IndexBuildID))
+	ctx2, cancel := context.WithCancel(ctx2)
 
 	t := &IndexBuildTask{
 		BaseTask: BaseTask{
-			ctx:  ctx2,
-			done: make(chan error),
+			ctx:    ctx2,
+			cancel: cancel,
+			done:   make(chan error),
 		},
 		req:    request,
 		kv:     i.kv,
-		etcdKV: i.etcdKV,
+		metaKV: i.metaKV,
 		nodeID: Params.NodeID,
 	}
 
@@ -281,6 +288,7 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 
 	err := i.sched.IndexBuildQueue.Enqueue(t)
 	if err != nil {
+		cancel()
 		log.Warn("IndexNode failed to schedule", zap.Int64("indexBuildID", request.IndexBuildID), zap.Error(err))
 		ret.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		ret.Reason = err.Error()
@@ -291,6 +299,28 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 	return ret, nil
 }
 
+// CancelIndexBuild receives request from IndexCoordinator to stop an index build task.
+// If the task is still waiting in the queue, it is removed; if it is running, its context is canceled.
+func (i *IndexNode) CancelIndexBuild(ctx context.Context, request *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	if !i.isHealthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgIndexNodeIsUnhealthy(Params.NodeID),
+		}, nil
+	}
+	log.Info("IndexNode cancel index build task",
+		zap.Int64("IndexBuildID", request.IndexBuildID),
+		zap.String("Reason", request.Reason))
+
+	if !i.sched.IndexBuildQueue.CancelTask(request.IndexBuildID) {
+		log.Info("IndexNode has no such index build task, it may have finished",
+			zap.Int64("IndexBuildID", request.IndexBuildID))
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // GetComponentStates gets the component states of IndexNode.
 func (i *IndexNode) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	log.Debug("get IndexNode components states ...")
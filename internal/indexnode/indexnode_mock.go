@@ -267,6 +267,20 @@ func (inm *Mock) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 	}, nil
 }
 
+// CancelIndexBuild receives a canceling index build request, and return success. If the internal member `Err` is true,
+// it will return an error.
+func (inm *Mock) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	if inm.Err {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		}, errors.New("IndexNode CancelIndexBuild failed")
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // GetMetrics gets the metrics of mocked IndexNode, if the internal member `Failure` is true, it will return an error.
 func (inm *Mock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if inm.Err {
@@ -137,7 +137,7 @@ func TestIndexNode(t *testing.T) {
 
 		value, err := proto.Marshal(indexMeta)
 		assert.Nil(t, err)
-		err = in.etcdKV.Save(metaPath1, string(value))
+		err = in.metaKV.Save(metaPath1, string(value))
 		assert.Nil(t, err)
 		req := &indexpb.CreateIndexRequest{
 			IndexBuildID: indexBuildID1,
@@ -172,14 +172,14 @@ func TestIndexNode(t *testing.T) {
 		assert.Nil(t, err2)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		strValue, err3 := in.etcdKV.Load(metaPath1)
+		strValue, err3 := in.metaKV.Load(metaPath1)
 		assert.Nil(t, err3)
 		indexMetaTmp := indexpb.IndexMeta{}
 		err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 		assert.Nil(t, err)
 		for indexMetaTmp.State != commonpb.IndexState_Finished {
 			time.Sleep(100 * time.Millisecond)
-			strValue, err := in.etcdKV.Load(metaPath1)
+			strValue, err := in.metaKV.Load(metaPath1)
 			assert.Nil(t, err)
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
@@ -192,7 +192,7 @@ func TestIndexNode(t *testing.T) {
 			}
 		}()
 
-		defer in.etcdKV.RemoveWithPrefix(metaPath1)
+		defer in.metaKV.RemoveWithPrefix(metaPath1)
 	})
 	t.Run("CreateIndex BinaryVector", func(t *testing.T) {
 		var insertCodec storage.InsertCodec
@@ -252,7 +252,7 @@ func TestIndexNode(t *testing.T) {
 
 		value, err := proto.Marshal(indexMeta)
 		assert.Nil(t, err)
-		err = in.etcdKV.Save(metaPath2, string(value))
+		err = in.metaKV.Save(metaPath2, string(value))
 		assert.Nil(t, err)
 		req := &indexpb.CreateIndexRequest{
 			IndexBuildID: indexBuildID2,
@@ -283,14 +283,14 @@ func TestIndexNode(t *testing.T) {
 		assert.Nil(t, err2)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		strValue, err3 := in.etcdKV.Load(metaPath2)
+		strValue, err3 := in.metaKV.Load(metaPath2)
 		assert.Nil(t, err3)
 		indexMetaTmp := indexpb.IndexMeta{}
 		err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 		assert.Nil(t, err)
 		for indexMetaTmp.State != commonpb.IndexState_Finished {
 			time.Sleep(100 * time.Millisecond)
-			strValue, err = in.etcdKV.Load(metaPath2)
+			strValue, err = in.metaKV.Load(metaPath2)
 			assert.Nil(t, err)
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
@@ -303,7 +303,7 @@ func TestIndexNode(t *testing.T) {
 			}
 		}()
 
-		defer in.etcdKV.RemoveWithPrefix(metaPath2)
+		defer in.metaKV.RemoveWithPrefix(metaPath2)
 	})
 
 	t.Run("Create Deleted_Index", func(t *testing.T) {
@@ -365,7 +365,7 @@ func TestIndexNode(t *testing.T) {
 
 		value, err := proto.Marshal(indexMeta)
 		assert.Nil(t, err)
-		err = in.etcdKV.Save(metaPath3, string(value))
+		err = in.metaKV.Save(metaPath3, string(value))
 		assert.Nil(t, err)
 		req := &indexpb.CreateIndexRequest{
 			IndexBuildID: indexBuildID1,
@@ -400,14 +400,14 @@ func TestIndexNode(t *testing.T) {
 		assert.Nil(t, err2)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		strValue, err3 := in.etcdKV.Load(metaPath3)
+		strValue, err3 := in.metaKV.Load(metaPath3)
 		assert.Nil(t, err3)
 		indexMetaTmp := indexpb.IndexMeta{}
 		err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 		assert.Nil(t, err)
 		for indexMetaTmp.State != commonpb.IndexState_Finished {
 			time.Sleep(100 * time.Millisecond)
-			strValue, err := in.etcdKV.Load(metaPath3)
+			strValue, err := in.metaKV.Load(metaPath3)
 			assert.Nil(t, err)
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
@@ -420,7 +420,7 @@ func TestIndexNode(t *testing.T) {
 			}
 		}()
 
-		defer in.etcdKV.RemoveWithPrefix(metaPath3)
+		defer in.metaKV.RemoveWithPrefix(metaPath3)
 	})
 
 	t.Run("GetComponentStates", func(t *testing.T) {
@@ -451,7 +451,7 @@ func TestIndexNode(t *testing.T) {
 			zap.String("resp", resp.Response),
 			zap.String("name", resp.ComponentName))
 	})
-	err = in.etcdKV.RemoveWithPrefix("session/IndexNode")
+	err = in.metaKV.RemoveWithPrefix("session/IndexNode")
 	assert.Nil(t, err)
 
 	err = in.Stop()
@@ -542,7 +542,7 @@ func TestCreateIndexFailed(t *testing.T) {
 
 		value, err := proto.Marshal(indexMeta)
 		assert.Nil(t, err)
-		err = in.etcdKV.Save(metaPath1, string(value))
+		err = in.metaKV.Save(metaPath1, string(value))
 		assert.Nil(t, err)
 		req := &indexpb.CreateIndexRequest{
 			IndexBuildID: indexBuildID1,
@@ -581,14 +581,14 @@ func TestCreateIndexFailed(t *testing.T) {
 		assert.Nil(t, err2)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		strValue, err3 := in.etcdKV.Load(metaPath1)
+		strValue, err3 := in.metaKV.Load(metaPath1)
 		assert.Nil(t, err3)
 		indexMetaTmp := indexpb.IndexMeta{}
 		err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 		assert.Nil(t, err)
 		for indexMetaTmp.State != commonpb.IndexState_Failed {
 			time.Sleep(100 * time.Millisecond)
-			strValue, err = in.etcdKV.Load(metaPath1)
+			strValue, err = in.metaKV.Load(metaPath1)
 			assert.Nil(t, err)
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
@@ -660,7 +660,7 @@ func TestCreateIndexFailed(t *testing.T) {
 
 		value2, err := proto.Marshal(indexMeta2)
 		assert.Nil(t, err)
-		err = in.etcdKV.Save(metaPath2, string(value2))
+		err = in.metaKV.Save(metaPath2, string(value2))
 		assert.Nil(t, err)
 
 		req2 := &indexpb.CreateIndexRequest{
@@ -700,14 +700,14 @@ func TestCreateIndexFailed(t *testing.T) {
 		assert.Nil(t, err2)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		strValue, err3 := in.etcdKV.Load(metaPath2)
+		strValue, err3 := in.metaKV.Load(metaPath2)
 		assert.Nil(t, err3)
 		indexMetaTmp := indexpb.IndexMeta{}
 		err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 		assert.Nil(t, err)
 		for indexMetaTmp.State != commonpb.IndexState_Failed {
 			time.Sleep(100 * time.Millisecond)
-			strValue, err = in.etcdKV.Load(metaPath2)
+			strValue, err = in.metaKV.Load(metaPath2)
 			assert.Nil(t, err)
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
@@ -728,7 +728,7 @@ func TestCreateIndexFailed(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
 	})
 
-	err = in.etcdKV.RemoveWithPrefix("session/IndexNode")
+	err = in.metaKV.RemoveWithPrefix("session/IndexNode")
 	assert.Nil(t, err)
 
 	err = in.Stop()
@@ -780,7 +780,7 @@ func TestIndexNode_Error(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
 	})
 
-	err = in.etcdKV.RemoveWithPrefix("session/IndexNode")
+	err = in.metaKV.RemoveWithPrefix("session/IndexNode")
 	assert.Nil(t, err)
 
 	err = in.Stop()
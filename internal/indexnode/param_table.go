@@ -17,6 +17,7 @@
 package indexnode
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
@@ -25,6 +26,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
@@ -44,11 +46,16 @@ type ParamTable struct {
 	MetaRootPath         string
 	IndexStorageRootPath string
 
+	MetaStoreType      string
+	MetaStoreSQLDriver string
+	MetaStoreSQLDSN    string
+
 	MinIOAddress         string
 	MinIOAccessKeyID     string
 	MinIOSecretAccessKey string
 	MinIOUseSSL          bool
 	MinioBucketName      string
+	Storage              paramtable.StorageConfig
 
 	SimdType string
 
@@ -84,13 +91,25 @@ func (pt *ParamTable) initParams() {
 	pt.initMinIOSecretAccessKey()
 	pt.initMinIOUseSSL()
 	pt.initMinioBucketName()
+	pt.initStorage()
 	pt.initEtcdEndpoints()
 	pt.initMetaRootPath()
+	pt.initMetaStore()
 	pt.initIndexStorageRootPath()
 	pt.initRoleName()
 	pt.initKnowhereSimdType()
 }
 
+// initMetaStore initializes the store which the index meta is kept in, it must be the same as the one of indexCoord.
+func (pt *ParamTable) initMetaStore() {
+	pt.MetaStoreType = pt.LoadWithDefault("indexCoord.metastore", pt.LoadWithDefault("metastore.type", kv.MetaStoreEtcd))
+	if pt.MetaStoreType != kv.MetaStoreEtcd && pt.MetaStoreType != kv.MetaStoreSQL {
+		panic(fmt.Sprintf("unsupported metastore type %s", pt.MetaStoreType))
+	}
+	pt.MetaStoreSQLDriver = pt.LoadWithDefault("metastore.sql.driver", "mysql")
+	pt.MetaStoreSQLDSN = pt.LoadWithDefault("metastore.sql.dsn", "")
+}
+
 func (pt *ParamTable) initMinIOAddress() {
 	ret, err := pt.Load("_MinioAddress")
 	if err != nil {
@@ -162,6 +181,10 @@ func (pt *ParamTable) initMinioBucketName() {
 	pt.MinioBucketName = bucketName
 }
 
+func (pt *ParamTable) initStorage() {
+	pt.Storage = pt.LoadStorageConfig()
+}
+
 func (pt *ParamTable) initRoleName() {
 	pt.RoleName = "indexnode"
 }
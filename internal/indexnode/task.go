@@ -25,24 +25,30 @@ import (
 	"strconv"
 
 	"github.com/golang/protobuf/proto"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
 	// paramsKeyToParse is the key of the param to build index.
 	paramsKeyToParse = "params"
 
+	// scalarIndexDataTypeKey is the key of the index param which holds the data type of a scalar index.
+	scalarIndexDataTypeKey = "data_type"
+
 	// IndexBuildTaskName is the name of the operation to add an index task.
 	IndexBuildTaskName = "IndexBuildTask"
 )
@@ -59,12 +65,14 @@ type task interface {
 	Notify(err error)
 	OnEnqueue() error
 	SetError(err error)
+	Cancel()
 }
 
 // BaseTask is an basic instance of task.
 type BaseTask struct {
 	done        chan error
 	ctx         context.Context
+	cancel      context.CancelFunc
 	id          UniqueID
 	err         error
 	internalErr error
@@ -99,12 +107,19 @@ func (bt *BaseTask) Notify(err error) {
 	bt.done <- err
 }
 
+// Cancel cancels the context of the task, the task stops at the next checkpoint.
+func (bt *BaseTask) Cancel() {
+	if bt.cancel != nil {
+		bt.cancel()
+	}
+}
+
 // IndexBuildTask is used to record the information of the index tasks.
 type IndexBuildTask struct {
 	BaseTask
 	index     Index
 	kv        kv.BaseKV
-	etcdKV    *etcdkv.EtcdKV
+	metaKV    kv.VersionedKV
 	savePaths []string
 	req       *indexpb.CreateIndexRequest
 	nodeID    UniqueID
@@ -141,7 +156,7 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 	fn := func() error {
 		//TODO error handling need to be optimized, return Unrecoverable to avoid retry
 		indexMeta := indexpb.IndexMeta{}
-		_, values, versions, err := it.etcdKV.LoadWithPrefix2(it.req.MetaPath)
+		_, values, versions, err := it.metaKV.LoadWithPrefix2(it.req.MetaPath)
 		if err != nil {
 			log.Error("IndexNode checkIndexMeta", zap.Any("load meta error with path", it.req.MetaPath),
 				zap.Error(err), zap.Any("pre", pre))
@@ -161,6 +176,11 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 			log.Info("IndexNode checkIndexMeta version mismatch",
 				zap.Any("req version", it.req.Version),
 				zap.Any("index meta version", indexMeta.Version))
+			if pre && indexMeta.State == commonpb.IndexState_Failed {
+				// the task has been canceled by IndexCoord, there is no need to build it
+				return retry.Unrecoverable(fmt.Errorf("the index build task has been canceled with indexBuildID %d, reason: %s",
+					indexMeta.IndexBuildID, indexMeta.FailReason))
+			}
 			return nil
 		}
 		if indexMeta.MarkDeleted {
@@ -169,7 +189,7 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 			if err != nil {
 				return err
 			}
-			err = it.etcdKV.CompareVersionAndSwap(it.req.MetaPath, versions[0], string(v))
+			err = it.metaKV.CompareVersionAndSwap(it.req.MetaPath, versions[0], string(v))
 			if err != nil {
 				return err
 			}
@@ -200,7 +220,7 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 				zap.Any("proto.Marshal failed:", err))
 			return err
 		}
-		err = it.etcdKV.CompareVersionAndSwap(it.req.MetaPath, versions[0], string(metaValue))
+		err = it.metaKV.CompareVersionAndSwap(it.req.MetaPath, versions[0], string(metaValue))
 		if err != nil {
 			log.Warn("IndexNode checkIndexMeta CompareVersionAndSwap", zap.Error(err))
 		}
@@ -218,7 +238,7 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 func (it *IndexBuildTask) PreExecute(ctx context.Context) error {
 	log.Debug("IndexNode IndexBuildTask preExecute...", zap.Int64("buildId", it.req.IndexBuildID))
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-PreExecute")
-	defer sp.Finish()
+	defer sp.End()
 	return it.checkIndexMeta(ctx, true)
 }
 
@@ -227,15 +247,15 @@ func (it *IndexBuildTask) PreExecute(ctx context.Context) error {
 func (it *IndexBuildTask) PostExecute(ctx context.Context) error {
 	log.Debug("IndexNode IndexBuildTask PostExecute...", zap.Int64("buildId", it.req.IndexBuildID))
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-PostExecute")
-	defer sp.Finish()
+	defer sp.End()
 	return it.checkIndexMeta(ctx, false)
 }
 
 // Execute actually performs the task of building an index.
 func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	log.Debug("IndexNode IndexBuildTask Execute ...", zap.Int64("buildId", it.req.IndexBuildID))
-	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-Execute")
-	defer sp.Finish()
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-Execute")
+	defer sp.End()
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("IndexBuildTask %d", it.req.IndexBuildID))
 	var err error
 
@@ -279,22 +299,6 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	it.index, err = NewCIndex(typeParams, indexParams)
-	if err != nil {
-		log.Error("IndexNode IndexBuildTask Execute NewCIndex failed",
-			zap.Int64("buildId", it.req.IndexBuildID),
-			zap.Error(err))
-		return err
-	}
-	defer func() {
-		err = it.index.Delete()
-		if err != nil {
-			log.Warn("IndexNode IndexBuildTask Execute CIndexDelete failed",
-				zap.Int64("buildId", it.req.IndexBuildID),
-				zap.Error(err))
-		}
-	}()
-
 	getKeyByPathNaive := func(path string) string {
 		// splitElements := strings.Split(path, "/")
 		// return splitElements[len(splitElements)-1]
@@ -345,6 +349,10 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	}
 	log.Debug("IndexNode load data success", zap.Int64("buildId", it.req.IndexBuildID))
 	tr.Record("loadKey done")
+	if err := ctx.Err(); err != nil {
+		log.Warn("IndexNode IndexBuildTask canceled after loading data", zap.Int64("buildId", it.req.IndexBuildID))
+		return err
+	}
 
 	storageBlobs := getStorageBlobs(blobs)
 	var insertCodec storage.InsertCodec
@@ -357,30 +365,97 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	}
 	tr.Record("deserialize storage blobs done")
 
+	for _, value := range insertData.Data {
+		// the scalar index is typed, the data type is also persisted with the index files for loading
+		if dataType, ok := scalarIndexDataType(value); ok {
+			indexParams[scalarIndexDataTypeKey] = strconv.Itoa(int(dataType))
+		}
+	}
+
+	it.index, err = NewCIndex(typeParams, indexParams)
+	if err != nil {
+		log.Error("IndexNode IndexBuildTask Execute NewCIndex failed",
+			zap.Int64("buildId", it.req.IndexBuildID),
+			zap.Error(err))
+		return err
+	}
+	defer func() {
+		err = it.index.Delete()
+		if err != nil {
+			log.Warn("IndexNode IndexBuildTask Execute CIndexDelete failed",
+				zap.Int64("buildId", it.req.IndexBuildID),
+				zap.Error(err))
+		}
+	}()
+
 	for fieldID, value := range insertData.Data {
-		// TODO: BinaryVectorFieldData
-		floatVectorFieldData, fOk := value.(*storage.FloatVectorFieldData)
-		if fOk {
-			err = it.index.BuildFloatVecIndexWithoutIds(floatVectorFieldData.Data)
-			if err != nil {
-				log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
-				return err
+		buildSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexNode-BuildIndex", oteltrace.WithAttributes(
+			attribute.Int64("IndexBuildID", it.req.IndexBuildID),
+			attribute.Int64("fieldID", fieldID)))
+		err = func() error {
+			// TODO: BinaryVectorFieldData
+			floatVectorFieldData, fOk := value.(*storage.FloatVectorFieldData)
+			if fOk {
+				err = it.index.BuildFloatVecIndexWithoutIds(floatVectorFieldData.Data)
+				if err != nil {
+					log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
+					return err
+				}
+				tr.Record("build float vector index done")
 			}
-			tr.Record("build float vector index done")
-		}
 
-		binaryVectorFieldData, bOk := value.(*storage.BinaryVectorFieldData)
-		if bOk {
-			err = it.index.BuildBinaryVecIndexWithoutIds(binaryVectorFieldData.Data)
-			if err != nil {
-				log.Error("IndexNode BuildBinaryVecIndexWithoutIds failed", zap.Error(err))
-				return err
+			// half float vectors are indexed as float vectors, query nodes search them as float vectors
+			var halfFloatVectors []float32
+			switch fieldData := value.(type) {
+			case *storage.Float16VectorFieldData:
+				halfFloatVectors = typeutil.Float16BytesToFloat32s(fieldData.Data)
+				fOk = true
+			case *storage.BFloat16VectorFieldData:
+				halfFloatVectors = typeutil.BFloat16BytesToFloat32s(fieldData.Data)
+				fOk = true
+			}
+			if halfFloatVectors != nil {
+				err = it.index.BuildFloatVecIndexWithoutIds(halfFloatVectors)
+				if err != nil {
+					log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
+					return err
+				}
+				tr.Record("build half float vector index done")
 			}
-			tr.Record("build binary vector index done")
-		}
 
-		if !fOk && !bOk {
-			return errors.New("we expect FloatVectorFieldData or BinaryVectorFieldData")
+			binaryVectorFieldData, bOk := value.(*storage.BinaryVectorFieldData)
+			if bOk {
+				err = it.index.BuildBinaryVecIndexWithoutIds(binaryVectorFieldData.Data)
+				if err != nil {
+					log.Error("IndexNode BuildBinaryVecIndexWithoutIds failed", zap.Error(err))
+					return err
+				}
+				tr.Record("build binary vector index done")
+			}
+
+			_, sOk := scalarIndexDataType(value)
+			if sOk {
+				err = it.index.BuildScalarIndex(value)
+				if err != nil {
+					log.Error("IndexNode BuildScalarIndex failed", zap.Error(err))
+					return err
+				}
+				tr.Record("build scalar index done")
+			}
+
+			if !fOk && !bOk && !sOk {
+				return errors.New("we expect FloatVectorFieldData, BinaryVectorFieldData or scalar field data")
+			}
+			return nil
+		}()
+		trace.LogError(buildSp, err)
+		buildSp.End()
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			log.Warn("IndexNode IndexBuildTask canceled after building index", zap.Int64("buildId", it.req.IndexBuildID))
+			return err
 		}
 
 		indexBlobs, err := it.index.Serialize()
@@ -425,7 +500,7 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 			savePath := getSavePathByKey(key)
 
 			saveIndexFileFn := func() error {
-				v, err := it.etcdKV.Load(it.req.MetaPath)
+				v, err := it.metaKV.Load(it.req.MetaPath)
 				if err != nil {
 					log.Warn("IndexNode load meta failed", zap.Any("path", it.req.MetaPath), zap.Error(err))
 					return err
@@ -468,3 +543,25 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	tr.Elapse("all done")
 	return nil
 }
+
+// scalarIndexDataType returns the data type of field data which a scalar index can be built on.
+func scalarIndexDataType(data storage.FieldData) (schemapb.DataType, bool) {
+	switch data.(type) {
+	case *storage.Int8FieldData:
+		return schemapb.DataType_Int8, true
+	case *storage.Int16FieldData:
+		return schemapb.DataType_Int16, true
+	case *storage.Int32FieldData:
+		return schemapb.DataType_Int32, true
+	case *storage.Int64FieldData:
+		return schemapb.DataType_Int64, true
+	case *storage.FloatFieldData:
+		return schemapb.DataType_Float, true
+	case *storage.DoubleFieldData:
+		return schemapb.DataType_Double, true
+	case *storage.StringFieldData:
+		return schemapb.DataType_String, true
+	default:
+		return schemapb.DataType_None, false
+	}
+}
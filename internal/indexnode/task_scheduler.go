@@ -27,8 +27,8 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 // TaskQueue is a queue used to store tasks.
@@ -42,6 +42,7 @@ type TaskQueue interface {
 	AddActiveTask(t task)
 	PopActiveTask(tID UniqueID) task
 	Enqueue(t task) error
+	CancelTask(tID UniqueID) bool
 	//tryToRemoveUselessIndexBuildTask(indexID UniqueID) []UniqueID
 }
 
@@ -160,6 +161,34 @@ func (queue *BaseTaskQueue) PopActiveTask(tID UniqueID) task {
 //	return indexBuildIDs
 //}
 
+// CancelTask removes the task from the unissued tasks if it has not been scheduled, otherwise cancels the context of
+// the active task. It returns false if there is no such task.
+func (queue *BaseTaskQueue) CancelTask(tID UniqueID) bool {
+	queue.utLock.Lock()
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		t := e.Value.(task)
+		if t.ID() == tID {
+			queue.unissuedTasks.Remove(e)
+			queue.utLock.Unlock()
+			t.Cancel()
+			log.Debug("IndexNode cancel the unissued task", zap.Int64("TaskID", tID))
+			return true
+		}
+	}
+	queue.utLock.Unlock()
+
+	queue.atLock.Lock()
+	defer queue.atLock.Unlock()
+	t, ok := queue.activeTasks[tID]
+	if !ok {
+		log.Debug("IndexNode task to cancel was not found", zap.Int64("TaskID", tID))
+		return false
+	}
+	t.Cancel()
+	log.Debug("IndexNode cancel the active task", zap.Int64("TaskID", tID))
+	return true
+}
+
 // Enqueue adds a task to TaskQueue.
 func (queue *BaseTaskQueue) Enqueue(t task) error {
 	err := t.OnEnqueue()
@@ -235,18 +264,17 @@ func (sched *TaskScheduler) scheduleIndexBuildTask() []task {
 
 func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.Ctx(),
-		opentracing.Tags{
-			"Type": t.Name(),
-			"ID":   t.ID(),
-		})
+		oteltrace.WithAttributes(
+			attribute.String("Type", t.Name()),
+			attribute.Int64("ID", t.ID())))
 
-	defer span.Finish()
-	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
+	defer span.End()
+	span.AddEvent("scheduler process PreExecute")
 	err := t.PreExecute(ctx)
 	t.SetError(err)
 
 	defer func() {
-		span.LogFields(oplog.Int64("scheduler process PostExecute", t.ID()))
+		span.AddEvent("scheduler process PostExecute")
 		err := t.PostExecute(ctx)
 		t.SetError(err)
 	}()
@@ -256,17 +284,17 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
+	span.AddEvent("scheduler process AddActiveTask")
 	q.AddActiveTask(t)
 
 	// log.Printf("task add to active list ...")
 	defer func() {
-		span.LogFields(oplog.Int64("scheduler process PopActiveTask", t.ID()))
+		span.AddEvent("scheduler process PopActiveTask")
 		q.PopActiveTask(t.ID())
 		// log.Printf("pop from active list ...")
 	}()
 
-	span.LogFields(oplog.Int64("scheduler process Execute", t.ID()))
+	span.AddEvent("scheduler process Execute")
 	err = t.Execute(ctx)
 	t.SetError(err)
 }
@@ -0,0 +1,489 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurekv
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	// apiVersion is the Blob service REST API version AzureKV speaks.
+	apiVersion = "2019-12-12"
+
+	// defaultRequestTimeout covers reading the response body too, so it is long enough to read large binlogs
+	defaultRequestTimeout = 2 * time.Minute
+	// requestAttempts is the number of attempts of a request failed with a network error, 429 or 5xx
+	requestAttempts = 5
+)
+
+// errNotFound is returned when the requested blob does not exist.
+var errNotFound = errors.New("blob not found")
+
+// AzureKV implements DataKV interface and relies on the Azure Blob service.
+// Requests are signed with the storage account shared key, so AzureKV works
+// against both Azure and emulators like Azurite.
+type AzureKV struct {
+	ctx         context.Context
+	client      *http.Client
+	endpoint    *url.URL
+	accountName string
+	accountKey  []byte
+	container   string
+}
+
+// Option option when creates AzureKV.
+type Option struct {
+	// Endpoint of the blob service, defaults to https://<AccountName>.blob.core.windows.net.
+	// For Azurite it looks like http://127.0.0.1:10000/devstoreaccount1.
+	Endpoint        string
+	AccountName     string
+	AccountKey      string // base64 encoded shared key
+	ContainerName   string
+	CreateContainer bool // when container not existed, create it
+	// RequestTimeout of every request, defaults to 2 minutes.
+	RequestTimeout time.Duration
+}
+
+// NewAzureKV creates AzureKV to save and load blobs to Azure Blob storage.
+func NewAzureKV(ctx context.Context, option *Option) (*AzureKV, error) {
+	key, err := base64.StdEncoding.DecodeString(option.AccountKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid azure account key: %w", err)
+	}
+	endpoint := option.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", option.AccountName)
+	}
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	timeout := option.RequestTimeout
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
+	}
+	kv := &AzureKV{
+		ctx:         ctx,
+		client:      &http.Client{Timeout: timeout},
+		endpoint:    u,
+		accountName: option.AccountName,
+		accountKey:  key,
+		container:   option.ContainerName,
+	}
+
+	checkContainerFn := func() error {
+		exist, err := kv.containerExists()
+		if err != nil {
+			return err
+		}
+		if !exist {
+			log.Debug("AzureKV NewAzureKV", zap.Any("Check container", "container not exist"))
+			if option.CreateContainer {
+				log.Debug("AzureKV NewAzureKV create container.")
+				return kv.createContainer()
+			}
+			return fmt.Errorf("container %s not Existed", option.ContainerName)
+		}
+		return nil
+	}
+	err = retry.Do(ctx, checkContainerFn, retry.Attempts(300))
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("AzureKV new AzureKV success.")
+	return kv, nil
+}
+
+func (kv *AzureKV) containerExists() (bool, error) {
+	resp, err := kv.do(http.MethodHead, "", url.Values{"restype": {"container"}}, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+	return false, responseError(resp)
+}
+
+func (kv *AzureKV) createContainer() error {
+	resp, err := kv.do(http.MethodPut, "", url.Values{"restype": {"container"}}, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// someone else may create the container at the same time
+	if resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	return responseError(resp)
+}
+
+// Exist checks whether a key exists in the container.
+func (kv *AzureKV) Exist(key string) bool {
+	_, err := kv.GetSize(key)
+	return err == nil
+}
+
+// Load loads a blob with @key.
+func (kv *AzureKV) Load(key string) (string, error) {
+	reader, err := kv.Reader(key)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// MultiLoad loads blobs with multi @keys.
+func (kv *AzureKV) MultiLoad(keys []string) ([]string, error) {
+	var resultErr error
+	var values []string
+	for _, key := range keys {
+		value, err := kv.Load(key)
+		if err != nil {
+			if resultErr == nil {
+				resultErr = err
+			}
+		}
+		values = append(values, value)
+	}
+	return values, resultErr
+}
+
+// LoadWithPrefix loads blobs with the same prefix @prefix.
+func (kv *AzureKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	keys, _, err := kv.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err := kv.MultiLoad(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+// ListWithPrefix lists all blobs with the same prefix @prefix,
+// returns the keys and last modified time of the blobs.
+func (kv *AzureKV) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+	marker := ""
+	for {
+		query := url.Values{
+			"restype": {"container"},
+			"comp":    {"list"},
+		}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := kv.do(http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = responseError(resp)
+			resp.Body.Close()
+			return nil, nil, err
+		}
+		result := listBlobsResult{}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, blob := range result.Blobs {
+			modTime, err := http.ParseTime(blob.Properties.LastModified)
+			if err != nil {
+				return nil, nil, err
+			}
+			keys = append(keys, blob.Name)
+			modTimes = append(modTimes, modTime)
+		}
+		if result.NextMarker == "" {
+			return keys, modTimes, nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+// Reader returns a reader of the blob with @key, the reader must be closed after use.
+func (kv *AzureKV) Reader(key string) (io.ReadCloser, error) {
+	resp, err := kv.do(http.MethodGet, key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, keyError(key, resp)
+	}
+	return resp.Body, nil
+}
+
+// Save saves @value as a block blob with @key.
+func (kv *AzureKV) Save(key, value string) error {
+	header := http.Header{"x-ms-blob-type": {"BlockBlob"}}
+	resp, err := kv.do(http.MethodPut, key, nil, header, []byte(value))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return keyError(key, resp)
+	}
+	return nil
+}
+
+// MultiSave saves multiple blobs, the path is the key of @kvs.
+func (kv *AzureKV) MultiSave(kvs map[string]string) error {
+	var resultErr error
+	for key, value := range kvs {
+		err := kv.Save(key, value)
+		if err != nil {
+			if resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+	return resultErr
+}
+
+// Remove deletes a blob with @key, removing a non-existent blob is not an error.
+func (kv *AzureKV) Remove(key string) error {
+	resp, err := kv.do(http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNotFound {
+		return keyError(key, resp)
+	}
+	return nil
+}
+
+// MultiRemove deletes blobs with @keys.
+func (kv *AzureKV) MultiRemove(keys []string) error {
+	var resultErr error
+	for _, key := range keys {
+		err := kv.Remove(key)
+		if err != nil {
+			if resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+	return resultErr
+}
+
+// RemoveWithPrefix removes all blobs with the same prefix @prefix.
+func (kv *AzureKV) RemoveWithPrefix(prefix string) error {
+	keys, _, err := kv.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return kv.MultiRemove(keys)
+}
+
+// LoadPartial loads partial data ranged in [start, end) with @key.
+func (kv *AzureKV) LoadPartial(key string, start, end int64) ([]byte, error) {
+	switch {
+	case start < 0 || end < 0:
+		return nil, fmt.Errorf("invalid range specified: start=%d end=%d",
+			start, end)
+	case start >= end:
+		return nil, fmt.Errorf("invalid range specified: start=%d end=%d",
+			start, end)
+	}
+
+	header := http.Header{"x-ms-range": {fmt.Sprintf("bytes=%d-%d", start, end-1)}}
+	resp, err := kv.do(http.MethodGet, key, nil, header, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// a 200 response carries the whole blob, the range is not honored
+	if resp.StatusCode != http.StatusPartialContent {
+		return nil, keyError(key, resp)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// GetSize obtains the data size of the blob with @key.
+func (kv *AzureKV) GetSize(key string) (int64, error) {
+	resp, err := kv.do(http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, keyError(key, resp)
+	}
+	return strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+}
+
+// Close close the AzureKV.
+func (kv *AzureKV) Close() {
+	kv.client.CloseIdleConnections()
+}
+
+// do sends a signed request for the blob @key, an empty @key addresses the container itself.
+// The request is signed again and retried with backoff on network errors, 429 and 5xx responses.
+func (kv *AzureKV) do(method, key string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	u := *kv.endpoint
+	u.Path = path.Join(u.Path, kv.container, key)
+	u.RawPath = ""
+	u.RawQuery = query.Encode()
+	var resp *http.Response
+	err := retry.Do(kv.ctx, func() error {
+		req, err := http.NewRequestWithContext(kv.ctx, method, u.String(), bytes.NewReader(body))
+		if err != nil {
+			return retry.Unrecoverable(err)
+		}
+		for k, v := range header {
+			req.Header[http.CanonicalHeaderKey(k)] = v
+		}
+		req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+		req.Header.Set("x-ms-version", apiVersion)
+		req.Header.Set("Authorization", "SharedKey "+kv.accountName+":"+sign(kv.accountName, kv.accountKey, req))
+		resp, err = kv.client.Do(req)
+		if err != nil {
+			return err
+		}
+		if isRetryableStatus(resp.StatusCode) {
+			err = responseError(resp)
+			resp.Body.Close()
+			return err
+		}
+		return nil
+	}, retry.Attempts(requestAttempts))
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// sign computes the Shared Key signature of @req, see
+// https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func sign(accountName string, accountKey []byte, req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var msHeaders []string
+	for k := range req.Header {
+		if lower := strings.ToLower(k); strings.HasPrefix(lower, "x-ms-") {
+			msHeaders = append(msHeaders, lower)
+		}
+	}
+	sort.Strings(msHeaders)
+	var canonicalized strings.Builder
+	for _, k := range msHeaders {
+		canonicalized.WriteString(k + ":" + strings.TrimSpace(req.Header.Get(k)) + "\n")
+	}
+
+	canonicalized.WriteString("/" + accountName + req.URL.EscapedPath())
+	query := req.URL.Query()
+	params := make([]string, 0, len(query))
+	for k := range query {
+		params = append(params, k)
+	}
+	sort.Strings(params)
+	for _, k := range params {
+		values := query[k]
+		sort.Strings(values)
+		canonicalized.WriteString("\n" + strings.ToLower(k) + ":" + strings.Join(values, ","))
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is used instead
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalized.String(),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, accountKey)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+type listBlobsResult struct {
+	Blobs []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			LastModified string `xml:"Last-Modified"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func keyError(key string, resp *http.Response) error {
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w with key: %s", errNotFound, key)
+	}
+	return fmt.Errorf("key %s: %w", key, responseError(resp))
+}
+
+func responseError(resp *http.Response) error {
+	msg, _ := ioutil.ReadAll(resp.Body)
+	return fmt.Errorf("azure blob service responded %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+}
@@ -0,0 +1,415 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurekv
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAccount   = "devstoreaccount1"
+	testContainer = "a-bucket"
+)
+
+var testAccountKey = base64.StdEncoding.EncodeToString([]byte("azure-kv-test-key"))
+
+type emulatedBlob struct {
+	data    []byte
+	modTime time.Time
+}
+
+// blobEmulator is a minimal in-memory stand-in for the Azure Blob service,
+// it serves a single account and checks the shared key signature of every request.
+type blobEmulator struct {
+	mu         sync.Mutex
+	key        []byte
+	pageSize   int
+	containers map[string]map[string]*emulatedBlob
+	// failures are the status codes answered to the next requests
+	failures []int
+	// ignoreRange makes range requests answered with the whole blob
+	ignoreRange bool
+}
+
+func newBlobEmulator(t *testing.T) (*blobEmulator, *httptest.Server) {
+	key, err := base64.StdEncoding.DecodeString(testAccountKey)
+	require.NoError(t, err)
+	e := &blobEmulator{
+		key:        key,
+		pageSize:   2,
+		containers: make(map[string]map[string]*emulatedBlob),
+	}
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return e, server
+}
+
+func (e *blobEmulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("x-ms-version") == "" || r.Header.Get("x-ms-date") == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if r.Header.Get("Authorization") != "SharedKey "+testAccount+":"+sign(testAccount, e.key, r) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.failures) > 0 {
+		w.WriteHeader(e.failures[0])
+		e.failures = e.failures[1:]
+		return
+	}
+	// path is /<account>/<container>[/<blob>]
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != testAccount {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	container, ok := e.containers[parts[1]]
+	if len(parts) == 2 {
+		e.serveContainer(w, r, parts[1], container, ok)
+		return
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	name := parts[2]
+	blob, exist := container[name]
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := ioutil.ReadAll(r.Body)
+		container[name] = &emulatedBlob{data: data, modTime: time.Now()}
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		if !exist {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(container, name)
+		w.WriteHeader(http.StatusAccepted)
+	case http.MethodHead:
+		if !exist {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(blob.data)))
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		if !exist {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if rng := r.Header.Get("x-ms-range"); rng != "" && !e.ignoreRange {
+			var start, end int
+			fmt.Sscanf(rng, "bytes=%d-%d", &start, &end)
+			if end >= len(blob.data) {
+				end = len(blob.data) - 1
+			}
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(blob.data[start : end+1])
+			return
+		}
+		w.Write(blob.data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (e *blobEmulator) serveContainer(w http.ResponseWriter, r *http.Request, name string, container map[string]*emulatedBlob, exist bool) {
+	query := r.URL.Query()
+	if query.Get("restype") != "container" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	switch {
+	case r.Method == http.MethodPut:
+		if exist {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		e.containers[name] = make(map[string]*emulatedBlob)
+		w.WriteHeader(http.StatusCreated)
+	case !exist:
+		w.WriteHeader(http.StatusNotFound)
+	case r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet && query.Get("comp") == "list":
+		var names []string
+		for blobName := range container {
+			if strings.HasPrefix(blobName, query.Get("prefix")) && blobName >= query.Get("marker") {
+				names = append(names, blobName)
+			}
+		}
+		sort.Strings(names)
+		result := listBlobsResult{}
+		if len(names) > e.pageSize {
+			result.NextMarker = names[e.pageSize]
+			names = names[:e.pageSize]
+		}
+		for _, blobName := range names {
+			blob := struct {
+				Name       string `xml:"Name"`
+				Properties struct {
+					LastModified string `xml:"Last-Modified"`
+				} `xml:"Properties"`
+			}{Name: blobName}
+			blob.Properties.LastModified = container[blobName].modTime.UTC().Format(http.TimeFormat)
+			result.Blobs = append(result.Blobs, blob)
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		xml.NewEncoder(w).Encode(struct {
+			XMLName xml.Name `xml:"EnumerationResults"`
+			listBlobsResult
+		}{listBlobsResult: result})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestAzureKV(t *testing.T, endpoint string) *AzureKV {
+	kv, err := NewAzureKV(context.Background(), &Option{
+		Endpoint:        endpoint + "/" + testAccount,
+		AccountName:     testAccount,
+		AccountKey:      testAccountKey,
+		ContainerName:   testContainer,
+		CreateContainer: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(kv.Close)
+	return kv
+}
+
+func TestAzureKV(t *testing.T) {
+	_, server := newBlobEmulator(t)
+
+	t.Run("test create container", func(t *testing.T) {
+		newTestAzureKV(t, server.URL)
+		// container already created
+		newTestAzureKV(t, server.URL)
+
+		_, err := NewAzureKV(context.Background(), &Option{
+			AccountName: testAccount,
+			AccountKey:  "not base64 !",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("test wrong account key", func(t *testing.T) {
+		kv := newTestAzureKV(t, server.URL)
+		kv.accountKey = []byte("wrong")
+		err := kv.Save("key", "value")
+		assert.Error(t, err)
+	})
+
+	t.Run("test save and load", func(t *testing.T) {
+		kv := newTestAzureKV(t, server.URL)
+		defer kv.RemoveWithPrefix("save_load")
+
+		err := kv.Save("save_load/abc", "123")
+		assert.NoError(t, err)
+		err = kv.MultiSave(map[string]string{
+			"save_load/abd":     "456",
+			"save_load/sub/a b": "789",
+			"save_load/x+y":     "",
+		})
+		assert.NoError(t, err)
+
+		val, err := kv.Load("save_load/abc")
+		assert.NoError(t, err)
+		assert.Equal(t, "123", val)
+		val, err = kv.Load("save_load/sub/a b")
+		assert.NoError(t, err)
+		assert.Equal(t, "789", val)
+		val, err = kv.Load("save_load/x+y")
+		assert.NoError(t, err)
+		assert.Equal(t, "", val)
+
+		_, err = kv.Load("save_load/not_exist")
+		assert.ErrorIs(t, err, errNotFound)
+
+		vals, err := kv.MultiLoad([]string{"save_load/abc", "save_load/abd"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"123", "456"}, vals)
+		_, err = kv.MultiLoad([]string{"save_load/abc", "save_load/not_exist"})
+		assert.Error(t, err)
+
+		keys, vals, err := kv.LoadWithPrefix("save_load/ab")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"save_load/abc", "save_load/abd"}, keys)
+		assert.Equal(t, []string{"123", "456"}, vals)
+
+		assert.True(t, kv.Exist("save_load/abc"))
+		assert.False(t, kv.Exist("save_load/ab"))
+	})
+
+	t.Run("test list with prefix", func(t *testing.T) {
+		kv := newTestAzureKV(t, server.URL)
+		defer kv.RemoveWithPrefix("list")
+
+		before := time.Now().Add(-time.Second)
+		for i := 0; i < 5; i++ {
+			err := kv.Save(fmt.Sprintf("list/%d", i), "v")
+			assert.NoError(t, err)
+		}
+		// the emulator returns two blobs per page
+		keys, modTimes, err := kv.ListWithPrefix("list/")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"list/0", "list/1", "list/2", "list/3", "list/4"}, keys)
+		assert.Equal(t, 5, len(modTimes))
+		for _, modTime := range modTimes {
+			assert.True(t, modTime.After(before))
+		}
+
+		keys, modTimes, err = kv.ListWithPrefix("list/not_exist")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+		assert.Empty(t, modTimes)
+	})
+
+	t.Run("test remove", func(t *testing.T) {
+		kv := newTestAzureKV(t, server.URL)
+
+		err := kv.MultiSave(map[string]string{
+			"remove/a":   "1",
+			"remove/b":   "2",
+			"remove/c/d": "3",
+			"remove/c/e": "4",
+		})
+		assert.NoError(t, err)
+
+		err = kv.Remove("remove/a")
+		assert.NoError(t, err)
+		assert.False(t, kv.Exist("remove/a"))
+		// removing a non-existent blob is fine
+		err = kv.Remove("remove/a")
+		assert.NoError(t, err)
+
+		err = kv.MultiRemove([]string{"remove/b", "remove/not_exist"})
+		assert.NoError(t, err)
+		assert.False(t, kv.Exist("remove/b"))
+
+		err = kv.RemoveWithPrefix("remove/c")
+		assert.NoError(t, err)
+		keys, _, err := kv.ListWithPrefix("remove")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("test load partial and get size", func(t *testing.T) {
+		kv := newTestAzureKV(t, server.URL)
+		defer kv.RemoveWithPrefix("partial")
+
+		err := kv.Save("partial/key", "12345678")
+		assert.NoError(t, err)
+
+		size, err := kv.GetSize("partial/key")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 8, size)
+		_, err = kv.GetSize("partial/not_exist")
+		assert.Error(t, err)
+
+		data, err := kv.LoadPartial("partial/key", 2, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("345"), data)
+		data, err = kv.LoadPartial("partial/key", 6, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("78"), data)
+
+		_, err = kv.LoadPartial("partial/key", 5, 2)
+		assert.Error(t, err)
+		_, err = kv.LoadPartial("partial/key", -1, 2)
+		assert.Error(t, err)
+		_, err = kv.LoadPartial("partial/not_exist", 0, 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("test reader", func(t *testing.T) {
+		kv := newTestAzureKV(t, server.URL)
+		defer kv.RemoveWithPrefix("reader")
+
+		err := kv.Save("reader/key", "value")
+		assert.NoError(t, err)
+
+		reader, err := kv.Reader("reader/key")
+		assert.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), data)
+		assert.NoError(t, reader.Close())
+
+		_, err = kv.Reader("reader/not_exist")
+		assert.ErrorIs(t, err, errNotFound)
+	})
+}
+
+func TestAzureKV_Retry(t *testing.T) {
+	e, server := newBlobEmulator(t)
+	kv := newTestAzureKV(t, server.URL)
+	assert.Equal(t, defaultRequestTimeout, kv.client.Timeout)
+
+	setFailures := func(failures ...int) {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.failures = failures
+	}
+
+	// 429 and 5xx are retried
+	setFailures(http.StatusTooManyRequests, http.StatusServiceUnavailable)
+	err := kv.Save("retry/key", "0123456789")
+	assert.NoError(t, err)
+	val, err := kv.Load("retry/key")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789", val)
+
+	// other errors are not retried
+	setFailures(http.StatusBadRequest)
+	_, err = kv.Load("retry/key")
+	assert.Error(t, err)
+	val, err = kv.Load("retry/key")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789", val)
+
+	setFailures(http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError,
+		http.StatusInternalServerError, http.StatusInternalServerError)
+	_, err = kv.Load("retry/key")
+	assert.Error(t, err)
+
+	// the whole blob is not accepted as a partial load
+	e.mu.Lock()
+	e.ignoreRange = true
+	e.mu.Unlock()
+	_, err = kv.LoadPartial("retry/key", 2, 4)
+	assert.Error(t, err)
+}
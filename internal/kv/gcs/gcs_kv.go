@@ -0,0 +1,468 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcskv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/oauth2/jwt"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	defaultEndpoint = "https://storage.googleapis.com"
+	defaultTokenURL = "https://oauth2.googleapis.com/token"
+	readWriteScope  = "https://www.googleapis.com/auth/devstorage.read_write"
+
+	// defaultRequestTimeout covers reading the response body too, so it is long enough to read large binlogs
+	defaultRequestTimeout = 2 * time.Minute
+	// requestAttempts is the number of attempts of a request failed with a network error, 429 or 5xx
+	requestAttempts = 5
+)
+
+// errNotFound is returned when the requested object does not exist.
+var errNotFound = errors.New("object not found")
+
+// GCSKV implements DataKV interface and relies on Google Cloud Storage.
+// GCSKV talks to the GCS JSON API, so it works against both GCS and emulators
+// like fake-gcs-server.
+type GCSKV struct {
+	ctx        context.Context
+	client     *http.Client
+	endpoint   string
+	projectID  string
+	bucketName string
+}
+
+// Option option when creates GCSKV.
+type Option struct {
+	// Endpoint of the JSON API, defaults to https://storage.googleapis.com.
+	Endpoint string
+	// CredentialsFile is the path of a service account key file,
+	// requests are sent unauthenticated when it is empty.
+	CredentialsFile string
+	BucketName      string
+	CreateBucket    bool // when bucket not existed, create it
+	// RequestTimeout of every request, defaults to 2 minutes.
+	RequestTimeout time.Duration
+}
+
+// serviceAccount holds the fields of a service account key file used by GCSKV.
+type serviceAccount struct {
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyID string `json:"private_key_id"`
+	TokenURI     string `json:"token_uri"`
+	ProjectID    string `json:"project_id"`
+}
+
+// NewGCSKV creates GCSKV to save and load objects to Google Cloud Storage.
+func NewGCSKV(ctx context.Context, option *Option) (*GCSKV, error) {
+	kv := &GCSKV{
+		ctx:        ctx,
+		client:     &http.Client{},
+		endpoint:   strings.TrimSuffix(option.Endpoint, "/"),
+		bucketName: option.BucketName,
+	}
+	if kv.endpoint == "" {
+		kv.endpoint = defaultEndpoint
+	}
+	if option.CredentialsFile != "" {
+		content, err := ioutil.ReadFile(option.CredentialsFile)
+		if err != nil {
+			return nil, err
+		}
+		account := serviceAccount{}
+		if err := json.Unmarshal(content, &account); err != nil {
+			return nil, fmt.Errorf("invalid gcs credentials file %s: %w", option.CredentialsFile, err)
+		}
+		conf := &jwt.Config{
+			Email:        account.ClientEmail,
+			PrivateKey:   []byte(account.PrivateKey),
+			PrivateKeyID: account.PrivateKeyID,
+			Scopes:       []string{readWriteScope},
+			TokenURL:     account.TokenURI,
+		}
+		if conf.TokenURL == "" {
+			conf.TokenURL = defaultTokenURL
+		}
+		kv.client = conf.Client(ctx)
+		kv.projectID = account.ProjectID
+	}
+	kv.client.Timeout = option.RequestTimeout
+	if kv.client.Timeout <= 0 {
+		kv.client.Timeout = defaultRequestTimeout
+	}
+
+	checkBucketFn := func() error {
+		exist, err := kv.bucketExists()
+		if err != nil {
+			return err
+		}
+		if !exist {
+			log.Debug("GCSKV NewGCSKV", zap.Any("Check bucket", "bucket not exist"))
+			if option.CreateBucket {
+				log.Debug("GCSKV NewGCSKV create bucket.")
+				return kv.createBucket()
+			}
+			return fmt.Errorf("bucket %s not Existed", option.BucketName)
+		}
+		return nil
+	}
+	err := retry.Do(ctx, checkBucketFn, retry.Attempts(300))
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("GCSKV new GCSKV success.")
+	return kv, nil
+}
+
+func (kv *GCSKV) bucketURL() string {
+	return kv.endpoint + "/storage/v1/b/" + url.PathEscape(kv.bucketName)
+}
+
+func (kv *GCSKV) objectURL(key string) string {
+	return kv.bucketURL() + "/o/" + url.PathEscape(key)
+}
+
+func (kv *GCSKV) bucketExists() (bool, error) {
+	resp, err := kv.do(http.MethodGet, kv.bucketURL(), nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+	return false, responseError(resp)
+}
+
+func (kv *GCSKV) createBucket() error {
+	body, err := json.Marshal(map[string]string{"name": kv.bucketName})
+	if err != nil {
+		return err
+	}
+	u := kv.endpoint + "/storage/v1/b?" + url.Values{"project": {kv.projectID}}.Encode()
+	resp, err := kv.do(http.MethodPost, u, map[string]string{"Content-Type": "application/json"}, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// someone else may create the bucket at the same time
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	return responseError(resp)
+}
+
+// Exist checks whether a key exists in the bucket.
+func (kv *GCSKV) Exist(key string) bool {
+	_, err := kv.stat(key)
+	return err == nil
+}
+
+// Load loads an object with @key.
+func (kv *GCSKV) Load(key string) (string, error) {
+	reader, err := kv.Reader(key)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// MultiLoad loads objects with multi @keys.
+func (kv *GCSKV) MultiLoad(keys []string) ([]string, error) {
+	var resultErr error
+	var values []string
+	for _, key := range keys {
+		value, err := kv.Load(key)
+		if err != nil {
+			if resultErr == nil {
+				resultErr = err
+			}
+		}
+		values = append(values, value)
+	}
+	return values, resultErr
+}
+
+// LoadWithPrefix loads objects with the same prefix @prefix.
+func (kv *GCSKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	keys, _, err := kv.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err := kv.MultiLoad(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+// ListWithPrefix lists all objects with the same prefix @prefix,
+// returns the keys and last modified time of the objects.
+func (kv *GCSKV) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+	pageToken := ""
+	for {
+		query := url.Values{}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		resp, err := kv.do(http.MethodGet, kv.bucketURL()+"/o?"+query.Encode(), nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = responseError(resp)
+			resp.Body.Close()
+			return nil, nil, err
+		}
+		result := listObjectsResult{}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, object := range result.Items {
+			keys = append(keys, object.Name)
+			modTimes = append(modTimes, object.Updated)
+		}
+		if result.NextPageToken == "" {
+			return keys, modTimes, nil
+		}
+		pageToken = result.NextPageToken
+	}
+}
+
+// Reader returns a reader of the object with @key, the reader must be closed after use.
+func (kv *GCSKV) Reader(key string) (io.ReadCloser, error) {
+	resp, err := kv.do(http.MethodGet, kv.objectURL(key)+"?alt=media", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, keyError(key, resp)
+	}
+	return resp.Body, nil
+}
+
+// Save saves @value as an object with @key.
+func (kv *GCSKV) Save(key, value string) error {
+	query := url.Values{
+		"uploadType": {"media"},
+		"name":       {key},
+	}
+	u := kv.endpoint + "/upload/storage/v1/b/" + url.PathEscape(kv.bucketName) + "/o?" + query.Encode()
+	resp, err := kv.do(http.MethodPost, u, map[string]string{"Content-Type": "application/octet-stream"}, []byte(value))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return keyError(key, resp)
+	}
+	return nil
+}
+
+// MultiSave saves multiple objects, the path is the key of @kvs.
+func (kv *GCSKV) MultiSave(kvs map[string]string) error {
+	var resultErr error
+	for key, value := range kvs {
+		err := kv.Save(key, value)
+		if err != nil {
+			if resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+	return resultErr
+}
+
+// Remove deletes an object with @key, removing a non-existent object is not an error.
+func (kv *GCSKV) Remove(key string) error {
+	resp, err := kv.do(http.MethodDelete, kv.objectURL(key), nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return keyError(key, resp)
+	}
+	return nil
+}
+
+// MultiRemove deletes objects with @keys.
+func (kv *GCSKV) MultiRemove(keys []string) error {
+	var resultErr error
+	for _, key := range keys {
+		err := kv.Remove(key)
+		if err != nil {
+			if resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+	return resultErr
+}
+
+// RemoveWithPrefix removes all objects with the same prefix @prefix.
+func (kv *GCSKV) RemoveWithPrefix(prefix string) error {
+	keys, _, err := kv.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return kv.MultiRemove(keys)
+}
+
+// LoadPartial loads partial data ranged in [start, end) with @key.
+func (kv *GCSKV) LoadPartial(key string, start, end int64) ([]byte, error) {
+	switch {
+	case start < 0 || end < 0:
+		return nil, fmt.Errorf("invalid range specified: start=%d end=%d",
+			start, end)
+	case start >= end:
+		return nil, fmt.Errorf("invalid range specified: start=%d end=%d",
+			start, end)
+	}
+
+	header := map[string]string{"Range": fmt.Sprintf("bytes=%d-%d", start, end-1)}
+	resp, err := kv.do(http.MethodGet, kv.objectURL(key)+"?alt=media", header, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// a 200 response carries the whole object, the range is not honored
+	if resp.StatusCode != http.StatusPartialContent {
+		return nil, keyError(key, resp)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// GetSize obtains the data size of the object with @key.
+func (kv *GCSKV) GetSize(key string) (int64, error) {
+	object, err := kv.stat(key)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(object.Size, 10, 64)
+}
+
+// Close close the GCSKV.
+func (kv *GCSKV) Close() {
+	kv.client.CloseIdleConnections()
+}
+
+func (kv *GCSKV) stat(key string) (*objectAttrs, error) {
+	resp, err := kv.do(http.MethodGet, kv.objectURL(key), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, keyError(key, resp)
+	}
+	object := &objectAttrs{}
+	if err := json.NewDecoder(resp.Body).Decode(object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// do sends a request, the request is retried with backoff on network errors, 429 and 5xx responses.
+func (kv *GCSKV) do(method, u string, header map[string]string, body []byte) (*http.Response, error) {
+	var resp *http.Response
+	err := retry.Do(kv.ctx, func() error {
+		req, err := http.NewRequestWithContext(kv.ctx, method, u, bytes.NewReader(body))
+		if err != nil {
+			return retry.Unrecoverable(err)
+		}
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		resp, err = kv.client.Do(req)
+		if err != nil {
+			return err
+		}
+		if isRetryableStatus(resp.StatusCode) {
+			err = responseError(resp)
+			resp.Body.Close()
+			return err
+		}
+		return nil
+	}, retry.Attempts(requestAttempts))
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// objectAttrs is the subset of the object resource used by GCSKV, note that
+// the JSON API encodes the 64-bit size as a string.
+type objectAttrs struct {
+	Name    string    `json:"name"`
+	Size    string    `json:"size"`
+	Updated time.Time `json:"updated"`
+}
+
+type listObjectsResult struct {
+	Items         []objectAttrs `json:"items"`
+	NextPageToken string        `json:"nextPageToken"`
+}
+
+func keyError(key string, resp *http.Response) error {
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w with key: %s", errNotFound, key)
+	}
+	return fmt.Errorf("key %s: %w", key, responseError(resp))
+}
+
+func responseError(resp *http.Response) error {
+	msg, _ := ioutil.ReadAll(resp.Body)
+	return fmt.Errorf("gcs responded %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+}
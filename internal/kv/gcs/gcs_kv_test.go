@@ -0,0 +1,461 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcskv
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBucket = "a-bucket"
+
+type emulatedObject struct {
+	data    []byte
+	modTime time.Time
+}
+
+// gcsEmulator is a minimal in-memory stand-in for the GCS JSON API. When token
+// is set, it also acts as the OAuth2 token endpoint and rejects requests
+// without the issued access token.
+type gcsEmulator struct {
+	mu       sync.Mutex
+	token    string
+	pageSize int
+	buckets  map[string]map[string]*emulatedObject
+	// failures are the status codes answered to the next requests
+	failures []int
+	// ignoreRange makes range requests answered with the whole object
+	ignoreRange bool
+}
+
+func newGCSEmulator(t *testing.T, token string) (*gcsEmulator, *httptest.Server) {
+	e := &gcsEmulator{
+		token:    token,
+		pageSize: 2,
+		buckets:  make(map[string]map[string]*emulatedObject),
+	}
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return e, server
+}
+
+func (e *gcsEmulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/token" {
+		r.ParseForm()
+		if r.Form.Get("assertion") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"token_type":"Bearer","expires_in":3600}`, e.token)
+		return
+	}
+	if e.token != "" && r.Header.Get("Authorization") != "Bearer "+e.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.failures) > 0 {
+		w.WriteHeader(e.failures[0])
+		e.failures = e.failures[1:]
+		return
+	}
+	p := r.URL.EscapedPath()
+	switch {
+	case p == "/storage/v1/b" && r.Method == http.MethodPost:
+		req := struct {
+			Name string `json:"name"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&req)
+		if _, ok := e.buckets[req.Name]; ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		e.buckets[req.Name] = make(map[string]*emulatedObject)
+		json.NewEncoder(w).Encode(req)
+	case strings.HasPrefix(p, "/upload/storage/v1/b/") && r.Method == http.MethodPost:
+		bucket, ok := e.buckets[path.Base(path.Dir(p))]
+		if !ok || r.URL.Query().Get("uploadType") != "media" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, _ := ioutil.ReadAll(r.Body)
+		name := r.URL.Query().Get("name")
+		bucket[name] = &emulatedObject{data: data, modTime: time.Now()}
+		json.NewEncoder(w).Encode(e.attrs(name, bucket[name]))
+	case strings.HasPrefix(p, "/storage/v1/b/"):
+		parts := strings.SplitN(strings.TrimPrefix(p, "/storage/v1/b/"), "/", 3)
+		bucket, ok := e.buckets[parts[0]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch len(parts) {
+		case 1:
+			fmt.Fprintf(w, `{"name":%q}`, parts[0])
+		case 2:
+			e.list(w, r, bucket)
+		default:
+			name, _ := url.PathUnescape(parts[2])
+			e.serveObject(w, r, bucket, name)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (e *gcsEmulator) attrs(name string, object *emulatedObject) objectAttrs {
+	return objectAttrs{
+		Name:    name,
+		Size:    strconv.Itoa(len(object.data)),
+		Updated: object.modTime,
+	}
+}
+
+func (e *gcsEmulator) list(w http.ResponseWriter, r *http.Request, bucket map[string]*emulatedObject) {
+	query := r.URL.Query()
+	var names []string
+	for name := range bucket {
+		if strings.HasPrefix(name, query.Get("prefix")) && name >= query.Get("pageToken") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	result := listObjectsResult{}
+	if len(names) > e.pageSize {
+		result.NextPageToken = names[e.pageSize]
+		names = names[:e.pageSize]
+	}
+	for _, name := range names {
+		result.Items = append(result.Items, e.attrs(name, bucket[name]))
+	}
+	json.NewEncoder(w).Encode(result)
+}
+
+func (e *gcsEmulator) serveObject(w http.ResponseWriter, r *http.Request, bucket map[string]*emulatedObject, name string) {
+	object, ok := bucket[name]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodDelete:
+		delete(bucket, name)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		if r.URL.Query().Get("alt") != "media" {
+			json.NewEncoder(w).Encode(e.attrs(name, object))
+			return
+		}
+		if rng := r.Header.Get("Range"); rng != "" && !e.ignoreRange {
+			var start, end int
+			fmt.Sscanf(rng, "bytes=%d-%d", &start, &end)
+			if end >= len(object.data) {
+				end = len(object.data) - 1
+			}
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(object.data[start : end+1])
+			return
+		}
+		w.Write(object.data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestGCSKV(t *testing.T, option *Option) *GCSKV {
+	kv, err := NewGCSKV(context.Background(), option)
+	require.NoError(t, err)
+	t.Cleanup(kv.Close)
+	return kv
+}
+
+func TestGCSKV(t *testing.T) {
+	_, server := newGCSEmulator(t, "")
+	option := &Option{
+		Endpoint:     server.URL,
+		BucketName:   testBucket,
+		CreateBucket: true,
+	}
+
+	t.Run("test create bucket", func(t *testing.T) {
+		newTestGCSKV(t, option)
+		// bucket already created
+		newTestGCSKV(t, option)
+
+		_, err := NewGCSKV(context.Background(), &Option{
+			CredentialsFile: "/not/exist/credentials.json",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("test save and load", func(t *testing.T) {
+		kv := newTestGCSKV(t, option)
+		defer kv.RemoveWithPrefix("save_load")
+
+		err := kv.Save("save_load/abc", "123")
+		assert.NoError(t, err)
+		err = kv.MultiSave(map[string]string{
+			"save_load/abd":     "456",
+			"save_load/sub/a b": "789",
+			"save_load/x+y?z":   "",
+		})
+		assert.NoError(t, err)
+
+		val, err := kv.Load("save_load/abc")
+		assert.NoError(t, err)
+		assert.Equal(t, "123", val)
+		val, err = kv.Load("save_load/sub/a b")
+		assert.NoError(t, err)
+		assert.Equal(t, "789", val)
+		val, err = kv.Load("save_load/x+y?z")
+		assert.NoError(t, err)
+		assert.Equal(t, "", val)
+
+		_, err = kv.Load("save_load/not_exist")
+		assert.ErrorIs(t, err, errNotFound)
+
+		vals, err := kv.MultiLoad([]string{"save_load/abc", "save_load/abd"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"123", "456"}, vals)
+		_, err = kv.MultiLoad([]string{"save_load/abc", "save_load/not_exist"})
+		assert.Error(t, err)
+
+		keys, vals, err := kv.LoadWithPrefix("save_load/ab")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"save_load/abc", "save_load/abd"}, keys)
+		assert.Equal(t, []string{"123", "456"}, vals)
+
+		assert.True(t, kv.Exist("save_load/abc"))
+		assert.False(t, kv.Exist("save_load/ab"))
+	})
+
+	t.Run("test list with prefix", func(t *testing.T) {
+		kv := newTestGCSKV(t, option)
+		defer kv.RemoveWithPrefix("list")
+
+		before := time.Now().Add(-time.Second)
+		for i := 0; i < 5; i++ {
+			err := kv.Save(fmt.Sprintf("list/%d", i), "v")
+			assert.NoError(t, err)
+		}
+		// the emulator returns two objects per page
+		keys, modTimes, err := kv.ListWithPrefix("list/")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"list/0", "list/1", "list/2", "list/3", "list/4"}, keys)
+		assert.Equal(t, 5, len(modTimes))
+		for _, modTime := range modTimes {
+			assert.True(t, modTime.After(before))
+		}
+
+		keys, modTimes, err = kv.ListWithPrefix("list/not_exist")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+		assert.Empty(t, modTimes)
+	})
+
+	t.Run("test remove", func(t *testing.T) {
+		kv := newTestGCSKV(t, option)
+
+		err := kv.MultiSave(map[string]string{
+			"remove/a":   "1",
+			"remove/b":   "2",
+			"remove/c/d": "3",
+			"remove/c/e": "4",
+		})
+		assert.NoError(t, err)
+
+		err = kv.Remove("remove/a")
+		assert.NoError(t, err)
+		assert.False(t, kv.Exist("remove/a"))
+		// removing a non-existent object is fine
+		err = kv.Remove("remove/a")
+		assert.NoError(t, err)
+
+		err = kv.MultiRemove([]string{"remove/b", "remove/not_exist"})
+		assert.NoError(t, err)
+		assert.False(t, kv.Exist("remove/b"))
+
+		err = kv.RemoveWithPrefix("remove/c")
+		assert.NoError(t, err)
+		keys, _, err := kv.ListWithPrefix("remove")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("test load partial and get size", func(t *testing.T) {
+		kv := newTestGCSKV(t, option)
+		defer kv.RemoveWithPrefix("partial")
+
+		err := kv.Save("partial/key", "12345678")
+		assert.NoError(t, err)
+
+		size, err := kv.GetSize("partial/key")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 8, size)
+		_, err = kv.GetSize("partial/not_exist")
+		assert.Error(t, err)
+
+		data, err := kv.LoadPartial("partial/key", 2, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("345"), data)
+		data, err = kv.LoadPartial("partial/key", 6, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("78"), data)
+
+		_, err = kv.LoadPartial("partial/key", 5, 2)
+		assert.Error(t, err)
+		_, err = kv.LoadPartial("partial/key", -1, 2)
+		assert.Error(t, err)
+		_, err = kv.LoadPartial("partial/not_exist", 0, 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("test reader", func(t *testing.T) {
+		kv := newTestGCSKV(t, option)
+		defer kv.RemoveWithPrefix("reader")
+
+		err := kv.Save("reader/key", "value")
+		assert.NoError(t, err)
+
+		reader, err := kv.Reader("reader/key")
+		assert.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), data)
+		assert.NoError(t, reader.Close())
+
+		_, err = kv.Reader("reader/not_exist")
+		assert.ErrorIs(t, err, errNotFound)
+	})
+}
+
+func TestGCSKV_Credentials(t *testing.T) {
+	_, server := newGCSEmulator(t, "test-token")
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+	credentials, err := json.Marshal(serviceAccount{
+		ClientEmail:  "milvus@test.iam.gserviceaccount.com",
+		PrivateKey:   string(keyPEM),
+		PrivateKeyID: "key-id",
+		TokenURI:     server.URL + "/token",
+		ProjectID:    "test-project",
+	})
+	require.NoError(t, err)
+	credentialsFile := path.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, ioutil.WriteFile(credentialsFile, credentials, 0600))
+
+	kv := newTestGCSKV(t, &Option{
+		Endpoint:        server.URL,
+		CredentialsFile: credentialsFile,
+		BucketName:      testBucket,
+		CreateBucket:    true,
+	})
+	assert.Equal(t, "test-project", kv.projectID)
+	err = kv.Save("key", "value")
+	assert.NoError(t, err)
+	val, err := kv.Load("key")
+	assert.NoError(t, err)
+	assert.Equal(t, "value", val)
+
+	// requests without the access token are rejected
+	anonymous := &GCSKV{
+		ctx:        context.Background(),
+		client:     &http.Client{},
+		endpoint:   server.URL,
+		bucketName: testBucket,
+	}
+	_, err = anonymous.Load("key")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, errNotFound)
+
+	err = ioutil.WriteFile(credentialsFile, []byte("not json"), 0600)
+	require.NoError(t, err)
+	_, err = NewGCSKV(context.Background(), &Option{CredentialsFile: credentialsFile})
+	assert.Error(t, err)
+}
+
+func TestGCSKV_Retry(t *testing.T) {
+	e, server := newGCSEmulator(t, "")
+	kv := newTestGCSKV(t, &Option{
+		Endpoint:       server.URL,
+		BucketName:     testBucket,
+		CreateBucket:   true,
+		RequestTimeout: time.Second,
+	})
+	assert.Equal(t, time.Second, kv.client.Timeout)
+
+	setFailures := func(failures ...int) {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.failures = failures
+	}
+
+	// 429 and 5xx are retried
+	setFailures(http.StatusTooManyRequests, http.StatusServiceUnavailable)
+	err := kv.Save("retry/key", "0123456789")
+	assert.NoError(t, err)
+	val, err := kv.Load("retry/key")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789", val)
+
+	// other errors are not retried
+	setFailures(http.StatusForbidden)
+	_, err = kv.Load("retry/key")
+	assert.Error(t, err)
+	val, err = kv.Load("retry/key")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789", val)
+
+	setFailures(http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError,
+		http.StatusInternalServerError, http.StatusInternalServerError)
+	_, err = kv.Load("retry/key")
+	assert.Error(t, err)
+
+	// the whole object is not accepted as a partial load
+	e.mu.Lock()
+	e.ignoreRange = true
+	e.mu.Unlock()
+	_, err = kv.LoadPartial("retry/key", 2, 4)
+	assert.Error(t, err)
+}
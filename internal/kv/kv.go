@@ -21,6 +21,12 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
+// Types of the store which coordinators keep meta in
+const (
+	MetaStoreEtcd = "etcd"
+	MetaStoreSQL  = "sql"
+)
+
 // Value is interface for kv-value, needed to support string and byte slice
 type Value interface {
 	Serialize() []byte
@@ -105,6 +111,14 @@ type MetaKv interface {
 	CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) error
 }
 
+// VersionedKV is TxnKV whose keys carry versions for optimistic concurrency control,
+// the version of a key is 0 if it doesn't exist and increases by 1 on each save.
+type VersionedKV interface {
+	TxnKV
+	LoadWithPrefix2(key string) ([]string, []string, []int64, error)
+	CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) error
+}
+
 // SnapShotKV is TxnKV for snapshot data. It must save timestamp.
 type SnapShotKV interface {
 	Save(key string, value string, ts typeutil.Timestamp) error
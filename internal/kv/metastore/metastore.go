@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastore
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
+)
+
+// NewMetaKV returns the kv which coordinators keep meta in, the store is chosen by storeType.
+// The sql driver and dsn are only used by the sql store, the etcd endpoints are only used by the etcd store.
+func NewMetaKV(storeType, sqlDriver, sqlDSN string, etcdEndpoints []string, rootPath string) (kv.VersionedKV, error) {
+	switch storeType {
+	case kv.MetaStoreEtcd:
+		metaKV, err := etcdkv.NewEtcdKV(etcdEndpoints, rootPath)
+		if err != nil {
+			return nil, err
+		}
+		return metaKV, nil
+	case kv.MetaStoreSQL:
+		metaKV, err := sqlkv.NewSQLKV(sqlDriver, sqlDSN, rootPath)
+		if err != nil {
+			return nil, err
+		}
+		return metaKV, nil
+	default:
+		return nil, fmt.Errorf("unsupported metastore type %s", storeType)
+	}
+}
@@ -0,0 +1,50 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastore
+
+import (
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
+)
+
+func TestNewMetaKV(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "meta.db")
+	metaKV, err := NewMetaKV(kv.MetaStoreSQL, "sqlite3", dsn, nil, "root")
+	require.NoError(t, err)
+	defer metaKV.Close()
+	_, ok := metaKV.(*sqlkv.SQLKV)
+	assert.True(t, ok)
+
+	err = metaKV.Save("key", "value")
+	assert.NoError(t, err)
+	val, err := metaKV.Load("key")
+	assert.NoError(t, err)
+	assert.Equal(t, "value", val)
+
+	_, err = NewMetaKV(kv.MetaStoreSQL, "unknown", dsn, nil, "root")
+	assert.Error(t, err)
+
+	_, err = NewMetaKV("unknown", "sqlite3", dsn, nil, "root")
+	assert.Error(t, err)
+}
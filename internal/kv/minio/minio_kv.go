@@ -24,6 +24,7 @@ import (
 
 	"io"
 	"strings"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -119,6 +120,35 @@ func (kv *MinIOKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return objectsKeys, objectsValues, nil
 }
 
+// ListWithPrefix lists all objects with the same prefix @prefix recursively,
+// returns the keys and last modified time of the objects.
+func (kv *MinIOKV) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+	for object := range kv.minioClient.ListObjects(kv.ctx, kv.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, nil, object.Err
+		}
+		keys = append(keys, object.Key)
+		modTimes = append(modTimes, object.LastModified)
+	}
+	return keys, modTimes, nil
+}
+
+// Reader returns a reader of the object with @key, the reader must be closed after use.
+func (kv *MinIOKV) Reader(key string) (io.ReadCloser, error) {
+	object, err := kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject is lazy, stat it to make sure the object exists
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, err
+	}
+	return object, nil
+}
+
 // Load loads an object with @key.
 func (kv *MinIOKV) Load(key string) (string, error) {
 	object, err := kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{})
@@ -441,4 +441,36 @@ func TestMinIOKV(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, int64(0), size)
 	})
+
+	t.Run("test ListWithPrefix and Reader", func(t *testing.T) {
+		testListRoot := path.Join(testMinIOKVRoot, "list")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testKV, err := newMinIOKVClient(ctx, testBucket)
+		require.NoError(t, err)
+		defer testKV.RemoveWithPrefix(testListRoot)
+
+		err = testKV.MultiSave(map[string]string{
+			path.Join(testListRoot, "a/b/1"): "1",
+			path.Join(testListRoot, "a/b/2"): "22",
+			path.Join(testListRoot, "a/3"):   "333",
+		})
+		require.NoError(t, err)
+
+		keys, modTimes, err := testKV.ListWithPrefix(path.Join(testListRoot, "a"))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(keys))
+		assert.Equal(t, 3, len(modTimes))
+
+		reader, err := testKV.Reader(path.Join(testListRoot, "a/3"))
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, "333", string(content))
+		reader.Close()
+
+		_, err = testKV.Reader(path.Join(testListRoot, "not_exist"))
+		assert.Error(t, err)
+	})
 }
@@ -0,0 +1,313 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+const fakeDriverName = "sqlkv-fake"
+
+var fakeSQLDriver = &fakeDriver{stores: make(map[string]*fakeStore)}
+
+func init() {
+	sql.Register(fakeDriverName, fakeSQLDriver)
+	createTableSQLs[fakeDriverName] = createTableSQLite
+}
+
+// fakeDriver is an in-memory sql driver which only understands the statements used by SQLKV.
+// Like innodb, a transaction locks the rows it writes until it ends, writes wait for the locks
+// of other transactions and plain reads only see committed rows.
+type fakeDriver struct {
+	mu     sync.Mutex
+	stores map[string]*fakeStore
+}
+
+// fakeValue is a row of the meta table
+type fakeValue struct {
+	value   string
+	version int64
+}
+
+type fakeStore struct {
+	mu sync.Mutex
+	// unlocked is signaled when a transaction releases its row locks
+	unlocked *sync.Cond
+	kvs      map[string]fakeValue
+	// locks are the rows written by uncommitted transactions
+	locks map[string]*fakeConn
+	// failInsert makes insert statements fail
+	failInsert bool
+	// beforeCommit is called before a transaction commits if set
+	beforeCommit func()
+}
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	store, ok := d.stores[dsn]
+	if !ok {
+		store = &fakeStore{
+			kvs:   make(map[string]fakeValue),
+			locks: make(map[string]*fakeConn),
+		}
+		store.unlocked = sync.NewCond(&store.mu)
+		d.stores[dsn] = store
+	}
+	return &fakeConn{store: store}, nil
+}
+
+func getFakeStore(dsn string) *fakeStore {
+	conn, _ := fakeSQLDriver.Open(dsn)
+	return conn.(*fakeConn).store
+}
+
+type fakeConn struct {
+	store *fakeStore
+	// undo keeps the committed rows written by the transaction, nil means the row did not exist
+	undo map[string]*fakeValue
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.undo = make(map[string]*fakeValue)
+	return c, nil
+}
+
+func (c *fakeConn) Commit() error {
+	if c.store.beforeCommit != nil {
+		c.store.beforeCommit()
+	}
+	c.end(false)
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	c.end(true)
+	return nil
+}
+
+// end releases the row locks of the transaction, the rows are restored on rollback
+func (c *fakeConn) end(rollback bool) {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	for key, old := range c.undo {
+		if rollback {
+			if old == nil {
+				delete(c.store.kvs, key)
+			} else {
+				c.store.kvs[key] = *old
+			}
+		}
+		delete(c.store.locks, key)
+	}
+	c.undo = nil
+	c.store.unlocked.Broadcast()
+}
+
+// lock waits until no other transaction holds the rows of keys,
+// and locks them until the transaction ends, store.mu must be held
+func (c *fakeConn) lock(keys func() []string) []string {
+	for {
+		locked := false
+		for _, key := range keys() {
+			if owner, ok := c.store.locks[key]; ok && owner != c {
+				locked = true
+				break
+			}
+		}
+		if !locked {
+			break
+		}
+		c.store.unlocked.Wait()
+	}
+	result := keys()
+	if c.undo == nil {
+		return result
+	}
+	for _, key := range result {
+		if _, ok := c.undo[key]; ok {
+			continue
+		}
+		if old, ok := c.store.kvs[key]; ok {
+			c.undo[key] = &old
+		} else {
+			c.undo[key] = nil
+		}
+		c.store.locks[key] = c
+	}
+	return result
+}
+
+// view returns the rows visible to the connection, rows written by other transactions
+// are replaced with their committed values, store.mu must be held
+func (c *fakeConn) view() map[string]fakeValue {
+	kvs := make(map[string]fakeValue, len(c.store.kvs))
+	for k, v := range c.store.kvs {
+		kvs[k] = v
+	}
+	for key, owner := range c.store.locks {
+		if owner == c {
+			continue
+		}
+		if old := owner.undo[key]; old != nil {
+			kvs[key] = *old
+		} else {
+			delete(kvs, key)
+		}
+	}
+	return kvs
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func inRange(key string, args []driver.Value) bool {
+	if key < args[0].(string) {
+		return false
+	}
+	return len(args) < 2 || key < args[1].(string)
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	store := s.conn.store
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	var affected int64
+	switch s.query {
+	case createTableSQLite:
+	case insertSQL:
+		if store.failInsert {
+			return nil, errors.New("insert failed")
+		}
+		key := args[0].(string)
+		s.conn.lock(func() []string { return []string{key} })
+		if _, ok := store.kvs[key]; ok {
+			return nil, fmt.Errorf("duplicate key %s", key)
+		}
+		store.kvs[key] = fakeValue{value: args[1].(string), version: args[2].(int64)}
+		affected = 1
+	case updateSQL, casUpdateSQL:
+		key := args[1].(string)
+		s.conn.lock(func() []string { return []string{key} })
+		old, ok := store.kvs[key]
+		if ok && (s.query == updateSQL || old.version == args[2].(int64)) {
+			store.kvs[key] = fakeValue{value: args[0].(string), version: old.version + 1}
+			affected = 1
+		}
+	case deleteSQL:
+		key := args[0].(string)
+		s.conn.lock(func() []string { return []string{key} })
+		if _, ok := store.kvs[key]; ok {
+			delete(store.kvs, key)
+			affected = 1
+		}
+	case deleteRangeSQL, deleteFromSQL:
+		keys := s.conn.lock(func() []string {
+			keys := make([]string, 0)
+			for key := range store.kvs {
+				if inRange(key, args) {
+					keys = append(keys, key)
+				}
+			}
+			return keys
+		})
+		for _, key := range keys {
+			delete(store.kvs, key)
+		}
+		affected = int64(len(keys))
+	default:
+		return nil, fmt.Errorf("unsupported statement %s", s.query)
+	}
+	return driver.RowsAffected(affected), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.conn.store.mu.Lock()
+	kvs := s.conn.view()
+	s.conn.store.mu.Unlock()
+
+	rows := &fakeRows{}
+	switch s.query {
+	case loadSQL:
+		rows.columns = []string{"v"}
+		if value, ok := kvs[args[0].(string)]; ok {
+			rows.values = append(rows.values, []driver.Value{value.value})
+		}
+	case loadRangeSQL, loadFromSQL:
+		rows.columns = []string{"k", "v", "ver"}
+		keys := make([]string, 0)
+		for key := range kvs {
+			if inRange(key, args) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			rows.values = append(rows.values, []driver.Value{key, kvs[key].value, kvs[key].version})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported query %s", s.query)
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+	pos     int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.pos])
+	r.pos++
+	return nil
+}
@@ -0,0 +1,383 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// RequestTimeout default timeout for sql request.
+	RequestTimeout = 10 * time.Second
+
+	// MetaTable is the name of the table which stores all key-value pairs.
+	MetaTable = "milvus_meta"
+)
+
+const (
+	loadSQL          = "SELECT v FROM " + MetaTable + " WHERE k = ?"
+	loadRangeSQL     = "SELECT k, v, ver FROM " + MetaTable + " WHERE k >= ? AND k < ? ORDER BY k"
+	loadFromSQL      = "SELECT k, v, ver FROM " + MetaTable + " WHERE k >= ? ORDER BY k"
+	insertSQL        = "INSERT INTO " + MetaTable + " (k, v, ver) VALUES (?, ?, ?)"
+	updateSQL        = "UPDATE " + MetaTable + " SET v = ?, ver = ver + 1 WHERE k = ?"
+	casUpdateSQL     = "UPDATE " + MetaTable + " SET v = ?, ver = ver + 1 WHERE k = ? AND ver = ?"
+	deleteSQL        = "DELETE FROM " + MetaTable + " WHERE k = ?"
+	deleteRangeSQL   = "DELETE FROM " + MetaTable + " WHERE k >= ? AND k < ?"
+	deleteFromSQL    = "DELETE FROM " + MetaTable + " WHERE k >= ?"
+	createTableMySQL = "CREATE TABLE IF NOT EXISTS " + MetaTable +
+		" (k VARBINARY(1024) NOT NULL PRIMARY KEY, v LONGBLOB NOT NULL, ver BIGINT NOT NULL)"
+	createTableSQLite = "CREATE TABLE IF NOT EXISTS " + MetaTable +
+		" (k TEXT NOT NULL PRIMARY KEY, v BLOB NOT NULL, ver INTEGER NOT NULL)"
+)
+
+// createTableSQLs are the table definitions of supported drivers,
+// keys must be compared byte by byte so that prefix ranges work like etcd
+var createTableSQLs = map[string]string{
+	"mysql":   createTableMySQL,
+	"sqlite3": createTableSQLite,
+}
+
+// SQLKV implements VersionedKV interface based on a sql database,
+// it stores all keys in one table and uses transactions for multi-key operations.
+// Like etcd, the version of a key starts from 1 and increases on each save.
+// The mysql and sqlite3 drivers are registered by the milvus and metamigration binaries.
+type SQLKV struct {
+	db       *sql.DB
+	rootPath string
+}
+
+var _ kv.VersionedKV = (*SQLKV)(nil)
+
+// NewSQLKV opens the database with driver and dsn, and creates the meta table if not exists.
+func NewSQLKV(driverName, dsn, rootPath string) (*SQLKV, error) {
+	createTableSQL, ok := createTableSQLs[driverName]
+	if !ok {
+		return nil, fmt.Errorf("sql driver %s is not supported", driverName)
+	}
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if driverName == "sqlite3" {
+		// sqlite does not support concurrent writers
+		db.SetMaxOpenConns(1)
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	if _, err := db.ExecContext(ctx, createTableSQL); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &SQLKV{
+		db:       db,
+		rootPath: rootPath,
+	}, nil
+}
+
+// Close closes the database.
+func (kv *SQLKV) Close() {
+	if err := kv.db.Close(); err != nil {
+		log.Warn("failed to close sql kv", zap.Error(err))
+	}
+}
+
+// GetPath returns the full path of the key.
+func (kv *SQLKV) GetPath(key string) string {
+	return path.Join(kv.rootPath, key)
+}
+
+// prefixEnd returns the smallest key which is greater than all keys with the prefix,
+// returns empty string if there is no such key
+func prefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+	return ""
+}
+
+// queryer is implemented by both sql.DB and sql.Tx
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func loadWithPrefix(ctx context.Context, q queryer, prefix string) ([]string, []string, []int64, error) {
+	var rows *sql.Rows
+	var err error
+	if end := prefixEnd(prefix); end != "" {
+		rows, err = q.QueryContext(ctx, loadRangeSQL, prefix, end)
+	} else {
+		rows, err = q.QueryContext(ctx, loadFromSQL, prefix)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	versions := make([]int64, 0)
+	for rows.Next() {
+		var k, v string
+		var ver int64
+		if err := rows.Scan(&k, &v, &ver); err != nil {
+			return nil, nil, nil, err
+		}
+		keys = append(keys, k)
+		values = append(values, v)
+		versions = append(versions, ver)
+	}
+	return keys, values, versions, rows.Err()
+}
+
+// save updates the key in place so that the row lock is held until the transaction ends,
+// the key is inserted with version 1 if it does not exist
+func save(ctx context.Context, q queryer, key, value string) error {
+	res, err := q.ExecContext(ctx, updateSQL, value, key)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected > 0 {
+		return nil
+	}
+	_, err = q.ExecContext(ctx, insertSQL, key, value, 1)
+	return err
+}
+
+func removeWithPrefix(ctx context.Context, q queryer, prefix string) error {
+	var err error
+	if end := prefixEnd(prefix); end != "" {
+		_, err = q.ExecContext(ctx, deleteRangeSQL, prefix, end)
+	} else {
+		_, err = q.ExecContext(ctx, deleteFromSQL, prefix)
+	}
+	return err
+}
+
+// txn runs fn in a transaction, the transaction is rolled back if fn fails
+func (kv *SQLKV) txn(fn func(ctx context.Context, tx *sql.Tx) error) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	tx, err := kv.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(ctx, tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			log.Warn("failed to rollback sql kv txn", zap.Error(rbErr))
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Load returns value of the key.
+func (kv *SQLKV) Load(key string) (string, error) {
+	key = path.Join(kv.rootPath, key)
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	var value string
+	err := kv.db.QueryRowContext(ctx, loadSQL, key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("there is no value on key = %s", key)
+	}
+	if err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// MultiLoad returns values of the keys, returns error if any key does not exist.
+func (kv *SQLKV) MultiLoad(keys []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	result := make([]string, 0, len(keys))
+	invalid := make([]string, 0, len(keys))
+	for _, key := range keys {
+		var value string
+		err := kv.db.QueryRowContext(ctx, loadSQL, path.Join(kv.rootPath, key)).Scan(&value)
+		if err == sql.ErrNoRows {
+			invalid = append(invalid, key)
+			result = append(result, "")
+			continue
+		}
+		if err != nil {
+			return []string{}, err
+		}
+		result = append(result, value)
+	}
+	if len(invalid) != 0 {
+		log.Warn("MultiLoad: there are invalid keys", zap.Strings("keys", invalid))
+		return result, fmt.Errorf("there are invalid keys: %s", invalid)
+	}
+	return result, nil
+}
+
+// LoadWithPrefix returns all keys and values with the prefix, keys are sorted and contain the root path.
+func (kv *SQLKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	keys, values, _, err := loadWithPrefix(ctx, kv.db, path.Join(kv.rootPath, prefix))
+	return keys, values, err
+}
+
+// LoadWithPrefix2 returns all keys, values and versions with the prefix, keys are sorted and contain the root path.
+func (kv *SQLKV) LoadWithPrefix2(prefix string) ([]string, []string, []int64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	return loadWithPrefix(ctx, kv.db, path.Join(kv.rootPath, prefix))
+}
+
+// CompareVersionAndSwap saves the key-value pair if the version of the key is the same as version,
+// etcd options are not supported.
+// The comparison is done by the update or insert statement itself, so concurrent swaps against
+// the same version can not both succeed.
+func (kv *SQLKV) CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) error {
+	if len(opts) > 0 {
+		return errors.New("sql kv does not support etcd options")
+	}
+	key = path.Join(kv.rootPath, key)
+	return kv.txn(func(ctx context.Context, tx *sql.Tx) error {
+		if version == 0 {
+			// the key must not exist, the insert fails on the primary key otherwise
+			if _, err := tx.ExecContext(ctx, insertSQL, key, target, 1); err != nil {
+				var value string
+				if loadErr := tx.QueryRowContext(ctx, loadSQL, key).Scan(&value); loadErr == nil {
+					return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
+				}
+				return err
+			}
+			return nil
+		}
+		res, err := tx.ExecContext(ctx, casUpdateSQL, target, key, version)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
+		}
+		return nil
+	})
+}
+
+// Save saves the key-value pair.
+func (kv *SQLKV) Save(key, value string) error {
+	return kv.txn(func(ctx context.Context, tx *sql.Tx) error {
+		return save(ctx, tx, path.Join(kv.rootPath, key), value)
+	})
+}
+
+// MultiSave saves the key-value pairs in a transaction.
+func (kv *SQLKV) MultiSave(kvs map[string]string) error {
+	return kv.txn(func(ctx context.Context, tx *sql.Tx) error {
+		for key, value := range kvs {
+			if err := save(ctx, tx, path.Join(kv.rootPath, key), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Remove removes the key.
+func (kv *SQLKV) Remove(key string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	_, err := kv.db.ExecContext(ctx, deleteSQL, path.Join(kv.rootPath, key))
+	return err
+}
+
+// MultiRemove removes the keys in a transaction.
+func (kv *SQLKV) MultiRemove(keys []string) error {
+	return kv.txn(func(ctx context.Context, tx *sql.Tx) error {
+		for _, key := range keys {
+			if _, err := tx.ExecContext(ctx, deleteSQL, path.Join(kv.rootPath, key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveWithPrefix removes all keys with the prefix.
+func (kv *SQLKV) RemoveWithPrefix(prefix string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	return removeWithPrefix(ctx, kv.db, path.Join(kv.rootPath, prefix))
+}
+
+// MultiSaveAndRemove saves the key-value pairs and removes the keys in a transaction.
+func (kv *SQLKV) MultiSaveAndRemove(saves map[string]string, removals []string) error {
+	return kv.txn(func(ctx context.Context, tx *sql.Tx) error {
+		for key, value := range saves {
+			if err := save(ctx, tx, path.Join(kv.rootPath, key), value); err != nil {
+				return err
+			}
+		}
+		for _, key := range removals {
+			if _, err := tx.ExecContext(ctx, deleteSQL, path.Join(kv.rootPath, key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MultiRemoveWithPrefix removes all keys with the prefixes in a transaction.
+func (kv *SQLKV) MultiRemoveWithPrefix(prefixes []string) error {
+	return kv.MultiSaveAndRemoveWithPrefix(map[string]string{}, prefixes)
+}
+
+// MultiSaveAndRemoveWithPrefix saves the key-value pairs and removes all keys with the prefixes in a transaction.
+func (kv *SQLKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, prefixes []string) error {
+	return kv.txn(func(ctx context.Context, tx *sql.Tx) error {
+		for key, value := range saves {
+			if err := save(ctx, tx, path.Join(kv.rootPath, key), value); err != nil {
+				return err
+			}
+		}
+		for _, prefix := range prefixes {
+			if err := removeWithPrefix(ctx, tx, path.Join(kv.rootPath, prefix)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,292 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func newTestSQLKV(t *testing.T, rootPath string) *SQLKV {
+	kv, err := NewSQLKV(fakeDriverName, t.Name(), rootPath)
+	require.NoError(t, err)
+	return kv
+}
+
+// forEachDriver runs fn against the fake driver and a real sqlite database,
+// open creates a SQLKV with the root path on the same database.
+func forEachDriver(t *testing.T, fn func(t *testing.T, open func(rootPath string) *SQLKV)) {
+	t.Run("fake", func(t *testing.T) {
+		fn(t, func(rootPath string) *SQLKV {
+			return newTestSQLKV(t, rootPath)
+		})
+	})
+	t.Run("sqlite3", func(t *testing.T) {
+		dsn := filepath.Join(t.TempDir(), "meta.db")
+		fn(t, func(rootPath string) *SQLKV {
+			kv, err := NewSQLKV("sqlite3", dsn, rootPath)
+			require.NoError(t, err)
+			return kv
+		})
+	})
+}
+
+func TestNewSQLKV(t *testing.T) {
+	_, err := NewSQLKV("unknown", "", "root")
+	assert.Error(t, err)
+
+	// supported dialect but driver not registered
+	_, err = NewSQLKV("mysql", "", "root")
+	assert.Error(t, err)
+
+	kv := newTestSQLKV(t, "root")
+	defer kv.Close()
+	assert.Equal(t, "root/key", kv.GetPath("key"))
+}
+
+func TestPrefixEnd(t *testing.T) {
+	assert.Equal(t, "abd", prefixEnd("abc"))
+	assert.Equal(t, "ac", prefixEnd("ab\xff"))
+	assert.Equal(t, "", prefixEnd("\xff\xff"))
+	assert.Equal(t, "", prefixEnd(""))
+}
+
+func TestSQLKV_SaveAndLoad(t *testing.T) {
+	forEachDriver(t, testSQLKVSaveAndLoad)
+}
+
+func testSQLKVSaveAndLoad(t *testing.T, open func(rootPath string) *SQLKV) {
+	kv := open("root")
+	defer kv.Close()
+
+	err := kv.Save("abc", "value1")
+	assert.NoError(t, err)
+	err = kv.Save("abc", "value2")
+	assert.NoError(t, err)
+	err = kv.MultiSave(map[string]string{
+		"abcd": "value3",
+		"abd":  "value4",
+		"b":    "value5",
+	})
+	assert.NoError(t, err)
+
+	val, err := kv.Load("abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "value2", val)
+	_, err = kv.Load("a")
+	assert.Error(t, err)
+
+	vals, err := kv.MultiLoad([]string{"abc", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"value2", "value5"}, vals)
+	vals, err = kv.MultiLoad([]string{"abc", "c"})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"value2", ""}, vals)
+
+	keys, vals, err := kv.LoadWithPrefix("abc")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"root/abc", "root/abcd"}, keys)
+	assert.Equal(t, []string{"value2", "value3"}, vals)
+
+	keys, _, err = kv.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(keys))
+
+	// keys of other root are invisible
+	other := open("root2")
+	defer other.Close()
+	err = other.Save("abc", "other")
+	assert.NoError(t, err)
+	val, err = kv.Load("abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "value2", val)
+}
+
+func TestSQLKV_Remove(t *testing.T) {
+	forEachDriver(t, testSQLKVRemove)
+}
+
+func testSQLKVRemove(t *testing.T, open func(rootPath string) *SQLKV) {
+	kv := open("root")
+	defer kv.Close()
+
+	saves := make(map[string]string)
+	for i := 0; i < 10; i++ {
+		saves[fmt.Sprintf("a/%d", i)] = fmt.Sprintf("value-%d", i)
+		saves[fmt.Sprintf("b/%d", i)] = fmt.Sprintf("value-%d", i)
+		saves[fmt.Sprintf("c/%d", i)] = fmt.Sprintf("value-%d", i)
+	}
+	err := kv.MultiSave(saves)
+	assert.NoError(t, err)
+
+	err = kv.Remove("a/0")
+	assert.NoError(t, err)
+	err = kv.MultiRemove([]string{"a/1", "a/2"})
+	assert.NoError(t, err)
+	keys, _, err := kv.LoadWithPrefix("a")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(keys))
+
+	err = kv.RemoveWithPrefix("a")
+	assert.NoError(t, err)
+	keys, _, err = kv.LoadWithPrefix("a")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	err = kv.MultiSaveAndRemove(map[string]string{"d/0": "value"}, []string{"b/0"})
+	assert.NoError(t, err)
+	_, err = kv.Load("b/0")
+	assert.Error(t, err)
+	val, err := kv.Load("d/0")
+	assert.NoError(t, err)
+	assert.Equal(t, "value", val)
+
+	err = kv.MultiSaveAndRemoveWithPrefix(map[string]string{"e/0": "value"}, []string{"b"})
+	assert.NoError(t, err)
+	keys, _, err = kv.LoadWithPrefix("b")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+	_, err = kv.Load("e/0")
+	assert.NoError(t, err)
+
+	err = kv.MultiRemoveWithPrefix([]string{"c", "d", "e"})
+	assert.NoError(t, err)
+	keys, _, err = kv.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+}
+
+func TestSQLKV_CompareVersionAndSwap(t *testing.T) {
+	forEachDriver(t, testSQLKVCompareVersionAndSwap)
+}
+
+func testSQLKVCompareVersionAndSwap(t *testing.T, open func(rootPath string) *SQLKV) {
+	kv := open("root")
+	defer kv.Close()
+
+	// the version of a missing key is 0
+	err := kv.CompareVersionAndSwap("a/1", 1, "value")
+	assert.Error(t, err)
+	err = kv.CompareVersionAndSwap("a/1", 0, "value1")
+	assert.NoError(t, err)
+	err = kv.Save("a/2", "value")
+	assert.NoError(t, err)
+	err = kv.Save("a/2", "value2")
+	assert.NoError(t, err)
+
+	keys, vals, versions, err := kv.LoadWithPrefix2("a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"root/a/1", "root/a/2"}, keys)
+	assert.Equal(t, []string{"value1", "value2"}, vals)
+	assert.Equal(t, []int64{1, 2}, versions)
+
+	err = kv.CompareVersionAndSwap("a/2", 1, "stale")
+	assert.Error(t, err)
+	err = kv.CompareVersionAndSwap("a/2", 2, "value3")
+	assert.NoError(t, err)
+	_, vals, versions, err = kv.LoadWithPrefix2("a/2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"value3"}, vals)
+	assert.Equal(t, []int64{3}, versions)
+
+	// the version starts over once the key is removed
+	err = kv.Remove("a/2")
+	assert.NoError(t, err)
+	err = kv.CompareVersionAndSwap("a/2", 0, "value4")
+	assert.NoError(t, err)
+	_, _, versions, err = kv.LoadWithPrefix2("a/2")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, versions)
+
+	err = kv.CompareVersionAndSwap("a/2", 1, "value5", clientv3.WithPrevKV())
+	assert.Error(t, err)
+}
+
+func TestSQLKV_ConcurrentCompareVersionAndSwap(t *testing.T) {
+	kv := newTestSQLKV(t, "root")
+	defer kv.Close()
+
+	err := kv.Save("a", "value")
+	assert.NoError(t, err)
+
+	// the first swap holds its row lock until the second swap against the same version has started
+	store := getFakeStore(t.Name())
+	written := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	store.beforeCommit = func() {
+		once.Do(func() {
+			close(written)
+			<-release
+		})
+	}
+	defer func() { store.beforeCommit = nil }()
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- kv.CompareVersionAndSwap("a", 1, "value1")
+	}()
+	<-written
+	go func() {
+		errs <- kv.CompareVersionAndSwap("a", 1, "value2")
+	}()
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	succeeded := 0
+	for i := 0; i < 2; i++ {
+		if <-errs == nil {
+			succeeded++
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+	_, vals, versions, err := kv.LoadWithPrefix2("a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"value1"}, vals)
+	assert.Equal(t, []int64{2}, versions)
+}
+
+func TestSQLKV_TxnRollback(t *testing.T) {
+	kv := newTestSQLKV(t, "root")
+	defer kv.Close()
+
+	err := kv.Save("a", "value")
+	assert.NoError(t, err)
+
+	store := getFakeStore(t.Name())
+	store.failInsert = true
+	err = kv.MultiSaveAndRemove(map[string]string{"b": "value"}, []string{"a"})
+	assert.Error(t, err)
+	err = kv.Save("c", "value")
+	assert.Error(t, err)
+	store.failInsert = false
+
+	// nothing changed
+	val, err := kv.Load("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "value", val)
+	_, err = kv.Load("b")
+	assert.Error(t, err)
+}
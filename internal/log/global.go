@@ -14,6 +14,8 @@
 package log
 
 import (
+	"time"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -63,7 +65,7 @@ func Fatal(msg string, fields ...zap.Field) {
 // it limit log print to avoid too many logs
 // return true if log successfully
 func RatedDebug(cost float64, msg string, fields ...zap.Field) bool {
-	if R().CheckCredit(cost) {
+	if R().AllowN(time.Now(), int(cost)) {
 		L().WithOptions(zap.AddCallerSkip(1)).Debug(msg, fields...)
 		return true
 	}
@@ -74,7 +76,7 @@ func RatedDebug(cost float64, msg string, fields ...zap.Field) bool {
 // it limit log print to avoid too many logs
 // return true if log successfully
 func RatedInfo(cost float64, msg string, fields ...zap.Field) bool {
-	if R().CheckCredit(cost) {
+	if R().AllowN(time.Now(), int(cost)) {
 		L().WithOptions(zap.AddCallerSkip(1)).Info(msg, fields...)
 		return true
 	}
@@ -85,7 +87,7 @@ func RatedInfo(cost float64, msg string, fields ...zap.Field) bool {
 // it limit log print to avoid too many logs
 // return true if log successfully
 func RatedWarn(cost float64, msg string, fields ...zap.Field) bool {
-	if R().CheckCredit(cost) {
+	if R().AllowN(time.Now(), int(cost)) {
 		L().WithOptions(zap.AddCallerSkip(1)).Warn(msg, fields...)
 		return true
 	}
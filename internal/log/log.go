@@ -36,15 +36,14 @@ import (
 
 	"errors"
 
-	"github.com/uber/jaeger-client-go/utils"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
+	"golang.org/x/time/rate"
 	lumberjack "gopkg.in/natefinch/lumberjack.v2"
 )
 
 var _globalL, _globalP, _globalS, _globalR atomic.Value
-var rateLimiter *utils.ReconfigurableRateLimiter
 
 func init() {
 	l, p := newStdLogger()
@@ -53,7 +52,7 @@ func init() {
 	s := _globalL.Load().(*zap.Logger).Sugar()
 	_globalS.Store(s)
 
-	r := utils.NewRateLimiter(1.0, 60.0)
+	r := rate.NewLimiter(rate.Limit(1.0), 60)
 	_globalR.Store(r)
 }
 
@@ -145,9 +144,9 @@ func S() *zap.SugaredLogger {
 	return _globalS.Load().(*zap.SugaredLogger)
 }
 
-// R returns utils.ReconfigurableRateLimiter.
-func R() *utils.ReconfigurableRateLimiter {
-	return _globalR.Load().(*utils.ReconfigurableRateLimiter)
+// R returns the global rate limiter of the rated logs.
+func R() *rate.Limiter {
+	return _globalR.Load().(*rate.Limiter)
 }
 
 // ReplaceGlobals replaces the global Logger and SugaredLogger.
@@ -193,6 +193,36 @@ var (
 			Name:      "dd_channel_time_tick",
 			Help:      "Time tick of dd Channel in 24H",
 		})
+
+	////////////////////////////////////////////////////////////////////////////
+	// for meta snapshot
+
+	// RootCoordSnapshotKeyNum counts the num of keys stored in meta snapshot
+	RootCoordSnapshotKeyNum = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "snapshot_key_num",
+			Help:      "Num of keys stored in meta snapshot",
+		})
+
+	// RootCoordSnapshotKeySize counts the total size in bytes of keys and values stored in meta snapshot
+	RootCoordSnapshotKeySize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "snapshot_key_size",
+			Help:      "Size in bytes of keys and values stored in meta snapshot",
+		})
+
+	// RootCoordSnapshotPrunedCounter counts the num of snapshot versions pruned
+	RootCoordSnapshotPrunedCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemRootCoord,
+			Name:      "snapshot_pruned_total",
+			Help:      "Counter of meta snapshot keys deleted by pruning",
+		})
 )
 
 //RegisterRootCoord registers RootCoord metrics
@@ -218,6 +248,11 @@ func RegisterRootCoord() {
 	// for time tick
 	prometheus.MustRegister(RootCoordInsertChannelTimeTick)
 	prometheus.MustRegister(RootCoordDDChannelTimeTick)
+
+	// for meta snapshot
+	prometheus.MustRegister(RootCoordSnapshotKeyNum)
+	prometheus.MustRegister(RootCoordSnapshotKeySize)
+	prometheus.MustRegister(RootCoordSnapshotPrunedCounter)
 	//prometheus.MustRegister(PanicCounter)
 }
 
@@ -536,6 +571,15 @@ var (
 			Name:      "dml_channels_time_tick",
 			Help:      "Time tick of dml channels",
 		}, []string{"pchan"})
+
+	// ProxySlowSearchCounter counts the num of searches exceeding the slow query threshold
+	ProxySlowSearchCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemProxy,
+			Name:      "slow_search_total",
+			Help:      "Counter of slow search",
+		})
 )
 
 //RegisterProxy registers Proxy metrics
@@ -585,6 +629,7 @@ func RegisterProxy() {
 	prometheus.MustRegister(ProxyReleaseDQLMessageStreamCounter)
 
 	prometheus.MustRegister(ProxyDmlChannelTimeTick)
+	prometheus.MustRegister(ProxySlowSearchCounter)
 }
 
 //RegisterQueryCoord registers QueryCoord metrics
@@ -607,11 +652,62 @@ var (
 			Help:      "List of data nodes registered within etcd",
 		}, []string{"status"},
 	)
+
+	// DataCoordCompactionCounter counts the num of completed compaction plans
+	DataCoordCompactionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "compaction_total",
+			Help:      "Counter of completed compaction plans",
+		}, []string{"type"})
+
+	// DataCoordCompactionRowsCounter counts the num of rows read by compactions
+	DataCoordCompactionRowsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "compaction_rows_total",
+			Help:      "Counter of rows read by compactions",
+		}, []string{"type"})
+
+	// DataCoordCompactionDeletedRowsCounter counts the num of deleted rows purged by compactions
+	DataCoordCompactionDeletedRowsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "compaction_deleted_rows_total",
+			Help:      "Counter of deleted rows purged by compactions",
+		}, []string{"type"})
+
+	// DataCoordCompactionBytesCounter counts the bytes read and written by compactions
+	DataCoordCompactionBytesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "compaction_bytes_total",
+			Help:      "Counter of bytes read and written by compactions",
+		}, []string{"type", "direction"})
+
+	// DataCoordCompactionLatency records the time cost of compactions on datanodes
+	DataCoordCompactionLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "compaction_latency_seconds",
+			Help:      "Time cost of compactions on datanodes",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14), // 0.1s to about 14min
+		}, []string{"type"})
 )
 
 //RegisterDataCoord registers DataCoord metrics
 func RegisterDataCoord() {
 	prometheus.MustRegister(DataCoordDataNodeList)
+	prometheus.MustRegister(DataCoordCompactionCounter)
+	prometheus.MustRegister(DataCoordCompactionRowsCounter)
+	prometheus.MustRegister(DataCoordCompactionDeletedRowsCounter)
+	prometheus.MustRegister(DataCoordCompactionBytesCounter)
+	prometheus.MustRegister(DataCoordCompactionLatency)
 }
 
 var (
@@ -18,6 +18,7 @@ package msgstream
 
 import (
 	"context"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/mitchellh/mapstructure"
@@ -81,6 +82,11 @@ func NewPmsFactory() Factory {
 // RmsFactory is a rocksmq msgstream factory that implemented Factory interface(msgstream.go)
 type RmsFactory struct {
 	dispatcherFactory ProtoUDFactory
+	// serverAddresses are the addresses of the rocksmq server, the rocksmq of this process is used if it's empty
+	serverAddresses []string
+	// remoteClient is the connection to the rocksmq server shared by the msgstreams
+	remoteMu     sync.Mutex
+	remoteClient mqclient.Client
 	// the following members must be public, so that mapstructure.Decode() can access them
 	ReceiveBufSize int64
 	RmqBufSize     int64
@@ -95,9 +101,25 @@ func (f *RmsFactory) SetParams(params map[string]interface{}) error {
 	return nil
 }
 
+func (f *RmsFactory) newClient() (mqclient.Client, error) {
+	if len(f.serverAddresses) > 0 {
+		f.remoteMu.Lock()
+		defer f.remoteMu.Unlock()
+		if f.remoteClient == nil {
+			client, err := mqclient.NewRemoteRmqClient(f.serverAddresses)
+			if err != nil {
+				return nil, err
+			}
+			f.remoteClient = client
+		}
+		return f.remoteClient, nil
+	}
+	return mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.GetRocksMQ()})
+}
+
 // NewMsgStream is used to generate a new Msgstream object
 func (f *RmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
-	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq})
+	rmqClient, err := f.newClient()
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +128,7 @@ func (f *RmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
 
 // NewTtMsgStream is used to generate a new TtMsgstream object
 func (f *RmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
-	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq})
+	rmqClient, err := f.newClient()
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +137,7 @@ func (f *RmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
 
 // NewQueryMsgStream is used to generate a new QueryMsgstream object
 func (f *RmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
-	rmqClient, err := mqclient.NewRmqClient(rocksmq.ClientOptions{Server: rocksmqserver.Rmq})
+	rmqClient, err := f.newClient()
 	if err != nil {
 		return nil, err
 	}
@@ -133,3 +155,83 @@ func NewRmsFactory() Factory {
 	rocksmqserver.InitRocksMQ()
 	return f
 }
+
+// NewRemoteRmsFactory is used to generate a RmsFactory which accesses the rocksmq server of another process
+func NewRemoteRmsFactory(serverAddresses []string) Factory {
+	f := &RmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		serverAddresses:   serverAddresses,
+		ReceiveBufSize:    1024,
+		RmqBufSize:        1024,
+	}
+	return f
+}
+
+// KmsFactory is a kafka msgstream factory that implemented Factory interface(msgstream.go)
+type KmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	brokerOptions     mqclient.KafkaBrokerOptions
+	// the connections to kafka are shared by all the msgstreams created by the factory
+	brokerMu sync.Mutex
+	broker   mqclient.KafkaBroker
+	// the following members must be public, so that mapstructure.Decode() can access them
+	ReceiveBufSize int64
+	KafkaBufSize   int64
+}
+
+// SetParams is used to set parameters for KmsFactory
+func (f *KmsFactory) SetParams(params map[string]interface{}) error {
+	err := mapstructure.Decode(params, f)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (f *KmsFactory) newClient() (mqclient.Client, error) {
+	f.brokerMu.Lock()
+	defer f.brokerMu.Unlock()
+	if f.broker == nil {
+		broker, err := mqclient.NewKafkaBroker(f.brokerOptions)
+		if err != nil {
+			return nil, err
+		}
+		f.broker = broker
+	}
+	return mqclient.NewKafkaClient(f.broker)
+}
+
+// NewMsgStream is used to generate a new Msgstream object
+func (f *KmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient, err := f.newClient()
+	if err != nil {
+		return nil, err
+	}
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+// NewTtMsgStream is used to generate a new TtMsgstream object
+func (f *KmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient, err := f.newClient()
+	if err != nil {
+		return nil, err
+	}
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+// NewQueryMsgStream is used to generate a new QueryMsgstream object
+func (f *KmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+// NewKmsFactory is used to generate a new KmsFactory object, the kafka cluster is connected when
+// the first msgstream is created
+func NewKmsFactory(brokerOptions mqclient.KafkaBrokerOptions) Factory {
+	f := &KmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		brokerOptions:     brokerOptions,
+		ReceiveBufSize:    1024,
+		KafkaBufSize:      1024,
+	}
+	return f
+}
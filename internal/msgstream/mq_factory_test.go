@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/mqclient"
 )
 
 func TestPmsFactory(t *testing.T) {
@@ -82,6 +84,18 @@ func TestRmsFactory(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestRmsFactory_Remote(t *testing.T) {
+	// no rocksmq server is listening on the address
+	rmsFactory := NewRemoteRmsFactory([]string{"127.0.0.1:1"})
+
+	ctx := context.Background()
+	_, err := rmsFactory.NewMsgStream(ctx)
+	assert.NotNil(t, err)
+
+	_, err = rmsFactory.NewTtMsgStream(ctx)
+	assert.NotNil(t, err)
+}
+
 func TestRmsFactory_SetParams(t *testing.T) {
 	rmsFactory := (*RmsFactory)(nil)
 
@@ -92,3 +106,43 @@ func TestRmsFactory_SetParams(t *testing.T) {
 	err := rmsFactory.SetParams(m)
 	assert.NotNil(t, err)
 }
+
+func TestKmsFactory(t *testing.T) {
+	kmsFactory := &KmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		broker:            mqclient.NewMemoryKafkaBroker(),
+	}
+
+	m := map[string]interface{}{
+		"ReceiveBufSize": 1024,
+		"KafkaBufSize":   1024,
+	}
+	err := kmsFactory.SetParams(m)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1024), kmsFactory.KafkaBufSize)
+
+	ctx := context.Background()
+	_, err = kmsFactory.NewMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = kmsFactory.NewTtMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = kmsFactory.NewQueryMsgStream(ctx)
+	assert.Nil(t, err)
+
+	// no kafka brokers to connect
+	_, err = NewKmsFactory(mqclient.KafkaBrokerOptions{}).NewMsgStream(ctx)
+	assert.NotNil(t, err)
+}
+
+func TestKmsFactory_SetParams(t *testing.T) {
+	kmsFactory := (*KmsFactory)(nil)
+
+	m := map[string]interface{}{
+		"ReceiveBufSize": 1024,
+		"KafkaBufSize":   1024,
+	}
+	err := kmsFactory.SetParams(m)
+	assert.NotNil(t, err)
+}
@@ -33,7 +33,6 @@ import (
 	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
 )
 
 var _ MsgStream = (*mqMsgStream)(nil)
@@ -284,8 +283,10 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 		channel := ms.producerChannels[k]
 		for i := 0; i < len(v.Msgs); i++ {
 			sp, spanCtx := MsgSpanFromCtx(v.Msgs[i].TraceCtx(), v.Msgs[i])
+			properties := map[string]string{}
+			tracedMsg := InjectCtxToMsg(spanCtx, v.Msgs[i], properties)
 
-			mb, err := v.Msgs[i].Marshal(v.Msgs[i])
+			mb, err := tracedMsg.Marshal(tracedMsg)
 			if err != nil {
 				return err
 			}
@@ -295,9 +296,7 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 				return err
 			}
 
-			msg := &mqclient.ProducerMessage{Payload: m, Properties: map[string]string{}}
-
-			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+			msg := &mqclient.ProducerMessage{Payload: m, Properties: properties}
 
 			ms.producerLock.Lock()
 			if _, err := ms.producers[channel].Send(
@@ -306,10 +305,10 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 			); err != nil {
 				ms.producerLock.Unlock()
 				trace.LogError(sp, err)
-				sp.Finish()
+				sp.End()
 				return err
 			}
-			sp.Finish()
+			sp.End()
 			ms.producerLock.Unlock()
 		}
 	}
@@ -350,8 +349,10 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 		channel := ms.producerChannels[k]
 		for i, tsMsg := range v.Msgs {
 			sp, spanCtx := MsgSpanFromCtx(v.Msgs[i].TraceCtx(), tsMsg)
+			properties := map[string]string{}
+			tracedMsg := InjectCtxToMsg(spanCtx, tsMsg, properties)
 
-			mb, err := tsMsg.Marshal(tsMsg)
+			mb, err := tracedMsg.Marshal(tracedMsg)
 			if err != nil {
 				return ids, err
 			}
@@ -361,9 +362,7 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 				return ids, err
 			}
 
-			msg := &mqclient.ProducerMessage{Payload: m, Properties: map[string]string{}}
-
-			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+			msg := &mqclient.ProducerMessage{Payload: m, Properties: properties}
 
 			ms.producerLock.Lock()
 			id, err := ms.producers[channel].Send(
@@ -373,11 +372,11 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 			if err != nil {
 				ms.producerLock.Unlock()
 				trace.LogError(sp, err)
-				sp.Finish()
+				sp.End()
 				return ids, err
 			}
 			ids[channel] = append(ids[channel], id)
-			sp.Finish()
+			sp.End()
 			ms.producerLock.Unlock()
 		}
 	}
@@ -393,8 +392,10 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 	}
 	for _, v := range msgPack.Msgs {
 		sp, spanCtx := MsgSpanFromCtx(v.TraceCtx(), v)
+		properties := map[string]string{}
+		tracedMsg := InjectCtxToMsg(spanCtx, v, properties)
 
-		mb, err := v.Marshal(v)
+		mb, err := tracedMsg.Marshal(tracedMsg)
 		if err != nil {
 			return err
 		}
@@ -404,9 +405,7 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 			return err
 		}
 
-		msg := &mqclient.ProducerMessage{Payload: m, Properties: map[string]string{}}
-
-		trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+		msg := &mqclient.ProducerMessage{Payload: m, Properties: properties}
 
 		ms.producerLock.Lock()
 		for _, producer := range ms.producers {
@@ -416,12 +415,12 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 			); err != nil {
 				ms.producerLock.Unlock()
 				trace.LogError(sp, err)
-				sp.Finish()
+				sp.End()
 				return err
 			}
 		}
 		ms.producerLock.Unlock()
-		sp.Finish()
+		sp.End()
 	}
 	return nil
 }
@@ -435,8 +434,10 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 	}
 	for _, v := range msgPack.Msgs {
 		sp, spanCtx := MsgSpanFromCtx(v.TraceCtx(), v)
+		properties := map[string]string{}
+		tracedMsg := InjectCtxToMsg(spanCtx, v, properties)
 
-		mb, err := v.Marshal(v)
+		mb, err := tracedMsg.Marshal(tracedMsg)
 		if err != nil {
 			return ids, err
 		}
@@ -446,9 +447,7 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 			return ids, err
 		}
 
-		msg := &mqclient.ProducerMessage{Payload: m, Properties: map[string]string{}}
-
-		trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+		msg := &mqclient.ProducerMessage{Payload: m, Properties: properties}
 
 		ms.producerLock.Lock()
 		for channel, producer := range ms.producers {
@@ -456,13 +455,13 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 			if err != nil {
 				ms.producerLock.Unlock()
 				trace.LogError(sp, err)
-				sp.Finish()
+				sp.End()
 				return ids, err
 			}
 			ids[channel] = append(ids[channel], id)
 		}
 		ms.producerLock.Unlock()
-		sp.Finish()
+		sp.End()
 	}
 	return ids, nil
 }
@@ -538,9 +537,9 @@ func (ms *mqMsgStream) receiveMsg(consumer mqclient.Consumer) {
 				Timestamp:   tsMsg.BeginTs(),
 			})
 
-			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
-			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+			sp, ctx := ExtractFromMsgProperties(tsMsg, msg.Properties())
+			if ctx != nil {
+				tsMsg.SetTraceCtx(ctx)
 			}
 
 			msgPack := MsgPack{
@@ -550,7 +549,7 @@ func (ms *mqMsgStream) receiveMsg(consumer mqclient.Consumer) {
 			}
 			ms.receiveBuf <- &msgPack
 
-			sp.Finish()
+			sp.End()
 		}
 	}
 }
@@ -891,9 +890,9 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqclient.Consumer) {
 				continue
 			}
 
-			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
-			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+			sp, ctx := ExtractFromMsgProperties(tsMsg, msg.Properties())
+			if ctx != nil {
+				tsMsg.SetTraceCtx(ctx)
 			}
 
 			ms.chanMsgBufMutex.Lock()
@@ -904,10 +903,10 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqclient.Consumer) {
 				ms.chanTtMsgTimeMutex.Lock()
 				ms.chanTtMsgTime[consumer] = tsMsg.(*TimeTickMsg).Base.Timestamp
 				ms.chanTtMsgTimeMutex.Unlock()
-				sp.Finish()
+				sp.End()
 				return
 			}
-			sp.Finish()
+			sp.End()
 		}
 	}
 }
@@ -2062,3 +2062,186 @@ type messageID struct {
 type iface struct {
 	Type, Data unsafe.Pointer
 }
+
+/****************************************Kafka test******************************************/
+
+func initKafkaStream(broker mqclient.KafkaBroker, producerChannels []string,
+	consumerChannels []string,
+	consumerSubName string,
+	tt bool) (MsgStream, MsgStream) {
+	factory := ProtoUDFactory{}
+
+	kafkaClient, _ := mqclient.NewKafkaClient(broker)
+	inputStream, _ := NewMqMsgStream(context.Background(), 100, 100, kafkaClient, factory.NewUnmarshalDispatcher())
+	inputStream.AsProducer(producerChannels)
+	inputStream.Start()
+	var input MsgStream = inputStream
+
+	kafkaClient2, _ := mqclient.NewKafkaClient(broker)
+	var output MsgStream
+	if tt {
+		output, _ = NewMqTtMsgStream(context.Background(), 100, 100, kafkaClient2, factory.NewUnmarshalDispatcher())
+	} else {
+		output, _ = NewMqMsgStream(context.Background(), 100, 100, kafkaClient2, factory.NewUnmarshalDispatcher())
+	}
+	output.AsConsumer(consumerChannels, consumerSubName)
+	output.Start()
+
+	return input, output
+}
+
+func TestStream_KafkaMsgStream_Insert(t *testing.T) {
+	producerChannels := []string{"insert1", "insert2"}
+	consumerChannels := []string{"insert1", "insert2"}
+	consumerSubName := "InsertGroup"
+
+	msgPack := MsgPack{}
+	msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_Insert, 1))
+	msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_Insert, 3))
+
+	inputStream, outputStream := initKafkaStream(mqclient.NewMemoryKafkaBroker(), producerChannels, consumerChannels, consumerSubName, false)
+	defer inputStream.Close()
+	defer outputStream.Close()
+	err := inputStream.Produce(&msgPack)
+	require.NoErrorf(t, err, fmt.Sprintf("produce error = %v", err))
+
+	receiveMsg(outputStream, len(msgPack.Msgs))
+}
+
+func TestStream_KafkaMsgStream_Seek(t *testing.T) {
+	broker := mqclient.NewMemoryKafkaBroker()
+	c := funcutil.RandomString(8)
+	consumerSubName := funcutil.RandomString(8)
+
+	inputStream, outputStream := initKafkaStream(broker, []string{c}, []string{c}, consumerSubName, false)
+	defer inputStream.Close()
+
+	msgPack := &MsgPack{}
+	for i := 0; i < 10; i++ {
+		msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_Insert, int64(i)))
+	}
+	err := inputStream.Produce(msgPack)
+	assert.Nil(t, err)
+
+	var seekPosition *internalpb.MsgPosition
+	for i := 0; i < 10; i++ {
+		result := outputStream.Consume()
+		assert.Equal(t, result.Msgs[0].ID(), int64(i))
+		if i == 5 {
+			seekPosition = result.EndPositions[0]
+		}
+	}
+	outputStream.Close()
+
+	factory := ProtoUDFactory{}
+	kafkaClient, _ := mqclient.NewKafkaClient(broker)
+	outputStream2, _ := NewMqMsgStream(context.Background(), 100, 100, kafkaClient, factory.NewUnmarshalDispatcher())
+	outputStream2.AsConsumer([]string{c}, consumerSubName)
+	err = outputStream2.Seek([]*internalpb.MsgPosition{seekPosition})
+	assert.Nil(t, err)
+	outputStream2.Start()
+	defer outputStream2.Close()
+
+	for i := 6; i < 10; i++ {
+		result := outputStream2.Consume()
+		assert.Equal(t, result.Msgs[0].ID(), int64(i))
+	}
+}
+
+func TestStream_KafkaTtMsgStream_Seek(t *testing.T) {
+	broker := mqclient.NewMemoryKafkaBroker()
+	c := funcutil.RandomString(8)
+	consumerSubName := funcutil.RandomString(8)
+
+	inputStream, outputStream := initKafkaStream(broker, []string{c}, []string{c}, consumerSubName, true)
+	defer inputStream.Close()
+
+	assert.Nil(t, inputStream.Broadcast(getTimeTickMsgPack(0)))
+	assert.Nil(t, inputStream.Produce(getInsertMsgPack([]int{1, 3})))
+	assert.Nil(t, inputStream.Broadcast(getTimeTickMsgPack(5)))
+	assert.Nil(t, inputStream.Produce(getInsertMsgPack([]int{14, 9})))
+	assert.Nil(t, inputStream.Broadcast(getTimeTickMsgPack(11)))
+	assert.Nil(t, inputStream.Broadcast(getTimeTickMsgPack(20)))
+
+	receivedMsg := outputStream.Consume()
+	assert.Equal(t, len(receivedMsg.Msgs), 2)
+	assert.Equal(t, receivedMsg.EndTs, uint64(5))
+
+	receivedMsg2 := outputStream.Consume()
+	assert.Equal(t, len(receivedMsg2.Msgs), 1)
+	assert.Equal(t, receivedMsg2.BeginTs, uint64(5))
+	assert.Equal(t, receivedMsg2.EndTs, uint64(11))
+	outputStream.Close()
+
+	factory := ProtoUDFactory{}
+	kafkaClient, _ := mqclient.NewKafkaClient(broker)
+	outputStream2, _ := NewMqTtMsgStream(context.Background(), 100, 100, kafkaClient, factory.NewUnmarshalDispatcher())
+	outputStream2.AsConsumer([]string{c}, funcutil.RandomString(8))
+	err := outputStream2.Seek(receivedMsg2.StartPositions)
+	assert.Nil(t, err)
+	outputStream2.Start()
+	defer outputStream2.Close()
+
+	seekMsg := outputStream2.Consume()
+	assert.Equal(t, len(seekMsg.Msgs), 1)
+	assert.Equal(t, seekMsg.Msgs[0].BeginTs(), uint64(9))
+	assert.Equal(t, seekMsg.EndTs, uint64(11))
+
+	seekMsg2 := outputStream2.Consume()
+	assert.Equal(t, len(seekMsg2.Msgs), 1)
+	assert.Equal(t, seekMsg2.Msgs[0].BeginTs(), uint64(14))
+}
+
+func TestStream_KafkaMsgStream_Reader(t *testing.T) {
+	ctx := context.Background()
+	broker := mqclient.NewMemoryKafkaBroker()
+	c := funcutil.RandomString(8)
+	factory := ProtoUDFactory{}
+
+	kafkaClient, _ := mqclient.NewKafkaClient(broker)
+	inputStream, _ := NewMqMsgStream(ctx, 100, 100, kafkaClient, factory.NewUnmarshalDispatcher())
+	inputStream.AsProducer([]string{c})
+	inputStream.Start()
+	defer inputStream.Close()
+
+	n := 10
+	p := 5
+	msgPack := &MsgPack{}
+	for i := 0; i < n; i++ {
+		msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_Insert, int64(i)))
+	}
+	err := inputStream.Produce(msgPack)
+	assert.Nil(t, err)
+
+	readStream, _ := NewMqMsgStream(ctx, 100, 100, kafkaClient, factory.NewUnmarshalDispatcher())
+	readStream.AsReader([]string{c}, funcutil.RandomString(8))
+	defer readStream.Close()
+	var seekPosition *internalpb.MsgPosition
+	for i := 0; i < n; i++ {
+		assert.True(t, readStream.HasNext(c))
+		result, err := readStream.Next(ctx, c)
+		assert.Nil(t, err)
+		assert.Equal(t, result.ID(), int64(i))
+		if i == p {
+			seekPosition = result.Position()
+		}
+	}
+	assert.False(t, readStream.HasNext(c))
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	result, err := readStream.Next(timeoutCtx, c)
+	assert.NotNil(t, err)
+	assert.Nil(t, result)
+
+	readStream2, _ := NewMqMsgStream(ctx, 100, 100, kafkaClient, factory.NewUnmarshalDispatcher())
+	readStream2.AsReader([]string{c}, funcutil.RandomString(8))
+	defer readStream2.Close()
+	err = readStream2.SeekReaders([]*internalpb.MsgPosition{seekPosition})
+	assert.Nil(t, err)
+	for i := p; i < n; i++ {
+		result, err := readStream2.Next(ctx, c)
+		assert.Nil(t, err)
+		assert.Equal(t, result.ID(), int64(i))
+	}
+	assert.False(t, readStream2.HasNext(c))
+}
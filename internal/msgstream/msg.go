@@ -59,12 +59,12 @@ type BaseMsg struct {
 	MsgPosition    *MsgPosition
 }
 
-// TraceCtx returns the context of opentracing
+// TraceCtx returns the context of tracing
 func (bm *BaseMsg) TraceCtx() context.Context {
 	return bm.Ctx
 }
 
-// SetTraceCtx is used to set context for opentracing
+// SetTraceCtx is used to set context for tracing
 func (bm *BaseMsg) SetTraceCtx(ctx context.Context) {
 	bm.Ctx = ctx
 }
@@ -547,12 +547,12 @@ type QueryNodeStatsMsg struct {
 // interface implementation validation
 var _ TsMsg = &QueryNodeStatsMsg{}
 
-// TraceCtx returns the context of opentracing
+// TraceCtx returns the context of tracing
 func (qs *QueryNodeStatsMsg) TraceCtx() context.Context {
 	return qs.BaseMsg.Ctx
 }
 
-// SetTraceCtx is used to set context for opentracing
+// SetTraceCtx is used to set context for tracing
 func (qs *QueryNodeStatsMsg) SetTraceCtx(ctx context.Context) {
 	qs.BaseMsg.Ctx = ctx
 }
@@ -610,12 +610,12 @@ type SegmentStatisticsMsg struct {
 // interface implementation validation
 var _ TsMsg = &SegmentStatisticsMsg{}
 
-// TraceCtx returns the context of opentracing
+// TraceCtx returns the context of tracing
 func (ss *SegmentStatisticsMsg) TraceCtx() context.Context {
 	return ss.BaseMsg.Ctx
 }
 
-// SetTraceCtx is used to set context for opentracing
+// SetTraceCtx is used to set context for tracing
 func (ss *SegmentStatisticsMsg) SetTraceCtx(ctx context.Context) {
 	ss.BaseMsg.Ctx = ctx
 }
@@ -890,12 +890,12 @@ type LoadIndexMsg struct {
 	internalpb.LoadIndex
 }
 
-// TraceCtx returns the context of opentracing
+// TraceCtx returns the context of tracing
 func (lim *LoadIndexMsg) TraceCtx() context.Context {
 	return lim.BaseMsg.Ctx
 }
 
-// SetTraceCtx is used to set context for opentracing
+// SetTraceCtx is used to set context for tracing
 func (lim *LoadIndexMsg) SetTraceCtx(ctx context.Context) {
 	lim.BaseMsg.Ctx = ctx
 }
@@ -18,84 +18,101 @@ package msgstream
 
 import (
 	"context"
-	"errors"
-	"runtime"
+	"reflect"
 
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/ext"
-	"github.com/opentracing/opentracing-go/log"
+	"github.com/golang/protobuf/proto"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
-// ExtractFromPulsarMsgProperties extracts trace span from msg.properties.
-// And it will attach some default tags to the span.
-func ExtractFromPulsarMsgProperties(msg TsMsg, properties map[string]string) (opentracing.Span, bool) {
-	if !allowTrace(msg) {
-		return trace.NoopSpan(), false
+type msgWithBase interface {
+	GetBase() *commonpb.MsgBase
+}
+
+func getMsgBase(msg TsMsg) *commonpb.MsgBase {
+	if m, ok := msg.(msgWithBase); ok {
+		return m.GetBase()
 	}
-	tracer := opentracing.GlobalTracer()
-	sc, _ := tracer.Extract(opentracing.TextMap, trace.PropertiesReaderWriter{PpMap: properties})
-	name := "receive pulsar msg"
-	opts := []opentracing.StartSpanOption{
-		ext.RPCServerOption(sc),
-		opentracing.Tags{
-			"ID":       msg.ID(),
-			"Type":     msg.Type(),
-			"HashKeys": msg.HashKeys(),
-			"Position": msg.Position(),
-		}}
-	return opentracing.StartSpan(name, opts...), true
+	return nil
 }
 
-// MsgSpanFromCtx extracts the span from context.
-// And it will attach some default tags to the span.
-func MsgSpanFromCtx(ctx context.Context, msg TsMsg, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
-	if ctx == nil {
-		return trace.NoopSpan(), ctx
+func msgAttributes(msg TsMsg) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.Int64("ID", msg.ID()),
+		attribute.String("Type", msg.Type().String()),
 	}
-	if !allowTrace(msg) {
-		return trace.NoopSpan(), ctx
+	if pos := msg.Position(); pos != nil {
+		attrs = append(attrs, attribute.String("Channel", pos.ChannelName))
 	}
-	operationName := "send pulsar msg"
-	opts = append(opts, opentracing.Tags{
-		"ID":       msg.ID(),
-		"Type":     msg.Type(),
-		"HashKeys": msg.HashKeys(),
-		"Position": msg.Position(),
-	})
+	return attrs
+}
 
-	var pcs [1]uintptr
-	n := runtime.Callers(2, pcs[:])
-	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, operationName, opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
-		return span, ctx
+// withMsgBase returns a shallow copy of msg whose MsgBase is replaced by base, or msg itself if
+// it doesn't carry a MsgBase.
+func withMsgBase(msg TsMsg, base *commonpb.MsgBase) TsMsg {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return msg
 	}
-	file, line := runtime.FuncForPC(pcs[0]).FileLine(pcs[0])
-
-	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
-		opts = append(opts, opentracing.ChildOf(parentSpan.Context()))
+	copied := reflect.New(v.Elem().Type())
+	copied.Elem().Set(v.Elem())
+	field := copied.Elem().FieldByName("Base")
+	if !field.IsValid() || !field.CanSet() || field.Type() != reflect.TypeOf(base) {
+		return msg
 	}
-	span := opentracing.StartSpan(operationName, opts...)
-	ctx = opentracing.ContextWithSpan(ctx, span)
-
-	span.LogFields(log.String("filename", file), log.Int("line", line))
+	field.Set(reflect.ValueOf(base))
+	return copied.Interface().(TsMsg)
+}
 
-	return span, ctx
+// InjectCtxToMsg injects the trace context of ctx into the properties of the mq message, and
+// returns the msg to marshal, which carries the context in its MsgBase too. The context in MsgBase
+// survives the mqs which drop the message properties. The msgs may be shared by the callers, so
+// the returned msg is a copy with a cloned MsgBase and msg itself is never modified.
+func InjectCtxToMsg(ctx context.Context, msg TsMsg, properties map[string]string) TsMsg {
+	if ctx == nil || !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return msg
+	}
+	trace.InjectContextToMsgProperties(ctx, properties)
+	base := getMsgBase(msg)
+	if base == nil {
+		return msg
+	}
+	traced := proto.Clone(base).(*commonpb.MsgBase)
+	traced.TraceCtx = make(map[string]string)
+	trace.InjectContextToMsgProperties(ctx, traced.TraceCtx)
+	return withMsgBase(msg, traced)
 }
 
-func allowTrace(in interface{}) bool {
-	if in == nil {
-		return false
+// ExtractFromMsgProperties extracts the trace context of msg from the properties of the mq message,
+// or from the MsgBase of msg if the mq drops the properties, and starts a span of receiving the msg.
+// And it will attach some default attributes to the span. The msgs sent out of any trace, e.g. the
+// time ticks, are not traced on the receiver either, the returned context is nil for them.
+func ExtractFromMsgProperties(msg TsMsg, properties map[string]string) (trace.Span, context.Context) {
+	ctx := trace.ExtractContextFromMsgProperties(context.Background(), properties)
+	if !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		if base := getMsgBase(msg); base != nil {
+			ctx = trace.ExtractContextFromMsgProperties(context.Background(), base.GetTraceCtx())
+		}
+	}
+	if !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return trace.NoopSpan(), nil
 	}
-	switch res := in.(type) {
-	case TsMsg:
-		return !(res.Type() == commonpb.MsgType_TimeTick ||
-			res.Type() == commonpb.MsgType_QueryNodeStats ||
-			res.Type() == commonpb.MsgType_LoadIndex)
-	default:
-		return false
+	return trace.StartSpanFromContextWithOperationNameWithSkip(ctx, "receive msg", 3,
+		oteltrace.WithSpanKind(oteltrace.SpanKindConsumer),
+		oteltrace.WithAttributes(msgAttributes(msg)...))
+}
+
+// MsgSpanFromCtx starts a span of sending msg from the span of the context, no span is started
+// if the context doesn't carry one. And it will attach some default attributes to the span.
+func MsgSpanFromCtx(ctx context.Context, msg TsMsg, opts ...oteltrace.SpanOption) (trace.Span, context.Context) {
+	if ctx == nil || !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return trace.NoopSpan(), ctx
 	}
+	opts = append(opts,
+		oteltrace.WithSpanKind(oteltrace.SpanKindProducer),
+		oteltrace.WithAttributes(msgAttributes(msg)...))
+	return trace.StartSpanFromContextWithOperationNameWithSkip(ctx, "send msg", 3, opts...)
 }
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/trace"
+)
+
+func TestMsgTrace_PropagateByMsgBase(t *testing.T) {
+	provider := sdktrace.NewTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer provider.Shutdown(context.Background())
+
+	msgTypes := []MsgType{
+		commonpb.MsgType_Insert,
+		commonpb.MsgType_Delete,
+		commonpb.MsgType_Search,
+		commonpb.MsgType_SearchResult,
+		commonpb.MsgType_TimeTick,
+		commonpb.MsgType_QueryNodeStats,
+	}
+	for _, msgType := range msgTypes {
+		msg := getTsMsg(msgType, 1)
+		sp, ctx := trace.StartSpanFromContextWithOperationName(context.Background(), "test")
+		traceID, _, found := trace.InfoFromSpan(sp)
+		assert.True(t, found)
+
+		sendSp, sendCtx := MsgSpanFromCtx(ctx, msg)
+		// the rocksmq drops the message properties, the context is carried by MsgBase
+		tracedMsg := InjectCtxToMsg(sendCtx, msg, map[string]string{})
+		bytes, err := tracedMsg.Marshal(tracedMsg)
+		assert.Nil(t, err)
+		// the msg may be shared by the senders, it is never modified
+		if base := getMsgBase(msg); base != nil {
+			assert.Empty(t, base.GetTraceCtx(), msgType.String())
+			assert.NotEmpty(t, getMsgBase(tracedMsg).GetTraceCtx(), msgType.String())
+			assert.Equal(t, base.GetMsgID(), getMsgBase(tracedMsg).GetMsgID(), msgType.String())
+		}
+		sendSp.End()
+		sp.End()
+
+		received, err := msg.Unmarshal(bytes)
+		assert.Nil(t, err)
+		recvSp, recvCtx := ExtractFromMsgProperties(received, nil)
+		assert.NotNil(t, recvCtx, msgType.String())
+		recvTraceID, _, found := trace.InfoFromSpan(recvSp)
+		assert.True(t, found)
+		assert.Equal(t, traceID, recvTraceID, msgType.String())
+		recvSp.End()
+	}
+
+	// the msgs out of any trace are not traced
+	msg := getTsMsg(commonpb.MsgType_TimeTick, 1)
+	sp, ctx := MsgSpanFromCtx(context.Background(), msg)
+	properties := map[string]string{}
+	tracedMsg := InjectCtxToMsg(ctx, msg, properties)
+	assert.Equal(t, 0, len(properties))
+	assert.Equal(t, msg, tracedMsg)
+	_, _, found := trace.InfoFromSpan(sp)
+	assert.False(t, found)
+	_, recvCtx := ExtractFromMsgProperties(msg, properties)
+	assert.Nil(t, recvCtx)
+}
@@ -211,6 +211,8 @@ const (
 	MsgType_CreateAlias        MsgType = 108
 	MsgType_DropAlias          MsgType = 109
 	MsgType_AlterAlias         MsgType = 110
+	MsgType_RenameCollection   MsgType = 111
+	MsgType_RecoverCollection  MsgType = 112
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -219,6 +221,7 @@ const (
 	MsgType_ShowPartitions    MsgType = 204
 	MsgType_LoadPartitions    MsgType = 205
 	MsgType_ReleasePartitions MsgType = 206
+	MsgType_RecoverPartition  MsgType = 207
 	// DEFINE REQUESTS: SEGMENT
 	MsgType_ShowSegments        MsgType = 250
 	MsgType_DescribeSegment     MsgType = 251
@@ -277,6 +280,8 @@ var MsgType_name = map[int32]string{
 	108:  "CreateAlias",
 	109:  "DropAlias",
 	110:  "AlterAlias",
+	111:  "RenameCollection",
+	112:  "RecoverCollection",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -284,6 +289,7 @@ var MsgType_name = map[int32]string{
 	204:  "ShowPartitions",
 	205:  "LoadPartitions",
 	206:  "ReleasePartitions",
+	207:  "RecoverPartition",
 	250:  "ShowSegments",
 	251:  "DescribeSegment",
 	252:  "LoadSegments",
@@ -336,6 +342,8 @@ var MsgType_value = map[string]int32{
 	"CreateAlias":              108,
 	"DropAlias":                109,
 	"AlterAlias":               110,
+	"RenameCollection":         111,
+	"RecoverCollection":        112,
 	"CreatePartition":          200,
 	"DropPartition":            201,
 	"HasPartition":             202,
@@ -343,6 +351,7 @@ var MsgType_value = map[string]int32{
 	"ShowPartitions":           204,
 	"LoadPartitions":           205,
 	"ReleasePartitions":        206,
+	"RecoverPartition":         207,
 	"ShowSegments":             250,
 	"DescribeSegment":          251,
 	"LoadSegments":             252,
@@ -671,13 +680,15 @@ func (m *Address) GetPort() int64 {
 }
 
 type MsgBase struct {
-	MsgType              MsgType  `protobuf:"varint,1,opt,name=msg_type,json=msgType,proto3,enum=milvus.proto.common.MsgType" json:"msg_type,omitempty"`
-	MsgID                int64    `protobuf:"varint,2,opt,name=msgID,proto3" json:"msgID,omitempty"`
-	Timestamp            uint64   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	SourceID             int64    `protobuf:"varint,4,opt,name=sourceID,proto3" json:"sourceID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MsgType   MsgType `protobuf:"varint,1,opt,name=msg_type,json=msgType,proto3,enum=milvus.proto.common.MsgType" json:"msg_type,omitempty"`
+	MsgID     int64   `protobuf:"varint,2,opt,name=msgID,proto3" json:"msgID,omitempty"`
+	Timestamp uint64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SourceID  int64   `protobuf:"varint,4,opt,name=sourceID,proto3" json:"sourceID,omitempty"`
+	// carries the trace context across the message queues which don't support message properties
+	TraceCtx             map[string]string `protobuf:"bytes,5,rep,name=trace_ctx,json=traceCtx,proto3" json:"trace_ctx,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *MsgBase) Reset()         { *m = MsgBase{} }
@@ -733,6 +744,13 @@ func (m *MsgBase) GetSourceID() int64 {
 	return 0
 }
 
+func (m *MsgBase) GetTraceCtx() map[string]string {
+	if m != nil {
+		return m.TraceCtx
+	}
+	return nil
+}
+
 // Don't Modify This. @czs
 type MsgHeader struct {
 	Base                 *MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -834,6 +852,7 @@ func init() {
 	proto.RegisterType((*Blob)(nil), "milvus.proto.common.Blob")
 	proto.RegisterType((*Address)(nil), "milvus.proto.common.Address")
 	proto.RegisterType((*MsgBase)(nil), "milvus.proto.common.MsgBase")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.common.MsgBase.TraceCtxEntry")
 	proto.RegisterType((*MsgHeader)(nil), "milvus.proto.common.MsgHeader")
 	proto.RegisterType((*DMLMsgHeader)(nil), "milvus.proto.common.DMLMsgHeader")
 }
@@ -841,95 +860,100 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x73, 0x1b, 0xc7,
-	0x11, 0xe6, 0x62, 0x41, 0x82, 0x68, 0x82, 0xe4, 0x70, 0xf8, 0x10, 0xa5, 0x30, 0x29, 0x15, 0x4e,
-	0x2a, 0x56, 0x89, 0x4c, 0xa2, 0x4a, 0x72, 0xd2, 0x81, 0xc4, 0xf2, 0x81, 0x92, 0xf8, 0xc8, 0x82,
-	0x52, 0x52, 0x39, 0x44, 0x35, 0xdc, 0x6d, 0x02, 0x13, 0xed, 0xee, 0x20, 0x3b, 0xb3, 0x14, 0x71,
-	0x4b, 0xfe, 0x81, 0xad, 0xdf, 0x61, 0xbb, 0xfc, 0xb6, 0xcb, 0xbf, 0xc0, 0xef, 0xb3, 0xfd, 0x0f,
-	0xfc, 0x03, 0xfc, 0xd4, 0xd3, 0xd5, 0xb3, 0x0b, 0x60, 0x55, 0x25, 0x9d, 0x7c, 0x9b, 0xfe, 0xa6,
-	0xfb, 0x9b, 0x9e, 0xaf, 0x7b, 0x7a, 0x17, 0x1a, 0x81, 0x8a, 0x63, 0x95, 0x6c, 0xf4, 0x53, 0x65,
-	0x14, 0x5f, 0x8c, 0x65, 0x74, 0x9e, 0xe9, 0xdc, 0xda, 0xc8, 0xb7, 0x9a, 0xf7, 0x60, 0xaa, 0x63,
-	0x84, 0xc9, 0x34, 0xbf, 0x09, 0x80, 0x69, 0xaa, 0xd2, 0x7b, 0x81, 0x0a, 0x71, 0xd5, 0xb9, 0xea,
-	0x5c, 0x9b, 0xfb, 0xf3, 0x1f, 0x36, 0x5e, 0x12, 0xb3, 0xb1, 0x43, 0x6e, 0x2d, 0x15, 0xa2, 0x5f,
-	0xc7, 0xe1, 0x92, 0xaf, 0xc0, 0x54, 0x8a, 0x42, 0xab, 0x64, 0xb5, 0x72, 0xd5, 0xb9, 0x56, 0xf7,
-	0x0b, 0xab, 0xf9, 0x57, 0x68, 0xdc, 0xc2, 0xc1, 0x5d, 0x11, 0x65, 0x78, 0x2c, 0x64, 0xca, 0x19,
-	0xb8, 0xf7, 0x71, 0x60, 0xf9, 0xeb, 0x3e, 0x2d, 0xf9, 0x12, 0x4c, 0x9e, 0xd3, 0x76, 0x11, 0x98,
-	0x1b, 0xcd, 0x1b, 0x30, 0x73, 0x0b, 0x07, 0x9e, 0x30, 0xe2, 0x15, 0x61, 0x1c, 0xaa, 0xa1, 0x30,
-	0xc2, 0x46, 0x35, 0x7c, 0xbb, 0x6e, 0xae, 0x41, 0x75, 0x3b, 0x52, 0xa7, 0x63, 0x4a, 0xc7, 0x6e,
-	0x16, 0x94, 0xd7, 0xa1, 0xb6, 0x15, 0x86, 0x29, 0x6a, 0xcd, 0xe7, 0xa0, 0x22, 0xfb, 0x05, 0x5b,
-	0x45, 0xf6, 0x89, 0xac, 0xaf, 0x52, 0x63, 0xc9, 0x5c, 0xdf, 0xae, 0x9b, 0x0f, 0x1d, 0xa8, 0x1d,
-	0xe8, 0xee, 0xb6, 0xd0, 0xc8, 0xff, 0x06, 0xd3, 0xb1, 0xee, 0xde, 0x33, 0x83, 0xfe, 0x50, 0x9a,
-	0xb5, 0x97, 0x4a, 0x73, 0xa0, 0xbb, 0x27, 0x83, 0x3e, 0xfa, 0xb5, 0x38, 0x5f, 0x50, 0x26, 0xb1,
-	0xee, 0xb6, 0xbd, 0x82, 0x39, 0x37, 0xf8, 0x1a, 0xd4, 0x8d, 0x8c, 0x51, 0x1b, 0x11, 0xf7, 0x57,
-	0xdd, 0xab, 0xce, 0xb5, 0xaa, 0x3f, 0x06, 0xf8, 0x15, 0x98, 0xd6, 0x2a, 0x4b, 0x03, 0x6c, 0x7b,
-	0xab, 0x55, 0x1b, 0x36, 0xb2, 0x9b, 0x37, 0xa1, 0x7e, 0xa0, 0xbb, 0xfb, 0x28, 0x42, 0x4c, 0xf9,
-	0x1f, 0xa1, 0x7a, 0x2a, 0x74, 0x9e, 0xd1, 0xcc, 0xab, 0x33, 0xa2, 0x1b, 0xf8, 0xd6, 0xb3, 0xf9,
-	0x6f, 0x68, 0x78, 0x07, 0xb7, 0x7f, 0x03, 0x03, 0xa5, 0xae, 0x7b, 0x22, 0x0d, 0x0f, 0x45, 0x3c,
-	0xac, 0xd8, 0x18, 0x58, 0xff, 0xa4, 0x0a, 0xf5, 0x51, 0x7b, 0xf0, 0x19, 0xa8, 0x75, 0xb2, 0x20,
-	0x40, 0xad, 0xd9, 0x04, 0x5f, 0x84, 0xf9, 0x3b, 0x09, 0x5e, 0xf4, 0x31, 0x30, 0x18, 0x5a, 0x1f,
-	0xe6, 0xf0, 0x05, 0x98, 0x6d, 0xa9, 0x24, 0xc1, 0xc0, 0xec, 0x0a, 0x19, 0x61, 0xc8, 0x2a, 0x7c,
-	0x09, 0xd8, 0x31, 0xa6, 0xb1, 0xd4, 0x5a, 0xaa, 0xc4, 0xc3, 0x44, 0x62, 0xc8, 0x5c, 0x7e, 0x09,
-	0x16, 0x5b, 0x2a, 0x8a, 0x30, 0x30, 0x52, 0x25, 0x87, 0xca, 0xec, 0x5c, 0x48, 0x6d, 0x34, 0xab,
-	0x12, 0x6d, 0x3b, 0x8a, 0xb0, 0x2b, 0xa2, 0xad, 0xb4, 0x9b, 0xc5, 0x98, 0x18, 0x36, 0x49, 0x1c,
-	0x05, 0xe8, 0xc9, 0x18, 0x13, 0x62, 0x62, 0xb5, 0x12, 0xda, 0x4e, 0x42, 0xbc, 0xa0, 0xfa, 0xb0,
-	0x69, 0x7e, 0x19, 0x96, 0x0b, 0xb4, 0x74, 0x80, 0x88, 0x91, 0xd5, 0xf9, 0x3c, 0xcc, 0x14, 0x5b,
-	0x27, 0x47, 0xc7, 0xb7, 0x18, 0x94, 0x18, 0x7c, 0xf5, 0xc0, 0xc7, 0x40, 0xa5, 0x21, 0x9b, 0x29,
-	0xa5, 0x70, 0x17, 0x03, 0xa3, 0xd2, 0xb6, 0xc7, 0x1a, 0x94, 0x70, 0x01, 0x76, 0x50, 0xa4, 0x41,
-	0xcf, 0x47, 0x9d, 0x45, 0x86, 0xcd, 0x72, 0x06, 0x8d, 0x5d, 0x19, 0xe1, 0xa1, 0x32, 0xbb, 0x2a,
-	0x4b, 0x42, 0x36, 0xc7, 0xe7, 0x00, 0x0e, 0xd0, 0x88, 0x42, 0x81, 0x79, 0x3a, 0xb6, 0x25, 0x82,
-	0x1e, 0x16, 0x00, 0xe3, 0x2b, 0xc0, 0x5b, 0x22, 0x49, 0x94, 0x69, 0xa5, 0x28, 0x0c, 0xee, 0xaa,
-	0x28, 0xc4, 0x94, 0x2d, 0x50, 0x3a, 0x2f, 0xe0, 0x32, 0x42, 0xc6, 0xc7, 0xde, 0x1e, 0x46, 0x38,
-	0xf2, 0x5e, 0x1c, 0x7b, 0x17, 0x38, 0x79, 0x2f, 0x51, 0xf2, 0xdb, 0x99, 0x8c, 0x42, 0x2b, 0x49,
-	0x5e, 0x96, 0x65, 0xca, 0xb1, 0x48, 0xfe, 0xf0, 0x76, 0xbb, 0x73, 0xc2, 0x56, 0xf8, 0x32, 0x2c,
-	0x14, 0xc8, 0x01, 0x9a, 0x54, 0x06, 0x56, 0xbc, 0x4b, 0x94, 0xea, 0x51, 0x66, 0x8e, 0xce, 0x0e,
-	0x30, 0x56, 0xe9, 0x80, 0xad, 0x52, 0x41, 0x2d, 0xd3, 0xb0, 0x44, 0xec, 0x32, 0x9d, 0xb0, 0x13,
-	0xf7, 0xcd, 0x60, 0x2c, 0x2f, 0xbb, 0xc2, 0x39, 0xcc, 0x7a, 0x9e, 0x8f, 0xff, 0xcd, 0x50, 0x1b,
-	0x5f, 0x04, 0xc8, 0xbe, 0xab, 0xad, 0xff, 0x13, 0xc0, 0xc6, 0xd2, 0x40, 0x42, 0xce, 0x61, 0x6e,
-	0x6c, 0x1d, 0xaa, 0x04, 0xd9, 0x04, 0x6f, 0xc0, 0xf4, 0x9d, 0x44, 0x6a, 0x9d, 0x61, 0xc8, 0x1c,
-	0xd2, 0xad, 0x9d, 0x1c, 0xa7, 0xaa, 0x4b, 0x4f, 0x9a, 0x55, 0x68, 0x77, 0x57, 0x26, 0x52, 0xf7,
-	0x6c, 0xc7, 0x00, 0x4c, 0x15, 0x02, 0x56, 0xd7, 0x35, 0x34, 0x3a, 0xd8, 0xa5, 0xe6, 0xc8, 0xb9,
-	0x97, 0x80, 0x95, 0xed, 0x31, 0xfb, 0x28, 0x6d, 0x87, 0x9a, 0x77, 0x2f, 0x55, 0x0f, 0x64, 0xd2,
-	0x65, 0x15, 0x22, 0xeb, 0xa0, 0x88, 0x2c, 0xf1, 0x0c, 0xd4, 0x76, 0xa3, 0xcc, 0x9e, 0x52, 0xb5,
-	0x67, 0x92, 0x41, 0x6e, 0x93, 0xb4, 0xe5, 0xa5, 0xaa, 0xdf, 0xc7, 0x90, 0x4d, 0xad, 0x3f, 0x9d,
-	0xb6, 0xf3, 0xc3, 0x8e, 0x81, 0x59, 0xa8, 0xdf, 0x49, 0x42, 0x3c, 0x93, 0x09, 0x86, 0x6c, 0xc2,
-	0x96, 0xc2, 0x96, 0xac, 0xa4, 0x49, 0x48, 0x37, 0xa6, 0xe8, 0x12, 0x86, 0xa4, 0xe7, 0xbe, 0xd0,
-	0x25, 0xe8, 0x8c, 0xea, 0xeb, 0xa1, 0x0e, 0x52, 0x79, 0x5a, 0x0e, 0xef, 0x92, 0xce, 0x9d, 0x9e,
-	0x7a, 0x30, 0xc6, 0x34, 0xeb, 0xd1, 0x49, 0x7b, 0x68, 0x3a, 0x03, 0x6d, 0x30, 0x6e, 0xa9, 0xe4,
-	0x4c, 0x76, 0x35, 0x93, 0x74, 0xd2, 0x6d, 0x25, 0xc2, 0x52, 0xf8, 0x7f, 0xa8, 0xc2, 0x3e, 0x46,
-	0x28, 0x74, 0x99, 0xf5, 0xbe, 0x6d, 0x46, 0x9b, 0xea, 0x56, 0x24, 0x85, 0x66, 0x11, 0x5d, 0x85,
-	0xb2, 0xcc, 0xcd, 0x98, 0x8a, 0xb0, 0x15, 0x19, 0x4c, 0x73, 0x3b, 0xe1, 0x4b, 0x30, 0x9f, 0xfb,
-	0x1f, 0x8b, 0xd4, 0x48, 0x4b, 0xf2, 0xa9, 0x63, 0xcb, 0x9d, 0xaa, 0xfe, 0x18, 0xfb, 0x8c, 0xde,
-	0x7e, 0x63, 0x5f, 0xe8, 0x31, 0xf4, 0xb9, 0xc3, 0x57, 0x60, 0x61, 0x78, 0xb5, 0x31, 0xfe, 0x85,
-	0xc3, 0x17, 0x61, 0x8e, 0xae, 0x36, 0xc2, 0x34, 0xfb, 0xd2, 0x82, 0x74, 0x89, 0x12, 0xf8, 0x95,
-	0x65, 0x28, 0x6e, 0x51, 0xc2, 0xbf, 0xb6, 0x87, 0x11, 0x43, 0x51, 0x75, 0xcd, 0x1e, 0x39, 0x94,
-	0xe9, 0xf0, 0xb0, 0x02, 0x66, 0x8f, 0xad, 0x23, 0xb1, 0x8e, 0x1c, 0x9f, 0x58, 0xc7, 0x82, 0x73,
-	0x84, 0x3e, 0xb5, 0xe8, 0xbe, 0x48, 0x42, 0x75, 0x76, 0x36, 0x42, 0x9f, 0x39, 0x7c, 0x15, 0x16,
-	0x29, 0x7c, 0x5b, 0x44, 0x22, 0x09, 0xc6, 0xfe, 0xcf, 0x1d, 0xce, 0x86, 0x42, 0xda, 0xae, 0x66,
-	0x6f, 0x54, 0xac, 0x28, 0x45, 0x02, 0x39, 0xf6, 0x66, 0x85, 0xcf, 0xe5, 0xea, 0xe6, 0xf6, 0x5b,
-	0x15, 0x3e, 0x03, 0x53, 0xed, 0x44, 0x63, 0x6a, 0xd8, 0x6b, 0xd4, 0x79, 0x53, 0xf9, 0xdb, 0x65,
-	0xaf, 0x53, 0x7f, 0x4f, 0xda, 0xce, 0x63, 0x0f, 0xed, 0x46, 0x3e, 0x65, 0xd8, 0xf7, 0xae, 0xbd,
-	0x6a, 0x79, 0xe4, 0xfc, 0xe0, 0xd2, 0x49, 0x7b, 0x68, 0xc6, 0xcf, 0x89, 0xfd, 0xe8, 0xf2, 0x2b,
-	0xb0, 0x3c, 0xc4, 0xec, 0x00, 0x18, 0x3d, 0xa4, 0x9f, 0x5c, 0xbe, 0x06, 0x97, 0xf6, 0xd0, 0x8c,
-	0xfb, 0x80, 0x82, 0xa4, 0x36, 0x32, 0xd0, 0xec, 0x67, 0x97, 0xff, 0x0e, 0x56, 0xf6, 0xd0, 0x8c,
-	0xf4, 0x2d, 0x6d, 0xfe, 0xe2, 0xf2, 0x59, 0x98, 0xf6, 0x69, 0x42, 0xe0, 0x39, 0xb2, 0x47, 0x2e,
-	0x15, 0x69, 0x68, 0x16, 0xe9, 0x3c, 0x76, 0x49, 0xba, 0x7f, 0x08, 0x13, 0xf4, 0xbc, 0xb8, 0xd5,
-	0x13, 0x49, 0x82, 0x91, 0x66, 0x4f, 0x5c, 0xbe, 0x0c, 0xcc, 0xc7, 0x58, 0x9d, 0x63, 0x09, 0x7e,
-	0x4a, 0x93, 0x9f, 0x5b, 0xe7, 0xbf, 0x67, 0x98, 0x0e, 0x46, 0x1b, 0xcf, 0x5c, 0x92, 0x3a, 0xf7,
-	0x7f, 0x71, 0xe7, 0xb9, 0xcb, 0x7f, 0x0f, 0xab, 0xf9, 0x6b, 0x1d, 0xea, 0x4f, 0x9b, 0x5d, 0x6c,
-	0x27, 0x67, 0x8a, 0xfd, 0xaf, 0x3a, 0x62, 0xf4, 0x30, 0x32, 0x62, 0x14, 0xf7, 0xff, 0x2a, 0x95,
-	0xa8, 0x88, 0xb0, 0xae, 0xdf, 0x54, 0xf9, 0x3c, 0x40, 0xfe, 0x76, 0x2c, 0xf0, 0x6d, 0x95, 0xae,
-	0x77, 0x22, 0x63, 0x3c, 0x91, 0xc1, 0x7d, 0xf6, 0x76, 0x9d, 0xae, 0x67, 0x4f, 0x3f, 0x54, 0x21,
-	0x92, 0x0e, 0x9a, 0xbd, 0x53, 0xa7, 0x1a, 0x52, 0x0f, 0xe4, 0x35, 0x7c, 0xd7, 0xda, 0xc5, 0xa4,
-	0x6b, 0x7b, 0xec, 0x3d, 0xfa, 0xac, 0x40, 0x61, 0x9f, 0x74, 0x8e, 0xd8, 0xfb, 0x75, 0xd2, 0x63,
-	0x2b, 0x8a, 0x54, 0x20, 0xcc, 0xa8, 0x13, 0x3f, 0xa8, 0x53, 0x2b, 0x97, 0x86, 0x54, 0xa1, 0xf0,
-	0x87, 0x75, 0xd2, 0xa9, 0xc0, 0x6d, 0xfd, 0x3d, 0x1a, 0x5e, 0x1f, 0x59, 0x56, 0xfa, 0x5b, 0xa2,
-	0x4c, 0x4e, 0x0c, 0xfb, 0xb8, 0xbe, 0xde, 0x84, 0x9a, 0xa7, 0x23, 0x3b, 0x7e, 0x6a, 0xe0, 0x7a,
-	0x3a, 0x62, 0x13, 0xf4, 0x5a, 0xb7, 0x95, 0x8a, 0x76, 0x2e, 0xfa, 0xe9, 0xdd, 0x3f, 0x31, 0x67,
-	0x7d, 0x1b, 0xe6, 0x5b, 0x2a, 0xee, 0x8b, 0x51, 0x95, 0xed, 0xc4, 0xc9, 0x47, 0x15, 0x86, 0x79,
-	0xaf, 0x4c, 0xd0, 0x93, 0xdf, 0xb9, 0xc0, 0x20, 0x33, 0x34, 0xe5, 0x1c, 0x32, 0x29, 0x88, 0x1a,
-	0x31, 0x64, 0x95, 0xed, 0xbf, 0xfc, 0xeb, 0x46, 0x57, 0x9a, 0x5e, 0x76, 0x4a, 0x3f, 0x0c, 0x9b,
-	0xf9, 0x1f, 0xc4, 0x75, 0xa9, 0x8a, 0xd5, 0xa6, 0x4c, 0x0c, 0xa6, 0x89, 0x88, 0x36, 0xed, 0x4f,
-	0xc5, 0x66, 0xfe, 0x53, 0xd1, 0x3f, 0x3d, 0x9d, 0xb2, 0xf6, 0x8d, 0x5f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x87, 0x06, 0x40, 0xd1, 0xa5, 0x0a, 0x00, 0x00,
+	// 1507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5b, 0x6f, 0x1c, 0x4b,
+	0x11, 0xf6, 0xec, 0xac, 0xbd, 0xde, 0xf2, 0xda, 0xee, 0xb4, 0x2f, 0xf1, 0x09, 0x06, 0x45, 0x7e,
+	0x8a, 0x2c, 0x1d, 0x07, 0x12, 0x71, 0x11, 0xe8, 0x3c, 0xd8, 0x3b, 0xb6, 0xb3, 0x4a, 0xec, 0x98,
+	0x59, 0x27, 0x20, 0x1e, 0xb0, 0xda, 0x33, 0xe5, 0xdd, 0x26, 0x33, 0xdd, 0x4b, 0x77, 0xaf, 0xe3,
+	0x7d, 0x83, 0x7f, 0x00, 0x3c, 0xf1, 0x23, 0x00, 0x71, 0x07, 0xf1, 0xc6, 0x1b, 0x77, 0x5e, 0xe1,
+	0x1f, 0xf0, 0x03, 0xb8, 0x9e, 0x2b, 0xaa, 0x9e, 0xd9, 0x9d, 0x89, 0x74, 0x82, 0x90, 0xce, 0x5b,
+	0xd7, 0xd7, 0x55, 0x5f, 0x57, 0x7f, 0x55, 0x5d, 0x33, 0xd0, 0x49, 0x74, 0x9e, 0x6b, 0xb5, 0x37,
+	0x32, 0xda, 0x69, 0xbe, 0x96, 0xcb, 0xec, 0x7a, 0x6c, 0x0b, 0x6b, 0xaf, 0xd8, 0xda, 0xb9, 0x80,
+	0x85, 0xbe, 0x13, 0x6e, 0x6c, 0xf9, 0x5b, 0x00, 0x68, 0x8c, 0x36, 0x17, 0x89, 0x4e, 0x71, 0x2b,
+	0xb8, 0x1b, 0xdc, 0x5b, 0x79, 0xf0, 0x89, 0xbd, 0x0f, 0x89, 0xd9, 0x3b, 0x24, 0xb7, 0xae, 0x4e,
+	0x31, 0x6e, 0xe3, 0x74, 0xc9, 0x37, 0x61, 0xc1, 0xa0, 0xb0, 0x5a, 0x6d, 0x35, 0xee, 0x06, 0xf7,
+	0xda, 0x71, 0x69, 0xed, 0x7c, 0x06, 0x3a, 0x8f, 0x71, 0xf2, 0x5c, 0x64, 0x63, 0x3c, 0x13, 0xd2,
+	0x70, 0x06, 0xe1, 0x0b, 0x9c, 0x78, 0xfe, 0x76, 0x4c, 0x4b, 0xbe, 0x0e, 0xf3, 0xd7, 0xb4, 0x5d,
+	0x06, 0x16, 0xc6, 0xce, 0x43, 0x58, 0x7a, 0x8c, 0x93, 0x48, 0x38, 0xf1, 0x9a, 0x30, 0x0e, 0xcd,
+	0x54, 0x38, 0xe1, 0xa3, 0x3a, 0xb1, 0x5f, 0xef, 0x6c, 0x43, 0xf3, 0x20, 0xd3, 0x97, 0x15, 0x65,
+	0xe0, 0x37, 0x4b, 0xca, 0x37, 0xa1, 0xb5, 0x9f, 0xa6, 0x06, 0xad, 0xe5, 0x2b, 0xd0, 0x90, 0xa3,
+	0x92, 0xad, 0x21, 0x47, 0x44, 0x36, 0xd2, 0xc6, 0x79, 0xb2, 0x30, 0xf6, 0xeb, 0x9d, 0xef, 0x36,
+	0xa0, 0x75, 0x62, 0x07, 0x07, 0xc2, 0x22, 0xff, 0x2c, 0x2c, 0xe6, 0x76, 0x70, 0xe1, 0x26, 0xa3,
+	0xa9, 0x34, 0xdb, 0x1f, 0x2a, 0xcd, 0x89, 0x1d, 0x9c, 0x4f, 0x46, 0x18, 0xb7, 0xf2, 0x62, 0x41,
+	0x99, 0xe4, 0x76, 0xd0, 0x8b, 0x4a, 0xe6, 0xc2, 0xe0, 0xdb, 0xd0, 0x76, 0x32, 0x47, 0xeb, 0x44,
+	0x3e, 0xda, 0x0a, 0xef, 0x06, 0xf7, 0x9a, 0x71, 0x05, 0xf0, 0x3b, 0xb0, 0x68, 0xf5, 0xd8, 0x24,
+	0xd8, 0x8b, 0xb6, 0x9a, 0x3e, 0x6c, 0x66, 0xf3, 0x63, 0x68, 0x3b, 0x23, 0x12, 0xbc, 0x48, 0xdc,
+	0xcd, 0xd6, 0xfc, 0xdd, 0xf0, 0xde, 0xd2, 0x83, 0xdd, 0xd7, 0x65, 0x42, 0x99, 0xef, 0x9d, 0x93,
+	0x77, 0xd7, 0xdd, 0x1c, 0x2a, 0x67, 0x26, 0xf1, 0xa2, 0x2b, 0xcd, 0x3b, 0x5f, 0x80, 0xe5, 0x57,
+	0xb6, 0xfe, 0xdf, 0xc2, 0x7c, 0xbe, 0xf1, 0xb9, 0x60, 0xe7, 0x2d, 0x68, 0x9f, 0xd8, 0xc1, 0x23,
+	0x14, 0x29, 0x1a, 0xfe, 0x49, 0x68, 0x5e, 0x0a, 0x5b, 0xe8, 0xb2, 0xf4, 0x60, 0xfb, 0x7f, 0x65,
+	0x13, 0x7b, 0xcf, 0x9d, 0xaf, 0x42, 0x27, 0x3a, 0x79, 0xf2, 0x11, 0x18, 0x48, 0x40, 0x3b, 0x14,
+	0x26, 0x3d, 0x15, 0xf9, 0x34, 0xbd, 0x0a, 0xd8, 0xfd, 0x55, 0x13, 0xda, 0xb3, 0x26, 0xe5, 0x4b,
+	0xd0, 0xea, 0x8f, 0x93, 0x04, 0xad, 0x65, 0x73, 0x7c, 0x0d, 0x56, 0x9f, 0x29, 0xbc, 0x19, 0x61,
+	0xe2, 0x30, 0xf5, 0x3e, 0x2c, 0xe0, 0xb7, 0x60, 0xb9, 0xab, 0x95, 0xc2, 0xc4, 0x1d, 0x09, 0x99,
+	0x61, 0xca, 0x1a, 0x7c, 0x1d, 0xd8, 0x19, 0x9a, 0x5c, 0x5a, 0x2b, 0xb5, 0x8a, 0x50, 0x49, 0x4c,
+	0x59, 0xc8, 0x6f, 0xc3, 0x5a, 0x57, 0x67, 0x19, 0x26, 0x4e, 0x6a, 0x75, 0xaa, 0xdd, 0xe1, 0x8d,
+	0xb4, 0xce, 0xb2, 0x26, 0xd1, 0xf6, 0xb2, 0x0c, 0x07, 0x22, 0xdb, 0x37, 0x83, 0x71, 0x8e, 0xca,
+	0xb1, 0x79, 0xe2, 0x28, 0xc1, 0x48, 0xe6, 0xa8, 0x88, 0x89, 0xb5, 0x6a, 0x68, 0x4f, 0xa5, 0x78,
+	0x43, 0x5d, 0xc2, 0x16, 0xf9, 0x1b, 0xb0, 0x51, 0xa2, 0xb5, 0x03, 0x44, 0x8e, 0xac, 0xcd, 0x57,
+	0x61, 0xa9, 0xdc, 0x3a, 0x7f, 0x7a, 0xf6, 0x98, 0x41, 0x8d, 0x21, 0xd6, 0x2f, 0x63, 0x4c, 0xb4,
+	0x49, 0xd9, 0x52, 0x2d, 0x85, 0xe7, 0x98, 0x38, 0x6d, 0x7a, 0x11, 0xeb, 0x50, 0xc2, 0x25, 0xd8,
+	0x47, 0x61, 0x92, 0x61, 0x8c, 0x76, 0x9c, 0x39, 0xb6, 0xcc, 0x19, 0x74, 0x8e, 0x64, 0x86, 0xa7,
+	0xda, 0x1d, 0xe9, 0xb1, 0x4a, 0xd9, 0x0a, 0x5f, 0x01, 0x38, 0x41, 0x27, 0x4a, 0x05, 0x56, 0xe9,
+	0xd8, 0xae, 0x48, 0x86, 0x58, 0x02, 0x8c, 0x6f, 0x02, 0xef, 0x0a, 0xa5, 0xb4, 0xeb, 0x1a, 0x14,
+	0x0e, 0x8f, 0x74, 0x96, 0xa2, 0x61, 0xb7, 0x28, 0x9d, 0x57, 0x70, 0x99, 0x21, 0xe3, 0x95, 0x77,
+	0x84, 0x19, 0xce, 0xbc, 0xd7, 0x2a, 0xef, 0x12, 0x27, 0xef, 0x75, 0x4a, 0xfe, 0x60, 0x2c, 0xb3,
+	0xd4, 0x4b, 0x52, 0x94, 0x65, 0x83, 0x72, 0x2c, 0x93, 0x3f, 0x7d, 0xd2, 0xeb, 0x9f, 0xb3, 0x4d,
+	0xbe, 0x01, 0xb7, 0x4a, 0xe4, 0x04, 0x9d, 0x91, 0x89, 0x17, 0xef, 0x36, 0xa5, 0xfa, 0x74, 0xec,
+	0x9e, 0x5e, 0x9d, 0x60, 0xae, 0xcd, 0x84, 0x6d, 0x51, 0x41, 0x3d, 0xd3, 0xb4, 0x44, 0xec, 0x0d,
+	0x3a, 0xe1, 0x30, 0x1f, 0xb9, 0x49, 0x25, 0x2f, 0xbb, 0xc3, 0x39, 0x2c, 0x47, 0x51, 0x8c, 0x5f,
+	0x1f, 0xa3, 0x75, 0xb1, 0x48, 0x90, 0xfd, 0xad, 0xb5, 0xfb, 0x65, 0x00, 0x1f, 0x4b, 0x63, 0x11,
+	0x39, 0x87, 0x95, 0xca, 0x3a, 0xd5, 0x0a, 0xd9, 0x1c, 0xef, 0xc0, 0xe2, 0x33, 0x25, 0xad, 0x1d,
+	0x63, 0xca, 0x02, 0xd2, 0xad, 0xa7, 0xce, 0x8c, 0x1e, 0xd0, 0x60, 0x61, 0x0d, 0xda, 0x3d, 0x92,
+	0x4a, 0xda, 0xa1, 0xef, 0x18, 0x80, 0x85, 0x52, 0xc0, 0xe6, 0xae, 0x85, 0x4e, 0x1f, 0x07, 0xd4,
+	0x1c, 0x05, 0xf7, 0x3a, 0xb0, 0xba, 0x5d, 0xb1, 0xcf, 0xd2, 0x0e, 0xa8, 0x79, 0x8f, 0x8d, 0x7e,
+	0x29, 0xd5, 0x80, 0x35, 0x88, 0xac, 0x8f, 0x22, 0xf3, 0xc4, 0x4b, 0xd0, 0x3a, 0xca, 0xc6, 0xfe,
+	0x94, 0xa6, 0x3f, 0x93, 0x0c, 0x72, 0x9b, 0xa7, 0xad, 0xc8, 0xe8, 0xd1, 0x08, 0x53, 0xb6, 0xb0,
+	0xfb, 0xeb, 0xb6, 0x9f, 0x62, 0x7e, 0x18, 0x2d, 0x43, 0xfb, 0x99, 0x4a, 0xf1, 0x4a, 0x2a, 0x4c,
+	0xd9, 0x9c, 0x2f, 0x85, 0x2f, 0x59, 0x4d, 0x93, 0x94, 0x6e, 0x4c, 0xd1, 0x35, 0x0c, 0x49, 0xcf,
+	0x47, 0xc2, 0xd6, 0xa0, 0x2b, 0xaa, 0x6f, 0x84, 0x36, 0x31, 0xf2, 0xb2, 0x1e, 0x3e, 0x20, 0x9d,
+	0xfb, 0x43, 0xfd, 0xb2, 0xc2, 0x2c, 0x1b, 0xd2, 0x49, 0xc7, 0xe8, 0xfa, 0x13, 0xeb, 0x30, 0xef,
+	0x6a, 0x75, 0x25, 0x07, 0x96, 0x49, 0x3a, 0xe9, 0x89, 0x16, 0x69, 0x2d, 0xfc, 0x6b, 0x54, 0xe1,
+	0x18, 0x33, 0x14, 0xb6, 0xce, 0xfa, 0xc2, 0x37, 0xa3, 0x4f, 0x75, 0x3f, 0x93, 0xc2, 0xb2, 0x8c,
+	0xae, 0x42, 0x59, 0x16, 0x66, 0x4e, 0x45, 0xd8, 0xcf, 0x1c, 0x9a, 0xc2, 0x56, 0x74, 0x60, 0x8c,
+	0x4a, 0xe4, 0x75, 0x16, 0x5d, 0x90, 0x27, 0xfa, 0x1a, 0x4d, 0x0d, 0x1e, 0xf1, 0x75, 0x58, 0x2d,
+	0xc8, 0xcf, 0x84, 0x71, 0xd2, 0x83, 0xbf, 0x09, 0x7c, 0x6f, 0x18, 0x3d, 0xaa, 0xb0, 0xdf, 0xd2,
+	0xa0, 0xe8, 0x3c, 0x12, 0xb6, 0x82, 0x7e, 0x17, 0xf0, 0x4d, 0xb8, 0x35, 0xd5, 0xa1, 0xc2, 0x7f,
+	0x1f, 0xf0, 0x35, 0x58, 0x21, 0x1d, 0x66, 0x98, 0x65, 0x7f, 0xf0, 0x20, 0xdd, 0xb8, 0x06, 0xfe,
+	0xd1, 0x33, 0x94, 0x57, 0xae, 0xe1, 0x7f, 0x0a, 0xf8, 0x06, 0xb0, 0x32, 0xdb, 0x8a, 0xf8, 0xcf,
+	0x3e, 0x07, 0x22, 0x2e, 0x3b, 0xc7, 0xb2, 0xb7, 0x03, 0xba, 0xc0, 0x34, 0x87, 0x12, 0x66, 0xef,
+	0x78, 0x47, 0x3a, 0x6c, 0xe6, 0xf8, 0xae, 0x77, 0x2c, 0x8f, 0x9a, 0xa1, 0xef, 0x79, 0xf4, 0x91,
+	0x50, 0xa9, 0xbe, 0xba, 0x9a, 0xa1, 0xef, 0x07, 0x7c, 0x0b, 0xd6, 0x28, 0xfc, 0x40, 0x64, 0x42,
+	0x25, 0x95, 0xff, 0x07, 0x01, 0x67, 0xd3, 0x62, 0xf8, 0x97, 0xc1, 0xbe, 0xd7, 0xf0, 0x5a, 0x95,
+	0x09, 0x14, 0xd8, 0xf7, 0x1b, 0x7c, 0xa5, 0xa8, 0x50, 0x61, 0xff, 0xa0, 0xc1, 0x97, 0x60, 0xa1,
+	0xa7, 0x2c, 0x1a, 0xc7, 0xbe, 0x45, 0xdd, 0xbb, 0x50, 0xbc, 0x7f, 0xf6, 0x6d, 0x7a, 0x23, 0xf3,
+	0xbe, 0x7b, 0xd9, 0x77, 0xfc, 0x46, 0x31, 0xa9, 0xd8, 0xdf, 0x43, 0x7f, 0xd5, 0xfa, 0xd8, 0xfa,
+	0x47, 0x48, 0x27, 0x1d, 0xa3, 0xab, 0x9e, 0x24, 0xfb, 0x67, 0xc8, 0xef, 0xc0, 0xc6, 0x14, 0xf3,
+	0x43, 0x64, 0xf6, 0x18, 0xff, 0x15, 0xf2, 0x6d, 0xb8, 0x7d, 0x8c, 0xae, 0x2a, 0x37, 0x05, 0x49,
+	0xeb, 0x64, 0x62, 0xd9, 0xbf, 0x43, 0xfe, 0x31, 0xd8, 0x3c, 0x46, 0x37, 0x93, 0xb7, 0xb6, 0xf9,
+	0x9f, 0x90, 0x2f, 0xc3, 0x62, 0x4c, 0x53, 0x06, 0xaf, 0x91, 0xbd, 0x1d, 0x52, 0xed, 0xa6, 0x66,
+	0x99, 0xce, 0x3b, 0x21, 0x49, 0xf7, 0x25, 0xe1, 0x92, 0x61, 0x94, 0x77, 0x87, 0x42, 0x29, 0xcc,
+	0x2c, 0x7b, 0x37, 0x2c, 0x2a, 0x97, 0xeb, 0x6b, 0xac, 0xc1, 0xef, 0xd1, 0xd7, 0x83, 0x7b, 0xe7,
+	0x2f, 0x8e, 0xd1, 0x4c, 0x66, 0x1b, 0xef, 0x87, 0x24, 0x75, 0xe1, 0xff, 0xea, 0xce, 0x07, 0x21,
+	0xff, 0x38, 0x6c, 0x15, 0x2f, 0x7e, 0xaa, 0x3f, 0x6d, 0x0e, 0xb0, 0xa7, 0xae, 0x34, 0xfb, 0x46,
+	0x73, 0xc6, 0x18, 0x61, 0xe6, 0xc4, 0x2c, 0xee, 0x9b, 0x4d, 0x2a, 0x51, 0x19, 0xe1, 0x5d, 0xff,
+	0xd2, 0xe4, 0xab, 0x00, 0xc5, 0xfb, 0xf3, 0xc0, 0x5f, 0x9b, 0x74, 0xbd, 0x73, 0x99, 0xe3, 0xb9,
+	0x4c, 0x5e, 0xb0, 0x1f, 0xb6, 0xe9, 0x7a, 0xfe, 0xf4, 0x53, 0x9d, 0x22, 0xe9, 0x60, 0xd9, 0x8f,
+	0xda, 0x54, 0x43, 0xea, 0x81, 0xa2, 0x86, 0x3f, 0xf6, 0x76, 0x39, 0x2d, 0x7b, 0x11, 0xfb, 0x09,
+	0x7d, 0x9a, 0xa0, 0xb4, 0xcf, 0xfb, 0x4f, 0xd9, 0x4f, 0xdb, 0xa4, 0xc7, 0x7e, 0x96, 0xe9, 0x44,
+	0xb8, 0x59, 0x27, 0xfe, 0xac, 0x4d, 0x1d, 0x5e, 0x1b, 0x74, 0xa5, 0xc2, 0x3f, 0x6f, 0x93, 0x4e,
+	0x25, 0xee, 0xeb, 0x1f, 0xd1, 0x00, 0xfc, 0x85, 0x67, 0xa5, 0xff, 0x3e, 0xca, 0xe4, 0xdc, 0xb1,
+	0x5f, 0xb6, 0x77, 0x77, 0xa0, 0x15, 0xd9, 0xcc, 0x8f, 0xb0, 0x16, 0x84, 0x91, 0xcd, 0xd8, 0x1c,
+	0xbd, 0xf8, 0x03, 0xad, 0xb3, 0xc3, 0x9b, 0x91, 0x79, 0xfe, 0x29, 0x16, 0xec, 0x1e, 0xc0, 0x6a,
+	0x57, 0xe7, 0x23, 0x31, 0xab, 0xb2, 0x9f, 0x5a, 0xc5, 0xb8, 0xc3, 0xd4, 0x03, 0x6c, 0x8e, 0xc6,
+	0xc6, 0xe1, 0x0d, 0x26, 0x63, 0x47, 0x93, 0x32, 0x20, 0x93, 0x82, 0xa8, 0x11, 0x53, 0xd6, 0x38,
+	0xf8, 0xf4, 0x57, 0x1e, 0x0e, 0xa4, 0x1b, 0x8e, 0x2f, 0xe9, 0xa7, 0xe3, 0x7e, 0xf1, 0x17, 0xf2,
+	0xa6, 0xd4, 0xe5, 0xea, 0xbe, 0x54, 0x0e, 0x8d, 0x12, 0xd9, 0x7d, 0xff, 0x63, 0x72, 0xbf, 0xf8,
+	0x31, 0x19, 0x5d, 0x5e, 0x2e, 0x78, 0xfb, 0xe1, 0x7f, 0x07, 0x00, 0x74, 0x31, 0xf8, 0xbc, 0x6f,
+	0x0b, 0x00, 0x00,
 }
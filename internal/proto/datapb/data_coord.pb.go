@@ -56,21 +56,24 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 type CompactionType int32
 
 const (
-	CompactionType_UndefinedCompaction CompactionType = 0
-	CompactionType_InnerCompaction     CompactionType = 1
-	CompactionType_MergeCompaction     CompactionType = 2
+	CompactionType_UndefinedCompaction  CompactionType = 0
+	CompactionType_InnerCompaction      CompactionType = 1
+	CompactionType_MergeCompaction      CompactionType = 2
+	CompactionType_ClusteringCompaction CompactionType = 3
 )
 
 var CompactionType_name = map[int32]string{
 	0: "UndefinedCompaction",
 	1: "InnerCompaction",
 	2: "MergeCompaction",
+	3: "ClusteringCompaction",
 }
 
 var CompactionType_value = map[string]int32{
-	"UndefinedCompaction": 0,
-	"InnerCompaction":     1,
-	"MergeCompaction":     2,
+	"UndefinedCompaction":  0,
+	"InnerCompaction":      1,
+	"MergeCompaction":      2,
+	"ClusteringCompaction": 3,
 }
 
 func (x CompactionType) String() string {
@@ -1374,15 +1377,18 @@ type SegmentInfo struct {
 	StartPosition  *internalpb.MsgPosition `protobuf:"bytes,9,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	DmlPosition    *internalpb.MsgPosition `protobuf:"bytes,10,opt,name=dml_position,json=dmlPosition,proto3" json:"dml_position,omitempty"`
 	// binlogs consist of insert binlogs
-	Binlogs              []*FieldBinlog  `protobuf:"bytes,11,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
-	Statslogs            []*FieldBinlog  `protobuf:"bytes,12,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
-	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,13,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	CreatedByCompaction  bool            `protobuf:"varint,14,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
-	CompactionFrom       []int64         `protobuf:"varint,15,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
-	DroppedAt            uint64          `protobuf:"varint,16,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Binlogs              []*FieldBinlog      `protobuf:"bytes,11,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
+	Statslogs            []*FieldBinlog      `protobuf:"bytes,12,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs            []*DeltaLogInfo     `protobuf:"bytes,13,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CreatedByCompaction  bool                `protobuf:"varint,14,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
+	CompactionFrom       []int64             `protobuf:"varint,15,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	DroppedAt            uint64              `protobuf:"varint,16,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+	ClusteringKeyRange   *ClusteringKeyRange `protobuf:"bytes,17,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	DroppedWithChannel   bool                `protobuf:"varint,18,opt,name=dropped_with_channel,json=droppedWithChannel,proto3" json:"dropped_with_channel,omitempty"`
+	CompactionOutputs    []int64             `protobuf:"varint,19,rep,packed,name=compaction_outputs,json=compactionOutputs,proto3" json:"compaction_outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
@@ -1522,6 +1528,27 @@ func (m *SegmentInfo) GetDroppedAt() uint64 {
 	return 0
 }
 
+func (m *SegmentInfo) GetClusteringKeyRange() *ClusteringKeyRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetDroppedWithChannel() bool {
+	if m != nil {
+		return m.DroppedWithChannel
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetCompactionOutputs() []int64 {
+	if m != nil {
+		return m.CompactionOutputs
+	}
+	return nil
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1972,14 +1999,15 @@ func (m *DataNodeInfo) GetChannels() []*ChannelStatus {
 }
 
 type SegmentBinlogs struct {
-	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	FieldBinlogs         []*FieldBinlog  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
-	NumOfRows            int64           `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	Statslogs            []*FieldBinlog  `protobuf:"bytes,4,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
-	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	SegmentID            int64               `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldBinlogs         []*FieldBinlog      `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	NumOfRows            int64               `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs            []*FieldBinlog      `protobuf:"bytes,4,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs            []*DeltaLogInfo     `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	ClusteringKeyRange   *ClusteringKeyRange `protobuf:"bytes,6,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
@@ -2042,6 +2070,13 @@ func (m *SegmentBinlogs) GetDeltalogs() []*DeltaLogInfo {
 	return nil
 }
 
+func (m *SegmentBinlogs) GetClusteringKeyRange() *ClusteringKeyRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []string `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -2403,6 +2438,79 @@ func (m *ChannelWatchInfo) GetState() ChannelWatchState {
 	return ChannelWatchState_Uncomplete
 }
 
+// ClusteringKeyRange is the inclusive range of the clustering key values in a segment,
+// integer keys use the int bounds while float and double keys use the float bounds
+type ClusteringKeyRange struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	MinInt               int64    `protobuf:"varint,2,opt,name=min_int,json=minInt,proto3" json:"min_int,omitempty"`
+	MaxInt               int64    `protobuf:"varint,3,opt,name=max_int,json=maxInt,proto3" json:"max_int,omitempty"`
+	MinFloat             float64  `protobuf:"fixed64,4,opt,name=min_float,json=minFloat,proto3" json:"min_float,omitempty"`
+	MaxFloat             float64  `protobuf:"fixed64,5,opt,name=max_float,json=maxFloat,proto3" json:"max_float,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusteringKeyRange) Reset()         { *m = ClusteringKeyRange{} }
+func (m *ClusteringKeyRange) String() string { return proto.CompactTextString(m) }
+func (*ClusteringKeyRange) ProtoMessage()    {}
+func (*ClusteringKeyRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{39}
+}
+
+func (m *ClusteringKeyRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusteringKeyRange.Unmarshal(m, b)
+}
+func (m *ClusteringKeyRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusteringKeyRange.Marshal(b, m, deterministic)
+}
+func (m *ClusteringKeyRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusteringKeyRange.Merge(m, src)
+}
+func (m *ClusteringKeyRange) XXX_Size() int {
+	return xxx_messageInfo_ClusteringKeyRange.Size(m)
+}
+func (m *ClusteringKeyRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusteringKeyRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusteringKeyRange proto.InternalMessageInfo
+
+func (m *ClusteringKeyRange) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *ClusteringKeyRange) GetMinInt() int64 {
+	if m != nil {
+		return m.MinInt
+	}
+	return 0
+}
+
+func (m *ClusteringKeyRange) GetMaxInt() int64 {
+	if m != nil {
+		return m.MaxInt
+	}
+	return 0
+}
+
+func (m *ClusteringKeyRange) GetMinFloat() float64 {
+	if m != nil {
+		return m.MinFloat
+	}
+	return 0
+}
+
+func (m *ClusteringKeyRange) GetMaxFloat() float64 {
+	if m != nil {
+		return m.MaxFloat
+	}
+	return 0
+}
+
 type CompactionSegmentBinlogs struct {
 	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
@@ -2417,7 +2525,7 @@ func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlo
 func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*CompactionSegmentBinlogs) ProtoMessage()    {}
 func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2474,6 +2582,8 @@ type CompactionPlan struct {
 	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
 	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
 	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	ClusteringFieldID    int64                       `protobuf:"varint,8,opt,name=clustering_fieldID,json=clusteringFieldID,proto3" json:"clustering_fieldID,omitempty"`
+	MaxSegmentRows       int64                       `protobuf:"varint,9,opt,name=max_segment_rows,json=maxSegmentRows,proto3" json:"max_segment_rows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -2483,7 +2593,7 @@ func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
 func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
 func (*CompactionPlan) ProtoMessage()    {}
 func (*CompactionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
@@ -2553,23 +2663,120 @@ func (m *CompactionPlan) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionPlan) GetClusteringFieldID() int64 {
+	if m != nil {
+		return m.ClusteringFieldID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetMaxSegmentRows() int64 {
+	if m != nil {
+		return m.MaxSegmentRows
+	}
+	return 0
+}
+
+type CompactionSegment struct {
+	SegmentID            int64               `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64               `protobuf:"varint,2,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog      `protobuf:"bytes,3,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog      `protobuf:"bytes,4,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*DeltaLogInfo     `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	ClusteringKeyRange   *ClusteringKeyRange `protobuf:"bytes,6,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CompactionSegment) Reset()         { *m = CompactionSegment{} }
+func (m *CompactionSegment) String() string { return proto.CompactTextString(m) }
+func (*CompactionSegment) ProtoMessage()    {}
+func (*CompactionSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{42}
+}
+
+func (m *CompactionSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionSegment.Unmarshal(m, b)
+}
+func (m *CompactionSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionSegment.Marshal(b, m, deterministic)
+}
+func (m *CompactionSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionSegment.Merge(m, src)
+}
+func (m *CompactionSegment) XXX_Size() int {
+	return xxx_messageInfo_CompactionSegment.Size(m)
+}
+func (m *CompactionSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionSegment proto.InternalMessageInfo
+
+func (m *CompactionSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *CompactionSegment) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *CompactionSegment) GetInsertLogs() []*FieldBinlog {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetClusteringKeyRange() *ClusteringKeyRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 type CompactionResult struct {
-	PlanID               int64           `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64           `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64           `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog  `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog  `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	PlanID               int64                `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID            int64                `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64                `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog       `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog       `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*DeltaLogInfo      `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Segments             []*CompactionSegment `protobuf:"bytes,7,rep,name=segments,proto3" json:"segments,omitempty"`
+	BytesRead            int64                `protobuf:"varint,8,opt,name=bytes_read,json=bytesRead,proto3" json:"bytes_read,omitempty"`
+	BytesWritten         int64                `protobuf:"varint,9,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	TimeCostMs           int64                `protobuf:"varint,10,opt,name=time_cost_ms,json=timeCostMs,proto3" json:"time_cost_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
 func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionResult) ProtoMessage()    {}
 func (*CompactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{43}
 }
 
 func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
@@ -2632,6 +2839,90 @@ func (m *CompactionResult) GetDeltalogs() []*DeltaLogInfo {
 	return nil
 }
 
+func (m *CompactionResult) GetSegments() []*CompactionSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *CompactionResult) GetBytesRead() int64 {
+	if m != nil {
+		return m.BytesRead
+	}
+	return 0
+}
+
+func (m *CompactionResult) GetBytesWritten() int64 {
+	if m != nil {
+		return m.BytesWritten
+	}
+	return 0
+}
+
+func (m *CompactionResult) GetTimeCostMs() int64 {
+	if m != nil {
+		return m.TimeCostMs
+	}
+	return 0
+}
+
+// CompactionPolicyInfo is the compaction policy chosen by a collection
+type CompactionPolicyInfo struct {
+	CollectionID         int64                    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Policy               string                   `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CompactionPolicyInfo) Reset()         { *m = CompactionPolicyInfo{} }
+func (m *CompactionPolicyInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactionPolicyInfo) ProtoMessage()    {}
+func (*CompactionPolicyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{44}
+}
+
+func (m *CompactionPolicyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionPolicyInfo.Unmarshal(m, b)
+}
+func (m *CompactionPolicyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionPolicyInfo.Marshal(b, m, deterministic)
+}
+func (m *CompactionPolicyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionPolicyInfo.Merge(m, src)
+}
+func (m *CompactionPolicyInfo) XXX_Size() int {
+	return xxx_messageInfo_CompactionPolicyInfo.Size(m)
+}
+func (m *CompactionPolicyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionPolicyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionPolicyInfo proto.InternalMessageInfo
+
+func (m *CompactionPolicyInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CompactionPolicyInfo) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *CompactionPolicyInfo) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // Deprecated
 type SegmentFieldBinlogMeta struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -2645,7 +2936,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{45}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -2692,7 +2983,7 @@ func (m *WatchChannelsRequest) Reset()         { *m = WatchChannelsRequest{} }
 func (m *WatchChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsRequest) ProtoMessage()    {}
 func (*WatchChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{43}
+	return fileDescriptor_82cd95f524594f49, []int{46}
 }
 
 func (m *WatchChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -2738,7 +3029,7 @@ func (m *WatchChannelsResponse) Reset()         { *m = WatchChannelsResponse{} }
 func (m *WatchChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsResponse) ProtoMessage()    {}
 func (*WatchChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{44}
+	return fileDescriptor_82cd95f524594f49, []int{47}
 }
 
 func (m *WatchChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -2779,7 +3070,7 @@ func (m *DropVirtualChannelRequest) Reset()         { *m = DropVirtualChannelReq
 func (m *DropVirtualChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelRequest) ProtoMessage()    {}
 func (*DropVirtualChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{45}
+	return fileDescriptor_82cd95f524594f49, []int{48}
 }
 
 func (m *DropVirtualChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -2839,7 +3130,7 @@ func (m *DropVirtualChannelSegment) Reset()         { *m = DropVirtualChannelSeg
 func (m *DropVirtualChannelSegment) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelSegment) ProtoMessage()    {}
 func (*DropVirtualChannelSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{46}
+	return fileDescriptor_82cd95f524594f49, []int{49}
 }
 
 func (m *DropVirtualChannelSegment) XXX_Unmarshal(b []byte) error {
@@ -2927,7 +3218,7 @@ func (m *DropVirtualChannelResponse) Reset()         { *m = DropVirtualChannelRe
 func (m *DropVirtualChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelResponse) ProtoMessage()    {}
 func (*DropVirtualChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{47}
+	return fileDescriptor_82cd95f524594f49, []int{50}
 }
 
 func (m *DropVirtualChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -2955,6 +3246,53 @@ func (m *DropVirtualChannelResponse) GetStatus() *commonpb.Status {
 	return nil
 }
 
+type RecoverDroppedSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RecoverDroppedSegmentsRequest) Reset()         { *m = RecoverDroppedSegmentsRequest{} }
+func (m *RecoverDroppedSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*RecoverDroppedSegmentsRequest) ProtoMessage()    {}
+func (*RecoverDroppedSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{51}
+}
+
+func (m *RecoverDroppedSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoverDroppedSegmentsRequest.Unmarshal(m, b)
+}
+func (m *RecoverDroppedSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoverDroppedSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *RecoverDroppedSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoverDroppedSegmentsRequest.Merge(m, src)
+}
+func (m *RecoverDroppedSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_RecoverDroppedSegmentsRequest.Size(m)
+}
+func (m *RecoverDroppedSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoverDroppedSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoverDroppedSegmentsRequest proto.InternalMessageInfo
+
+func (m *RecoverDroppedSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RecoverDroppedSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
 	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
@@ -2997,194 +3335,223 @@ func init() {
 	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
 	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
 	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*ClusteringKeyRange)(nil), "milvus.proto.data.ClusteringKeyRange")
 	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
 	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionSegment)(nil), "milvus.proto.data.CompactionSegment")
 	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*CompactionPolicyInfo)(nil), "milvus.proto.data.CompactionPolicyInfo")
 	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
 	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
 	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
 	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
 	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
 	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
+	proto.RegisterType((*RecoverDroppedSegmentsRequest)(nil), "milvus.proto.data.RecoverDroppedSegmentsRequest")
 }
 
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2758 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdf, 0x6f, 0x1b, 0xc7,
-	0xf1, 0xf7, 0xf1, 0x87, 0x44, 0x0e, 0x29, 0x8a, 0x5e, 0x39, 0x32, 0xc3, 0x38, 0xb2, 0x7c, 0x49,
-	0x1c, 0xc5, 0x71, 0x24, 0x5b, 0xfe, 0x06, 0xdf, 0xa0, 0x4e, 0x1a, 0x58, 0x96, 0x2d, 0x13, 0x95,
-	0x5c, 0xe5, 0xa8, 0xd8, 0x45, 0x03, 0x94, 0x38, 0xf1, 0x56, 0xd4, 0xd5, 0xbc, 0x3b, 0xfa, 0x6e,
-	0x29, 0x5b, 0x79, 0x89, 0x91, 0x02, 0x05, 0x5a, 0xb4, 0x4d, 0x8a, 0xbe, 0x16, 0x68, 0xd1, 0xa7,
-	0x02, 0x7d, 0x29, 0xfa, 0x98, 0xfe, 0x03, 0x45, 0xfb, 0xde, 0xbf, 0xa1, 0x6f, 0xfd, 0x17, 0x8a,
-	0xfd, 0x71, 0x7b, 0x3f, 0x78, 0x47, 0x1e, 0x25, 0xff, 0x78, 0xe3, 0xee, 0xcd, 0xce, 0xcc, 0xce,
-	0xce, 0x7c, 0x66, 0x66, 0x97, 0x50, 0x37, 0x74, 0xa2, 0x77, 0xba, 0x8e, 0xe3, 0x1a, 0xab, 0x03,
-	0xd7, 0x21, 0x0e, 0x3a, 0x6b, 0x99, 0xfd, 0xa3, 0xa1, 0xc7, 0x47, 0xab, 0xf4, 0x73, 0xb3, 0xda,
-	0x75, 0x2c, 0xcb, 0xb1, 0xf9, 0x54, 0xb3, 0x66, 0xda, 0x04, 0xbb, 0xb6, 0xde, 0x17, 0xe3, 0x6a,
-	0x78, 0x41, 0xb3, 0xea, 0x75, 0x0f, 0xb1, 0xa5, 0xf3, 0x91, 0xfa, 0x14, 0xaa, 0x77, 0xfb, 0x43,
-	0xef, 0x50, 0xc3, 0x8f, 0x87, 0xd8, 0x23, 0xe8, 0x1a, 0x14, 0xf6, 0x75, 0x0f, 0x37, 0x94, 0x65,
-	0x65, 0xa5, 0xb2, 0x7e, 0x61, 0x35, 0x22, 0x4b, 0x48, 0xd9, 0xf1, 0x7a, 0x1b, 0xba, 0x87, 0x35,
-	0x46, 0x89, 0x10, 0x14, 0x8c, 0xfd, 0xd6, 0x66, 0x23, 0xb7, 0xac, 0xac, 0xe4, 0x35, 0xf6, 0x1b,
-	0xa9, 0x50, 0xed, 0x3a, 0xfd, 0x3e, 0xee, 0x12, 0xd3, 0xb1, 0x5b, 0x9b, 0x8d, 0x02, 0xfb, 0x16,
-	0x99, 0x53, 0x7f, 0xaf, 0xc0, 0x9c, 0x10, 0xed, 0x0d, 0x1c, 0xdb, 0xc3, 0xe8, 0x06, 0xcc, 0x78,
-	0x44, 0x27, 0x43, 0x4f, 0x48, 0x7f, 0x23, 0x51, 0x7a, 0x9b, 0x91, 0x68, 0x82, 0x34, 0x93, 0xf8,
-	0xfc, 0xa8, 0x78, 0xb4, 0x04, 0xe0, 0xe1, 0x9e, 0x85, 0x6d, 0xd2, 0xda, 0xf4, 0x1a, 0x85, 0xe5,
-	0xfc, 0x4a, 0x5e, 0x0b, 0xcd, 0xa8, 0xbf, 0x55, 0xa0, 0xde, 0xf6, 0x87, 0xbe, 0x75, 0xce, 0x41,
-	0xb1, 0xeb, 0x0c, 0x6d, 0xc2, 0x14, 0x9c, 0xd3, 0xf8, 0x00, 0x5d, 0x82, 0x6a, 0xf7, 0x50, 0xb7,
-	0x6d, 0xdc, 0xef, 0xd8, 0xba, 0x85, 0x99, 0x2a, 0x65, 0xad, 0x22, 0xe6, 0xee, 0xeb, 0x16, 0xce,
-	0xa4, 0xd1, 0x32, 0x54, 0x06, 0xba, 0x4b, 0xcc, 0x88, 0xcd, 0xc2, 0x53, 0xea, 0x1f, 0x15, 0x58,
-	0xbc, 0xe5, 0x79, 0x66, 0xcf, 0x1e, 0xd1, 0x6c, 0x11, 0x66, 0x6c, 0xc7, 0xc0, 0xad, 0x4d, 0xa6,
-	0x5a, 0x5e, 0x13, 0x23, 0xf4, 0x06, 0x94, 0x07, 0x18, 0xbb, 0x1d, 0xd7, 0xe9, 0xfb, 0x8a, 0x95,
-	0xe8, 0x84, 0xe6, 0xf4, 0x31, 0xfa, 0x0c, 0xce, 0x7a, 0x31, 0x46, 0x5e, 0x23, 0xbf, 0x9c, 0x5f,
-	0xa9, 0xac, 0xbf, 0xb5, 0x3a, 0xe2, 0x65, 0xab, 0x71, 0xa1, 0xda, 0xe8, 0x6a, 0xf5, 0x59, 0x0e,
-	0x16, 0x24, 0x1d, 0xd7, 0x95, 0xfe, 0xa6, 0x96, 0xf3, 0x70, 0x4f, 0xaa, 0xc7, 0x07, 0x59, 0x2c,
-	0x27, 0x4d, 0x9e, 0x0f, 0x9b, 0x3c, 0x83, 0x83, 0xc5, 0xed, 0x59, 0x1c, 0xb1, 0x27, 0xba, 0x08,
-	0x15, 0xfc, 0x74, 0x60, 0xba, 0xb8, 0x43, 0x4c, 0x0b, 0x37, 0x66, 0x96, 0x95, 0x95, 0x82, 0x06,
-	0x7c, 0x6a, 0xcf, 0xb4, 0xc2, 0x1e, 0x39, 0x9b, 0xd9, 0x23, 0xd5, 0x3f, 0x29, 0x70, 0x7e, 0xe4,
-	0x94, 0x84, 0x8b, 0x6b, 0x50, 0x67, 0x3b, 0x0f, 0x2c, 0x43, 0x9d, 0x9d, 0x1a, 0xfc, 0xf2, 0x38,
-	0x83, 0x07, 0xe4, 0xda, 0xc8, 0xfa, 0x90, 0x92, 0xb9, 0xec, 0x4a, 0x3e, 0x82, 0xf3, 0x5b, 0x98,
-	0x08, 0x01, 0xf4, 0x1b, 0xf6, 0x4e, 0x0e, 0x01, 0xd1, 0x58, 0xca, 0x8d, 0xc4, 0xd2, 0x5f, 0x73,
-	0x32, 0x96, 0x98, 0xa8, 0x96, 0x7d, 0xe0, 0xa0, 0x0b, 0x50, 0x96, 0x24, 0xc2, 0x2b, 0x82, 0x09,
-	0xf4, 0xff, 0x50, 0xa4, 0x9a, 0x72, 0x97, 0xa8, 0xad, 0x5f, 0x4a, 0xde, 0x53, 0x88, 0xa7, 0xc6,
-	0xe9, 0x51, 0x0b, 0x6a, 0x1e, 0xd1, 0x5d, 0xd2, 0x19, 0x38, 0x1e, 0x3b, 0x67, 0xe6, 0x38, 0x95,
-	0x75, 0x35, 0xca, 0x41, 0x42, 0xe4, 0x8e, 0xd7, 0xdb, 0x15, 0x94, 0xda, 0x1c, 0x5b, 0xe9, 0x0f,
-	0xd1, 0x1d, 0xa8, 0x62, 0xdb, 0x08, 0x18, 0x15, 0x32, 0x33, 0xaa, 0x60, 0xdb, 0x90, 0x6c, 0x82,
-	0xf3, 0x29, 0x66, 0x3f, 0x9f, 0x5f, 0x29, 0xd0, 0x18, 0x3d, 0xa0, 0xd3, 0x00, 0xe5, 0x4d, 0xbe,
-	0x08, 0xf3, 0x03, 0x1a, 0x1b, 0xe1, 0xf2, 0x90, 0x34, 0xb1, 0x44, 0x35, 0xe1, 0xb5, 0x40, 0x1b,
-	0xf6, 0xe5, 0x85, 0x39, 0xcb, 0xcf, 0x14, 0x58, 0x8c, 0xcb, 0x3a, 0xcd, 0xbe, 0xff, 0x0f, 0x8a,
-	0xa6, 0x7d, 0xe0, 0xf8, 0xdb, 0x5e, 0x1a, 0x13, 0x67, 0x54, 0x16, 0x27, 0x56, 0x2d, 0x78, 0x63,
-	0x0b, 0x93, 0x96, 0xed, 0x61, 0x97, 0x6c, 0x98, 0x76, 0xdf, 0xe9, 0xed, 0xea, 0xe4, 0xf0, 0x14,
-	0x31, 0x12, 0x71, 0xf7, 0x5c, 0xcc, 0xdd, 0xd5, 0x3f, 0x2b, 0x70, 0x21, 0x59, 0x9e, 0xd8, 0x7a,
-	0x13, 0x4a, 0x07, 0x26, 0xee, 0x1b, 0xd4, 0x66, 0x0a, 0xb3, 0x99, 0x1c, 0xd3, 0x58, 0x19, 0x50,
-	0x62, 0xb1, 0xc3, 0x4b, 0x29, 0x0e, 0xda, 0x26, 0xae, 0x69, 0xf7, 0xb6, 0x4d, 0x8f, 0x68, 0x9c,
-	0x3e, 0x64, 0xcf, 0x7c, 0x76, 0xcf, 0xfc, 0xa5, 0x02, 0x4b, 0x5b, 0x98, 0xdc, 0x96, 0x50, 0x4b,
-	0xbf, 0x9b, 0x1e, 0x31, 0xbb, 0xde, 0x8b, 0x2d, 0x22, 0x12, 0x72, 0xa6, 0xfa, 0x8d, 0x02, 0x17,
-	0x53, 0x95, 0x11, 0xa6, 0x13, 0x50, 0xe2, 0x03, 0x6d, 0x32, 0x94, 0xfc, 0x00, 0x1f, 0x3f, 0xd0,
-	0xfb, 0x43, 0xbc, 0xab, 0x9b, 0x2e, 0x87, 0x92, 0x13, 0x02, 0xeb, 0x5f, 0x14, 0x78, 0x73, 0x0b,
-	0x93, 0x5d, 0x3f, 0xcd, 0xbc, 0x42, 0xeb, 0x64, 0xa8, 0x28, 0x7e, 0xc3, 0x0f, 0x33, 0x51, 0xdb,
-	0x57, 0x62, 0xbe, 0x25, 0x16, 0x07, 0xa1, 0x80, 0xbc, 0xcd, 0x6b, 0x01, 0x61, 0x3c, 0xf5, 0x59,
-	0x1e, 0xaa, 0x0f, 0x44, 0x7d, 0xc0, 0xd2, 0x48, 0xdc, 0x0e, 0x4a, 0xb2, 0x1d, 0x42, 0x25, 0x45,
-	0x52, 0x95, 0xb1, 0x05, 0x73, 0x1e, 0xc6, 0x8f, 0x4e, 0x92, 0x34, 0xaa, 0x74, 0xa1, 0x04, 0xfb,
-	0x6d, 0x38, 0x3b, 0xb4, 0x0f, 0x68, 0x59, 0x8b, 0x0d, 0xb1, 0x0b, 0x5e, 0x5d, 0x4e, 0x46, 0x9e,
-	0xd1, 0x85, 0xe8, 0x1e, 0xcc, 0xc7, 0x79, 0x15, 0x33, 0xf1, 0x8a, 0x2f, 0x43, 0x2d, 0xa8, 0x1b,
-	0xae, 0x33, 0x18, 0x60, 0xa3, 0xe3, 0xf9, 0xac, 0x66, 0xb2, 0xb1, 0x12, 0xeb, 0x7c, 0x56, 0xea,
-	0x2f, 0x14, 0x58, 0x7c, 0xa8, 0x93, 0xee, 0xe1, 0xa6, 0x25, 0x0e, 0xe7, 0x14, 0xae, 0xfd, 0x09,
-	0x94, 0x8f, 0xc4, 0x41, 0xf8, 0xf8, 0x75, 0x31, 0x41, 0xa1, 0xf0, 0x91, 0x6b, 0xc1, 0x0a, 0xf5,
-	0x1f, 0x0a, 0x9c, 0x63, 0x4d, 0x84, 0xaf, 0xdd, 0xcb, 0x0f, 0xb2, 0x09, 0x8d, 0x04, 0xba, 0x0c,
-	0x35, 0x4b, 0x77, 0x1f, 0xb5, 0x03, 0x9a, 0x22, 0xa3, 0x89, 0xcd, 0xaa, 0x4f, 0x01, 0xc4, 0x68,
-	0xc7, 0xeb, 0x9d, 0x40, 0xff, 0x8f, 0x60, 0x56, 0x48, 0x15, 0xf1, 0x36, 0xe9, 0x60, 0x7d, 0x72,
-	0xf5, 0x9f, 0x0a, 0xd4, 0x02, 0x04, 0x65, 0x51, 0x55, 0x83, 0x9c, 0x8c, 0xa5, 0x5c, 0x6b, 0x13,
-	0x7d, 0x02, 0x33, 0xbc, 0x6d, 0x14, 0xbc, 0xdf, 0x89, 0xf2, 0x16, 0x2d, 0x65, 0x08, 0x86, 0xd9,
-	0x84, 0x26, 0x16, 0x51, 0x1b, 0x49, 0xd4, 0xe1, 0x1d, 0x46, 0x5e, 0x0b, 0xcd, 0xa0, 0x16, 0xcc,
-	0x47, 0x8b, 0x36, 0x3f, 0x66, 0x96, 0xd3, 0xd0, 0x66, 0x53, 0x27, 0x3a, 0x03, 0x9b, 0x5a, 0xa4,
-	0x66, 0xf3, 0xd4, 0xff, 0x16, 0xa1, 0x12, 0xda, 0xe5, 0xc8, 0x4e, 0xe2, 0x47, 0x9a, 0x9b, 0x8c,
-	0x9b, 0xf9, 0xd1, 0xce, 0xe1, 0x1d, 0xa8, 0x99, 0x2c, 0x57, 0x77, 0x84, 0x2b, 0x32, 0x70, 0x2d,
-	0x6b, 0x73, 0x7c, 0x56, 0xc4, 0x05, 0x5a, 0x82, 0x8a, 0x3d, 0xb4, 0x3a, 0xce, 0x41, 0xc7, 0x75,
-	0x9e, 0x78, 0xa2, 0x05, 0x29, 0xdb, 0x43, 0xeb, 0x87, 0x07, 0x9a, 0xf3, 0xc4, 0x0b, 0xaa, 0xdc,
-	0x99, 0x29, 0xab, 0xdc, 0x25, 0xa8, 0x58, 0xfa, 0x53, 0xca, 0xb5, 0x63, 0x0f, 0x2d, 0xd6, 0x9d,
-	0xe4, 0xb5, 0xb2, 0xa5, 0x3f, 0xd5, 0x9c, 0x27, 0xf7, 0x87, 0x16, 0x5a, 0x81, 0x7a, 0x5f, 0xf7,
-	0x48, 0x27, 0xdc, 0xde, 0x94, 0x58, 0x7b, 0x53, 0xa3, 0xf3, 0x77, 0x82, 0x16, 0x67, 0xb4, 0x5e,
-	0x2e, 0x9f, 0xa2, 0x5e, 0x36, 0xac, 0x7e, 0xc0, 0x08, 0xb2, 0xd7, 0xcb, 0x86, 0xd5, 0x97, 0x6c,
-	0x3e, 0x82, 0xd9, 0x7d, 0x56, 0x01, 0x79, 0x8d, 0x4a, 0x2a, 0x42, 0xdd, 0xa5, 0xc5, 0x0f, 0x2f,
-	0x94, 0x34, 0x9f, 0x1c, 0x7d, 0x0c, 0x65, 0x96, 0x7a, 0xd8, 0xda, 0x6a, 0xa6, 0xb5, 0xc1, 0x02,
-	0x0a, 0x45, 0x06, 0xee, 0x13, 0x9d, 0xad, 0x9e, 0x4b, 0x85, 0xa2, 0x4d, 0x4a, 0xb3, 0xed, 0xf4,
-	0x38, 0x14, 0xc9, 0x15, 0xe8, 0x1a, 0x2c, 0x74, 0x5d, 0xac, 0x13, 0x6c, 0x6c, 0x1c, 0xdf, 0x76,
-	0xac, 0x81, 0xce, 0xbc, 0xa9, 0x51, 0x5b, 0x56, 0x56, 0x4a, 0x5a, 0xd2, 0x27, 0x8a, 0x0c, 0x5d,
-	0x39, 0xba, 0xeb, 0x3a, 0x56, 0x63, 0x9e, 0x23, 0x43, 0x74, 0x16, 0xbd, 0x09, 0xe0, 0x63, 0xb7,
-	0x4e, 0x1a, 0x75, 0x76, 0x8c, 0x65, 0x31, 0x73, 0x8b, 0xa8, 0x5f, 0xc1, 0xb9, 0xc0, 0x45, 0x42,
-	0xc7, 0x31, 0x7a, 0xb2, 0xca, 0x49, 0x4f, 0x76, 0x7c, 0xf1, 0xfa, 0xb7, 0x02, 0x2c, 0xb6, 0xf5,
-	0x23, 0xfc, 0xe2, 0xeb, 0xe4, 0x4c, 0x80, 0xbc, 0x0d, 0x67, 0x59, 0x69, 0xbc, 0x1e, 0xd2, 0x67,
-	0x4c, 0x0a, 0x0e, 0x7b, 0xc3, 0xe8, 0x42, 0xf4, 0x29, 0xad, 0x1d, 0x70, 0xf7, 0xd1, 0xae, 0x63,
-	0x06, 0xe9, 0xf7, 0xcd, 0x04, 0x3e, 0xb7, 0x25, 0x95, 0x16, 0x5e, 0x81, 0x76, 0x47, 0xb1, 0x8d,
-	0x27, 0xde, 0x77, 0xc7, 0x36, 0x60, 0x81, 0xf5, 0xe3, 0x10, 0x87, 0x1a, 0x30, 0x2b, 0xd2, 0x3b,
-	0x0b, 0xfc, 0x92, 0xe6, 0x0f, 0xd1, 0x2e, 0x2c, 0xf0, 0x1d, 0xb4, 0x85, 0x57, 0xf3, 0xcd, 0x97,
-	0x32, 0x6d, 0x3e, 0x69, 0x69, 0x34, 0x28, 0xca, 0x53, 0x07, 0x45, 0x03, 0x66, 0x85, 0xa3, 0x32,
-	0x34, 0x28, 0x69, 0xfe, 0x90, 0xb6, 0x11, 0x10, 0x98, 0x6c, 0xc2, 0x6d, 0xc0, 0xf7, 0xa1, 0x24,
-	0x9d, 0x38, 0x97, 0xd9, 0x89, 0xe5, 0x9a, 0x38, 0x0e, 0xe7, 0x63, 0x38, 0xac, 0xfe, 0x4b, 0x81,
-	0x6a, 0x78, 0x0b, 0x14, 0xdf, 0x5d, 0xdc, 0x75, 0x5c, 0xa3, 0x83, 0x6d, 0xe2, 0x9a, 0x98, 0x77,
-	0x9c, 0x05, 0x6d, 0x8e, 0xcf, 0xde, 0xe1, 0x93, 0x94, 0x8c, 0x42, 0xab, 0x47, 0x74, 0x6b, 0xd0,
-	0x39, 0xa0, 0x11, 0x9c, 0xe3, 0x64, 0x72, 0x96, 0x05, 0xf0, 0x25, 0xa8, 0x06, 0x64, 0xc4, 0x61,
-	0xf2, 0x0b, 0x5a, 0x45, 0xce, 0xed, 0x39, 0xe8, 0x6d, 0xa8, 0x31, 0xab, 0x75, 0xfa, 0x4e, 0xaf,
-	0x43, 0xbb, 0x33, 0x91, 0x50, 0xaa, 0x86, 0x50, 0x8b, 0x1e, 0x47, 0x94, 0xca, 0x33, 0xbf, 0xc4,
-	0x22, 0xa5, 0x48, 0xaa, 0xb6, 0xf9, 0x25, 0x56, 0xbf, 0x56, 0x60, 0x8e, 0xe6, 0xc7, 0xfb, 0x8e,
-	0x81, 0xf7, 0x4e, 0x58, 0x4d, 0x64, 0xb8, 0x99, 0xbb, 0x00, 0x65, 0xb9, 0x03, 0xb1, 0xa5, 0x60,
-	0x82, 0xb6, 0xf1, 0x73, 0x22, 0x0d, 0xb6, 0xe5, 0x4d, 0x2d, 0x63, 0xa5, 0x30, 0x56, 0xec, 0x37,
-	0xfa, 0x5e, 0xf4, 0x9a, 0xe7, 0xed, 0xc4, 0xb8, 0x62, 0x4c, 0x58, 0xc5, 0x19, 0xc9, 0x81, 0x59,
-	0xfa, 0xc3, 0x67, 0xf4, 0x60, 0x85, 0x29, 0xd8, 0xc1, 0x36, 0x60, 0x56, 0x37, 0x0c, 0x17, 0x7b,
-	0x9e, 0xd0, 0xc3, 0x1f, 0xd2, 0x2f, 0x47, 0xd8, 0xf5, 0x7c, 0x17, 0xcb, 0x6b, 0xfe, 0x10, 0x7d,
-	0x0c, 0x25, 0x59, 0xa2, 0xe6, 0x93, 0xca, 0x92, 0xb0, 0x9e, 0xa2, 0x9f, 0x91, 0x2b, 0xd4, 0x6f,
-	0x72, 0x50, 0x13, 0x61, 0xbd, 0x21, 0xf2, 0xd4, 0x78, 0x67, 0xdf, 0x80, 0xea, 0x41, 0x10, 0x96,
-	0xe3, 0xee, 0x2d, 0xc2, 0xd1, 0x1b, 0x59, 0x33, 0xc9, 0xe1, 0xa3, 0x99, 0xb2, 0x70, 0xaa, 0x4c,
-	0x59, 0x9c, 0x16, 0x14, 0xd4, 0x5b, 0x50, 0x09, 0x31, 0x66, 0x70, 0xc6, 0xaf, 0x32, 0x84, 0x2d,
-	0xfc, 0x21, 0xfd, 0xb2, 0x1f, 0x32, 0x42, 0x59, 0x66, 0x7a, 0x5a, 0xf7, 0x9f, 0xdf, 0xc2, 0x44,
-	0xc3, 0x5d, 0xe7, 0x08, 0xbb, 0xc7, 0xa7, 0xbf, 0x25, 0xba, 0x19, 0x3a, 0xe3, 0x8c, 0x6d, 0x88,
-	0x5c, 0x80, 0x6e, 0x06, 0x7a, 0xe6, 0x93, 0x9a, 0xe4, 0x30, 0xb4, 0x8b, 0x13, 0x0a, 0xb6, 0xf2,
-	0x2d, 0xbf, 0xef, 0x8a, 0x6e, 0xe5, 0xa4, 0xd9, 0xf3, 0xb9, 0x54, 0xb7, 0xea, 0xef, 0x14, 0x78,
-	0x7d, 0x0b, 0x93, 0xbb, 0xd1, 0x1e, 0xf2, 0x55, 0x6b, 0x65, 0x41, 0x33, 0x49, 0xa9, 0xd3, 0x9c,
-	0x7a, 0x13, 0x4a, 0xb2, 0x1b, 0xe6, 0x37, 0x91, 0x72, 0xac, 0xfe, 0x5c, 0x81, 0x86, 0x90, 0xc2,
-	0x64, 0xd2, 0xc2, 0xad, 0x8f, 0x09, 0x36, 0x5e, 0x76, 0x7b, 0xf6, 0x07, 0x05, 0xea, 0x61, 0x10,
-	0x64, 0x38, 0xf6, 0x21, 0x14, 0x59, 0x17, 0x2c, 0x34, 0x98, 0xe8, 0xac, 0x9c, 0x9a, 0x46, 0x14,
-	0x2b, 0x26, 0xf6, 0x3c, 0x1f, 0xe4, 0xc4, 0x30, 0x40, 0xe2, 0xfc, 0xd4, 0x48, 0xac, 0xfe, 0x3a,
-	0x07, 0x8d, 0xa0, 0xae, 0x7d, 0xe9, 0x60, 0x97, 0x52, 0xf5, 0xe4, 0x9f, 0x53, 0xd5, 0x53, 0x98,
-	0x1a, 0xe0, 0xfe, 0x9e, 0xa3, 0x0d, 0xb5, 0x6f, 0x8f, 0xdd, 0xbe, 0x6e, 0xa3, 0x45, 0x98, 0x19,
-	0xf4, 0xf5, 0xe0, 0x82, 0x4a, 0x8c, 0x50, 0x1b, 0x6a, 0x5e, 0xc4, 0x5e, 0xc2, 0x02, 0xef, 0x27,
-	0xd9, 0x3f, 0xc5, 0xc4, 0x5a, 0x8c, 0x05, 0x6d, 0x18, 0x78, 0xc9, 0xc9, 0xfa, 0x3e, 0x91, 0x9a,
-	0xf9, 0x41, 0xd3, 0x96, 0xef, 0x2a, 0x20, 0xfa, 0xc1, 0x19, 0x92, 0x8e, 0x69, 0x77, 0x3c, 0xdc,
-	0x75, 0x6c, 0xc3, 0x63, 0xf5, 0x46, 0x51, 0xab, 0x8b, 0x2f, 0x2d, 0xbb, 0xcd, 0xe7, 0xd1, 0x87,
-	0x50, 0x20, 0xc7, 0x03, 0x5e, 0x69, 0xd4, 0x12, 0x91, 0x2d, 0xd0, 0x6b, 0xef, 0x78, 0x80, 0x35,
-	0x46, 0x4e, 0x5b, 0x7e, 0xca, 0x8a, 0xb8, 0xfa, 0x11, 0xee, 0xfb, 0x4f, 0x6b, 0xc1, 0x0c, 0xf5,
-	0x44, 0xbf, 0x75, 0x9e, 0xe5, 0x89, 0x58, 0x0c, 0xd5, 0xef, 0x72, 0x50, 0x0f, 0x58, 0x6a, 0xd8,
-	0x1b, 0xf6, 0x49, 0xaa, 0xfd, 0xc6, 0xb7, 0x0b, 0x93, 0xd2, 0xe0, 0xa7, 0x50, 0x11, 0x6d, 0xfc,
-	0x14, 0x89, 0x10, 0xf8, 0x92, 0xed, 0x31, 0xae, 0x57, 0x7c, 0x4e, 0xae, 0x37, 0x33, 0xb5, 0xeb,
-	0xb5, 0x61, 0xd1, 0x07, 0xad, 0x40, 0xd2, 0x0e, 0x26, 0xfa, 0x98, 0x34, 0x7b, 0x11, 0x2a, 0x3c,
-	0x19, 0xf1, 0xc2, 0x93, 0x97, 0x7a, 0xb0, 0x2f, 0x9b, 0x20, 0xf5, 0x27, 0x70, 0x8e, 0x05, 0x7d,
-	0xfc, 0xba, 0x2f, 0xcb, 0xdd, 0xab, 0x2a, 0x0b, 0x49, 0x5a, 0x34, 0xfa, 0x89, 0x3c, 0x32, 0xa7,
-	0x6e, 0xc3, 0x6b, 0x31, 0xfe, 0xa7, 0x00, 0x75, 0xf5, 0x3b, 0x05, 0x5e, 0xdf, 0x74, 0x9d, 0xc1,
-	0x03, 0xd3, 0x25, 0x43, 0xbd, 0x1f, 0xbd, 0x40, 0x7e, 0x31, 0xa5, 0xf0, 0xbd, 0x50, 0x1e, 0xe1,
-	0xb0, 0x73, 0x35, 0xe9, 0xcc, 0x46, 0x94, 0x12, 0x47, 0x15, 0xca, 0x3a, 0xff, 0xc9, 0x27, 0x29,
-	0x2f, 0xe8, 0x26, 0x60, 0x69, 0x96, 0x34, 0x9b, 0xd8, 0x1c, 0xe7, 0x4f, 0xda, 0x1c, 0xa7, 0xb8,
-	0x7f, 0xe1, 0x39, 0xb9, 0xff, 0xd4, 0xa5, 0x25, 0xba, 0x07, 0xd1, 0x9b, 0x0b, 0x06, 0x3c, 0x27,
-	0xba, 0xf2, 0xd8, 0x00, 0x08, 0xba, 0x78, 0xf1, 0xfc, 0x9f, 0x85, 0x4d, 0x68, 0x15, 0x3d, 0x2e,
-	0x89, 0x35, 0xec, 0xfa, 0x2d, 0xd2, 0x74, 0x7e, 0x06, 0xcd, 0x24, 0x37, 0x3d, 0x85, 0xeb, 0x5f,
-	0xb9, 0x0e, 0x67, 0x47, 0x92, 0x34, 0xaa, 0x01, 0x7c, 0x6e, 0x77, 0x45, 0xf5, 0x52, 0x3f, 0x83,
-	0xaa, 0x50, 0xf2, 0x6b, 0x99, 0xba, 0x72, 0xa5, 0x1d, 0x4e, 0x55, 0x14, 0xbf, 0xd1, 0x79, 0x58,
-	0xf8, 0xdc, 0x36, 0xf0, 0x81, 0x69, 0x63, 0x23, 0xf8, 0x54, 0x3f, 0x83, 0x16, 0x60, 0xbe, 0x65,
-	0xdb, 0xd8, 0x0d, 0x4d, 0x2a, 0x74, 0x72, 0x07, 0xbb, 0x3d, 0x1c, 0x9a, 0xcc, 0xad, 0x7f, 0xbb,
-	0x00, 0x65, 0xda, 0x76, 0xdd, 0x76, 0x1c, 0xd7, 0x40, 0x03, 0x40, 0xec, 0x8d, 0xce, 0x1a, 0x38,
-	0xb6, 0x7c, 0xcc, 0x46, 0xd7, 0x52, 0x8c, 0x39, 0x4a, 0x2a, 0x42, 0xb7, 0x79, 0x39, 0x65, 0x45,
-	0x8c, 0x5c, 0x3d, 0x83, 0x2c, 0x26, 0x91, 0x26, 0xbb, 0x3d, 0xb3, 0xfb, 0xc8, 0xbf, 0x8d, 0x1d,
-	0x23, 0x31, 0x46, 0xea, 0x4b, 0x8c, 0xbd, 0x91, 0x8b, 0x01, 0x7f, 0x48, 0xf5, 0x4f, 0x4a, 0x3d,
-	0x83, 0x1e, 0xc3, 0xb9, 0x2d, 0x4c, 0x82, 0xb7, 0x33, 0x5f, 0xe0, 0x7a, 0xba, 0xc0, 0x11, 0xe2,
-	0x29, 0x45, 0x6e, 0x43, 0x91, 0x55, 0xa5, 0x28, 0x29, 0x3a, 0xc2, 0xff, 0xe8, 0x6a, 0x2e, 0xa7,
-	0x13, 0x48, 0x6e, 0x3f, 0x85, 0xf9, 0xd8, 0x3f, 0x56, 0xd0, 0x7b, 0x09, 0xcb, 0x92, 0xff, 0x7b,
-	0xd4, 0xbc, 0x92, 0x85, 0x54, 0xca, 0xea, 0x41, 0x2d, 0xfa, 0xc2, 0x87, 0x56, 0x12, 0xd6, 0x27,
-	0xfe, 0xdb, 0xa0, 0xf9, 0x5e, 0x06, 0x4a, 0x29, 0xc8, 0x82, 0x7a, 0xfc, 0x1f, 0x14, 0xe8, 0xca,
-	0x58, 0x06, 0x51, 0x77, 0x7b, 0x3f, 0x13, 0xad, 0x14, 0x77, 0xcc, 0x9c, 0x60, 0xe4, 0x05, 0x1f,
-	0xad, 0x26, 0xb3, 0x49, 0xfb, 0x6b, 0x41, 0x73, 0x2d, 0x33, 0xbd, 0x14, 0xfd, 0x35, 0xef, 0x86,
-	0x93, 0x5e, 0xc1, 0xd1, 0xf5, 0x64, 0x76, 0x63, 0x9e, 0xef, 0x9b, 0xeb, 0xd3, 0x2c, 0x91, 0x4a,
-	0x7c, 0xc5, 0xda, 0xd8, 0x84, 0x97, 0xe4, 0x78, 0xdc, 0xf9, 0xfc, 0xd2, 0x9f, 0xc8, 0x9b, 0xd7,
-	0xa7, 0x58, 0x21, 0x15, 0x70, 0xe2, 0xff, 0x51, 0xf1, 0xc3, 0x70, 0x6d, 0xa2, 0xd7, 0x9c, 0x2c,
-	0x06, 0xbf, 0x80, 0xf9, 0xd8, 0xb5, 0x77, 0x62, 0xd4, 0x24, 0x5f, 0x8d, 0x37, 0xc7, 0x01, 0x3a,
-	0x0f, 0xc9, 0xd8, 0xad, 0x00, 0x4a, 0xf1, 0xfe, 0x84, 0x9b, 0x83, 0xe6, 0x95, 0x2c, 0xa4, 0x72,
-	0x23, 0x1e, 0x83, 0xcb, 0x58, 0x67, 0x8d, 0xae, 0x26, 0xf3, 0x48, 0xbe, 0x15, 0x68, 0x7e, 0x90,
-	0x91, 0x5a, 0x0a, 0xed, 0x00, 0x6c, 0x61, 0xb2, 0x83, 0x89, 0x4b, 0x7d, 0xe4, 0x72, 0xa2, 0xc9,
-	0x03, 0x02, 0x5f, 0xcc, 0xbb, 0x13, 0xe9, 0xa4, 0x80, 0x1f, 0x01, 0xf2, 0xf3, 0x5c, 0xe8, 0xd1,
-	0xe5, 0xad, 0xb1, 0x0d, 0x0c, 0xef, 0x36, 0x26, 0x9d, 0xcd, 0x63, 0xa8, 0xef, 0xe8, 0x36, 0x4d,
-	0xda, 0x01, 0xdf, 0xab, 0x89, 0x8a, 0xc5, 0xc9, 0x52, 0xac, 0x95, 0x4a, 0x2d, 0x37, 0xf3, 0x44,
-	0xe6, 0x50, 0x5d, 0x86, 0x20, 0x8e, 0x63, 0x4b, 0x60, 0x8d, 0x18, 0x61, 0x0a, 0xb6, 0x8c, 0xa1,
-	0x97, 0x82, 0x9f, 0x29, 0xec, 0x9f, 0x50, 0x31, 0x82, 0x87, 0x26, 0x39, 0xa4, 0x7d, 0xad, 0x97,
-	0x45, 0x05, 0x46, 0x38, 0x85, 0x0a, 0x82, 0x5e, 0xaa, 0x60, 0xc0, 0x5c, 0xa4, 0x3d, 0x40, 0x49,
-	0x2f, 0x27, 0x49, 0x0d, 0x4a, 0x73, 0x65, 0x32, 0xa1, 0x94, 0x72, 0x08, 0x73, 0xbe, 0xbf, 0x72,
-	0xe3, 0xbe, 0x97, 0xa6, 0x69, 0x40, 0x93, 0x12, 0x6e, 0xc9, 0xa4, 0xe1, 0x70, 0x1b, 0x2d, 0xfc,
-	0x50, 0xb6, 0x8e, 0x61, 0x5c, 0xb8, 0xa5, 0x57, 0x93, 0xea, 0x99, 0xf5, 0x7f, 0x17, 0xa0, 0xe4,
-	0xdf, 0x84, 0xbf, 0x82, 0x8a, 0xec, 0x15, 0x94, 0x48, 0x5f, 0xc0, 0x7c, 0xec, 0x6f, 0x2a, 0x89,
-	0x08, 0x9a, 0xfc, 0x57, 0x96, 0x49, 0x10, 0xf0, 0x50, 0xfc, 0x79, 0x5d, 0xa2, 0xe5, 0xbb, 0x69,
-	0x65, 0x56, 0x1c, 0x28, 0x27, 0x30, 0x7e, 0xe1, 0xb0, 0x78, 0x1f, 0x20, 0x04, 0x5b, 0xe3, 0xef,
-	0x73, 0x68, 0x24, 0x4e, 0x50, 0x78, 0xe3, 0xc6, 0x8f, 0xaf, 0xf7, 0x4c, 0x72, 0x38, 0xdc, 0xa7,
-	0x5f, 0xd6, 0x38, 0xe9, 0x07, 0xa6, 0x23, 0x7e, 0xad, 0xf9, 0x27, 0xba, 0xc6, 0x56, 0xaf, 0x51,
-	0x01, 0x83, 0xfd, 0xfd, 0x19, 0x36, 0xba, 0xf1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x4d,
-	0xf6, 0xb0, 0xde, 0x30, 0x00, 0x00,
+	// 3157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x5d, 0x6f, 0x1b, 0xc7,
+	0xb5, 0x5e, 0x2e, 0x25, 0x91, 0x87, 0x14, 0x45, 0x8d, 0x15, 0x99, 0xa1, 0xbf, 0xe4, 0x4d, 0xe2,
+	0x28, 0x8e, 0x23, 0xdb, 0xca, 0x0d, 0x6e, 0xee, 0x4d, 0x72, 0x73, 0x23, 0x2b, 0x96, 0x89, 0x48,
+	0x8e, 0xb2, 0x72, 0xe2, 0xa2, 0x01, 0x4a, 0xac, 0xc8, 0x11, 0xb5, 0x35, 0x77, 0x97, 0xde, 0x19,
+	0xda, 0x52, 0x80, 0x22, 0x46, 0x0a, 0x14, 0x68, 0xd1, 0xf4, 0x03, 0xed, 0x53, 0x51, 0xa0, 0x45,
+	0x9f, 0x5a, 0xf4, 0xa5, 0xe8, 0x63, 0x7f, 0x41, 0xd1, 0xbe, 0x16, 0xfd, 0x01, 0x7d, 0xca, 0x8f,
+	0xe8, 0x43, 0x31, 0x1f, 0x3b, 0xfb, 0x49, 0x72, 0x25, 0xd9, 0x71, 0xde, 0x38, 0x33, 0xe7, 0xcc,
+	0x39, 0x73, 0xbe, 0xcf, 0xec, 0x10, 0xea, 0x5d, 0x8b, 0x5a, 0xed, 0x8e, 0xe7, 0xf9, 0xdd, 0x95,
+	0x81, 0xef, 0x51, 0x0f, 0xcd, 0x3b, 0x76, 0xff, 0xe1, 0x90, 0x88, 0xd1, 0x0a, 0x5b, 0x6e, 0x56,
+	0x3b, 0x9e, 0xe3, 0x78, 0xae, 0x98, 0x6a, 0xd6, 0x6c, 0x97, 0x62, 0xdf, 0xb5, 0xfa, 0x72, 0x5c,
+	0x8d, 0x22, 0x34, 0xab, 0xa4, 0xb3, 0x8f, 0x1d, 0x4b, 0x8c, 0x8c, 0x03, 0xa8, 0xde, 0xea, 0x0f,
+	0xc9, 0xbe, 0x89, 0x1f, 0x0c, 0x31, 0xa1, 0xe8, 0x3a, 0x14, 0x77, 0x2d, 0x82, 0x1b, 0xda, 0x92,
+	0xb6, 0x5c, 0x59, 0x3d, 0xb7, 0x12, 0xa3, 0x25, 0xa9, 0x6c, 0x91, 0xde, 0x9a, 0x45, 0xb0, 0xc9,
+	0x21, 0x11, 0x82, 0x62, 0x77, 0xb7, 0xb5, 0xde, 0x28, 0x2c, 0x69, 0xcb, 0xba, 0xc9, 0x7f, 0x23,
+	0x03, 0xaa, 0x1d, 0xaf, 0xdf, 0xc7, 0x1d, 0x6a, 0x7b, 0x6e, 0x6b, 0xbd, 0x51, 0xe4, 0x6b, 0xb1,
+	0x39, 0xe3, 0xd7, 0x1a, 0xcc, 0x4a, 0xd2, 0x64, 0xe0, 0xb9, 0x04, 0xa3, 0xd7, 0x61, 0x9a, 0x50,
+	0x8b, 0x0e, 0x89, 0xa4, 0x7e, 0x36, 0x93, 0xfa, 0x0e, 0x07, 0x31, 0x25, 0x68, 0x2e, 0xf2, 0x7a,
+	0x9a, 0x3c, 0xba, 0x00, 0x40, 0x70, 0xcf, 0xc1, 0x2e, 0x6d, 0xad, 0x93, 0x46, 0x71, 0x49, 0x5f,
+	0xd6, 0xcd, 0xc8, 0x8c, 0xf1, 0x73, 0x0d, 0xea, 0x3b, 0xc1, 0x30, 0x90, 0xce, 0x02, 0x4c, 0x75,
+	0xbc, 0xa1, 0x4b, 0x39, 0x83, 0xb3, 0xa6, 0x18, 0xa0, 0x4b, 0x50, 0xed, 0xec, 0x5b, 0xae, 0x8b,
+	0xfb, 0x6d, 0xd7, 0x72, 0x30, 0x67, 0xa5, 0x6c, 0x56, 0xe4, 0xdc, 0x1d, 0xcb, 0xc1, 0xb9, 0x38,
+	0x5a, 0x82, 0xca, 0xc0, 0xf2, 0xa9, 0x1d, 0x93, 0x59, 0x74, 0xca, 0xf8, 0xad, 0x06, 0x8b, 0xef,
+	0x11, 0x62, 0xf7, 0xdc, 0x14, 0x67, 0x8b, 0x30, 0xed, 0x7a, 0x5d, 0xdc, 0x5a, 0xe7, 0xac, 0xe9,
+	0xa6, 0x1c, 0xa1, 0xb3, 0x50, 0x1e, 0x60, 0xec, 0xb7, 0x7d, 0xaf, 0x1f, 0x30, 0x56, 0x62, 0x13,
+	0xa6, 0xd7, 0xc7, 0xe8, 0x23, 0x98, 0x27, 0x89, 0x8d, 0x48, 0x43, 0x5f, 0xd2, 0x97, 0x2b, 0xab,
+	0x2f, 0xac, 0xa4, 0xac, 0x6c, 0x25, 0x49, 0xd4, 0x4c, 0x63, 0x1b, 0x8f, 0x0b, 0x70, 0x5a, 0xc1,
+	0x09, 0x5e, 0xd9, 0x6f, 0x26, 0x39, 0x82, 0x7b, 0x8a, 0x3d, 0x31, 0xc8, 0x23, 0x39, 0x25, 0x72,
+	0x3d, 0x2a, 0xf2, 0x1c, 0x06, 0x96, 0x94, 0xe7, 0x54, 0x4a, 0x9e, 0xe8, 0x22, 0x54, 0xf0, 0xc1,
+	0xc0, 0xf6, 0x71, 0x9b, 0xda, 0x0e, 0x6e, 0x4c, 0x2f, 0x69, 0xcb, 0x45, 0x13, 0xc4, 0xd4, 0x5d,
+	0xdb, 0x89, 0x5a, 0xe4, 0x4c, 0x6e, 0x8b, 0x34, 0x7e, 0xa7, 0xc1, 0x99, 0x94, 0x96, 0xa4, 0x89,
+	0x9b, 0x50, 0xe7, 0x27, 0x0f, 0x25, 0xc3, 0x8c, 0x9d, 0x09, 0xfc, 0xf2, 0x38, 0x81, 0x87, 0xe0,
+	0x66, 0x0a, 0x3f, 0xc2, 0x64, 0x21, 0x3f, 0x93, 0xf7, 0xe1, 0xcc, 0x06, 0xa6, 0x92, 0x00, 0x5b,
+	0xc3, 0xe4, 0xf8, 0x21, 0x20, 0xee, 0x4b, 0x85, 0x94, 0x2f, 0xfd, 0xa9, 0x00, 0xf5, 0x28, 0xa9,
+	0x96, 0xbb, 0xe7, 0xa1, 0x73, 0x50, 0x56, 0x20, 0xd2, 0x2a, 0xc2, 0x09, 0xf4, 0xdf, 0x30, 0xc5,
+	0x38, 0x15, 0x26, 0x51, 0x5b, 0xbd, 0x94, 0x7d, 0xa6, 0xc8, 0x9e, 0xa6, 0x80, 0x47, 0x2d, 0xa8,
+	0x11, 0x6a, 0xf9, 0xb4, 0x3d, 0xf0, 0x08, 0xd7, 0x33, 0x37, 0x9c, 0xca, 0xaa, 0x11, 0xdf, 0x41,
+	0x85, 0xc8, 0x2d, 0xd2, 0xdb, 0x96, 0x90, 0xe6, 0x2c, 0xc7, 0x0c, 0x86, 0xe8, 0x7d, 0xa8, 0x62,
+	0xb7, 0x1b, 0x6e, 0x54, 0xcc, 0xbd, 0x51, 0x05, 0xbb, 0x5d, 0xb5, 0x4d, 0xa8, 0x9f, 0xa9, 0xfc,
+	0xfa, 0xf9, 0xb1, 0x06, 0x8d, 0xb4, 0x82, 0x4e, 0x12, 0x28, 0xdf, 0x12, 0x48, 0x58, 0x28, 0x68,
+	0xac, 0x87, 0x2b, 0x25, 0x99, 0x12, 0xc5, 0xb0, 0xe1, 0xb9, 0x90, 0x1b, 0xbe, 0xf2, 0xd4, 0x8c,
+	0xe5, 0xfb, 0x1a, 0x2c, 0x26, 0x69, 0x9d, 0xe4, 0xdc, 0xff, 0x05, 0x53, 0xb6, 0xbb, 0xe7, 0x05,
+	0xc7, 0xbe, 0x30, 0xc6, 0xcf, 0x18, 0x2d, 0x01, 0x6c, 0x38, 0x70, 0x76, 0x03, 0xd3, 0x96, 0x4b,
+	0xb0, 0x4f, 0xd7, 0x6c, 0xb7, 0xef, 0xf5, 0xb6, 0x2d, 0xba, 0x7f, 0x02, 0x1f, 0x89, 0x99, 0x7b,
+	0x21, 0x61, 0xee, 0xc6, 0xef, 0x35, 0x38, 0x97, 0x4d, 0x4f, 0x1e, 0xbd, 0x09, 0xa5, 0x3d, 0x1b,
+	0xf7, 0xbb, 0xad, 0x75, 0x11, 0x30, 0x74, 0x53, 0x8d, 0x99, 0xaf, 0x0c, 0x18, 0xb0, 0x3c, 0xe1,
+	0xa5, 0x11, 0x06, 0xba, 0x43, 0x7d, 0xdb, 0xed, 0x6d, 0xda, 0x84, 0x9a, 0x02, 0x3e, 0x22, 0x4f,
+	0x3d, 0xbf, 0x65, 0xfe, 0x48, 0x83, 0x0b, 0x1b, 0x98, 0xde, 0x54, 0xa1, 0x96, 0xad, 0xdb, 0x84,
+	0xda, 0x1d, 0xf2, 0x74, 0x8b, 0x88, 0x8c, 0x9c, 0x69, 0xfc, 0x54, 0x83, 0x8b, 0x23, 0x99, 0x91,
+	0xa2, 0x93, 0xa1, 0x24, 0x08, 0xb4, 0xd9, 0xa1, 0xe4, 0x03, 0x7c, 0xf8, 0x89, 0xd5, 0x1f, 0xe2,
+	0x6d, 0xcb, 0xf6, 0x45, 0x28, 0x39, 0x66, 0x60, 0xfd, 0xa3, 0x06, 0xe7, 0x37, 0x30, 0xdd, 0x0e,
+	0xd2, 0xcc, 0x33, 0x94, 0x4e, 0x8e, 0x8a, 0xe2, 0x27, 0x42, 0x99, 0x99, 0xdc, 0x3e, 0x13, 0xf1,
+	0x5d, 0xe0, 0x7e, 0x10, 0x71, 0xc8, 0x9b, 0xa2, 0x16, 0x90, 0xc2, 0x33, 0x1e, 0xeb, 0x50, 0xfd,
+	0x44, 0xd6, 0x07, 0x6c, 0x39, 0x25, 0x07, 0x2d, 0x5b, 0x0e, 0x91, 0x92, 0x22, 0xab, 0xca, 0xd8,
+	0x80, 0x59, 0x82, 0xf1, 0xfd, 0xe3, 0x24, 0x8d, 0x2a, 0x43, 0x0c, 0x46, 0x68, 0x13, 0xe6, 0x87,
+	0xee, 0x1e, 0x2b, 0x6b, 0x71, 0x57, 0x9e, 0x42, 0x54, 0x97, 0x93, 0x23, 0x4f, 0x1a, 0x11, 0xdd,
+	0x86, 0xb9, 0xe4, 0x5e, 0x53, 0xb9, 0xf6, 0x4a, 0xa2, 0xa1, 0x16, 0xd4, 0xbb, 0xbe, 0x37, 0x18,
+	0xe0, 0x6e, 0x9b, 0x04, 0x5b, 0x4d, 0xe7, 0xdb, 0x4a, 0xe2, 0x05, 0x5b, 0x19, 0x3f, 0xd4, 0x60,
+	0xf1, 0x9e, 0x45, 0x3b, 0xfb, 0xeb, 0x8e, 0x54, 0xce, 0x09, 0x4c, 0xfb, 0x1d, 0x28, 0x3f, 0x94,
+	0x8a, 0x08, 0xe2, 0xd7, 0xc5, 0x0c, 0x86, 0xa2, 0x2a, 0x37, 0x43, 0x0c, 0xe3, 0xaf, 0x1a, 0x2c,
+	0xf0, 0x26, 0x22, 0xe0, 0xee, 0xeb, 0x77, 0xb2, 0x09, 0x8d, 0x04, 0xba, 0x0c, 0x35, 0xc7, 0xf2,
+	0xef, 0xef, 0x84, 0x30, 0x53, 0x1c, 0x26, 0x31, 0x6b, 0x1c, 0x00, 0xc8, 0xd1, 0x16, 0xe9, 0x1d,
+	0x83, 0xff, 0x37, 0x61, 0x46, 0x52, 0x95, 0xfe, 0x36, 0x49, 0xb1, 0x01, 0xb8, 0xf1, 0x37, 0x0d,
+	0x6a, 0x61, 0x04, 0xe5, 0x5e, 0x55, 0x83, 0x82, 0xf2, 0xa5, 0x42, 0x6b, 0x1d, 0xbd, 0x03, 0xd3,
+	0xa2, 0x6d, 0x94, 0x7b, 0xbf, 0x14, 0xdf, 0x5b, 0xac, 0xad, 0x44, 0xc2, 0x30, 0x9f, 0x30, 0x25,
+	0x12, 0x93, 0x91, 0x8a, 0x3a, 0xa2, 0xc3, 0xd0, 0xcd, 0xc8, 0x0c, 0x6a, 0xc1, 0x5c, 0xbc, 0x68,
+	0x0b, 0x7c, 0x66, 0x69, 0x54, 0xb4, 0x59, 0xb7, 0xa8, 0xc5, 0x83, 0x4d, 0x2d, 0x56, 0xb3, 0x11,
+	0xe3, 0x0f, 0x33, 0x50, 0x89, 0x9c, 0x32, 0x75, 0x92, 0xa4, 0x4a, 0x0b, 0x93, 0xe3, 0xa6, 0x9e,
+	0xee, 0x1c, 0x5e, 0x82, 0x9a, 0xcd, 0x73, 0x75, 0x5b, 0x9a, 0x22, 0x0f, 0xae, 0x65, 0x73, 0x56,
+	0xcc, 0x4a, 0xbf, 0x40, 0x17, 0xa0, 0xe2, 0x0e, 0x9d, 0xb6, 0xb7, 0xd7, 0xf6, 0xbd, 0x47, 0x44,
+	0xb6, 0x20, 0x65, 0x77, 0xe8, 0x7c, 0xb8, 0x67, 0x7a, 0x8f, 0x48, 0x58, 0xe5, 0x4e, 0x1f, 0xb1,
+	0xca, 0xbd, 0x00, 0x15, 0xc7, 0x3a, 0x60, 0xbb, 0xb6, 0xdd, 0xa1, 0xc3, 0xbb, 0x13, 0xdd, 0x2c,
+	0x3b, 0xd6, 0x81, 0xe9, 0x3d, 0xba, 0x33, 0x74, 0xd0, 0x32, 0xd4, 0xfb, 0x16, 0xa1, 0xed, 0x68,
+	0x7b, 0x53, 0xe2, 0xed, 0x4d, 0x8d, 0xcd, 0xbf, 0x1f, 0xb6, 0x38, 0xe9, 0x7a, 0xb9, 0x7c, 0x82,
+	0x7a, 0xb9, 0xeb, 0xf4, 0xc3, 0x8d, 0x20, 0x7f, 0xbd, 0xdc, 0x75, 0xfa, 0x6a, 0x9b, 0x37, 0x61,
+	0x66, 0x97, 0x57, 0x40, 0xa4, 0x51, 0x19, 0x19, 0xa1, 0x6e, 0xb1, 0xe2, 0x47, 0x14, 0x4a, 0x66,
+	0x00, 0x8e, 0xde, 0x86, 0x32, 0x4f, 0x3d, 0x1c, 0xb7, 0x9a, 0x0b, 0x37, 0x44, 0x60, 0xa1, 0xa8,
+	0x8b, 0xfb, 0xd4, 0xe2, 0xd8, 0xb3, 0x23, 0x43, 0xd1, 0x3a, 0x83, 0xd9, 0xf4, 0x7a, 0x22, 0x14,
+	0x29, 0x0c, 0x74, 0x1d, 0x4e, 0x77, 0x7c, 0x6c, 0x51, 0xdc, 0x5d, 0x3b, 0xbc, 0xe9, 0x39, 0x03,
+	0x8b, 0x5b, 0x53, 0xa3, 0xb6, 0xa4, 0x2d, 0x97, 0xcc, 0xac, 0x25, 0x16, 0x19, 0x3a, 0x6a, 0x74,
+	0xcb, 0xf7, 0x9c, 0xc6, 0x9c, 0x88, 0x0c, 0xf1, 0x59, 0x74, 0x1e, 0x20, 0x88, 0xdd, 0x16, 0x6d,
+	0xd4, 0xb9, 0x1a, 0xcb, 0x72, 0xe6, 0x3d, 0x8a, 0xee, 0xc1, 0x42, 0xa7, 0x3f, 0x24, 0x14, 0xb3,
+	0xf2, 0xae, 0x7d, 0x1f, 0x1f, 0xb6, 0x7d, 0xcb, 0xed, 0xe1, 0xc6, 0x7c, 0x96, 0xa7, 0xf2, 0x23,
+	0xdc, 0x54, 0xe0, 0x1f, 0xe0, 0x43, 0x93, 0x01, 0x9b, 0xa8, 0x93, 0x9a, 0x43, 0xd7, 0x61, 0x21,
+	0xa0, 0xfb, 0xc8, 0xa6, 0xfb, 0xca, 0xd4, 0x11, 0x3f, 0x12, 0x92, 0x6b, 0xf7, 0x6c, 0xba, 0x1f,
+	0xd8, 0xfb, 0x6b, 0x80, 0x42, 0xde, 0xdb, 0xde, 0x90, 0x0e, 0x86, 0x94, 0x34, 0x4e, 0xf3, 0x53,
+	0xcd, 0x87, 0x2b, 0x1f, 0x8a, 0x05, 0xe3, 0x73, 0x58, 0x08, 0x8d, 0x3b, 0x62, 0x48, 0x69, 0x9b,
+	0xd4, 0x8e, 0x6b, 0x93, 0xe3, 0xcb, 0xee, 0x3f, 0x17, 0x61, 0x71, 0xc7, 0x7a, 0x88, 0x9f, 0x7e,
+	0x85, 0x9f, 0x2b, 0x95, 0x6c, 0xc2, 0x3c, 0x2f, 0xea, 0x57, 0x23, 0xfc, 0x34, 0x8a, 0xb9, 0xec,
+	0x38, 0x8d, 0x88, 0xde, 0x65, 0x55, 0x0f, 0xee, 0xdc, 0xdf, 0xf6, 0xec, 0xb0, 0x70, 0x38, 0x9f,
+	0x65, 0x0e, 0x0a, 0xca, 0x8c, 0x62, 0xa0, 0xed, 0x74, 0x54, 0x16, 0x25, 0xc3, 0xcb, 0x63, 0x5b,
+	0xc7, 0x50, 0xfa, 0xc9, 0xe0, 0x8c, 0x1a, 0x30, 0x23, 0x0b, 0x13, 0x1e, 0xb2, 0x4a, 0x66, 0x30,
+	0x44, 0xdb, 0x70, 0x5a, 0x9c, 0x60, 0x47, 0xfa, 0xa3, 0x38, 0x7c, 0x29, 0xd7, 0xe1, 0xb3, 0x50,
+	0xe3, 0xee, 0x5c, 0x3e, 0xb2, 0x3b, 0x37, 0x60, 0x46, 0x1a, 0x38, 0x8f, 0x63, 0x25, 0x33, 0x18,
+	0xb2, 0x06, 0x08, 0x42, 0x91, 0x4d, 0xb8, 0xc7, 0xf8, 0x3f, 0x28, 0x29, 0x23, 0x2e, 0xe4, 0x36,
+	0x62, 0x85, 0x93, 0xcc, 0x20, 0x7a, 0x22, 0x83, 0x18, 0x7f, 0xd7, 0xa0, 0x1a, 0x3d, 0x02, 0xcb,
+	0x4c, 0x3e, 0xee, 0x78, 0x7e, 0xb7, 0x8d, 0x5d, 0xea, 0xdb, 0x58, 0xf4, 0xca, 0x45, 0x73, 0x56,
+	0xcc, 0xbe, 0x2f, 0x26, 0x19, 0x18, 0x4b, 0x0a, 0x84, 0x5a, 0xce, 0xa0, 0xbd, 0xc7, 0x62, 0x4f,
+	0x41, 0x80, 0xa9, 0x59, 0x1e, 0x7a, 0x2e, 0x41, 0x35, 0x04, 0xa3, 0x1e, 0xa7, 0x5f, 0x34, 0x2b,
+	0x6a, 0xee, 0xae, 0x87, 0x5e, 0x84, 0x1a, 0x97, 0x5a, 0xbb, 0xef, 0xf5, 0xda, 0xac, 0xaf, 0x94,
+	0xa9, 0xb0, 0xda, 0x95, 0x6c, 0x31, 0x75, 0xc4, 0xa1, 0x88, 0xfd, 0x19, 0x96, 0xc9, 0x50, 0x41,
+	0xed, 0xd8, 0x9f, 0x61, 0xe3, 0x0b, 0x0d, 0x66, 0x59, 0x66, 0xbf, 0xe3, 0x75, 0xf1, 0xdd, 0x63,
+	0xd6, 0x41, 0x39, 0xee, 0x14, 0xcf, 0x41, 0x59, 0x9d, 0x40, 0x1e, 0x29, 0x9c, 0x60, 0x17, 0x10,
+	0xb3, 0x32, 0xa0, 0xed, 0xa8, 0x3b, 0x66, 0xbe, 0x95, 0xc6, 0xb7, 0xe2, 0xbf, 0xd1, 0xff, 0xc6,
+	0x2f, 0xa8, 0x5e, 0xcc, 0xf4, 0x2b, 0xbe, 0x09, 0xaf, 0x95, 0x63, 0xd9, 0x3b, 0x4f, 0x67, 0xfb,
+	0x98, 0x29, 0x56, 0x8a, 0x82, 0x2b, 0xb6, 0x01, 0x33, 0x56, 0xb7, 0xeb, 0x63, 0x42, 0x24, 0x1f,
+	0xc1, 0x90, 0xad, 0x3c, 0xc4, 0x3e, 0x09, 0x4c, 0x4c, 0x37, 0x83, 0x21, 0x7a, 0x1b, 0x4a, 0xaa,
+	0xb8, 0xd6, 0xb3, 0x0a, 0xaa, 0x28, 0x9f, 0xb2, 0x13, 0x53, 0x18, 0xc6, 0x57, 0x05, 0xa8, 0x49,
+	0xb7, 0x5e, 0x93, 0x19, 0x76, 0xbc, 0xb1, 0xaf, 0x41, 0x75, 0x2f, 0x74, 0xcb, 0x71, 0x37, 0x2e,
+	0x51, 0xef, 0x8d, 0xe1, 0x4c, 0x32, 0xf8, 0x78, 0x8e, 0x2f, 0x9e, 0x28, 0xc7, 0x4f, 0x1d, 0x39,
+	0x28, 0x8c, 0x4a, 0xb5, 0xd3, 0x27, 0x4c, 0xb5, 0xc6, 0x7b, 0x50, 0x89, 0x70, 0xcc, 0xe3, 0xa4,
+	0xb8, 0xdd, 0x91, 0x42, 0x0e, 0x86, 0x6c, 0x65, 0x37, 0x22, 0xdd, 0xb2, 0x2a, 0x7e, 0x58, 0x2b,
+	0xc4, 0xae, 0x74, 0x4d, 0xdc, 0xf1, 0x1e, 0x62, 0xff, 0xf0, 0xe4, 0x17, 0x67, 0x6f, 0x45, 0x8c,
+	0x27, 0x67, 0x67, 0xa6, 0x10, 0xd0, 0x5b, 0x21, 0x9f, 0x7a, 0xd6, 0xbd, 0x41, 0x34, 0x67, 0x48,
+	0xd5, 0x87, 0x47, 0xf9, 0x99, 0xb8, 0x02, 0x8c, 0x1f, 0xe5, 0xb8, 0x69, 0xf9, 0x89, 0x14, 0xfc,
+	0xc6, 0x2f, 0x34, 0x78, 0x7e, 0x03, 0xd3, 0x5b, 0xf1, 0xb6, 0xfa, 0x59, 0x73, 0xe5, 0x40, 0x33,
+	0x8b, 0xa9, 0x93, 0x68, 0xbd, 0x09, 0x25, 0x75, 0x41, 0x20, 0x2e, 0x67, 0xd5, 0xd8, 0xf8, 0x81,
+	0x06, 0x0d, 0x49, 0x85, 0xd3, 0x64, 0xb5, 0x6c, 0x1f, 0x53, 0xdc, 0xfd, 0xba, 0x3b, 0xd6, 0xdf,
+	0x68, 0x50, 0x8f, 0x46, 0x57, 0xb6, 0x8a, 0xde, 0x80, 0x29, 0x7e, 0x31, 0x20, 0x39, 0x98, 0x68,
+	0xac, 0x02, 0x9a, 0x79, 0x14, 0xaf, 0x52, 0xee, 0x92, 0x20, 0x7a, 0xca, 0x61, 0x18, 0xe2, 0xf5,
+	0x23, 0x87, 0x78, 0xe3, 0x57, 0x1a, 0xa0, 0xb4, 0xef, 0x8f, 0x71, 0xec, 0x33, 0x30, 0xe3, 0xd8,
+	0x6e, 0xdb, 0x96, 0xc2, 0xd0, 0xcd, 0x69, 0xc7, 0x76, 0x5b, 0x2e, 0xe5, 0x0b, 0xd6, 0x01, 0x5f,
+	0xd0, 0xe5, 0x82, 0x75, 0xc0, 0x16, 0xce, 0x42, 0x99, 0x61, 0xec, 0xf5, 0x3d, 0x8b, 0xf2, 0x9c,
+	0xab, 0x99, 0x25, 0xc7, 0x76, 0x6f, 0xb1, 0x31, 0x5f, 0xb4, 0x0e, 0xe4, 0xe2, 0x94, 0x5c, 0xb4,
+	0x0e, 0xf8, 0xa2, 0xf1, 0x65, 0x01, 0x1a, 0x61, 0x1f, 0xf2, 0xb5, 0x87, 0xf8, 0x11, 0xb5, 0x9e,
+	0xfe, 0x84, 0x6a, 0xbd, 0xe2, 0x51, 0xc3, 0xba, 0xf1, 0x4b, 0x1d, 0x6a, 0xa1, 0x3c, 0xb6, 0xfb,
+	0x96, 0xcb, 0xbe, 0xa7, 0x0e, 0xfa, 0x56, 0x78, 0xa1, 0x28, 0x47, 0x68, 0x07, 0x6a, 0x24, 0x26,
+	0x2f, 0x29, 0x81, 0x57, 0xb3, 0x8c, 0x63, 0x84, 0x88, 0xcd, 0xc4, 0x16, 0xac, 0xc1, 0x13, 0x85,
+	0x36, 0xef, 0xd3, 0x65, 0x41, 0x22, 0xac, 0x90, 0xb5, 0xe8, 0x57, 0x01, 0xb1, 0x05, 0x6f, 0x48,
+	0xdb, 0xb6, 0xdb, 0x26, 0xb8, 0xe3, 0xb9, 0x5d, 0xc2, 0x35, 0x3e, 0x65, 0xd6, 0xe5, 0x4a, 0xcb,
+	0xdd, 0x11, 0xf3, 0xe8, 0x0d, 0x28, 0xd2, 0xc3, 0x81, 0xa8, 0xaf, 0x6a, 0xab, 0x97, 0xc6, 0xf2,
+	0x75, 0xf7, 0x70, 0x80, 0x4d, 0x0e, 0xce, 0xae, 0x68, 0xd8, 0x56, 0xd4, 0xb7, 0x1e, 0xe2, 0x7e,
+	0xf0, 0x29, 0x34, 0x9c, 0x61, 0x96, 0x1b, 0xf4, 0x7f, 0x33, 0xa2, 0xfc, 0xe8, 0x44, 0x9a, 0xbe,
+	0x30, 0x29, 0x06, 0xe6, 0x5d, 0xe2, 0x62, 0x9b, 0x0f, 0x57, 0x6e, 0x49, 0x43, 0x5f, 0x86, 0x3a,
+	0xb3, 0x4c, 0x29, 0x02, 0x91, 0xe5, 0xcb, 0x1c, 0xb8, 0xe6, 0x58, 0x07, 0x52, 0x52, 0xbc, 0xb6,
+	0xfd, 0x77, 0x01, 0xe6, 0x53, 0x32, 0x9c, 0x60, 0x9f, 0x89, 0xf2, 0xa1, 0x90, 0x2c, 0x1f, 0xde,
+	0x85, 0x8a, 0xbc, 0xb8, 0x89, 0xe4, 0xa6, 0x49, 0x36, 0x07, 0x02, 0x65, 0x73, 0x8c, 0xf1, 0x16,
+	0x9f, 0x90, 0xf1, 0x7e, 0x83, 0x6a, 0x92, 0x7f, 0xe9, 0x50, 0x0f, 0xc5, 0x6f, 0x62, 0x32, 0xec,
+	0xd3, 0x91, 0x7e, 0x31, 0xbe, 0xf9, 0x9d, 0x54, 0xd4, 0x25, 0xb4, 0x52, 0x7c, 0x52, 0x5a, 0x99,
+	0x7a, 0x42, 0x5a, 0x99, 0x3e, 0xb2, 0x56, 0xfe, 0x3f, 0x92, 0x46, 0x67, 0x38, 0xf6, 0x8b, 0x79,
+	0x22, 0x44, 0x98, 0x6c, 0x59, 0x50, 0xd8, 0x3d, 0xa4, 0x98, 0xb4, 0x7d, 0x6c, 0x75, 0xa5, 0x3b,
+	0x95, 0xf9, 0x8c, 0x89, 0xad, 0x2e, 0x7a, 0x01, 0x66, 0xc5, 0xf2, 0x23, 0xdf, 0xa6, 0x14, 0xbb,
+	0xd2, 0x87, 0xaa, 0x7c, 0xf2, 0x9e, 0x98, 0x43, 0x4b, 0xa2, 0x7d, 0x6b, 0x77, 0x3c, 0x42, 0xdb,
+	0x0e, 0xe1, 0x9d, 0xac, 0x2e, 0xdc, 0xfa, 0xa6, 0x47, 0xe8, 0x16, 0x31, 0xbe, 0xd4, 0x60, 0x21,
+	0x12, 0xfa, 0xbc, 0xbe, 0xdd, 0x39, 0xcc, 0xfd, 0x5d, 0x85, 0x19, 0x03, 0xc7, 0x90, 0x4d, 0x96,
+	0x1c, 0xa1, 0xff, 0x81, 0xe9, 0x81, 0xe5, 0x5b, 0xce, 0x88, 0xda, 0x2f, 0xeb, 0x9b, 0x91, 0x44,
+	0x30, 0x76, 0x60, 0x31, 0xa8, 0x30, 0x42, 0x0d, 0x6d, 0x61, 0x6a, 0x8d, 0x49, 0x9d, 0x17, 0xa1,
+	0x22, 0x2a, 0x47, 0xd1, 0x7e, 0x0a, 0x5e, 0x60, 0x57, 0x5d, 0x85, 0x18, 0xdf, 0x81, 0x05, 0x9e,
+	0xa1, 0x93, 0x9f, 0x2b, 0xf2, 0x9c, 0xd1, 0x80, 0x6a, 0xa4, 0x75, 0x0c, 0xaa, 0xee, 0xd8, 0x9c,
+	0xb1, 0x09, 0xcf, 0x25, 0xf6, 0x3f, 0x41, 0x05, 0x66, 0xfc, 0x45, 0x83, 0xe7, 0xd7, 0x7d, 0x6f,
+	0xf0, 0x89, 0xed, 0xd3, 0xa1, 0xd5, 0x8f, 0x7f, 0x00, 0x7b, 0x3a, 0x0d, 0xf1, 0xed, 0x88, 0xb5,
+	0x0a, 0x95, 0x5d, 0xcd, 0xb2, 0xf5, 0x14, 0x53, 0x29, 0xab, 0x35, 0xbe, 0xd2, 0xe1, 0xf9, 0x91,
+	0x70, 0x13, 0x62, 0x77, 0x9e, 0x9a, 0x38, 0xf3, 0x8a, 0x4c, 0x3f, 0xee, 0x15, 0xd9, 0x37, 0x2e,
+	0x98, 0xdf, 0x86, 0xf8, 0xfd, 0x65, 0x63, 0x3a, 0xf7, 0x9d, 0x51, 0x1c, 0x11, 0xad, 0x01, 0x84,
+	0x77, 0x79, 0x8d, 0x99, 0xdc, 0xdb, 0x44, 0xb0, 0x98, 0xba, 0x54, 0x8c, 0x0e, 0x22, 0x90, 0x9a,
+	0x30, 0x3e, 0x82, 0x66, 0x96, 0x99, 0x9e, 0xc4, 0xf4, 0x87, 0x70, 0x5e, 0x36, 0x7d, 0xeb, 0xf1,
+	0x8f, 0x8e, 0x4f, 0xb5, 0xd1, 0xba, 0x72, 0x03, 0xe6, 0x53, 0x85, 0x3c, 0xaa, 0x01, 0x7c, 0xec,
+	0x76, 0x64, 0x87, 0x53, 0x3f, 0x85, 0xaa, 0x50, 0x0a, 0xfa, 0x9d, 0xba, 0x76, 0xc5, 0x81, 0x5a,
+	0xbc, 0x8c, 0x42, 0x67, 0xe0, 0xf4, 0xc7, 0x6e, 0x17, 0xef, 0xd9, 0x2e, 0xee, 0x86, 0x4b, 0xf5,
+	0x53, 0xe8, 0x34, 0xcc, 0xb5, 0x5c, 0x17, 0xfb, 0x91, 0x49, 0x8d, 0x4d, 0x6e, 0x61, 0xbf, 0x87,
+	0x23, 0x93, 0x05, 0xd4, 0x80, 0x85, 0x30, 0x37, 0x47, 0x56, 0xf4, 0xd5, 0x7f, 0x2c, 0x42, 0x99,
+	0xdd, 0x06, 0xdd, 0xf4, 0x3c, 0xbf, 0x8b, 0x06, 0x80, 0xf8, 0xa3, 0x07, 0x67, 0xe0, 0xb9, 0xea,
+	0x75, 0x10, 0xba, 0x3e, 0x42, 0xbb, 0x69, 0x50, 0x29, 0xcd, 0xe6, 0xe5, 0x11, 0x18, 0x09, 0x70,
+	0xe3, 0x14, 0x72, 0x38, 0x45, 0x56, 0x8d, 0xde, 0xb5, 0x3b, 0xf7, 0x83, 0xeb, 0xfe, 0x31, 0x14,
+	0x13, 0xa0, 0x01, 0xc5, 0xc4, 0xa3, 0x23, 0x39, 0x10, 0x2f, 0x53, 0x02, 0xd3, 0x31, 0x4e, 0xa1,
+	0x07, 0xb0, 0xc0, 0x5e, 0x01, 0xa8, 0xc7, 0x08, 0x01, 0xc1, 0xd5, 0xd1, 0x04, 0x53, 0xc0, 0x47,
+	0x24, 0xb9, 0x09, 0x53, 0xbc, 0xa7, 0x45, 0x59, 0xee, 0x1a, 0x7d, 0x22, 0xdb, 0x5c, 0x1a, 0x0d,
+	0xa0, 0x76, 0xfb, 0x2e, 0xcc, 0x25, 0x9e, 0x00, 0xa2, 0x57, 0x32, 0xd0, 0xb2, 0x1f, 0x73, 0x36,
+	0xaf, 0xe4, 0x01, 0x55, 0xb4, 0x7a, 0x50, 0x8b, 0x3f, 0x99, 0x40, 0xcb, 0x19, 0xf8, 0x99, 0xcf,
+	0xb7, 0x9a, 0xaf, 0xe4, 0x80, 0x54, 0x84, 0x1c, 0xa8, 0x27, 0x9f, 0xa4, 0xa1, 0x2b, 0x63, 0x37,
+	0x88, 0x9b, 0xdb, 0xab, 0xb9, 0x60, 0x15, 0xb9, 0x43, 0x58, 0xc8, 0x7a, 0x12, 0x85, 0x56, 0xb2,
+	0xb7, 0x19, 0xf5, 0x56, 0xab, 0x79, 0x2d, 0x37, 0xbc, 0x22, 0xfd, 0x85, 0xb8, 0x4b, 0xcb, 0x7a,
+	0x56, 0x84, 0x6e, 0x64, 0x6f, 0x37, 0xe6, 0x3d, 0x54, 0x73, 0xf5, 0x28, 0x28, 0x8a, 0x89, 0xcf,
+	0x61, 0x31, 0xfb, 0x69, 0x0e, 0xba, 0x9e, 0xbd, 0xdf, 0xe8, 0x37, 0x47, 0xcd, 0x1b, 0x47, 0xc0,
+	0x50, 0x0c, 0x78, 0xc9, 0x47, 0x7f, 0x81, 0x1b, 0x5e, 0x9b, 0x68, 0x35, 0xc7, 0xf3, 0xc1, 0x4f,
+	0x61, 0x2e, 0xf1, 0x35, 0x2e, 0xd3, 0x6b, 0xb2, 0xbf, 0xd8, 0x35, 0xc7, 0x65, 0x18, 0xe1, 0x92,
+	0x89, 0x3b, 0x45, 0x34, 0xc2, 0xfa, 0x33, 0xee, 0x1d, 0x9b, 0x57, 0xf2, 0x80, 0xaa, 0x83, 0x10,
+	0x1e, 0x2e, 0x13, 0xf7, 0x72, 0xe8, 0x6a, 0xf6, 0x1e, 0xd9, 0x77, 0x8a, 0xcd, 0xd7, 0x72, 0x42,
+	0x2b, 0xa2, 0x6d, 0x80, 0x0d, 0x4c, 0xb7, 0x30, 0xf5, 0x99, 0x8d, 0x5c, 0xce, 0x14, 0x79, 0x08,
+	0x10, 0x90, 0x79, 0x79, 0x22, 0x9c, 0x22, 0xf0, 0x2d, 0x40, 0x41, 0x06, 0x8c, 0x7c, 0xc5, 0x7e,
+	0x61, 0x6c, 0x5f, 0x23, 0xda, 0xc6, 0x49, 0xba, 0x79, 0x00, 0xf5, 0x2d, 0xcb, 0x65, 0x55, 0x44,
+	0xb8, 0xef, 0xd5, 0x4c, 0xc6, 0x92, 0x60, 0x23, 0xa4, 0x35, 0x12, 0x5a, 0x1d, 0xe6, 0x91, 0xca,
+	0xa1, 0x96, 0x72, 0x41, 0x8c, 0x56, 0x32, 0xb7, 0x49, 0x03, 0x8e, 0x88, 0x2d, 0x63, 0xe0, 0x15,
+	0xe1, 0xc7, 0x1a, 0x9c, 0x4d, 0x03, 0xb0, 0xaf, 0xe8, 0xec, 0xe2, 0x89, 0xe4, 0x61, 0x81, 0x03,
+	0x1e, 0x81, 0x05, 0x09, 0xaf, 0x58, 0xc0, 0xec, 0x8d, 0x3e, 0x4d, 0xb6, 0x7d, 0x28, 0x7b, 0xa7,
+	0x0c, 0xc8, 0x9c, 0x1e, 0xf7, 0x00, 0xea, 0xeb, 0xfe, 0xa1, 0x39, 0x74, 0x27, 0x6a, 0x35, 0x09,
+	0x36, 0x5e, 0xab, 0x69, 0x68, 0x75, 0xb2, 0xef, 0xf1, 0x9c, 0x11, 0x2e, 0xdd, 0xb6, 0x09, 0xf5,
+	0xfc, 0x43, 0x74, 0x3d, 0x73, 0xa3, 0x2c, 0xd0, 0x11, 0x11, 0x73, 0x2c, 0x86, 0x22, 0xdf, 0x85,
+	0xd9, 0x58, 0x23, 0x88, 0xb2, 0xbe, 0x94, 0x67, 0xb5, 0xa2, 0xcd, 0xe5, 0xc9, 0x80, 0x8a, 0xca,
+	0x3e, 0xcc, 0x06, 0x81, 0x40, 0x58, 0xed, 0x2b, 0xa3, 0x78, 0x0d, 0x61, 0x46, 0xc4, 0xb1, 0x6c,
+	0xd0, 0x68, 0x1c, 0x4b, 0x97, 0xf8, 0x28, 0x5f, 0x6f, 0x38, 0x2e, 0x8e, 0x8d, 0xee, 0x1b, 0x8c,
+	0x53, 0xc8, 0x86, 0xc5, 0xec, 0x26, 0x20, 0x33, 0xef, 0x8d, 0xed, 0x17, 0x26, 0x58, 0xe8, 0xea,
+	0x3f, 0x8b, 0x50, 0x0a, 0x3e, 0xb2, 0x3e, 0x83, 0xaa, 0xfa, 0x19, 0x94, 0xb9, 0x9f, 0xc2, 0x5c,
+	0xe2, 0xed, 0x66, 0x66, 0x16, 0xcc, 0x7e, 0xdf, 0x39, 0xc9, 0xe1, 0xef, 0xc9, 0x7f, 0x74, 0x29,
+	0x85, 0xbd, 0x3c, 0xaa, 0x54, 0x3e, 0x9a, 0x9e, 0x9e, 0x7e, 0x6a, 0xbb, 0x03, 0x10, 0x09, 0x52,
+	0xe3, 0x2f, 0xcd, 0x59, 0x34, 0x9d, 0xc0, 0xf0, 0xda, 0xeb, 0xdf, 0xbe, 0xd1, 0xb3, 0xe9, 0xfe,
+	0x70, 0x97, 0xad, 0x5c, 0x13, 0xa0, 0xaf, 0xd9, 0x9e, 0xfc, 0x75, 0x2d, 0xd0, 0xe8, 0x35, 0x8e,
+	0x7d, 0x8d, 0x11, 0x18, 0xec, 0xee, 0x4e, 0xf3, 0xd1, 0xeb, 0xff, 0x19, 0x00, 0x89, 0x89, 0x62,
+	0xa9, 0xf3, 0x37, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3219,9 +3586,13 @@ type DataCoordClient interface {
 	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
+	SetCompactionPolicy(ctx context.Context, in *milvuspb.SetCompactionPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DryRunCompaction(ctx context.Context, in *milvuspb.DryRunCompactionRequest, opts ...grpc.CallOption) (*milvuspb.DryRunCompactionResponse, error)
+	GetCompactionHistory(ctx context.Context, in *milvuspb.GetCompactionHistoryRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionHistoryResponse, error)
 	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
 	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
+	RecoverDroppedSegments(ctx context.Context, in *RecoverDroppedSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataCoordClient struct {
@@ -3403,6 +3774,33 @@ func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *m
 	return out, nil
 }
 
+func (c *dataCoordClient) SetCompactionPolicy(ctx context.Context, in *milvuspb.SetCompactionPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SetCompactionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DryRunCompaction(ctx context.Context, in *milvuspb.DryRunCompactionRequest, opts ...grpc.CallOption) (*milvuspb.DryRunCompactionResponse, error) {
+	out := new(milvuspb.DryRunCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DryRunCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionHistory(ctx context.Context, in *milvuspb.GetCompactionHistoryRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionHistoryResponse, error) {
+	out := new(milvuspb.GetCompactionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
 	out := new(WatchChannelsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
@@ -3430,6 +3828,15 @@ func (c *dataCoordClient) DropVirtualChannel(ctx context.Context, in *DropVirtua
 	return out, nil
 }
 
+func (c *dataCoordClient) RecoverDroppedSegments(ctx context.Context, in *RecoverDroppedSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RecoverDroppedSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -3452,9 +3859,13 @@ type DataCoordServer interface {
 	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	SetCompactionPolicy(context.Context, *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error)
+	DryRunCompaction(context.Context, *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error)
+	GetCompactionHistory(context.Context, *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error)
 	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
 	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
+	RecoverDroppedSegments(context.Context, *RecoverDroppedSegmentsRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -3518,6 +3929,15 @@ func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req
 func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
 }
+func (*UnimplementedDataCoordServer) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCompactionPolicy not implemented")
+}
+func (*UnimplementedDataCoordServer) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionHistory not implemented")
+}
 func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
 }
@@ -3527,6 +3947,9 @@ func (*UnimplementedDataCoordServer) GetFlushState(ctx context.Context, req *mil
 func (*UnimplementedDataCoordServer) DropVirtualChannel(ctx context.Context, req *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropVirtualChannel not implemented")
 }
+func (*UnimplementedDataCoordServer) RecoverDroppedSegments(ctx context.Context, req *RecoverDroppedSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverDroppedSegments not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -3874,6 +4297,60 @@ func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_SetCompactionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SetCompactionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).SetCompactionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/SetCompactionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).SetCompactionPolicy(ctx, req.(*milvuspb.SetCompactionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_DryRunCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DryRunCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).DryRunCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/DryRunCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).DryRunCompaction(ctx, req.(*milvuspb.DryRunCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetCompactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionHistory(ctx, req.(*milvuspb.GetCompactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WatchChannelsRequest)
 	if err := dec(in); err != nil {
@@ -3928,6 +4405,24 @@ func _DataCoord_DropVirtualChannel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_RecoverDroppedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverDroppedSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).RecoverDroppedSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/RecoverDroppedSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).RecoverDroppedSegments(ctx, req.(*RecoverDroppedSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -4008,6 +4503,18 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompactionStateWithPlans",
 			Handler:    _DataCoord_GetCompactionStateWithPlans_Handler,
 		},
+		{
+			MethodName: "SetCompactionPolicy",
+			Handler:    _DataCoord_SetCompactionPolicy_Handler,
+		},
+		{
+			MethodName: "DryRunCompaction",
+			Handler:    _DataCoord_DryRunCompaction_Handler,
+		},
+		{
+			MethodName: "GetCompactionHistory",
+			Handler:    _DataCoord_GetCompactionHistory_Handler,
+		},
 		{
 			MethodName: "WatchChannels",
 			Handler:    _DataCoord_WatchChannels_Handler,
@@ -4020,6 +4527,10 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropVirtualChannel",
 			Handler:    _DataCoord_DropVirtualChannel_Handler,
 		},
+		{
+			MethodName: "RecoverDroppedSegments",
+			Handler:    _DataCoord_RecoverDroppedSegments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -361,6 +361,126 @@ func (m *CollectionInfo) GetStartPositions() []*commonpb.KeyDataPair {
 	return nil
 }
 
+// DroppedCollectionInfo records a dropped collection, whose meta before the drop is kept in the snapshot
+type DroppedCollectionInfo struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	CollectionName       string   `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	DropTime             uint64   `protobuf:"varint,3,opt,name=drop_time,json=dropTime,proto3" json:"drop_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DroppedCollectionInfo) Reset()         { *m = DroppedCollectionInfo{} }
+func (m *DroppedCollectionInfo) String() string { return proto.CompactTextString(m) }
+func (*DroppedCollectionInfo) ProtoMessage()    {}
+func (*DroppedCollectionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DroppedCollectionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DroppedCollectionInfo.Unmarshal(m, b)
+}
+func (m *DroppedCollectionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DroppedCollectionInfo.Marshal(b, m, deterministic)
+}
+func (m *DroppedCollectionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DroppedCollectionInfo.Merge(m, src)
+}
+func (m *DroppedCollectionInfo) XXX_Size() int {
+	return xxx_messageInfo_DroppedCollectionInfo.Size(m)
+}
+func (m *DroppedCollectionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DroppedCollectionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DroppedCollectionInfo proto.InternalMessageInfo
+
+func (m *DroppedCollectionInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DroppedCollectionInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DroppedCollectionInfo) GetDropTime() uint64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
+// DroppedPartitionInfo records a dropped partition, whose meta before the drop is kept in the snapshot
+type DroppedPartitionInfo struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	PartitionName        string   `protobuf:"bytes,3,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	DropTime             uint64   `protobuf:"varint,4,opt,name=drop_time,json=dropTime,proto3" json:"drop_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DroppedPartitionInfo) Reset()         { *m = DroppedPartitionInfo{} }
+func (m *DroppedPartitionInfo) String() string { return proto.CompactTextString(m) }
+func (*DroppedPartitionInfo) ProtoMessage()    {}
+func (*DroppedPartitionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{6}
+}
+
+func (m *DroppedPartitionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DroppedPartitionInfo.Unmarshal(m, b)
+}
+func (m *DroppedPartitionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DroppedPartitionInfo.Marshal(b, m, deterministic)
+}
+func (m *DroppedPartitionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DroppedPartitionInfo.Merge(m, src)
+}
+func (m *DroppedPartitionInfo) XXX_Size() int {
+	return xxx_messageInfo_DroppedPartitionInfo.Size(m)
+}
+func (m *DroppedPartitionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DroppedPartitionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DroppedPartitionInfo proto.InternalMessageInfo
+
+func (m *DroppedPartitionInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DroppedPartitionInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *DroppedPartitionInfo) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DroppedPartitionInfo) GetDropTime() uint64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -378,7 +498,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{7}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -464,7 +584,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{8}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -533,6 +653,8 @@ func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
+	proto.RegisterType((*DroppedCollectionInfo)(nil), "milvus.proto.etcd.DroppedCollectionInfo")
+	proto.RegisterType((*DroppedPartitionInfo)(nil), "milvus.proto.etcd.DroppedPartitionInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 }
@@ -540,52 +662,56 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xe3, 0x36,
-	0x10, 0x86, 0x22, 0xc7, 0x8e, 0xc6, 0x8e, 0x9d, 0xb0, 0x3f, 0x20, 0x82, 0xb4, 0x55, 0x04, 0x24,
-	0x15, 0x50, 0xd4, 0x46, 0x93, 0xa2, 0xb7, 0x02, 0x6d, 0x23, 0x04, 0x30, 0x8a, 0x06, 0xae, 0x62,
-	0xf4, 0xd0, 0x8b, 0x40, 0x4b, 0xb4, 0x4d, 0x40, 0xa4, 0xbc, 0x22, 0x15, 0xc4, 0xb7, 0x3d, 0xef,
-	0x23, 0xec, 0x23, 0xec, 0x8b, 0xed, 0x61, 0x5f, 0x62, 0x21, 0x52, 0x92, 0xed, 0xc4, 0x39, 0xee,
-	0x4d, 0xf3, 0xcd, 0x0c, 0xf9, 0xcd, 0xc7, 0x6f, 0x04, 0x03, 0xaa, 0xe2, 0x24, 0xe2, 0x54, 0x91,
-	0xe1, 0x2a, 0xcf, 0x54, 0x86, 0x4e, 0x39, 0x4b, 0x1f, 0x0b, 0x69, 0xa2, 0x61, 0x99, 0x3d, 0xeb,
-	0xc5, 0x19, 0xe7, 0x99, 0x30, 0xd0, 0x59, 0x4f, 0xc6, 0x4b, 0xca, 0xab, 0x72, 0xef, 0xbd, 0x05,
-	0x30, 0xa5, 0x82, 0x08, 0xf5, 0x0f, 0x55, 0x04, 0xf5, 0xe1, 0x60, 0x1c, 0x60, 0xcb, 0xb5, 0x7c,
-	0x3b, 0x3c, 0x18, 0x07, 0xe8, 0x0a, 0x06, 0xa2, 0xe0, 0xd1, 0x9b, 0x82, 0xe6, 0xeb, 0x48, 0x64,
-	0x09, 0x95, 0xf8, 0x40, 0x27, 0x8f, 0x45, 0xc1, 0xff, 0x2d, 0xd1, 0xfb, 0x12, 0x44, 0x3f, 0xc1,
-	0x29, 0x13, 0x92, 0xe6, 0x2a, 0x8a, 0x97, 0x44, 0x08, 0x9a, 0x8e, 0x03, 0x89, 0x6d, 0xd7, 0xf6,
-	0x9d, 0xf0, 0xc4, 0x24, 0x6e, 0x1b, 0x1c, 0xfd, 0x08, 0x03, 0x73, 0x60, 0x53, 0x8b, 0x5b, 0xae,
-	0xe5, 0x3b, 0x61, 0x5f, 0xc3, 0x4d, 0xa5, 0xf7, 0xd6, 0x02, 0x67, 0x92, 0x67, 0x4f, 0xeb, 0xbd,
-	0xdc, 0x7e, 0x83, 0x0e, 0x49, 0x92, 0x9c, 0x4a, 0xc3, 0xa9, 0x7b, 0x7d, 0x3e, 0xdc, 0x99, 0xbd,
-	0x9a, 0xfa, 0x4f, 0x53, 0x13, 0xd6, 0xc5, 0x25, 0xd7, 0x9c, 0xca, 0x22, 0xdd, 0xc7, 0xd5, 0x24,
-	0x36, 0x5c, 0xbd, 0x77, 0x16, 0x38, 0x63, 0x91, 0xd0, 0xa7, 0xb1, 0x98, 0x67, 0xe8, 0x3b, 0x00,
-	0x56, 0x06, 0x91, 0x20, 0x9c, 0x6a, 0x2a, 0x4e, 0xe8, 0x68, 0xe4, 0x9e, 0x70, 0x8a, 0x30, 0x74,
-	0x74, 0x30, 0x0e, 0x2a, 0x95, 0xea, 0x10, 0x05, 0xd0, 0x33, 0x8d, 0x2b, 0x92, 0x13, 0x6e, 0xae,
-	0xeb, 0x5e, 0x5f, 0xec, 0x25, 0xfc, 0x37, 0x5d, 0xff, 0x47, 0xd2, 0x82, 0x4e, 0x08, 0xcb, 0xc3,
-	0xae, 0x6e, 0x9b, 0xe8, 0x2e, 0x2f, 0x80, 0xfe, 0x1d, 0xa3, 0x69, 0xb2, 0x21, 0x84, 0xa1, 0x33,
-	0x67, 0x29, 0x4d, 0x1a, 0x61, 0xea, 0xf0, 0x75, 0x2e, 0xde, 0x87, 0x16, 0xf4, 0x6f, 0xb3, 0x34,
-	0xa5, 0xb1, 0x62, 0x99, 0xd0, 0xc7, 0x3c, 0x97, 0xf6, 0x77, 0x68, 0x1b, 0x97, 0x54, 0xca, 0x5e,
-	0xee, 0x12, 0xad, 0x1c, 0xb4, 0x39, 0xe4, 0x41, 0x03, 0x61, 0xd5, 0x84, 0x7e, 0x80, 0x6e, 0x9c,
-	0x53, 0xa2, 0x68, 0xa4, 0x18, 0xa7, 0xd8, 0x76, 0x2d, 0xbf, 0x15, 0x82, 0x81, 0xa6, 0x8c, 0x53,
-	0xe4, 0x41, 0x6f, 0x45, 0x72, 0xc5, 0x34, 0x81, 0x40, 0xe2, 0x96, 0x6b, 0xfb, 0x76, 0xb8, 0x83,
-	0xa1, 0x2b, 0xe8, 0x37, 0x71, 0xa9, 0xae, 0xc4, 0x87, 0xfa, 0x8d, 0x9e, 0xa1, 0xe8, 0x0e, 0x8e,
-	0xe7, 0xa5, 0x28, 0x91, 0x9e, 0x8f, 0x4a, 0xdc, 0xde, 0xa7, 0x6d, 0xb9, 0x08, 0xc3, 0x5d, 0xf1,
-	0xc2, 0xde, 0xbc, 0x89, 0xa9, 0x44, 0xd7, 0xf0, 0xcd, 0x23, 0xcb, 0x55, 0x41, 0xd2, 0xda, 0x17,
-	0xfa, 0x95, 0x25, 0xee, 0xe8, 0x6b, 0xbf, 0xaa, 0x92, 0x95, 0x37, 0xcc, 0xdd, 0xbf, 0xc2, 0xb7,
-	0xab, 0xe5, 0x5a, 0xb2, 0xf8, 0x45, 0xd3, 0x91, 0x6e, 0xfa, 0xba, 0xce, 0xee, 0x74, 0xfd, 0x01,
-	0xe7, 0xcd, 0x0c, 0x91, 0x51, 0x25, 0xd1, 0x4a, 0x49, 0x45, 0xf8, 0x4a, 0x62, 0xc7, 0xb5, 0xfd,
-	0x56, 0x78, 0xd6, 0xd4, 0xdc, 0x9a, 0x92, 0x69, 0x53, 0x51, 0xfa, 0x50, 0x2e, 0x49, 0x9e, 0xc8,
-	0x48, 0x14, 0x1c, 0x83, 0x6b, 0xf9, 0x87, 0xa1, 0x63, 0x90, 0xfb, 0x82, 0xa3, 0x31, 0x0c, 0xa4,
-	0x22, 0xb9, 0x8a, 0x56, 0x99, 0xd4, 0x27, 0x48, 0xdc, 0xd5, 0xa2, 0xb8, 0xaf, 0x19, 0x2e, 0x20,
-	0x8a, 0x68, 0xbf, 0xf5, 0x75, 0xe3, 0xa4, 0xee, 0xf3, 0x3e, 0x5a, 0x70, 0xf2, 0x40, 0x17, 0x9c,
-	0x0a, 0xb5, 0x71, 0x9d, 0x07, 0xbd, 0x78, 0x63, 0xa0, 0xda, 0x38, 0x3b, 0x18, 0x72, 0xa1, 0xbb,
-	0xf5, 0x9c, 0x95, 0x07, 0xb7, 0x21, 0x74, 0x0e, 0x8e, 0xac, 0x4e, 0x0e, 0xb4, 0x47, 0xec, 0x70,
-	0x03, 0x18, 0x67, 0x97, 0xcf, 0x63, 0x7e, 0x0e, 0xda, 0xd9, 0x3a, 0xdc, 0x76, 0xf6, 0xe1, 0xee,
-	0x96, 0x61, 0xe8, 0xcc, 0x0a, 0xa6, 0x7b, 0xda, 0x26, 0x53, 0x85, 0xe8, 0x02, 0x7a, 0x54, 0x90,
-	0x59, 0x4a, 0x8d, 0x4b, 0x70, 0xc7, 0xb5, 0xfc, 0xa3, 0xb0, 0x6b, 0x30, 0x3d, 0x98, 0xf7, 0xc9,
-	0xda, 0x5e, 0x8b, 0xbd, 0x7f, 0x9c, 0x2f, 0xbd, 0x16, 0xdf, 0x03, 0x34, 0x02, 0xd4, 0x4b, 0xb1,
-	0x85, 0xa0, 0xcb, 0xad, 0x95, 0x88, 0x14, 0x59, 0xd4, 0x2b, 0x71, 0xdc, 0xa0, 0x53, 0xb2, 0x90,
-	0x2f, 0xb6, 0xab, 0xfd, 0x72, 0xbb, 0xfe, 0xba, 0xf9, 0xff, 0x97, 0x05, 0x53, 0xcb, 0x62, 0x56,
-	0x9a, 0x60, 0x64, 0xc6, 0xf8, 0x99, 0x65, 0xd5, 0xd7, 0x88, 0x09, 0x45, 0x73, 0x41, 0xd2, 0x91,
-	0x9e, 0x6c, 0x54, 0x6e, 0xcf, 0x6a, 0x36, 0x6b, 0xeb, 0xe8, 0xe6, 0x73, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x9e, 0xc5, 0x2c, 0x1a, 0x75, 0x06, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x96, 0xeb, 0x34, 0xa9, 0x5f, 0xd2, 0x74, 0x77, 0xd8, 0x45, 0xa3, 0x52, 0xc0, 0x6b, 0x69,
+	0x17, 0x4b, 0x88, 0x56, 0x74, 0x11, 0x37, 0x24, 0xa0, 0xd6, 0x4a, 0x11, 0xa2, 0x0a, 0xde, 0x8a,
+	0x03, 0x17, 0x6b, 0x62, 0x4f, 0x93, 0x91, 0x3c, 0x63, 0x33, 0x33, 0x5e, 0x6d, 0x6e, 0x70, 0xe5,
+	0x4f, 0xe0, 0xc4, 0x99, 0x7f, 0x8c, 0x03, 0xff, 0x04, 0xf2, 0x8c, 0x7f, 0xc4, 0x69, 0x56, 0xe2,
+	0x00, 0x37, 0xbf, 0x6f, 0xde, 0x1b, 0x7f, 0xef, 0xcd, 0xf7, 0x3e, 0x38, 0xa3, 0x3a, 0xcd, 0x12,
+	0x4e, 0x35, 0xb9, 0x2c, 0x65, 0xa1, 0x0b, 0xf4, 0x98, 0xb3, 0xfc, 0x4d, 0xa5, 0x6c, 0x74, 0x59,
+	0x9f, 0x9e, 0xcf, 0xd2, 0x82, 0xf3, 0x42, 0x58, 0xe8, 0x7c, 0xa6, 0xd2, 0x0d, 0xe5, 0x4d, 0x7a,
+	0xf0, 0xbb, 0x03, 0x70, 0x47, 0x05, 0x11, 0xfa, 0x7b, 0xaa, 0x09, 0x9a, 0xc3, 0xd1, 0x22, 0xc2,
+	0x8e, 0xef, 0x84, 0x6e, 0x7c, 0xb4, 0x88, 0xd0, 0x0b, 0x38, 0x13, 0x15, 0x4f, 0x7e, 0xae, 0xa8,
+	0xdc, 0x26, 0xa2, 0xc8, 0xa8, 0xc2, 0x47, 0xe6, 0xf0, 0x54, 0x54, 0xfc, 0x87, 0x1a, 0xbd, 0xad,
+	0x41, 0xf4, 0x29, 0x3c, 0x66, 0x42, 0x51, 0xa9, 0x93, 0x74, 0x43, 0x84, 0xa0, 0xf9, 0x22, 0x52,
+	0xd8, 0xf5, 0xdd, 0xd0, 0x8b, 0x1f, 0xd9, 0x83, 0x9b, 0x0e, 0x47, 0x9f, 0xc0, 0x99, 0xbd, 0xb0,
+	0xcb, 0xc5, 0x23, 0xdf, 0x09, 0xbd, 0x78, 0x6e, 0xe0, 0x2e, 0x33, 0xf8, 0xc5, 0x01, 0x6f, 0x29,
+	0x8b, 0xb7, 0xdb, 0x83, 0xdc, 0xbe, 0x84, 0x09, 0xc9, 0x32, 0x49, 0x95, 0xe5, 0x34, 0xbd, 0xbe,
+	0xb8, 0x1c, 0xf4, 0xde, 0x74, 0xfd, 0x8d, 0xcd, 0x89, 0xdb, 0xe4, 0x9a, 0xab, 0xa4, 0xaa, 0xca,
+	0x0f, 0x71, 0xb5, 0x07, 0x3d, 0xd7, 0xe0, 0x37, 0x07, 0xbc, 0x85, 0xc8, 0xe8, 0xdb, 0x85, 0xb8,
+	0x2f, 0xd0, 0x87, 0x00, 0xac, 0x0e, 0x12, 0x41, 0x38, 0x35, 0x54, 0xbc, 0xd8, 0x33, 0xc8, 0x2d,
+	0xe1, 0x14, 0x61, 0x98, 0x98, 0x60, 0x11, 0x35, 0x53, 0x6a, 0x43, 0x14, 0xc1, 0xcc, 0x16, 0x96,
+	0x44, 0x12, 0x6e, 0x7f, 0x37, 0xbd, 0x7e, 0x76, 0x90, 0xf0, 0x77, 0x74, 0xfb, 0x23, 0xc9, 0x2b,
+	0xba, 0x24, 0x4c, 0xc6, 0x53, 0x53, 0xb6, 0x34, 0x55, 0x41, 0x04, 0xf3, 0x57, 0x8c, 0xe6, 0x59,
+	0x4f, 0x08, 0xc3, 0xe4, 0x9e, 0xe5, 0x34, 0xeb, 0x06, 0xd3, 0x86, 0xef, 0xe6, 0x12, 0xfc, 0x39,
+	0x82, 0xf9, 0x4d, 0x91, 0xe7, 0x34, 0xd5, 0xac, 0x10, 0xe6, 0x9a, 0xfd, 0xd1, 0x7e, 0x05, 0x63,
+	0xab, 0x92, 0x66, 0xb2, 0xcf, 0x87, 0x44, 0x1b, 0x05, 0xf5, 0x97, 0xbc, 0x36, 0x40, 0xdc, 0x14,
+	0xa1, 0x8f, 0x61, 0x9a, 0x4a, 0x4a, 0x34, 0x4d, 0x34, 0xe3, 0x14, 0xbb, 0xbe, 0x13, 0x8e, 0x62,
+	0xb0, 0xd0, 0x1d, 0xe3, 0x14, 0x05, 0x30, 0x2b, 0x89, 0xd4, 0xcc, 0x10, 0x88, 0x14, 0x1e, 0xf9,
+	0x6e, 0xe8, 0xc6, 0x03, 0x0c, 0xbd, 0x80, 0x79, 0x17, 0xd7, 0xd3, 0x55, 0xf8, 0xd8, 0xbc, 0xd1,
+	0x1e, 0x8a, 0x5e, 0xc1, 0xe9, 0x7d, 0x3d, 0x94, 0xc4, 0xf4, 0x47, 0x15, 0x1e, 0x1f, 0x9a, 0x6d,
+	0xbd, 0x08, 0x97, 0xc3, 0xe1, 0xc5, 0xb3, 0xfb, 0x2e, 0xa6, 0x0a, 0x5d, 0xc3, 0xd3, 0x37, 0x4c,
+	0xea, 0x8a, 0xe4, 0xad, 0x2e, 0xcc, 0x2b, 0x2b, 0x3c, 0x31, 0xbf, 0x7d, 0xaf, 0x39, 0x6c, 0xb4,
+	0x61, 0xff, 0xfd, 0x05, 0xbc, 0x5f, 0x6e, 0xb6, 0x8a, 0xa5, 0x0f, 0x8a, 0x4e, 0x4c, 0xd1, 0x93,
+	0xf6, 0x74, 0x50, 0xf5, 0x35, 0x5c, 0x74, 0x3d, 0x24, 0x76, 0x2a, 0x99, 0x99, 0x94, 0xd2, 0x84,
+	0x97, 0x0a, 0x7b, 0xbe, 0x1b, 0x8e, 0xe2, 0xf3, 0x2e, 0xe7, 0xc6, 0xa6, 0xdc, 0x75, 0x19, 0xb5,
+	0x0e, 0xd5, 0x86, 0xc8, 0x4c, 0x25, 0xa2, 0xe2, 0x18, 0x7c, 0x27, 0x3c, 0x8e, 0x3d, 0x8b, 0xdc,
+	0x56, 0x1c, 0x2d, 0xe0, 0x4c, 0x69, 0x22, 0x75, 0x52, 0x16, 0xca, 0xdc, 0xa0, 0xf0, 0xd4, 0x0c,
+	0xc5, 0x7f, 0x97, 0xe0, 0x22, 0xa2, 0x89, 0xd1, 0xdb, 0xdc, 0x14, 0x2e, 0xdb, 0xba, 0xe0, 0x57,
+	0x07, 0x9e, 0x46, 0xb2, 0x28, 0x4b, 0x9a, 0xed, 0x69, 0x26, 0x80, 0x59, 0xda, 0x23, 0xad, 0x7a,
+	0x06, 0x58, 0xbd, 0xe9, 0x7d, 0x6c, 0x97, 0xe6, 0xc8, 0x6e, 0x7a, 0x0f, 0x9b, 0xcd, 0xf9, 0x00,
+	0xbc, 0x4c, 0x16, 0xe5, 0xae, 0x5e, 0x4e, 0x6a, 0xa0, 0xee, 0x39, 0xf8, 0xc3, 0x81, 0x27, 0x0d,
+	0x87, 0x65, 0xa7, 0x90, 0x7f, 0x4b, 0xc1, 0x87, 0xe9, 0x8e, 0xac, 0x9a, 0x5d, 0xd8, 0x85, 0xd0,
+	0xf3, 0x1d, 0xa1, 0x59, 0x8e, 0xae, 0xe1, 0x78, 0x3a, 0x10, 0xda, 0x90, 0xe2, 0x68, 0x8f, 0xe2,
+	0x5f, 0x0e, 0x3c, 0x7a, 0x4d, 0xd7, 0x9c, 0x0a, 0xdd, 0x2f, 0xe7, 0x7f, 0x43, 0xef, 0x02, 0x3c,
+	0xd5, 0xdc, 0x1c, 0x19, 0x66, 0x6e, 0xdc, 0x03, 0xd6, 0x00, 0x6a, 0x15, 0x5b, 0x0f, 0x75, 0xe3,
+	0x36, 0xdc, 0x35, 0x80, 0xe3, 0xa1, 0x19, 0x61, 0x98, 0xac, 0x2a, 0x66, 0x6a, 0xc6, 0xf6, 0xa4,
+	0x09, 0xd1, 0x33, 0x98, 0x51, 0x41, 0x56, 0x39, 0xb5, 0xcb, 0x84, 0x27, 0xbe, 0x13, 0x9e, 0xc4,
+	0x53, 0x8b, 0x99, 0xc6, 0x82, 0xbf, 0x9d, 0x5d, 0xf7, 0x38, 0x68, 0xcc, 0xff, 0xb7, 0x7b, 0x7c,
+	0x04, 0xd0, 0x0d, 0xa0, 0xf5, 0x8e, 0x1d, 0x64, 0xf8, 0xa0, 0x9a, 0xac, 0x5b, 0xe7, 0xe8, 0x1f,
+	0xf4, 0x8e, 0xac, 0xd5, 0x03, 0x13, 0x1a, 0x3f, 0x34, 0xa1, 0x6f, 0x5f, 0xfe, 0xf4, 0xf9, 0x9a,
+	0xe9, 0x4d, 0xb5, 0xaa, 0x77, 0xe5, 0xca, 0xb6, 0xf1, 0x19, 0x2b, 0x9a, 0xaf, 0x2b, 0x26, 0x34,
+	0x95, 0x82, 0xe4, 0x57, 0xa6, 0xb3, 0xab, 0xda, 0x64, 0xca, 0xd5, 0x6a, 0x6c, 0xa2, 0x97, 0xff,
+	0x0c, 0x00, 0xd1, 0x67, 0x2f, 0xa9, 0x9c, 0x07, 0x00, 0x00,
 }
@@ -658,6 +658,7 @@ type IndexMeta struct {
 	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Version              int64               `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	Recycled             bool                `protobuf:"varint,9,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	RetryCount           int64               `protobuf:"varint,10,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -751,6 +752,13 @@ func (m *IndexMeta) GetRecycled() bool {
 	return false
 }
 
+func (m *IndexMeta) GetRetryCount() int64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
 type DropIndexRequest struct {
 	IndexID              int64    `protobuf:"varint,1,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -790,6 +798,315 @@ func (m *DropIndexRequest) GetIndexID() int64 {
 	return 0
 }
 
+type IndexBuildTaskInfo struct {
+	IndexBuildID         int64               `protobuf:"varint,1,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	IndexID              int64               `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	IndexName            string              `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	NodeID               int64               `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	State                commonpb.IndexState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	Version              int64               `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	RetryCount           int64               `protobuf:"varint,7,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	FailReason           string              `protobuf:"bytes,8,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	DataPaths            []string            `protobuf:"bytes,9,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *IndexBuildTaskInfo) Reset()         { *m = IndexBuildTaskInfo{} }
+func (m *IndexBuildTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexBuildTaskInfo) ProtoMessage()    {}
+func (*IndexBuildTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{13}
+}
+
+func (m *IndexBuildTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexBuildTaskInfo.Unmarshal(m, b)
+}
+func (m *IndexBuildTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexBuildTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *IndexBuildTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexBuildTaskInfo.Merge(m, src)
+}
+func (m *IndexBuildTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_IndexBuildTaskInfo.Size(m)
+}
+func (m *IndexBuildTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexBuildTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexBuildTaskInfo proto.InternalMessageInfo
+
+func (m *IndexBuildTaskInfo) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+func (m *IndexBuildTaskInfo) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *IndexBuildTaskInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexBuildTaskInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *IndexBuildTaskInfo) GetState() commonpb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+func (m *IndexBuildTaskInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *IndexBuildTaskInfo) GetRetryCount() int64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *IndexBuildTaskInfo) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *IndexBuildTaskInfo) GetDataPaths() []string {
+	if m != nil {
+		return m.DataPaths
+	}
+	return nil
+}
+
+type ListIndexBuildTasksRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// 0 means all indexes
+	IndexID int64 `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	// empty means all states
+	States               []commonpb.IndexState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=milvus.proto.common.IndexState" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListIndexBuildTasksRequest) Reset()         { *m = ListIndexBuildTasksRequest{} }
+func (m *ListIndexBuildTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIndexBuildTasksRequest) ProtoMessage()    {}
+func (*ListIndexBuildTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{14}
+}
+
+func (m *ListIndexBuildTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIndexBuildTasksRequest.Unmarshal(m, b)
+}
+func (m *ListIndexBuildTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIndexBuildTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListIndexBuildTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIndexBuildTasksRequest.Merge(m, src)
+}
+func (m *ListIndexBuildTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListIndexBuildTasksRequest.Size(m)
+}
+func (m *ListIndexBuildTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIndexBuildTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIndexBuildTasksRequest proto.InternalMessageInfo
+
+func (m *ListIndexBuildTasksRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListIndexBuildTasksRequest) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *ListIndexBuildTasksRequest) GetStates() []commonpb.IndexState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+type ListIndexBuildTasksResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*IndexBuildTaskInfo `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListIndexBuildTasksResponse) Reset()         { *m = ListIndexBuildTasksResponse{} }
+func (m *ListIndexBuildTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIndexBuildTasksResponse) ProtoMessage()    {}
+func (*ListIndexBuildTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{15}
+}
+
+func (m *ListIndexBuildTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIndexBuildTasksResponse.Unmarshal(m, b)
+}
+func (m *ListIndexBuildTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIndexBuildTasksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListIndexBuildTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIndexBuildTasksResponse.Merge(m, src)
+}
+func (m *ListIndexBuildTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListIndexBuildTasksResponse.Size(m)
+}
+func (m *ListIndexBuildTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIndexBuildTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIndexBuildTasksResponse proto.InternalMessageInfo
+
+func (m *ListIndexBuildTasksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListIndexBuildTasksResponse) GetTasks() []*IndexBuildTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type CancelIndexBuildRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	IndexBuildID         int64             `protobuf:"varint,2,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
+	Reason               string            `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelIndexBuildRequest) Reset()         { *m = CancelIndexBuildRequest{} }
+func (m *CancelIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexBuildRequest) ProtoMessage()    {}
+func (*CancelIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{16}
+}
+
+func (m *CancelIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIndexBuildRequest.Unmarshal(m, b)
+}
+func (m *CancelIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIndexBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexBuildRequest.Merge(m, src)
+}
+func (m *CancelIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelIndexBuildRequest.Size(m)
+}
+func (m *CancelIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIndexBuildRequest proto.InternalMessageInfo
+
+func (m *CancelIndexBuildRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelIndexBuildRequest) GetIndexBuildID() int64 {
+	if m != nil {
+		return m.IndexBuildID
+	}
+	return 0
+}
+
+func (m *CancelIndexBuildRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type RetryIndexBuildRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	IndexBuildIDs        []int64           `protobuf:"varint,2,rep,packed,name=indexBuildIDs,proto3" json:"indexBuildIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RetryIndexBuildRequest) Reset()         { *m = RetryIndexBuildRequest{} }
+func (m *RetryIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryIndexBuildRequest) ProtoMessage()    {}
+func (*RetryIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{17}
+}
+
+func (m *RetryIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryIndexBuildRequest.Unmarshal(m, b)
+}
+func (m *RetryIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryIndexBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *RetryIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryIndexBuildRequest.Merge(m, src)
+}
+func (m *RetryIndexBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_RetryIndexBuildRequest.Size(m)
+}
+func (m *RetryIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryIndexBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryIndexBuildRequest proto.InternalMessageInfo
+
+func (m *RetryIndexBuildRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RetryIndexBuildRequest) GetIndexBuildIDs() []int64 {
+	if m != nil {
+		return m.IndexBuildIDs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
 	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
@@ -804,73 +1121,92 @@ func init() {
 	proto.RegisterType((*GetIndexFilePathsResponse)(nil), "milvus.proto.index.GetIndexFilePathsResponse")
 	proto.RegisterType((*IndexMeta)(nil), "milvus.proto.index.IndexMeta")
 	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
+	proto.RegisterType((*IndexBuildTaskInfo)(nil), "milvus.proto.index.IndexBuildTaskInfo")
+	proto.RegisterType((*ListIndexBuildTasksRequest)(nil), "milvus.proto.index.ListIndexBuildTasksRequest")
+	proto.RegisterType((*ListIndexBuildTasksResponse)(nil), "milvus.proto.index.ListIndexBuildTasksResponse")
+	proto.RegisterType((*CancelIndexBuildRequest)(nil), "milvus.proto.index.CancelIndexBuildRequest")
+	proto.RegisterType((*RetryIndexBuildRequest)(nil), "milvus.proto.index.RetryIndexBuildRequest")
 }
 
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xcf, 0xf9, 0x12, 0x3b, 0x1e, 0x87, 0xa8, 0x59, 0x4a, 0x75, 0xb8, 0x54, 0x75, 0x8e, 0x52,
-	0x0c, 0x6a, 0x9d, 0xca, 0xa5, 0xf0, 0x84, 0x04, 0x89, 0x45, 0x64, 0xa1, 0x54, 0xd1, 0x36, 0xe2,
-	0x01, 0x09, 0x59, 0x1b, 0xdf, 0x24, 0x59, 0xf5, 0xfe, 0xe5, 0x76, 0x5d, 0x91, 0x77, 0xde, 0x79,
-	0x2b, 0xe2, 0x93, 0xf0, 0x39, 0xfa, 0xcc, 0x97, 0x41, 0xbb, 0xb7, 0x77, 0xb9, 0x3b, 0x9f, 0x53,
-	0x87, 0x50, 0x78, 0xe1, 0xed, 0x66, 0xf6, 0x37, 0x33, 0x3b, 0xbf, 0x9d, 0xfd, 0xdd, 0xc2, 0x16,
-	0x0f, 0x3d, 0xfc, 0x79, 0x32, 0x8d, 0xa2, 0xc4, 0x1b, 0xc4, 0x49, 0x24, 0x23, 0x42, 0x02, 0xee,
-	0xbf, 0x9a, 0x89, 0xd4, 0x1a, 0xe8, 0xf5, 0xee, 0xc6, 0x34, 0x0a, 0x82, 0x28, 0x4c, 0x7d, 0xdd,
-	0x4d, 0x1e, 0x4a, 0x4c, 0x42, 0xe6, 0x1b, 0x7b, 0xa3, 0x18, 0xe1, 0xfe, 0x66, 0xc1, 0xfb, 0x14,
-	0x4f, 0xb9, 0x90, 0x98, 0x3c, 0x8f, 0x3c, 0xa4, 0x78, 0x3e, 0x43, 0x21, 0xc9, 0x13, 0x58, 0x3d,
-	0x66, 0x02, 0x1d, 0xab, 0x67, 0xf5, 0x3b, 0xc3, 0x8f, 0x06, 0xa5, 0x32, 0x26, 0xff, 0x81, 0x38,
-	0xdd, 0x65, 0x02, 0xa9, 0x46, 0x92, 0x2f, 0xa1, 0xc5, 0x3c, 0x2f, 0x41, 0x21, 0x9c, 0xc6, 0x15,
-	0x41, 0xdf, 0xa6, 0x18, 0x9a, 0x81, 0xc9, 0x1d, 0x68, 0x86, 0x91, 0x87, 0xe3, 0x91, 0x63, 0xf7,
-	0xac, 0xbe, 0x4d, 0x8d, 0xe5, 0xfe, 0x6a, 0xc1, 0xed, 0xf2, 0xce, 0x44, 0x1c, 0x85, 0x02, 0xc9,
-	0x53, 0x68, 0x0a, 0xc9, 0xe4, 0x4c, 0x98, 0xcd, 0xdd, 0xad, 0xad, 0xf3, 0x42, 0x43, 0xa8, 0x81,
-	0x92, 0x5d, 0xe8, 0xf0, 0x90, 0xcb, 0x49, 0xcc, 0x12, 0x16, 0x64, 0x3b, 0xdc, 0x1e, 0x54, 0xd8,
-	0x33, 0x44, 0x8d, 0x43, 0x2e, 0x0f, 0x35, 0x90, 0x02, 0xcf, 0xbf, 0xdd, 0xaf, 0xe1, 0x83, 0x7d,
-	0x94, 0x63, 0xc5, 0xb1, 0xca, 0x8e, 0x22, 0x23, 0xeb, 0x01, 0xbc, 0xa7, 0x99, 0xdf, 0x9d, 0x71,
-	0xdf, 0x1b, 0x8f, 0xd4, 0xc6, 0xec, 0xbe, 0x4d, 0xcb, 0x4e, 0xf7, 0x0f, 0x0b, 0xda, 0x3a, 0x78,
-	0x1c, 0x9e, 0x44, 0xe4, 0x19, 0xac, 0xa9, 0xad, 0xa5, 0x0c, 0x6f, 0x0e, 0xef, 0xd7, 0x36, 0x71,
-	0x59, 0x8b, 0xa6, 0x68, 0xe2, 0xc2, 0x46, 0x31, 0xab, 0x6e, 0xc4, 0xa6, 0x25, 0x1f, 0x71, 0xa0,
-	0xa5, 0xed, 0x9c, 0xd2, 0xcc, 0x24, 0xf7, 0x00, 0xd2, 0x11, 0x0a, 0x59, 0x80, 0xce, 0x6a, 0xcf,
-	0xea, 0xb7, 0x69, 0x5b, 0x7b, 0x9e, 0xb3, 0x00, 0xd5, 0x51, 0x24, 0xc8, 0x44, 0x14, 0x3a, 0x6b,
-	0x7a, 0xc9, 0x58, 0xee, 0x2f, 0x16, 0xdc, 0xa9, 0x76, 0x7e, 0x93, 0xc3, 0x78, 0x96, 0x06, 0xa1,
-	0x3a, 0x07, 0xbb, 0xdf, 0x19, 0xde, 0x1b, 0xcc, 0x4f, 0xf1, 0x20, 0xa7, 0x8a, 0x1a, 0xb0, 0xfb,
-	0xa6, 0x01, 0x64, 0x2f, 0x41, 0x26, 0x51, 0xaf, 0x65, 0xec, 0x57, 0x29, 0xb1, 0x6a, 0x28, 0x29,
-	0x37, 0xde, 0xa8, 0x36, 0xbe, 0x98, 0x31, 0x07, 0x5a, 0xaf, 0x30, 0x11, 0x3c, 0x0a, 0x35, 0x5d,
-	0x36, 0xcd, 0x4c, 0x72, 0x17, 0xda, 0x01, 0x4a, 0x36, 0x89, 0x99, 0x3c, 0x33, 0x7c, 0xad, 0x2b,
-	0xc7, 0x21, 0x93, 0x67, 0xaa, 0x9e, 0xc7, 0xcc, 0xa2, 0x70, 0x9a, 0x3d, 0x5b, 0xd5, 0x53, 0x1e,
-	0xb5, 0xaa, 0xa7, 0x51, 0x5e, 0xc4, 0x98, 0x4d, 0x63, 0x4b, 0xb3, 0xb0, 0x5d, 0x4b, 0xdd, 0xf7,
-	0x78, 0xf1, 0x03, 0xf3, 0x67, 0x78, 0xc8, 0x78, 0x42, 0x41, 0x45, 0xa5, 0xd3, 0x48, 0x46, 0xa6,
-	0xed, 0x2c, 0xc9, 0xfa, 0xb2, 0x49, 0x3a, 0x3a, 0xcc, 0xcc, 0xf4, 0xef, 0x0d, 0xd8, 0x4a, 0x49,
-	0xfa, 0xd7, 0x28, 0x2d, 0x73, 0xb3, 0xf6, 0x16, 0x6e, 0x9a, 0xff, 0x04, 0x37, 0xad, 0xbf, 0xc5,
-	0x4d, 0x00, 0xa4, 0x48, 0xcd, 0x4d, 0x26, 0x7e, 0x89, 0x6b, 0xeb, 0x7e, 0x03, 0x4e, 0x76, 0xc9,
-	0xbe, 0xe3, 0x3e, 0x6a, 0x36, 0xae, 0xa7, 0x30, 0xaf, 0x2d, 0xd8, 0x2a, 0xc5, 0x6b, 0xa5, 0x79,
-	0x57, 0x1b, 0x26, 0x7d, 0xb8, 0x95, 0xb2, 0x7c, 0xc2, 0x7d, 0x34, 0xc7, 0x69, 0xeb, 0xe3, 0xdc,
-	0xe4, 0xa5, 0x2e, 0xd4, 0xc6, 0x3e, 0xac, 0xe9, 0xed, 0x26, 0x8c, 0x8e, 0x00, 0x0a, 0x65, 0x53,
-	0x1d, 0xf9, 0x64, 0xa1, 0x8e, 0x14, 0x09, 0xa1, 0xed, 0x93, 0x7c, 0x63, 0x7f, 0x36, 0x8c, 0x26,
-	0x1f, 0xa0, 0x64, 0x4b, 0x8d, 0x7d, 0xae, 0xdb, 0x8d, 0x6b, 0xe9, 0xf6, 0x7d, 0xe8, 0x9c, 0x30,
-	0xee, 0x4f, 0x8c, 0xbe, 0xda, 0xfa, 0xba, 0x80, 0x72, 0x51, 0xed, 0x21, 0x5f, 0x81, 0x9d, 0xe0,
-	0xb9, 0x16, 0x99, 0x05, 0x8d, 0xcc, 0x5d, 0x53, 0xaa, 0x22, 0x6a, 0x4f, 0x61, 0xad, 0xee, 0x14,
-	0xc8, 0x36, 0x6c, 0x04, 0x2c, 0x79, 0x39, 0xf1, 0xd0, 0x47, 0x89, 0x9e, 0xd3, 0xec, 0x59, 0xfd,
-	0x75, 0xda, 0x51, 0xbe, 0x51, 0xea, 0x2a, 0xfc, 0x8c, 0x5b, 0xc5, 0x9f, 0x71, 0x51, 0x06, 0xd7,
-	0xcb, 0x32, 0xd8, 0x85, 0xf5, 0x04, 0xa7, 0x17, 0x53, 0x1f, 0x3d, 0xa7, 0xad, 0x13, 0xe6, 0xb6,
-	0xfb, 0x08, 0x6e, 0x8d, 0x92, 0x28, 0x2e, 0x49, 0x4b, 0x41, 0x17, 0xac, 0x92, 0x2e, 0x0c, 0xdf,
-	0x34, 0x01, 0x34, 0x74, 0x4f, 0xbd, 0x6f, 0x48, 0x0c, 0x64, 0x1f, 0xe5, 0x5e, 0x14, 0xc4, 0x51,
-	0x88, 0xa1, 0x4c, 0xff, 0x3b, 0xe4, 0xc9, 0x82, 0x5f, 0xf6, 0x3c, 0xd4, 0x14, 0xec, 0x3e, 0x5c,
-	0x10, 0x51, 0x81, 0xbb, 0x2b, 0x24, 0xd0, 0x15, 0x8f, 0x78, 0x80, 0x47, 0x7c, 0xfa, 0x72, 0xef,
-	0x8c, 0x85, 0x21, 0xfa, 0x57, 0x55, 0xac, 0x40, 0xb3, 0x8a, 0x1f, 0x97, 0x23, 0x8c, 0xf1, 0x42,
-	0x26, 0x3c, 0x3c, 0xcd, 0x86, 0xde, 0x5d, 0x21, 0xe7, 0x70, 0x7b, 0x1f, 0x75, 0x75, 0x2e, 0x24,
-	0x9f, 0x8a, 0xac, 0xe0, 0x70, 0x71, 0xc1, 0x39, 0xf0, 0x35, 0x4b, 0xfe, 0x04, 0x70, 0x39, 0x45,
-	0x64, 0xb9, 0x29, 0x9b, 0x27, 0xb0, 0x0a, 0xcb, 0xd3, 0x73, 0xd8, 0x2c, 0x3f, 0x13, 0xc8, 0x67,
-	0x75, 0xb1, 0xb5, 0x8f, 0xa8, 0xee, 0xe7, 0xcb, 0x40, 0xf3, 0x52, 0x09, 0x6c, 0xcd, 0x09, 0x0a,
-	0x79, 0x74, 0x55, 0x8a, 0xaa, 0xa6, 0x76, 0x1f, 0x2f, 0x89, 0xce, 0x6b, 0x1e, 0x42, 0x3b, 0x1f,
-	0x67, 0xf2, 0xa0, 0x2e, 0xba, 0x3a, 0xed, 0xdd, 0xab, 0xa4, 0xcc, 0x5d, 0x21, 0x13, 0x80, 0x7d,
-	0x94, 0x07, 0x28, 0x13, 0x3e, 0x15, 0xe4, 0x61, 0xed, 0x21, 0x5e, 0x02, 0xb2, 0xa4, 0x9f, 0xbe,
-	0x15, 0x97, 0x6d, 0x79, 0xf8, 0x7a, 0xd5, 0xe8, 0x9b, 0x7a, 0x41, 0xff, 0x7f, 0xa5, 0xde, 0xc1,
-	0x95, 0x3a, 0x82, 0x4e, 0xe1, 0x4d, 0x4a, 0x6a, 0x2f, 0xcb, 0xfc, 0xa3, 0xf5, 0xbf, 0x1e, 0x8c,
-	0xdd, 0x2f, 0x7e, 0x1c, 0x9e, 0x72, 0x79, 0x36, 0x3b, 0x56, 0xa5, 0x77, 0x52, 0xe4, 0x63, 0x1e,
-	0x99, 0xaf, 0x9d, 0x8c, 0xa1, 0x1d, 0x9d, 0x69, 0x47, 0xb7, 0x11, 0x1f, 0x1f, 0x37, 0xb5, 0xf9,
-	0xf4, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x9d, 0x20, 0xf1, 0x89, 0x0e, 0x00, 0x00,
+	// 1198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x73, 0xdb, 0x54,
+	0x17, 0xae, 0xac, 0xf8, 0xeb, 0x38, 0x6f, 0xde, 0xe6, 0xb6, 0x04, 0xe1, 0xd0, 0xa9, 0x2b, 0x4a,
+	0x30, 0xd0, 0x3a, 0x1d, 0x97, 0xd2, 0x0d, 0xcc, 0x40, 0xec, 0x21, 0xe3, 0x81, 0x74, 0x32, 0x6a,
+	0x86, 0x05, 0x03, 0x78, 0x6e, 0xac, 0x93, 0xe4, 0x4e, 0xf4, 0xe1, 0x48, 0xd7, 0x9d, 0x66, 0xcf,
+	0x0c, 0xc3, 0x0a, 0x56, 0x30, 0x2c, 0xf9, 0x15, 0x2c, 0xf8, 0x15, 0xfc, 0x19, 0x56, 0x2c, 0x98,
+	0x7b, 0x75, 0xa5, 0x48, 0xb2, 0x1c, 0x3b, 0x49, 0xc3, 0x8a, 0x9d, 0xef, 0xd1, 0xf9, 0x7c, 0xce,
+	0xd1, 0x73, 0xae, 0x0c, 0xab, 0xcc, 0xb3, 0xf1, 0xe5, 0x70, 0xe4, 0xfb, 0x81, 0xdd, 0x19, 0x07,
+	0x3e, 0xf7, 0x09, 0x71, 0x99, 0xf3, 0x62, 0x12, 0x46, 0xa7, 0x8e, 0x7c, 0xde, 0x5c, 0x1e, 0xf9,
+	0xae, 0xeb, 0x7b, 0x91, 0xac, 0xb9, 0xc2, 0x3c, 0x8e, 0x81, 0x47, 0x1d, 0x75, 0x5e, 0x4e, 0x5b,
+	0x98, 0xbf, 0x68, 0x70, 0xcb, 0xc2, 0x43, 0x16, 0x72, 0x0c, 0x9e, 0xf9, 0x36, 0x5a, 0x78, 0x32,
+	0xc1, 0x90, 0x93, 0x47, 0xb0, 0xb4, 0x4f, 0x43, 0x34, 0xb4, 0x96, 0xd6, 0x6e, 0x74, 0xdf, 0xec,
+	0x64, 0xc2, 0x28, 0xff, 0x3b, 0xe1, 0xe1, 0x16, 0x0d, 0xd1, 0x92, 0x9a, 0xe4, 0x43, 0xa8, 0x52,
+	0xdb, 0x0e, 0x30, 0x0c, 0x8d, 0xd2, 0x39, 0x46, 0x9f, 0x46, 0x3a, 0x56, 0xac, 0x4c, 0xd6, 0xa0,
+	0xe2, 0xf9, 0x36, 0x0e, 0xfa, 0x86, 0xde, 0xd2, 0xda, 0xba, 0xa5, 0x4e, 0xe6, 0x8f, 0x1a, 0xdc,
+	0xce, 0x66, 0x16, 0x8e, 0x7d, 0x2f, 0x44, 0xf2, 0x18, 0x2a, 0x21, 0xa7, 0x7c, 0x12, 0xaa, 0xe4,
+	0xd6, 0x0b, 0xe3, 0x3c, 0x97, 0x2a, 0x96, 0x52, 0x25, 0x5b, 0xd0, 0x60, 0x1e, 0xe3, 0xc3, 0x31,
+	0x0d, 0xa8, 0x1b, 0x67, 0x78, 0xaf, 0x93, 0x43, 0x4f, 0x01, 0x35, 0xf0, 0x18, 0xdf, 0x95, 0x8a,
+	0x16, 0xb0, 0xe4, 0xb7, 0xf9, 0x31, 0xbc, 0xb6, 0x8d, 0x7c, 0x20, 0x30, 0x16, 0xde, 0x31, 0x8c,
+	0xc1, 0xba, 0x0f, 0xff, 0x93, 0xc8, 0x6f, 0x4d, 0x98, 0x63, 0x0f, 0xfa, 0x22, 0x31, 0xbd, 0xad,
+	0x5b, 0x59, 0xa1, 0xf9, 0xbb, 0x06, 0x75, 0x69, 0x3c, 0xf0, 0x0e, 0x7c, 0xf2, 0x04, 0xca, 0x22,
+	0xb5, 0x08, 0xe1, 0x95, 0xee, 0xdd, 0xc2, 0x22, 0xce, 0x62, 0x59, 0x91, 0x36, 0x31, 0x61, 0x39,
+	0xed, 0x55, 0x16, 0xa2, 0x5b, 0x19, 0x19, 0x31, 0xa0, 0x2a, 0xcf, 0x09, 0xa4, 0xf1, 0x91, 0xdc,
+	0x01, 0x88, 0x46, 0xc8, 0xa3, 0x2e, 0x1a, 0x4b, 0x2d, 0xad, 0x5d, 0xb7, 0xea, 0x52, 0xf2, 0x8c,
+	0xba, 0x28, 0x5a, 0x11, 0x20, 0x0d, 0x7d, 0xcf, 0x28, 0xcb, 0x47, 0xea, 0x64, 0x7e, 0xa7, 0xc1,
+	0x5a, 0xbe, 0xf2, 0xab, 0x34, 0xe3, 0x49, 0x64, 0x84, 0xa2, 0x0f, 0x7a, 0xbb, 0xd1, 0xbd, 0xd3,
+	0x99, 0x9e, 0xe2, 0x4e, 0x02, 0x95, 0xa5, 0x94, 0xcd, 0x3f, 0x4b, 0x40, 0x7a, 0x01, 0x52, 0x8e,
+	0xf2, 0x59, 0x8c, 0x7e, 0x1e, 0x12, 0xad, 0x00, 0x92, 0x6c, 0xe1, 0xa5, 0x7c, 0xe1, 0xb3, 0x11,
+	0x33, 0xa0, 0xfa, 0x02, 0x83, 0x90, 0xf9, 0x9e, 0x84, 0x4b, 0xb7, 0xe2, 0x23, 0x59, 0x87, 0xba,
+	0x8b, 0x9c, 0x0e, 0xc7, 0x94, 0x1f, 0x29, 0xbc, 0x6a, 0x42, 0xb0, 0x4b, 0xf9, 0x91, 0x88, 0x67,
+	0x53, 0xf5, 0x30, 0x34, 0x2a, 0x2d, 0x5d, 0xc4, 0xb3, 0x69, 0xf4, 0x54, 0x4e, 0x23, 0x3f, 0x1d,
+	0x63, 0x3c, 0x8d, 0xd5, 0x96, 0x3e, 0x3d, 0x8d, 0x0a, 0xba, 0xcf, 0xf1, 0xf4, 0x4b, 0xea, 0x4c,
+	0x70, 0x97, 0xb2, 0xc0, 0x02, 0x61, 0x15, 0x4d, 0x23, 0xe9, 0xab, 0xb2, 0x63, 0x27, 0xb5, 0x45,
+	0x9d, 0x34, 0xa4, 0x99, 0x9a, 0xe9, 0x5f, 0x4b, 0xb0, 0x1a, 0x81, 0xf4, 0xaf, 0x41, 0x9a, 0xc5,
+	0xa6, 0x3c, 0x07, 0x9b, 0xca, 0xab, 0xc0, 0xa6, 0x7a, 0x29, 0x6c, 0x5c, 0x20, 0x69, 0x68, 0xae,
+	0x32, 0xf1, 0x0b, 0xbc, 0xb6, 0xe6, 0x27, 0x60, 0xc4, 0x2f, 0xd9, 0x67, 0xcc, 0x41, 0x89, 0xc6,
+	0xc5, 0x18, 0xe6, 0x67, 0x0d, 0x56, 0x33, 0xf6, 0x92, 0x69, 0xae, 0x2b, 0x61, 0xd2, 0x86, 0x9b,
+	0x11, 0xca, 0x07, 0xcc, 0x41, 0xd5, 0x4e, 0x5d, 0xb6, 0x73, 0x85, 0x65, 0xaa, 0x10, 0x89, 0xbd,
+	0x51, 0x50, 0xdb, 0x55, 0x10, 0xed, 0x03, 0xa4, 0xc2, 0x46, 0x3c, 0xf2, 0xf6, 0x4c, 0x1e, 0x49,
+	0x03, 0x62, 0xd5, 0x0f, 0x92, 0xc4, 0xfe, 0x2e, 0x29, 0x4e, 0xde, 0x41, 0x4e, 0x17, 0x1a, 0xfb,
+	0x84, 0xb7, 0x4b, 0x17, 0xe2, 0xed, 0xbb, 0xd0, 0x38, 0xa0, 0xcc, 0x19, 0x2a, 0x7e, 0xd5, 0xe5,
+	0xeb, 0x02, 0x42, 0x64, 0x49, 0x09, 0x79, 0x0a, 0x7a, 0x80, 0x27, 0x92, 0x64, 0x66, 0x14, 0x32,
+	0xf5, 0x9a, 0x5a, 0xc2, 0xa2, 0xb0, 0x0b, 0xe5, 0xa2, 0x2e, 0x90, 0x7b, 0xb0, 0xec, 0xd2, 0xe0,
+	0x78, 0x68, 0xa3, 0x83, 0x1c, 0x6d, 0xa3, 0xd2, 0xd2, 0xda, 0x35, 0xab, 0x21, 0x64, 0xfd, 0x48,
+	0x94, 0x5a, 0xc6, 0xd5, 0xf4, 0x32, 0x4e, 0xd3, 0x60, 0x2d, 0x4b, 0x83, 0x4d, 0xa8, 0x05, 0x38,
+	0x3a, 0x1d, 0x39, 0x68, 0x1b, 0x75, 0xe9, 0x30, 0x39, 0x8b, 0xa2, 0x03, 0xe4, 0xc1, 0xe9, 0x70,
+	0xe4, 0x4f, 0x3c, 0x6e, 0x80, 0xb4, 0x04, 0x29, 0xea, 0x09, 0x89, 0xf9, 0x00, 0x6e, 0xf6, 0x03,
+	0x7f, 0x9c, 0xe1, 0x9e, 0x14, 0x71, 0x68, 0x19, 0xe2, 0x30, 0xff, 0x28, 0x01, 0x19, 0x24, 0xbd,
+	0xd8, 0xa3, 0xe1, 0xb1, 0x9c, 0xef, 0x45, 0xba, 0x96, 0x72, 0x5a, 0x3a, 0x6f, 0x25, 0xea, 0x05,
+	0x2b, 0x51, 0x01, 0xb2, 0x94, 0x01, 0x24, 0x19, 0x83, 0xf2, 0x85, 0xc6, 0x20, 0x85, 0x63, 0x25,
+	0x8b, 0x63, 0x0e, 0xab, 0x6a, 0x1e, 0xab, 0xfc, 0x04, 0xd5, 0xa6, 0x26, 0x28, 0xcb, 0xab, 0xf5,
+	0x1c, 0xaf, 0x9a, 0xbf, 0x69, 0xd0, 0xfc, 0x82, 0x85, 0x3c, 0x8b, 0x60, 0x78, 0xf9, 0x0b, 0xdf,
+	0x6c, 0x4c, 0x9f, 0x26, 0xfb, 0x5d, 0xd0, 0xc1, 0x02, 0xe8, 0xc4, 0x1b, 0xfe, 0x27, 0x0d, 0xd6,
+	0x0b, 0x73, 0xbc, 0x0a, 0x53, 0x7c, 0x04, 0x65, 0x2e, 0xbc, 0x28, 0x92, 0xd8, 0x98, 0x49, 0x12,
+	0x99, 0xb1, 0xb2, 0x22, 0x23, 0xf3, 0x7b, 0x0d, 0x5e, 0xef, 0x51, 0x6f, 0x84, 0xce, 0x99, 0xce,
+	0xe5, 0x31, 0x5b, 0x84, 0x56, 0xcf, 0x6e, 0x61, 0x7a, 0xe6, 0x16, 0x36, 0x86, 0x35, 0x4b, 0x8c,
+	0xc3, 0xab, 0xc8, 0x63, 0x6a, 0x9f, 0x94, 0x0a, 0xf6, 0x49, 0xf7, 0x87, 0x3a, 0x80, 0x8c, 0xd6,
+	0x13, 0x5f, 0x1c, 0x64, 0x0c, 0x64, 0x1b, 0x79, 0xcf, 0x77, 0xc7, 0xbe, 0x87, 0x1e, 0x8f, 0x6e,
+	0x82, 0xe4, 0xd1, 0x8c, 0x4b, 0xf4, 0xb4, 0xaa, 0x4a, 0xb7, 0xb9, 0x31, 0xc3, 0x22, 0xa7, 0x6e,
+	0xde, 0x20, 0xae, 0x8c, 0xb8, 0xc7, 0x5c, 0xdc, 0x63, 0xa3, 0xe3, 0xde, 0x11, 0xf5, 0x3c, 0x74,
+	0xce, 0x8b, 0x98, 0x53, 0x8d, 0x23, 0xbe, 0x95, 0xb5, 0x50, 0x87, 0xe7, 0x3c, 0x60, 0xde, 0x61,
+	0x3c, 0x5c, 0xe6, 0x0d, 0x72, 0x02, 0xb7, 0xb7, 0x51, 0x46, 0x67, 0x21, 0x67, 0xa3, 0x30, 0x0e,
+	0xd8, 0x9d, 0x1d, 0x70, 0x4a, 0xf9, 0x82, 0x21, 0xbf, 0x01, 0x38, 0xe3, 0x75, 0xb2, 0x18, 0xef,
+	0x37, 0x37, 0xe6, 0xa9, 0x25, 0xee, 0x19, 0xac, 0x64, 0x2f, 0xee, 0xe4, 0xdd, 0x22, 0xdb, 0xc2,
+	0xcf, 0x9a, 0xe6, 0x7b, 0x8b, 0xa8, 0x26, 0xa1, 0x02, 0x58, 0x9d, 0x5a, 0xf1, 0xe4, 0xc1, 0x79,
+	0x2e, 0xf2, 0xb7, 0x9c, 0xe6, 0xc3, 0x05, 0xb5, 0x93, 0x98, 0xbb, 0x50, 0x4f, 0xf6, 0x07, 0xb9,
+	0x5f, 0x64, 0x9d, 0x5f, 0x2f, 0xcd, 0xf3, 0x28, 0xc3, 0xbc, 0x41, 0x86, 0x00, 0xdb, 0xc8, 0x77,
+	0x90, 0x07, 0x6c, 0x14, 0x92, 0x8d, 0xc2, 0x26, 0x9e, 0x29, 0xc4, 0x4e, 0xdf, 0x99, 0xab, 0x97,
+	0xa4, 0xfc, 0x12, 0x6e, 0x15, 0x30, 0x1c, 0xe9, 0x14, 0x25, 0x3f, 0x9b, 0xae, 0x9b, 0x9b, 0x0b,
+	0xeb, 0x27, 0x91, 0xbf, 0x85, 0x9b, 0x79, 0x22, 0x23, 0xef, 0x17, 0xb9, 0x99, 0x41, 0x77, 0xf3,
+	0xa0, 0xfb, 0x1a, 0xfe, 0x9f, 0xe3, 0x27, 0x52, 0x38, 0x41, 0xc5, 0x24, 0x36, 0xc7, 0x7b, 0xf7,
+	0xaf, 0x25, 0x75, 0x53, 0x13, 0xff, 0x05, 0xfc, 0x47, 0x45, 0xd7, 0x40, 0x45, 0x7b, 0xd0, 0x48,
+	0x7d, 0x5d, 0x93, 0x42, 0x92, 0x99, 0xfe, 0xfc, 0x9e, 0x37, 0x15, 0xd7, 0x3d, 0x75, 0xd7, 0xfd,
+	0xc2, 0x6e, 0x7d, 0xf0, 0x55, 0xf7, 0x90, 0xf1, 0xa3, 0xc9, 0xbe, 0x08, 0xbd, 0x19, 0x69, 0x3e,
+	0x64, 0xbe, 0xfa, 0xb5, 0x19, 0x77, 0x60, 0x53, 0x7a, 0xda, 0x94, 0xb5, 0x8c, 0xf7, 0xf7, 0x2b,
+	0xf2, 0xf8, 0xf8, 0x9f, 0x01, 0x00, 0xa9, 0x82, 0x1f, 0xbb, 0xb3, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -894,6 +1230,9 @@ type IndexCoordClient interface {
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	ListIndexBuildTasks(ctx context.Context, in *ListIndexBuildTasksRequest, opts ...grpc.CallOption) (*ListIndexBuildTasksResponse, error)
+	CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RetryIndexBuild(ctx context.Context, in *RetryIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type indexCoordClient struct {
@@ -976,6 +1315,33 @@ func (c *indexCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetri
 	return out, nil
 }
 
+func (c *indexCoordClient) ListIndexBuildTasks(ctx context.Context, in *ListIndexBuildTasksRequest, opts ...grpc.CallOption) (*ListIndexBuildTasksResponse, error) {
+	out := new(ListIndexBuildTasksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/ListIndexBuildTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/CancelIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexCoordClient) RetryIndexBuild(ctx context.Context, in *RetryIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/RetryIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexCoordServer is the server API for IndexCoord service.
 type IndexCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -987,6 +1353,9 @@ type IndexCoordServer interface {
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	ListIndexBuildTasks(context.Context, *ListIndexBuildTasksRequest) (*ListIndexBuildTasksResponse, error)
+	CancelIndexBuild(context.Context, *CancelIndexBuildRequest) (*commonpb.Status, error)
+	RetryIndexBuild(context.Context, *RetryIndexBuildRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedIndexCoordServer can be embedded to have forward compatible implementations.
@@ -1017,6 +1386,15 @@ func (*UnimplementedIndexCoordServer) DropIndex(ctx context.Context, req *DropIn
 func (*UnimplementedIndexCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (*UnimplementedIndexCoordServer) ListIndexBuildTasks(ctx context.Context, req *ListIndexBuildTasksRequest) (*ListIndexBuildTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexBuildTasks not implemented")
+}
+func (*UnimplementedIndexCoordServer) CancelIndexBuild(ctx context.Context, req *CancelIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndexBuild not implemented")
+}
+func (*UnimplementedIndexCoordServer) RetryIndexBuild(ctx context.Context, req *RetryIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryIndexBuild not implemented")
+}
 
 func RegisterIndexCoordServer(s *grpc.Server, srv IndexCoordServer) {
 	s.RegisterService(&_IndexCoord_serviceDesc, srv)
@@ -1166,6 +1544,60 @@ func _IndexCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexCoord_ListIndexBuildTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexBuildTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).ListIndexBuildTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/ListIndexBuildTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).ListIndexBuildTasks(ctx, req.(*ListIndexBuildTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_CancelIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).CancelIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/CancelIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).CancelIndexBuild(ctx, req.(*CancelIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexCoord_RetryIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexCoordServer).RetryIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexCoord/RetryIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexCoordServer).RetryIndexBuild(ctx, req.(*RetryIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.index.IndexCoord",
 	HandlerType: (*IndexCoordServer)(nil),
@@ -1202,6 +1634,18 @@ var _IndexCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _IndexCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "ListIndexBuildTasks",
+			Handler:    _IndexCoord_ListIndexBuildTasks_Handler,
+		},
+		{
+			MethodName: "CancelIndexBuild",
+			Handler:    _IndexCoord_CancelIndexBuild_Handler,
+		},
+		{
+			MethodName: "RetryIndexBuild",
+			Handler:    _IndexCoord_RetryIndexBuild_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "index_coord.proto",
@@ -1215,6 +1659,7 @@ type IndexNodeClient interface {
 	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -1263,6 +1708,15 @@ func (c *indexNodeClient) CreateIndex(ctx context.Context, in *CreateIndexReques
 	return out, nil
 }
 
+func (c *indexNodeClient) CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/CancelIndexBuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/GetMetrics", in, out, opts...)
@@ -1278,6 +1732,7 @@ type IndexNodeServer interface {
 	GetTimeTickChannel(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
+	CancelIndexBuild(context.Context, *CancelIndexBuildRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -1298,6 +1753,9 @@ func (*UnimplementedIndexNodeServer) GetStatisticsChannel(ctx context.Context, r
 func (*UnimplementedIndexNodeServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
+func (*UnimplementedIndexNodeServer) CancelIndexBuild(ctx context.Context, req *CancelIndexBuildRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndexBuild not implemented")
+}
 func (*UnimplementedIndexNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -1378,6 +1836,24 @@ func _IndexNode_CreateIndex_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexNode_CancelIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).CancelIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/CancelIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).CancelIndexBuild(ctx, req.(*CancelIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IndexNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -1416,6 +1892,10 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateIndex",
 			Handler:    _IndexNode_CreateIndex_Handler,
 		},
+		{
+			MethodName: "CancelIndexBuild",
+			Handler:    _IndexNode_CancelIndexBuild_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _IndexNode_GetMetrics_Handler,
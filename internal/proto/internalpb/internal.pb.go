@@ -1240,15 +1240,17 @@ type SearchRequest struct {
 	PartitionIDs    []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl             string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// the index to search with on sealed segments, empty means the default one
+	IndexName            string   `protobuf:"bytes,13,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
@@ -1360,6 +1362,88 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+// SearchCost records where a query node spent the time of a search, the durations are in milliseconds.
+type SearchCost struct {
+	NodeID int64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	// waiting for the tSafe to pass the guarantee timestamp
+	WaitTsafeDuration int64 `protobuf:"varint,2,opt,name=wait_tsafe_duration,json=waitTsafeDuration,proto3" json:"wait_tsafe_duration,omitempty"`
+	// searching the historical and streaming segments
+	SearchDuration int64 `protobuf:"varint,3,opt,name=search_duration,json=searchDuration,proto3" json:"search_duration,omitempty"`
+	// reducing the results of the segments
+	ReduceDuration       int64    `protobuf:"varint,4,opt,name=reduce_duration,json=reduceDuration,proto3" json:"reduce_duration,omitempty"`
+	NumSegmentsSearched  int64    `protobuf:"varint,5,opt,name=num_segments_searched,json=numSegmentsSearched,proto3" json:"num_segments_searched,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchCost) Reset()         { *m = SearchCost{} }
+func (m *SearchCost) String() string { return proto.CompactTextString(m) }
+func (*SearchCost) ProtoMessage()    {}
+func (*SearchCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
+}
+
+func (m *SearchCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCost.Unmarshal(m, b)
+}
+func (m *SearchCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCost.Marshal(b, m, deterministic)
+}
+func (m *SearchCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCost.Merge(m, src)
+}
+func (m *SearchCost) XXX_Size() int {
+	return xxx_messageInfo_SearchCost.Size(m)
+}
+func (m *SearchCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCost proto.InternalMessageInfo
+
+func (m *SearchCost) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SearchCost) GetWaitTsafeDuration() int64 {
+	if m != nil {
+		return m.WaitTsafeDuration
+	}
+	return 0
+}
+
+func (m *SearchCost) GetSearchDuration() int64 {
+	if m != nil {
+		return m.SearchDuration
+	}
+	return 0
+}
+
+func (m *SearchCost) GetReduceDuration() int64 {
+	if m != nil {
+		return m.ReduceDuration
+	}
+	return 0
+}
+
+func (m *SearchCost) GetNumSegmentsSearched() int64 {
+	if m != nil {
+		return m.NumSegmentsSearched
+	}
+	return 0
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1371,19 +1455,20 @@ type SearchResults struct {
 	ChannelIDsSearched       []string          `protobuf:"bytes,8,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
 	GlobalSealedSegmentIDs   []int64           `protobuf:"varint,9,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
 	// schema.SearchResultsData inside
-	SlicedBlob           []byte   `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
-	SlicedNumCount       int64    `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
-	SlicedOffset         int64    `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SlicedBlob           []byte      `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
+	SlicedNumCount       int64       `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
+	SlicedOffset         int64       `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	Cost                 *SearchCost `protobuf:"bytes,13,opt,name=cost,proto3" json:"cost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -1488,26 +1573,37 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 	return 0
 }
 
+func (m *SearchResults) GetCost() *SearchCost {
+	if m != nil {
+		return m.Cost
+	}
+	return nil
+}
+
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ResultChannelID      string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
-	DbID                 int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	SerializedExprPlan   []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ResultChannelID    string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
+	DbID               int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID       int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs       []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SerializedExprPlan []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// primary keys to get, segments whose pk bloom filter excludes all of them are skipped
+	Ids *schemapb.IDs `protobuf:"bytes,10,opt,name=ids,proto3" json:"ids,omitempty"`
+	// DML channels owning the primary keys, growing segments of other channels are skipped
+	DmlChannels          []string `protobuf:"bytes,11,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -1591,6 +1687,20 @@ func (m *RetrieveRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetIds() *schemapb.IDs {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *RetrieveRequest) GetDmlChannels() []string {
+	if m != nil {
+		return m.DmlChannels
+	}
+	return nil
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1609,7 +1719,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1706,7 +1816,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1813,7 +1923,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -1893,7 +2003,7 @@ func (m *SegmentStatisticsUpdates) Reset()         { *m = SegmentStatisticsUpdat
 func (m *SegmentStatisticsUpdates) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatisticsUpdates) ProtoMessage()    {}
 func (*SegmentStatisticsUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *SegmentStatisticsUpdates) XXX_Unmarshal(b []byte) error {
@@ -1975,7 +2085,7 @@ func (m *SegmentStatistics) Reset()         { *m = SegmentStatistics{} }
 func (m *SegmentStatistics) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatistics) ProtoMessage()    {}
 func (*SegmentStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *SegmentStatistics) XXX_Unmarshal(b []byte) error {
@@ -2022,7 +2132,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -2070,7 +2180,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2126,7 +2236,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2188,7 +2298,7 @@ func (m *QueryNodeStats) Reset()         { *m = QueryNodeStats{} }
 func (m *QueryNodeStats) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeStats) ProtoMessage()    {}
 func (*QueryNodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *QueryNodeStats) XXX_Unmarshal(b []byte) error {
@@ -2244,7 +2354,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{31}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2307,7 +2417,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{32}
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2378,6 +2488,7 @@ func init() {
 	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.internal.CreateIndexRequest")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.internal.InsertRequest")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.internal.SearchRequest")
+	proto.RegisterType((*SearchCost)(nil), "milvus.proto.internal.SearchCost")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.internal.RetrieveRequest")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.internal.RetrieveResults")
@@ -2396,130 +2507,139 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1998 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x72, 0x1c, 0x47,
-	0xf5, 0xff, 0xcf, 0xce, 0xae, 0x76, 0xf7, 0xcc, 0x4a, 0x5e, 0xb5, 0x64, 0x67, 0x64, 0x3b, 0xb1,
-	0x32, 0xc9, 0x1f, 0x44, 0x5c, 0x58, 0x46, 0x01, 0x92, 0xa2, 0x28, 0x1c, 0x5b, 0x1b, 0xcc, 0x96,
-	0x23, 0x21, 0x46, 0x4e, 0xaa, 0xe0, 0x66, 0xaa, 0x77, 0xa7, 0xb5, 0x1a, 0x3c, 0x5f, 0x99, 0xee,
-	0x91, 0xbd, 0xb9, 0xa2, 0x28, 0xae, 0xa0, 0xa0, 0x0a, 0xaa, 0xb8, 0x84, 0x47, 0xe0, 0x96, 0x2b,
-	0x3e, 0x8a, 0x2b, 0x5e, 0x81, 0x07, 0xe0, 0x1d, 0x28, 0xae, 0xa8, 0x3e, 0xdd, 0xf3, 0xb1, 0xab,
-	0x95, 0x2c, 0x2b, 0x15, 0x62, 0xaa, 0x72, 0x37, 0x7d, 0xce, 0xe9, 0x8f, 0xf3, 0x3b, 0xbf, 0x73,
-	0xfa, 0x6c, 0x2f, 0xac, 0x04, 0xb1, 0x60, 0x59, 0x4c, 0xc3, 0x3b, 0x69, 0x96, 0x88, 0x84, 0x5c,
-	0x8d, 0x82, 0xf0, 0x24, 0xe7, 0x6a, 0x74, 0xa7, 0x50, 0x5e, 0xef, 0x8d, 0x93, 0x28, 0x4a, 0x62,
-	0x25, 0xbe, 0xde, 0xe3, 0xe3, 0x63, 0x16, 0x51, 0x35, 0x72, 0xfe, 0x6c, 0xc0, 0xf2, 0x6e, 0x12,
-	0xa5, 0x49, 0xcc, 0x62, 0x31, 0x8c, 0x8f, 0x12, 0x72, 0x0d, 0x96, 0xe2, 0xc4, 0x67, 0xc3, 0x81,
-	0x6d, 0x6c, 0x1a, 0x5b, 0xa6, 0xab, 0x47, 0x84, 0x40, 0x33, 0x4b, 0x42, 0x66, 0x37, 0x36, 0x8d,
-	0xad, 0xae, 0x8b, 0xdf, 0xe4, 0x1e, 0x00, 0x17, 0x54, 0x30, 0x6f, 0x9c, 0xf8, 0xcc, 0x36, 0x37,
-	0x8d, 0xad, 0x95, 0x9d, 0xcd, 0x3b, 0x0b, 0x4f, 0x71, 0xe7, 0x50, 0x1a, 0xee, 0x26, 0x3e, 0x73,
-	0xbb, 0xbc, 0xf8, 0x24, 0xef, 0x01, 0xb0, 0x67, 0x22, 0xa3, 0x5e, 0x10, 0x1f, 0x25, 0x76, 0x73,
-	0xd3, 0xdc, 0xb2, 0x76, 0x5e, 0x9f, 0x5d, 0x40, 0x1f, 0xfe, 0x11, 0x9b, 0x7e, 0x44, 0xc3, 0x9c,
-	0x1d, 0xd0, 0x20, 0x73, 0xbb, 0x38, 0x49, 0x1e, 0xd7, 0xf9, 0x87, 0x01, 0x57, 0x4a, 0x07, 0x70,
-	0x0f, 0x4e, 0xbe, 0x05, 0x2d, 0xdc, 0x02, 0x3d, 0xb0, 0x76, 0xde, 0x3c, 0xe3, 0x44, 0x33, 0x7e,
-	0xbb, 0x6a, 0x0a, 0xf9, 0x10, 0xd6, 0x78, 0x3e, 0x1a, 0x17, 0x2a, 0x0f, 0xa5, 0xdc, 0x6e, 0xe0,
-	0xd1, 0x2e, 0xb6, 0x12, 0xa9, 0x2f, 0xa0, 0x8f, 0xf4, 0x36, 0x2c, 0xc9, 0x95, 0x72, 0x8e, 0x28,
-	0x59, 0x3b, 0x37, 0x16, 0x3a, 0x79, 0x88, 0x26, 0xae, 0x36, 0x75, 0x6e, 0xc0, 0xc6, 0x43, 0x26,
-	0xe6, 0xbc, 0x73, 0xd9, 0xc7, 0x39, 0xe3, 0x42, 0x2b, 0x1f, 0x07, 0x11, 0x7b, 0x1c, 0x8c, 0x9f,
-	0xec, 0x1e, 0xd3, 0x38, 0x66, 0x61, 0xa1, 0x7c, 0x15, 0x6e, 0x3c, 0x64, 0x38, 0x21, 0xe0, 0x22,
-	0x18, 0xf3, 0x39, 0xf5, 0x55, 0x58, 0x7b, 0xc8, 0xc4, 0xc0, 0x9f, 0x13, 0x7f, 0x04, 0x9d, 0x7d,
-	0x19, 0x6c, 0x49, 0x83, 0x6f, 0x42, 0x9b, 0xfa, 0x7e, 0xc6, 0x38, 0xd7, 0x28, 0xde, 0x5c, 0x78,
-	0xe2, 0xfb, 0xca, 0xc6, 0x2d, 0x8c, 0x17, 0xd1, 0xc4, 0xf9, 0x31, 0xc0, 0x30, 0x0e, 0xc4, 0x01,
-	0xcd, 0x68, 0xc4, 0xcf, 0x24, 0xd8, 0x00, 0x7a, 0x5c, 0xd0, 0x4c, 0x78, 0x29, 0xda, 0x69, 0xc8,
-	0x2f, 0xc0, 0x06, 0x0b, 0xa7, 0xa9, 0xd5, 0x9d, 0x1f, 0x02, 0x1c, 0x8a, 0x2c, 0x88, 0x27, 0x1f,
-	0x04, 0x5c, 0xc8, 0xbd, 0x4e, 0xa4, 0x9d, 0x74, 0xc2, 0xdc, 0xea, 0xba, 0x7a, 0x54, 0x0b, 0x47,
-	0xe3, 0xe2, 0xe1, 0xb8, 0x07, 0x56, 0x01, 0xf7, 0x1e, 0x9f, 0x90, 0xbb, 0xd0, 0x1c, 0x51, 0xce,
-	0xce, 0x85, 0x67, 0x8f, 0x4f, 0x1e, 0x50, 0xce, 0x5c, 0xb4, 0x74, 0x7e, 0x6e, 0xc2, 0x2b, 0xbb,
-	0x19, 0x43, 0xf2, 0x87, 0x21, 0x1b, 0x8b, 0x20, 0x89, 0x35, 0xf6, 0x2f, 0xbe, 0x1a, 0x79, 0x05,
-	0xda, 0xfe, 0xc8, 0x8b, 0x69, 0x54, 0x80, 0xbd, 0xe4, 0x8f, 0xf6, 0x69, 0xc4, 0xc8, 0x97, 0x60,
-	0x65, 0x5c, 0xae, 0x2f, 0x25, 0xc8, 0xb9, 0xae, 0x3b, 0x27, 0x25, 0x6f, 0xc2, 0x72, 0x4a, 0x33,
-	0x11, 0x94, 0x66, 0x4d, 0x34, 0x9b, 0x15, 0xca, 0x80, 0xfa, 0xa3, 0xe1, 0xc0, 0x6e, 0x61, 0xb0,
-	0xf0, 0x9b, 0x38, 0xd0, 0xab, 0xd6, 0x1a, 0x0e, 0xec, 0x25, 0xd4, 0xcd, 0xc8, 0xc8, 0x26, 0x58,
-	0xe5, 0x42, 0xc3, 0x81, 0xdd, 0x46, 0x93, 0xba, 0x48, 0x06, 0x47, 0xd5, 0x22, 0xbb, 0xb3, 0x69,
-	0x6c, 0xf5, 0x5c, 0x3d, 0x22, 0x77, 0x61, 0xed, 0x24, 0xc8, 0x44, 0x4e, 0x43, 0xcd, 0x4f, 0x79,
-	0x0e, 0x6e, 0x77, 0x31, 0x82, 0x8b, 0x54, 0x64, 0x07, 0xd6, 0xd3, 0xe3, 0x29, 0x0f, 0xc6, 0x73,
-	0x53, 0x00, 0xa7, 0x2c, 0xd4, 0x39, 0x7f, 0x33, 0xe0, 0xea, 0x20, 0x4b, 0xd2, 0x97, 0x22, 0x14,
-	0x05, 0xc8, 0xcd, 0x73, 0x40, 0x6e, 0x9d, 0x06, 0xd9, 0xf9, 0x65, 0x03, 0xae, 0x29, 0x46, 0x1d,
-	0x14, 0xc0, 0x7e, 0x06, 0x5e, 0x7c, 0x19, 0xae, 0x54, 0xbb, 0x2a, 0x83, 0xc5, 0x6e, 0xfc, 0x3f,
-	0xac, 0x94, 0x01, 0x56, 0x76, 0xff, 0x5d, 0x4a, 0x39, 0xbf, 0x68, 0xc0, 0xba, 0x0c, 0xea, 0x17,
-	0x68, 0x48, 0x34, 0x7e, 0x6f, 0x00, 0x51, 0xec, 0xb8, 0x1f, 0x06, 0x94, 0x7f, 0x9e, 0x58, 0xac,
-	0x43, 0x8b, 0xca, 0x33, 0x68, 0x08, 0xd4, 0xc0, 0xe1, 0xd0, 0x97, 0xd1, 0xfa, 0xac, 0x4e, 0x57,
-	0x6e, 0x6a, 0xd6, 0x37, 0xfd, 0x9d, 0x01, 0xab, 0xf7, 0x43, 0xc1, 0xb2, 0x97, 0x14, 0x94, 0xbf,
-	0x34, 0x8a, 0xa8, 0x0d, 0x63, 0x9f, 0x3d, 0xfb, 0x3c, 0x0f, 0xf8, 0x2a, 0xc0, 0x51, 0xc0, 0x42,
-	0xbf, 0xce, 0xde, 0x2e, 0x4a, 0x3e, 0x15, 0x73, 0x6d, 0x68, 0xe3, 0x22, 0x25, 0x6b, 0x8b, 0xa1,
-	0xec, 0x01, 0x54, 0x3f, 0xa8, 0x7b, 0x80, 0xce, 0x85, 0x7b, 0x00, 0x9c, 0xa6, 0x7b, 0x80, 0x3f,
-	0x98, 0xb0, 0x3c, 0x8c, 0x39, 0xcb, 0xc4, 0xe5, 0xc1, 0xbb, 0x09, 0x5d, 0x7e, 0x4c, 0x33, 0x74,
-	0x54, 0xc3, 0x57, 0x09, 0xea, 0xd0, 0x9a, 0xcf, 0x83, 0xb6, 0x79, 0xc1, 0xe2, 0xd0, 0x3a, 0xaf,
-	0x38, 0x2c, 0x9d, 0x03, 0x71, 0xfb, 0xf9, 0xc5, 0xa1, 0x73, 0xfa, 0xf6, 0x95, 0x0e, 0xb2, 0x49,
-	0x24, 0x9b, 0xd6, 0x81, 0xdd, 0x45, 0x7d, 0x25, 0x20, 0xaf, 0x01, 0x88, 0x20, 0x62, 0x5c, 0xd0,
-	0x28, 0x55, 0xf7, 0x68, 0xd3, 0xad, 0x49, 0xe4, 0xdd, 0x9d, 0x25, 0x4f, 0x87, 0x03, 0x6e, 0x5b,
-	0x9b, 0xa6, 0x6c, 0xe2, 0xd4, 0x88, 0x7c, 0x1d, 0x3a, 0x59, 0xf2, 0xd4, 0xf3, 0xa9, 0xa0, 0x76,
-	0x0f, 0x83, 0xb7, 0xb1, 0x10, 0xec, 0x07, 0x61, 0x32, 0x72, 0xdb, 0x59, 0xf2, 0x74, 0x40, 0x05,
-	0x75, 0xfe, 0x65, 0xc2, 0xf2, 0x21, 0xa3, 0xd9, 0xf8, 0xf8, 0xf2, 0x01, 0xfb, 0x0a, 0xf4, 0x33,
-	0xc6, 0xf3, 0x50, 0x78, 0x63, 0x75, 0xcd, 0x0f, 0x07, 0x3a, 0x6e, 0x57, 0x94, 0x7c, 0xb7, 0x10,
-	0x97, 0xa0, 0x9a, 0xe7, 0x80, 0xda, 0x5c, 0x00, 0xaa, 0x03, 0xbd, 0x1a, 0x82, 0xdc, 0x6e, 0xa1,
-	0xeb, 0x33, 0x32, 0xd2, 0x07, 0xd3, 0xe7, 0x21, 0xc6, 0xab, 0xeb, 0xca, 0x4f, 0x72, 0x1b, 0x56,
-	0xd3, 0x90, 0x8e, 0xd9, 0x71, 0x12, 0xfa, 0x2c, 0xf3, 0x26, 0x59, 0x92, 0xa7, 0x18, 0xb3, 0x9e,
-	0xdb, 0xaf, 0x29, 0x1e, 0x4a, 0x39, 0x79, 0x07, 0x3a, 0x3e, 0x0f, 0x3d, 0x31, 0x4d, 0x19, 0x06,
-	0x6d, 0xe5, 0x0c, 0xdf, 0x07, 0x3c, 0x7c, 0x3c, 0x4d, 0x99, 0xdb, 0xf6, 0xd5, 0x07, 0xb9, 0x0b,
-	0xeb, 0x9c, 0x65, 0x01, 0x0d, 0x83, 0x4f, 0x98, 0xef, 0xb1, 0x67, 0x69, 0xe6, 0xa5, 0x21, 0x8d,
-	0x31, 0xb2, 0x3d, 0x97, 0x54, 0xba, 0xf7, 0x9f, 0xa5, 0xd9, 0x41, 0x48, 0x63, 0xb2, 0x05, 0xfd,
-	0x24, 0x17, 0x69, 0x2e, 0x3c, 0xcc, 0x3e, 0xee, 0x05, 0x3e, 0x06, 0xda, 0x74, 0x57, 0x94, 0xfc,
-	0xbb, 0x28, 0x1e, 0xfa, 0x12, 0x5a, 0x91, 0xd1, 0x13, 0x16, 0x7a, 0x25, 0x03, 0x6c, 0x6b, 0xd3,
-	0xd8, 0x6a, 0xba, 0x57, 0x94, 0xfc, 0x71, 0x21, 0x26, 0xdb, 0xb0, 0x36, 0xc9, 0x69, 0x46, 0x63,
-	0xc1, 0x58, 0xcd, 0xba, 0x87, 0xd6, 0xa4, 0x54, 0x95, 0x13, 0x9c, 0x5f, 0x37, 0xab, 0xd0, 0xcb,
-	0x28, 0xf1, 0x4b, 0x84, 0xfe, 0x32, 0xdd, 0xfc, 0x42, 0xbe, 0x98, 0x8b, 0xf9, 0x72, 0x0b, 0xac,
-	0x88, 0x89, 0x2c, 0x18, 0xab, 0xb8, 0xa8, 0x84, 0x06, 0x25, 0x42, 0xf0, 0x6f, 0x81, 0x15, 0xe7,
-	0x91, 0xf7, 0x71, 0xce, 0xb2, 0x80, 0x71, 0x5d, 0x0f, 0x21, 0xce, 0xa3, 0x1f, 0x28, 0x09, 0x59,
-	0x83, 0x96, 0x48, 0x52, 0xef, 0x49, 0x91, 0xc7, 0x22, 0x49, 0x1f, 0x91, 0x6f, 0xc3, 0x75, 0xce,
-	0x68, 0xc8, 0x7c, 0xaf, 0xcc, 0x3b, 0xee, 0x71, 0xc4, 0x82, 0xf9, 0x76, 0x1b, 0x43, 0x61, 0x2b,
-	0x8b, 0xc3, 0xd2, 0xe0, 0x50, 0xeb, 0x25, 0xd2, 0xe5, 0xc1, 0x6b, 0xd3, 0x3a, 0xd8, 0xf2, 0x92,
-	0x4a, 0x55, 0x4e, 0x78, 0x17, 0xec, 0x49, 0x98, 0x8c, 0x68, 0xe8, 0x9d, 0xda, 0x15, 0x7b, 0x6b,
-	0xd3, 0xbd, 0xa6, 0xf4, 0x87, 0x73, 0x5b, 0x4a, 0xf7, 0x78, 0x18, 0x8c, 0x99, 0xef, 0x8d, 0xc2,
-	0x64, 0x64, 0x03, 0x52, 0x0a, 0x94, 0x48, 0x26, 0xb2, 0xa4, 0x92, 0x36, 0x90, 0x30, 0x8c, 0x93,
-	0x3c, 0x16, 0x48, 0x10, 0xd3, 0x5d, 0x51, 0xf2, 0xfd, 0x3c, 0xda, 0x95, 0x52, 0xf2, 0x06, 0x2c,
-	0x6b, 0xcb, 0xe4, 0xe8, 0x88, 0x33, 0x81, 0xcc, 0x30, 0xdd, 0x9e, 0x12, 0x7e, 0x1f, 0x65, 0xce,
-	0x4f, 0x4d, 0xb8, 0xe2, 0x4a, 0x74, 0xd9, 0x09, 0xfb, 0x9f, 0x2f, 0x08, 0x67, 0x25, 0xe6, 0xd2,
-	0x0b, 0x25, 0x66, 0xfb, 0xc2, 0x89, 0xd9, 0x79, 0xa1, 0xc4, 0xec, 0x9e, 0x99, 0x98, 0x7f, 0x9a,
-	0x09, 0xc2, 0xcb, 0x9a, 0x9a, 0x6f, 0x81, 0x19, 0xf8, 0xaa, 0x81, 0xb2, 0x76, 0xec, 0xd9, 0xc5,
-	0xf5, 0x43, 0xd7, 0x70, 0xc0, 0x5d, 0x69, 0x44, 0xee, 0x81, 0xa5, 0x01, 0xc5, 0xeb, 0xa9, 0x85,
-	0xd7, 0xd3, 0x6b, 0x0b, 0xe7, 0x20, 0xc2, 0xf2, 0x6a, 0x72, 0x55, 0x03, 0xc4, 0xe5, 0x37, 0xf9,
-	0x0e, 0xdc, 0x38, 0x9d, 0xb0, 0x99, 0xc6, 0xc8, 0xb7, 0x97, 0x30, 0x46, 0x1b, 0xf3, 0x19, 0x5b,
-	0x80, 0xe8, 0x93, 0xaf, 0xc1, 0x7a, 0x2d, 0x65, 0xab, 0x89, 0x6d, 0xf5, 0xcb, 0xb6, 0xd2, 0x55,
-	0x53, 0xce, 0x4b, 0xda, 0xce, 0x79, 0x49, 0xeb, 0xfc, 0xb3, 0x01, 0xcb, 0x03, 0x16, 0x32, 0xc1,
-	0xbe, 0x68, 0x82, 0xce, 0x6c, 0x82, 0x5e, 0x87, 0x5e, 0x9a, 0x05, 0x11, 0xcd, 0xa6, 0xde, 0x13,
-	0x36, 0x2d, 0xea, 0xa0, 0xa5, 0x65, 0x8f, 0xd8, 0x94, 0x3f, 0xaf, 0x13, 0x72, 0xfe, 0x6d, 0x40,
-	0xf7, 0x83, 0x84, 0xfa, 0xd8, 0xac, 0x5f, 0x12, 0xe3, 0xb2, 0x0f, 0x6b, 0xcc, 0xf7, 0x61, 0x37,
-	0xa1, 0xea, 0xb7, 0x35, 0xca, 0xb5, 0x06, 0xbc, 0xd6, 0x48, 0x37, 0x67, 0x1b, 0xe9, 0x5b, 0x60,
-	0x05, 0xf2, 0x40, 0x5e, 0x4a, 0xc5, 0xb1, 0x2a, 0x4c, 0x5d, 0x17, 0x50, 0x74, 0x20, 0x25, 0xb2,
-	0xd3, 0x2e, 0x0c, 0xb0, 0xd3, 0x5e, 0xba, 0x70, 0xa7, 0xad, 0x17, 0xc1, 0x4e, 0xfb, 0xaf, 0x0d,
-	0xb0, 0x35, 0xe7, 0xaa, 0xc7, 0xc6, 0x0f, 0x53, 0x1f, 0xdf, 0x3c, 0x6f, 0x42, 0xb7, 0xe4, 0xa3,
-	0x7e, 0xeb, 0xab, 0x04, 0x12, 0xd7, 0x3d, 0x16, 0x25, 0xd9, 0xf4, 0x30, 0xf8, 0x84, 0x69, 0xc7,
-	0x6b, 0x12, 0xe9, 0xdb, 0x7e, 0x1e, 0xb9, 0xc9, 0x53, 0xae, 0xcb, 0x72, 0x31, 0x94, 0xbe, 0x8d,
-	0xf1, 0xf7, 0x11, 0xd6, 0x31, 0xf4, 0xbc, 0xe9, 0x82, 0x12, 0xc9, 0xfa, 0x45, 0x36, 0xa0, 0xc3,
-	0x62, 0x5f, 0x69, 0x5b, 0xa8, 0x6d, 0xb3, 0xd8, 0x47, 0xd5, 0x10, 0x56, 0xf4, 0x23, 0x63, 0xc2,
-	0x91, 0x04, 0x48, 0x2a, 0x6b, 0xc7, 0x39, 0xe3, 0x65, 0x77, 0x8f, 0x4f, 0x0e, 0xb4, 0xa5, 0xbb,
-	0xac, 0xde, 0x19, 0xf5, 0x90, 0xbc, 0x0f, 0x3d, 0xb9, 0x4b, 0xb9, 0x50, 0xfb, 0xc2, 0x0b, 0x59,
-	0x2c, 0xf6, 0x8b, 0x81, 0xf3, 0x1b, 0x03, 0x56, 0x4f, 0x41, 0x78, 0x09, 0x1e, 0x3d, 0x82, 0xce,
-	0x21, 0x9b, 0xc8, 0x25, 0x8a, 0xa7, 0xd3, 0xed, 0xb3, 0x5e, 0xe2, 0xcf, 0x08, 0x98, 0x5b, 0x2e,
-	0xe0, 0xfc, 0xcc, 0x00, 0x40, 0x42, 0xe3, 0xf0, 0x14, 0x59, 0x8c, 0xcb, 0x90, 0x45, 0xde, 0x84,
-	0xb2, 0x3d, 0xc8, 0x58, 0x48, 0x45, 0x55, 0xc9, 0xb8, 0x8e, 0x3d, 0x89, 0xf3, 0xc8, 0x55, 0x2a,
-	0x7d, 0x40, 0xee, 0xfc, 0xca, 0x00, 0xc0, 0x52, 0xac, 0x8e, 0x31, 0x9f, 0xf3, 0xc6, 0xf9, 0xbf,
-	0x2d, 0x1b, 0xb3, 0x29, 0xf1, 0xa0, 0x48, 0x09, 0x8e, 0x18, 0x99, 0x8b, 0x7c, 0x28, 0x31, 0xaa,
-	0x9c, 0xd7, 0x59, 0xa3, 0x70, 0xf9, 0xad, 0x01, 0xbd, 0x1a, 0x7c, 0x7c, 0x36, 0x7b, 0x8d, 0xf9,
-	0xec, 0xc5, 0xc6, 0x51, 0x32, 0xda, 0xe3, 0x35, 0x92, 0x47, 0x15, 0xc9, 0x37, 0xa0, 0x83, 0x90,
-	0xd4, 0x58, 0x1e, 0x6b, 0x96, 0xdf, 0x86, 0xd5, 0x8c, 0x8d, 0x59, 0x2c, 0xc2, 0xa9, 0x17, 0x25,
-	0x7e, 0x70, 0x14, 0x30, 0x1f, 0xb9, 0xde, 0x71, 0xfb, 0x85, 0x62, 0x4f, 0xcb, 0x9d, 0xbf, 0x1b,
-	0xb0, 0x22, 0x7b, 0xcd, 0xe9, 0x7e, 0xe2, 0x33, 0x75, 0xb2, 0x17, 0x67, 0xd0, 0x7b, 0xe8, 0x8b,
-	0x86, 0x47, 0x51, 0xe8, 0x8d, 0xe7, 0x53, 0x88, 0xbb, 0x1d, 0xae, 0x69, 0x23, 0x21, 0x56, 0xef,
-	0x05, 0x17, 0x81, 0xb8, 0x0a, 0xac, 0xbe, 0x64, 0x15, 0xc4, 0x3f, 0x31, 0xc0, 0xaa, 0x25, 0x8b,
-	0x2c, 0xd1, 0xfa, 0x62, 0x54, 0x37, 0x84, 0x81, 0x45, 0xd0, 0x1a, 0x57, 0x6f, 0xb9, 0x64, 0x1d,
-	0x5a, 0x11, 0x9f, 0xe8, 0x88, 0xf7, 0x5c, 0x35, 0x20, 0xd7, 0xa1, 0x13, 0xf1, 0x09, 0xfe, 0xac,
-	0xd2, 0x95, 0xb3, 0x1c, 0xcb, 0xb0, 0x55, 0x3d, 0x90, 0x2a, 0x20, 0x95, 0xc0, 0xf9, 0xa3, 0x01,
-	0x44, 0xb7, 0x18, 0x9f, 0xea, 0xc1, 0x1f, 0x09, 0x5b, 0x7f, 0x8f, 0x6e, 0x60, 0x19, 0x9e, 0x91,
-	0xcd, 0xdd, 0x2f, 0xe6, 0xa9, 0x5f, 0xda, 0xb7, 0x61, 0xd5, 0x67, 0x47, 0x54, 0x76, 0x43, 0xf3,
-	0x47, 0xee, 0x6b, 0x45, 0xd9, 0xb4, 0xbd, 0xf5, 0x2e, 0x74, 0xcb, 0xff, 0xd9, 0x48, 0x1f, 0x7a,
-	0xc3, 0x38, 0x10, 0xd8, 0x5e, 0x06, 0xf1, 0xa4, 0xff, 0x7f, 0xc4, 0x82, 0xf6, 0xf7, 0x18, 0x0d,
-	0xc5, 0xf1, 0xb4, 0x6f, 0x90, 0x1e, 0x74, 0xee, 0x8f, 0xe2, 0x24, 0x8b, 0x68, 0xd8, 0x6f, 0x3c,
-	0x78, 0xe7, 0x47, 0xdf, 0x98, 0x04, 0xe2, 0x38, 0x1f, 0x49, 0x4f, 0xb6, 0x95, 0x6b, 0x5f, 0x0d,
-	0x12, 0xfd, 0xb5, 0x5d, 0x44, 0x6d, 0x1b, 0xbd, 0x2d, 0x87, 0xe9, 0x68, 0xb4, 0x84, 0x92, 0xb7,
-	0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x62, 0xb9, 0xd2, 0xdf, 0x8d, 0x1c, 0x00, 0x00,
+	// 2131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x73, 0x23, 0x47,
+	0x15, 0x67, 0x34, 0xb2, 0x25, 0xbd, 0x91, 0xb5, 0x72, 0xdb, 0xbb, 0x19, 0xef, 0x47, 0x56, 0x3b,
+	0x09, 0x60, 0xb2, 0x85, 0xbd, 0x38, 0x84, 0xa4, 0x28, 0x8a, 0xcd, 0xae, 0x15, 0x16, 0xd5, 0xc6,
+	0xc6, 0x8c, 0x9c, 0x54, 0xc1, 0x65, 0xaa, 0x35, 0xd3, 0x96, 0x87, 0x9d, 0xaf, 0x4c, 0xf7, 0xd8,
+	0x56, 0x4e, 0x1c, 0x38, 0x41, 0xc1, 0x81, 0x2a, 0x8a, 0x13, 0xfc, 0x09, 0x5c, 0x39, 0xf1, 0x51,
+	0x9c, 0x72, 0xe5, 0x08, 0x77, 0xfe, 0x09, 0x4e, 0x54, 0x7f, 0xcc, 0x87, 0x64, 0xd9, 0xab, 0x75,
+	0x2a, 0x64, 0x53, 0x95, 0xdb, 0xf4, 0x7b, 0xaf, 0x3f, 0xde, 0xef, 0xfd, 0xfa, 0xf5, 0xeb, 0x1e,
+	0xe8, 0xf8, 0x11, 0x23, 0x69, 0x84, 0x83, 0xad, 0x24, 0x8d, 0x59, 0x8c, 0xae, 0x87, 0x7e, 0x70,
+	0x92, 0x51, 0xd9, 0xda, 0xca, 0x95, 0x37, 0xdb, 0x6e, 0x1c, 0x86, 0x71, 0x24, 0xc5, 0x37, 0xdb,
+	0xd4, 0x3d, 0x26, 0x21, 0x96, 0x2d, 0xeb, 0xaf, 0x1a, 0xac, 0xec, 0xc6, 0x61, 0x12, 0x47, 0x24,
+	0x62, 0x83, 0xe8, 0x28, 0x46, 0x37, 0x60, 0x39, 0x8a, 0x3d, 0x32, 0xe8, 0x9b, 0x5a, 0x4f, 0xdb,
+	0xd4, 0x6d, 0xd5, 0x42, 0x08, 0xea, 0x69, 0x1c, 0x10, 0xb3, 0xd6, 0xd3, 0x36, 0x5b, 0xb6, 0xf8,
+	0x46, 0x0f, 0x01, 0x28, 0xc3, 0x8c, 0x38, 0x6e, 0xec, 0x11, 0x53, 0xef, 0x69, 0x9b, 0x9d, 0x9d,
+	0xde, 0xd6, 0xdc, 0x55, 0x6c, 0x0d, 0xb9, 0xe1, 0x6e, 0xec, 0x11, 0xbb, 0x45, 0xf3, 0x4f, 0xf4,
+	0x2e, 0x00, 0x39, 0x63, 0x29, 0x76, 0xfc, 0xe8, 0x28, 0x36, 0xeb, 0x3d, 0x7d, 0xd3, 0xd8, 0xb9,
+	0x37, 0x3d, 0x80, 0x5a, 0xfc, 0x53, 0x32, 0xf9, 0x10, 0x07, 0x19, 0x39, 0xc0, 0x7e, 0x6a, 0xb7,
+	0x44, 0x27, 0xbe, 0x5c, 0xeb, 0x5f, 0x1a, 0x5c, 0x2b, 0x1c, 0x10, 0x73, 0x50, 0xf4, 0x5d, 0x58,
+	0x12, 0x53, 0x08, 0x0f, 0x8c, 0x9d, 0xd7, 0x2f, 0x58, 0xd1, 0x94, 0xdf, 0xb6, 0xec, 0x82, 0x3e,
+	0x80, 0x35, 0x9a, 0x8d, 0xdc, 0x5c, 0xe5, 0x08, 0x29, 0x35, 0x6b, 0x3d, 0x7d, 0xe1, 0x91, 0x50,
+	0x75, 0x00, 0xb5, 0xa4, 0x37, 0x61, 0x99, 0x8f, 0x94, 0x51, 0x81, 0x92, 0xb1, 0x73, 0x6b, 0xae,
+	0x93, 0x43, 0x61, 0x62, 0x2b, 0x53, 0xeb, 0x16, 0x6c, 0x3c, 0x21, 0x6c, 0xc6, 0x3b, 0x9b, 0x7c,
+	0x94, 0x11, 0xca, 0x94, 0xf2, 0xd0, 0x0f, 0xc9, 0xa1, 0xef, 0x3e, 0xdb, 0x3d, 0xc6, 0x51, 0x44,
+	0x82, 0x5c, 0x79, 0x07, 0x6e, 0x3d, 0x21, 0xa2, 0x83, 0x4f, 0x99, 0xef, 0xd2, 0x19, 0xf5, 0x75,
+	0x58, 0x7b, 0x42, 0x58, 0xdf, 0x9b, 0x11, 0x7f, 0x08, 0xcd, 0x7d, 0x1e, 0x6c, 0x4e, 0x83, 0xef,
+	0x40, 0x03, 0x7b, 0x5e, 0x4a, 0x28, 0x55, 0x28, 0xde, 0x9e, 0xbb, 0xe2, 0x47, 0xd2, 0xc6, 0xce,
+	0x8d, 0xe7, 0xd1, 0xc4, 0xfa, 0x19, 0xc0, 0x20, 0xf2, 0xd9, 0x01, 0x4e, 0x71, 0x48, 0x2f, 0x24,
+	0x58, 0x1f, 0xda, 0x94, 0xe1, 0x94, 0x39, 0x89, 0xb0, 0x33, 0x6b, 0x8b, 0xb2, 0xc1, 0x10, 0xdd,
+	0xe4, 0xe8, 0xd6, 0x4f, 0x00, 0x86, 0x2c, 0xf5, 0xa3, 0xf1, 0xfb, 0x3e, 0x65, 0x7c, 0xae, 0x13,
+	0x6e, 0xc7, 0x9d, 0xd0, 0x37, 0x5b, 0xb6, 0x6a, 0x55, 0xc2, 0x51, 0x5b, 0x3c, 0x1c, 0x0f, 0xc1,
+	0xc8, 0xe1, 0xde, 0xa3, 0x63, 0xf4, 0x00, 0xea, 0x23, 0x4c, 0xc9, 0xa5, 0xf0, 0xec, 0xd1, 0xf1,
+	0x63, 0x4c, 0x89, 0x2d, 0x2c, 0xad, 0x5f, 0xea, 0xf0, 0xca, 0x6e, 0x4a, 0x04, 0xf9, 0x83, 0x80,
+	0xb8, 0xcc, 0x8f, 0x23, 0x85, 0xfd, 0x8b, 0x8f, 0x86, 0x5e, 0x81, 0x86, 0x37, 0x72, 0x22, 0x1c,
+	0xe6, 0x60, 0x2f, 0x7b, 0xa3, 0x7d, 0x1c, 0x12, 0xf4, 0x35, 0xe8, 0xb8, 0xc5, 0xf8, 0x5c, 0x22,
+	0x38, 0xd7, 0xb2, 0x67, 0xa4, 0xe8, 0x75, 0x58, 0x49, 0x70, 0xca, 0xfc, 0xc2, 0xac, 0x2e, 0xcc,
+	0xa6, 0x85, 0x3c, 0xa0, 0xde, 0x68, 0xd0, 0x37, 0x97, 0x44, 0xb0, 0xc4, 0x37, 0xb2, 0xa0, 0x5d,
+	0x8e, 0x35, 0xe8, 0x9b, 0xcb, 0x42, 0x37, 0x25, 0x43, 0x3d, 0x30, 0x8a, 0x81, 0x06, 0x7d, 0xb3,
+	0x21, 0x4c, 0xaa, 0x22, 0x1e, 0x1c, 0x99, 0x8b, 0xcc, 0x66, 0x4f, 0xdb, 0x6c, 0xdb, 0xaa, 0x85,
+	0x1e, 0xc0, 0xda, 0x89, 0x9f, 0xb2, 0x0c, 0x07, 0x8a, 0x9f, 0x7c, 0x1d, 0xd4, 0x6c, 0x89, 0x08,
+	0xce, 0x53, 0xa1, 0x1d, 0x58, 0x4f, 0x8e, 0x27, 0xd4, 0x77, 0x67, 0xba, 0x80, 0xe8, 0x32, 0x57,
+	0x67, 0xfd, 0x43, 0x83, 0xeb, 0xfd, 0x34, 0x4e, 0x5e, 0x8a, 0x50, 0xe4, 0x20, 0xd7, 0x2f, 0x01,
+	0x79, 0xe9, 0x3c, 0xc8, 0xd6, 0xaf, 0x6b, 0x70, 0x43, 0x32, 0xea, 0x20, 0x07, 0xf6, 0x33, 0xf0,
+	0xe2, 0xeb, 0x70, 0xad, 0x9c, 0xd5, 0x89, 0x2e, 0x76, 0xe3, 0xab, 0xd0, 0x29, 0x02, 0x2c, 0xed,
+	0xfe, 0xbf, 0x94, 0xb2, 0x7e, 0x55, 0x83, 0x75, 0x1e, 0xd4, 0x2f, 0xd1, 0xe0, 0x68, 0xfc, 0x51,
+	0x03, 0x24, 0xd9, 0xf1, 0x28, 0xf0, 0x31, 0xfd, 0x3c, 0xb1, 0x58, 0x87, 0x25, 0xcc, 0xd7, 0xa0,
+	0x20, 0x90, 0x0d, 0x8b, 0x42, 0x97, 0x47, 0xeb, 0xb3, 0x5a, 0x5d, 0x31, 0xa9, 0x5e, 0x9d, 0xf4,
+	0x0f, 0x1a, 0xac, 0x3e, 0x0a, 0x18, 0x49, 0x5f, 0x52, 0x50, 0xfe, 0x56, 0xcb, 0xa3, 0x36, 0x88,
+	0x3c, 0x72, 0xf6, 0x79, 0x2e, 0xf0, 0x0e, 0xc0, 0x91, 0x4f, 0x02, 0xaf, 0xca, 0xde, 0x96, 0x90,
+	0x7c, 0x2a, 0xe6, 0x9a, 0xd0, 0x10, 0x83, 0x14, 0xac, 0xcd, 0x9b, 0xbc, 0x06, 0x90, 0xf5, 0xa0,
+	0xaa, 0x01, 0x9a, 0x0b, 0xd7, 0x00, 0xa2, 0x9b, 0xaa, 0x01, 0xfe, 0xa4, 0xc3, 0xca, 0x20, 0xa2,
+	0x24, 0x65, 0x57, 0x07, 0xef, 0x36, 0xb4, 0xe8, 0x31, 0x4e, 0xbd, 0xfd, 0x12, 0xbe, 0x52, 0x50,
+	0x85, 0x56, 0x7f, 0x1e, 0xb4, 0xf5, 0x05, 0x93, 0xc3, 0xd2, 0x65, 0xc9, 0x61, 0xf9, 0x12, 0x88,
+	0x1b, 0xcf, 0x4f, 0x0e, 0xcd, 0xf3, 0xa7, 0x2f, 0x77, 0x90, 0x8c, 0x43, 0x5e, 0xb4, 0xf6, 0xcd,
+	0x96, 0xd0, 0x97, 0x02, 0xf4, 0x2a, 0x00, 0xf3, 0x43, 0x42, 0x19, 0x0e, 0x13, 0x79, 0x8e, 0xd6,
+	0xed, 0x8a, 0x84, 0x9f, 0xdd, 0x69, 0x7c, 0x3a, 0xe8, 0x53, 0xd3, 0xe8, 0xe9, 0xbc, 0x88, 0x93,
+	0x2d, 0xf4, 0x6d, 0x68, 0xa6, 0xf1, 0xa9, 0xe3, 0x61, 0x86, 0xcd, 0xb6, 0x08, 0xde, 0xc6, 0x5c,
+	0xb0, 0x1f, 0x07, 0xf1, 0xc8, 0x6e, 0xa4, 0xf1, 0x69, 0x1f, 0x33, 0x6c, 0xfd, 0xbe, 0x0e, 0x2b,
+	0x43, 0x82, 0x53, 0xf7, 0xf8, 0xea, 0x01, 0xfb, 0x06, 0x74, 0x53, 0x42, 0xb3, 0x80, 0x39, 0xae,
+	0x3c, 0xe6, 0x07, 0x7d, 0x15, 0xb7, 0x6b, 0x52, 0xbe, 0x9b, 0x8b, 0x0b, 0x50, 0xf5, 0x4b, 0x40,
+	0xad, 0xcf, 0x01, 0xd5, 0x82, 0x76, 0x05, 0x41, 0x6a, 0x2e, 0x09, 0xd7, 0xa7, 0x64, 0xa8, 0x0b,
+	0xba, 0x47, 0x03, 0x11, 0xaf, 0x96, 0xcd, 0x3f, 0xd1, 0x7d, 0x58, 0x4d, 0x02, 0xec, 0x92, 0xe3,
+	0x38, 0xf0, 0x48, 0xea, 0x8c, 0xd3, 0x38, 0x4b, 0x44, 0xcc, 0xda, 0x76, 0xb7, 0xa2, 0x78, 0xc2,
+	0xe5, 0xe8, 0x6d, 0x68, 0x7a, 0x34, 0x70, 0xd8, 0x24, 0x21, 0x22, 0x68, 0x9d, 0x0b, 0x7c, 0xef,
+	0xd3, 0xe0, 0x70, 0x92, 0x10, 0xbb, 0xe1, 0xc9, 0x0f, 0xf4, 0x00, 0xd6, 0x29, 0x49, 0x7d, 0x1c,
+	0xf8, 0x1f, 0x13, 0xcf, 0x21, 0x67, 0x49, 0xea, 0x24, 0x01, 0x8e, 0x44, 0x64, 0xdb, 0x36, 0x2a,
+	0x75, 0xef, 0x9d, 0x25, 0xe9, 0x41, 0x80, 0x23, 0xb4, 0x09, 0xdd, 0x38, 0x63, 0x49, 0xc6, 0x1c,
+	0xb1, 0xfb, 0xa8, 0xe3, 0x7b, 0x22, 0xd0, 0xba, 0xdd, 0x91, 0xf2, 0x1f, 0x08, 0xf1, 0xc0, 0xe3,
+	0xd0, 0xb2, 0x14, 0x9f, 0x90, 0xc0, 0x29, 0x18, 0x60, 0x1a, 0x3d, 0x6d, 0xb3, 0x6e, 0x5f, 0x93,
+	0xf2, 0xc3, 0x5c, 0x8c, 0xb6, 0x61, 0x6d, 0x9c, 0xe1, 0x14, 0x47, 0x8c, 0x90, 0x8a, 0x75, 0x5b,
+	0x58, 0xa3, 0x42, 0x55, 0x76, 0xb8, 0x03, 0xe0, 0xf3, 0x34, 0x27, 0xf7, 0xc0, 0x8a, 0xdc, 0x68,
+	0x42, 0xc2, 0xf9, 0x6f, 0xfd, 0x5b, 0x03, 0x90, 0xcc, 0xd8, 0x8d, 0x65, 0x3d, 0x3f, 0xf7, 0xee,
+	0xb0, 0x05, 0x6b, 0xa7, 0xd8, 0x67, 0x0e, 0xa3, 0xf8, 0x88, 0x38, 0x5e, 0x96, 0x62, 0x1e, 0x0f,
+	0x11, 0x7f, 0xdd, 0x5e, 0xe5, 0xaa, 0x43, 0xae, 0xe9, 0x2b, 0x05, 0xdf, 0xa6, 0x54, 0x8c, 0x5a,
+	0xda, 0x4a, 0x32, 0x74, 0xa4, 0xb8, 0x6a, 0x98, 0x12, 0x2f, 0x73, 0x2b, 0x83, 0x4a, 0x66, 0x74,
+	0xa4, 0xb8, 0x30, 0xdc, 0x81, 0xeb, 0x51, 0x16, 0x3a, 0x6a, 0x07, 0x51, 0x47, 0x8e, 0x43, 0x3c,
+	0x95, 0x1c, 0xd7, 0xa2, 0x2c, 0x1c, 0x2a, 0xdd, 0x50, 0xa9, 0xac, 0x4f, 0x2a, 0xb4, 0xe7, 0x0c,
+	0xa5, 0x57, 0xa0, 0xfd, 0x55, 0x6e, 0x32, 0x73, 0xf7, 0x8a, 0x3e, 0x7f, 0xaf, 0xdc, 0x05, 0x23,
+	0x24, 0x2c, 0xf5, 0x5d, 0xc9, 0x49, 0x99, 0xcc, 0x40, 0x8a, 0x04, 0xf1, 0xee, 0x82, 0xc1, 0x1d,
+	0xff, 0x28, 0x23, 0xa9, 0x4f, 0xa8, 0x72, 0x17, 0xa2, 0x2c, 0xfc, 0xb1, 0x94, 0xa0, 0x35, 0x58,
+	0x62, 0x71, 0xe2, 0x3c, 0xcb, 0x73, 0x18, 0x8b, 0x93, 0xa7, 0xe8, 0x7b, 0x70, 0x93, 0x12, 0x1c,
+	0x10, 0xcf, 0x29, 0x72, 0x4e, 0x05, 0xb3, 0x86, 0xa0, 0xa1, 0x29, 0x2d, 0x86, 0x85, 0x41, 0x0e,
+	0x1c, 0x67, 0x59, 0xb1, 0xf0, 0x4a, 0xb7, 0xa6, 0x28, 0xf7, 0x51, 0xa9, 0x2a, 0x3a, 0xbc, 0x03,
+	0xe6, 0x38, 0x88, 0x47, 0x38, 0x70, 0xce, 0xcd, 0x2a, 0xee, 0x15, 0xba, 0x7d, 0x43, 0xea, 0x87,
+	0x33, 0x53, 0x72, 0xf7, 0x68, 0xe0, 0xbb, 0xc4, 0x73, 0x46, 0x41, 0x3c, 0x32, 0x41, 0x6c, 0x27,
+	0x90, 0x22, 0x9e, 0xc4, 0xf8, 0x36, 0x52, 0x06, 0x1c, 0x06, 0x37, 0xce, 0x22, 0x66, 0x1a, 0x8a,
+	0x4b, 0x42, 0xbe, 0x9f, 0x85, 0xbb, 0x5c, 0x8a, 0x5e, 0x83, 0x15, 0x65, 0x19, 0x1f, 0x1d, 0x51,
+	0xc2, 0xc4, 0xae, 0xd0, 0xed, 0xb6, 0x14, 0xfe, 0x48, 0xc8, 0xd0, 0x5b, 0x50, 0x77, 0x63, 0xca,
+	0xc4, 0x4e, 0x38, 0x77, 0xf2, 0x95, 0x8f, 0x29, 0xc5, 0x96, 0xb0, 0x85, 0xb9, 0xf5, 0x4f, 0x1d,
+	0xae, 0xd9, 0x3c, 0x28, 0xe4, 0x84, 0x7c, 0xe1, 0x73, 0xe8, 0x45, 0xb9, 0x6c, 0xf9, 0x85, 0x72,
+	0x59, 0x63, 0xe1, 0x5c, 0xd6, 0x7c, 0xa1, 0x5c, 0xd6, 0xba, 0x30, 0x97, 0xbd, 0x01, 0xba, 0xef,
+	0x51, 0xc1, 0x11, 0x63, 0xc7, 0x9c, 0xc6, 0x5b, 0xbd, 0xba, 0x0d, 0xfa, 0xd4, 0xe6, 0x46, 0xe8,
+	0x1e, 0xb4, 0xbd, 0x30, 0xc8, 0x71, 0x96, 0xc7, 0x68, 0xcb, 0x36, 0xbc, 0x30, 0xbf, 0xa5, 0x52,
+	0xeb, 0x2f, 0x53, 0x31, 0x7d, 0x59, 0x13, 0x84, 0x72, 0xba, 0xbe, 0x88, 0xd3, 0x0f, 0xc1, 0x50,
+	0xf1, 0x11, 0x05, 0xc2, 0x92, 0x28, 0x10, 0x5e, 0x9d, 0xdb, 0x47, 0x04, 0x8c, 0x17, 0x07, 0xb6,
+	0x2c, 0x41, 0x29, 0xff, 0x46, 0xdf, 0x87, 0x5b, 0xe7, 0xd3, 0x46, 0xaa, 0x30, 0xf2, 0xcc, 0x65,
+	0x11, 0xf2, 0x8d, 0xd9, 0xbc, 0x91, 0x83, 0xe8, 0xa1, 0x6f, 0xc1, 0x7a, 0x25, 0x71, 0x94, 0x1d,
+	0x1b, 0xf2, 0x6d, 0xa1, 0xd4, 0x95, 0x5d, 0x2e, 0x4b, 0x1d, 0xcd, 0xcb, 0x52, 0x87, 0xf5, 0x9f,
+	0x1a, 0xac, 0xf4, 0x49, 0x40, 0x18, 0xf9, 0xb2, 0x0c, 0xbd, 0xb0, 0x0c, 0xbd, 0x07, 0xed, 0x24,
+	0xf5, 0x43, 0x9c, 0x4e, 0x9c, 0x67, 0x64, 0x92, 0x67, 0x63, 0x43, 0xc9, 0x9e, 0x92, 0x09, 0x7d,
+	0x5e, 0x2d, 0x6a, 0xfd, 0x57, 0x83, 0xd6, 0xfb, 0x31, 0xf6, 0xc4, 0x75, 0xe9, 0x8a, 0x18, 0x17,
+	0x95, 0x70, 0x6d, 0xb6, 0x12, 0xbe, 0x0d, 0xe5, 0x8d, 0x47, 0xa1, 0x5c, 0x0a, 0xaa, 0x57, 0x99,
+	0xfa, 0xf4, 0x55, 0xe6, 0x2e, 0x18, 0xb2, 0xb0, 0x49, 0x30, 0x3b, 0x96, 0x79, 0xae, 0x65, 0xcb,
+	0x5a, 0xe7, 0x80, 0x4b, 0xf8, 0x5d, 0x27, 0x37, 0x10, 0x77, 0x9d, 0xe5, 0x85, 0xef, 0x3a, 0x6a,
+	0x10, 0x71, 0xd7, 0xf9, 0x7b, 0x0d, 0x4c, 0xc5, 0xb9, 0xf2, 0xb9, 0xf7, 0x83, 0xc4, 0x13, 0xaf,
+	0xce, 0xb7, 0xa1, 0x55, 0xf0, 0x51, 0x55, 0x4c, 0xa5, 0x80, 0xe3, 0xba, 0x47, 0xc2, 0x38, 0x9d,
+	0x0c, 0xfd, 0x8f, 0x89, 0x72, 0xbc, 0x22, 0xe1, 0xbe, 0xed, 0x67, 0xa1, 0x1d, 0x9f, 0x52, 0x95,
+	0xe5, 0xf3, 0x26, 0xf7, 0xcd, 0x15, 0x37, 0x54, 0x91, 0x16, 0x85, 0xe7, 0x75, 0x1b, 0xa4, 0x88,
+	0xa7, 0x43, 0xb4, 0x01, 0x4d, 0x12, 0x79, 0x52, 0xbb, 0x24, 0xb4, 0x0d, 0x12, 0x79, 0x42, 0x35,
+	0x80, 0x8e, 0x7a, 0xe6, 0x8d, 0xa9, 0x20, 0x81, 0x20, 0x95, 0xb1, 0x63, 0x5d, 0x70, 0xd4, 0xed,
+	0xd1, 0xf1, 0x81, 0xb2, 0xb4, 0x57, 0xe4, 0x4b, 0xaf, 0x6a, 0xa2, 0xf7, 0xa0, 0xcd, 0x67, 0x29,
+	0x06, 0x6a, 0x2c, 0x3c, 0x90, 0x41, 0x22, 0x2f, 0x6f, 0x58, 0xbf, 0xd5, 0x60, 0xf5, 0x1c, 0x84,
+	0x57, 0xe0, 0xd1, 0x53, 0x68, 0x0e, 0xc9, 0x98, 0x0f, 0x91, 0x3f, 0x5e, 0x6f, 0x5f, 0x78, 0x7c,
+	0xcf, 0x0f, 0x98, 0x5d, 0x0c, 0x60, 0xfd, 0x42, 0xe3, 0x8f, 0xe6, 0x1e, 0x39, 0x13, 0xcd, 0x73,
+	0x64, 0xd1, 0xae, 0x42, 0x16, 0x7e, 0xb0, 0xf2, 0x22, 0x25, 0x25, 0x01, 0x66, 0x65, 0x26, 0xa3,
+	0x2a, 0xf6, 0x28, 0xca, 0x42, 0x5b, 0xaa, 0xd4, 0x02, 0xa9, 0xf5, 0x1b, 0x0d, 0x40, 0xa4, 0x62,
+	0xb9, 0x8c, 0xd9, 0x3d, 0xaf, 0x5d, 0x7e, 0xbb, 0xaf, 0x4d, 0x6f, 0x89, 0xc7, 0xf9, 0x96, 0xa0,
+	0x02, 0x23, 0x7d, 0x9e, 0x0f, 0x05, 0x46, 0xa5, 0xf3, 0x6a, 0xd7, 0x48, 0x5c, 0x7e, 0xa7, 0x41,
+	0xbb, 0x02, 0x1f, 0x9d, 0xde, 0xbd, 0xda, 0xec, 0xee, 0x15, 0xe5, 0x2b, 0x67, 0xb4, 0x43, 0x2b,
+	0x24, 0x0f, 0x4b, 0x92, 0x6f, 0x40, 0x53, 0x40, 0x52, 0x61, 0x79, 0xa4, 0x58, 0x7e, 0x1f, 0x56,
+	0x53, 0xe2, 0x92, 0x88, 0x05, 0x13, 0x27, 0x8c, 0x3d, 0xff, 0xc8, 0x27, 0x9e, 0xe0, 0x7a, 0xd3,
+	0xee, 0xe6, 0x8a, 0x3d, 0x25, 0xb7, 0x3e, 0xd1, 0xa0, 0xc3, 0x2b, 0xde, 0x09, 0xff, 0x83, 0x22,
+	0x57, 0xf6, 0xe2, 0x0c, 0x7a, 0x57, 0xf8, 0xe2, 0xd0, 0x0a, 0x85, 0x5e, 0x7b, 0x3e, 0x85, 0xa8,
+	0xdd, 0xa4, 0x8a, 0x36, 0x1c, 0x62, 0xf9, 0x62, 0xb3, 0x08, 0xc4, 0x65, 0x60, 0xd5, 0x21, 0x2b,
+	0x21, 0xfe, 0xb9, 0x06, 0x46, 0x65, 0xb3, 0xf0, 0x14, 0xad, 0x0e, 0x46, 0x79, 0x42, 0x68, 0x22,
+	0x09, 0x1a, 0x6e, 0xf9, 0x9a, 0xce, 0x5f, 0xb2, 0x42, 0x3a, 0x56, 0x11, 0x6f, 0xdb, 0xb2, 0x81,
+	0x6e, 0x42, 0x33, 0xa4, 0x63, 0x71, 0xb1, 0x55, 0x99, 0xb3, 0x68, 0xf3, 0xb0, 0x95, 0x25, 0x95,
+	0x4c, 0x20, 0xa5, 0xc0, 0xfa, 0x33, 0x7f, 0xb9, 0x94, 0xe3, 0x7f, 0xaa, 0x5f, 0x2e, 0x82, 0xb0,
+	0xd5, 0x3f, 0x02, 0x35, 0x91, 0x86, 0xa7, 0x64, 0x33, 0xe7, 0x8b, 0x7e, 0xee, 0xad, 0xe3, 0x3e,
+	0xac, 0x7a, 0xe4, 0x08, 0xf3, 0x6a, 0x68, 0x76, 0xc9, 0x5d, 0xa5, 0x28, 0x6a, 0xc0, 0x37, 0xde,
+	0x81, 0x56, 0xf1, 0xa7, 0x13, 0x75, 0xa1, 0xcd, 0x7f, 0x7c, 0x89, 0x6a, 0xd5, 0x8f, 0xc6, 0xdd,
+	0xaf, 0x20, 0x03, 0x1a, 0x3f, 0x24, 0x38, 0x60, 0xc7, 0x93, 0xae, 0x86, 0xda, 0xd0, 0x7c, 0x34,
+	0x8a, 0xe2, 0x34, 0xc4, 0x41, 0xb7, 0xf6, 0xf8, 0xed, 0x9f, 0xbe, 0x35, 0xf6, 0xd9, 0x71, 0x36,
+	0xe2, 0x9e, 0x6c, 0x4b, 0xd7, 0xbe, 0xe9, 0xc7, 0xea, 0x6b, 0x3b, 0x8f, 0xda, 0xb6, 0xf0, 0xb6,
+	0x68, 0x26, 0xa3, 0xd1, 0xb2, 0x90, 0xbc, 0xf9, 0xbf, 0x01, 0x00, 0xb4, 0xc9, 0xeb, 0xea, 0x0f,
+	0x1e, 0x00, 0x00,
 }
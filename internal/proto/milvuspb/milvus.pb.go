@@ -58,21 +58,30 @@ func (ShowType) EnumDescriptor() ([]byte, []int) {
 type PlaceholderType int32
 
 const (
-	PlaceholderType_None         PlaceholderType = 0
-	PlaceholderType_BinaryVector PlaceholderType = 100
-	PlaceholderType_FloatVector  PlaceholderType = 101
+	PlaceholderType_None              PlaceholderType = 0
+	PlaceholderType_BinaryVector      PlaceholderType = 100
+	PlaceholderType_FloatVector       PlaceholderType = 101
+	PlaceholderType_Float16Vector     PlaceholderType = 102
+	PlaceholderType_BFloat16Vector    PlaceholderType = 103
+	PlaceholderType_SparseFloatVector PlaceholderType = 104
 )
 
 var PlaceholderType_name = map[int32]string{
 	0:   "None",
 	100: "BinaryVector",
 	101: "FloatVector",
+	102: "Float16Vector",
+	103: "BFloat16Vector",
+	104: "SparseFloatVector",
 }
 
 var PlaceholderType_value = map[string]int32{
-	"None":         0,
-	"BinaryVector": 100,
-	"FloatVector":  101,
+	"None":              0,
+	"BinaryVector":      100,
+	"FloatVector":       101,
+	"Float16Vector":     102,
+	"BFloat16Vector":    103,
+	"SparseFloatVector": 104,
 }
 
 func (x PlaceholderType) String() string {
@@ -277,7 +286,9 @@ type CreateCollectionRequest struct {
 	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	// Once set, no modification is allowed (Optional)
 	// https://github.com/milvus-io/milvus/issues/6690
-	ShardsNum            int32    `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The number of partitions created for the partition key, only used if the collection has a partition key (Optional)
+	NumPartitions        int64    `protobuf:"varint,6,opt,name=num_partitions,json=numPartitions,proto3" json:"num_partitions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -343,7 +354,14 @@ func (m *CreateCollectionRequest) GetShardsNum() int32 {
 	return 0
 }
 
-//*
+func (m *CreateCollectionRequest) GetNumPartitions() int64 {
+	if m != nil {
+		return m.NumPartitions
+	}
+	return 0
+}
+
+// *
 // Drop collection in milvus, also will drop data in collection.
 type DropCollectionRequest struct {
 	// Not useful for now
@@ -1163,7 +1181,202 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
-//
+// Rename a collection, the old name still refers to the collection when time traveling
+// to the timestamps before the rename
+type RenameCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The current collection name in milvus.(Required)
+	OldName string `protobuf:"bytes,3,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	// The new collection name, which can't be an existing collection name or alias.(Required)
+	NewName              string   `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameCollectionRequest) Reset()         { *m = RenameCollectionRequest{} }
+func (m *RenameCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameCollectionRequest) ProtoMessage()    {}
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{16}
+}
+
+func (m *RenameCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameCollectionRequest.Unmarshal(m, b)
+}
+func (m *RenameCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameCollectionRequest.Merge(m, src)
+}
+func (m *RenameCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameCollectionRequest.Size(m)
+}
+func (m *RenameCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameCollectionRequest proto.InternalMessageInfo
+
+func (m *RenameCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RenameCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+// Recover a dropped collection before its data is garbage collected
+type RecoverCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The name of the dropped collection, the latest dropped one is recovered if there are several.(Required)
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoverCollectionRequest) Reset()         { *m = RecoverCollectionRequest{} }
+func (m *RecoverCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RecoverCollectionRequest) ProtoMessage()    {}
+func (*RecoverCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{17}
+}
+
+func (m *RecoverCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoverCollectionRequest.Unmarshal(m, b)
+}
+func (m *RecoverCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoverCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RecoverCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoverCollectionRequest.Merge(m, src)
+}
+func (m *RecoverCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RecoverCollectionRequest.Size(m)
+}
+func (m *RecoverCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoverCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoverCollectionRequest proto.InternalMessageInfo
+
+func (m *RecoverCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RecoverCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *RecoverCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+// Recover a dropped partition of an existing collection before its data is garbage collected
+type RecoverPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The name of the dropped partition, the latest dropped one is recovered if there are several.(Required)
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoverPartitionRequest) Reset()         { *m = RecoverPartitionRequest{} }
+func (m *RecoverPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*RecoverPartitionRequest) ProtoMessage()    {}
+func (*RecoverPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{18}
+}
+
+func (m *RecoverPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoverPartitionRequest.Unmarshal(m, b)
+}
+func (m *RecoverPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoverPartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *RecoverPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoverPartitionRequest.Merge(m, src)
+}
+func (m *RecoverPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_RecoverPartitionRequest.Size(m)
+}
+func (m *RecoverPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoverPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoverPartitionRequest proto.InternalMessageInfo
+
+func (m *RecoverPartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RecoverPartitionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *RecoverPartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *RecoverPartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
 // Create partition in created collection.
 type CreatePartitionRequest struct {
 	// Not useful for now
@@ -1183,7 +1396,7 @@ func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{}
 func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePartitionRequest) ProtoMessage()    {}
 func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
 func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1252,7 +1465,7 @@ func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
 func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropPartitionRequest) ProtoMessage()    {}
 func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+	return fileDescriptor_02345ba45cc0e303, []int{20}
 }
 
 func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1321,7 +1534,7 @@ func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
 func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasPartitionRequest) ProtoMessage()    {}
 func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
 
 func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1391,7 +1604,7 @@ func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
 func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadPartitionsRequest) ProtoMessage()    {}
 func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
 func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1461,7 +1674,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+	return fileDescriptor_02345ba45cc0e303, []int{23}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1530,7 +1743,7 @@ func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStati
 func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
 func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+	return fileDescriptor_02345ba45cc0e303, []int{24}
 }
 
 func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1591,7 +1804,7 @@ func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStat
 func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
 func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+	return fileDescriptor_02345ba45cc0e303, []int{25}
 }
 
 func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1650,7 +1863,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+	return fileDescriptor_02345ba45cc0e303, []int{26}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1738,7 +1951,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{27}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1802,19 +2015,23 @@ func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
 }
 
 type DescribeSegmentRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentID    int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	// The field whose index is described, zero means the first indexed field
+	FieldID int64 `protobuf:"varint,4,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	// The name of the index, empty means the default index of the field
+	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{} }
 func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentRequest) ProtoMessage()    {}
 func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{28}
 }
 
 func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -1856,6 +2073,20 @@ func (m *DescribeSegmentRequest) GetSegmentID() int64 {
 	return 0
 }
 
+func (m *DescribeSegmentRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *DescribeSegmentRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
 type DescribeSegmentResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
@@ -1871,7 +2102,7 @@ func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse
 func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentResponse) ProtoMessage()    {}
 func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
 
 func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -1940,7 +2171,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1994,7 +2225,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2041,17 +2272,20 @@ type CreateIndexRequest struct {
 	// The vector field name in this particular collection
 	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
 	// Support keys: index_type,metric_type, params. Different index_type may has different params.
-	ExtraParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	ExtraParams []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
+	// The name of the index, a field can have several indexes with different names.
+	// The default index name is used if it is empty.
+	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2107,7 +2341,13 @@ func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
-//
+func (m *CreateIndexRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
 // Get created index information.
 // Current release of Milvus only supports showing latest built index.
 type DescribeIndexRequest struct {
@@ -2130,7 +2370,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2206,7 +2446,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -2271,7 +2511,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -2306,8 +2546,7 @@ func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
 	return nil
 }
 
-//
-//  Get index building progress
+// Get index building progress
 type GetIndexBuildProgressRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -2328,7 +2567,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -2397,7 +2636,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -2440,21 +2679,23 @@ func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
 }
 
 type GetIndexStateRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName      string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// The index whose state is returned, the default index is used if it is empty.
+	// field_name can be omitted when index_name is set
+	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2523,7 +2764,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2566,21 +2807,23 @@ func (m *GetIndexStateResponse) GetFailReason() string {
 }
 
 type DropIndexRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName      string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// The index to drop, the default index is dropped if it is empty.
+	// field_name can be omitted when index_name is set
+	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2653,7 +2896,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2742,7 +2985,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -2842,7 +3085,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -2919,7 +3162,7 @@ func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
 func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderValue) ProtoMessage()    {}
 func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
 func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
@@ -2972,7 +3215,7 @@ func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
 func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderGroup) ProtoMessage()    {}
 func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
 func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
@@ -3007,22 +3250,26 @@ type SearchRequest struct {
 	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	PlaceholderGroup   []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields       []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams       []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp    uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// search with the vectors of the entities with these primary keys, instead of placeholder_group
+	Ids *schemapb.IDs `protobuf:"bytes,12,opt,name=ids,proto3" json:"ids,omitempty"`
+	// exclude each entity from the results of the search with its own vector, only valid with ids
+	ExcludeSeed          bool     `protobuf:"varint,13,opt,name=exclude_seed,json=excludeSeed,proto3" json:"exclude_seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -3120,8 +3367,22 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
-type Hits struct {
-	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+func (m *SearchRequest) GetIds() *schemapb.IDs {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetExcludeSeed() bool {
+	if m != nil {
+		return m.ExcludeSeed
+	}
+	return false
+}
+
+type Hits struct {
+	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
 	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -3133,7 +3394,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -3187,7 +3448,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -3235,7 +3496,7 @@ func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
 func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushRequest) ProtoMessage()    {}
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
@@ -3290,7 +3551,7 @@ func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
 func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
 func (*FlushResponse) ProtoMessage()    {}
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
 func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
@@ -3350,7 +3611,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -3439,7 +3700,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
@@ -3474,6 +3735,102 @@ func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
 	return nil
 }
 
+// Get retrieves the entities by primary keys, only the DML channels owning the keys are queried.
+type GetRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Ids                  *schemapb.IDs     `protobuf:"bytes,4,opt,name=ids,proto3" json:"ids,omitempty"`
+	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{53}
+}
+
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetRequest) GetIds() *schemapb.IDs {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *GetRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *GetRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *GetRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *GetRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
 type VectorIDs struct {
 	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
@@ -3488,7 +3845,7 @@ func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
 func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
 func (*VectorIDs) ProtoMessage()    {}
 func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
 func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
@@ -3551,7 +3908,7 @@ func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
 func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
 func (*VectorsArray) ProtoMessage()    {}
 func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
 
 func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
@@ -3631,7 +3988,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3697,7 +4054,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+	return fileDescriptor_02345ba45cc0e303, []int{57}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3785,7 +4142,7 @@ func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
 func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistentSegmentInfo) ProtoMessage()    {}
 func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
 func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3854,7 +4211,7 @@ func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSe
 func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
 func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3908,7 +4265,7 @@ func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentS
 func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
 
 func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3962,7 +4319,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -4059,7 +4416,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{62}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4113,7 +4470,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4159,7 +4516,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{64}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -4198,7 +4555,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -4236,7 +4593,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -4269,7 +4626,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -4316,7 +4673,7 @@ func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
 func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsRequest) ProtoMessage()    {}
 func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
 
 func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -4364,7 +4721,7 @@ func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
 func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsResponse) ProtoMessage()    {}
 func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+	return fileDescriptor_02345ba45cc0e303, []int{69}
 }
 
 func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -4422,7 +4779,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{66}
+	return fileDescriptor_02345ba45cc0e303, []int{70}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -4483,7 +4840,7 @@ func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest
 func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionRequest) ProtoMessage()    {}
 func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{67}
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
 func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
@@ -4530,7 +4887,7 @@ func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionRespon
 func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionResponse) ProtoMessage()    {}
 func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{68}
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
 func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
@@ -4576,7 +4933,7 @@ func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateReq
 func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateRequest) ProtoMessage()    {}
 func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{69}
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
 func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4619,7 +4976,7 @@ func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateRe
 func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateResponse) ProtoMessage()    {}
 func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{70}
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
 func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -4686,7 +5043,7 @@ func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansReq
 func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansRequest) ProtoMessage()    {}
 func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{71}
+	return fileDescriptor_02345ba45cc0e303, []int{75}
 }
 
 func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
@@ -4727,7 +5084,7 @@ func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansRe
 func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansResponse) ProtoMessage()    {}
 func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{72}
+	return fileDescriptor_02345ba45cc0e303, []int{76}
 }
 
 func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
@@ -4781,7 +5138,7 @@ func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
 func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
 func (*CompactionMergeInfo) ProtoMessage()    {}
 func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{73}
+	return fileDescriptor_02345ba45cc0e303, []int{77}
 }
 
 func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
@@ -4816,102 +5173,576 @@ func (m *CompactionMergeInfo) GetTarget() int64 {
 	return 0
 }
 
-type GetFlushStateRequest struct {
-	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetCompactionPolicyRequest struct {
+	CollectionID         int64                    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Policy               string                   `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
-func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFlushStateRequest) ProtoMessage()    {}
-func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{74}
+func (m *SetCompactionPolicyRequest) Reset()         { *m = SetCompactionPolicyRequest{} }
+func (m *SetCompactionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCompactionPolicyRequest) ProtoMessage()    {}
+func (*SetCompactionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{78}
 }
 
-func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+func (m *SetCompactionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCompactionPolicyRequest.Unmarshal(m, b)
 }
-func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+func (m *SetCompactionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCompactionPolicyRequest.Marshal(b, m, deterministic)
 }
-func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+func (m *SetCompactionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCompactionPolicyRequest.Merge(m, src)
 }
-func (m *GetFlushStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+func (m *SetCompactionPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetCompactionPolicyRequest.Size(m)
 }
-func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+func (m *SetCompactionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCompactionPolicyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetCompactionPolicyRequest proto.InternalMessageInfo
 
-func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+func (m *SetCompactionPolicyRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.SegmentIDs
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SetCompactionPolicyRequest) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *SetCompactionPolicyRequest) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
 	}
 	return nil
 }
 
-type GetFlushStateResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type DryRunCompactionRequest struct {
+	CollectionID         int64                    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Timetravel           uint64                   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Policy               string                   `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
-func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetFlushStateResponse) ProtoMessage()    {}
-func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{75}
+func (m *DryRunCompactionRequest) Reset()         { *m = DryRunCompactionRequest{} }
+func (m *DryRunCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunCompactionRequest) ProtoMessage()    {}
+func (*DryRunCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{79}
 }
 
-func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
+func (m *DryRunCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunCompactionRequest.Unmarshal(m, b)
 }
-func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
+func (m *DryRunCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunCompactionRequest.Marshal(b, m, deterministic)
 }
-func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
+func (m *DryRunCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunCompactionRequest.Merge(m, src)
 }
-func (m *GetFlushStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetFlushStateResponse.Size(m)
+func (m *DryRunCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_DryRunCompactionRequest.Size(m)
 }
-func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
+func (m *DryRunCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunCompactionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_DryRunCompactionRequest proto.InternalMessageInfo
 
-func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
+func (m *DryRunCompactionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DryRunCompactionRequest) GetTimetravel() uint64 {
+	if m != nil {
+		return m.Timetravel
+	}
+	return 0
+}
+
+func (m *DryRunCompactionRequest) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *DryRunCompactionRequest) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type DryRunCompactionResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Policy               string                   `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	Plans                []*CompactionPlanInfo    `protobuf:"bytes,4,rep,name=plans,proto3" json:"plans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *DryRunCompactionResponse) Reset()         { *m = DryRunCompactionResponse{} }
+func (m *DryRunCompactionResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunCompactionResponse) ProtoMessage()    {}
+func (*DryRunCompactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{80}
+}
+
+func (m *DryRunCompactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunCompactionResponse.Unmarshal(m, b)
+}
+func (m *DryRunCompactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunCompactionResponse.Marshal(b, m, deterministic)
+}
+func (m *DryRunCompactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunCompactionResponse.Merge(m, src)
+}
+func (m *DryRunCompactionResponse) XXX_Size() int {
+	return xxx_messageInfo_DryRunCompactionResponse.Size(m)
+}
+func (m *DryRunCompactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunCompactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunCompactionResponse proto.InternalMessageInfo
+
+func (m *DryRunCompactionResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetFlushStateResponse) GetFlushed() bool {
+func (m *DryRunCompactionResponse) GetPolicy() string {
 	if m != nil {
-		return m.Flushed
+		return m.Policy
 	}
-	return false
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
-	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
-	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
-	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
-	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
-	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
-	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
-	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
-	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
+func (m *DryRunCompactionResponse) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *DryRunCompactionResponse) GetPlans() []*CompactionPlanInfo {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+type CompactionPlanInfo struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Channel              string   `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sources              []int64  `protobuf:"varint,3,rep,packed,name=sources,proto3" json:"sources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionPlanInfo) Reset()         { *m = CompactionPlanInfo{} }
+func (m *CompactionPlanInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactionPlanInfo) ProtoMessage()    {}
+func (*CompactionPlanInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{81}
+}
+
+func (m *CompactionPlanInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionPlanInfo.Unmarshal(m, b)
+}
+func (m *CompactionPlanInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionPlanInfo.Marshal(b, m, deterministic)
+}
+func (m *CompactionPlanInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionPlanInfo.Merge(m, src)
+}
+func (m *CompactionPlanInfo) XXX_Size() int {
+	return xxx_messageInfo_CompactionPlanInfo.Size(m)
+}
+func (m *CompactionPlanInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionPlanInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionPlanInfo proto.InternalMessageInfo
+
+func (m *CompactionPlanInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CompactionPlanInfo) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *CompactionPlanInfo) GetSources() []int64 {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+type GetCompactionHistoryRequest struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionHistoryRequest) Reset()         { *m = GetCompactionHistoryRequest{} }
+func (m *GetCompactionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionHistoryRequest) ProtoMessage()    {}
+func (*GetCompactionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{82}
+}
+
+func (m *GetCompactionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionHistoryRequest.Merge(m, src)
+}
+func (m *GetCompactionHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionHistoryRequest.Size(m)
+}
+func (m *GetCompactionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionHistoryRequest proto.InternalMessageInfo
+
+func (m *GetCompactionHistoryRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetCompactionHistoryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetCompactionHistoryResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Histories            []*CompactionHistory `protobuf:"bytes,2,rep,name=histories,proto3" json:"histories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetCompactionHistoryResponse) Reset()         { *m = GetCompactionHistoryResponse{} }
+func (m *GetCompactionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionHistoryResponse) ProtoMessage()    {}
+func (*GetCompactionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{83}
+}
+
+func (m *GetCompactionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionHistoryResponse.Merge(m, src)
+}
+func (m *GetCompactionHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionHistoryResponse.Size(m)
+}
+func (m *GetCompactionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionHistoryResponse proto.InternalMessageInfo
+
+func (m *GetCompactionHistoryResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionHistoryResponse) GetHistories() []*CompactionHistory {
+	if m != nil {
+		return m.Histories
+	}
+	return nil
+}
+
+// CompactionHistory is the record of a completed compaction plan
+type CompactionHistory struct {
+	PlanID               int64    `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Channel              string   `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sources              []int64  `protobuf:"varint,5,rep,packed,name=sources,proto3" json:"sources,omitempty"`
+	Results              []int64  `protobuf:"varint,6,rep,packed,name=results,proto3" json:"results,omitempty"`
+	RowsBefore           int64    `protobuf:"varint,7,opt,name=rows_before,json=rowsBefore,proto3" json:"rows_before,omitempty"`
+	RowsAfter            int64    `protobuf:"varint,8,opt,name=rows_after,json=rowsAfter,proto3" json:"rows_after,omitempty"`
+	DeletedRows          int64    `protobuf:"varint,9,opt,name=deleted_rows,json=deletedRows,proto3" json:"deleted_rows,omitempty"`
+	BytesRead            int64    `protobuf:"varint,10,opt,name=bytes_read,json=bytesRead,proto3" json:"bytes_read,omitempty"`
+	BytesWritten         int64    `protobuf:"varint,11,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	TimeCostMs           int64    `protobuf:"varint,12,opt,name=time_cost_ms,json=timeCostMs,proto3" json:"time_cost_ms,omitempty"`
+	NodeID               int64    `protobuf:"varint,13,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	CompleteTime         uint64   `protobuf:"varint,14,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionHistory) Reset()         { *m = CompactionHistory{} }
+func (m *CompactionHistory) String() string { return proto.CompactTextString(m) }
+func (*CompactionHistory) ProtoMessage()    {}
+func (*CompactionHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{84}
+}
+
+func (m *CompactionHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionHistory.Unmarshal(m, b)
+}
+func (m *CompactionHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionHistory.Marshal(b, m, deterministic)
+}
+func (m *CompactionHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionHistory.Merge(m, src)
+}
+func (m *CompactionHistory) XXX_Size() int {
+	return xxx_messageInfo_CompactionHistory.Size(m)
+}
+func (m *CompactionHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionHistory proto.InternalMessageInfo
+
+func (m *CompactionHistory) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CompactionHistory) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *CompactionHistory) GetSources() []int64 {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *CompactionHistory) GetResults() []int64 {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *CompactionHistory) GetRowsBefore() int64 {
+	if m != nil {
+		return m.RowsBefore
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetRowsAfter() int64 {
+	if m != nil {
+		return m.RowsAfter
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetDeletedRows() int64 {
+	if m != nil {
+		return m.DeletedRows
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetBytesRead() int64 {
+	if m != nil {
+		return m.BytesRead
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetBytesWritten() int64 {
+	if m != nil {
+		return m.BytesWritten
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetTimeCostMs() int64 {
+	if m != nil {
+		return m.TimeCostMs
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *CompactionHistory) GetCompleteTime() uint64 {
+	if m != nil {
+		return m.CompleteTime
+	}
+	return 0
+}
+
+type GetFlushStateRequest struct {
+	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
+func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateRequest) ProtoMessage()    {}
+func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{85}
+}
+
+func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+}
+func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+}
+func (m *GetFlushStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+}
+func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+
+func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type GetFlushStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
+func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateResponse) ProtoMessage()    {}
+func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{86}
+}
+
+func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
+}
+func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
+}
+func (m *GetFlushStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateResponse.Size(m)
+}
+func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
+
+func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetFlushStateResponse) GetFlushed() bool {
+	if m != nil {
+		return m.Flushed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
+	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
+	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
+	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
+	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
+	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
+	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
+	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
+	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
 	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
 	proto.RegisterType((*DescribeCollectionRequest)(nil), "milvus.proto.milvus.DescribeCollectionRequest")
 	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
@@ -4921,6 +5752,9 @@ func init() {
 	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
+	proto.RegisterType((*RenameCollectionRequest)(nil), "milvus.proto.milvus.RenameCollectionRequest")
+	proto.RegisterType((*RecoverCollectionRequest)(nil), "milvus.proto.milvus.RecoverCollectionRequest")
+	proto.RegisterType((*RecoverPartitionRequest)(nil), "milvus.proto.milvus.RecoverPartitionRequest")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
 	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
@@ -4956,6 +5790,7 @@ func init() {
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
 	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
 	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
+	proto.RegisterType((*GetRequest)(nil), "milvus.proto.milvus.GetRequest")
 	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
 	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
 	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
@@ -4980,6 +5815,13 @@ func init() {
 	proto.RegisterType((*GetCompactionPlansRequest)(nil), "milvus.proto.milvus.GetCompactionPlansRequest")
 	proto.RegisterType((*GetCompactionPlansResponse)(nil), "milvus.proto.milvus.GetCompactionPlansResponse")
 	proto.RegisterType((*CompactionMergeInfo)(nil), "milvus.proto.milvus.CompactionMergeInfo")
+	proto.RegisterType((*SetCompactionPolicyRequest)(nil), "milvus.proto.milvus.SetCompactionPolicyRequest")
+	proto.RegisterType((*DryRunCompactionRequest)(nil), "milvus.proto.milvus.DryRunCompactionRequest")
+	proto.RegisterType((*DryRunCompactionResponse)(nil), "milvus.proto.milvus.DryRunCompactionResponse")
+	proto.RegisterType((*CompactionPlanInfo)(nil), "milvus.proto.milvus.CompactionPlanInfo")
+	proto.RegisterType((*GetCompactionHistoryRequest)(nil), "milvus.proto.milvus.GetCompactionHistoryRequest")
+	proto.RegisterType((*GetCompactionHistoryResponse)(nil), "milvus.proto.milvus.GetCompactionHistoryResponse")
+	proto.RegisterType((*CompactionHistory)(nil), "milvus.proto.milvus.CompactionHistory")
 	proto.RegisterType((*GetFlushStateRequest)(nil), "milvus.proto.milvus.GetFlushStateRequest")
 	proto.RegisterType((*GetFlushStateResponse)(nil), "milvus.proto.milvus.GetFlushStateResponse")
 }
@@ -4987,230 +5829,265 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4d, 0x73, 0x1b, 0x47,
-	0x76, 0x1c, 0x80, 0x20, 0x80, 0x47, 0x80, 0x84, 0x9a, 0x14, 0x05, 0x41, 0x5f, 0xd4, 0xd8, 0xb2,
-	0x28, 0xc9, 0x92, 0x2c, 0xca, 0x5f, 0x91, 0x93, 0xd8, 0x92, 0x18, 0x4b, 0x2c, 0x4b, 0x0a, 0x3d,
-	0xb4, 0x9d, 0x72, 0x5c, 0x2a, 0x54, 0x13, 0xd3, 0x04, 0xa7, 0x34, 0x98, 0x81, 0xa7, 0x1b, 0x92,
-	0xe8, 0x53, 0xaa, 0x1c, 0x27, 0x95, 0xb2, 0x63, 0x57, 0x2a, 0xa9, 0xa4, 0x72, 0x48, 0x0e, 0xc9,
-	0xee, 0x61, 0x6f, 0xeb, 0xf5, 0xd6, 0xee, 0xd6, 0x5e, 0x76, 0x0f, 0x3e, 0xec, 0x61, 0xab, 0xf6,
-	0xe3, 0xb2, 0x87, 0xbd, 0xec, 0x1f, 0xf0, 0x3f, 0xd8, 0xc3, 0x56, 0x7f, 0xcc, 0x60, 0x66, 0xd0,
-	0x03, 0x82, 0x82, 0xb5, 0x24, 0x6f, 0xd3, 0xaf, 0xdf, 0x7b, 0xfd, 0xfa, 0xf5, 0xeb, 0xd7, 0xaf,
-	0x5f, 0xbf, 0x81, 0x4a, 0xc7, 0x71, 0x1f, 0xf6, 0xe8, 0xa5, 0x6e, 0xe0, 0x33, 0x1f, 0xcd, 0xc5,
-	0x5b, 0x97, 0x64, 0xa3, 0x51, 0x69, 0xf9, 0x9d, 0x8e, 0xef, 0x49, 0x60, 0xa3, 0x42, 0x5b, 0x5b,
-	0xa4, 0x83, 0x65, 0xcb, 0xfc, 0x5f, 0x03, 0xd0, 0xcd, 0x80, 0x60, 0x46, 0xae, 0xbb, 0x0e, 0xa6,
-	0x16, 0xf9, 0xb0, 0x47, 0x28, 0x43, 0x2f, 0xc0, 0xe4, 0x06, 0xa6, 0xa4, 0x6e, 0x2c, 0x1a, 0x4b,
-	0xd3, 0xcb, 0xc7, 0x2f, 0x25, 0xd8, 0x2a, 0x76, 0x77, 0x69, 0xfb, 0x06, 0xa6, 0xc4, 0x12, 0x98,
-	0xe8, 0x08, 0x14, 0xed, 0x8d, 0xa6, 0x87, 0x3b, 0xa4, 0x9e, 0x5b, 0x34, 0x96, 0xca, 0xd6, 0x94,
-	0xbd, 0x71, 0x0f, 0x77, 0x08, 0x3a, 0x0b, 0xb3, 0x2d, 0xdf, 0x75, 0x49, 0x8b, 0x39, 0xbe, 0x27,
-	0x11, 0xf2, 0x02, 0x61, 0xa6, 0x0f, 0x16, 0x88, 0xf3, 0x50, 0xc0, 0x5c, 0x86, 0xfa, 0xa4, 0xe8,
-	0x96, 0x0d, 0x93, 0x42, 0x6d, 0x25, 0xf0, 0xbb, 0x4f, 0x4b, 0xba, 0x68, 0xd0, 0x7c, 0x7c, 0xd0,
-	0xff, 0x31, 0xe0, 0xd0, 0x75, 0x97, 0x91, 0x60, 0x9f, 0x2a, 0xe5, 0x6b, 0x03, 0x8e, 0xc8, 0x55,
-	0xbb, 0x19, 0xa1, 0xef, 0xa5, 0x94, 0x0b, 0x30, 0x25, 0xad, 0x4a, 0x88, 0x59, 0xb1, 0x54, 0x0b,
-	0x9d, 0x00, 0xa0, 0x5b, 0x38, 0xb0, 0x69, 0xd3, 0xeb, 0x75, 0xea, 0x85, 0x45, 0x63, 0xa9, 0x60,
-	0x95, 0x25, 0xe4, 0x5e, 0xaf, 0x63, 0x7e, 0x6a, 0xc0, 0x61, 0xbe, 0xb8, 0xfb, 0x62, 0x12, 0xe6,
-	0xf7, 0x0c, 0x98, 0xbf, 0x8d, 0xe9, 0xfe, 0xd0, 0xe8, 0x09, 0x00, 0xe6, 0x74, 0x48, 0x93, 0x32,
-	0xdc, 0xe9, 0x0a, 0xad, 0x4e, 0x5a, 0x65, 0x0e, 0x59, 0xe7, 0x00, 0xf3, 0x7d, 0xa8, 0xdc, 0xf0,
-	0x7d, 0xd7, 0x22, 0xb4, 0xeb, 0x7b, 0x94, 0xa0, 0xab, 0x30, 0x45, 0x19, 0x66, 0x3d, 0xaa, 0x84,
-	0x3c, 0xa6, 0x15, 0x72, 0x5d, 0xa0, 0x58, 0x0a, 0x95, 0xdb, 0xd6, 0x43, 0xec, 0xf6, 0xa4, 0x8c,
-	0x25, 0x4b, 0x36, 0xcc, 0x0f, 0x60, 0x66, 0x9d, 0x05, 0x8e, 0xd7, 0xfe, 0x16, 0x99, 0x97, 0x43,
-	0xe6, 0xbf, 0x35, 0xe0, 0xe8, 0x0a, 0xa1, 0xad, 0xc0, 0xd9, 0xd8, 0x27, 0xa6, 0x6b, 0x42, 0xa5,
-	0x0f, 0x59, 0x5d, 0x11, 0xaa, 0xce, 0x5b, 0x09, 0x58, 0x6a, 0x31, 0x0a, 0xe9, 0xc5, 0xf8, 0x78,
-	0x12, 0x1a, 0xba, 0x49, 0x8d, 0xa3, 0xbe, 0xbf, 0x8a, 0x76, 0x54, 0x4e, 0x10, 0x9d, 0x49, 0x12,
-	0x29, 0x1f, 0xde, 0x1f, 0x6d, 0x5d, 0x00, 0xa2, 0x8d, 0x97, 0x9e, 0x55, 0x5e, 0x33, 0xab, 0x65,
-	0x38, 0xfc, 0xd0, 0x09, 0x58, 0x0f, 0xbb, 0xcd, 0xd6, 0x16, 0xf6, 0x3c, 0xe2, 0x0a, 0x3d, 0x71,
-	0x57, 0x93, 0x5f, 0x2a, 0x5b, 0x73, 0xaa, 0xf3, 0xa6, 0xec, 0xe3, 0xca, 0xa2, 0xe8, 0x45, 0x58,
-	0xe8, 0x6e, 0x6d, 0x53, 0xa7, 0x35, 0x40, 0x54, 0x10, 0x44, 0xf3, 0x61, 0x6f, 0x82, 0xea, 0x02,
-	0x1c, 0x6a, 0x09, 0x6f, 0x65, 0x37, 0xb9, 0xd6, 0xa4, 0x1a, 0xa7, 0x84, 0x1a, 0x6b, 0xaa, 0xe3,
-	0x9d, 0x10, 0xce, 0xc5, 0x0a, 0x91, 0x7b, 0xac, 0x15, 0x23, 0x28, 0x0a, 0x82, 0x39, 0xd5, 0xf9,
-	0x2e, 0x6b, 0xf5, 0x69, 0x92, 0x7e, 0xa6, 0x94, 0xf2, 0x33, 0xa8, 0x0e, 0x45, 0xe1, 0x37, 0x09,
-	0xad, 0x97, 0x85, 0x98, 0x61, 0x13, 0xad, 0xc2, 0x2c, 0x65, 0x38, 0x60, 0xcd, 0xae, 0x4f, 0x1d,
-	0xae, 0x17, 0x5a, 0x87, 0xc5, 0xfc, 0xd2, 0xf4, 0xf2, 0xa2, 0x76, 0x91, 0xde, 0x22, 0xdb, 0x2b,
-	0x98, 0xe1, 0x35, 0xec, 0x04, 0xd6, 0x8c, 0x20, 0x5c, 0x0b, 0xe9, 0x84, 0x33, 0xbb, 0xe3, 0x63,
-	0x7b, 0x7f, 0x38, 0xb3, 0xcf, 0x0d, 0xa8, 0x5b, 0xc4, 0x25, 0x98, 0xee, 0x8f, 0x7d, 0x66, 0xfe,
-	0x87, 0x01, 0x27, 0x6f, 0x11, 0x16, 0xb3, 0x58, 0x86, 0x99, 0x43, 0x99, 0xd3, 0xda, 0xcb, 0xf3,
-	0xd5, 0xfc, 0xc2, 0x80, 0x53, 0x99, 0x62, 0x8d, 0xb3, 0x81, 0x5f, 0x81, 0x02, 0xff, 0xa2, 0xf5,
-	0x9c, 0xb0, 0xa7, 0xd3, 0x59, 0xf6, 0xf4, 0x1e, 0xf7, 0x8b, 0xc2, 0xa0, 0x24, 0xbe, 0xf9, 0x07,
-	0x03, 0x16, 0xd6, 0xb7, 0xfc, 0x47, 0x7d, 0x91, 0x9e, 0x86, 0x82, 0x92, 0x2e, 0x2d, 0x9f, 0x72,
-	0x69, 0xe8, 0x0a, 0x4c, 0xb2, 0xed, 0x2e, 0x11, 0xde, 0x70, 0x66, 0xf9, 0xc4, 0x25, 0x4d, 0x58,
-	0x79, 0x89, 0x0b, 0xf9, 0xce, 0x76, 0x97, 0x58, 0x02, 0x15, 0x9d, 0x83, 0x5a, 0x4a, 0xe5, 0xa1,
-	0x53, 0x98, 0x4d, 0xea, 0x9c, 0x9a, 0x3f, 0xc9, 0xc1, 0x91, 0x81, 0x29, 0x8e, 0xa3, 0x6c, 0xdd,
-	0xd8, 0x39, 0xed, 0xd8, 0xe8, 0x0c, 0xc4, 0x4c, 0xa0, 0xe9, 0xd8, 0x3c, 0xf2, 0xcb, 0x2f, 0xe5,
-	0xad, 0x6a, 0xcc, 0x37, 0xda, 0x14, 0x5d, 0x04, 0x34, 0xe0, 0xb2, 0xa4, 0x67, 0x9c, 0xb4, 0x0e,
-	0xa5, 0x7d, 0x96, 0xf0, 0x8b, 0x5a, 0xa7, 0x25, 0x55, 0x30, 0x69, 0xcd, 0x6b, 0xbc, 0x16, 0x45,
-	0x57, 0x60, 0xde, 0xf1, 0xee, 0x92, 0x8e, 0x1f, 0x6c, 0x37, 0xbb, 0x24, 0x68, 0x11, 0x8f, 0xe1,
-	0x36, 0xa1, 0xf5, 0x29, 0x21, 0xd1, 0x5c, 0xd8, 0xb7, 0xd6, 0xef, 0x32, 0xbf, 0x32, 0x60, 0x41,
-	0x46, 0x7e, 0x6b, 0x38, 0x60, 0xce, 0x5e, 0x9f, 0x9e, 0x67, 0x60, 0xa6, 0x1b, 0xca, 0x21, 0xf1,
-	0x64, 0x9c, 0x5a, 0x8d, 0xa0, 0x62, 0x97, 0x7d, 0x69, 0xc0, 0x3c, 0x0f, 0xf4, 0x0e, 0x92, 0xcc,
-	0xdf, 0x37, 0x60, 0xee, 0x36, 0xa6, 0x07, 0x49, 0xe4, 0x1f, 0xaa, 0x23, 0x28, 0x92, 0x79, 0x4f,
-	0xaf, 0x2e, 0x67, 0x61, 0x36, 0x29, 0x74, 0x18, 0x59, 0xcc, 0x24, 0xa4, 0xa6, 0xe6, 0x8f, 0xfb,
-	0x67, 0xd5, 0x01, 0x93, 0xfc, 0xa7, 0x06, 0x9c, 0xb8, 0x45, 0x58, 0x24, 0xf5, 0xbe, 0x38, 0xd3,
-	0x46, 0xb5, 0x96, 0xcf, 0xe5, 0x89, 0xac, 0x15, 0x7e, 0x4f, 0x4e, 0xbe, 0x4f, 0x73, 0x70, 0x98,
-	0x1f, 0x0b, 0xfb, 0xc3, 0x08, 0x46, 0xb9, 0x18, 0x68, 0x0c, 0xa5, 0xa0, 0x33, 0x94, 0xe8, 0x3c,
-	0x9d, 0x1a, 0xf9, 0x3c, 0x35, 0x7f, 0x90, 0x93, 0x71, 0x40, 0x5c, 0x1b, 0xe3, 0x2c, 0x8b, 0x46,
-	0xd6, 0x9c, 0x56, 0x56, 0x13, 0x2a, 0x11, 0x64, 0x75, 0x25, 0x3c, 0x1f, 0x13, 0xb0, 0x7d, 0x7b,
-	0x3c, 0x7e, 0x66, 0xc0, 0x42, 0x78, 0x15, 0x5b, 0x27, 0xed, 0x0e, 0xf1, 0xd8, 0x93, 0xdb, 0x50,
-	0xda, 0x02, 0x72, 0x1a, 0x0b, 0x38, 0x0e, 0x65, 0x2a, 0xc7, 0x89, 0x6e, 0x59, 0x7d, 0x80, 0xf9,
-	0x33, 0x03, 0x8e, 0x0c, 0x88, 0x33, 0xce, 0x22, 0xd6, 0xa1, 0xe8, 0x78, 0x36, 0x79, 0x1c, 0x49,
-	0x13, 0x36, 0x79, 0xcf, 0x46, 0xcf, 0x71, 0xed, 0x48, 0x8c, 0xb0, 0x89, 0x4e, 0x43, 0x85, 0x78,
-	0x78, 0xc3, 0x25, 0x4d, 0x81, 0x2b, 0x0c, 0xb9, 0x64, 0x4d, 0x4b, 0xd8, 0x2a, 0x07, 0x71, 0xe2,
-	0x4d, 0x87, 0x08, 0xe2, 0x82, 0x24, 0x56, 0x4d, 0xf3, 0x5f, 0x0d, 0x98, 0xe3, 0x56, 0xa8, 0xa4,
-	0xa7, 0x4f, 0x57, 0x9b, 0x8b, 0x30, 0x1d, 0x33, 0x33, 0x35, 0x91, 0x38, 0xc8, 0x7c, 0x00, 0xf3,
-	0x49, 0x71, 0xc6, 0xd1, 0xe6, 0x49, 0x80, 0x68, 0xad, 0xe4, 0x6e, 0xc8, 0x5b, 0x31, 0x88, 0xf9,
-	0x4d, 0x94, 0x1c, 0x15, 0x6a, 0xda, 0xe3, 0x7c, 0x90, 0x58, 0x92, 0xb8, 0x3f, 0x2f, 0x0b, 0x88,
-	0xe8, 0x5e, 0x81, 0x0a, 0x79, 0xcc, 0x02, 0xdc, 0xec, 0xe2, 0x00, 0x77, 0xe4, 0xb6, 0x1a, 0xc9,
-	0xf5, 0x4e, 0x0b, 0xb2, 0x35, 0x41, 0x65, 0xfe, 0x82, 0x87, 0x69, 0xca, 0x5c, 0xf7, 0xfb, 0x8c,
-	0x4f, 0x00, 0x08, 0x73, 0x96, 0xdd, 0x05, 0xd9, 0x2d, 0x20, 0xe2, 0x70, 0xfb, 0xae, 0x01, 0x35,
-	0x31, 0x05, 0x39, 0x9f, 0x2e, 0x67, 0x9b, 0xa2, 0x31, 0x52, 0x34, 0x43, 0x36, 0xd7, 0x5f, 0xc0,
-	0x94, 0x52, 0x6c, 0x7e, 0x54, 0xc5, 0x2a, 0x82, 0x1d, 0xa6, 0x61, 0xfe, 0x9f, 0x01, 0x87, 0x53,
-	0x2a, 0x1f, 0xc7, 0xa2, 0xdf, 0x01, 0x24, 0x67, 0x68, 0xf7, 0xa7, 0x1d, 0x1e, 0xc4, 0x67, 0xb4,
-	0xa7, 0x4e, 0x5a, 0x49, 0xd6, 0x21, 0x27, 0x05, 0xa1, 0xe6, 0xaf, 0x0d, 0x38, 0x7e, 0x8b, 0x30,
-	0x81, 0x7a, 0x83, 0x7b, 0x95, 0xb5, 0xc0, 0x6f, 0x07, 0x84, 0xd2, 0x83, 0x6b, 0x1f, 0xff, 0x29,
-	0x23, 0x37, 0xdd, 0x94, 0xc6, 0xd1, 0xff, 0x69, 0xa8, 0x88, 0x31, 0x88, 0xdd, 0x0c, 0xfc, 0x47,
-	0x54, 0xd9, 0xd1, 0xb4, 0x82, 0x59, 0xfe, 0x23, 0x61, 0x10, 0xcc, 0x67, 0xd8, 0x95, 0x08, 0xea,
-	0xc8, 0x10, 0x10, 0xde, 0x2d, 0xf6, 0x60, 0x28, 0x18, 0x67, 0x4e, 0x0e, 0xae, 0x8e, 0xbf, 0x63,
-	0xc0, 0xe1, 0xd4, 0x54, 0xc6, 0xd1, 0xed, 0x4b, 0x32, 0xae, 0x94, 0x93, 0x99, 0x59, 0x3e, 0xa5,
-	0xa5, 0x89, 0x0d, 0x26, 0xb1, 0xd1, 0x29, 0x98, 0xde, 0xc4, 0x8e, 0xdb, 0x0c, 0x08, 0xa6, 0xbe,
-	0xa7, 0x26, 0x0a, 0x1c, 0x64, 0x09, 0x88, 0xf9, 0xb5, 0x21, 0x9f, 0x98, 0x0e, 0xb8, 0xc7, 0xfb,
-	0xff, 0x1c, 0x54, 0x57, 0x3d, 0x4a, 0x02, 0xb6, 0xff, 0xef, 0x1e, 0xe8, 0x75, 0x98, 0x16, 0x13,
-	0xa3, 0x4d, 0x1b, 0x33, 0xac, 0x8e, 0xab, 0x93, 0xda, 0x1c, 0xf7, 0x9b, 0x1c, 0x6f, 0x05, 0x33,
-	0x6c, 0x49, 0xed, 0x50, 0xfe, 0x8d, 0x8e, 0x41, 0x79, 0x0b, 0xd3, 0xad, 0xe6, 0x03, 0xb2, 0x2d,
-	0x03, 0xc2, 0xaa, 0x55, 0xe2, 0x80, 0xb7, 0xc8, 0x36, 0x45, 0x47, 0xa1, 0xe4, 0xf5, 0x3a, 0x72,
-	0x83, 0x15, 0x17, 0x8d, 0xa5, 0xaa, 0x55, 0xf4, 0x7a, 0x1d, 0xb1, 0xbd, 0x7e, 0x99, 0x83, 0x99,
-	0xbb, 0x3d, 0x7e, 0xd3, 0x11, 0x19, 0xfa, 0x9e, 0xcb, 0x9e, 0xcc, 0x18, 0xcf, 0x43, 0x5e, 0xc6,
-	0x0c, 0x9c, 0xa2, 0xae, 0x15, 0x7c, 0x75, 0x85, 0x5a, 0x1c, 0x49, 0x64, 0xa7, 0x7b, 0xad, 0x96,
-	0x0a, 0xbf, 0xf2, 0x42, 0xd8, 0x32, 0x87, 0xc8, 0xe0, 0xeb, 0x18, 0x94, 0x49, 0x10, 0x44, 0xc1,
-	0x99, 0x98, 0x0a, 0x09, 0x02, 0xd9, 0x69, 0x42, 0x05, 0xb7, 0x1e, 0x78, 0xfe, 0x23, 0x97, 0xd8,
-	0x6d, 0x62, 0x8b, 0x65, 0x2f, 0x59, 0x09, 0x98, 0x34, 0x0c, 0xbe, 0xf0, 0xcd, 0x96, 0xc7, 0xc4,
-	0x15, 0x23, 0xcf, 0x0d, 0x83, 0x43, 0x6e, 0x7a, 0x8c, 0x77, 0xdb, 0xc4, 0x25, 0x8c, 0x88, 0xee,
-	0xa2, 0xec, 0x96, 0x10, 0xd5, 0xdd, 0xeb, 0x46, 0xd4, 0x25, 0xd9, 0x2d, 0x21, 0xbc, 0xfb, 0x38,
-	0x94, 0xfb, 0x29, 0xf8, 0x72, 0x3f, 0x4f, 0x28, 0x00, 0xe6, 0xef, 0x0d, 0xa8, 0xae, 0x08, 0x56,
-	0x07, 0xc0, 0xe8, 0x10, 0x4c, 0x92, 0xc7, 0xdd, 0x40, 0x6d, 0x1d, 0xf1, 0x3d, 0xd4, 0x8e, 0xcc,
-	0x87, 0x50, 0x5b, 0x73, 0x71, 0x8b, 0x6c, 0xf9, 0xae, 0x4d, 0x02, 0x71, 0xb6, 0xa3, 0x1a, 0xe4,
-	0x19, 0x6e, 0xab, 0xe0, 0x81, 0x7f, 0xa2, 0x57, 0xd5, 0xdd, 0x4e, 0xba, 0xa5, 0x67, 0xb5, 0xa7,
-	0x6c, 0x8c, 0x4d, 0x2c, 0x65, 0xba, 0x00, 0x53, 0xe2, 0x59, 0x4c, 0x86, 0x15, 0x15, 0x4b, 0xb5,
-	0xcc, 0xfb, 0x89, 0x71, 0x6f, 0x05, 0x7e, 0xaf, 0x8b, 0x56, 0xa1, 0xd2, 0xed, 0xc3, 0xb8, 0xad,
-	0x66, 0x9f, 0xe9, 0x69, 0xa1, 0xad, 0x04, 0xa9, 0xf9, 0x4d, 0x1e, 0xaa, 0xeb, 0x04, 0x07, 0xad,
-	0xad, 0x83, 0x90, 0x64, 0xe1, 0x1a, 0xb7, 0xa9, 0xab, 0x56, 0x8d, 0x7f, 0xa2, 0x0b, 0x70, 0x28,
-	0x36, 0xa1, 0x66, 0x9b, 0x2b, 0x48, 0xd8, 0x7d, 0xc5, 0xaa, 0x75, 0xd3, 0x8a, 0x7b, 0x05, 0x4a,
-	0x36, 0x75, 0x9b, 0x62, 0x89, 0x8a, 0x62, 0x89, 0xf4, 0xf3, 0x5b, 0xa1, 0xae, 0x58, 0x9a, 0xa2,
-	0x2d, 0x3f, 0xd0, 0x33, 0x50, 0xf5, 0x7b, 0xac, 0xdb, 0x63, 0x4d, 0xe9, 0x77, 0xea, 0x25, 0x21,
-	0x5e, 0x45, 0x02, 0x85, 0x5b, 0xa2, 0xe8, 0x4d, 0xa8, 0x52, 0xa1, 0xca, 0x30, 0xf2, 0x2e, 0x8f,
-	0x1a, 0x20, 0x56, 0x24, 0x9d, 0x0c, 0xbd, 0xd1, 0x39, 0xa8, 0xb1, 0x00, 0x3f, 0x24, 0x6e, 0xec,
-	0xc1, 0x0b, 0xc4, 0x6e, 0x9b, 0x95, 0xf0, 0xfe, 0x63, 0xd7, 0x65, 0x98, 0x6b, 0xf7, 0x70, 0x80,
-	0x3d, 0x46, 0x48, 0x0c, 0x7b, 0x5a, 0x60, 0xa3, 0xa8, 0x2b, 0x22, 0x30, 0xdf, 0x82, 0xc9, 0xdb,
-	0x0e, 0x13, 0x8a, 0xe4, 0x3e, 0xcb, 0x10, 0xf7, 0x1c, 0xe1, 0x99, 0x8e, 0x42, 0x29, 0xf0, 0x1f,
-	0x49, 0x1f, 0x9c, 0x13, 0x26, 0x58, 0x0c, 0xfc, 0x47, 0xc2, 0xc1, 0x8a, 0x27, 0x7d, 0x3f, 0x50,
-	0xb6, 0x99, 0xb3, 0x54, 0xcb, 0xfc, 0xc4, 0xe8, 0x1b, 0x0f, 0x77, 0x9f, 0xf4, 0xc9, 0xfc, 0xe7,
-	0xeb, 0x50, 0x0c, 0x24, 0xfd, 0xd0, 0x07, 0xce, 0xf8, 0x48, 0xe2, 0x0c, 0x08, 0xa9, 0xcc, 0x7f,
-	0x34, 0xa0, 0xf2, 0xa6, 0xdb, 0xa3, 0x4f, 0xc3, 0x86, 0x75, 0xcf, 0x09, 0x79, 0xfd, 0x53, 0xc6,
-	0xbf, 0xe5, 0xa0, 0xaa, 0xc4, 0x18, 0x27, 0xb6, 0xc9, 0x14, 0x65, 0x1d, 0xa6, 0xf9, 0x90, 0x4d,
-	0x4a, 0xda, 0x61, 0x2e, 0x66, 0x7a, 0x79, 0x59, 0xbb, 0xeb, 0x13, 0x62, 0x88, 0xa7, 0xe1, 0x75,
-	0x41, 0xf4, 0x37, 0x1e, 0x0b, 0xb6, 0x2d, 0x68, 0x45, 0x80, 0xc6, 0x7d, 0x98, 0x4d, 0x75, 0x73,
-	0xdb, 0x78, 0x40, 0xb6, 0x43, 0xb7, 0xf6, 0x80, 0x6c, 0xa3, 0x17, 0xe3, 0x0f, 0xf8, 0x59, 0x87,
-	0xf3, 0x1d, 0xdf, 0x6b, 0x5f, 0x0f, 0x02, 0xbc, 0xad, 0x1e, 0xf8, 0xaf, 0xe5, 0x5e, 0x35, 0xcc,
-	0x9f, 0xe7, 0xa0, 0xf2, 0x76, 0x8f, 0x04, 0xdb, 0x7b, 0xe9, 0x5e, 0x42, 0x67, 0x3f, 0x19, 0x73,
-	0xf6, 0x03, 0x3b, 0xba, 0xa0, 0xd9, 0xd1, 0x1a, 0xbf, 0x34, 0xa5, 0xf5, 0x4b, 0xba, 0x2d, 0x5b,
-	0xdc, 0xd5, 0x96, 0x2d, 0x65, 0x6e, 0x59, 0x6e, 0xdd, 0x4a, 0x85, 0x63, 0x6d, 0xb2, 0x44, 0x94,
-	0x95, 0xdb, 0x6d, 0x94, 0x65, 0x7e, 0x69, 0x40, 0xf9, 0x3d, 0xd2, 0x62, 0x7e, 0xc0, 0xbd, 0x85,
-	0x46, 0xf7, 0xc6, 0x08, 0x81, 0x6c, 0x2e, 0x1d, 0xc8, 0x5e, 0x85, 0x92, 0x63, 0x37, 0x31, 0x37,
-	0x1b, 0xb1, 0x78, 0xc3, 0x02, 0xa8, 0xa2, 0x63, 0x0b, 0xfb, 0x1a, 0x3d, 0x27, 0xff, 0x5f, 0x06,
-	0x54, 0xa4, 0xcc, 0x54, 0x52, 0xbe, 0x16, 0x1b, 0xce, 0xd0, 0xd9, 0xb2, 0x6a, 0x44, 0x13, 0xbd,
-	0x3d, 0xd1, 0x1f, 0xf6, 0x3a, 0x00, 0xd7, 0x9d, 0x22, 0x97, 0x5b, 0x61, 0x51, 0x2b, 0xad, 0x24,
-	0x17, 0x7a, 0xbc, 0x3d, 0x61, 0x95, 0x39, 0x95, 0x60, 0x71, 0xa3, 0x08, 0x05, 0x41, 0x6d, 0xfe,
-	0xd1, 0x80, 0xb9, 0x9b, 0xd8, 0x6d, 0xad, 0x38, 0x94, 0x61, 0xaf, 0x35, 0x46, 0xc8, 0x74, 0x0d,
-	0x8a, 0x7e, 0xb7, 0xe9, 0x92, 0x4d, 0xa6, 0x44, 0x3a, 0x3d, 0x64, 0x46, 0x52, 0x0d, 0xd6, 0x94,
-	0xdf, 0xbd, 0x43, 0x36, 0x19, 0xfa, 0x4b, 0x28, 0xf9, 0xdd, 0x66, 0xe0, 0xb4, 0xb7, 0x98, 0xd2,
-	0xfe, 0x08, 0xc4, 0x45, 0xbf, 0x6b, 0x71, 0x8a, 0x58, 0x26, 0x64, 0x72, 0x97, 0x99, 0x10, 0xf3,
-	0x37, 0x03, 0xd3, 0x1f, 0xc3, 0xb4, 0xaf, 0x41, 0xc9, 0xf1, 0x58, 0xd3, 0x76, 0x68, 0xa8, 0x82,
-	0x13, 0x7a, 0x1b, 0xf2, 0x98, 0x98, 0x81, 0x58, 0x53, 0x8f, 0xf1, 0xb1, 0xd1, 0x1b, 0x00, 0x9b,
-	0xae, 0x8f, 0x15, 0xb5, 0xd4, 0xc1, 0x29, 0xfd, 0xae, 0xe0, 0x68, 0x21, 0x7d, 0x59, 0x10, 0x71,
-	0x0e, 0xfd, 0x25, 0xfd, 0x95, 0x01, 0x87, 0xd7, 0x48, 0x40, 0x1d, 0xca, 0x88, 0xc7, 0x54, 0x56,
-	0x72, 0xd5, 0xdb, 0xf4, 0x93, 0x89, 0x61, 0x23, 0x95, 0x18, 0xfe, 0x76, 0x92, 0xa1, 0x89, 0x7b,
-	0x8e, 0x7c, 0x9e, 0x08, 0xef, 0x39, 0xe1, 0x23, 0x8c, 0xbc, 0x27, 0xce, 0x64, 0x2c, 0x93, 0x92,
-	0x37, 0x7e, 0x5d, 0x36, 0xff, 0x5d, 0x16, 0x44, 0x68, 0x27, 0xf5, 0xe4, 0x06, 0xbb, 0x00, 0xca,
-	0x81, 0xa7, 0xdc, 0xf9, 0x73, 0x90, 0xf2, 0x1d, 0x19, 0x65, 0x1a, 0xff, 0x6d, 0xc0, 0x62, 0xb6,
-	0x54, 0xe3, 0x9c, 0xbc, 0x6f, 0x40, 0xc1, 0xf1, 0x36, 0xfd, 0x30, 0x49, 0x76, 0x5e, 0x1f, 0x50,
-	0x6b, 0xc7, 0x95, 0x84, 0xe6, 0x8f, 0x72, 0x50, 0x13, 0xbe, 0x7a, 0x0f, 0x96, 0xbf, 0x43, 0x3a,
-	0x4d, 0xea, 0x7c, 0x44, 0xc2, 0xe5, 0xef, 0x90, 0xce, 0xba, 0xf3, 0x11, 0x49, 0x58, 0x46, 0x21,
-	0x69, 0x19, 0xc9, 0x34, 0xc2, 0xd4, 0x90, 0x24, 0x68, 0x31, 0x99, 0x04, 0x5d, 0x80, 0x29, 0xcf,
-	0xb7, 0xc9, 0xea, 0x8a, 0xba, 0x24, 0xaa, 0x56, 0xdf, 0xd4, 0xca, 0xbb, 0x34, 0xb5, 0xcf, 0x0d,
-	0x68, 0xdc, 0x22, 0x2c, 0xad, 0xbb, 0xbd, 0xb3, 0xb2, 0x2f, 0x0c, 0x38, 0xa6, 0x15, 0x68, 0x1c,
-	0x03, 0x7b, 0x2d, 0x69, 0x60, 0xfa, 0x1b, 0xdb, 0xc0, 0x90, 0xca, 0xb6, 0xae, 0x40, 0x65, 0xa5,
-	0xd7, 0xe9, 0x44, 0x91, 0xd4, 0x69, 0xa8, 0x04, 0xf2, 0x53, 0x5e, 0x68, 0xe4, 0xf9, 0x3b, 0xad,
-	0x60, 0xfc, 0xda, 0x62, 0x5e, 0x80, 0xaa, 0x22, 0x51, 0x52, 0x37, 0xa0, 0x14, 0xa8, 0x6f, 0x85,
-	0x1f, 0xb5, 0xcd, 0xc3, 0x30, 0x67, 0x91, 0x36, 0x37, 0xed, 0xe0, 0x8e, 0xe3, 0x3d, 0x50, 0xc3,
-	0x98, 0x1f, 0x1b, 0x30, 0x9f, 0x84, 0x2b, 0x5e, 0x2f, 0x43, 0x11, 0xdb, 0x76, 0x40, 0x28, 0x1d,
-	0xba, 0x2c, 0xd7, 0x25, 0x8e, 0x15, 0x22, 0xc7, 0x34, 0x97, 0x1b, 0x59, 0x73, 0x66, 0x13, 0x0e,
-	0xdd, 0x22, 0xec, 0x2e, 0x61, 0xc1, 0x58, 0x0f, 0xea, 0x75, 0x7e, 0xd5, 0x10, 0xc4, 0xca, 0x2c,
-	0xc2, 0xa6, 0xf9, 0x99, 0x01, 0x28, 0x3e, 0xc2, 0x38, 0xcb, 0x1c, 0xd7, 0x72, 0x2e, 0xa9, 0x65,
-	0x59, 0x73, 0xd4, 0xe9, 0xfa, 0x1e, 0xf1, 0x58, 0x3c, 0x66, 0xad, 0x46, 0x50, 0x61, 0x7e, 0x5f,
-	0x19, 0x80, 0xee, 0xf8, 0xd8, 0xbe, 0x81, 0xdd, 0xf1, 0xc2, 0x83, 0x13, 0x00, 0x34, 0x68, 0x35,
-	0xd5, 0x6e, 0xcd, 0x29, 0xef, 0x13, 0xb4, 0xee, 0xc9, 0x0d, 0x7b, 0x0a, 0xa6, 0x6d, 0xca, 0x54,
-	0x77, 0xf8, 0xbe, 0x0b, 0x36, 0x65, 0xb2, 0x5f, 0xd4, 0x6b, 0x52, 0x82, 0x5d, 0x62, 0x37, 0x63,
-	0xcf, 0x63, 0x93, 0x02, 0xad, 0x26, 0x3b, 0xd6, 0xfb, 0x8f, 0x64, 0xf7, 0xe1, 0xc8, 0x5d, 0xec,
-	0xf5, 0xb0, 0x7b, 0xd3, 0xef, 0x74, 0x71, 0xa2, 0xce, 0x30, 0xed, 0xe6, 0x0c, 0x8d, 0x9b, 0x3b,
-	0x29, 0x0b, 0xd1, 0x64, 0xc4, 0x2c, 0x64, 0x9d, 0xb4, 0x62, 0x10, 0x93, 0x42, 0x7d, 0x90, 0xfd,
-	0x38, 0x0b, 0x25, 0x84, 0x0a, 0x59, 0xc5, 0x7d, 0x6f, 0x1f, 0x66, 0xbe, 0x0e, 0x47, 0x45, 0x51,
-	0x60, 0x08, 0x4a, 0x24, 0xe2, 0xd3, 0x0c, 0x0c, 0x0d, 0x83, 0x7f, 0xce, 0x09, 0xd7, 0x36, 0xc0,
-	0x61, 0x1c, 0xc1, 0xaf, 0x25, 0xf3, 0xdf, 0xcf, 0x6a, 0x69, 0xd2, 0x23, 0xaa, 0x24, 0xf8, 0x12,
-	0xcc, 0x92, 0xc7, 0xa4, 0xd5, 0x63, 0x8e, 0xd7, 0x5e, 0x73, 0xb1, 0x77, 0xcf, 0x57, 0x07, 0x4a,
-	0x1a, 0x8c, 0x9e, 0x85, 0x2a, 0xd7, 0xbe, 0xdf, 0x63, 0x0a, 0x4f, 0x9e, 0x2c, 0x49, 0x20, 0xe7,
-	0xc7, 0xe7, 0xeb, 0x12, 0x46, 0x6c, 0x85, 0x27, 0x8f, 0x99, 0x34, 0x78, 0x40, 0x95, 0x1c, 0x4c,
-	0x77, 0xa3, 0xca, 0xdf, 0x19, 0x29, 0x55, 0x2a, 0x0e, 0x7b, 0xa5, 0xca, 0xdb, 0x00, 0x1d, 0x12,
-	0xb4, 0xc9, 0xaa, 0x70, 0xea, 0xf2, 0x42, 0xbe, 0xa4, 0x75, 0xea, 0x7d, 0x06, 0x77, 0x43, 0x02,
-	0x2b, 0x46, 0x6b, 0xde, 0x82, 0x39, 0x0d, 0x0a, 0xf7, 0x57, 0xd4, 0xef, 0x05, 0x2d, 0x12, 0xa6,
-	0x6a, 0xc2, 0x26, 0x3f, 0xdf, 0x18, 0x0e, 0xda, 0x84, 0x29, 0xa3, 0x55, 0x2d, 0xf3, 0x65, 0xf1,
-	0x64, 0x24, 0xee, 0xff, 0x09, 0x4b, 0x4d, 0xbe, 0x6f, 0x1b, 0x03, 0xef, 0xdb, 0x9b, 0xe2, 0x7d,
-	0x26, 0x4e, 0x37, 0x66, 0x6d, 0xc2, 0x26, 0x67, 0x45, 0x6c, 0xf5, 0x43, 0x41, 0xd8, 0x3c, 0x7f,
-	0x1a, 0x4a, 0x61, 0x71, 0x0b, 0x2a, 0x42, 0xfe, 0xba, 0xeb, 0xd6, 0x26, 0x50, 0x05, 0x4a, 0xab,
-	0xaa, 0x82, 0xa3, 0x66, 0x9c, 0xff, 0x6b, 0x98, 0x4d, 0xe5, 0x48, 0x51, 0x09, 0x26, 0xef, 0xf9,
-	0x1e, 0xa9, 0x4d, 0xa0, 0x1a, 0x54, 0x6e, 0x38, 0x1e, 0x0e, 0xb6, 0xe5, 0x9d, 0xa4, 0x66, 0xa3,
-	0x59, 0x98, 0x16, 0xb1, 0xb9, 0x02, 0x90, 0xe5, 0x4f, 0x4e, 0x41, 0xf5, 0xae, 0x90, 0x71, 0x9d,
-	0x04, 0x0f, 0x9d, 0x16, 0x41, 0x4d, 0xa8, 0xa5, 0x7f, 0x91, 0x41, 0xcf, 0xeb, 0xd7, 0x49, 0xff,
-	0x27, 0x4d, 0x63, 0xd8, 0xac, 0xcd, 0x09, 0xf4, 0x01, 0xcc, 0x24, 0x7f, 0x5e, 0x41, 0xfa, 0xe0,
-	0x51, 0xfb, 0x87, 0xcb, 0x4e, 0xcc, 0x9b, 0x50, 0x4d, 0xfc, 0x8b, 0x82, 0xce, 0x69, 0x79, 0xeb,
-	0xfe, 0x57, 0x69, 0xe8, 0xef, 0x73, 0xf1, 0xff, 0x45, 0xa4, 0xf4, 0xc9, 0x6a, 0xf5, 0x0c, 0xe9,
-	0xb5, 0x25, 0xed, 0x3b, 0x49, 0x8f, 0xe1, 0xd0, 0x40, 0xf1, 0x39, 0xba, 0xa8, 0xe5, 0x9f, 0x55,
-	0xa4, 0xbe, 0xd3, 0x10, 0x8f, 0x00, 0x0d, 0xfe, 0x73, 0x81, 0x2e, 0xe9, 0x57, 0x20, 0xeb, 0x8f,
-	0x93, 0xc6, 0xe5, 0x91, 0xf1, 0x23, 0xc5, 0xfd, 0x93, 0x01, 0x47, 0x32, 0x2a, 0xc6, 0xd1, 0x55,
-	0x2d, 0xbb, 0xe1, 0x65, 0xef, 0x8d, 0x17, 0x77, 0x47, 0x14, 0x09, 0xe2, 0xc1, 0x6c, 0xaa, 0x88,
-	0x1a, 0x5d, 0xc8, 0x2c, 0x2c, 0x1b, 0xac, 0x26, 0x6f, 0x3c, 0x3f, 0x1a, 0x72, 0x34, 0xde, 0x7d,
-	0x98, 0x4d, 0x55, 0x1e, 0x67, 0x8c, 0xa7, 0xaf, 0x4f, 0xde, 0x69, 0x41, 0xdf, 0x87, 0x6a, 0xa2,
-	0x44, 0x38, 0xc3, 0xe2, 0x75, 0x65, 0xc4, 0x3b, 0xb1, 0xbe, 0x0f, 0x95, 0x78, 0x25, 0x2f, 0x5a,
-	0xca, 0xda, 0x4b, 0x03, 0x8c, 0x77, 0xb3, 0x95, 0xfa, 0x85, 0x7a, 0x43, 0xb6, 0xd2, 0x40, 0x6d,
-	0xe3, 0xe8, 0x5b, 0x29, 0xc6, 0x7f, 0xe8, 0x56, 0xda, 0xf5, 0x10, 0x1f, 0x1b, 0xb0, 0xa0, 0x2f,
-	0x04, 0x45, 0xcb, 0x59, 0xb6, 0x99, 0x5d, 0xf2, 0xda, 0xb8, 0xba, 0x2b, 0x9a, 0x48, 0x8b, 0x0f,
-	0x60, 0x26, 0x59, 0xee, 0x98, 0xa1, 0x45, 0x6d, 0x85, 0x68, 0xe3, 0xc2, 0x48, 0xb8, 0xd1, 0x60,
-	0xef, 0xc2, 0x74, 0xec, 0xaf, 0x57, 0x74, 0x76, 0x88, 0x1d, 0xc7, 0x7f, 0x01, 0xdd, 0x49, 0x93,
-	0x6f, 0x43, 0x39, 0xfa, 0x59, 0x15, 0x9d, 0xc9, 0xb4, 0xdf, 0xdd, 0xb0, 0x5c, 0x07, 0xe8, 0xff,
-	0x89, 0x8a, 0x9e, 0xd3, 0xf2, 0x1c, 0xf8, 0x55, 0x75, 0x27, 0xa6, 0xd1, 0xf4, 0xe5, 0x23, 0xf3,
-	0xb0, 0xe9, 0xc7, 0xab, 0x22, 0x76, 0x62, 0xbb, 0x05, 0xd5, 0x44, 0x2d, 0x53, 0xd6, 0x16, 0xd6,
-	0x94, 0x98, 0x35, 0xce, 0x8f, 0x82, 0x1a, 0xad, 0xdf, 0x16, 0x54, 0x13, 0x95, 0x25, 0x19, 0x23,
-	0xe9, 0x0a, 0x69, 0x32, 0x46, 0xd2, 0x16, 0xaa, 0x98, 0x13, 0xe8, 0x1f, 0x62, 0x45, 0x2c, 0x89,
-	0x42, 0x21, 0x74, 0x65, 0x28, 0x1f, 0x5d, 0x9d, 0x54, 0x63, 0x79, 0x37, 0x24, 0x91, 0x08, 0xca,
-	0xaa, 0xa4, 0x4a, 0xb3, 0xad, 0x6a, 0x37, 0x2b, 0xb5, 0x0e, 0x53, 0xb2, 0x56, 0x04, 0x99, 0x19,
-	0x55, 0x61, 0xb1, 0x42, 0x92, 0xc6, 0x33, 0x5a, 0x9c, 0x64, 0x19, 0x85, 0x64, 0x2a, 0x6b, 0x01,
-	0x32, 0x98, 0x26, 0x0a, 0x05, 0x46, 0x65, 0x6a, 0xc1, 0x94, 0x7c, 0x04, 0xcc, 0x60, 0x9a, 0x78,
-	0xc8, 0x6e, 0x0c, 0xc7, 0x91, 0x2f, 0x87, 0x13, 0x68, 0x0d, 0x0a, 0x22, 0xe8, 0x45, 0xa7, 0x87,
-	0x3d, 0xa4, 0x0d, 0xe3, 0x98, 0x78, 0x6b, 0x33, 0x27, 0xd0, 0xdf, 0x42, 0x41, 0xa4, 0x70, 0x32,
-	0x38, 0xc6, 0x5f, 0xc3, 0x1a, 0x43, 0x51, 0x42, 0x11, 0x6d, 0xa8, 0xc4, 0x73, 0xe5, 0x19, 0x47,
-	0x96, 0xe6, 0x35, 0xa1, 0x31, 0x0a, 0x66, 0x38, 0x8a, 0xdc, 0x46, 0xfd, 0x0b, 0x40, 0xf6, 0x36,
-	0x1a, 0xb8, 0x5c, 0x64, 0x6f, 0xa3, 0xc1, 0xfb, 0x84, 0x39, 0x81, 0xfe, 0xc5, 0x80, 0x7a, 0x56,
-	0x02, 0x17, 0x65, 0x46, 0x40, 0xc3, 0xb2, 0xd0, 0x8d, 0x97, 0x76, 0x49, 0x15, 0xc9, 0xf2, 0x11,
-	0xcc, 0x69, 0xb2, 0x7c, 0xe8, 0x72, 0x16, 0xbf, 0x8c, 0x04, 0x65, 0xe3, 0x85, 0xd1, 0x09, 0xa2,
-	0xb1, 0xd7, 0xa0, 0x20, 0xb2, 0x73, 0x19, 0x86, 0x12, 0x4f, 0xf6, 0x65, 0x98, 0x5e, 0x22, 0xb9,
-	0x67, 0x4e, 0x20, 0x02, 0x95, 0x78, 0xaa, 0x2e, 0xc3, 0x52, 0x34, 0x59, 0xbe, 0xc6, 0xb9, 0x11,
-	0x30, 0xa3, 0x61, 0x9a, 0x00, 0xfd, 0x54, 0x59, 0xc6, 0x39, 0x34, 0x90, 0xad, 0x6b, 0x9c, 0xdd,
-	0x11, 0x2f, 0x7e, 0x24, 0xc7, 0x92, 0x5f, 0x19, 0x67, 0xd2, 0x60, 0x7a, 0x6c, 0x84, 0x7b, 0xc2,
-	0x60, 0x22, 0x26, 0xe3, 0x9e, 0x90, 0x99, 0xf3, 0x69, 0x5c, 0x1e, 0x19, 0x3f, 0x9a, 0xcf, 0x87,
-	0x50, 0x4b, 0x27, 0xae, 0x32, 0xee, 0x9f, 0x19, 0xe9, 0xb3, 0xc6, 0xc5, 0x11, 0xb1, 0xe3, 0x67,
-	0xd5, 0xb1, 0x41, 0x99, 0xfe, 0xce, 0x61, 0x5b, 0x22, 0x67, 0x32, 0xca, 0xac, 0xe3, 0xe9, 0x99,
-	0x51, 0x66, 0x9d, 0x48, 0xc6, 0x98, 0x13, 0xcb, 0x3d, 0xa8, 0xac, 0x05, 0xfe, 0xe3, 0xed, 0xf0,
-	0x16, 0xfe, 0xe7, 0xb1, 0xce, 0x1b, 0x2f, 0xfd, 0xfd, 0xd5, 0xb6, 0xc3, 0xb6, 0x7a, 0x1b, 0x7c,
-	0xfd, 0x2f, 0x4b, 0xdc, 0x8b, 0x8e, 0xaf, 0xbe, 0x2e, 0x3b, 0x1e, 0x23, 0x81, 0x87, 0xdd, 0xcb,
-	0x82, 0x97, 0x82, 0x76, 0x37, 0x36, 0xa6, 0x44, 0xfb, 0xea, 0x9f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x0d, 0x1d, 0x49, 0x34, 0x45, 0x45, 0x00, 0x00,
+	// 4119 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x5d, 0x6f, 0x1c, 0x47,
+	0x72, 0x9a, 0xfd, 0xe0, 0xee, 0x16, 0x77, 0xc9, 0x65, 0x93, 0xa2, 0xd6, 0x23, 0xcb, 0xa2, 0xc6,
+	0xd6, 0x89, 0x92, 0x4f, 0x92, 0x45, 0xd9, 0xbe, 0x8b, 0x2f, 0x07, 0x9f, 0x28, 0xc6, 0x14, 0x61,
+	0x53, 0xe1, 0xcd, 0xda, 0x67, 0x5c, 0x2e, 0xc6, 0x62, 0xb8, 0xd3, 0x5c, 0x0e, 0x34, 0x3b, 0xb3,
+	0x9e, 0xee, 0x15, 0xb5, 0x46, 0x02, 0x04, 0x70, 0x3e, 0x10, 0xdc, 0xc5, 0x87, 0x20, 0x41, 0x82,
+	0x0b, 0x90, 0x3c, 0xe4, 0xe3, 0x21, 0x6f, 0xb9, 0xe4, 0x90, 0x0b, 0x82, 0x00, 0xc9, 0x43, 0x02,
+	0xe4, 0x21, 0x40, 0x2e, 0x79, 0x48, 0x1e, 0xf2, 0x92, 0x3c, 0xe7, 0x2f, 0xe4, 0x21, 0xe8, 0x8f,
+	0x99, 0x9d, 0x99, 0xed, 0xd9, 0x0f, 0xaf, 0x75, 0x24, 0xdf, 0x76, 0xaa, 0xab, 0xaa, 0xab, 0xab,
+	0xab, 0xab, 0xab, 0xbb, 0xaa, 0x17, 0xaa, 0x5d, 0xc7, 0x7d, 0xda, 0x27, 0x77, 0x7a, 0x81, 0x4f,
+	0x7d, 0xb4, 0x1a, 0xff, 0xba, 0x23, 0x3e, 0xf4, 0x6a, 0xdb, 0xef, 0x76, 0x7d, 0x4f, 0x00, 0xf5,
+	0x2a, 0x69, 0x1f, 0xe3, 0xae, 0x25, 0xbe, 0x8c, 0x3f, 0xd2, 0x00, 0x3d, 0x0c, 0xb0, 0x45, 0xf1,
+	0x03, 0xd7, 0xb1, 0x88, 0x89, 0x3f, 0xee, 0x63, 0x42, 0xd1, 0x6b, 0x50, 0x38, 0xb4, 0x08, 0x6e,
+	0x68, 0x1b, 0xda, 0xe6, 0xe2, 0xd6, 0x8b, 0x77, 0x12, 0x6c, 0x25, 0xbb, 0x7d, 0xd2, 0xd9, 0xb6,
+	0x08, 0x36, 0x39, 0x26, 0xba, 0x04, 0x25, 0xfb, 0xb0, 0xe5, 0x59, 0x5d, 0xdc, 0xc8, 0x6d, 0x68,
+	0x9b, 0x15, 0x73, 0xc1, 0x3e, 0x7c, 0x6c, 0x75, 0x31, 0xba, 0x01, 0xcb, 0x6d, 0xdf, 0x75, 0x71,
+	0x9b, 0x3a, 0xbe, 0x27, 0x10, 0xf2, 0x1c, 0x61, 0x69, 0x08, 0xe6, 0x88, 0x6b, 0x50, 0xb4, 0x98,
+	0x0c, 0x8d, 0x02, 0x6f, 0x16, 0x1f, 0x06, 0x81, 0xfa, 0x4e, 0xe0, 0xf7, 0x9e, 0x97, 0x74, 0x51,
+	0xa7, 0xf9, 0x78, 0xa7, 0x7f, 0xa8, 0xc1, 0xca, 0x03, 0x97, 0xe2, 0xe0, 0x8c, 0x2a, 0xe5, 0x7f,
+	0x35, 0xb8, 0x24, 0x66, 0xed, 0x61, 0x84, 0x7e, 0x9a, 0x52, 0xae, 0xc3, 0x82, 0xb0, 0x2a, 0x2e,
+	0x66, 0xd5, 0x94, 0x5f, 0xe8, 0x0a, 0x00, 0x39, 0xb6, 0x02, 0x9b, 0xb4, 0xbc, 0x7e, 0xb7, 0x51,
+	0xdc, 0xd0, 0x36, 0x8b, 0x66, 0x45, 0x40, 0x1e, 0xf7, 0xbb, 0xe8, 0x3a, 0x2c, 0x79, 0xfd, 0x6e,
+	0xab, 0x67, 0x05, 0xd4, 0x61, 0xbc, 0x48, 0x63, 0x61, 0x43, 0xdb, 0xcc, 0x9b, 0x35, 0xaf, 0xdf,
+	0x3d, 0x88, 0x80, 0xc6, 0x77, 0x35, 0xb8, 0xc8, 0x6c, 0xe0, 0x4c, 0x8c, 0xd5, 0xf8, 0x73, 0x0d,
+	0xd6, 0x1e, 0x59, 0xe4, 0x6c, 0x28, 0xfe, 0x0a, 0x00, 0x75, 0xba, 0xb8, 0x45, 0xa8, 0xd5, 0xed,
+	0x71, 0xe5, 0x17, 0xcc, 0x0a, 0x83, 0x34, 0x19, 0xc0, 0xf8, 0x36, 0x54, 0xb7, 0x7d, 0xdf, 0x35,
+	0x31, 0xe9, 0xf9, 0x1e, 0xc1, 0xe8, 0x3e, 0x2c, 0x10, 0x6a, 0xd1, 0x3e, 0x91, 0x42, 0x5e, 0x56,
+	0x0a, 0xd9, 0xe4, 0x28, 0xa6, 0x44, 0x65, 0x26, 0xf8, 0xd4, 0x72, 0xfb, 0x42, 0xc6, 0xb2, 0x29,
+	0x3e, 0x8c, 0xef, 0xc0, 0x52, 0x93, 0x06, 0x8e, 0xd7, 0xf9, 0x02, 0x99, 0x57, 0x42, 0xe6, 0xff,
+	0xae, 0xc1, 0x0b, 0x3b, 0x98, 0xb4, 0x03, 0xe7, 0xf0, 0x8c, 0x58, 0xb8, 0x01, 0xd5, 0x21, 0x64,
+	0x6f, 0x87, 0xab, 0x3a, 0x6f, 0x26, 0x60, 0xa9, 0xc9, 0x28, 0xa6, 0x27, 0xe3, 0xd3, 0x02, 0xe8,
+	0xaa, 0x41, 0xcd, 0xa3, 0xbe, 0xaf, 0x47, 0x0b, 0x2f, 0xc7, 0x89, 0xae, 0x27, 0x89, 0x44, 0xdb,
+	0x9d, 0x61, 0x6f, 0x4d, 0x0e, 0x88, 0xd6, 0x67, 0x7a, 0x54, 0x79, 0xc5, 0xa8, 0xb6, 0xe0, 0xe2,
+	0x53, 0x27, 0xa0, 0x7d, 0xcb, 0x6d, 0xb5, 0x8f, 0x2d, 0xcf, 0xc3, 0x2e, 0xd7, 0x13, 0xf3, 0x48,
+	0xf9, 0xcd, 0x8a, 0xb9, 0x2a, 0x1b, 0x1f, 0x8a, 0x36, 0xa6, 0x2c, 0x82, 0x5e, 0x87, 0xf5, 0xde,
+	0xf1, 0x80, 0x38, 0xed, 0x11, 0xa2, 0x22, 0x27, 0x5a, 0x0b, 0x5b, 0x13, 0x54, 0xaf, 0xc2, 0x4a,
+	0x9b, 0x3b, 0x35, 0xbb, 0xc5, 0xb4, 0x26, 0xd4, 0xb8, 0xc0, 0xd5, 0x58, 0x97, 0x0d, 0xef, 0x87,
+	0x70, 0x26, 0x56, 0x88, 0xdc, 0xa7, 0xed, 0x18, 0x41, 0x89, 0x13, 0xac, 0xca, 0xc6, 0x0f, 0x68,
+	0x7b, 0x48, 0x93, 0x74, 0x47, 0xe5, 0xb4, 0x3b, 0x6a, 0x40, 0x89, 0xbb, 0x57, 0x4c, 0x1a, 0x15,
+	0x2e, 0x66, 0xf8, 0x89, 0xf6, 0x60, 0x99, 0x50, 0x2b, 0xa0, 0xad, 0x9e, 0x4f, 0xa4, 0xa7, 0x82,
+	0x8d, 0xfc, 0xe6, 0xe2, 0xd6, 0x86, 0x72, 0x92, 0xde, 0xc5, 0x83, 0x1d, 0x8b, 0x5a, 0x07, 0x96,
+	0x13, 0x98, 0x4b, 0x9c, 0xf0, 0xc0, 0x27, 0x31, 0x67, 0xf6, 0x9e, 0x6f, 0xd9, 0x67, 0xc3, 0x99,
+	0x7d, 0xa6, 0x41, 0xc3, 0xc4, 0x2e, 0xb6, 0xc8, 0xd9, 0x58, 0x67, 0xc6, 0xef, 0x6a, 0xf0, 0xd2,
+	0x2e, 0xa6, 0x31, 0x8b, 0xa5, 0x16, 0x75, 0x08, 0x75, 0xda, 0xa7, 0xb9, 0x0d, 0x1b, 0xdf, 0xd7,
+	0xe0, 0x6a, 0xa6, 0x58, 0xf3, 0x2c, 0xe0, 0xaf, 0x40, 0x91, 0xfd, 0x22, 0x8d, 0x1c, 0xb7, 0xa7,
+	0x6b, 0x59, 0xf6, 0xf4, 0x2d, 0xe6, 0x17, 0xb9, 0x41, 0x09, 0x7c, 0xe3, 0xbf, 0x35, 0x58, 0x6f,
+	0x1e, 0xfb, 0x27, 0x43, 0x91, 0x9e, 0x87, 0x82, 0x92, 0x2e, 0x2d, 0x9f, 0x72, 0x69, 0xe8, 0x1e,
+	0x14, 0xe8, 0xa0, 0x87, 0xb9, 0x37, 0x5c, 0xda, 0xba, 0x72, 0x47, 0x11, 0x7d, 0xde, 0x61, 0x42,
+	0xbe, 0x3f, 0xe8, 0x61, 0x93, 0xa3, 0xa2, 0x9b, 0x50, 0x4f, 0xa9, 0x3c, 0x74, 0x0a, 0xcb, 0x49,
+	0x9d, 0x13, 0xe3, 0x6f, 0x72, 0x70, 0x69, 0x64, 0x88, 0xf3, 0x28, 0x5b, 0xd5, 0x77, 0x4e, 0xd9,
+	0x37, 0x0b, 0x4d, 0x62, 0xa8, 0x8e, 0xcd, 0x02, 0xc4, 0x3c, 0x0b, 0x4d, 0x86, 0xd0, 0x3d, 0x9b,
+	0xa0, 0xdb, 0x80, 0x46, 0x5c, 0x96, 0xf0, 0x8c, 0x05, 0x73, 0x25, 0xed, 0xb3, 0xb8, 0x5f, 0x54,
+	0x3a, 0x2d, 0xa1, 0x82, 0x82, 0xb9, 0xa6, 0xf0, 0x5a, 0x04, 0xdd, 0x83, 0x35, 0xc7, 0xdb, 0xc7,
+	0x5d, 0x3f, 0x18, 0xb4, 0x7a, 0x38, 0x68, 0x63, 0x8f, 0x5a, 0x1d, 0xcc, 0x82, 0x25, 0x26, 0xd1,
+	0x6a, 0xd8, 0x76, 0x30, 0x6c, 0x32, 0xfe, 0x40, 0x83, 0x4b, 0x26, 0x66, 0x23, 0x7c, 0xae, 0xcb,
+	0xfa, 0x05, 0x28, 0xfb, 0xae, 0x1d, 0x5f, 0x38, 0x25, 0xdf, 0xb5, 0xc3, 0x26, 0x0f, 0x9f, 0x88,
+	0x26, 0x11, 0xbb, 0x96, 0x3c, 0x7c, 0x12, 0x73, 0x3a, 0x6d, 0xff, 0x29, 0x0e, 0xce, 0x86, 0xd3,
+	0xf9, 0x11, 0xd7, 0x16, 0x17, 0x28, 0x0a, 0x3b, 0x4f, 0x33, 0xd8, 0xb8, 0x0e, 0x4b, 0x51, 0x4c,
+	0x1c, 0xd7, 0x60, 0x2d, 0x82, 0x72, 0xb1, 0xff, 0x4a, 0x83, 0x75, 0x71, 0x0a, 0x38, 0x4f, 0x52,
+	0xff, 0x50, 0x83, 0x35, 0x16, 0xcd, 0x9f, 0x27, 0x99, 0xff, 0x42, 0x83, 0xd5, 0x47, 0x16, 0x39,
+	0x4f, 0x22, 0xff, 0x48, 0xc6, 0x19, 0x91, 0xcc, 0xa7, 0x7a, 0x8c, 0xbd, 0x01, 0xcb, 0x49, 0xa1,
+	0xc3, 0xf0, 0x71, 0x29, 0x21, 0x35, 0x31, 0x7e, 0x3c, 0x0c, 0x48, 0xce, 0x99, 0xe4, 0x7f, 0xab,
+	0xc1, 0x95, 0x5d, 0x4c, 0x23, 0xa9, 0xcf, 0x44, 0xe0, 0x32, 0xad, 0xb5, 0x7c, 0x26, 0xc2, 0x2e,
+	0xa5, 0xf0, 0xa7, 0x12, 0xde, 0x7c, 0x37, 0x07, 0x17, 0xd9, 0xde, 0x7f, 0x36, 0x8c, 0x60, 0x9a,
+	0xd3, 0x9f, 0xc2, 0x50, 0x8a, 0x2a, 0x43, 0x89, 0x82, 0xa6, 0x85, 0xa9, 0x83, 0x26, 0xe3, 0x2f,
+	0x73, 0xb0, 0x9e, 0xd6, 0xc6, 0x3c, 0xd3, 0xa2, 0x90, 0x35, 0xa7, 0x94, 0xd5, 0x80, 0x6a, 0x04,
+	0xd9, 0xdb, 0x09, 0x83, 0xa0, 0x04, 0xec, 0xcc, 0xc6, 0x40, 0xff, 0xa4, 0xc1, 0x7a, 0x78, 0xde,
+	0x6e, 0xe2, 0x4e, 0x17, 0x7b, 0xf4, 0xf3, 0xdb, 0x50, 0xda, 0x02, 0x72, 0x0a, 0x0b, 0x78, 0x11,
+	0x2a, 0x44, 0xf4, 0x13, 0x1d, 0xa5, 0x87, 0x00, 0x76, 0xba, 0x3c, 0x72, 0xb0, 0x6b, 0x47, 0xe6,
+	0x13, 0x7e, 0xb2, 0x20, 0xdb, 0xf1, 0x6c, 0xfc, 0x4c, 0x58, 0x60, 0x91, 0x5b, 0x60, 0x85, 0x43,
+	0xf8, 0xda, 0xfc, 0x7b, 0x0d, 0x2e, 0x8d, 0x8c, 0x63, 0x9e, 0xd9, 0x6f, 0x40, 0x89, 0x73, 0x8f,
+	0x86, 0x11, 0x7e, 0xb2, 0x96, 0xc3, 0xbe, 0xe3, 0xda, 0x91, 0xfc, 0xe1, 0x27, 0xba, 0x06, 0x55,
+	0xec, 0x59, 0x87, 0x2e, 0x6e, 0x71, 0x5c, 0x3e, 0x84, 0xb2, 0xb9, 0x28, 0x60, 0x7b, 0x0c, 0x14,
+	0x1f, 0x60, 0x31, 0x31, 0x40, 0xe3, 0xb7, 0x34, 0x58, 0x65, 0xe6, 0x2b, 0xa5, 0x27, 0xcf, 0x77,
+	0x1a, 0x36, 0x60, 0x31, 0x66, 0x9f, 0x72, 0x20, 0x71, 0x90, 0xf1, 0x04, 0xd6, 0x92, 0xe2, 0xcc,
+	0xa3, 0xcd, 0x97, 0x00, 0xa2, 0x49, 0x16, 0xcb, 0x28, 0x6f, 0xc6, 0x20, 0xc6, 0xf7, 0x72, 0xe1,
+	0x0d, 0x3b, 0x57, 0xd3, 0x29, 0xdf, 0x16, 0xf2, 0x29, 0x89, 0x6f, 0x04, 0x15, 0x0e, 0xe1, 0xcd,
+	0x3b, 0x50, 0xc5, 0xcf, 0x68, 0x60, 0xb1, 0x0b, 0x59, 0xab, 0x2b, 0xd6, 0xe3, 0x54, 0x3e, 0x7b,
+	0x91, 0x93, 0x1d, 0x70, 0xaa, 0x94, 0x35, 0x2f, 0xa4, 0xad, 0xf9, 0x9f, 0x59, 0xf8, 0x27, 0xad,
+	0xf9, 0xac, 0x2b, 0x64, 0xc2, 0xc2, 0xfc, 0x33, 0x0d, 0xea, 0x7c, 0x08, 0x62, 0x3c, 0x3d, 0xc6,
+	0x36, 0x45, 0xa3, 0xa5, 0x68, 0xc6, 0xac, 0xbd, 0x9f, 0x81, 0x05, 0xa9, 0xf7, 0xfc, 0xb4, 0x7a,
+	0x97, 0x04, 0x13, 0x86, 0x61, 0xfc, 0x31, 0xbb, 0x3f, 0x4f, 0xaa, 0x7c, 0x1e, 0x83, 0x7f, 0x1f,
+	0x90, 0x18, 0xa1, 0x3d, 0x1c, 0x76, 0xb8, 0xc1, 0x5f, 0x57, 0xee, 0x66, 0x69, 0x25, 0x99, 0x2b,
+	0x4e, 0x0a, 0x42, 0x8c, 0x9f, 0x68, 0xf0, 0xe2, 0x2e, 0xa6, 0x1c, 0x75, 0x9b, 0x39, 0x9d, 0x83,
+	0xc0, 0xef, 0x04, 0x98, 0x90, 0xf3, 0x6b, 0x1f, 0xbf, 0x27, 0x22, 0x42, 0xd5, 0x90, 0xe6, 0xd1,
+	0xff, 0x35, 0xa8, 0xf2, 0x3e, 0xb0, 0xdd, 0x0a, 0xfc, 0x13, 0x22, 0xed, 0x68, 0x51, 0xc2, 0x4c,
+	0xff, 0x84, 0x1b, 0x04, 0xf5, 0xa9, 0xe5, 0x0a, 0x04, 0xb9, 0x15, 0x71, 0x08, 0x6b, 0xe6, 0x6b,
+	0x30, 0x14, 0x8c, 0x31, 0xc7, 0xe7, 0x57, 0xc7, 0x7f, 0xaa, 0xc1, 0xc5, 0xd4, 0x50, 0xe6, 0xd1,
+	0xed, 0x1b, 0x22, 0x5e, 0x15, 0x83, 0x59, 0xda, 0xba, 0xaa, 0xa4, 0x89, 0x75, 0x26, 0xb0, 0xd1,
+	0x55, 0x58, 0x3c, 0xb2, 0x1c, 0xb7, 0x15, 0x60, 0x8b, 0xf8, 0x9e, 0x1c, 0x28, 0x30, 0x90, 0xc9,
+	0x21, 0xc6, 0x3f, 0x6a, 0x22, 0x8d, 0x79, 0xce, 0x3d, 0xde, 0x9f, 0xe4, 0xa0, 0xb6, 0xe7, 0x11,
+	0x1c, 0xd0, 0xb3, 0x7f, 0xa6, 0x41, 0x6f, 0xc3, 0x22, 0x1f, 0x18, 0x69, 0xd9, 0x16, 0xb5, 0xe4,
+	0x6e, 0xf6, 0x92, 0x32, 0x41, 0xf2, 0x0e, 0xc3, 0x63, 0x57, 0xf6, 0xa6, 0xd0, 0x0e, 0x61, 0xbf,
+	0xd1, 0x65, 0xa8, 0x1c, 0x5b, 0xe4, 0xb8, 0xf5, 0x04, 0x0f, 0x44, 0xa0, 0x59, 0x33, 0xcb, 0x0c,
+	0xf0, 0x2e, 0x1e, 0x10, 0x7e, 0xbf, 0xd5, 0xef, 0x8a, 0x05, 0xc6, 0x52, 0x0e, 0x35, 0xb3, 0xe4,
+	0xf5, 0xbb, 0x7c, 0x79, 0xfd, 0x4b, 0x0e, 0x96, 0xf6, 0xfb, 0xd4, 0x92, 0xe9, 0x9d, 0xbe, 0x4b,
+	0x3f, 0x9f, 0x31, 0xde, 0x82, 0xbc, 0x08, 0x29, 0x18, 0x45, 0x43, 0x29, 0xf8, 0xde, 0x0e, 0x31,
+	0x19, 0x12, 0x9b, 0x38, 0xd2, 0x6f, 0xb7, 0x65, 0x74, 0x96, 0xe7, 0xc2, 0x56, 0x18, 0x44, 0xc4,
+	0x66, 0x97, 0xa1, 0x82, 0x83, 0x20, 0x8a, 0xdd, 0xf8, 0x50, 0x70, 0x10, 0x88, 0x46, 0x03, 0xaa,
+	0x56, 0xfb, 0x89, 0xe7, 0x9f, 0xb8, 0xd8, 0xee, 0x60, 0x9b, 0x4f, 0x7b, 0xd9, 0x4c, 0xc0, 0x84,
+	0x61, 0xb0, 0x89, 0x6f, 0xb5, 0x3d, 0x2a, 0xd3, 0xb4, 0x15, 0x01, 0x79, 0xe8, 0x51, 0xd6, 0x6c,
+	0x63, 0x17, 0x53, 0xcc, 0x9b, 0x4b, 0xa2, 0x59, 0x40, 0x64, 0x73, 0xbf, 0x17, 0x51, 0x97, 0x45,
+	0xb3, 0x80, 0xb0, 0xe6, 0x17, 0xa1, 0x32, 0xcc, 0xdf, 0x54, 0x86, 0x97, 0xcc, 0x1c, 0x60, 0xfc,
+	0x97, 0x06, 0xb5, 0x1d, 0xce, 0xea, 0x1c, 0x18, 0x1d, 0x82, 0x02, 0x7e, 0xd6, 0x0b, 0xe4, 0xd2,
+	0xe1, 0xbf, 0xc7, 0xda, 0x91, 0xf1, 0x14, 0xea, 0x07, 0xae, 0xd5, 0xc6, 0xc7, 0xbe, 0x6b, 0xe3,
+	0x80, 0xef, 0xed, 0xa8, 0x0e, 0x79, 0x6a, 0x75, 0x64, 0xf0, 0xc0, 0x7e, 0xa2, 0xaf, 0xca, 0x33,
+	0xa3, 0x70, 0x4b, 0xaf, 0x28, 0x77, 0xd9, 0x18, 0x9b, 0xd8, 0x7d, 0xfb, 0x3a, 0x2c, 0xf0, 0x9c,
+	0xaa, 0x08, 0x2b, 0xaa, 0xa6, 0xfc, 0x32, 0x3e, 0x4a, 0xf4, 0xbb, 0x1b, 0xf8, 0xfd, 0x1e, 0xda,
+	0x83, 0x6a, 0x6f, 0x08, 0x63, 0xb6, 0x9a, 0xbd, 0xa7, 0xa7, 0x85, 0x36, 0x13, 0xa4, 0xc6, 0xdf,
+	0x15, 0xa0, 0xd6, 0xc4, 0x56, 0xd0, 0x3e, 0x3e, 0x0f, 0x97, 0x37, 0x4c, 0xe3, 0x36, 0x71, 0xe5,
+	0xac, 0xb1, 0x9f, 0x2c, 0x19, 0x19, 0x1b, 0x50, 0xab, 0xc3, 0x14, 0xc4, 0xed, 0xbe, 0x6a, 0xd6,
+	0x7b, 0x69, 0xc5, 0x7d, 0x05, 0xca, 0x36, 0x71, 0x5b, 0x7c, 0x8a, 0x4a, 0x7c, 0x8a, 0xd4, 0xe3,
+	0xdb, 0x21, 0x2e, 0x9f, 0x9a, 0x92, 0x2d, 0x7e, 0xa0, 0x97, 0xa1, 0xe6, 0xf7, 0x69, 0xaf, 0x4f,
+	0x5b, 0xc2, 0xef, 0x34, 0xca, 0x5c, 0xbc, 0xaa, 0x00, 0x72, 0xb7, 0x44, 0xd0, 0x3b, 0x50, 0x23,
+	0x5c, 0x95, 0x61, 0x60, 0x5e, 0x99, 0x36, 0x40, 0xac, 0x0a, 0x3a, 0x19, 0x99, 0xdf, 0x84, 0x3a,
+	0x0d, 0xac, 0xa7, 0xd8, 0x8d, 0x65, 0x4b, 0x81, 0xaf, 0xb6, 0x65, 0x01, 0x1f, 0x66, 0x4a, 0xef,
+	0xc2, 0x6a, 0xa7, 0x6f, 0x05, 0x96, 0x47, 0x31, 0x8e, 0x61, 0x2f, 0x72, 0x6c, 0x14, 0x35, 0x0d,
+	0x09, 0x6e, 0x41, 0x9e, 0x25, 0x49, 0xaa, 0x93, 0x7c, 0x95, 0x63, 0xf3, 0x00, 0x06, 0x3f, 0x6b,
+	0xbb, 0x7d, 0x1b, 0xb7, 0x08, 0xc6, 0x76, 0xa3, 0x26, 0xcf, 0x92, 0x02, 0xd6, 0xc4, 0xd8, 0x36,
+	0xde, 0x85, 0xc2, 0x23, 0x87, 0xf2, 0x79, 0xd9, 0xdb, 0x11, 0x86, 0x98, 0x17, 0x8e, 0xee, 0x05,
+	0x28, 0x07, 0xfe, 0x89, 0x70, 0xe9, 0x39, 0x6e, 0xd1, 0xa5, 0xc0, 0x3f, 0xe1, 0xfe, 0x9a, 0x57,
+	0xa1, 0xf8, 0x81, 0x34, 0xf5, 0x9c, 0x29, 0xbf, 0x8c, 0x5f, 0xd3, 0x86, 0xb6, 0xc8, 0xbc, 0x31,
+	0xf9, 0x7c, 0xee, 0xf8, 0x6d, 0x28, 0x05, 0x82, 0x7e, 0x6c, 0xb2, 0x3d, 0xde, 0x13, 0xdf, 0x52,
+	0x42, 0x2a, 0xe3, 0x57, 0x35, 0xa8, 0xbe, 0xe3, 0xf6, 0xc9, 0xf3, 0x58, 0x12, 0xaa, 0xd4, 0x56,
+	0x5e, 0x9d, 0x56, 0xfb, 0xed, 0x1c, 0xd4, 0xa4, 0x18, 0xf3, 0x84, 0x4a, 0x99, 0xa2, 0x34, 0x61,
+	0x91, 0x75, 0xd9, 0x22, 0xb8, 0x13, 0x5e, 0x19, 0x2d, 0x6e, 0x6d, 0x29, 0x9d, 0x48, 0x42, 0x0c,
+	0x5e, 0xa6, 0xd0, 0xe4, 0x44, 0x3f, 0xe7, 0xd1, 0x60, 0x60, 0x42, 0x3b, 0x02, 0xe8, 0x1f, 0xc1,
+	0x72, 0xaa, 0x99, 0xd9, 0xc6, 0x13, 0x3c, 0x08, 0xbd, 0xe4, 0x13, 0x3c, 0x40, 0xaf, 0xc7, 0x8b,
+	0x49, 0xb2, 0xf6, 0xfa, 0xf7, 0x7c, 0xaf, 0xf3, 0x20, 0x08, 0xac, 0x81, 0x2c, 0x36, 0x79, 0x2b,
+	0xf7, 0x55, 0xcd, 0xf8, 0x87, 0x1c, 0x54, 0xbf, 0xd9, 0xc7, 0xc1, 0xe0, 0x34, 0xbd, 0x55, 0xb8,
+	0x77, 0x14, 0x62, 0x7b, 0xc7, 0x88, 0x83, 0x28, 0x2a, 0x1c, 0x84, 0xc2, 0xcd, 0x2d, 0x28, 0xdd,
+	0x9c, 0xca, 0x03, 0x94, 0x66, 0xf2, 0x00, 0xe5, 0x2c, 0x0f, 0xc0, 0xad, 0x5b, 0xaa, 0x70, 0xae,
+	0x45, 0x96, 0x08, 0xda, 0x72, 0xb3, 0x06, 0x6d, 0xc6, 0x7f, 0xe4, 0x00, 0x76, 0xf1, 0xa9, 0xc6,
+	0xa7, 0xd2, 0x17, 0x16, 0xa6, 0xf1, 0x85, 0xe7, 0x67, 0x7e, 0x7f, 0xa8, 0x41, 0xe5, 0x5b, 0xb8,
+	0x4d, 0xfd, 0x80, 0xb9, 0x61, 0x85, 0x32, 0xb4, 0x29, 0x0e, 0x1c, 0xb9, 0xf4, 0x81, 0xe3, 0x3e,
+	0x94, 0x1d, 0xbb, 0x65, 0xb1, 0xf5, 0xd8, 0xc8, 0x4f, 0x50, 0x58, 0xc9, 0xb1, 0xf9, 0xc2, 0x9d,
+	0x3e, 0x27, 0xf3, 0xfb, 0x1a, 0x54, 0x85, 0xcc, 0x44, 0x50, 0x7e, 0x2d, 0xd6, 0x9d, 0xa6, 0x72,
+	0x12, 0xf2, 0x23, 0x1a, 0xe8, 0xa3, 0x0b, 0xc3, 0x6e, 0x1f, 0x00, 0x30, 0xa3, 0x94, 0xe4, 0xc2,
+	0xc7, 0x6c, 0x28, 0xa5, 0x15, 0xe4, 0x7c, 0xf6, 0x1e, 0x5d, 0x30, 0x2b, 0x8c, 0x8a, 0xb3, 0xd8,
+	0x2e, 0x41, 0x91, 0x53, 0x1b, 0xff, 0xa7, 0xc1, 0xea, 0x43, 0xcb, 0x6d, 0xef, 0x38, 0x84, 0x5a,
+	0x5e, 0x7b, 0x8e, 0xd0, 0xf6, 0x2d, 0x28, 0xf9, 0xbd, 0x96, 0x8b, 0x8f, 0xa8, 0x14, 0xe9, 0xda,
+	0x98, 0x11, 0x09, 0x35, 0x98, 0x0b, 0x7e, 0xef, 0x3d, 0x7c, 0x44, 0xd1, 0xcf, 0x42, 0xd9, 0xef,
+	0xb5, 0x02, 0xa7, 0x73, 0x4c, 0x1b, 0xf9, 0x69, 0x89, 0x4b, 0x7e, 0xcf, 0x64, 0x14, 0xb1, 0x1b,
+	0xab, 0xc2, 0x8c, 0x37, 0x56, 0xc6, 0xbf, 0x8d, 0x0c, 0x7f, 0x0e, 0x9f, 0xf1, 0x16, 0x94, 0x1d,
+	0x8f, 0xb6, 0x6c, 0x87, 0x84, 0x2a, 0xb8, 0xa2, 0xb6, 0x21, 0x8f, 0xf2, 0x11, 0xf0, 0x39, 0xf5,
+	0x28, 0xeb, 0x1b, 0x7d, 0x03, 0xe0, 0xc8, 0xf5, 0x2d, 0x49, 0x2d, 0x74, 0x70, 0x55, 0xed, 0x6e,
+	0x18, 0x5a, 0x48, 0x5f, 0xe1, 0x44, 0x8c, 0xc3, 0x70, 0x4a, 0xff, 0x55, 0x83, 0x8b, 0x07, 0x38,
+	0x20, 0x0e, 0xa1, 0xd8, 0xa3, 0xf2, 0x72, 0x79, 0xcf, 0x3b, 0xf2, 0x93, 0x89, 0x01, 0x2d, 0x9d,
+	0x18, 0xf8, 0x42, 0xee, 0xb4, 0x13, 0xe7, 0x51, 0x99, 0x5f, 0x90, 0xe7, 0xd1, 0x30, 0x09, 0x27,
+	0xce, 0xf3, 0x4b, 0x19, 0xd3, 0x24, 0xe5, 0x8d, 0x5f, 0x6b, 0x18, 0xbf, 0x23, 0xaa, 0x9e, 0x94,
+	0x83, 0xfa, 0xfc, 0x06, 0xbb, 0x0e, 0xd2, 0xa3, 0xa6, 0xfc, 0xeb, 0x97, 0x20, 0xe5, 0x3b, 0x32,
+	0xaa, 0x35, 0x7e, 0xa0, 0xc1, 0x46, 0xb6, 0x54, 0xf3, 0x84, 0x34, 0xdf, 0x80, 0xa2, 0xe3, 0x1d,
+	0xf9, 0xe1, 0x65, 0xe6, 0x2d, 0xf5, 0xc1, 0x47, 0xd9, 0xaf, 0x20, 0x34, 0xfe, 0x3a, 0x07, 0x75,
+	0xbe, 0x09, 0x9e, 0xc2, 0xf4, 0x77, 0x71, 0xb7, 0x45, 0x9c, 0x4f, 0x70, 0x38, 0xfd, 0x5d, 0xdc,
+	0x6d, 0x3a, 0x9f, 0xe0, 0x84, 0x65, 0x14, 0x93, 0x96, 0x31, 0xfe, 0xae, 0x3e, 0x7e, 0x59, 0x5d,
+	0x4a, 0x5e, 0x56, 0xaf, 0xc3, 0x82, 0xe7, 0xdb, 0x78, 0x6f, 0x47, 0x1e, 0xe6, 0xe5, 0xd7, 0xd0,
+	0xd4, 0x2a, 0x33, 0x9a, 0xda, 0x67, 0x1a, 0xe8, 0xbb, 0x98, 0xa6, 0x75, 0x77, 0x7a, 0x56, 0xf6,
+	0x7d, 0x0d, 0x2e, 0x2b, 0x05, 0x9a, 0xc7, 0xc0, 0xbe, 0x96, 0x34, 0x30, 0xf5, 0xc9, 0x7a, 0xa4,
+	0x4b, 0x69, 0x5b, 0xf7, 0xa0, 0xba, 0xd3, 0xef, 0x76, 0xa3, 0x10, 0xf5, 0x1a, 0x54, 0x03, 0xf1,
+	0x53, 0x1c, 0x3c, 0xc5, 0xfe, 0xbb, 0x28, 0x61, 0xec, 0x78, 0x69, 0xbc, 0x0a, 0x35, 0x49, 0x22,
+	0xa5, 0xd6, 0xa1, 0x1c, 0xc8, 0xdf, 0x12, 0x3f, 0xfa, 0x36, 0x2e, 0xc2, 0xaa, 0x89, 0x3b, 0xcc,
+	0xb4, 0x83, 0xf7, 0x1c, 0xef, 0x89, 0xec, 0xc6, 0xf8, 0x54, 0x83, 0xb5, 0x24, 0x5c, 0xf2, 0x7a,
+	0x13, 0x4a, 0x96, 0x6d, 0x07, 0x98, 0x90, 0xb1, 0xd3, 0xf2, 0x40, 0xe0, 0x98, 0x21, 0x72, 0x4c,
+	0x73, 0xb9, 0xa9, 0x35, 0x67, 0xb4, 0x60, 0x65, 0x17, 0xd3, 0x7d, 0x4c, 0x83, 0xb9, 0x0a, 0x2a,
+	0x1a, 0xec, 0x0c, 0xc7, 0x89, 0xa5, 0x59, 0x84, 0x9f, 0xc6, 0xf7, 0x34, 0x40, 0xf1, 0x1e, 0xe6,
+	0x99, 0xe6, 0xb8, 0x96, 0x73, 0x49, 0x2d, 0x8b, 0xc2, 0xc2, 0x6e, 0xcf, 0xf7, 0xb0, 0x47, 0xe3,
+	0x41, 0x64, 0x2d, 0x82, 0x86, 0xb5, 0x5d, 0x88, 0x95, 0xef, 0x6c, 0x5b, 0xee, 0x7c, 0xe1, 0x01,
+	0xbb, 0x18, 0x0c, 0xda, 0x2d, 0xb9, 0x5a, 0x73, 0xd2, 0xfb, 0x04, 0xed, 0xc7, 0x62, 0xc1, 0x5e,
+	0x85, 0x45, 0x9b, 0x50, 0xd9, 0x1c, 0xe6, 0xf7, 0xc1, 0x26, 0x54, 0xb4, 0xf3, 0xa2, 0x6c, 0x82,
+	0x2d, 0x17, 0xdb, 0xad, 0x58, 0x96, 0xb3, 0xc0, 0xd1, 0xea, 0xa2, 0xa1, 0x19, 0xc1, 0x8d, 0x8f,
+	0xe0, 0xd2, 0xbe, 0xe5, 0xb1, 0x6a, 0x70, 0xbf, 0xdb, 0xb3, 0x12, 0x75, 0x7d, 0x69, 0x37, 0xa7,
+	0x29, 0xdc, 0xdc, 0x4b, 0xa2, 0xda, 0x54, 0x84, 0xaa, 0x5c, 0xd6, 0x82, 0x19, 0x83, 0x18, 0x04,
+	0x1a, 0xa3, 0xec, 0xe7, 0x99, 0x28, 0x2e, 0x54, 0xc8, 0x2a, 0xee, 0x7b, 0x87, 0x30, 0xe3, 0x6d,
+	0x78, 0x81, 0x57, 0xfe, 0x86, 0xa0, 0x44, 0xc2, 0x24, 0xcd, 0x40, 0x53, 0x30, 0xf8, 0x8d, 0x1c,
+	0xe8, 0x2a, 0x0e, 0xf3, 0x08, 0xfe, 0x56, 0x32, 0x4f, 0xf1, 0x8a, 0x92, 0x26, 0xdd, 0xa3, 0x20,
+	0x41, 0x9b, 0xb0, 0x8c, 0x9f, 0xe1, 0x76, 0x9f, 0x3a, 0x5e, 0xe7, 0xc0, 0xb5, 0xbc, 0xc7, 0xbe,
+	0xdc, 0x50, 0xd2, 0x60, 0xf4, 0x0a, 0xd4, 0x98, 0xf6, 0xfd, 0x3e, 0x95, 0x78, 0x62, 0x67, 0x49,
+	0x02, 0x19, 0x3f, 0x36, 0x5e, 0x17, 0x53, 0x6c, 0x4b, 0x3c, 0xb1, 0xcd, 0xa4, 0xc1, 0x23, 0xaa,
+	0x64, 0x60, 0x32, 0x8b, 0x2a, 0xff, 0x53, 0x03, 0x5d, 0xc5, 0xe1, 0xb4, 0x54, 0xf9, 0x08, 0xa0,
+	0x8b, 0x83, 0x0e, 0xde, 0xe3, 0x4e, 0x5d, 0xdc, 0x74, 0x6c, 0x2a, 0x9d, 0xfa, 0x90, 0xc1, 0x7e,
+	0x48, 0x60, 0xc6, 0x68, 0x8d, 0x5d, 0x58, 0x55, 0xa0, 0x30, 0x7f, 0x45, 0xfc, 0x7e, 0xd0, 0xc6,
+	0xe1, 0x1d, 0x58, 0xf8, 0xc9, 0xf6, 0x37, 0x6a, 0x05, 0x1d, 0x4c, 0xa5, 0xd1, 0xca, 0x2f, 0x16,
+	0xb3, 0xe9, 0xcd, 0x84, 0x8a, 0x7c, 0xd7, 0x69, 0x0f, 0x66, 0x59, 0x86, 0xeb, 0xb0, 0xd0, 0xe3,
+	0x44, 0xe1, 0xd6, 0x29, 0xbe, 0xe6, 0xc8, 0x50, 0xb3, 0x02, 0xca, 0x4b, 0x3b, 0xc1, 0xc0, 0xec,
+	0x7b, 0xcf, 0xc5, 0x33, 0xc4, 0x44, 0xce, 0x67, 0x88, 0x3c, 0xf3, 0x11, 0xe5, 0x7f, 0x34, 0x68,
+	0x8c, 0x8a, 0x3c, 0x8f, 0xa5, 0x7d, 0xf1, 0x7a, 0x45, 0x5f, 0x87, 0x62, 0x8f, 0x2d, 0x01, 0x39,
+	0xbc, 0x1b, 0x13, 0x6c, 0x8f, 0x2d, 0x17, 0x11, 0x52, 0x70, 0x2a, 0xe3, 0x17, 0x01, 0x8d, 0x36,
+	0xb2, 0x7b, 0xa8, 0x58, 0x40, 0xc1, 0x7f, 0x33, 0x43, 0x94, 0x0f, 0x79, 0xc2, 0x8d, 0x53, 0x7e,
+	0xc6, 0x4d, 0x34, 0x9f, 0x30, 0x51, 0xe3, 0x43, 0x1e, 0x41, 0x0d, 0x3b, 0x78, 0xe4, 0x10, 0xea,
+	0x07, 0x33, 0x99, 0xe2, 0x1a, 0x14, 0x5d, 0xa7, 0xeb, 0x84, 0x46, 0x2e, 0x3e, 0x8c, 0x1f, 0x88,
+	0x5a, 0x01, 0x05, 0xe7, 0x79, 0xa6, 0x67, 0x07, 0x2a, 0xc7, 0x9c, 0x8f, 0x83, 0xc3, 0x00, 0xed,
+	0x4b, 0x13, 0xf4, 0x19, 0xf6, 0x3b, 0x24, 0x34, 0x7e, 0x9c, 0x87, 0x95, 0x11, 0x04, 0x3e, 0xf5,
+	0xae, 0x35, 0x1c, 0xa5, 0xfc, 0x9a, 0x2a, 0xf8, 0x0f, 0xa7, 0x23, 0xaf, 0x9e, 0x8e, 0x42, 0xe6,
+	0x74, 0x14, 0x93, 0x1e, 0xa3, 0x31, 0xbc, 0xbf, 0x16, 0x55, 0x73, 0xe1, 0x27, 0x0b, 0x02, 0xd8,
+	0xe9, 0xa0, 0x75, 0x88, 0x8f, 0xfc, 0x00, 0xcb, 0x58, 0x1f, 0x18, 0x68, 0x9b, 0x43, 0x58, 0x10,
+	0xc1, 0x11, 0xac, 0x23, 0x8a, 0x83, 0x30, 0x7f, 0xc7, 0x20, 0x0f, 0x18, 0x80, 0x45, 0xa2, 0x22,
+	0xd7, 0x27, 0x2b, 0x12, 0x2a, 0xe2, 0x7c, 0x22, 0x61, 0xe1, 0x49, 0xe3, 0x70, 0x40, 0x31, 0x61,
+	0x29, 0x72, 0x9b, 0x67, 0x1d, 0xf2, 0x66, 0x85, 0x43, 0x4c, 0x6c, 0xd9, 0xec, 0x1a, 0x4c, 0x34,
+	0x9f, 0x04, 0x0e, 0xa5, 0xd8, 0xe3, 0x99, 0x86, 0xbc, 0x59, 0xe5, 0xc0, 0x0f, 0x05, 0x0c, 0x6d,
+	0x40, 0x95, 0x3f, 0x46, 0x69, 0xfb, 0x84, 0xb6, 0xba, 0x22, 0xd9, 0x90, 0x17, 0x6e, 0xe0, 0xa1,
+	0x4f, 0xe8, 0x3e, 0x89, 0x1d, 0x4b, 0x6a, 0x89, 0x63, 0xc9, 0xcb, 0x50, 0x0b, 0xf7, 0x22, 0x7e,
+	0xd7, 0xd5, 0x58, 0xe2, 0x1e, 0xa4, 0x1a, 0x02, 0xd9, 0x2d, 0x97, 0xf1, 0x26, 0x2f, 0x8a, 0xe0,
+	0x57, 0xd2, 0x89, 0x3d, 0x3e, 0x59, 0xe0, 0xa5, 0x8d, 0x14, 0x78, 0x1d, 0xc1, 0xc5, 0x14, 0xdd,
+	0x9c, 0xc5, 0x79, 0x47, 0x8c, 0x15, 0xb6, 0xe5, 0x7b, 0xcb, 0xf0, 0xf3, 0xd6, 0x35, 0x28, 0x87,
+	0x65, 0xa1, 0xa8, 0x04, 0xf9, 0x07, 0xae, 0x5b, 0xbf, 0x80, 0xaa, 0x50, 0xde, 0x93, 0xb5, 0x8f,
+	0x75, 0xed, 0xd6, 0x2f, 0xc1, 0x72, 0x2a, 0x0b, 0x88, 0xca, 0x50, 0x78, 0xec, 0x7b, 0xb8, 0x7e,
+	0x01, 0xd5, 0xa1, 0xba, 0xed, 0x78, 0x56, 0x30, 0x10, 0xb7, 0x39, 0x75, 0x1b, 0x2d, 0xc3, 0x22,
+	0xbf, 0xd5, 0x90, 0x00, 0x8c, 0x56, 0x58, 0x66, 0xc0, 0xb7, 0xe8, 0xbd, 0x37, 0x25, 0xe8, 0x08,
+	0x21, 0x58, 0xda, 0x4e, 0xc2, 0x3a, 0xe8, 0x22, 0xac, 0x34, 0x7b, 0x56, 0x40, 0x70, 0x9c, 0xfa,
+	0x78, 0xeb, 0x27, 0xaf, 0x40, 0x6d, 0x9f, 0x8f, 0xb0, 0x89, 0x83, 0xa7, 0x4e, 0x1b, 0xa3, 0x16,
+	0xd4, 0xd3, 0xcf, 0x94, 0xd1, 0x97, 0xd5, 0x6b, 0x4a, 0xfd, 0x9a, 0x59, 0x1f, 0xa7, 0x33, 0xe3,
+	0x02, 0xfa, 0x0e, 0x2c, 0x25, 0x5f, 0x06, 0x23, 0xf5, 0xa1, 0x5d, 0xf9, 0x7c, 0x78, 0x12, 0xf3,
+	0x16, 0xd4, 0x12, 0x0f, 0x7d, 0xd1, 0x4d, 0x25, 0x6f, 0xd5, 0x63, 0x60, 0x5d, 0x7d, 0x8f, 0x16,
+	0x7f, 0x8c, 0x2b, 0xa4, 0x4f, 0x3e, 0x05, 0xcc, 0x90, 0x5e, 0xf9, 0x5e, 0x70, 0x92, 0xf4, 0x16,
+	0xac, 0x8c, 0xbc, 0xec, 0x43, 0xb7, 0x95, 0xfc, 0xb3, 0x5e, 0x00, 0x4e, 0xea, 0xe2, 0x04, 0xd0,
+	0xe8, 0x83, 0x56, 0x74, 0x47, 0x3d, 0x03, 0x59, 0xcf, 0x79, 0xf5, 0xbb, 0x53, 0xe3, 0x47, 0x8a,
+	0xfb, 0x75, 0x0d, 0x2e, 0x65, 0x3c, 0xc7, 0x43, 0xf7, 0x95, 0xec, 0xc6, 0xbf, 0x29, 0xd4, 0x5f,
+	0x9f, 0x8d, 0x28, 0x12, 0xc4, 0x83, 0xe5, 0xd4, 0x0b, 0x35, 0xf4, 0x6a, 0x66, 0x41, 0xf7, 0xe8,
+	0x53, 0x3d, 0xfd, 0xcb, 0xd3, 0x21, 0x47, 0xfd, 0xb5, 0xa0, 0x9e, 0x7e, 0xd6, 0x95, 0xb1, 0xa0,
+	0x32, 0x5e, 0x7f, 0x4d, 0x65, 0x35, 0xa9, 0xa7, 0x59, 0x99, 0x56, 0xa3, 0x7e, 0xc2, 0x35, 0xa9,
+	0x0b, 0x96, 0xca, 0x4b, 0xbe, 0x5a, 0xca, 0xd0, 0x99, 0xfa, 0x6d, 0xd3, 0x24, 0xf6, 0xdf, 0x86,
+	0x5a, 0xe2, 0x79, 0x51, 0xc6, 0xaa, 0x55, 0x3d, 0x41, 0x9a, 0x2c, 0x79, 0x35, 0xfe, 0x0a, 0x08,
+	0x6d, 0x66, 0xf9, 0x83, 0x11, 0xc6, 0xb3, 0xb8, 0x83, 0x88, 0x98, 0x8c, 0x71, 0x07, 0x23, 0xef,
+	0x22, 0xa6, 0x77, 0x07, 0x31, 0xfe, 0x63, 0xdd, 0xc1, 0xcc, 0x5d, 0x7c, 0xaa, 0xc1, 0xba, 0xfa,
+	0x11, 0x09, 0xda, 0xca, 0x5a, 0x5f, 0xd9, 0xcf, 0x65, 0xf4, 0xfb, 0x33, 0xd1, 0x44, 0x5a, 0x7c,
+	0x02, 0x4b, 0xc9, 0xa7, 0x12, 0x19, 0x5a, 0x54, 0xbe, 0x2e, 0xd1, 0x5f, 0x9d, 0x0a, 0x37, 0xb9,
+	0x1e, 0x93, 0x0f, 0x07, 0x33, 0xd7, 0xa3, 0xf2, 0x7d, 0xe1, 0x24, 0x9d, 0x7e, 0x00, 0x8b, 0xb1,
+	0xbf, 0x67, 0x41, 0x37, 0xc6, 0x2c, 0x94, 0xf8, 0x7f, 0x95, 0x4c, 0x62, 0xfb, 0x4d, 0xa8, 0x44,
+	0xff, 0xaa, 0x82, 0xae, 0x67, 0x2e, 0x90, 0x59, 0x58, 0x36, 0x01, 0x86, 0x7f, 0x99, 0x82, 0xd4,
+	0x91, 0xf3, 0xc8, 0x7f, 0xaa, 0x4c, 0x3d, 0x7c, 0x51, 0xa9, 0x36, 0x6e, 0xf8, 0xf1, 0xd2, 0xca,
+	0x49, 0x6c, 0x8f, 0xa1, 0x16, 0xee, 0x2f, 0x82, 0xf1, 0xcd, 0xb1, 0x7b, 0x50, 0x82, 0xf5, 0xad,
+	0x69, 0x50, 0x23, 0x03, 0x39, 0x86, 0x5a, 0xa2, 0x3c, 0x35, 0xa3, 0x27, 0x55, 0x35, 0xae, 0x7e,
+	0x6b, 0x1a, 0xd4, 0xa8, 0xa7, 0x5f, 0x89, 0x55, 0xc2, 0x26, 0xaa, 0x8d, 0xd1, 0xbd, 0xb1, 0x7c,
+	0x54, 0xc5, 0xd6, 0xfa, 0xd6, 0x2c, 0x24, 0x91, 0x08, 0xd2, 0xaa, 0x84, 0x4a, 0xb3, 0xad, 0x6a,
+	0x96, 0x99, 0x6a, 0xc2, 0x82, 0x28, 0x38, 0x45, 0x46, 0x46, 0x69, 0x79, 0xac, 0x1a, 0x55, 0x7f,
+	0x59, 0x89, 0x93, 0xac, 0xc5, 0x14, 0x4c, 0x45, 0x41, 0x61, 0x06, 0xd3, 0x44, 0xb5, 0xe1, 0xb4,
+	0x4c, 0x4d, 0x58, 0x10, 0xa5, 0x3f, 0x19, 0x4c, 0x13, 0xd5, 0x70, 0xfa, 0x78, 0x1c, 0x51, 0x2f,
+	0x74, 0x01, 0x1d, 0x40, 0x91, 0x9f, 0x2b, 0xd0, 0xb5, 0x71, 0xe5, 0x33, 0xe3, 0x38, 0x26, 0x2a,
+	0x6c, 0x8c, 0x0b, 0xe8, 0xe7, 0xa1, 0xc8, 0xf3, 0x0b, 0x19, 0x1c, 0xe3, 0x35, 0x30, 0xfa, 0x58,
+	0x94, 0x50, 0xc4, 0x77, 0x21, 0xbf, 0x8b, 0x29, 0xba, 0x9a, 0x65, 0x30, 0x33, 0x31, 0xb3, 0xa1,
+	0x1a, 0xcf, 0x0a, 0x67, 0x6c, 0xb0, 0x8a, 0xbc, 0xb9, 0x3e, 0x0d, 0x66, 0xd8, 0x8b, 0x58, 0x93,
+	0xc3, 0x03, 0x5b, 0xf6, 0x9a, 0x1c, 0x39, 0x0c, 0xea, 0xb7, 0xa6, 0x41, 0x8d, 0xb4, 0xfd, 0x9b,
+	0x1a, 0x34, 0xb2, 0x52, 0x95, 0x28, 0x33, 0xe6, 0x1c, 0x97, 0x6f, 0xd5, 0xdf, 0x98, 0x91, 0x2a,
+	0x92, 0xe5, 0x13, 0x58, 0x55, 0xe4, 0xb3, 0xd0, 0xdd, 0x2c, 0x7e, 0x19, 0xa9, 0x38, 0xfd, 0xb5,
+	0xe9, 0x09, 0xa2, 0xbe, 0x0f, 0xa0, 0xc8, 0xf3, 0x50, 0x19, 0x56, 0x17, 0x4f, 0x6b, 0xe9, 0xc6,
+	0x38, 0x94, 0x88, 0x23, 0x86, 0x6a, 0x3c, 0x29, 0x95, 0x61, 0x29, 0x8a, 0x7c, 0x96, 0x7e, 0x73,
+	0x0a, 0xcc, 0xd8, 0xfe, 0x0e, 0xc3, 0xa4, 0x50, 0xc6, 0xa6, 0x36, 0x92, 0x97, 0xd2, 0x6f, 0x4c,
+	0xc4, 0x8b, 0x3a, 0xf8, 0x00, 0x16, 0x63, 0x69, 0x9e, 0x8c, 0x0d, 0x6e, 0x34, 0x11, 0x34, 0xc5,
+	0xc9, 0x6c, 0x34, 0xe5, 0x90, 0x71, 0x32, 0xcb, 0xcc, 0x6e, 0xe8, 0x77, 0xa7, 0xc6, 0x8f, 0xc6,
+	0xf3, 0x31, 0xd4, 0xd3, 0x29, 0x9a, 0x8c, 0x80, 0x28, 0x23, 0x51, 0xa4, 0xdf, 0x9e, 0x12, 0x3b,
+	0xbe, 0xf1, 0x5d, 0x1e, 0x95, 0xe9, 0x43, 0x87, 0x1e, 0xf3, 0xec, 0xc0, 0x34, 0xa3, 0x8e, 0x27,
+	0x22, 0xf4, 0xbb, 0x53, 0xe3, 0xc7, 0xac, 0x71, 0x55, 0x71, 0xe7, 0x9e, 0xb1, 0xb6, 0xb2, 0x6f,
+	0xe7, 0x27, 0xcd, 0xea, 0xc7, 0x50, 0x4f, 0xdf, 0x48, 0x67, 0x28, 0x37, 0xe3, 0xae, 0x5d, 0xbf,
+	0x3d, 0x25, 0x76, 0x34, 0xb2, 0x5f, 0xe6, 0x97, 0x62, 0xa3, 0x17, 0x9a, 0xaf, 0x4d, 0x56, 0x52,
+	0xf2, 0xba, 0x57, 0xbf, 0x37, 0x03, 0x45, 0xd8, 0xfd, 0x56, 0x1f, 0xaa, 0x07, 0x81, 0xff, 0x6c,
+	0x10, 0x5e, 0x28, 0xfd, 0x74, 0x96, 0xfd, 0xf6, 0x1b, 0xbf, 0x70, 0xbf, 0xe3, 0xd0, 0xe3, 0xfe,
+	0x21, 0x9b, 0x82, 0xbb, 0x02, 0xf7, 0xb6, 0xe3, 0xcb, 0x5f, 0x77, 0x1d, 0x8f, 0xe2, 0xc0, 0xb3,
+	0xdc, 0xbb, 0x9c, 0x97, 0x84, 0xf6, 0x0e, 0x0f, 0x17, 0xf8, 0xf7, 0xfd, 0xff, 0x1f, 0x00, 0x5f,
+	0x6b, 0xae, 0xbc, 0x94, 0x51, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5233,6 +6110,8 @@ type MilvusServiceClient interface {
 	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RecoverCollection(ctx context.Context, in *RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
@@ -5240,6 +6119,7 @@ type MilvusServiceClient interface {
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
 	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
+	RecoverPartition(ctx context.Context, in *RecoverPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -5253,6 +6133,7 @@ type MilvusServiceClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*QueryResults, error)
 	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
 	GetFlushState(ctx context.Context, in *GetFlushStateRequest, opts ...grpc.CallOption) (*GetFlushStateResponse, error)
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
@@ -5266,6 +6147,9 @@ type MilvusServiceClient interface {
 	GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error)
+	SetCompactionPolicy(ctx context.Context, in *SetCompactionPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DryRunCompaction(ctx context.Context, in *DryRunCompactionRequest, opts ...grpc.CallOption) (*DryRunCompactionResponse, error)
+	GetCompactionHistory(ctx context.Context, in *GetCompactionHistoryRequest, opts ...grpc.CallOption) (*GetCompactionHistoryResponse, error)
 }
 
 type milvusServiceClient struct {
@@ -5348,6 +6232,24 @@ func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowColle
 	return out, nil
 }
 
+func (c *milvusServiceClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RecoverCollection(ctx context.Context, in *RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RecoverCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
@@ -5411,6 +6313,15 @@ func (c *milvusServiceClient) ShowPartitions(ctx context.Context, in *ShowPartit
 	return out, nil
 }
 
+func (c *milvusServiceClient) RecoverPartition(ctx context.Context, in *RecoverPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RecoverPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateAlias", in, out, opts...)
@@ -5528,6 +6439,15 @@ func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts
 	return out, nil
 }
 
+func (c *milvusServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*QueryResults, error) {
+	out := new(QueryResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
 	out := new(CalcDistanceResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
@@ -5627,6 +6547,33 @@ func (c *milvusServiceClient) GetCompactionStateWithPlans(ctx context.Context, i
 	return out, nil
 }
 
+func (c *milvusServiceClient) SetCompactionPolicy(ctx context.Context, in *SetCompactionPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SetCompactionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DryRunCompaction(ctx context.Context, in *DryRunCompactionRequest, opts ...grpc.CallOption) (*DryRunCompactionResponse, error) {
+	out := new(DryRunCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DryRunCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCompactionHistory(ctx context.Context, in *GetCompactionHistoryRequest, opts ...grpc.CallOption) (*GetCompactionHistoryResponse, error) {
+	out := new(GetCompactionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MilvusServiceServer is the server API for MilvusService service.
 type MilvusServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*commonpb.Status, error)
@@ -5637,6 +6584,8 @@ type MilvusServiceServer interface {
 	DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*commonpb.Status, error)
+	RecoverCollection(context.Context, *RecoverCollectionRequest) (*commonpb.Status, error)
 	CreatePartition(context.Context, *CreatePartitionRequest) (*commonpb.Status, error)
 	DropPartition(context.Context, *DropPartitionRequest) (*commonpb.Status, error)
 	HasPartition(context.Context, *HasPartitionRequest) (*BoolResponse, error)
@@ -5644,6 +6593,7 @@ type MilvusServiceServer interface {
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
 	ShowPartitions(context.Context, *ShowPartitionsRequest) (*ShowPartitionsResponse, error)
+	RecoverPartition(context.Context, *RecoverPartitionRequest) (*commonpb.Status, error)
 	CreateAlias(context.Context, *CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *AlterAliasRequest) (*commonpb.Status, error)
@@ -5657,6 +6607,7 @@ type MilvusServiceServer interface {
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
+	Get(context.Context, *GetRequest) (*QueryResults, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
 	GetFlushState(context.Context, *GetFlushStateRequest) (*GetFlushStateResponse, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
@@ -5670,6 +6621,9 @@ type MilvusServiceServer interface {
 	GetCompactionState(context.Context, *GetCompactionStateRequest) (*GetCompactionStateResponse, error)
 	ManualCompaction(context.Context, *ManualCompactionRequest) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(context.Context, *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error)
+	SetCompactionPolicy(context.Context, *SetCompactionPolicyRequest) (*commonpb.Status, error)
+	DryRunCompaction(context.Context, *DryRunCompactionRequest) (*DryRunCompactionResponse, error)
+	GetCompactionHistory(context.Context, *GetCompactionHistoryRequest) (*GetCompactionHistoryResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -5700,6 +6654,12 @@ func (*UnimplementedMilvusServiceServer) GetCollectionStatistics(ctx context.Con
 func (*UnimplementedMilvusServiceServer) ShowCollections(ctx context.Context, req *ShowCollectionsRequest) (*ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedMilvusServiceServer) RenameCollection(ctx context.Context, req *RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RecoverCollection(ctx context.Context, req *RecoverCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverCollection not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreatePartition(ctx context.Context, req *CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
@@ -5721,6 +6681,9 @@ func (*UnimplementedMilvusServiceServer) GetPartitionStatistics(ctx context.Cont
 func (*UnimplementedMilvusServiceServer) ShowPartitions(ctx context.Context, req *ShowPartitionsRequest) (*ShowPartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowPartitions not implemented")
 }
+func (*UnimplementedMilvusServiceServer) RecoverPartition(ctx context.Context, req *RecoverPartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverPartition not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateAlias(ctx context.Context, req *CreateAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAlias not implemented")
 }
@@ -5760,6 +6723,9 @@ func (*UnimplementedMilvusServiceServer) Flush(ctx context.Context, req *FlushRe
 func (*UnimplementedMilvusServiceServer) Query(ctx context.Context, req *QueryRequest) (*QueryResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Get(ctx context.Context, req *GetRequest) (*QueryResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CalcDistance(ctx context.Context, req *CalcDistanceRequest) (*CalcDistanceResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalcDistance not implemented")
 }
@@ -5793,6 +6759,15 @@ func (*UnimplementedMilvusServiceServer) ManualCompaction(ctx context.Context, r
 func (*UnimplementedMilvusServiceServer) GetCompactionStateWithPlans(ctx context.Context, req *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
 }
+func (*UnimplementedMilvusServiceServer) SetCompactionPolicy(ctx context.Context, req *SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCompactionPolicy not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DryRunCompaction(ctx context.Context, req *DryRunCompactionRequest) (*DryRunCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunCompaction not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetCompactionHistory(ctx context.Context, req *GetCompactionHistoryRequest) (*GetCompactionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionHistory not implemented")
+}
 
 func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
 	s.RegisterService(&_MilvusService_serviceDesc, srv)
@@ -5942,6 +6917,42 @@ func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_RecoverCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RecoverCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RecoverCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RecoverCollection(ctx, req.(*RecoverCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePartitionRequest)
 	if err := dec(in); err != nil {
@@ -6068,6 +7079,24 @@ func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_RecoverPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RecoverPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RecoverPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RecoverPartition(ctx, req.(*RecoverPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAliasRequest)
 	if err := dec(in); err != nil {
@@ -6302,6 +7331,24 @@ func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CalcDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalcDistanceRequest)
 	if err := dec(in); err != nil {
@@ -6500,6 +7547,60 @@ func _MilvusService_GetCompactionStateWithPlans_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_SetCompactionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompactionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SetCompactionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SetCompactionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SetCompactionPolicy(ctx, req.(*SetCompactionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DryRunCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DryRunCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DryRunCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DryRunCompaction(ctx, req.(*DryRunCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetCompactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetCompactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCompactionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetCompactionHistory(ctx, req.(*GetCompactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
@@ -6536,6 +7637,14 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _MilvusService_ShowCollections_Handler,
 		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _MilvusService_RenameCollection_Handler,
+		},
+		{
+			MethodName: "RecoverCollection",
+			Handler:    _MilvusService_RecoverCollection_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _MilvusService_CreatePartition_Handler,
@@ -6564,6 +7673,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowPartitions",
 			Handler:    _MilvusService_ShowPartitions_Handler,
 		},
+		{
+			MethodName: "RecoverPartition",
+			Handler:    _MilvusService_RecoverPartition_Handler,
+		},
 		{
 			MethodName: "CreateAlias",
 			Handler:    _MilvusService_CreateAlias_Handler,
@@ -6616,6 +7729,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _MilvusService_Query_Handler,
 		},
+		{
+			MethodName: "Get",
+			Handler:    _MilvusService_Get_Handler,
+		},
 		{
 			MethodName: "CalcDistance",
 			Handler:    _MilvusService_CalcDistance_Handler,
@@ -6660,6 +7777,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompactionStateWithPlans",
 			Handler:    _MilvusService_GetCompactionStateWithPlans_Handler,
 		},
+		{
+			MethodName: "SetCompactionPolicy",
+			Handler:    _MilvusService_SetCompactionPolicy_Handler,
+		},
+		{
+			MethodName: "DryRunCompaction",
+			Handler:    _MilvusService_DryRunCompaction_Handler,
+		},
+		{
+			MethodName: "GetCompactionHistory",
+			Handler:    _MilvusService_GetCompactionHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
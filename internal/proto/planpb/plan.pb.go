@@ -119,6 +119,7 @@ type GenericValue struct {
 	//	*GenericValue_BoolVal
 	//	*GenericValue_Int64Val
 	//	*GenericValue_FloatVal
+	//	*GenericValue_StringVal
 	Val                  isGenericValue_Val `protobuf_oneof:"val"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -166,12 +167,18 @@ type GenericValue_FloatVal struct {
 	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
 }
 
+type GenericValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
 func (*GenericValue_BoolVal) isGenericValue_Val() {}
 
 func (*GenericValue_Int64Val) isGenericValue_Val() {}
 
 func (*GenericValue_FloatVal) isGenericValue_Val() {}
 
+func (*GenericValue_StringVal) isGenericValue_Val() {}
+
 func (m *GenericValue) GetVal() isGenericValue_Val {
 	if m != nil {
 		return m.Val
@@ -200,12 +207,20 @@ func (m *GenericValue) GetFloatVal() float64 {
 	return 0
 }
 
+func (m *GenericValue) GetStringVal() string {
+	if x, ok := m.GetVal().(*GenericValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GenericValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*GenericValue_BoolVal)(nil),
 		(*GenericValue_Int64Val)(nil),
 		(*GenericValue_FloatVal)(nil),
+		(*GenericValue_StringVal)(nil),
 	}
 }
 
@@ -985,74 +1000,74 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0x1b, 0xc5,
-	0x13, 0xd7, 0x6a, 0x25, 0x79, 0xb7, 0xa5, 0x48, 0xca, 0x5e, 0xfe, 0xce, 0x3f, 0x04, 0x9b, 0x25,
-	0x45, 0x0c, 0x54, 0xec, 0x22, 0x09, 0x4e, 0x11, 0x0a, 0xca, 0x5f, 0xc1, 0x52, 0x11, 0x6c, 0xb3,
-	0x18, 0x1f, 0xb8, 0x6c, 0x8d, 0x76, 0xc7, 0xd2, 0x54, 0x66, 0x77, 0xd6, 0xb3, 0xb3, 0x22, 0x3a,
-	0x73, 0xe3, 0xc6, 0x4b, 0xc0, 0x19, 0x6e, 0xbc, 0x03, 0x0f, 0xc0, 0x9d, 0x17, 0xa1, 0xa6, 0x67,
-	0xad, 0x8f, 0x94, 0xec, 0x98, 0xaa, 0xdc, 0x66, 0x7e, 0xd3, 0xdd, 0xd3, 0xbf, 0xfe, 0x9a, 0x01,
-	0xc8, 0x38, 0x49, 0x37, 0x33, 0x29, 0x94, 0xf0, 0x6e, 0x27, 0x8c, 0x8f, 0x8b, 0xdc, 0xec, 0x36,
-	0xf5, 0xc1, 0xff, 0x5b, 0x79, 0x34, 0xa2, 0x09, 0x31, 0x90, 0x9f, 0x41, 0xeb, 0x90, 0xa6, 0x54,
-	0xb2, 0xe8, 0x8c, 0xf0, 0x82, 0x7a, 0x77, 0xc1, 0x19, 0x08, 0xc1, 0xc3, 0x31, 0xe1, 0xab, 0xd6,
-	0xba, 0xb5, 0xe1, 0xf4, 0x2a, 0xc1, 0x8a, 0x46, 0xce, 0x08, 0xf7, 0xee, 0x81, 0xcb, 0x52, 0xb5,
-	0xfd, 0x04, 0x4f, 0xab, 0xeb, 0xd6, 0x86, 0xdd, 0xab, 0x04, 0x0e, 0x42, 0xe5, 0xf1, 0x39, 0x17,
-	0x44, 0xe1, 0xb1, 0xbd, 0x6e, 0x6d, 0x58, 0xfa, 0x18, 0xa1, 0x33, 0xc2, 0xf7, 0xea, 0x60, 0x8f,
-	0x09, 0xf7, 0x7f, 0xb6, 0xc0, 0xfd, 0xb6, 0xa0, 0x72, 0xd2, 0x4f, 0xcf, 0x85, 0xe7, 0x41, 0x4d,
-	0x89, 0xec, 0x25, 0xde, 0x65, 0x07, 0xb8, 0xf6, 0xd6, 0xa0, 0x99, 0x50, 0x25, 0x59, 0x14, 0xaa,
-	0x49, 0x46, 0xd1, 0x92, 0x1b, 0x80, 0x81, 0x4e, 0x27, 0x19, 0xf5, 0xde, 0x87, 0x5b, 0x39, 0x25,
-	0x32, 0x1a, 0x85, 0x19, 0x91, 0x24, 0xc9, 0x57, 0x6b, 0x28, 0xd2, 0x32, 0xe0, 0x09, 0x62, 0x5a,
-	0x48, 0x8a, 0x22, 0x8d, 0xc3, 0x98, 0x46, 0x2c, 0x21, 0x7c, 0xb5, 0x8e, 0x57, 0xb4, 0x10, 0x3c,
-	0x30, 0x98, 0xff, 0xab, 0x05, 0xb0, 0x2f, 0x78, 0x91, 0xa4, 0xe8, 0xcd, 0x1d, 0x70, 0xce, 0x19,
-	0xe5, 0x71, 0xc8, 0xe2, 0xd2, 0xa3, 0x15, 0xdc, 0xf7, 0x63, 0xef, 0x19, 0xb8, 0x31, 0x51, 0xc4,
-	0xb8, 0xa4, 0xb9, 0xb7, 0x1f, 0xdd, 0xdb, 0x5c, 0x88, 0x6e, 0x19, 0xd7, 0x03, 0xa2, 0x88, 0xf6,
-	0x32, 0x70, 0xe2, 0x72, 0xe5, 0xdd, 0x87, 0x36, 0xcb, 0xc3, 0x4c, 0xb2, 0x84, 0xc8, 0x49, 0xf8,
-	0x92, 0x4e, 0x90, 0x93, 0x13, 0xb4, 0x58, 0x7e, 0x62, 0xc0, 0xaf, 0xe9, 0xc4, 0xbb, 0x0b, 0x2e,
-	0xcb, 0x43, 0x52, 0x28, 0xd1, 0x3f, 0x40, 0x46, 0x4e, 0xe0, 0xb0, 0x7c, 0x17, 0xf7, 0xfe, 0x1f,
-	0x16, 0xb4, 0xbf, 0x4f, 0x89, 0x9c, 0x04, 0x24, 0x1d, 0xd2, 0xe7, 0xaf, 0x32, 0xe9, 0x7d, 0x09,
-	0xcd, 0x08, 0x5d, 0x0f, 0x59, 0x7a, 0x2e, 0xd0, 0xdf, 0xe6, 0xeb, 0x3e, 0x61, 0x29, 0xcc, 0x08,
-	0x06, 0x10, 0xcd, 0xc8, 0x7e, 0x08, 0x55, 0x91, 0x95, 0x54, 0xee, 0x2c, 0x51, 0x3b, 0xce, 0x90,
-	0x46, 0x55, 0x64, 0xde, 0xa7, 0x50, 0x1f, 0xeb, 0xf2, 0x40, 0xbf, 0x9b, 0x8f, 0xd6, 0x96, 0x48,
-	0xcf, 0x57, 0x51, 0x60, 0xa4, 0xfd, 0xdf, 0xaa, 0xd0, 0xd9, 0x63, 0x6f, 0xd7, 0xeb, 0x07, 0xd0,
-	0xe1, 0xe2, 0x47, 0x2a, 0x43, 0x96, 0x46, 0xbc, 0xc8, 0xd9, 0xd8, 0x64, 0xc3, 0x09, 0xda, 0x08,
-	0xf7, 0x2f, 0x51, 0x2d, 0x58, 0x64, 0xd9, 0x82, 0xa0, 0x89, 0x7a, 0x1b, 0xe1, 0x99, 0xe0, 0x0e,
-	0x34, 0x8d, 0x45, 0x43, 0xb1, 0x76, 0x33, 0x8a, 0x80, 0x3a, 0xa6, 0x69, 0x76, 0xa0, 0x69, 0xae,
-	0x32, 0x16, 0xea, 0x37, 0xb4, 0x80, 0x3a, 0xb8, 0xf6, 0xff, 0xb2, 0xa0, 0xb9, 0x2f, 0x92, 0x8c,
-	0x48, 0x13, 0xa5, 0x43, 0xe8, 0x72, 0x7a, 0xae, 0xc2, 0xff, 0x1c, 0xaa, 0xb6, 0x56, 0x9b, 0xab,
-	0xe8, 0x3e, 0xdc, 0x96, 0x6c, 0x38, 0x5a, 0xb4, 0x54, 0xbd, 0x89, 0xa5, 0x0e, 0xea, 0xed, 0xbf,
-	0x5e, 0x2f, 0xf6, 0x0d, 0xea, 0xc5, 0xff, 0xc9, 0x02, 0xe7, 0x94, 0xca, 0xe4, 0xad, 0x64, 0xfc,
-	0x29, 0x34, 0x30, 0xae, 0xf9, 0x6a, 0x75, 0xdd, 0xbe, 0x49, 0x60, 0x4b, 0x71, 0xff, 0x17, 0x0b,
-	0x5c, 0xec, 0x19, 0x74, 0xe3, 0x09, 0xba, 0x6f, 0xa1, 0xfb, 0xf7, 0x97, 0x98, 0x98, 0x4a, 0x9a,
-	0xd5, 0x71, 0x86, 0x95, 0xff, 0x10, 0xea, 0xd1, 0x88, 0xf1, 0xb8, 0x8c, 0xd9, 0xff, 0x96, 0x28,
-	0x6a, 0x9d, 0xc0, 0x48, 0xf9, 0x6b, 0xb0, 0x52, 0x6a, 0x7b, 0x4d, 0x58, 0xe9, 0xa7, 0x63, 0xc2,
-	0x59, 0xdc, 0xad, 0x78, 0x2b, 0x60, 0x1f, 0x09, 0xd5, 0xb5, 0xfc, 0xbf, 0x2d, 0x00, 0xd3, 0x12,
-	0xe8, 0xd4, 0xf6, 0x9c, 0x53, 0x1f, 0x2c, 0xb1, 0x3d, 0x13, 0x2d, 0x97, 0xa5, 0x5b, 0x1f, 0x43,
-	0x4d, 0x27, 0xfa, 0x4d, 0x5e, 0xa1, 0x90, 0xe6, 0x80, 0xb9, 0x2c, 0xbb, 0xf7, 0x6a, 0x0e, 0x28,
-	0xe5, 0x6f, 0x83, 0x73, 0x79, 0xd7, 0x22, 0x89, 0x36, 0xc0, 0x0b, 0x31, 0x64, 0x11, 0xe1, 0xbb,
-	0x69, 0xdc, 0xb5, 0xbc, 0x5b, 0xe0, 0x96, 0xfb, 0x63, 0xd9, 0xad, 0xfa, 0xbf, 0xdb, 0x50, 0x43,
-	0x52, 0xcf, 0xc0, 0x55, 0x54, 0x26, 0x21, 0x7d, 0x95, 0xc9, 0x32, 0xdd, 0x77, 0x97, 0xdc, 0x79,
-	0x59, 0x20, 0xfa, 0x91, 0x50, 0x97, 0xc5, 0xf2, 0x05, 0x40, 0xa1, 0xef, 0x36, 0xca, 0x86, 0xde,
-	0x3b, 0xd7, 0x65, 0xab, 0x57, 0x09, 0xdc, 0x62, 0x1a, 0xcf, 0x1d, 0x68, 0x0e, 0xd8, 0x4c, 0xdf,
-	0xbe, 0xb2, 0xd6, 0x66, 0x81, 0xed, 0x55, 0x02, 0x18, 0xcc, 0x32, 0xb2, 0x0f, 0xad, 0xc8, 0x34,
-	0xa2, 0x31, 0x61, 0xc6, 0xc1, 0xbb, 0x4b, 0xcb, 0x75, 0xda, 0xaf, 0xbd, 0x4a, 0xd0, 0x8c, 0xe6,
-	0xda, 0xf7, 0x1b, 0xe8, 0x1a, 0x16, 0x52, 0xcf, 0x3d, 0x63, 0xc8, 0x4c, 0x85, 0xf7, 0xae, 0xe2,
-	0x32, 0x9d, 0x90, 0xbd, 0x4a, 0xd0, 0x2e, 0x16, 0x67, 0xe6, 0x09, 0xdc, 0x2e, 0x59, 0xcd, 0xd9,
-	0x6b, 0xa0, 0x3d, 0xff, 0x4a, 0x6e, 0xf3, 0x06, 0x3b, 0x83, 0x45, 0x68, 0xaf, 0x01, 0x35, 0x6d,
-	0xc4, 0xff, 0xc7, 0x02, 0x38, 0xa3, 0x91, 0x12, 0x72, 0xf7, 0xe8, 0xe8, 0xbb, 0xf2, 0x09, 0x32,
-	0xc2, 0xe6, 0xf9, 0xd7, 0x4f, 0x90, 0xb1, 0xb7, 0xf0, 0x38, 0x56, 0x17, 0x1f, 0xc7, 0xa7, 0x00,
-	0x99, 0xa4, 0x31, 0x8b, 0x88, 0xa2, 0xf9, 0x9b, 0xca, 0x6c, 0x4e, 0xd4, 0xfb, 0x1c, 0xe0, 0x42,
-	0xff, 0x05, 0xcc, 0x68, 0xa8, 0x5d, 0x99, 0xee, 0xe9, 0x87, 0x21, 0x70, 0x2f, 0xa6, 0x7f, 0x87,
-	0x07, 0xd0, 0xc9, 0x38, 0x89, 0xe8, 0x48, 0xf0, 0x98, 0xca, 0x50, 0x91, 0x21, 0x06, 0xd9, 0x0d,
-	0xda, 0x73, 0xf0, 0x29, 0x19, 0xfa, 0x7f, 0x5a, 0xe0, 0x9c, 0x70, 0x92, 0x1e, 0x89, 0x18, 0x87,
-	0xf5, 0x18, 0x19, 0x87, 0x24, 0x4d, 0xf3, 0x6b, 0xc6, 0xd1, 0x2c, 0x2e, 0xba, 0x44, 0x8c, 0xce,
-	0x6e, 0x9a, 0xe6, 0xde, 0x67, 0x0b, 0x6c, 0xaf, 0x6f, 0x41, 0xad, 0x3a, 0xc7, 0x77, 0x03, 0xba,
-	0xa2, 0x50, 0x59, 0xa1, 0xc2, 0xcb, 0x50, 0xea, 0x70, 0xd9, 0x1b, 0x76, 0xd0, 0x36, 0xf8, 0x57,
-	0x26, 0xa2, 0xb9, 0xce, 0x50, 0x2a, 0x62, 0xfa, 0x51, 0x0a, 0x0d, 0x33, 0x58, 0x17, 0x7b, 0xb1,
-	0x03, 0xcd, 0x43, 0x49, 0x89, 0xa2, 0xf2, 0x74, 0x44, 0xd2, 0xae, 0xe5, 0x75, 0xa1, 0x55, 0x02,
-	0xcf, 0x2f, 0x0a, 0xc2, 0xbb, 0x55, 0xaf, 0x05, 0xce, 0x0b, 0x9a, 0xe7, 0x78, 0x6e, 0x63, 0xb3,
-	0xd2, 0x3c, 0x37, 0x87, 0x35, 0xcf, 0x85, 0xba, 0x59, 0xd6, 0xb5, 0xdc, 0x91, 0x50, 0x66, 0xd7,
-	0xd8, 0x7b, 0xfc, 0xc3, 0x27, 0x43, 0xa6, 0x46, 0xc5, 0x60, 0x33, 0x12, 0xc9, 0x96, 0x21, 0xf5,
-	0x90, 0x89, 0x72, 0xb5, 0xc5, 0x52, 0x45, 0x65, 0x4a, 0xf8, 0x16, 0xf2, 0xdc, 0xd2, 0x3c, 0xb3,
-	0xc1, 0xa0, 0x81, 0xbb, 0xc7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x21, 0x9d, 0x5f, 0x11, 0x7b,
-	0x0a, 0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xb7, 0x2c, 0xdb, 0x91, 0x9e, 0x5d, 0xc7, 0xdd, 0x0b, 0x29, 0xa5, 0x24, 0x88, 0x0e, 0x18,
+	0x98, 0x26, 0x43, 0x5b, 0xda, 0xa1, 0x0c, 0x4c, 0x93, 0xb4, 0xc4, 0x1e, 0x4a, 0x12, 0x44, 0xc8,
+	0x81, 0x8b, 0x66, 0x2d, 0x6d, 0xec, 0x9d, 0xca, 0xbb, 0xca, 0x6a, 0x65, 0xea, 0x33, 0x37, 0x6e,
+	0x7c, 0x09, 0x38, 0xc3, 0x8d, 0xef, 0xc0, 0x07, 0xe0, 0xce, 0x17, 0x61, 0xf6, 0xad, 0x12, 0xdb,
+	0x19, 0x27, 0x0d, 0x33, 0xbd, 0xbd, 0xfd, 0xbd, 0x3f, 0x7a, 0xbf, 0xf7, 0xde, 0xbe, 0x15, 0x40,
+	0x96, 0x52, 0xb1, 0x99, 0x29, 0xa9, 0x25, 0xb9, 0x39, 0xe6, 0xe9, 0xa4, 0xc8, 0xed, 0x69, 0xd3,
+	0x28, 0xde, 0x6e, 0xe5, 0xf1, 0x88, 0x8d, 0xa9, 0x85, 0x82, 0x5f, 0x1d, 0x68, 0xed, 0x31, 0xc1,
+	0x14, 0x8f, 0x8f, 0x69, 0x5a, 0x30, 0x72, 0x1b, 0xbc, 0x81, 0x94, 0x69, 0x34, 0xa1, 0xe9, 0x9a,
+	0xb3, 0xe1, 0x74, 0xbd, 0x5e, 0x25, 0x5c, 0x31, 0xc8, 0x31, 0x4d, 0xc9, 0x1d, 0xf0, 0xb9, 0xd0,
+	0x8f, 0x1e, 0xa2, 0xb6, 0xba, 0xe1, 0x74, 0xdd, 0x5e, 0x25, 0xf4, 0x10, 0x2a, 0xd5, 0x27, 0xa9,
+	0xa4, 0x1a, 0xd5, 0xee, 0x86, 0xd3, 0x75, 0x8c, 0x1a, 0x21, 0xa3, 0x5e, 0x07, 0xc8, 0xb5, 0xe2,
+	0x62, 0x88, 0xfa, 0xda, 0x86, 0xd3, 0xf5, 0x7b, 0x95, 0xd0, 0xb7, 0xd8, 0x31, 0x4d, 0x77, 0xea,
+	0xe0, 0x4e, 0x68, 0x1a, 0xfc, 0xe2, 0x80, 0xff, 0x5d, 0xc1, 0xd4, 0xb4, 0x2f, 0x4e, 0x24, 0x21,
+	0x50, 0xd3, 0x32, 0x7b, 0x89, 0xc9, 0xb8, 0x21, 0xca, 0x64, 0x1d, 0x9a, 0x63, 0xa6, 0x15, 0x8f,
+	0x23, 0x3d, 0xcd, 0x18, 0x7e, 0xca, 0x0f, 0xc1, 0x42, 0x47, 0xd3, 0x8c, 0x91, 0xf7, 0xe1, 0x46,
+	0xce, 0xa8, 0x8a, 0x47, 0x51, 0x46, 0x15, 0x1d, 0xe7, 0xf6, 0x6b, 0x61, 0xcb, 0x82, 0x87, 0x88,
+	0x19, 0x23, 0x25, 0x0b, 0x91, 0x44, 0x09, 0x8b, 0xf9, 0x98, 0xa6, 0x6b, 0x75, 0xfc, 0x44, 0x0b,
+	0xc1, 0x67, 0x16, 0x0b, 0x7e, 0x73, 0x00, 0x76, 0x65, 0x5a, 0x8c, 0x05, 0x66, 0x73, 0x0b, 0xbc,
+	0x13, 0xce, 0xd2, 0x24, 0xe2, 0x49, 0x99, 0xd1, 0x0a, 0x9e, 0xfb, 0x09, 0x79, 0x02, 0x7e, 0x42,
+	0x35, 0xb5, 0x29, 0x99, 0xe2, 0xb4, 0xef, 0xdf, 0xd9, 0x5c, 0xa8, 0x7f, 0x59, 0xf9, 0x67, 0x54,
+	0x53, 0x93, 0x65, 0xe8, 0x25, 0xa5, 0x44, 0xee, 0x42, 0x9b, 0xe7, 0x51, 0xa6, 0xf8, 0x98, 0xaa,
+	0x69, 0xf4, 0x92, 0x4d, 0x91, 0x93, 0x17, 0xb6, 0x78, 0x7e, 0x68, 0xc1, 0x6f, 0xd8, 0x94, 0xdc,
+	0x06, 0x9f, 0xe7, 0x11, 0x2d, 0xb4, 0xec, 0x3f, 0x43, 0x46, 0x5e, 0xe8, 0xf1, 0x7c, 0x1b, 0xcf,
+	0xc1, 0x9f, 0x0e, 0xb4, 0x7f, 0x10, 0x54, 0x4d, 0x43, 0x2a, 0x86, 0xec, 0xf9, 0xab, 0x4c, 0x91,
+	0xaf, 0xa0, 0x19, 0x63, 0xea, 0x11, 0x17, 0x27, 0x12, 0xf3, 0x6d, 0x5e, 0xcc, 0x09, 0x87, 0x65,
+	0x46, 0x30, 0x84, 0x78, 0x46, 0xf6, 0x23, 0xa8, 0xca, 0xac, 0xa4, 0x72, 0x6b, 0x89, 0xdb, 0x41,
+	0x86, 0x34, 0xaa, 0x32, 0x23, 0x9f, 0x41, 0x7d, 0x62, 0xe6, 0x07, 0xf3, 0x6e, 0xde, 0x5f, 0x5f,
+	0x62, 0x3d, 0x3f, 0x66, 0xa1, 0xb5, 0x0e, 0x7e, 0xaf, 0xc2, 0xea, 0x0e, 0x7f, 0xb3, 0x59, 0x7f,
+	0x08, 0xab, 0xa9, 0xfc, 0x89, 0xa9, 0x88, 0x8b, 0x38, 0x2d, 0x72, 0x3e, 0xb1, 0xdd, 0xf0, 0xc2,
+	0x36, 0xc2, 0xfd, 0x33, 0xd4, 0x18, 0x16, 0x59, 0xb6, 0x60, 0x68, 0xab, 0xde, 0x46, 0x78, 0x66,
+	0xf8, 0x14, 0x9a, 0x36, 0xa2, 0xa5, 0x58, 0xbb, 0x1e, 0x45, 0x40, 0x1f, 0x94, 0x4d, 0x04, 0xfb,
+	0x29, 0x1b, 0xa1, 0x7e, 0xcd, 0x08, 0xe8, 0x83, 0x72, 0xf0, 0xb7, 0x03, 0xcd, 0x5d, 0x39, 0xce,
+	0xa8, 0xb2, 0x55, 0xda, 0x83, 0x4e, 0xca, 0x4e, 0x74, 0xf4, 0xbf, 0x4b, 0xd5, 0x36, 0x6e, 0xb3,
+	0x33, 0xe9, 0xc3, 0x4d, 0xc5, 0x87, 0xa3, 0xc5, 0x48, 0xd5, 0xeb, 0x44, 0x5a, 0x45, 0xbf, 0xdd,
+	0x8b, 0xf3, 0xe2, 0x5e, 0x63, 0x5e, 0x82, 0x9f, 0x1d, 0xf0, 0x8e, 0x98, 0x1a, 0xbf, 0x91, 0x8e,
+	0x3f, 0x86, 0x06, 0xd6, 0x35, 0x5f, 0xab, 0x6e, 0xb8, 0xd7, 0x29, 0x6c, 0x69, 0x6e, 0xb6, 0x9f,
+	0x8f, 0x77, 0x06, 0xd3, 0x78, 0x88, 0xe9, 0x3b, 0x98, 0xfe, 0xdd, 0x25, 0x21, 0xce, 0x2d, 0xad,
+	0x74, 0x90, 0xe1, 0xe4, 0xdf, 0x83, 0x7a, 0x3c, 0xe2, 0x69, 0x52, 0xd6, 0xec, 0xad, 0x25, 0x8e,
+	0xc6, 0x27, 0xb4, 0x56, 0xc1, 0x3a, 0xac, 0x94, 0xde, 0xa4, 0x09, 0x2b, 0x7d, 0x31, 0xa1, 0x29,
+	0x4f, 0x3a, 0x15, 0xb2, 0x02, 0xee, 0xbe, 0xd4, 0x1d, 0x27, 0xf8, 0xc7, 0x01, 0xb0, 0x57, 0x02,
+	0x93, 0x7a, 0x34, 0x97, 0xd4, 0x07, 0x4b, 0x62, 0xcf, 0x4c, 0x4b, 0xb1, 0x4c, 0xeb, 0x13, 0xa8,
+	0x99, 0x46, 0xbf, 0x2e, 0x2b, 0x34, 0x32, 0x1c, 0xb0, 0x97, 0x6b, 0xee, 0xd5, 0xd6, 0xd6, 0x2a,
+	0x78, 0x04, 0xde, 0x0e, 0x5f, 0x46, 0xa2, 0x0d, 0xf0, 0x42, 0x0e, 0x79, 0x4c, 0xd3, 0x6d, 0x91,
+	0x74, 0x1c, 0x72, 0x03, 0xfc, 0xf2, 0x7c, 0xa0, 0x3a, 0xd5, 0xe0, 0x0f, 0x17, 0x6a, 0x48, 0xea,
+	0x09, 0xf8, 0x9a, 0xa9, 0x71, 0xc4, 0x5e, 0x65, 0xaa, 0x6c, 0xf7, 0xed, 0x25, 0xdf, 0x3c, 0x1b,
+	0x10, 0xf3, 0x8a, 0xe8, 0x52, 0x26, 0x5f, 0x02, 0x14, 0xe6, 0xdb, 0xd6, 0xd9, 0xd2, 0x7b, 0xe7,
+	0xaa, 0x6e, 0x99, 0x37, 0xa6, 0x38, 0xaf, 0xe7, 0x53, 0x68, 0x0e, 0xf8, 0xcc, 0xdf, 0xbd, 0x74,
+	0xd6, 0x66, 0x85, 0xed, 0x55, 0x42, 0x18, 0xcc, 0x3a, 0xb2, 0x0b, 0xad, 0xd8, 0x5e, 0x44, 0x1b,
+	0xc2, 0xae, 0x83, 0x77, 0x97, 0x8e, 0xeb, 0xf9, 0x7d, 0xed, 0x55, 0xc2, 0x66, 0x3c, 0x3b, 0x92,
+	0x6f, 0xa1, 0x63, 0x59, 0x28, 0xb3, 0xf7, 0x6c, 0x20, 0xbb, 0x15, 0xde, 0xbb, 0x8c, 0xcb, 0xf9,
+	0x86, 0xec, 0x55, 0xc2, 0x76, 0xb1, 0x80, 0x90, 0x43, 0xb8, 0x39, 0xe0, 0x17, 0xe3, 0x35, 0x30,
+	0x5e, 0x70, 0x29, 0xb7, 0xf9, 0x80, 0xab, 0x83, 0x45, 0x68, 0xa7, 0x01, 0x35, 0x13, 0x24, 0xf8,
+	0xd7, 0x01, 0x38, 0x66, 0xb1, 0x96, 0x6a, 0x7b, 0x7f, 0xff, 0xfb, 0xf2, 0x09, 0xb2, 0xc6, 0x6b,
+	0xce, 0xd9, 0x13, 0x64, 0xe3, 0x2d, 0x3c, 0x8e, 0xd5, 0xc5, 0xc7, 0xf1, 0x31, 0x40, 0xa6, 0x58,
+	0xc2, 0x63, 0xaa, 0x59, 0xfe, 0xba, 0x31, 0x9b, 0x33, 0x25, 0x5f, 0x00, 0x9c, 0x9a, 0x7f, 0x01,
+	0xbb, 0x1a, 0x6a, 0x97, 0xb6, 0xfb, 0xfc, 0x87, 0x21, 0xf4, 0x4f, 0xcf, 0x44, 0xb3, 0xe1, 0xb3,
+	0x94, 0xc6, 0x6c, 0x24, 0xd3, 0x84, 0xa9, 0x48, 0xd3, 0x21, 0x16, 0xd9, 0x0f, 0xdb, 0x73, 0xf0,
+	0x11, 0x1d, 0x06, 0x7f, 0x39, 0xe0, 0x1d, 0xa6, 0x54, 0xec, 0xcb, 0x04, 0x97, 0xf5, 0x04, 0x19,
+	0x47, 0x54, 0x88, 0xfc, 0x8a, 0x75, 0x34, 0xab, 0x8b, 0x19, 0x11, 0xeb, 0xb3, 0x2d, 0x44, 0x4e,
+	0x3e, 0x5f, 0x60, 0x7b, 0xf5, 0x15, 0x34, 0xae, 0x73, 0x7c, 0xbb, 0xd0, 0x91, 0x85, 0xce, 0x0a,
+	0x1d, 0x9d, 0x95, 0xd2, 0x94, 0xcb, 0xed, 0xba, 0x61, 0xdb, 0xe2, 0x5f, 0xdb, 0x8a, 0xe6, 0xa6,
+	0x43, 0x42, 0x26, 0xec, 0x63, 0x01, 0x0d, 0xbb, 0x58, 0x17, 0xef, 0xe2, 0x2a, 0x34, 0xf7, 0x14,
+	0xa3, 0x9a, 0xa9, 0xa3, 0x11, 0x15, 0x1d, 0x87, 0x74, 0xa0, 0x55, 0x02, 0xcf, 0x4f, 0x0b, 0x9a,
+	0x76, 0xaa, 0xa4, 0x05, 0xde, 0x0b, 0x96, 0xe7, 0xa8, 0x77, 0xf1, 0xb2, 0xb2, 0x3c, 0xb7, 0xca,
+	0x1a, 0xf1, 0xa1, 0x6e, 0xc5, 0xba, 0xb1, 0xdb, 0x97, 0xda, 0x9e, 0x1a, 0x3b, 0x0f, 0x7e, 0xfc,
+	0x74, 0xc8, 0xf5, 0xa8, 0x18, 0x6c, 0xc6, 0x72, 0xbc, 0x65, 0x49, 0xdd, 0xe3, 0xb2, 0x94, 0xb6,
+	0xb8, 0xd0, 0x4c, 0x09, 0x9a, 0x6e, 0x21, 0xcf, 0x2d, 0xc3, 0x33, 0x1b, 0x0c, 0x1a, 0x78, 0x7a,
+	0xf0, 0xdf, 0x00, 0xeb, 0xf1, 0x90, 0xdc, 0x9d, 0x0a, 0x00, 0x00,
 }
@@ -101,7 +101,7 @@ func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
 }
 
-//----------------etcd-----------------
+// ----------------etcd-----------------
 type SegmentState int32
 
 const (
@@ -161,7 +161,7 @@ func (LoadType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
 }
 
-//--------------------query coordinator proto------------------
+// --------------------query coordinator proto------------------
 type ShowCollectionsRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
@@ -997,6 +997,7 @@ type SegmentInfo struct {
 	State                commonpb.SegmentState        `protobuf:"varint,13,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
 	EnableIndex          bool                         `protobuf:"varint,14,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
 	IndexPathInfos       []*indexpb.IndexFilePathInfo `protobuf:"bytes,15,rep,name=index_path_infos,json=indexPathInfos,proto3" json:"index_path_infos,omitempty"`
+	CompactionOutputs    []int64                      `protobuf:"varint,16,rep,packed,name=compaction_outputs,json=compactionOutputs,proto3" json:"compaction_outputs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -1132,6 +1133,13 @@ func (m *SegmentInfo) GetIndexPathInfos() []*indexpb.IndexFilePathInfo {
 	return nil
 }
 
+func (m *SegmentInfo) GetCompactionOutputs() []int64 {
+	if m != nil {
+		return m.CompactionOutputs
+	}
+	return nil
+}
+
 type GetSegmentInfoResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Infos                []*SegmentInfo   `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
@@ -1179,7 +1187,7 @@ func (m *GetSegmentInfoResponse) GetInfos() []*SegmentInfo {
 	return nil
 }
 
-//-----------------query node proto----------------
+// -----------------query node proto----------------
 type AddQueryChannelRequest struct {
 	Base                  *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID                int64                   `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1496,7 +1504,7 @@ func (m *WatchDeltaChannelsRequest) GetInfos() []*datapb.VchannelInfo {
 	return nil
 }
 
-//used for handoff task
+// used for handoff task
 type SegmentLoadInfo struct {
 	SegmentID            int64                        `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	PartitionID          int64                        `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -1510,6 +1518,8 @@ type SegmentLoadInfo struct {
 	CompactionFrom       []int64                      `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
 	EnableIndex          bool                         `protobuf:"varint,11,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
 	IndexPathInfos       []*indexpb.IndexFilePathInfo `protobuf:"bytes,12,rep,name=index_path_infos,json=indexPathInfos,proto3" json:"index_path_infos,omitempty"`
+	ClusteringKeyRange   *datapb.ClusteringKeyRange   `protobuf:"bytes,13,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	CompactionOutputs    []int64                      `protobuf:"varint,14,rep,packed,name=compaction_outputs,json=compactionOutputs,proto3" json:"compaction_outputs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -1624,6 +1634,20 @@ func (m *SegmentLoadInfo) GetIndexPathInfos() []*indexpb.IndexFilePathInfo {
 	return nil
 }
 
+func (m *SegmentLoadInfo) GetClusteringKeyRange() *datapb.ClusteringKeyRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetCompactionOutputs() []int64 {
+	if m != nil {
+		return m.CompactionOutputs
+	}
+	return nil
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DstNodeID            int64                      `protobuf:"varint,2,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
@@ -2217,7 +2241,7 @@ func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
 	return nil
 }
 
-//---------------- common query proto -----------------
+// ---------------- common query proto -----------------
 type SegmentChangeInfo struct {
 	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
 	OnlineSegments       []*SegmentInfo `protobuf:"bytes,2,rep,name=online_segments,json=onlineSegments,proto3" json:"online_segments,omitempty"`
@@ -2369,158 +2393,162 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 2413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x4d, 0x6f, 0xdc, 0xc6,
-	0x55, 0xdc, 0x0f, 0x69, 0xf7, 0xed, 0x17, 0x35, 0xb6, 0x94, 0xf5, 0x36, 0x76, 0x64, 0x3a, 0xfe,
-	0x88, 0xd2, 0xc8, 0x8e, 0x9c, 0x7e, 0x04, 0x6d, 0x0e, 0xb1, 0x36, 0x56, 0x36, 0xb5, 0x65, 0x95,
-	0x52, 0x52, 0xd4, 0x30, 0xb0, 0xe5, 0x2e, 0x47, 0x2b, 0xc2, 0x24, 0x67, 0xcd, 0xe1, 0x5a, 0x96,
-	0xcf, 0x3d, 0xb4, 0x87, 0xa2, 0x3f, 0xa0, 0x45, 0x81, 0x02, 0x29, 0x8a, 0x1c, 0x7a, 0x6c, 0xcf,
-	0xbe, 0xf4, 0xde, 0x5f, 0x50, 0xa0, 0x68, 0xfe, 0x42, 0x7b, 0x2e, 0xe6, 0x83, 0x5c, 0x92, 0x3b,
-	0x2b, 0xad, 0xa4, 0x3a, 0x36, 0x8a, 0xde, 0xc8, 0x37, 0x6f, 0xde, 0x7b, 0xf3, 0x3e, 0xe7, 0xbd,
-	0x81, 0xc5, 0x27, 0x23, 0x1c, 0x1c, 0x76, 0xfb, 0x84, 0x04, 0xf6, 0xda, 0x30, 0x20, 0x21, 0x41,
-	0xc8, 0x73, 0xdc, 0xa7, 0x23, 0x2a, 0xfe, 0xd6, 0xf8, 0x7a, 0xab, 0xda, 0x27, 0x9e, 0x47, 0x7c,
-	0x01, 0x6b, 0x55, 0x93, 0x18, 0xad, 0xba, 0xe3, 0x87, 0x38, 0xf0, 0x2d, 0x37, 0x5a, 0xa5, 0xfd,
-	0x7d, 0xec, 0x59, 0xf2, 0x4f, 0xb7, 0xad, 0xd0, 0x4a, 0xd2, 0x6f, 0x2d, 0x3a, 0xbe, 0x8d, 0x9f,
-	0x25, 0x41, 0xc6, 0xcf, 0x35, 0x58, 0xde, 0xd9, 0x27, 0x07, 0x1b, 0xc4, 0x75, 0x71, 0x3f, 0x74,
-	0x88, 0x4f, 0x4d, 0xfc, 0x64, 0x84, 0x69, 0x88, 0x6e, 0x41, 0xa1, 0x67, 0x51, 0xdc, 0xd4, 0x56,
-	0xb4, 0x1b, 0x95, 0xf5, 0x37, 0xd7, 0x52, 0xc2, 0x49, 0xa9, 0xee, 0xd3, 0xc1, 0x1d, 0x8b, 0x62,
-	0x93, 0x63, 0x22, 0x04, 0x05, 0xbb, 0xd7, 0x69, 0x37, 0x73, 0x2b, 0xda, 0x8d, 0xbc, 0xc9, 0xbf,
-	0xd1, 0xdb, 0x50, 0xeb, 0xc7, 0xb4, 0x3b, 0x6d, 0xda, 0xcc, 0xaf, 0xe4, 0x6f, 0xe4, 0xcd, 0x34,
-	0xd0, 0xf8, 0xa3, 0x06, 0x6f, 0x4c, 0x88, 0x41, 0x87, 0xc4, 0xa7, 0x18, 0xdd, 0x86, 0x79, 0x1a,
-	0x5a, 0xe1, 0x88, 0x4a, 0x49, 0xbe, 0xa5, 0x94, 0x64, 0x87, 0xa3, 0x98, 0x12, 0x75, 0x92, 0x6d,
-	0x4e, 0xc1, 0x16, 0xbd, 0x0f, 0xe7, 0x1d, 0xff, 0x3e, 0xf6, 0x48, 0x70, 0xd8, 0x1d, 0xe2, 0xa0,
-	0x8f, 0xfd, 0xd0, 0x1a, 0xe0, 0x48, 0xc6, 0x73, 0xd1, 0xda, 0xf6, 0x78, 0xc9, 0xf8, 0x83, 0x06,
-	0x4b, 0x4c, 0xd2, 0x6d, 0x2b, 0x08, 0x9d, 0x97, 0xa0, 0x2f, 0x03, 0xaa, 0x49, 0x19, 0x9b, 0x79,
-	0xbe, 0x96, 0x82, 0x31, 0x9c, 0x61, 0xc4, 0x9e, 0x9d, 0xad, 0xc0, 0xc5, 0x4d, 0xc1, 0x8c, 0x2f,
-	0xa5, 0x61, 0x93, 0x72, 0x9e, 0x45, 0xa1, 0x59, 0x9e, 0xb9, 0x49, 0x9e, 0xa7, 0x51, 0xe7, 0x0b,
-	0x0d, 0x96, 0xee, 0x11, 0xcb, 0x1e, 0x1b, 0xfe, 0x9b, 0x57, 0xe7, 0x47, 0x30, 0x2f, 0x02, 0xa7,
-	0x59, 0xe0, 0xbc, 0xae, 0xa6, 0x79, 0xc9, 0xa0, 0x1a, 0x4b, 0xb8, 0xc3, 0x01, 0xa6, 0xdc, 0x64,
-	0xfc, 0x56, 0x83, 0xa6, 0x89, 0x5d, 0x6c, 0x51, 0xfc, 0x2a, 0x4f, 0xb1, 0x0c, 0xf3, 0x3e, 0xb1,
-	0x71, 0xa7, 0xcd, 0x4f, 0x91, 0x37, 0xe5, 0x9f, 0xf1, 0xb5, 0xd4, 0xf0, 0x6b, 0xee, 0xb0, 0x09,
-	0x2b, 0x14, 0x4f, 0x63, 0x85, 0x17, 0x63, 0x2b, 0xbc, 0xee, 0x27, 0x1d, 0x5b, 0xaa, 0x98, 0xb2,
-	0xd4, 0x4f, 0xe1, 0xc2, 0x46, 0x80, 0xad, 0x10, 0xff, 0x98, 0x65, 0xfe, 0x8d, 0x7d, 0xcb, 0xf7,
-	0xb1, 0x1b, 0x1d, 0x21, 0xcb, 0x5c, 0x53, 0x30, 0x6f, 0xc2, 0xc2, 0x30, 0x20, 0xcf, 0x0e, 0x63,
-	0xb9, 0xa3, 0x5f, 0xe3, 0xf7, 0x1a, 0xb4, 0x54, 0xb4, 0xcf, 0x92, 0x11, 0xae, 0x43, 0x23, 0x10,
-	0xc2, 0x75, 0xfb, 0x82, 0x1e, 0xe7, 0x5a, 0x36, 0xeb, 0x12, 0x2c, 0xb9, 0xa0, 0xab, 0x50, 0x0f,
-	0x30, 0x1d, 0xb9, 0x63, 0xbc, 0x3c, 0xc7, 0xab, 0x09, 0xa8, 0x44, 0x33, 0xbe, 0xd2, 0xe0, 0xc2,
-	0x26, 0x0e, 0x63, 0xeb, 0x31, 0x76, 0xf8, 0x35, 0xcd, 0xae, 0xbf, 0xd3, 0xa0, 0x91, 0x11, 0x14,
-	0xad, 0x40, 0x25, 0x81, 0x23, 0x0d, 0x94, 0x04, 0xa1, 0xef, 0x43, 0x91, 0xe9, 0x0e, 0x73, 0x91,
-	0xea, 0xeb, 0xc6, 0xda, 0x64, 0xbd, 0x5f, 0x4b, 0x53, 0x35, 0xc5, 0x06, 0x74, 0x13, 0xce, 0x29,
-	0x32, 0xab, 0x14, 0x1f, 0x4d, 0x26, 0x56, 0xe3, 0x4f, 0x1a, 0xb4, 0x54, 0xca, 0x3c, 0x8b, 0xc1,
-	0x1f, 0xc2, 0x72, 0x7c, 0x9a, 0xae, 0x8d, 0x69, 0x3f, 0x70, 0x86, 0x3c, 0xcc, 0x78, 0x31, 0xa8,
-	0xac, 0x5f, 0x39, 0xfe, 0x3c, 0xd4, 0x5c, 0x8a, 0x49, 0xb4, 0x13, 0x14, 0x8c, 0x5f, 0x69, 0xb0,
-	0xb4, 0x89, 0xc3, 0x1d, 0x3c, 0xf0, 0xb0, 0x1f, 0x76, 0xfc, 0x3d, 0x72, 0x7a, 0xc3, 0x5f, 0x02,
-	0xa0, 0x92, 0x4e, 0x5c, 0xa8, 0x12, 0x90, 0x59, 0x9c, 0xc0, 0xf8, 0xba, 0x00, 0x95, 0x84, 0x30,
-	0xe8, 0x4d, 0x28, 0xc7, 0x14, 0xa4, 0x69, 0xc7, 0x80, 0x09, 0x8a, 0x39, 0x85, 0x5b, 0x65, 0xdc,
-	0x23, 0x3f, 0xe9, 0x1e, 0x53, 0x32, 0x38, 0xba, 0x00, 0x25, 0x0f, 0x7b, 0x5d, 0xea, 0x3c, 0xc7,
-	0x32, 0x63, 0x2c, 0x78, 0xd8, 0xdb, 0x71, 0x9e, 0x63, 0xb6, 0xe4, 0x8f, 0xbc, 0x6e, 0x40, 0x0e,
-	0x68, 0x73, 0x5e, 0x2c, 0xf9, 0x23, 0xcf, 0x24, 0x07, 0x14, 0x5d, 0x04, 0x10, 0xd7, 0x3d, 0xdf,
-	0xf2, 0x70, 0x73, 0x81, 0x47, 0x5c, 0x99, 0x43, 0xb6, 0x2c, 0x0f, 0xb3, 0x5c, 0xc1, 0x7f, 0x3a,
-	0xed, 0x66, 0x49, 0x6c, 0x94, 0xbf, 0xec, 0xa8, 0x32, 0x4e, 0x3b, 0xed, 0x66, 0x59, 0xec, 0x8b,
-	0x01, 0xe8, 0x13, 0xa8, 0xc9, 0x73, 0x77, 0x85, 0x2f, 0x03, 0xf7, 0xe5, 0x15, 0x95, 0xed, 0xa5,
-	0x02, 0x85, 0x27, 0x57, 0x69, 0xe2, 0x0f, 0x5d, 0x83, 0x7a, 0x9f, 0x78, 0x43, 0x8b, 0x6b, 0xe7,
-	0x6e, 0x40, 0xbc, 0x66, 0x85, 0xdb, 0x29, 0x03, 0x45, 0xb7, 0xe0, 0x5c, 0x9f, 0xe7, 0x2d, 0xfb,
-	0xce, 0xe1, 0x46, 0xbc, 0xd4, 0xac, 0xae, 0x68, 0x37, 0x4a, 0xa6, 0x6a, 0x09, 0x7d, 0x2f, 0x0a,
-	0xb2, 0x1a, 0x17, 0xec, 0xb2, 0xda, 0xb3, 0x93, 0x92, 0xc9, 0x18, 0xbb, 0x0c, 0x55, 0xec, 0x5b,
-	0x3d, 0x17, 0x77, 0xb9, 0x26, 0x9a, 0x75, 0xce, 0xa3, 0x22, 0x60, 0x1d, 0x06, 0x42, 0x0f, 0x40,
-	0x17, 0x3a, 0x1d, 0x5a, 0xe1, 0x7e, 0xd7, 0xf1, 0xf7, 0x08, 0x6d, 0x36, 0xb8, 0xef, 0x67, 0xaa,
-	0x15, 0xc7, 0x5a, 0xe3, 0x9b, 0xee, 0x3a, 0x2e, 0xde, 0xb6, 0xc2, 0x7d, 0xee, 0xd3, 0x75, 0xbe,
-	0x10, 0xfd, 0x52, 0x7e, 0xfd, 0xce, 0xba, 0xfd, 0x59, 0x42, 0xf4, 0x3b, 0x50, 0x14, 0x52, 0x89,
-	0x88, 0x7c, 0xeb, 0x08, 0xab, 0x70, 0x66, 0x02, 0xdb, 0xf8, 0x4b, 0x1e, 0x96, 0x3f, 0xb6, 0x6d,
-	0x55, 0xdd, 0x39, 0x79, 0xf8, 0x8d, 0xdd, 0x38, 0x97, 0x72, 0xe3, 0x59, 0x72, 0xef, 0xbb, 0xb0,
-	0x98, 0xa9, 0x29, 0x32, 0x1a, 0xca, 0xa6, 0x9e, 0xae, 0x2a, 0x9d, 0x36, 0x7a, 0x07, 0xf4, 0x74,
-	0x5d, 0x91, 0x15, 0xb5, 0x6c, 0x36, 0x52, 0x95, 0xa5, 0xd3, 0x46, 0xdf, 0x85, 0x37, 0x06, 0x2e,
-	0xe9, 0x59, 0x6e, 0x97, 0x62, 0xcb, 0xc5, 0x76, 0x77, 0x1c, 0xcc, 0xf3, 0xdc, 0xef, 0x96, 0xc4,
-	0xf2, 0x0e, 0x5f, 0xdd, 0x89, 0x03, 0x7b, 0x93, 0x79, 0x3b, 0x7e, 0xdc, 0x1d, 0x12, 0xca, 0xa3,
-	0x94, 0xc7, 0x51, 0x25, 0x9b, 0xb9, 0xe3, 0x36, 0xec, 0x3e, 0x1d, 0x6c, 0x4b, 0x4c, 0xe6, 0xef,
-	0xf8, 0x71, 0xf4, 0x87, 0x3e, 0x87, 0x65, 0xa5, 0x00, 0xb4, 0x59, 0x9a, 0xcd, 0x52, 0xe7, 0x15,
-	0x02, 0x52, 0xe3, 0x1f, 0x1a, 0x5c, 0x30, 0xb1, 0x47, 0x9e, 0xe2, 0xff, 0x59, 0xdb, 0x19, 0xff,
-	0xcc, 0xc1, 0xf2, 0x4f, 0xac, 0xb0, 0xbf, 0xdf, 0xf6, 0x24, 0x90, 0xbe, 0x9a, 0x03, 0x66, 0x32,
-	0x78, 0x61, 0x32, 0x83, 0xc7, 0xe1, 0x57, 0x54, 0x19, 0x95, 0xf5, 0xe3, 0x6b, 0x5f, 0x44, 0xe7,
-	0x1d, 0x87, 0x5f, 0xe2, 0xea, 0x3b, 0x7f, 0x8a, 0xab, 0x2f, 0xda, 0x80, 0x1a, 0x7e, 0xd6, 0x77,
-	0x47, 0x36, 0x96, 0x29, 0x69, 0x81, 0x73, 0xbf, 0xa4, 0xe0, 0x9e, 0xf4, 0xa8, 0xaa, 0xdc, 0x24,
-	0x32, 0xd1, 0x0b, 0x0d, 0x2e, 0x08, 0x2d, 0x63, 0x37, 0xb4, 0x5e, 0xad, 0xa2, 0x63, 0x35, 0x16,
-	0x4e, 0xa2, 0x46, 0xe3, 0xcb, 0x02, 0x34, 0xe4, 0x01, 0x59, 0xc3, 0x33, 0x43, 0xdd, 0xce, 0x58,
-	0x34, 0x37, 0x69, 0xd1, 0x59, 0xc4, 0x8d, 0x2e, 0x9a, 0x85, 0xc4, 0x45, 0xf3, 0x22, 0xc0, 0x9e,
-	0x3b, 0xa2, 0xfb, 0xdd, 0xd0, 0xf1, 0xa2, 0xaa, 0x5d, 0xe6, 0x90, 0x5d, 0xc7, 0xc3, 0xe8, 0x63,
-	0xa8, 0xf6, 0x1c, 0xdf, 0x25, 0x03, 0x5e, 0x49, 0x28, 0x4f, 0x42, 0x6a, 0x8b, 0xdd, 0x75, 0xb0,
-	0x6b, 0xdf, 0xe1, 0xb8, 0x66, 0x45, 0xec, 0x61, 0xe5, 0x83, 0xa2, 0x4b, 0x50, 0x61, 0xa5, 0x9f,
-	0xec, 0x89, 0xea, 0xbf, 0x20, 0x58, 0xf8, 0x23, 0xef, 0xc1, 0x1e, 0xaf, 0xff, 0x3f, 0x84, 0x32,
-	0x2b, 0x0a, 0xd4, 0x25, 0x83, 0x28, 0xc9, 0x1c, 0x47, 0x7f, 0xbc, 0x01, 0x7d, 0x04, 0x65, 0x9b,
-	0x39, 0x02, 0xdf, 0x5d, 0x9e, 0x6a, 0x06, 0xee, 0x2c, 0xf7, 0xc8, 0x80, 0x9b, 0x61, 0xbc, 0x43,
-	0x51, 0xde, 0x41, 0x59, 0xde, 0xb3, 0x35, 0xb7, 0x32, 0x5b, 0xcd, 0xad, 0x9e, 0xa5, 0xe6, 0xfe,
-	0x3b, 0x07, 0xe7, 0x98, 0x7f, 0x44, 0x49, 0xf4, 0xf4, 0x3e, 0x7e, 0x11, 0xc0, 0xa6, 0x61, 0x37,
-	0xe5, 0xe7, 0x65, 0x9b, 0x86, 0x5b, 0xc2, 0xd5, 0x3f, 0x8c, 0xdc, 0x38, 0x3f, 0xfd, 0x7a, 0x9c,
-	0xf1, 0xd7, 0xc9, 0x8c, 0x70, 0x9a, 0x91, 0x04, 0xfa, 0x11, 0xd4, 0x5d, 0x62, 0xd9, 0xdd, 0x3e,
-	0xf1, 0x6d, 0x51, 0xb7, 0x8a, 0xfc, 0x32, 0xf4, 0xb6, 0x4a, 0x84, 0xdd, 0xc0, 0x19, 0x0c, 0x70,
-	0xb0, 0x11, 0xe1, 0x9a, 0x35, 0x97, 0x0f, 0x64, 0xe4, 0x2f, 0xba, 0x02, 0x35, 0x4a, 0x46, 0x41,
-	0x1f, 0x47, 0x07, 0x15, 0x17, 0xcd, 0xaa, 0x00, 0x6e, 0xa9, 0xc3, 0x7a, 0x41, 0x71, 0xa7, 0xfe,
-	0xbb, 0x06, 0xcb, 0xb2, 0x45, 0x3f, 0xbb, 0xee, 0xa7, 0xe5, 0x97, 0x28, 0x18, 0xf3, 0x47, 0x74,
-	0x7d, 0x85, 0x19, 0xba, 0xbe, 0xa2, 0xa2, 0x71, 0x4f, 0x37, 0x16, 0xf3, 0xd9, 0xc6, 0xc2, 0xd8,
-	0x85, 0x5a, 0x5c, 0xa3, 0x78, 0xf6, 0xb9, 0x02, 0x35, 0x21, 0x56, 0x97, 0xa9, 0x14, 0xdb, 0x51,
-	0xd7, 0x2e, 0x80, 0xf7, 0x38, 0x8c, 0x51, 0x8d, 0x6b, 0xa0, 0xb8, 0xb8, 0x95, 0xcd, 0x04, 0xc4,
-	0xf8, 0x73, 0x0e, 0xf4, 0x64, 0x75, 0xe7, 0x94, 0x67, 0x19, 0x07, 0x5c, 0x87, 0x86, 0x9c, 0x31,
-	0xc7, 0x25, 0x56, 0x36, 0xe8, 0x4f, 0x92, 0xe4, 0xda, 0xe8, 0x03, 0x58, 0x16, 0x88, 0x13, 0x25,
-	0x59, 0x34, 0xea, 0xe7, 0xf9, 0xaa, 0x99, 0xb9, 0x53, 0x4d, 0xbf, 0xd2, 0x14, 0xce, 0x70, 0xa5,
-	0x99, 0xbc, 0x72, 0x15, 0x4f, 0x77, 0xe5, 0x32, 0xfe, 0x96, 0x87, 0xfa, 0x38, 0x42, 0x66, 0xd6,
-	0xda, 0x2c, 0x83, 0xce, 0x2d, 0xd0, 0xc7, 0x9d, 0x30, 0xef, 0x1e, 0x8e, 0x0c, 0xf2, 0x6c, 0x0f,
-	0xdc, 0x18, 0x66, 0x46, 0x07, 0x77, 0xa1, 0x26, 0x75, 0xde, 0x4d, 0x16, 0xbe, 0xcb, 0x2a, 0x62,
-	0x29, 0x0f, 0x33, 0xab, 0x89, 0x3a, 0x48, 0xd1, 0x87, 0x50, 0xe6, 0x71, 0x1f, 0x1e, 0x0e, 0xb1,
-	0x0c, 0xf9, 0x37, 0x55, 0x34, 0x98, 0xe7, 0xed, 0x1e, 0x0e, 0xb1, 0x59, 0x72, 0xe5, 0xd7, 0x59,
-	0xef, 0x20, 0xb7, 0x61, 0x29, 0x10, 0xa1, 0x6d, 0x77, 0x53, 0xea, 0x5b, 0xe0, 0xea, 0x3b, 0x1f,
-	0x2d, 0x6e, 0x27, 0xd5, 0x38, 0x65, 0xaa, 0x51, 0x9a, 0x3a, 0xd5, 0xf8, 0x4d, 0x0e, 0x96, 0x99,
-	0xec, 0x77, 0x2c, 0xd7, 0xf2, 0xfb, 0x78, 0xf6, 0x06, 0xfd, 0xbf, 0x53, 0xe8, 0x27, 0x32, 0x61,
-	0x41, 0x91, 0x09, 0xd3, 0x45, 0xa1, 0x98, 0x2d, 0x0a, 0x6f, 0x41, 0x45, 0xd2, 0xb0, 0x89, 0x8f,
-	0xb9, 0xb2, 0x4b, 0x26, 0x08, 0x50, 0x9b, 0xf8, 0xbc, 0xa5, 0x67, 0xfb, 0xf9, 0xea, 0x02, 0x5f,
-	0x5d, 0xb0, 0x69, 0xc8, 0x97, 0x2e, 0x02, 0x3c, 0xb5, 0x5c, 0xc7, 0xe6, 0x4e, 0xc2, 0xd5, 0x54,
-	0x32, 0xcb, 0x1c, 0xc2, 0x54, 0x60, 0xfc, 0x5a, 0x83, 0xe5, 0x4f, 0x2d, 0xdf, 0x26, 0x7b, 0x7b,
-	0x67, 0xcf, 0xaf, 0x1b, 0x10, 0x35, 0xec, 0x9d, 0x93, 0x34, 0x94, 0xa9, 0x4d, 0xc6, 0x2f, 0x72,
-	0x80, 0x12, 0xf6, 0x3a, 0xbd, 0x34, 0x57, 0xa1, 0x9e, 0xd2, 0x7c, 0xfc, 0x9e, 0x93, 0x54, 0x3d,
-	0x65, 0x75, 0xaf, 0x27, 0x58, 0x75, 0x03, 0x6c, 0x51, 0xe2, 0x73, 0x33, 0xce, 0x5c, 0xf7, 0x7a,
-	0x91, 0x98, 0x6c, 0x2b, 0xb3, 0xd4, 0xd8, 0x90, 0xd1, 0x18, 0x10, 0x62, 0x4b, 0x52, 0xd6, 0xf0,
-	0x64, 0xbb, 0xc9, 0xa8, 0x6e, 0xe8, 0x34, 0xdd, 0x48, 0x52, 0xe3, 0x5f, 0x1a, 0x2c, 0xca, 0x5f,
-	0x16, 0xbf, 0x03, 0x1c, 0x15, 0x08, 0xe2, 0xbb, 0x8e, 0x1f, 0x7b, 0x94, 0xcc, 0x48, 0x02, 0x28,
-	0x5d, 0xe6, 0x53, 0x68, 0x48, 0xa4, 0x38, 0xc3, 0xce, 0x68, 0x8d, 0xba, 0xd8, 0x17, 0xe7, 0xd6,
-	0xab, 0x50, 0x27, 0x7b, 0x7b, 0x49, 0x7e, 0xc2, 0xcd, 0x6b, 0x12, 0x2a, 0x19, 0x7e, 0x06, 0x7a,
-	0x84, 0x76, 0xd2, 0x9c, 0xde, 0x90, 0x1b, 0xe3, 0x0e, 0xf5, 0x97, 0x1a, 0x34, 0xd3, 0x19, 0x3e,
-	0x71, 0xfc, 0x93, 0x3b, 0xc2, 0x0f, 0xd2, 0x03, 0x8e, 0xab, 0x47, 0xc8, 0x33, 0xe6, 0x23, 0x6f,
-	0x55, 0xab, 0xcf, 0xa1, 0x9e, 0x4e, 0xc5, 0xa8, 0x0a, 0xa5, 0x2d, 0x12, 0x7e, 0xf2, 0xcc, 0xa1,
-	0xa1, 0x3e, 0x87, 0xea, 0x00, 0x5b, 0x24, 0xdc, 0x0e, 0x30, 0xc5, 0x7e, 0xa8, 0x6b, 0x08, 0x60,
-	0xfe, 0x81, 0xdf, 0x76, 0xe8, 0x63, 0x3d, 0x87, 0xce, 0xc9, 0x81, 0xaf, 0xe5, 0x76, 0x64, 0x5e,
-	0xd2, 0xf3, 0x6c, 0x7b, 0xfc, 0x57, 0x40, 0x3a, 0x54, 0x63, 0x94, 0xcd, 0xed, 0xcf, 0xf5, 0x22,
-	0x2a, 0x43, 0x51, 0x7c, 0xce, 0xaf, 0x3e, 0x00, 0x3d, 0xeb, 0x70, 0xa8, 0x02, 0x0b, 0xfb, 0x22,
-	0x5e, 0xf5, 0x39, 0xd4, 0x80, 0x8a, 0x3b, 0x0e, 0x15, 0x5d, 0x63, 0x80, 0x41, 0x30, 0xec, 0xcb,
-	0xa0, 0xd1, 0x73, 0x8c, 0x1b, 0xb3, 0x5a, 0x9b, 0x1c, 0xf8, 0x7a, 0x7e, 0xf5, 0x33, 0xa8, 0x26,
-	0xa7, 0x58, 0xa8, 0x04, 0x85, 0x2d, 0xe2, 0x63, 0x7d, 0x8e, 0x91, 0xdd, 0x0c, 0xc8, 0x81, 0xe3,
-	0x0f, 0xc4, 0x19, 0xee, 0x06, 0xe4, 0x39, 0xf6, 0xf5, 0x1c, 0x5b, 0x60, 0x7e, 0xc9, 0x16, 0xf2,
-	0x6c, 0x41, 0x38, 0xa9, 0x5e, 0x58, 0x7d, 0x1f, 0x4a, 0x51, 0x49, 0x40, 0x8b, 0x50, 0x4b, 0x3d,
-	0x17, 0xe9, 0x73, 0x08, 0x89, 0xeb, 0xe4, 0x38, 0xf9, 0xeb, 0xda, 0xfa, 0x5f, 0x2b, 0x00, 0xe2,
-	0x56, 0x42, 0x48, 0x60, 0xa3, 0x21, 0xa0, 0x4d, 0x1c, 0x6e, 0x10, 0x6f, 0x48, 0xfc, 0x48, 0x24,
-	0x8a, 0x6e, 0x4d, 0x29, 0xda, 0x93, 0xa8, 0xf2, 0x94, 0xad, 0x6b, 0x53, 0x76, 0x64, 0xd0, 0x8d,
-	0x39, 0xe4, 0x71, 0x8e, 0xac, 0x9b, 0xda, 0x75, 0xfa, 0x8f, 0xa3, 0xb7, 0x86, 0x23, 0x38, 0x66,
-	0x50, 0x23, 0x8e, 0x99, 0x8a, 0x2d, 0x7f, 0x76, 0xc2, 0xc0, 0xf1, 0x07, 0xd1, 0x30, 0xce, 0x98,
-	0x43, 0x4f, 0xe0, 0xfc, 0x26, 0xe6, 0xdc, 0x1d, 0x1a, 0x3a, 0x7d, 0x1a, 0x31, 0x5c, 0x9f, 0xce,
-	0x70, 0x02, 0xf9, 0x84, 0x2c, 0x5d, 0x68, 0x64, 0xde, 0xc4, 0xd1, 0xaa, 0xd2, 0xdf, 0x95, 0xef,
-	0xf7, 0xad, 0x77, 0x67, 0xc2, 0x8d, 0xb9, 0x39, 0x50, 0x4f, 0xbf, 0x17, 0xa3, 0x77, 0xa6, 0x11,
-	0x98, 0x78, 0x60, 0x6b, 0xad, 0xce, 0x82, 0x1a, 0xb3, 0x7a, 0x08, 0xf5, 0xf4, 0x8b, 0xa4, 0x9a,
-	0x95, 0xf2, 0xd5, 0xb2, 0x75, 0xd4, 0x1c, 0xd4, 0x98, 0x43, 0x3f, 0x83, 0xc5, 0x89, 0x67, 0x40,
-	0xf4, 0x6d, 0x15, 0xf9, 0x69, 0xaf, 0x85, 0xc7, 0x71, 0x90, 0xd2, 0x8f, 0xb5, 0x38, 0x5d, 0xfa,
-	0x89, 0xf7, 0xe0, 0xd9, 0xa5, 0x4f, 0x90, 0x3f, 0x4a, 0xfa, 0x13, 0x73, 0x18, 0x01, 0x9a, 0x7c,
-	0x08, 0x44, 0xef, 0xa9, 0x58, 0x4c, 0x7d, 0x8c, 0x6c, 0xad, 0xcd, 0x8a, 0x1e, 0x9b, 0x7c, 0xc4,
-	0xa3, 0x35, 0xfb, 0x64, 0xa6, 0x64, 0x3b, 0xf5, 0x0d, 0x50, 0xcd, 0x76, 0xfa, 0x2b, 0x97, 0x70,
-	0xea, 0xf4, 0x78, 0x5d, 0x6d, 0x2b, 0xe5, 0xcb, 0x93, 0xda, 0xa9, 0xd5, 0xd3, 0x7a, 0x63, 0x0e,
-	0xed, 0x42, 0x25, 0x71, 0xd5, 0x41, 0xd7, 0xa6, 0xf9, 0x44, 0xfa, 0x2e, 0x74, 0x9c, 0xb9, 0xba,
-	0x00, 0x9b, 0x38, 0xbc, 0x8f, 0xc3, 0xc0, 0xe9, 0xd3, 0x2c, 0x51, 0xf9, 0x33, 0x46, 0x88, 0x88,
-	0x5e, 0x3f, 0x16, 0x2f, 0x12, 0x7b, 0xfd, 0x2b, 0x80, 0x32, 0xb7, 0x19, 0xab, 0xfd, 0xff, 0x4f,
-	0xe3, 0x2f, 0x21, 0x8d, 0x3f, 0x82, 0x46, 0xe6, 0x6d, 0x45, 0x9d, 0xc6, 0xd5, 0x0f, 0x30, 0xc7,
-	0x39, 0x48, 0x0f, 0xd0, 0xe4, 0x03, 0x80, 0x3a, 0xb0, 0xa6, 0x3e, 0x14, 0x1c, 0xc7, 0xe3, 0x11,
-	0x34, 0x32, 0x03, 0x78, 0xf5, 0x09, 0xd4, 0x53, 0xfa, 0x19, 0x4e, 0x30, 0x39, 0x78, 0x56, 0x9f,
-	0x60, 0xea, 0x80, 0xfa, 0x38, 0x1e, 0x5f, 0x40, 0x35, 0x39, 0xf2, 0x43, 0xd7, 0xa7, 0x45, 0x67,
-	0xa6, 0x71, 0x7a, 0xf5, 0xf9, 0xfa, 0xe5, 0xd7, 0xb3, 0x47, 0xd0, 0xc8, 0x4c, 0xe5, 0xd4, 0xd6,
-	0x55, 0x8f, 0xee, 0x8e, 0xa3, 0xfe, 0x0d, 0x66, 0xe0, 0x97, 0x9d, 0x2b, 0xef, 0x7c, 0xf0, 0x70,
-	0x7d, 0xe0, 0x84, 0xfb, 0xa3, 0x1e, 0x3b, 0xe5, 0x4d, 0x81, 0xf9, 0x9e, 0x43, 0xe4, 0xd7, 0xcd,
-	0x28, 0x69, 0xdc, 0xe4, 0x94, 0x6e, 0x72, 0x69, 0x87, 0xbd, 0xde, 0x3c, 0xff, 0xbd, 0xfd, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0xe8, 0x29, 0x4c, 0xf1, 0x29, 0x00, 0x00,
+	// 2472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4d, 0x6f, 0xdc, 0xc6,
+	0x55, 0xdc, 0x0f, 0x49, 0xfb, 0xf6, 0x8b, 0x1a, 0x4b, 0xca, 0x7a, 0x1b, 0x27, 0x0a, 0x1d, 0x7f,
+	0x44, 0xa9, 0x65, 0x47, 0x4e, 0x3f, 0x82, 0x36, 0x87, 0x58, 0x1b, 0x2b, 0x9b, 0xd8, 0xb2, 0x4a,
+	0x29, 0x09, 0x6a, 0x18, 0x60, 0xa9, 0xe5, 0x68, 0x45, 0x98, 0xe4, 0xac, 0x39, 0x5c, 0xdb, 0xf2,
+	0xb9, 0x87, 0xf6, 0x50, 0xf4, 0xd0, 0x63, 0x8b, 0x02, 0x05, 0x5a, 0x14, 0x01, 0xda, 0x63, 0x7b,
+	0xf6, 0xa5, 0xf7, 0xfe, 0x82, 0x02, 0x45, 0xfb, 0x17, 0xda, 0x73, 0x31, 0x1f, 0xe4, 0xf2, 0x53,
+	0x5a, 0x49, 0x75, 0x6c, 0x14, 0xbd, 0x2d, 0xdf, 0xbc, 0x79, 0xef, 0xcd, 0xfb, 0x9e, 0x37, 0x0b,
+	0x0b, 0x8f, 0xc6, 0xd8, 0x3f, 0x34, 0x06, 0x84, 0xf8, 0xd6, 0xda, 0xc8, 0x27, 0x01, 0x41, 0xc8,
+	0xb5, 0x9d, 0xc7, 0x63, 0x2a, 0xbe, 0xd6, 0xf8, 0x7a, 0xb7, 0x31, 0x20, 0xae, 0x4b, 0x3c, 0x01,
+	0xeb, 0x36, 0xe2, 0x18, 0xdd, 0x96, 0xed, 0x05, 0xd8, 0xf7, 0x4c, 0x27, 0x5c, 0xa5, 0x83, 0x03,
+	0xec, 0x9a, 0xf2, 0x4b, 0xb5, 0xcc, 0xc0, 0x8c, 0xd3, 0xef, 0x2e, 0xd8, 0x9e, 0x85, 0x9f, 0xc6,
+	0x41, 0xda, 0x8f, 0x15, 0x58, 0xde, 0x39, 0x20, 0x4f, 0x36, 0x88, 0xe3, 0xe0, 0x41, 0x60, 0x13,
+	0x8f, 0xea, 0xf8, 0xd1, 0x18, 0xd3, 0x00, 0xdd, 0x80, 0xca, 0x9e, 0x49, 0x71, 0x47, 0x59, 0x51,
+	0xae, 0xd6, 0xd7, 0x5f, 0x5f, 0x4b, 0x08, 0x27, 0xa5, 0xba, 0x4b, 0x87, 0xb7, 0x4c, 0x8a, 0x75,
+	0x8e, 0x89, 0x10, 0x54, 0xac, 0xbd, 0x7e, 0xaf, 0x53, 0x5a, 0x51, 0xae, 0x96, 0x75, 0xfe, 0x1b,
+	0xbd, 0x0d, 0xcd, 0x41, 0x44, 0xbb, 0xdf, 0xa3, 0x9d, 0xf2, 0x4a, 0xf9, 0x6a, 0x59, 0x4f, 0x02,
+	0xb5, 0xdf, 0x2b, 0xf0, 0x5a, 0x46, 0x0c, 0x3a, 0x22, 0x1e, 0xc5, 0xe8, 0x26, 0xcc, 0xd2, 0xc0,
+	0x0c, 0xc6, 0x54, 0x4a, 0xf2, 0x8d, 0x5c, 0x49, 0x76, 0x38, 0x8a, 0x2e, 0x51, 0xb3, 0x6c, 0x4b,
+	0x39, 0x6c, 0xd1, 0x7b, 0xb0, 0x68, 0x7b, 0x77, 0xb1, 0x4b, 0xfc, 0x43, 0x63, 0x84, 0xfd, 0x01,
+	0xf6, 0x02, 0x73, 0x88, 0x43, 0x19, 0xcf, 0x85, 0x6b, 0xdb, 0x93, 0x25, 0xed, 0x77, 0x0a, 0x2c,
+	0x31, 0x49, 0xb7, 0x4d, 0x3f, 0xb0, 0x5f, 0x80, 0xbe, 0x34, 0x68, 0xc4, 0x65, 0xec, 0x94, 0xf9,
+	0x5a, 0x02, 0xc6, 0x70, 0x46, 0x21, 0x7b, 0x76, 0xb6, 0x0a, 0x17, 0x37, 0x01, 0xd3, 0x7e, 0x2b,
+	0x0d, 0x1b, 0x97, 0xf3, 0x2c, 0x0a, 0x4d, 0xf3, 0x2c, 0x65, 0x79, 0x9e, 0x46, 0x9d, 0xcf, 0x15,
+	0x58, 0xba, 0x43, 0x4c, 0x6b, 0x62, 0xf8, 0xaf, 0x5f, 0x9d, 0x1f, 0xc2, 0xac, 0x08, 0x9c, 0x4e,
+	0x85, 0xf3, 0xba, 0x94, 0xe4, 0x25, 0xd6, 0xd6, 0x26, 0x12, 0xee, 0x70, 0x80, 0x2e, 0x37, 0x69,
+	0xbf, 0x52, 0xa0, 0xa3, 0x63, 0x07, 0x9b, 0x14, 0xbf, 0xcc, 0x53, 0x2c, 0xc3, 0xac, 0x47, 0x2c,
+	0xdc, 0xef, 0xf1, 0x53, 0x94, 0x75, 0xf9, 0xa5, 0xfd, 0x53, 0x6a, 0xf8, 0x15, 0x77, 0xd8, 0x98,
+	0x15, 0xaa, 0xa7, 0xb1, 0xc2, 0xf3, 0x89, 0x15, 0x5e, 0xf5, 0x93, 0x4e, 0x2c, 0x55, 0x4d, 0x58,
+	0xea, 0x87, 0x70, 0x7e, 0xc3, 0xc7, 0x66, 0x80, 0x7f, 0xc0, 0x32, 0xff, 0xc6, 0x81, 0xe9, 0x79,
+	0xd8, 0x09, 0x8f, 0x90, 0x66, 0xae, 0xe4, 0x30, 0xef, 0xc0, 0xdc, 0xc8, 0x27, 0x4f, 0x0f, 0x23,
+	0xb9, 0xc3, 0x4f, 0xed, 0x37, 0x0a, 0x74, 0xf3, 0x68, 0x9f, 0x25, 0x23, 0x5c, 0x81, 0xb6, 0x2f,
+	0x84, 0x33, 0x06, 0x82, 0x1e, 0xe7, 0x5a, 0xd3, 0x5b, 0x12, 0x2c, 0xb9, 0xa0, 0x4b, 0xd0, 0xf2,
+	0x31, 0x1d, 0x3b, 0x13, 0xbc, 0x32, 0xc7, 0x6b, 0x0a, 0xa8, 0x44, 0xd3, 0xbe, 0x52, 0xe0, 0xfc,
+	0x26, 0x0e, 0x22, 0xeb, 0x31, 0x76, 0xf8, 0x15, 0xcd, 0xae, 0xbf, 0x56, 0xa0, 0x9d, 0x12, 0x14,
+	0xad, 0x40, 0x3d, 0x86, 0x23, 0x0d, 0x14, 0x07, 0xa1, 0xef, 0x42, 0x95, 0xe9, 0x0e, 0x73, 0x91,
+	0x5a, 0xeb, 0xda, 0x5a, 0xb6, 0xde, 0xaf, 0x25, 0xa9, 0xea, 0x62, 0x03, 0xba, 0x0e, 0xe7, 0x72,
+	0x32, 0xab, 0x14, 0x1f, 0x65, 0x13, 0xab, 0xf6, 0x47, 0x05, 0xba, 0x79, 0xca, 0x3c, 0x8b, 0xc1,
+	0xef, 0xc3, 0x72, 0x74, 0x1a, 0xc3, 0xc2, 0x74, 0xe0, 0xdb, 0x23, 0xf6, 0x5b, 0x14, 0x83, 0xfa,
+	0xfa, 0xc5, 0xe3, 0xcf, 0x43, 0xf5, 0xa5, 0x88, 0x44, 0x2f, 0x46, 0x41, 0xfb, 0x99, 0x02, 0x4b,
+	0x9b, 0x38, 0xd8, 0xc1, 0x43, 0x17, 0x7b, 0x41, 0xdf, 0xdb, 0x27, 0xa7, 0x37, 0xfc, 0x1b, 0x00,
+	0x54, 0xd2, 0x89, 0x0a, 0x55, 0x0c, 0x32, 0x8d, 0x13, 0x68, 0xbf, 0xa8, 0x42, 0x3d, 0x26, 0x0c,
+	0x7a, 0x1d, 0x6a, 0x11, 0x05, 0x69, 0xda, 0x09, 0x20, 0x43, 0xb1, 0x94, 0xe3, 0x56, 0x29, 0xf7,
+	0x28, 0x67, 0xdd, 0xa3, 0x20, 0x83, 0xa3, 0xf3, 0x30, 0xef, 0x62, 0xd7, 0xa0, 0xf6, 0x33, 0x2c,
+	0x33, 0xc6, 0x9c, 0x8b, 0xdd, 0x1d, 0xfb, 0x19, 0x66, 0x4b, 0xde, 0xd8, 0x35, 0x7c, 0xf2, 0x84,
+	0x76, 0x66, 0xc5, 0x92, 0x37, 0x76, 0x75, 0xf2, 0x84, 0xa2, 0x0b, 0x00, 0xa2, 0xdd, 0xf3, 0x4c,
+	0x17, 0x77, 0xe6, 0x78, 0xc4, 0xd5, 0x38, 0x64, 0xcb, 0x74, 0x31, 0xcb, 0x15, 0xfc, 0xa3, 0xdf,
+	0xeb, 0xcc, 0x8b, 0x8d, 0xf2, 0x93, 0x1d, 0x55, 0xc6, 0x69, 0xbf, 0xd7, 0xa9, 0x89, 0x7d, 0x11,
+	0x00, 0x7d, 0x0c, 0x4d, 0x79, 0x6e, 0x43, 0xf8, 0x32, 0x70, 0x5f, 0x5e, 0xc9, 0xb3, 0xbd, 0x54,
+	0xa0, 0xf0, 0xe4, 0x06, 0x8d, 0x7d, 0xa1, 0xcb, 0xd0, 0x1a, 0x10, 0x77, 0x64, 0x72, 0xed, 0xdc,
+	0xf6, 0x89, 0xdb, 0xa9, 0x73, 0x3b, 0xa5, 0xa0, 0xe8, 0x06, 0x9c, 0x1b, 0xf0, 0xbc, 0x65, 0xdd,
+	0x3a, 0xdc, 0x88, 0x96, 0x3a, 0x8d, 0x15, 0xe5, 0xea, 0xbc, 0x9e, 0xb7, 0x84, 0xbe, 0x13, 0x06,
+	0x59, 0x93, 0x0b, 0xf6, 0x56, 0xbe, 0x67, 0xc7, 0x25, 0x93, 0x31, 0xf6, 0x16, 0x34, 0xb0, 0x67,
+	0xee, 0x39, 0xd8, 0xe0, 0x9a, 0xe8, 0xb4, 0x38, 0x8f, 0xba, 0x80, 0xf5, 0x19, 0x08, 0xdd, 0x03,
+	0x55, 0xe8, 0x74, 0x64, 0x06, 0x07, 0x86, 0xed, 0xed, 0x13, 0xda, 0x69, 0xaf, 0x94, 0xb3, 0xd5,
+	0x8a, 0x63, 0xad, 0xf1, 0x4d, 0xb7, 0x6d, 0x07, 0x6f, 0x9b, 0xc1, 0x01, 0xf7, 0xe9, 0x16, 0x5f,
+	0x08, 0x3f, 0x29, 0xba, 0x06, 0x68, 0x72, 0x60, 0x83, 0x8c, 0x83, 0xd1, 0x38, 0xa0, 0x1d, 0x95,
+	0xab, 0x62, 0x61, 0xb2, 0x72, 0x4f, 0x2c, 0xf0, 0x6e, 0x3d, 0x1d, 0x25, 0x67, 0x89, 0xe8, 0x6f,
+	0x41, 0x55, 0x1c, 0x42, 0x04, 0xf0, 0x9b, 0x47, 0x18, 0x91, 0x33, 0x13, 0xd8, 0xda, 0x9f, 0xcb,
+	0xb0, 0xfc, 0x91, 0x65, 0xe5, 0x95, 0xa9, 0x93, 0x47, 0xeb, 0xc4, 0xeb, 0x4b, 0x09, 0xaf, 0x9f,
+	0x26, 0x55, 0xbf, 0x0b, 0x0b, 0xa9, 0x12, 0x24, 0x83, 0xa7, 0xa6, 0xab, 0xc9, 0x22, 0xd4, 0xef,
+	0xa1, 0x77, 0x40, 0x4d, 0x96, 0x21, 0x59, 0x80, 0x6b, 0x7a, 0x3b, 0x51, 0x88, 0xfa, 0x3d, 0xf4,
+	0x6d, 0x78, 0x6d, 0xe8, 0x90, 0x3d, 0xd3, 0x31, 0x28, 0x36, 0x1d, 0x6c, 0x19, 0x93, 0xd8, 0x9f,
+	0xe5, 0xb6, 0x59, 0x12, 0xcb, 0x3b, 0x7c, 0x35, 0xd4, 0x50, 0x0f, 0x6d, 0xb2, 0xe0, 0xc0, 0x0f,
+	0x8d, 0x11, 0xa1, 0x3c, 0xa8, 0x79, 0xd8, 0xd5, 0xd3, 0x89, 0x3e, 0xba, 0xb5, 0xdd, 0xa5, 0xc3,
+	0x6d, 0x89, 0xc9, 0xc2, 0x03, 0x3f, 0x0c, 0xbf, 0xd0, 0xe7, 0xb0, 0x9c, 0x2b, 0x00, 0xed, 0xcc,
+	0x4f, 0x67, 0xa9, 0xc5, 0x1c, 0x01, 0xa9, 0xf6, 0x77, 0x05, 0xce, 0xeb, 0xd8, 0x25, 0x8f, 0xf1,
+	0xff, 0xac, 0xed, 0xb4, 0x7f, 0x94, 0x60, 0xf9, 0x4b, 0x33, 0x18, 0x1c, 0xf4, 0x5c, 0x09, 0xa4,
+	0x2f, 0xe7, 0x80, 0xa9, 0x84, 0x5f, 0xc9, 0x26, 0xfc, 0x28, 0xfc, 0xaa, 0x79, 0x46, 0x65, 0xd7,
+	0xf7, 0xb5, 0x2f, 0xc2, 0xf3, 0x4e, 0xc2, 0x2f, 0xd6, 0x29, 0xcf, 0x9e, 0xa2, 0x53, 0x46, 0x1b,
+	0xd0, 0xc4, 0x4f, 0x07, 0xce, 0xd8, 0xc2, 0x32, 0x83, 0xcd, 0x71, 0xee, 0x6f, 0xe4, 0x70, 0x8f,
+	0x7b, 0x54, 0x43, 0x6e, 0xea, 0xf3, 0x14, 0xf0, 0x5c, 0x81, 0xf3, 0x42, 0xcb, 0xd8, 0x09, 0xcc,
+	0x97, 0xab, 0xe8, 0x48, 0x8d, 0x95, 0x93, 0xa8, 0x51, 0xfb, 0x43, 0x15, 0xda, 0xf2, 0x80, 0xec,
+	0x7e, 0x34, 0x45, 0x99, 0x4f, 0x59, 0xb4, 0x94, 0xb5, 0xe8, 0x34, 0xe2, 0x86, 0x7d, 0x69, 0x25,
+	0xd6, 0x97, 0x5e, 0x00, 0xd8, 0x77, 0xc6, 0xf4, 0xc0, 0x08, 0x6c, 0x37, 0x2c, 0xf2, 0x35, 0x0e,
+	0xd9, 0xb5, 0x5d, 0x8c, 0x3e, 0x82, 0xc6, 0x9e, 0xed, 0x39, 0x64, 0xc8, 0x0b, 0x0f, 0xed, 0xcc,
+	0x16, 0x5a, 0xec, 0xb6, 0x8d, 0x1d, 0xeb, 0x16, 0xc7, 0xd5, 0xeb, 0x62, 0x0f, 0xab, 0x36, 0x14,
+	0xbd, 0x01, 0x75, 0xd6, 0x29, 0x90, 0x7d, 0xd1, 0x2c, 0xcc, 0x09, 0x16, 0xde, 0xd8, 0xbd, 0xb7,
+	0xcf, 0xdb, 0x85, 0xef, 0x43, 0x8d, 0x15, 0x05, 0xea, 0x90, 0x61, 0x98, 0x64, 0x8e, 0xa3, 0x3f,
+	0xd9, 0x80, 0x3e, 0x84, 0x9a, 0xc5, 0x1c, 0x81, 0xef, 0xae, 0x15, 0x9a, 0x81, 0x3b, 0xcb, 0x1d,
+	0x32, 0xe4, 0x66, 0x98, 0xec, 0xc8, 0xe9, 0x06, 0x20, 0xb7, 0x1b, 0x48, 0x97, 0xe8, 0xfa, 0x74,
+	0x25, 0xba, 0x71, 0x96, 0x12, 0xfd, 0x25, 0x2c, 0x0e, 0x9c, 0x31, 0x0d, 0xb0, 0x6f, 0x7b, 0x43,
+	0xe3, 0x21, 0x3e, 0x34, 0x7c, 0xd3, 0x1b, 0x8a, 0xf6, 0x22, 0x43, 0x94, 0x9f, 0x72, 0x23, 0x42,
+	0xff, 0x0c, 0x1f, 0xea, 0x0c, 0x59, 0x47, 0x83, 0x0c, 0xac, 0xa0, 0xf6, 0xb7, 0x8a, 0x6a, 0xff,
+	0xbf, 0x4b, 0x70, 0x8e, 0xf9, 0x69, 0x98, 0xcc, 0x4f, 0x1f, 0x6b, 0x17, 0x00, 0x2c, 0x1a, 0x18,
+	0x89, 0x78, 0xab, 0x59, 0x34, 0xd8, 0xe2, 0x00, 0xf4, 0x41, 0x18, 0x4e, 0xe5, 0xe2, 0xae, 0x3e,
+	0x15, 0x37, 0xd9, 0xcc, 0x74, 0x9a, 0x49, 0x0a, 0xfa, 0x0c, 0x5a, 0x0e, 0x31, 0x2d, 0x63, 0x40,
+	0x3c, 0x4b, 0xd4, 0xcf, 0x2a, 0xef, 0xe1, 0xde, 0xce, 0x13, 0x61, 0xd7, 0xb7, 0x87, 0x43, 0xec,
+	0x6f, 0x84, 0xb8, 0x7a, 0xd3, 0xe1, 0x73, 0x24, 0xf9, 0x89, 0x2e, 0x42, 0x93, 0x92, 0xb1, 0x3f,
+	0xc0, 0xe1, 0x41, 0x45, 0x7f, 0xdc, 0x10, 0xc0, 0xad, 0xfc, 0xf4, 0x32, 0x97, 0x73, 0x15, 0xf8,
+	0x9b, 0x02, 0xcb, 0x72, 0xb2, 0x70, 0x76, 0xdd, 0x17, 0xe5, 0xb9, 0x30, 0x29, 0x94, 0x8f, 0xb8,
+	0xac, 0x56, 0xa6, 0xb8, 0xac, 0x56, 0x73, 0xe6, 0x0d, 0xc9, 0xfb, 0xd0, 0x6c, 0xfa, 0x3e, 0xa4,
+	0xed, 0x42, 0x33, 0xaa, 0x95, 0x3c, 0x0b, 0x5e, 0x84, 0xa6, 0x10, 0xcb, 0x60, 0x2a, 0xc5, 0x56,
+	0x38, 0x6c, 0x10, 0xc0, 0x3b, 0x1c, 0xc6, 0xa8, 0x46, 0xb5, 0x58, 0x34, 0x90, 0x35, 0x3d, 0x06,
+	0xd1, 0xfe, 0x54, 0x02, 0x35, 0xde, 0x65, 0x70, 0xca, 0xd3, 0x4c, 0x31, 0xae, 0x40, 0x5b, 0x8e,
+	0xc6, 0xa3, 0x52, 0x2f, 0xe7, 0x0a, 0x8f, 0xe2, 0xe4, 0x7a, 0xe8, 0x7d, 0x58, 0x16, 0x88, 0x99,
+	0xd6, 0x40, 0xcc, 0x17, 0x16, 0xf9, 0xaa, 0x9e, 0xea, 0xed, 0x8a, 0x5b, 0xab, 0xca, 0x19, 0x5a,
+	0xab, 0x6c, 0xeb, 0x57, 0x3d, 0x5d, 0xeb, 0xa7, 0xfd, 0xb5, 0x0c, 0xad, 0x49, 0x84, 0x4c, 0xad,
+	0xb5, 0x69, 0xe6, 0xb3, 0x5b, 0xa0, 0x46, 0xdf, 0xe2, 0xf6, 0x76, 0x64, 0x90, 0xa7, 0xaf, 0xee,
+	0xed, 0x51, 0x12, 0x80, 0x6e, 0x43, 0x53, 0xea, 0xdc, 0x88, 0x17, 0xe0, 0xb7, 0xf2, 0x88, 0x25,
+	0x3c, 0x4c, 0x6f, 0xc4, 0xea, 0x31, 0x45, 0x1f, 0x40, 0x8d, 0xc7, 0x7d, 0x70, 0x38, 0xc2, 0x32,
+	0xe4, 0x5f, 0xcf, 0xa3, 0xc1, 0x3c, 0x6f, 0xf7, 0x70, 0x84, 0xf5, 0x79, 0x47, 0xfe, 0x3a, 0x6b,
+	0x2f, 0x74, 0x13, 0x96, 0x7c, 0x11, 0xda, 0x96, 0x91, 0x50, 0xdf, 0x1c, 0x57, 0xdf, 0x62, 0xb8,
+	0xb8, 0x1d, 0x57, 0x63, 0xc1, 0x30, 0x66, 0xbe, 0x70, 0x18, 0xf3, 0xcb, 0x12, 0x2c, 0x33, 0xd9,
+	0x6f, 0x99, 0x8e, 0xe9, 0x0d, 0xf0, 0xf4, 0x73, 0x85, 0xff, 0x4e, 0xc3, 0x91, 0xc9, 0x84, 0x95,
+	0x9c, 0x4c, 0x98, 0x2c, 0x0a, 0xd5, 0x74, 0x51, 0x78, 0x13, 0xea, 0x92, 0x86, 0x45, 0x3c, 0xcc,
+	0x95, 0x3d, 0xaf, 0x83, 0x00, 0xf5, 0x88, 0xc7, 0x27, 0x11, 0x6c, 0x3f, 0x5f, 0x9d, 0xe3, 0xab,
+	0x73, 0x16, 0x0d, 0xf8, 0xd2, 0x05, 0x80, 0xc7, 0xa6, 0x63, 0x5b, 0xdc, 0x49, 0xb8, 0x9a, 0xe6,
+	0xf5, 0x1a, 0x87, 0x30, 0x15, 0x68, 0x3f, 0x57, 0x60, 0xf9, 0x13, 0xd3, 0xb3, 0xc8, 0xfe, 0xfe,
+	0xd9, 0xf3, 0xeb, 0x06, 0x84, 0x73, 0x86, 0xfe, 0x49, 0x2e, 0xb6, 0x89, 0x4d, 0xda, 0x4f, 0x4a,
+	0x80, 0x62, 0xf6, 0x3a, 0xbd, 0x34, 0x97, 0xa0, 0x95, 0xd0, 0x7c, 0xf4, 0x0c, 0x15, 0x57, 0x3d,
+	0x65, 0x75, 0x6f, 0x4f, 0xb0, 0x32, 0x7c, 0x6c, 0x52, 0xe2, 0x75, 0xca, 0x27, 0xa9, 0x7b, 0x7b,
+	0xa1, 0x98, 0x6c, 0x2b, 0xb3, 0xd4, 0xc4, 0x90, 0xe1, 0xf4, 0x12, 0x22, 0x4b, 0x52, 0x76, 0xf1,
+	0x4a, 0xdf, 0x6a, 0xc3, 0xba, 0xa1, 0xd2, 0xe4, 0x85, 0x96, 0x6a, 0xff, 0x52, 0x60, 0x41, 0x7e,
+	0xb2, 0xf8, 0x1d, 0xe2, 0xb0, 0x40, 0x10, 0xcf, 0xb1, 0xbd, 0xc8, 0xa3, 0x64, 0x46, 0x12, 0x40,
+	0xe9, 0x32, 0x9f, 0x40, 0x5b, 0x22, 0x45, 0x19, 0x76, 0x4a, 0x6b, 0xb4, 0xc4, 0xbe, 0x28, 0xb7,
+	0x5e, 0x82, 0x16, 0xd9, 0xdf, 0x8f, 0xf3, 0x13, 0x6e, 0xde, 0x94, 0x50, 0xc9, 0xf0, 0x53, 0x50,
+	0x43, 0xb4, 0x93, 0xe6, 0xf4, 0xb6, 0xdc, 0x18, 0xdd, 0x94, 0x7f, 0xaa, 0x40, 0x27, 0x99, 0xe1,
+	0x63, 0xc7, 0x3f, 0xb9, 0x23, 0x7c, 0x2f, 0x39, 0x68, 0xb9, 0x74, 0x84, 0x3c, 0x13, 0x3e, 0xb2,
+	0xab, 0x5a, 0x7d, 0x06, 0xad, 0x64, 0x2a, 0x46, 0x0d, 0x98, 0xdf, 0x22, 0xc1, 0xc7, 0x4f, 0x6d,
+	0x1a, 0xa8, 0x33, 0xa8, 0x05, 0xb0, 0x45, 0x82, 0x6d, 0x1f, 0x53, 0xec, 0x05, 0xaa, 0x82, 0x00,
+	0x66, 0xef, 0x79, 0x3d, 0x9b, 0x3e, 0x54, 0x4b, 0xe8, 0x9c, 0x9c, 0x53, 0x9b, 0x4e, 0x5f, 0xe6,
+	0x25, 0xb5, 0xcc, 0xb6, 0x47, 0x5f, 0x15, 0xa4, 0x42, 0x23, 0x42, 0xd9, 0xdc, 0xfe, 0x5c, 0xad,
+	0xa2, 0x1a, 0x54, 0xc5, 0xcf, 0xd9, 0xd5, 0x7b, 0xa0, 0xa6, 0x1d, 0x0e, 0xd5, 0x61, 0xee, 0x40,
+	0xc4, 0xab, 0x3a, 0x83, 0xda, 0x50, 0x77, 0x26, 0xa1, 0xa2, 0x2a, 0x0c, 0x30, 0xf4, 0x47, 0x03,
+	0x19, 0x34, 0x6a, 0x89, 0x71, 0x63, 0x56, 0xeb, 0x91, 0x27, 0x9e, 0x5a, 0x5e, 0xfd, 0x14, 0x1a,
+	0xf1, 0xe1, 0x1b, 0x9a, 0x87, 0xca, 0x16, 0xf1, 0xb0, 0x3a, 0xc3, 0xc8, 0x6e, 0xfa, 0xe4, 0x89,
+	0xed, 0x0d, 0xc5, 0x19, 0x6e, 0xfb, 0xe4, 0x19, 0xf6, 0xd4, 0x12, 0x5b, 0x60, 0x7e, 0xc9, 0x16,
+	0xca, 0x6c, 0x41, 0x38, 0xa9, 0x5a, 0x59, 0x7d, 0x0f, 0xe6, 0xc3, 0x92, 0x80, 0x16, 0xa0, 0x99,
+	0x78, 0xe5, 0x52, 0x67, 0x10, 0x12, 0xed, 0xe4, 0x24, 0xf9, 0xab, 0xca, 0xfa, 0x5f, 0xea, 0x00,
+	0xa2, 0x2b, 0x61, 0x8f, 0xe0, 0x68, 0x04, 0x68, 0x13, 0x07, 0x6c, 0x7a, 0x48, 0xbc, 0x50, 0x24,
+	0x8a, 0x6e, 0x14, 0x14, 0xed, 0x2c, 0xaa, 0x3c, 0x65, 0xf7, 0x72, 0xc1, 0x8e, 0x14, 0xba, 0x36,
+	0x83, 0x5c, 0xce, 0x91, 0xdd, 0xea, 0x76, 0xed, 0xc1, 0xc3, 0xf0, 0x89, 0xe4, 0x08, 0x8e, 0x29,
+	0xd4, 0x90, 0x63, 0xaa, 0x62, 0xcb, 0x8f, 0x9d, 0x80, 0x5d, 0x31, 0xc2, 0xa1, 0xa0, 0x36, 0x83,
+	0x1e, 0xc1, 0x22, 0x1b, 0x18, 0x06, 0x66, 0x60, 0xd3, 0xc0, 0x1e, 0xd0, 0x90, 0xe1, 0x7a, 0x31,
+	0xc3, 0x0c, 0xf2, 0x09, 0x59, 0x3a, 0xd0, 0x4e, 0x3d, 0xe5, 0xa3, 0xd5, 0x5c, 0x7f, 0xcf, 0xfd,
+	0xdb, 0x41, 0xf7, 0xdd, 0xa9, 0x70, 0x23, 0x6e, 0x36, 0xb4, 0x92, 0xcf, 0xdc, 0xe8, 0x9d, 0x22,
+	0x02, 0x99, 0x77, 0xc1, 0xee, 0xea, 0x34, 0xa8, 0x11, 0xab, 0xfb, 0xd0, 0x4a, 0x3e, 0xa4, 0xe6,
+	0xb3, 0xca, 0x7d, 0x6c, 0xed, 0x1e, 0x35, 0x8f, 0xd5, 0x66, 0xd0, 0x8f, 0x60, 0x21, 0xf3, 0x7a,
+	0x89, 0xbe, 0x99, 0x47, 0xbe, 0xe8, 0x91, 0xf3, 0x38, 0x0e, 0x52, 0xfa, 0x89, 0x16, 0x8b, 0xa5,
+	0xcf, 0x3c, 0x63, 0x4f, 0x2f, 0x7d, 0x8c, 0xfc, 0x51, 0xd2, 0x9f, 0x98, 0xc3, 0x18, 0x50, 0xf6,
+	0xfd, 0x12, 0x5d, 0xcb, 0x63, 0x51, 0xf8, 0x86, 0xda, 0x5d, 0x9b, 0x16, 0x3d, 0x32, 0xf9, 0x98,
+	0x47, 0x6b, 0xfa, 0xa5, 0x2f, 0x97, 0x6d, 0xe1, 0xd3, 0x65, 0x77, 0x6d, 0x5a, 0xf4, 0xb8, 0x53,
+	0x27, 0xc7, 0xfc, 0xf9, 0xb6, 0xca, 0x7d, 0x30, 0xeb, 0xae, 0x4e, 0x83, 0x1a, 0xb1, 0xda, 0x85,
+	0x7a, 0xac, 0xd5, 0x41, 0x97, 0x8b, 0x7c, 0x22, 0xd9, 0x0b, 0x1d, 0x67, 0x2e, 0x03, 0x60, 0x13,
+	0x07, 0x77, 0x71, 0xe0, 0xdb, 0x03, 0x9a, 0x26, 0x2a, 0x3f, 0x26, 0x08, 0x21, 0xd1, 0x2b, 0xc7,
+	0xe2, 0x85, 0x62, 0xaf, 0x7f, 0x05, 0x50, 0xe3, 0x36, 0x63, 0xb5, 0xff, 0xff, 0x69, 0xfc, 0x05,
+	0xa4, 0xf1, 0x07, 0xd0, 0x4e, 0xbd, 0xf1, 0xe4, 0xa7, 0xf1, 0xfc, 0x87, 0xa0, 0xe3, 0x1c, 0x64,
+	0x0f, 0x50, 0xf6, 0x21, 0x22, 0x3f, 0xb0, 0x0a, 0x1f, 0x2c, 0x8e, 0xe3, 0xf1, 0x00, 0xda, 0xa9,
+	0x87, 0x80, 0xfc, 0x13, 0xe4, 0xbf, 0x16, 0x4c, 0x71, 0x82, 0xec, 0x00, 0x3c, 0xff, 0x04, 0x85,
+	0x83, 0xf2, 0xe3, 0x78, 0x7c, 0x01, 0x8d, 0xf8, 0xc8, 0x0f, 0x5d, 0x29, 0x8a, 0xce, 0xd4, 0xc5,
+	0xe9, 0xe5, 0xe7, 0xeb, 0x17, 0x5f, 0xcf, 0x1e, 0x40, 0x3b, 0x35, 0x95, 0xcb, 0xb7, 0x6e, 0xfe,
+	0xe8, 0xee, 0x38, 0xea, 0x5f, 0x63, 0x06, 0x7e, 0xd1, 0xb9, 0xf2, 0xd6, 0xfb, 0xf7, 0xd7, 0x87,
+	0x76, 0x70, 0x30, 0xde, 0x63, 0xa7, 0xbc, 0x2e, 0x30, 0xaf, 0xd9, 0x44, 0xfe, 0xba, 0x1e, 0x26,
+	0x8d, 0xeb, 0x9c, 0xd2, 0x75, 0x2e, 0xed, 0x68, 0x6f, 0x6f, 0x96, 0x7f, 0xde, 0xfc, 0xcf, 0x00,
+	0x23, 0x8a, 0xa7, 0xbe, 0xa8, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
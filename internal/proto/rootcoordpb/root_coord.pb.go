@@ -243,59 +243,62 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdb, 0x4f, 0xe3, 0x46,
-	0x14, 0xc6, 0x49, 0xa0, 0x54, 0x1c, 0x72, 0x41, 0x23, 0xa0, 0x28, 0xe5, 0x81, 0xa6, 0x2a, 0x24,
-	0x5c, 0x1c, 0x04, 0x52, 0xd5, 0x57, 0x48, 0x54, 0x88, 0xd4, 0x48, 0xc5, 0x01, 0x69, 0x6f, 0x28,
-	0x9a, 0x38, 0x47, 0x89, 0x85, 0xed, 0x31, 0x9e, 0xc9, 0xc2, 0x3e, 0xee, 0x5f, 0xb6, 0xff, 0xda,
-	0xca, 0x97, 0x71, 0x6c, 0xc7, 0x0e, 0x8e, 0x76, 0xdf, 0xe2, 0xf8, 0x37, 0xdf, 0x37, 0xe7, 0x3b,
-	0x1e, 0xfb, 0xc0, 0x96, 0xc3, 0x98, 0x18, 0x68, 0x8c, 0x39, 0x23, 0xc5, 0x76, 0x98, 0x60, 0x64,
-	0xd7, 0xd4, 0x8d, 0xcf, 0x53, 0xee, 0x5f, 0x29, 0xee, 0x6d, 0xef, 0x6e, 0xad, 0xa4, 0x31, 0xd3,
-	0x64, 0x96, 0xff, 0x7f, 0xad, 0x14, 0xa5, 0x6a, 0x15, 0xdd, 0x12, 0xe8, 0x58, 0xd4, 0x08, 0xae,
-	0x37, 0x6d, 0x87, 0xbd, 0x7e, 0x09, 0x2e, 0xb6, 0x46, 0x54, 0xd0, 0xa8, 0x45, 0x7d, 0x00, 0x3b,
-	0x57, 0x86, 0xc1, 0xb4, 0x7b, 0xdd, 0x44, 0x2e, 0xa8, 0x69, 0xab, 0xf8, 0x3c, 0x45, 0x2e, 0xc8,
-	0x39, 0xac, 0x0d, 0x29, 0xc7, 0xbd, 0xc2, 0x41, 0xa1, 0xb1, 0x79, 0xb1, 0xaf, 0xc4, 0xb6, 0x12,
-	0xf8, 0xf7, 0xf8, 0xf8, 0x9a, 0x72, 0x54, 0x3d, 0x92, 0x6c, 0xc3, 0x2f, 0x1a, 0x9b, 0x5a, 0x62,
-	0x6f, 0xf5, 0xa0, 0xd0, 0x28, 0xab, 0xfe, 0x45, 0xfd, 0x6b, 0x01, 0x76, 0x93, 0x0e, 0xdc, 0x66,
-	0x16, 0x47, 0x72, 0x09, 0xeb, 0x5c, 0x50, 0x31, 0xe5, 0x81, 0xc9, 0xef, 0xa9, 0x26, 0x7d, 0x0f,
-	0x51, 0x03, 0x94, 0xec, 0xc3, 0x86, 0x90, 0x4a, 0x7b, 0xc5, 0x83, 0x42, 0x63, 0x4d, 0x9d, 0xfd,
-	0x91, 0xb1, 0x87, 0x77, 0x50, 0xf1, 0xb6, 0xd0, 0xed, 0xfc, 0x84, 0xea, 0x8a, 0x51, 0x65, 0x03,
-	0xaa, 0xa1, 0xf2, 0x8f, 0x54, 0x55, 0x81, 0x62, 0xb7, 0xe3, 0x49, 0xaf, 0xaa, 0xc5, 0x6e, 0x27,
-	0xbd, 0x8e, 0x8b, 0x6f, 0xdb, 0xb0, 0xa1, 0x32, 0x26, 0xda, 0x6e, 0x03, 0x89, 0x0d, 0xe4, 0x06,
-	0x45, 0x9b, 0x99, 0x36, 0xb3, 0xd0, 0x12, 0xae, 0x22, 0x72, 0x72, 0x1e, 0xb7, 0x0b, 0x9f, 0x86,
-	0x79, 0x34, 0xc8, 0xa2, 0x76, 0x98, 0xb1, 0x22, 0x81, 0xd7, 0x57, 0x88, 0xe9, 0x39, 0xba, 0x8d,
-	0xbc, 0xd7, 0xb5, 0xa7, 0xf6, 0x84, 0x5a, 0x16, 0x1a, 0x8b, 0x1c, 0x13, 0xa8, 0x74, 0xfc, 0x33,
-	0xbe, 0x22, 0xb8, 0xe8, 0x0b, 0x47, 0xb7, 0xc6, 0x32, 0xc7, 0xfa, 0x0a, 0x79, 0x86, 0xed, 0x1b,
-	0xf4, 0xdc, 0x75, 0x2e, 0x74, 0x8d, 0x4b, 0xc3, 0x8b, 0x6c, 0xc3, 0x39, 0x78, 0x49, 0xcb, 0x01,
-	0x6c, 0xb5, 0x1d, 0xa4, 0x02, 0xdb, 0xcc, 0x30, 0x50, 0x13, 0x3a, 0xb3, 0xc8, 0x69, 0xea, 0xd2,
-	0x24, 0x26, 0x8d, 0x16, 0xb5, 0xbb, 0xbe, 0x42, 0x3e, 0x42, 0xa5, 0xe3, 0x30, 0x3b, 0x22, 0x7f,
-	0x9c, 0x2a, 0x1f, 0x87, 0x72, 0x8a, 0x0f, 0xa0, 0x7c, 0x4b, 0x79, 0x44, 0xbb, 0x99, 0xaa, 0x1d,
-	0x63, 0xa4, 0xf4, 0x1f, 0xa9, 0xe8, 0x35, 0x63, 0x46, 0x24, 0x9e, 0x17, 0x20, 0x1d, 0xe4, 0x9a,
-	0xa3, 0x0f, 0xa3, 0x01, 0x29, 0xe9, 0x15, 0xcc, 0x81, 0xd2, 0xaa, 0x95, 0x9b, 0x0f, 0x8d, 0x1f,
-	0x60, 0xd3, 0x0f, 0xfc, 0xca, 0xd0, 0x29, 0x27, 0x47, 0x0b, 0x5a, 0xe2, 0x11, 0x39, 0x03, 0xbb,
-	0x83, 0x0d, 0x37, 0x68, 0x5f, 0xf4, 0xaf, 0xcc, 0x46, 0x2c, 0x23, 0xd9, 0x07, 0xb8, 0x32, 0x04,
-	0x3a, 0xbe, 0xe6, 0x61, 0xaa, 0xe6, 0x0c, 0xc8, 0x29, 0x6a, 0x41, 0xb5, 0x3f, 0x61, 0x2f, 0xb3,
-	0x68, 0x38, 0x39, 0x49, 0x7f, 0xa0, 0xe3, 0x94, 0x94, 0x3f, 0xcd, 0x07, 0x87, 0x71, 0x3f, 0x42,
-	0xd5, 0x0f, 0xf3, 0x7f, 0xea, 0x08, 0xdd, 0x6b, 0xf2, 0xc9, 0x82, 0xc8, 0x43, 0x2a, 0x67, 0x39,
-	0xef, 0xa1, 0xec, 0xc6, 0x3a, 0x13, 0x6f, 0x66, 0x46, 0xbf, 0xac, 0xf4, 0x23, 0x94, 0x6e, 0x29,
-	0x9f, 0x29, 0x37, 0xb2, 0x4e, 0xc0, 0x9c, 0x70, 0xae, 0x03, 0xf0, 0x04, 0x15, 0x37, 0xb5, 0x70,
-	0x31, 0xcf, 0x38, 0xbe, 0x71, 0x48, 0x5a, 0x9c, 0xe4, 0x62, 0x43, 0x33, 0x0b, 0xaa, 0xf2, 0x50,
-	0xf4, 0x71, 0x6c, 0xa2, 0x25, 0x32, 0xba, 0x90, 0xa0, 0x16, 0x77, 0x7d, 0x0e, 0x0e, 0xfd, 0x10,
-	0x4a, 0xee, 0x5e, 0x82, 0x1b, 0x3c, 0x23, 0xbb, 0x28, 0x22, 0x9d, 0x9a, 0x39, 0xc8, 0xf9, 0xb3,
-	0xdc, 0xb5, 0x46, 0xf8, 0xba, 0xf0, 0x2c, 0x7b, 0x44, 0xce, 0xce, 0x4f, 0xa0, 0x2c, 0x4b, 0xf3,
-	0x85, 0x9b, 0x0b, 0xcb, 0x8f, 0x49, 0x1f, 0xe7, 0x41, 0xc3, 0x02, 0x82, 0xb7, 0x86, 0xef, 0x92,
-	0xfd, 0xd6, 0x58, 0x66, 0xf3, 0xcf, 0xc1, 0x84, 0x12, 0x0e, 0x49, 0xe4, 0x4c, 0x49, 0x1f, 0xfe,
-	0x94, 0xd4, 0x71, 0xad, 0xa6, 0xe4, 0xc5, 0xc3, 0x2a, 0x3e, 0xc1, 0xaf, 0xc1, 0xe8, 0x92, 0x7c,
-	0x4b, 0x25, 0x16, 0x87, 0x53, 0x53, 0xed, 0xe8, 0x4d, 0x2e, 0x54, 0xa7, 0xb0, 0xf3, 0x60, 0x8f,
-	0xdc, 0x2f, 0xa4, 0xff, 0x1d, 0x96, 0x93, 0x40, 0xb2, 0x2b, 0xb3, 0x69, 0x23, 0xce, 0xf5, 0xf8,
-	0xf8, 0xad, 0xcc, 0x0c, 0xf8, 0x4d, 0x45, 0x03, 0x29, 0xc7, 0xce, 0xdd, 0x7f, 0x3d, 0xe4, 0x9c,
-	0x8e, 0xb1, 0x2f, 0x1c, 0xa4, 0x66, 0x72, 0x42, 0xf0, 0x47, 0xe0, 0x0c, 0x38, 0x67, 0x87, 0x34,
-	0xd8, 0x09, 0x9e, 0xe5, 0x7f, 0x8d, 0x29, 0x9f, 0xb8, 0xc3, 0x91, 0x81, 0x02, 0x47, 0xc9, 0x23,
-	0xe9, 0x4e, 0xd8, 0x4a, 0x2a, 0x99, 0xa3, 0xa4, 0x01, 0xc0, 0x0d, 0x8a, 0x1e, 0x0a, 0x47, 0xd7,
-	0xb2, 0x3e, 0x1e, 0x33, 0x20, 0xa3, 0x2d, 0x29, 0x9c, 0x6c, 0xcb, 0xf5, 0x3f, 0x1f, 0xfe, 0x1e,
-	0xeb, 0x62, 0x32, 0x1d, 0xba, 0xd6, 0x2d, 0x9f, 0x3c, 0xd3, 0x59, 0xf0, 0xab, 0x25, 0xbb, 0xd1,
-	0xf2, 0x94, 0x5a, 0x61, 0x83, 0xed, 0xe1, 0x70, 0xdd, 0xfb, 0xeb, 0xf2, 0x7b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xad, 0x32, 0xbe, 0xa6, 0x0c, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x6d, 0x6f, 0xdb, 0x36,
+	0x10, 0xc7, 0x63, 0xb7, 0xeb, 0x90, 0x8b, 0x9f, 0x46, 0x34, 0x59, 0xe0, 0xf5, 0x45, 0xe6, 0x61,
+	0xa9, 0xdd, 0xa4, 0x76, 0x91, 0x02, 0xc3, 0xde, 0x26, 0x36, 0x96, 0x1a, 0x98, 0x81, 0x55, 0x6e,
+	0x81, 0x3d, 0x05, 0x06, 0x2d, 0x1f, 0x6c, 0x21, 0x12, 0xa9, 0x88, 0x74, 0x92, 0xbd, 0xdc, 0x77,
+	0xdd, 0x07, 0x19, 0xf4, 0x44, 0x4b, 0xb2, 0xa4, 0xd0, 0xd8, 0xde, 0x85, 0xd2, 0x8f, 0xff, 0x3f,
+	0x79, 0x77, 0xba, 0x9c, 0xa1, 0xe5, 0x71, 0x2e, 0x67, 0x26, 0xe7, 0xde, 0xa2, 0xef, 0x7a, 0x5c,
+	0x72, 0x72, 0xe4, 0x58, 0xf6, 0xfd, 0x5a, 0x84, 0xab, 0xbe, 0xff, 0x3a, 0x78, 0xdb, 0xae, 0x99,
+	0xdc, 0x71, 0x38, 0x0b, 0x9f, 0xb7, 0x6b, 0x49, 0xaa, 0xdd, 0xb0, 0x98, 0x44, 0x8f, 0x51, 0x3b,
+	0x5a, 0x1f, 0xb8, 0x1e, 0x7f, 0xfc, 0x2b, 0x5a, 0xb4, 0x16, 0x54, 0xd2, 0xa4, 0x45, 0x67, 0x06,
+	0x87, 0x97, 0xb6, 0xcd, 0xcd, 0x4f, 0x96, 0x83, 0x42, 0x52, 0xc7, 0x35, 0xf0, 0x6e, 0x8d, 0x42,
+	0x92, 0x77, 0xf0, 0x7c, 0x4e, 0x05, 0x1e, 0x57, 0x4e, 0x2a, 0xdd, 0x83, 0x8b, 0x57, 0xfd, 0xd4,
+	0x51, 0x22, 0xff, 0x89, 0x58, 0x5e, 0x51, 0x81, 0x46, 0x40, 0x92, 0x97, 0xf0, 0x85, 0xc9, 0xd7,
+	0x4c, 0x1e, 0x3f, 0x3b, 0xa9, 0x74, 0xeb, 0x46, 0xb8, 0xe8, 0xfc, 0x5d, 0x81, 0xa3, 0xac, 0x83,
+	0x70, 0x39, 0x13, 0x48, 0xde, 0xc3, 0x0b, 0x21, 0xa9, 0x5c, 0x8b, 0xc8, 0xe4, 0x9b, 0x5c, 0x93,
+	0x69, 0x80, 0x18, 0x11, 0x4a, 0x5e, 0xc1, 0xbe, 0x8c, 0x95, 0x8e, 0xab, 0x27, 0x95, 0xee, 0x73,
+	0x63, 0xf3, 0xa0, 0xe0, 0x0c, 0xbf, 0x42, 0x23, 0x38, 0xc2, 0x78, 0xf4, 0x3f, 0xdc, 0xae, 0x9a,
+	0x54, 0xb6, 0xa1, 0xa9, 0x94, 0xff, 0xcb, 0xad, 0x1a, 0x50, 0x1d, 0x8f, 0x02, 0xe9, 0x67, 0x46,
+	0x75, 0x3c, 0xca, 0xbf, 0xc7, 0xc5, 0x3f, 0x47, 0xb0, 0x6f, 0x70, 0x2e, 0x87, 0x7e, 0x02, 0x89,
+	0x0b, 0xe4, 0x1a, 0xe5, 0x90, 0x3b, 0x2e, 0x67, 0xc8, 0xa4, 0xaf, 0x88, 0x82, 0xbc, 0x4b, 0xdb,
+	0xa9, 0x6a, 0xd8, 0x46, 0xa3, 0x58, 0xb4, 0x4f, 0x0b, 0x76, 0x64, 0xf0, 0xce, 0x1e, 0x71, 0x02,
+	0x47, 0x3f, 0x91, 0x9f, 0x2c, 0xf3, 0x76, 0xb8, 0xa2, 0x8c, 0xa1, 0x5d, 0xe6, 0x98, 0x41, 0x63,
+	0xc7, 0xef, 0xd2, 0x3b, 0xa2, 0xc5, 0x54, 0x7a, 0x16, 0x5b, 0xc6, 0x71, 0xec, 0xec, 0x91, 0x3b,
+	0x78, 0x79, 0x8d, 0x81, 0xbb, 0x25, 0xa4, 0x65, 0x8a, 0xd8, 0xf0, 0xa2, 0xd8, 0x70, 0x0b, 0xde,
+	0xd1, 0x72, 0x06, 0xad, 0xa1, 0x87, 0x54, 0xe2, 0x90, 0xdb, 0x36, 0x9a, 0xd2, 0xe2, 0x8c, 0x9c,
+	0xe7, 0x6e, 0xcd, 0x62, 0xb1, 0x51, 0x59, 0xba, 0x3b, 0x7b, 0xe4, 0x0f, 0x68, 0x8c, 0x3c, 0xee,
+	0x26, 0xe4, 0xdf, 0xe4, 0xca, 0xa7, 0x21, 0x4d, 0xf1, 0x19, 0xd4, 0x3f, 0x50, 0x91, 0xd0, 0xee,
+	0xe5, 0x6a, 0xa7, 0x98, 0x58, 0xfa, 0xdb, 0x5c, 0xf4, 0x8a, 0x73, 0x3b, 0x11, 0x9e, 0x07, 0x20,
+	0x23, 0x14, 0xa6, 0x67, 0xcd, 0x93, 0x01, 0xea, 0xe7, 0xdf, 0x60, 0x0b, 0x8c, 0xad, 0x06, 0xda,
+	0x7c, 0x32, 0x2f, 0x06, 0x32, 0xea, 0x3c, 0x9d, 0x97, 0x2c, 0xa6, 0x19, 0x3a, 0x0a, 0x5f, 0x19,
+	0x68, 0xf2, 0x7b, 0xf4, 0x12, 0x0e, 0x6f, 0x0b, 0x1c, 0x32, 0x9c, 0xa6, 0xc5, 0x67, 0x38, 0x08,
+	0x8b, 0xe6, 0xd2, 0xb6, 0xa8, 0x20, 0xaf, 0x4b, 0xca, 0x2a, 0x20, 0x34, 0x65, 0x3f, 0xc2, 0xbe,
+	0x5f, 0x2c, 0xa1, 0xe8, 0xf7, 0x85, 0xc5, 0xb4, 0x8b, 0xe4, 0x14, 0xe0, 0xd2, 0x96, 0xe8, 0x85,
+	0x9a, 0xa7, 0xb9, 0x9a, 0x1b, 0x40, 0x53, 0x94, 0x41, 0x73, 0xba, 0xe2, 0x0f, 0x9b, 0xb0, 0x09,
+	0x72, 0x96, 0xff, 0x51, 0xa6, 0xa9, 0x58, 0xfe, 0x5c, 0x0f, 0x56, 0x25, 0x73, 0x03, 0xcd, 0x30,
+	0x98, 0xbf, 0x50, 0x4f, 0x5a, 0x41, 0x3e, 0xcf, 0x4a, 0x42, 0xae, 0x28, 0xed, 0x6f, 0xad, 0x15,
+	0x15, 0xc2, 0x46, 0xff, 0xbc, 0xac, 0x5e, 0x76, 0x35, 0xf8, 0x0d, 0xea, 0x7e, 0xde, 0x36, 0xea,
+	0xbd, 0xc2, 0xdc, 0xee, 0x2a, 0x7d, 0x03, 0xb5, 0x0f, 0x54, 0x6c, 0x94, 0xbb, 0x45, 0x6d, 0x62,
+	0x4b, 0x58, 0xab, 0x4b, 0xdc, 0x42, 0xc3, 0x4f, 0x8b, 0xda, 0x2c, 0x0a, 0x7a, 0x5c, 0x1a, 0x8a,
+	0x2d, 0xce, 0xb4, 0x58, 0x65, 0xc6, 0xa0, 0x19, 0x77, 0x8e, 0x29, 0x2e, 0x1d, 0x64, 0xb2, 0x20,
+	0xcd, 0x19, 0xaa, 0xbc, 0xac, 0xb6, 0x60, 0xe5, 0x87, 0x50, 0xf3, 0xcf, 0x12, 0xbd, 0x10, 0x05,
+	0xb1, 0x4b, 0x22, 0xb1, 0x53, 0x4f, 0x83, 0x54, 0x36, 0xaa, 0x59, 0x8c, 0xd9, 0x02, 0x1f, 0x4b,
+	0x9b, 0x45, 0x40, 0x68, 0x66, 0x7e, 0x05, 0xf5, 0xf8, 0x6a, 0xa1, 0x70, 0xaf, 0xf4, 0xfa, 0x29,
+	0xe9, 0x37, 0x3a, 0xa8, 0xba, 0x40, 0xd4, 0x96, 0x42, 0x97, 0xe2, 0xb6, 0xb4, 0xcb, 0xe1, 0xef,
+	0xa2, 0x31, 0x4e, 0x4d, 0x92, 0xd9, 0x06, 0xad, 0x26, 0xe4, 0x7e, 0xee, 0x4c, 0xdb, 0xee, 0xeb,
+	0xe2, 0xea, 0x16, 0x7f, 0xc2, 0x97, 0xd1, 0x7c, 0x47, 0x4e, 0x4b, 0x37, 0xab, 0xd1, 0xb2, 0xfd,
+	0xfa, 0x49, 0x4e, 0xa9, 0x53, 0x38, 0xfc, 0xec, 0x2e, 0xfc, 0x31, 0x22, 0x1c, 0x56, 0xe2, 0x71,
+	0x89, 0xf4, 0x0a, 0x26, 0x9c, 0x0c, 0x37, 0x11, 0xcb, 0xa7, 0x62, 0x66, 0xc3, 0xd7, 0x06, 0xda,
+	0x48, 0x05, 0x8e, 0x3e, 0xfe, 0x3c, 0x41, 0x21, 0xe8, 0x12, 0xa7, 0xd2, 0x43, 0xea, 0x64, 0xc7,
+	0xa8, 0xf0, 0x77, 0x42, 0x01, 0xac, 0x99, 0x21, 0x13, 0x0e, 0xa3, 0x5a, 0xfe, 0xc9, 0x5e, 0x8b,
+	0x95, 0x3f, 0x41, 0xda, 0x28, 0x71, 0x91, 0xfd, 0x24, 0xfd, 0x9f, 0x21, 0xfd, 0x5c, 0x52, 0xe3,
+	0x4a, 0x33, 0x80, 0x6b, 0x94, 0x13, 0x94, 0x9e, 0x65, 0x16, 0xfd, 0x77, 0xda, 0x00, 0x05, 0x69,
+	0xc9, 0xe1, 0xe2, 0xb4, 0x5c, 0xfd, 0xf8, 0xfb, 0x0f, 0x4b, 0x4b, 0xae, 0xd6, 0x73, 0xdf, 0x7a,
+	0x10, 0x92, 0x6f, 0x2d, 0x1e, 0xfd, 0x35, 0x88, 0xb3, 0x31, 0x08, 0x94, 0x06, 0x2a, 0xc1, 0xee,
+	0x7c, 0xfe, 0x22, 0x78, 0xf4, 0xfe, 0xdf, 0x01, 0x00, 0xcb, 0x53, 0x72, 0x15, 0xcb, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -341,6 +344,20 @@ type RootCoordClient interface {
 	//
 	// @return CollectionSchema
 	DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
+	//*
+	// @brief This method is used to rename a collection.
+	//
+	// @param RenameCollectionRequest, the current and the new collection name.
+	//
+	// @return Status
+	RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to recover a dropped collection within the retention window.
+	//
+	// @param RecoverCollectionRequest, the name of the dropped collection.
+	//
+	// @return Status
+	RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -355,6 +372,11 @@ type RootCoordClient interface {
 	// @return Status
 	CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	//*
+	// @brief This method is used to recover a dropped partition within the retention window
+	//
+	// @return Status
+	RecoverPartition(ctx context.Context, in *milvuspb.RecoverPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
 	// @brief This method is used to drop partition
 	//
 	// @return Status
@@ -456,6 +478,24 @@ func (c *rootCoordClient) DescribeCollection(ctx context.Context, in *milvuspb.D
 	return out, nil
 }
 
+func (c *rootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RecoverCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateAlias", in, out, opts...)
@@ -501,6 +541,15 @@ func (c *rootCoordClient) CreatePartition(ctx context.Context, in *milvuspb.Crea
 	return out, nil
 }
 
+func (c *rootCoordClient) RecoverPartition(ctx context.Context, in *milvuspb.RecoverPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RecoverPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropPartition", in, out, opts...)
@@ -660,6 +709,20 @@ type RootCoordServer interface {
 	//
 	// @return CollectionSchema
 	DescribeCollection(context.Context, *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	//*
+	// @brief This method is used to rename a collection.
+	//
+	// @param RenameCollectionRequest, the current and the new collection name.
+	//
+	// @return Status
+	RenameCollection(context.Context, *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to recover a dropped collection within the retention window.
+	//
+	// @param RecoverCollectionRequest, the name of the dropped collection.
+	//
+	// @return Status
+	RecoverCollection(context.Context, *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error)
 	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
@@ -674,6 +737,11 @@ type RootCoordServer interface {
 	// @return Status
 	CreatePartition(context.Context, *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
 	//*
+	// @brief This method is used to recover a dropped partition within the retention window
+	//
+	// @return Status
+	RecoverPartition(context.Context, *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error)
+	//*
 	// @brief This method is used to drop partition
 	//
 	// @return Status
@@ -729,6 +797,12 @@ func (*UnimplementedRootCoordServer) HasCollection(ctx context.Context, req *mil
 func (*UnimplementedRootCoordServer) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCollection not implemented")
 }
+func (*UnimplementedRootCoordServer) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) RecoverCollection(ctx context.Context, req *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverCollection not implemented")
+}
 func (*UnimplementedRootCoordServer) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAlias not implemented")
 }
@@ -744,6 +818,9 @@ func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *m
 func (*UnimplementedRootCoordServer) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
+func (*UnimplementedRootCoordServer) RecoverPartition(ctx context.Context, req *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverPartition not implemented")
+}
 func (*UnimplementedRootCoordServer) DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropPartition not implemented")
 }
@@ -917,6 +994,42 @@ func _RootCoord_DescribeCollection_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RenameCollection(ctx, req.(*milvuspb.RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RecoverCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RecoverCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RecoverCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RecoverCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RecoverCollection(ctx, req.(*milvuspb.RecoverCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.CreateAliasRequest)
 	if err := dec(in); err != nil {
@@ -1007,6 +1120,24 @@ func _RootCoord_CreatePartition_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_RecoverPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RecoverPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RecoverPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RecoverPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RecoverPartition(ctx, req.(*milvuspb.RecoverPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.DropPartitionRequest)
 	if err := dec(in); err != nil {
@@ -1291,6 +1422,14 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeCollection",
 			Handler:    _RootCoord_DescribeCollection_Handler,
 		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _RootCoord_RenameCollection_Handler,
+		},
+		{
+			MethodName: "RecoverCollection",
+			Handler:    _RootCoord_RecoverCollection_Handler,
+		},
 		{
 			MethodName: "CreateAlias",
 			Handler:    _RootCoord_CreateAlias_Handler,
@@ -1311,6 +1450,10 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePartition",
 			Handler:    _RootCoord_CreatePartition_Handler,
 		},
+		{
+			MethodName: "RecoverPartition",
+			Handler:    _RootCoord_RecoverPartition_Handler,
+		},
 		{
 			MethodName: "DropPartition",
 			Handler:    _RootCoord_DropPartition_Handler,
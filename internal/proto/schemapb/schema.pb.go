@@ -26,17 +26,20 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type DataType int32
 
 const (
-	DataType_None         DataType = 0
-	DataType_Bool         DataType = 1
-	DataType_Int8         DataType = 2
-	DataType_Int16        DataType = 3
-	DataType_Int32        DataType = 4
-	DataType_Int64        DataType = 5
-	DataType_Float        DataType = 10
-	DataType_Double       DataType = 11
-	DataType_String       DataType = 20
-	DataType_BinaryVector DataType = 100
-	DataType_FloatVector  DataType = 101
+	DataType_None              DataType = 0
+	DataType_Bool              DataType = 1
+	DataType_Int8              DataType = 2
+	DataType_Int16             DataType = 3
+	DataType_Int32             DataType = 4
+	DataType_Int64             DataType = 5
+	DataType_Float             DataType = 10
+	DataType_Double            DataType = 11
+	DataType_String            DataType = 20
+	DataType_BinaryVector      DataType = 100
+	DataType_FloatVector       DataType = 101
+	DataType_Float16Vector     DataType = 102
+	DataType_BFloat16Vector    DataType = 103
+	DataType_SparseFloatVector DataType = 104
 )
 
 var DataType_name = map[int32]string{
@@ -51,20 +54,26 @@ var DataType_name = map[int32]string{
 	20:  "String",
 	100: "BinaryVector",
 	101: "FloatVector",
+	102: "Float16Vector",
+	103: "BFloat16Vector",
+	104: "SparseFloatVector",
 }
 
 var DataType_value = map[string]int32{
-	"None":         0,
-	"Bool":         1,
-	"Int8":         2,
-	"Int16":        3,
-	"Int32":        4,
-	"Int64":        5,
-	"Float":        10,
-	"Double":       11,
-	"String":       20,
-	"BinaryVector": 100,
-	"FloatVector":  101,
+	"None":              0,
+	"Bool":              1,
+	"Int8":              2,
+	"Int16":             3,
+	"Int32":             4,
+	"Int64":             5,
+	"Float":             10,
+	"Double":            11,
+	"String":            20,
+	"BinaryVector":      100,
+	"FloatVector":       101,
+	"Float16Vector":     102,
+	"BFloat16Vector":    103,
+	"SparseFloatVector": 104,
 }
 
 func (x DataType) String() string {
@@ -86,6 +95,8 @@ type FieldSchema struct {
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	IsClusteringKey      bool                     `protobuf:"varint,9,opt,name=is_clustering_key,json=isClusteringKey,proto3" json:"is_clustering_key,omitempty"`
+	IsPartitionKey       bool                     `protobuf:"varint,10,opt,name=is_partition_key,json=isPartitionKey,proto3" json:"is_partition_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -172,7 +183,21 @@ func (m *FieldSchema) GetAutoID() bool {
 	return false
 }
 
-//*
+func (m *FieldSchema) GetIsClusteringKey() bool {
+	if m != nil {
+		return m.IsClusteringKey
+	}
+	return false
+}
+
+func (m *FieldSchema) GetIsPartitionKey() bool {
+	if m != nil {
+		return m.IsPartitionKey
+	}
+	return false
+}
+
+// *
 // @brief Collection schema
 type CollectionSchema struct {
 	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -666,11 +691,64 @@ func (*ScalarField) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Every content is a sparse vector row, encoded as little-endian
+// (uint32 index, float32 value) pairs sorted by index in ascending order.
+type SparseFloatArray struct {
+	Contents [][]byte `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	// dim is the max dimension of all rows, which is (max index + 1)
+	Dim                  int64    `protobuf:"varint,2,opt,name=dim,proto3" json:"dim,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SparseFloatArray) Reset()         { *m = SparseFloatArray{} }
+func (m *SparseFloatArray) String() string { return proto.CompactTextString(m) }
+func (*SparseFloatArray) ProtoMessage()    {}
+func (*SparseFloatArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+}
+
+func (m *SparseFloatArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SparseFloatArray.Unmarshal(m, b)
+}
+func (m *SparseFloatArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SparseFloatArray.Marshal(b, m, deterministic)
+}
+func (m *SparseFloatArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SparseFloatArray.Merge(m, src)
+}
+func (m *SparseFloatArray) XXX_Size() int {
+	return xxx_messageInfo_SparseFloatArray.Size(m)
+}
+func (m *SparseFloatArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_SparseFloatArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SparseFloatArray proto.InternalMessageInfo
+
+func (m *SparseFloatArray) GetContents() [][]byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *SparseFloatArray) GetDim() int64 {
+	if m != nil {
+		return m.Dim
+	}
+	return 0
+}
+
 type VectorField struct {
 	Dim int64 `protobuf:"varint,1,opt,name=dim,proto3" json:"dim,omitempty"`
 	// Types that are valid to be assigned to Data:
 	//	*VectorField_FloatVector
 	//	*VectorField_BinaryVector
+	//	*VectorField_SparseFloatVector
+	//	*VectorField_Float16Vector
+	//	*VectorField_Bfloat16Vector
 	Data                 isVectorField_Data `protobuf_oneof:"data"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -681,7 +759,7 @@ func (m *VectorField) Reset()         { *m = VectorField{} }
 func (m *VectorField) String() string { return proto.CompactTextString(m) }
 func (*VectorField) ProtoMessage()    {}
 func (*VectorField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
 }
 
 func (m *VectorField) XXX_Unmarshal(b []byte) error {
@@ -721,10 +799,28 @@ type VectorField_BinaryVector struct {
 	BinaryVector []byte `protobuf:"bytes,3,opt,name=binary_vector,json=binaryVector,proto3,oneof"`
 }
 
+type VectorField_SparseFloatVector struct {
+	SparseFloatVector *SparseFloatArray `protobuf:"bytes,4,opt,name=sparse_float_vector,json=sparseFloatVector,proto3,oneof"`
+}
+
+type VectorField_Float16Vector struct {
+	Float16Vector []byte `protobuf:"bytes,5,opt,name=float16_vector,json=float16Vector,proto3,oneof"`
+}
+
+type VectorField_Bfloat16Vector struct {
+	Bfloat16Vector []byte `protobuf:"bytes,6,opt,name=bfloat16_vector,json=bfloat16Vector,proto3,oneof"`
+}
+
 func (*VectorField_FloatVector) isVectorField_Data() {}
 
 func (*VectorField_BinaryVector) isVectorField_Data() {}
 
+func (*VectorField_SparseFloatVector) isVectorField_Data() {}
+
+func (*VectorField_Float16Vector) isVectorField_Data() {}
+
+func (*VectorField_Bfloat16Vector) isVectorField_Data() {}
+
 func (m *VectorField) GetData() isVectorField_Data {
 	if m != nil {
 		return m.Data
@@ -746,11 +842,35 @@ func (m *VectorField) GetBinaryVector() []byte {
 	return nil
 }
 
+func (m *VectorField) GetSparseFloatVector() *SparseFloatArray {
+	if x, ok := m.GetData().(*VectorField_SparseFloatVector); ok {
+		return x.SparseFloatVector
+	}
+	return nil
+}
+
+func (m *VectorField) GetFloat16Vector() []byte {
+	if x, ok := m.GetData().(*VectorField_Float16Vector); ok {
+		return x.Float16Vector
+	}
+	return nil
+}
+
+func (m *VectorField) GetBfloat16Vector() []byte {
+	if x, ok := m.GetData().(*VectorField_Bfloat16Vector); ok {
+		return x.Bfloat16Vector
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*VectorField) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*VectorField_FloatVector)(nil),
 		(*VectorField_BinaryVector)(nil),
+		(*VectorField_SparseFloatVector)(nil),
+		(*VectorField_Float16Vector)(nil),
+		(*VectorField_Bfloat16Vector)(nil),
 	}
 }
 
@@ -771,7 +891,7 @@ func (m *FieldData) Reset()         { *m = FieldData{} }
 func (m *FieldData) String() string { return proto.CompactTextString(m) }
 func (*FieldData) ProtoMessage()    {}
 func (*FieldData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
 }
 
 func (m *FieldData) XXX_Unmarshal(b []byte) error {
@@ -872,7 +992,7 @@ func (m *IDs) Reset()         { *m = IDs{} }
 func (m *IDs) String() string { return proto.CompactTextString(m) }
 func (*IDs) ProtoMessage()    {}
 func (*IDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
 }
 
 func (m *IDs) XXX_Unmarshal(b []byte) error {
@@ -954,7 +1074,7 @@ func (m *SearchResultData) Reset()         { *m = SearchResultData{} }
 func (m *SearchResultData) String() string { return proto.CompactTextString(m) }
 func (*SearchResultData) ProtoMessage()    {}
 func (*SearchResultData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *SearchResultData) XXX_Unmarshal(b []byte) error {
@@ -1029,6 +1149,7 @@ func init() {
 	proto.RegisterType((*BytesArray)(nil), "milvus.proto.schema.BytesArray")
 	proto.RegisterType((*StringArray)(nil), "milvus.proto.schema.StringArray")
 	proto.RegisterType((*ScalarField)(nil), "milvus.proto.schema.ScalarField")
+	proto.RegisterType((*SparseFloatArray)(nil), "milvus.proto.schema.SparseFloatArray")
 	proto.RegisterType((*VectorField)(nil), "milvus.proto.schema.VectorField")
 	proto.RegisterType((*FieldData)(nil), "milvus.proto.schema.FieldData")
 	proto.RegisterType((*IDs)(nil), "milvus.proto.schema.IDs")
@@ -1038,66 +1159,75 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0xe3, 0xfc, 0xd8, 0xc7, 0xa1, 0x58, 0xd3, 0x0a, 0x19, 0xa4, 0x76, 0xd3, 0x08, 0xa4,
-	0xa8, 0x12, 0xbb, 0xea, 0x2e, 0x94, 0x52, 0x51, 0x01, 0x69, 0xb4, 0x4a, 0xb4, 0xa8, 0x5a, 0xbc,
-	0xa8, 0x17, 0xdc, 0x44, 0x93, 0x78, 0xba, 0x3b, 0x5a, 0x7b, 0x26, 0x78, 0x26, 0x15, 0x79, 0x00,
-	0xae, 0xb9, 0xe1, 0x8a, 0x77, 0xe3, 0x02, 0xf1, 0x1c, 0x48, 0x68, 0xce, 0x4c, 0x12, 0xd3, 0x78,
-	0x57, 0x7b, 0x77, 0x66, 0x7c, 0xbe, 0x6f, 0xce, 0xf9, 0xce, 0x8f, 0xa1, 0xa7, 0x16, 0x57, 0xac,
-	0xa0, 0x87, 0xcb, 0x52, 0x6a, 0x49, 0xee, 0x17, 0x3c, 0x7f, 0xb7, 0x52, 0xf6, 0x74, 0x68, 0x3f,
-	0x7d, 0xd2, 0x5b, 0xc8, 0xa2, 0x90, 0xc2, 0x5e, 0x0e, 0xfe, 0x6e, 0x42, 0x74, 0xca, 0x59, 0x9e,
-	0x5d, 0xe0, 0x57, 0x92, 0x40, 0xf7, 0xad, 0x39, 0x4e, 0xc7, 0x89, 0xd7, 0xf7, 0x86, 0x7e, 0xba,
-	0x39, 0x12, 0x02, 0x2d, 0x41, 0x0b, 0x96, 0x34, 0xfb, 0xde, 0x30, 0x4c, 0xd1, 0x26, 0x9f, 0xc2,
-	0x3d, 0xae, 0x66, 0xcb, 0x92, 0x17, 0xb4, 0x5c, 0xcf, 0xae, 0xd9, 0x3a, 0xf1, 0xfb, 0xde, 0x30,
-	0x48, 0x7b, 0x5c, 0x9d, 0xdb, 0xcb, 0x33, 0xb6, 0x26, 0x7d, 0x88, 0x32, 0xa6, 0x16, 0x25, 0x5f,
-	0x6a, 0x2e, 0x45, 0xd2, 0x42, 0x82, 0xea, 0x15, 0x79, 0x01, 0x61, 0x46, 0x35, 0x9d, 0xe9, 0xf5,
-	0x92, 0x25, 0xed, 0xbe, 0x37, 0xbc, 0x77, 0xfc, 0xf0, 0xb0, 0x26, 0xf8, 0xc3, 0x31, 0xd5, 0xf4,
-	0xa7, 0xf5, 0x92, 0xa5, 0x41, 0xe6, 0x2c, 0x32, 0x82, 0xc8, 0xc0, 0x66, 0x4b, 0x5a, 0xd2, 0x42,
-	0x25, 0x9d, 0xbe, 0x3f, 0x8c, 0x8e, 0x1f, 0xff, 0x1f, 0xed, 0x52, 0x3e, 0x63, 0xeb, 0x37, 0x34,
-	0x5f, 0xb1, 0x73, 0xca, 0xcb, 0x14, 0x0c, 0xea, 0x1c, 0x41, 0x64, 0x0c, 0x3d, 0x2e, 0x32, 0xf6,
-	0xeb, 0x86, 0xa4, 0x7b, 0x57, 0x92, 0x08, 0x61, 0x8e, 0xe5, 0x23, 0xe8, 0xd0, 0x95, 0x96, 0xd3,
-	0x71, 0x12, 0xa0, 0x0a, 0xee, 0x34, 0xf8, 0xd3, 0x83, 0xf8, 0x95, 0xcc, 0x73, 0xb6, 0x30, 0xc9,
-	0x3a, 0xa1, 0x37, 0x72, 0x7a, 0x15, 0x39, 0xdf, 0x13, 0xaa, 0xb9, 0x2f, 0xd4, 0xee, 0x09, 0xbf,
-	0xfa, 0x04, 0x79, 0x0e, 0x1d, 0xac, 0x93, 0x4a, 0x5a, 0x18, 0x7a, 0xbf, 0x56, 0xbd, 0x4a, 0xa1,
-	0x53, 0xe7, 0x3f, 0x38, 0x80, 0x70, 0x24, 0x65, 0xfe, 0x7d, 0x59, 0xd2, 0xb5, 0x09, 0xca, 0xe8,
-	0x9a, 0x78, 0x7d, 0x7f, 0x18, 0xa4, 0x68, 0x0f, 0x1e, 0x41, 0x30, 0x15, 0x7a, 0xff, 0x7b, 0xdb,
-	0x7d, 0x3f, 0x80, 0xf0, 0x07, 0x29, 0x2e, 0xf7, 0x1d, 0x7c, 0xe7, 0xd0, 0x07, 0x38, 0xcd, 0x25,
-	0xad, 0xa1, 0x68, 0x3a, 0x8f, 0xc7, 0x10, 0x8d, 0xe5, 0x6a, 0x9e, 0xb3, 0x7d, 0x17, 0x6f, 0x47,
-	0x32, 0x5a, 0x6b, 0xa6, 0xf6, 0x3d, 0x7a, 0x3b, 0x92, 0x0b, 0x5d, 0xf2, 0xba, 0x48, 0x42, 0xe7,
-	0xf2, 0x97, 0x0f, 0xd1, 0xc5, 0x82, 0xe6, 0xb4, 0x44, 0x25, 0xc8, 0x4b, 0x08, 0xe7, 0x52, 0xe6,
-	0x33, 0xe7, 0xe8, 0x0d, 0xa3, 0xe3, 0x47, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0xd2, 0xc0, 0x40,
-	0x4c, 0x1f, 0x92, 0x17, 0x10, 0x70, 0xa1, 0x2d, 0xba, 0x89, 0xe8, 0xfa, 0xa6, 0xdd, 0xc8, 0x37,
-	0x69, 0xa4, 0x5d, 0x2e, 0x34, 0x62, 0x5f, 0x42, 0x98, 0x4b, 0x71, 0x69, 0xc1, 0xfe, 0x2d, 0x4f,
-	0x6f, 0xb5, 0x35, 0x4f, 0x1b, 0x08, 0xc2, 0xbf, 0x03, 0x78, 0x6b, 0x34, 0xb5, 0xf8, 0x16, 0xe2,
-	0x0f, 0xea, 0x6b, 0xbe, 0x95, 0x7e, 0xd2, 0x48, 0x43, 0x04, 0x21, 0xc3, 0x2b, 0x88, 0x32, 0xd4,
-	0xdc, 0x52, 0xb4, 0x91, 0xa2, 0xbe, 0x6d, 0x2a, 0xb5, 0x99, 0x34, 0x52, 0xb0, 0xb0, 0x0d, 0x89,
-	0x42, 0xcd, 0x2d, 0x49, 0xe7, 0x16, 0x92, 0x4a, 0x6d, 0x0c, 0x89, 0x85, 0x6d, 0x72, 0x99, 0x9b,
-	0xd2, 0x5a, 0x8e, 0xee, 0x2d, 0xb9, 0xec, 0x3a, 0xc0, 0xe4, 0x82, 0x20, 0xc3, 0x30, 0xea, 0xd8,
-	0x5a, 0x0f, 0xfe, 0xf0, 0x20, 0x7a, 0xc3, 0x16, 0x5a, 0xba, 0xfa, 0xc6, 0xe0, 0x67, 0xbc, 0x70,
-	0x8b, 0xcc, 0x98, 0x66, 0xd0, 0xad, 0x6e, 0xef, 0xd0, 0xcd, 0x95, 0xed, 0x0e, 0xca, 0x45, 0x08,
-	0xb3, 0xe4, 0xe4, 0x33, 0xf8, 0x60, 0xce, 0x85, 0x59, 0x79, 0x8e, 0xc6, 0x14, 0xb0, 0x37, 0x69,
-	0xa4, 0x3d, 0x7b, 0x6d, 0xdd, 0xb6, 0x61, 0xfd, 0xeb, 0x41, 0x88, 0x01, 0x61, 0xba, 0x4f, 0xa1,
-	0x85, 0x6b, 0xce, 0xbb, 0xcb, 0x9a, 0x43, 0x57, 0xf2, 0x10, 0x00, 0xa7, 0x75, 0x56, 0x59, 0xc0,
-	0x21, 0xde, 0xbc, 0x36, 0x6b, 0xe3, 0x1b, 0xe8, 0x2a, 0xec, 0x6a, 0xe5, 0x3a, 0xe9, 0x86, 0x0a,
-	0xec, 0x3a, 0xdf, 0x74, 0xa2, 0x83, 0x18, 0xb4, 0xcd, 0x42, 0xb9, 0x3e, 0xaa, 0x47, 0x57, 0x74,
-	0x35, 0x68, 0x07, 0x21, 0x1f, 0x43, 0x60, 0x43, 0xe3, 0x19, 0xf6, 0xd0, 0xf6, 0x87, 0x91, 0x8d,
-	0xba, 0xd0, 0x46, 0x73, 0xf0, 0x9b, 0x07, 0xfe, 0x74, 0xac, 0xc8, 0x57, 0xd0, 0x31, 0xf3, 0xc2,
-	0xb3, 0x5b, 0x67, 0xad, 0xda, 0xf0, 0x6d, 0x2e, 0xf4, 0x34, 0x23, 0x5f, 0x43, 0x47, 0xe9, 0xd2,
-	0x00, 0x9b, 0x77, 0xee, 0xb0, 0xb6, 0xd2, 0xe5, 0x34, 0x1b, 0x01, 0x04, 0x3c, 0x9b, 0xd9, 0x38,
-	0xfe, 0xf1, 0x20, 0xbe, 0x60, 0xb4, 0x5c, 0x5c, 0xa5, 0x4c, 0xad, 0x72, 0x3b, 0x07, 0x07, 0x10,
-	0x89, 0x55, 0x31, 0xfb, 0x65, 0xc5, 0x4a, 0xce, 0x94, 0xeb, 0x15, 0x10, 0xab, 0xe2, 0x47, 0x7b,
-	0x43, 0xee, 0x43, 0x5b, 0xcb, 0xe5, 0xec, 0x1a, 0xdf, 0xf6, 0xd3, 0x96, 0x96, 0xcb, 0x33, 0xf2,
-	0x2d, 0x44, 0x76, 0x7f, 0x6e, 0x06, 0xd8, 0xbf, 0x31, 0x9f, 0x6d, 0xe5, 0x53, 0x5b, 0x44, 0x6c,
-	0x59, 0xb3, 0xc8, 0xd5, 0x42, 0x96, 0xcc, 0x2e, 0xec, 0x66, 0xea, 0x4e, 0xe4, 0x09, 0xf8, 0x3c,
-	0x53, 0x6e, 0x1c, 0x93, 0xfa, 0x75, 0x32, 0x56, 0xa9, 0x71, 0x22, 0x0f, 0x30, 0xb2, 0x6b, 0xfb,
-	0xcf, 0xf3, 0x53, 0x7b, 0x78, 0xf2, 0xbb, 0x07, 0xc1, 0xa6, 0x7f, 0x48, 0x00, 0xad, 0xd7, 0x52,
-	0xb0, 0xb8, 0x61, 0x2c, 0xb3, 0xc5, 0x62, 0xcf, 0x58, 0x53, 0xa1, 0x9f, 0xc7, 0x4d, 0x12, 0x42,
-	0x7b, 0x2a, 0xf4, 0xd3, 0x67, 0xb1, 0xef, 0xcc, 0x93, 0xe3, 0xb8, 0xe5, 0xcc, 0x67, 0x5f, 0xc4,
-	0x6d, 0x63, 0xe2, 0x14, 0xc4, 0x40, 0x00, 0x3a, 0x76, 0x0f, 0xc4, 0x91, 0xb1, 0xad, 0xd8, 0xf1,
-	0x03, 0x12, 0x43, 0x6f, 0x54, 0x69, 0xfa, 0x38, 0x23, 0x1f, 0x42, 0x74, 0xba, 0x1b, 0x96, 0x98,
-	0x8d, 0xbe, 0xfc, 0xf9, 0xe4, 0x92, 0xeb, 0xab, 0xd5, 0xdc, 0xfc, 0x42, 0x8f, 0x6c, 0x4a, 0x9f,
-	0x73, 0xe9, 0xac, 0x23, 0x2e, 0x34, 0x2b, 0x05, 0xcd, 0x8f, 0x30, 0xcb, 0x23, 0x9b, 0xe5, 0x72,
-	0x3e, 0xef, 0xe0, 0xf9, 0xe4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3c, 0xbb, 0x94, 0xd4,
-	0x08, 0x00, 0x00,
+	// 1111 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0xe3, 0x38, 0xb1, 0x8f, 0xb3, 0xa9, 0x3b, 0x5d, 0x90, 0x59, 0x69, 0xb7, 0xd9, 0x88,
+	0x15, 0xa1, 0x12, 0xad, 0xda, 0x42, 0x59, 0x56, 0xac, 0x58, 0xd2, 0xa8, 0x4a, 0x54, 0xb4, 0x2a,
+	0x0e, 0x5a, 0x24, 0x6e, 0x2c, 0x27, 0x9e, 0xb6, 0xa3, 0x3a, 0x33, 0xc1, 0x33, 0x59, 0x91, 0x07,
+	0xe0, 0x25, 0x78, 0x11, 0xae, 0x79, 0x00, 0x1e, 0x81, 0x2b, 0x9e, 0x03, 0x09, 0xcd, 0x4f, 0x12,
+	0xa7, 0xc9, 0x46, 0xbd, 0x3b, 0x73, 0xfc, 0x7d, 0x5f, 0x66, 0xbe, 0x73, 0xce, 0x4c, 0xa0, 0xce,
+	0x47, 0xb7, 0x78, 0x9c, 0x1c, 0x4e, 0x72, 0x26, 0x18, 0xda, 0x1b, 0x93, 0xec, 0xfd, 0x94, 0xeb,
+	0xd5, 0xa1, 0xfe, 0xf4, 0xa4, 0x3e, 0x62, 0xe3, 0x31, 0xa3, 0x3a, 0xd9, 0xfa, 0xd3, 0x06, 0xff,
+	0x82, 0xe0, 0x2c, 0x1d, 0xa8, 0xaf, 0x28, 0x84, 0xda, 0xb5, 0x5c, 0xf6, 0xbb, 0xa1, 0xd5, 0xb4,
+	0xda, 0x76, 0x34, 0x5f, 0x22, 0x04, 0x15, 0x9a, 0x8c, 0x71, 0x58, 0x6e, 0x5a, 0x6d, 0x2f, 0x52,
+	0x31, 0xfa, 0x14, 0x1a, 0x84, 0xc7, 0x93, 0x9c, 0x8c, 0x93, 0x7c, 0x16, 0xdf, 0xe1, 0x59, 0x68,
+	0x37, 0xad, 0xb6, 0x1b, 0xd5, 0x09, 0xbf, 0xd2, 0xc9, 0x4b, 0x3c, 0x43, 0x4d, 0xf0, 0x53, 0xcc,
+	0x47, 0x39, 0x99, 0x08, 0xc2, 0x68, 0x58, 0x51, 0x02, 0xc5, 0x14, 0x7a, 0x05, 0x5e, 0x9a, 0x88,
+	0x24, 0x16, 0xb3, 0x09, 0x0e, 0x9d, 0xa6, 0xd5, 0x6e, 0x9c, 0x3c, 0x3d, 0xdc, 0xb0, 0xf9, 0xc3,
+	0x6e, 0x22, 0x92, 0x9f, 0x66, 0x13, 0x1c, 0xb9, 0xa9, 0x89, 0x50, 0x07, 0x7c, 0x49, 0x8b, 0x27,
+	0x49, 0x9e, 0x8c, 0x79, 0x58, 0x6d, 0xda, 0x6d, 0xff, 0xe4, 0xf9, 0x2a, 0xdb, 0x1c, 0xf9, 0x12,
+	0xcf, 0xde, 0x25, 0xd9, 0x14, 0x5f, 0x25, 0x24, 0x8f, 0x40, 0xb2, 0xae, 0x14, 0x09, 0x75, 0xa1,
+	0x4e, 0x68, 0x8a, 0x7f, 0x9b, 0x8b, 0xd4, 0x1e, 0x2a, 0xe2, 0x2b, 0x9a, 0x51, 0xf9, 0x18, 0xaa,
+	0xc9, 0x54, 0xb0, 0x7e, 0x37, 0x74, 0x95, 0x0b, 0x66, 0x85, 0x0e, 0x60, 0x97, 0xf0, 0x78, 0x94,
+	0x4d, 0xb9, 0xc0, 0x39, 0xa1, 0x37, 0xca, 0x28, 0x4f, 0x41, 0x76, 0x08, 0x3f, 0x5f, 0xe4, 0xa5,
+	0x57, 0x6d, 0x08, 0xa4, 0xa3, 0x49, 0x2e, 0x88, 0x74, 0x46, 0x41, 0x41, 0x41, 0x1b, 0x84, 0x5f,
+	0xcd, 0xd3, 0x97, 0x78, 0xd6, 0xfa, 0xc3, 0x82, 0xe0, 0x9c, 0x65, 0x19, 0x1e, 0xc9, 0x8c, 0x29,
+	0xdf, 0xbc, 0x48, 0x56, 0xa1, 0x48, 0xf7, 0xec, 0x2f, 0xaf, 0xdb, 0xbf, 0xdc, 0xb8, 0xbd, 0xb2,
+	0xf1, 0x97, 0x50, 0x55, 0xd5, 0xe7, 0x61, 0x45, 0x19, 0xd2, 0xdc, 0x58, 0x93, 0x42, 0xfb, 0x44,
+	0x06, 0xdf, 0xda, 0x07, 0xaf, 0xc3, 0x58, 0xf6, 0x7d, 0x9e, 0x27, 0x33, 0xb9, 0x29, 0x59, 0xad,
+	0xd0, 0x6a, 0xda, 0x6d, 0x37, 0x52, 0x71, 0xeb, 0x19, 0xb8, 0x7d, 0x2a, 0xd6, 0xbf, 0x3b, 0xe6,
+	0xfb, 0x3e, 0x78, 0x3f, 0x30, 0x7a, 0xb3, 0x0e, 0xb0, 0x0d, 0xa0, 0x09, 0x70, 0x91, 0xb1, 0x64,
+	0x83, 0x44, 0xd9, 0x20, 0x9e, 0x83, 0xdf, 0x65, 0xd3, 0x61, 0x86, 0xd7, 0x21, 0xd6, 0x52, 0xa4,
+	0x33, 0x13, 0x98, 0xaf, 0x23, 0xea, 0x4b, 0x91, 0x81, 0x90, 0xc5, 0x59, 0x87, 0x78, 0x06, 0xf2,
+	0x8f, 0x0d, 0xfe, 0x60, 0x94, 0x64, 0x49, 0xae, 0x9c, 0x40, 0xaf, 0xc1, 0x1b, 0x32, 0x96, 0xc5,
+	0x06, 0x68, 0xb5, 0xfd, 0x93, 0x67, 0x1b, 0x8d, 0x5b, 0x38, 0xd4, 0x2b, 0x45, 0xae, 0xa4, 0xc8,
+	0xee, 0x46, 0xaf, 0xc0, 0x25, 0x54, 0x68, 0x76, 0x59, 0xb1, 0x37, 0x8f, 0xc2, 0xdc, 0xbe, 0x5e,
+	0x29, 0xaa, 0x11, 0x2a, 0x14, 0xf7, 0x35, 0x78, 0x19, 0xa3, 0x37, 0x9a, 0x6c, 0x6f, 0xf9, 0xe9,
+	0x85, 0xb7, 0xf2, 0xa7, 0x25, 0x45, 0xd1, 0xdf, 0x00, 0x5c, 0x4b, 0x4f, 0x35, 0xbf, 0xa2, 0xf8,
+	0xfb, 0x9b, 0x6b, 0xbe, 0xb0, 0xbe, 0x57, 0x8a, 0x3c, 0x45, 0x52, 0x0a, 0xe7, 0xe0, 0xa7, 0xca,
+	0x73, 0x2d, 0xe1, 0x34, 0xad, 0x0f, 0xb6, 0x4d, 0xa1, 0x36, 0xbd, 0x52, 0x04, 0x9a, 0x36, 0x17,
+	0xe1, 0xca, 0x73, 0x2d, 0x52, 0xdd, 0x22, 0x52, 0xa8, 0x8d, 0x14, 0xd1, 0xb4, 0xf9, 0x59, 0x86,
+	0xb2, 0xb4, 0x5a, 0xa3, 0xb6, 0xe5, 0x2c, 0xcb, 0x0e, 0x90, 0x67, 0x51, 0x24, 0xa9, 0xd0, 0xa9,
+	0xea, 0x5a, 0xb7, 0xde, 0x40, 0x30, 0x98, 0x24, 0x39, 0xc7, 0x85, 0x7e, 0x7b, 0x02, 0xee, 0x88,
+	0x51, 0x81, 0xa9, 0xe0, 0xa6, 0x5d, 0x16, 0x6b, 0x14, 0x80, 0x9d, 0x92, 0xb1, 0xaa, 0x9d, 0x1d,
+	0xc9, 0xb0, 0xf5, 0x57, 0x19, 0xfc, 0x77, 0x78, 0x24, 0x98, 0xe9, 0x10, 0x83, 0xb0, 0x16, 0x08,
+	0x79, 0x01, 0x69, 0xe7, 0xdf, 0x2b, 0x58, 0x58, 0xde, 0xb2, 0xdf, 0x15, 0xef, 0x7d, 0x45, 0xd3,
+	0xe2, 0xe8, 0x05, 0x3c, 0x1a, 0x12, 0x2a, 0xaf, 0x62, 0x23, 0x23, 0x5b, 0xa0, 0xde, 0x2b, 0x45,
+	0x75, 0x9d, 0x36, 0xb0, 0x9f, 0x61, 0x8f, 0xab, 0x03, 0xc5, 0x2b, 0xbf, 0xa9, 0xeb, 0xfd, 0x62,
+	0xb3, 0xcf, 0xf7, 0x0c, 0xe8, 0x95, 0xa2, 0x5d, 0xbe, 0xcc, 0x19, 0xe1, 0xcf, 0xa0, 0xa1, 0x14,
+	0x8f, 0xcf, 0xe6, 0x9a, 0x8e, 0xd9, 0xc0, 0x23, 0x93, 0x37, 0xc0, 0xcf, 0x61, 0x67, 0x78, 0x0f,
+	0x59, 0x35, 0xc8, 0xc6, 0x70, 0x05, 0xba, 0xa8, 0xc2, 0x7f, 0x16, 0x78, 0xca, 0x3d, 0x55, 0xdd,
+	0x63, 0xa8, 0xa8, 0xb7, 0xc2, 0x7a, 0xc8, 0x5b, 0xa1, 0xa0, 0xe8, 0x29, 0x80, 0xba, 0x9c, 0xe2,
+	0xc2, 0x2b, 0xe6, 0xa9, 0xcc, 0x5b, 0x79, 0x4b, 0x7e, 0x0b, 0x35, 0xae, 0x86, 0x98, 0x87, 0xf6,
+	0xb6, 0x86, 0x5b, 0x0e, 0xba, 0x1c, 0x3c, 0x43, 0x91, 0x6c, 0x7d, 0x0e, 0x1e, 0x56, 0xb6, 0xb0,
+	0x0b, 0x4d, 0x20, 0xd9, 0x86, 0x82, 0x3e, 0x01, 0x57, 0x6f, 0x8d, 0xa4, 0xa1, 0x53, 0x7c, 0x75,
+	0xd3, 0x4e, 0x0d, 0x1c, 0x15, 0xb6, 0x7e, 0xb7, 0xc0, 0xee, 0x77, 0x39, 0xfa, 0x1a, 0xaa, 0xf2,
+	0x7a, 0x20, 0x69, 0x68, 0x3d, 0x70, 0xbe, 0x1d, 0x42, 0x45, 0x3f, 0x45, 0xdf, 0x40, 0x95, 0x8b,
+	0x5c, 0x12, 0xcb, 0x0f, 0x1e, 0x28, 0x87, 0x8b, 0xbc, 0x9f, 0x76, 0x00, 0x5c, 0x92, 0xc6, 0x7a,
+	0x1f, 0xff, 0x5a, 0x10, 0x0c, 0x70, 0x92, 0x8f, 0x6e, 0x23, 0xcc, 0xa7, 0x99, 0x1e, 0xfb, 0x7d,
+	0xf0, 0xe9, 0x74, 0x1c, 0xff, 0x3a, 0xc5, 0x39, 0xc1, 0xdc, 0x34, 0x36, 0xd0, 0xe9, 0xf8, 0x47,
+	0x9d, 0x41, 0x7b, 0xe0, 0x08, 0x36, 0x89, 0xef, 0xcc, 0x54, 0x54, 0x04, 0x9b, 0x5c, 0xa2, 0xef,
+	0xc0, 0xd7, 0xcf, 0xc5, 0xfc, 0xbe, 0xb2, 0x3f, 0x78, 0x9e, 0x45, 0xe5, 0x23, 0x5d, 0x44, 0x35,
+	0xa1, 0xf2, 0xdd, 0xe2, 0x23, 0x96, 0x63, 0xfd, 0x3e, 0x95, 0x23, 0xb3, 0x42, 0x07, 0x60, 0x93,
+	0x94, 0x9b, 0xdb, 0x27, 0xdc, 0x7c, 0x7b, 0x76, 0x79, 0x24, 0x41, 0xe8, 0xb1, 0xda, 0xd9, 0x9d,
+	0xfe, 0xe3, 0x60, 0x47, 0x7a, 0x71, 0xf0, 0xb7, 0x05, 0xee, 0xbc, 0x7f, 0x90, 0x0b, 0x95, 0xb7,
+	0x8c, 0xe2, 0xa0, 0x24, 0x23, 0x79, 0x69, 0x07, 0x96, 0x8c, 0xfa, 0x54, 0xbc, 0x0c, 0xca, 0xc8,
+	0x03, 0xa7, 0x4f, 0xc5, 0xf1, 0x59, 0x60, 0x9b, 0xf0, 0xf4, 0x24, 0xa8, 0x98, 0xf0, 0xec, 0xcb,
+	0xc0, 0x91, 0xa1, 0x1a, 0x92, 0x00, 0x10, 0x40, 0x55, 0x5f, 0x7b, 0x81, 0x2f, 0x63, 0x6d, 0x76,
+	0xf0, 0x18, 0x05, 0x50, 0xef, 0x14, 0x26, 0x34, 0x48, 0xd1, 0x0e, 0xf8, 0x85, 0xc9, 0x0a, 0x30,
+	0xda, 0x85, 0x47, 0x17, 0xc5, 0xc1, 0x08, 0xae, 0x11, 0x82, 0x46, 0x67, 0x35, 0x77, 0x83, 0x3e,
+	0x82, 0xdd, 0xc1, 0xfd, 0xb9, 0x0c, 0x6e, 0x3b, 0x5f, 0xfd, 0x72, 0x7a, 0x43, 0xc4, 0xed, 0x74,
+	0x28, 0xff, 0xc5, 0x1c, 0x69, 0x43, 0xbe, 0x20, 0xcc, 0x44, 0x47, 0x84, 0x0a, 0x9c, 0xd3, 0x24,
+	0x3b, 0x52, 0x1e, 0x1d, 0x69, 0x8f, 0x26, 0xc3, 0x61, 0x55, 0xad, 0x4f, 0xff, 0x1f, 0x00, 0x2e,
+	0xfb, 0x8e, 0x5e, 0x57, 0x0a, 0x00, 0x00,
 }
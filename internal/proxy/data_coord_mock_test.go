@@ -194,6 +194,22 @@ func (coord *DataCoordMock) GetCompactionStateWithPlans(ctx context.Context, req
 	return &milvuspb.GetCompactionPlansResponse{}, nil
 }
 
+func (coord *DataCoordMock) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *DataCoordMock) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	return &milvuspb.DryRunCompactionResponse{}, nil
+}
+
+func (coord *DataCoordMock) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	return &milvuspb.GetCompactionHistoryResponse{}, nil
+}
+
+func (coord *DataCoordMock) RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
 func (coord *DataCoordMock) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return &datapb.WatchChannelsResponse{}, nil
 }
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/common"
 
@@ -145,7 +146,7 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateCollection")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
 	cct := &createCollectionTask{
@@ -236,7 +237,7 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropCollection")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
 	dct := &dropCollectionTask{
@@ -315,7 +316,7 @@ func (node *Proxy) HasCollection(ctx context.Context, request *milvuspb.HasColle
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-HasCollection")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
 	log.Debug("HasCollection received",
@@ -695,7 +696,7 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Insert")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
 	cpt := &createPartitionTask{
@@ -1428,7 +1429,7 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 // Insert insert records into collection.
 func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Insert")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	log.Info("Start processing insert request in Proxy", zap.String("traceID", traceID))
 	defer log.Info("Finish processing insert request in Proxy", zap.String("traceID", traceID))
@@ -1463,8 +1464,12 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		chTicker:       node.chTicker,
 	}
 
+	// rows of collections with the partition key are routed to partitions by their keys,
+	// a failed schema lookup is reported by PreExecute
 	if len(it.PartitionName) <= 0 {
-		it.PartitionName = Params.DefaultPartitionName
+		if withKey, err := hasPartitionKey(ctx, request.CollectionName); err != nil || !withKey {
+			it.PartitionName = Params.DefaultPartitionName
+		}
 	}
 
 	constructFailedResponse := func(err error) *milvuspb.MutationResult {
@@ -1536,7 +1541,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 // Delete delete records from collection, then these records cannot be searched.
 func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Delete")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 	log.Info("Start processing delete request in Proxy", zap.String("traceID", traceID))
 	defer log.Info("Finish processing delete request in Proxy", zap.String("traceID", traceID))
@@ -1625,10 +1630,34 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		}, nil
 	}
 
+	start := time.Now()
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Search")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
+	// search with the vectors of the entities, they're retrieved before the search
+	var excludeSeeds []int64
+	var seedTopK int64
+	if request.GetIds() != nil {
+		var err error
+		excludeSeeds, seedTopK, err = node.prepareSearchByIDs(ctx, request)
+		if err != nil {
+			log.Debug("Search failed to prepare search by ids",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", Params.RoleName),
+				zap.String("db", request.DbName),
+				zap.String("collection", request.CollectionName))
+
+			return &milvuspb.SearchResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+	}
+
 	qt := &searchTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -1643,6 +1672,9 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		query:     request,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+
+		excludeSeeds: excludeSeeds,
+		seedTopK:     seedTopK,
 	}
 
 	log.Debug("Search received",
@@ -1690,6 +1722,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		zap.Any("OutputFields", request.OutputFields))
 
 	err = qt.WaitToFinish()
+	node.recordSlowSearch(qt, traceID, start)
 
 	if err != nil {
 		log.Debug("Search failed to WaitToFinish",
@@ -1862,6 +1895,100 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}, nil
 }
 
+// Get retrieves the entities by primary keys. Unlike Query, the retrieve is only answered on the DML channels
+// owning the keys, and the query nodes skip the segments whose pk bloom filter excludes all the keys.
+func (node *Proxy) Get(ctx context.Context, request *milvuspb.GetRequest) (*milvuspb.QueryResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.QueryResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	if request.GetIds().GetIntId() == nil {
+		return &milvuspb.QueryResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    "only int64 primary keys are supported",
+			},
+		}, nil
+	}
+
+	queryRequest := &milvuspb.QueryRequest{
+		DbName:             request.DbName,
+		CollectionName:     request.CollectionName,
+		PartitionNames:     request.PartitionNames,
+		OutputFields:       request.OutputFields,
+		TravelTimestamp:    request.TravelTimestamp,
+		GuaranteeTimestamp: request.GuaranteeTimestamp,
+	}
+
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Retrieve,
+				SourceID: Params.ProxyID,
+			},
+			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
+		},
+		resultBuf: make(chan []*internalpb.RetrieveResults),
+		query:     queryRequest,
+		chMgr:     node.chMgr,
+		qc:        node.queryCoord,
+		ids:       request.Ids,
+	}
+
+	log.Debug("Get enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", queryRequest.DbName),
+		zap.String("collection", queryRequest.CollectionName),
+		zap.Any("partitions", queryRequest.PartitionNames),
+		zap.Int("ids", len(request.GetIds().GetIntId().GetData())))
+
+	err := node.sched.dqQueue.Enqueue(qt)
+	if err != nil {
+		return &milvuspb.QueryResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("Get",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", qt.Base.MsgID),
+		zap.Uint64("timestamp", qt.Base.Timestamp),
+		zap.String("db", queryRequest.DbName),
+		zap.String("collection", queryRequest.CollectionName),
+		zap.Any("partitions", queryRequest.PartitionNames))
+	defer func() {
+		log.Debug("Get Done",
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", qt.Base.MsgID),
+			zap.Uint64("timestamp", qt.Base.Timestamp),
+			zap.String("db", queryRequest.DbName),
+			zap.String("collection", queryRequest.CollectionName),
+			zap.Strings("dmlChannels", qt.DmlChannels))
+	}()
+
+	err = qt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.QueryResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return &milvuspb.QueryResults{
+		Status:     qt.result.Status,
+		FieldsData: qt.result.FieldsData,
+	}, nil
+}
+
 // CreateAlias create alias for collection, then you can search the collection with alias.
 func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
@@ -2001,6 +2128,145 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	return aat.result, nil
 }
 
+// RenameCollection renames a collection.
+func (node *Proxy) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	rct := &renameCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		RenameCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(rct)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("RenameCollection",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
+	defer func() {
+		log.Debug("RenameCollection Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("oldName", request.OldName),
+			zap.String("newName", request.NewName))
+	}()
+
+	err = rct.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return rct.result, nil
+}
+
+// RecoverCollection recovers a dropped collection before its segments are garbage collected.
+func (node *Proxy) RecoverCollection(ctx context.Context, request *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	rct := &recoverCollectionTask{
+		ctx:                      ctx,
+		Condition:                NewTaskCondition(ctx),
+		RecoverCollectionRequest: request,
+		rootCoord:                node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(rct)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("RecoverCollection",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("collection", request.CollectionName))
+	defer func() {
+		log.Debug("RecoverCollection Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("collection", request.CollectionName))
+	}()
+
+	err = rct.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return rct.result, nil
+}
+
+// RecoverPartition recovers a dropped partition before its segments are garbage collected.
+func (node *Proxy) RecoverPartition(ctx context.Context, request *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	rpt := &recoverPartitionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		RecoverPartitionRequest: request,
+		rootCoord:               node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(rpt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("RecoverPartition",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName))
+	defer func() {
+		log.Debug("RecoverPartition Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("collection", request.CollectionName),
+			zap.String("partition", request.PartitionName))
+	}()
+
+	err = rpt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return rpt.result, nil
+}
+
 // CalcDistance calculates the distances between vectors.
 func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	if !node.checkHealthy() {
@@ -2021,7 +2287,7 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CalcDistance")
-	defer sp.Finish()
+	defer sp.End()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
 	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
@@ -2132,6 +2398,8 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 		if retrievedIds == nil || retrievedVectors == nil {
 			return nil, errors.New("failed to fetch vectors")
 		}
+		// half float vectors are compared in float32
+		retrievedVectors = typeutil.HalfFloatVectorToFloatVector(retrievedVectors)
 
 		dict := make(map[int64]int)
 		for index, id := range retrievedIds.GetLongData().Data {
@@ -2315,6 +2583,9 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 		}, nil
 	}
 
+	vectorsLeft = typeutil.HalfFloatVectorToFloatVector(vectorsLeft)
+	vectorsRight = typeutil.HalfFloatVectorToFloatVector(vectorsRight)
+
 	if vectorsLeft.Dim != vectorsRight.Dim {
 		msg := "Vectors dimension is not equal"
 		log.Debug(msg,
@@ -2765,6 +3036,10 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.SlowQueryMetrics {
+		return getSlowQueryMetrics(node)
+	}
+
 	log.Debug("Proxy.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("node_id", Params.ProxyID),
 		zap.String("req", req.Request),
@@ -2859,6 +3134,48 @@ func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvusp
 	return resp, err
 }
 
+// SetCompactionPolicy sets the compaction policy of a collection
+func (node *Proxy) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error) {
+	log.Info("received SetCompactionPolicy request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("policy", req.GetPolicy()), zap.Any("params", req.GetParams()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	resp, err := node.dataCoord.SetCompactionPolicy(ctx, req)
+	log.Info("received SetCompactionPolicy response", zap.Int64("collectionID", req.GetCollectionID()), zap.Any("resp", resp), zap.Error(err))
+	return resp, err
+}
+
+// DryRunCompaction returns the compaction plans a policy would generate for a collection without executing them
+func (node *Proxy) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error) {
+	log.Info("received DryRunCompaction request", zap.Int64("collectionID", req.GetCollectionID()), zap.String("policy", req.GetPolicy()))
+	resp := &milvuspb.DryRunCompactionResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.DryRunCompaction(ctx, req)
+	log.Info("received DryRunCompaction response", zap.Int64("collectionID", req.GetCollectionID()), zap.Any("resp", resp), zap.Error(err))
+	return resp, err
+}
+
+// GetCompactionHistory returns the records of completed compaction plans of a collection
+func (node *Proxy) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error) {
+	log.Info("received GetCompactionHistory request", zap.Int64("collectionID", req.GetCollectionID()), zap.Int64("limit", req.GetLimit()))
+	resp := &milvuspb.GetCompactionHistoryResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.GetCompactionHistory(ctx, req)
+	log.Info("received GetCompactionHistory response", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("num of histories", len(resp.GetHistories())), zap.Error(err))
+	return resp, err
+}
+
 // GetFlushState gets the flush state of multiple segments
 func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
 	log.Info("received get flush state request", zap.Any("request", req))
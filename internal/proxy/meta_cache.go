@@ -44,8 +44,11 @@ type Cache interface {
 	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
 	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
 	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
+	// GetIndexFieldName get the name of the field which the index named indexName is built on.
+	GetIndexFieldName(ctx context.Context, collectionName string, indexName string) (string, error)
 	RemoveCollection(ctx context.Context, collectionName string)
 	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	RemoveIndex(ctx context.Context, collectionName string, indexName string)
 }
 
 type collectionInfo struct {
@@ -66,7 +69,9 @@ type MetaCache struct {
 	client types.RootCoord
 
 	collInfo map[string]*collectionInfo
-	mu       sync.RWMutex
+	// collection name -> index name -> field name
+	indexInfo map[string]map[string]string
+	mu        sync.RWMutex
 }
 
 var globalMetaCache Cache
@@ -82,8 +87,9 @@ func InitMetaCache(client types.RootCoord) error {
 
 func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
 	return &MetaCache{
-		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		client:    client,
+		collInfo:  map[string]*collectionInfo{},
+		indexInfo: map[string]map[string]string{},
 	}, nil
 }
 
@@ -364,10 +370,51 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 	return nil
 }
 
+func (m *MetaCache) GetIndexFieldName(ctx context.Context, collectionName string, indexName string) (string, error) {
+	m.mu.RLock()
+	fieldName, ok := m.indexInfo[collectionName][indexName]
+	m.mu.RUnlock()
+	if ok {
+		return fieldName, nil
+	}
+
+	req := &milvuspb.DescribeIndexRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_DescribeIndex,
+		},
+		CollectionName: collectionName,
+		IndexName:      indexName,
+	}
+	resp, err := m.client.DescribeIndex(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if resp.Status.ErrorCode == commonpb.ErrorCode_IndexNotExist {
+		return "", fmt.Errorf("index %s not found in collection %s", indexName, collectionName)
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return "", errors.New(resp.Status.Reason)
+	}
+	for _, desc := range resp.IndexDescriptions {
+		if desc.IndexName != indexName {
+			continue
+		}
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if _, ok := m.indexInfo[collectionName]; !ok {
+			m.indexInfo[collectionName] = map[string]string{}
+		}
+		m.indexInfo[collectionName][indexName] = desc.FieldName
+		return desc.FieldName, nil
+	}
+	return "", fmt.Errorf("index %s not found in collection %s", indexName, collectionName)
+}
+
 func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	delete(m.collInfo, collectionName)
+	delete(m.indexInfo, collectionName)
 }
 
 func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
@@ -383,3 +430,9 @@ func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partiti
 	}
 	delete(partInfo, partitionName)
 }
+
+func (m *MetaCache) RemoveIndex(ctx context.Context, collectionName, indexName string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.indexInfo[collectionName], indexName)
+}
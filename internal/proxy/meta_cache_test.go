@@ -135,6 +135,26 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 }
 
 //Simulate the cache path and the
+func (m *MockRootCoordClientInterface) DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	if in.CollectionName == "collection1" && in.IndexName == "idx1" {
+		return &milvuspb.DescribeIndexResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			IndexDescriptions: []*milvuspb.IndexDescription{{IndexName: "idx1", FieldName: "vec"}},
+		}, nil
+	}
+	return &milvuspb.DescribeIndexResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IndexNotExist,
+		},
+	}, nil
+}
+
 func TestMetaCache_GetCollection(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
@@ -273,3 +293,41 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
+
+func TestMetaCache_GetIndexFieldName(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	fieldName, err := globalMetaCache.GetIndexFieldName(ctx, "collection1", "idx1")
+	assert.Nil(t, err)
+	assert.Equal(t, "vec", fieldName)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// the field name is cached
+	fieldName, err = globalMetaCache.GetIndexFieldName(ctx, "collection1", "idx1")
+	assert.Nil(t, err)
+	assert.Equal(t, "vec", fieldName)
+	assert.Equal(t, 1, client.AccessCount)
+
+	_, err = globalMetaCache.GetIndexFieldName(ctx, "collection1", "idx2")
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, client.AccessCount)
+
+	// the index is described again once it is removed
+	globalMetaCache.RemoveIndex(ctx, "collection1", "idx1")
+	_, err = globalMetaCache.GetIndexFieldName(ctx, "collection1", "idx1")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, client.AccessCount)
+
+	globalMetaCache.RemoveCollection(ctx, "collection1")
+	_, err = globalMetaCache.GetIndexFieldName(ctx, "collection1", "idx1")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, client.AccessCount)
+
+	client.Error = true
+	globalMetaCache.RemoveIndex(ctx, "collection1", "idx1")
+	_, err = globalMetaCache.GetIndexFieldName(ctx, "collection1", "idx1")
+	assert.NotNil(t, err)
+}
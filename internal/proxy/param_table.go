@@ -73,6 +73,10 @@ type ParamTable struct {
 
 	MaxTaskNum int64
 
+	// --- Slow Query ---
+	SlowQueryThreshold  time.Duration
+	SlowQueryMaxRecords int
+
 	PulsarMaxMessageSize int
 
 	CreatedTime time.Time
@@ -116,6 +120,8 @@ func (pt *ParamTable) Init() {
 	pt.initMaxTaskNum()
 	pt.initBufFlagExpireTime()
 	pt.initBufFlagCleanupInterval()
+	pt.initSlowQueryThreshold()
+	pt.initSlowQueryMaxRecords()
 
 	pt.initRoleName()
 }
@@ -296,3 +302,12 @@ func (pt *ParamTable) initBufFlagCleanupInterval() {
 	interval := pt.ParseInt64WithDefault("proxy.bufFlagCleanupInterval", 600)
 	pt.BufFlagCleanupInterval = time.Duration(interval) * time.Second
 }
+
+func (pt *ParamTable) initSlowQueryThreshold() {
+	threshold := pt.ParseInt64WithDefault("proxy.slowQuery.threshold", 3000)
+	pt.SlowQueryThreshold = time.Duration(threshold) * time.Millisecond
+}
+
+func (pt *ParamTable) initSlowQueryMaxRecords() {
+	pt.SlowQueryMaxRecords = pt.ParseIntWithDefault("proxy.slowQuery.maxRecords", 100)
+}
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -94,6 +95,17 @@ func TestParamTable_Normal(t *testing.T) {
 	t.Run("MaxTaskNum", func(t *testing.T) {
 		t.Logf("MaxTaskNum: %d", Params.MaxTaskNum)
 	})
+
+	t.Run("SlowQuery", func(t *testing.T) {
+		assert.Equal(t, 3*time.Second, Params.SlowQueryThreshold)
+		assert.Equal(t, 100, Params.SlowQueryMaxRecords)
+
+		Params.Save("proxy.slowQuery.threshold", "500")
+		Params.initSlowQueryThreshold()
+		assert.Equal(t, 500*time.Millisecond, Params.SlowQueryThreshold)
+		Params.Save("proxy.slowQuery.threshold", "3000")
+		Params.initSlowQueryThreshold()
+	})
 }
 
 func shouldPanic(t *testing.T, name string, f func()) {
@@ -137,4 +149,10 @@ func TestParamTable_Panics(t *testing.T) {
 		Params.Save("proxy.maxTaskNum", "-asdf")
 		Params.initMaxTaskNum()
 	})
+
+	shouldPanic(t, "proxy.slowQuery.threshold", func() {
+		Params.Save("proxy.slowQuery.threshold", "abc")
+		Params.initSlowQueryThreshold()
+	})
+	Params.Save("proxy.slowQuery.threshold", "3000")
 }
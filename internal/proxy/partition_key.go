@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// hasPartitionKey returns true if the collection has a partition key, the partitions of such collection are
+// created for the partition key and hidden from users
+func hasPartitionKey(ctx context.Context, collectionName string) (bool, error) {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	if err != nil {
+		return false, err
+	}
+	return typeutil.GetPartitionKeyFieldSchema(schema) != nil, nil
+}
+
+// partitionKeyIndex returns the index of the partition the partition key is hashed into,
+// the keys are hashed by Hash32Int64(key) and bucketed by hash % partitionNum.
+func partitionKeyIndex(key int64, partitionNum int) int64 {
+	hash, _ := typeutil.Hash32Int64(key)
+	return int64(hash % uint32(partitionNum))
+}
+
+// getPartitionKeyData returns the values of the partition key field in the column based fields data
+func getPartitionKeyData(fieldsData []*schemapb.FieldData, keyField *schemapb.FieldSchema) ([]int64, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldName() != keyField.GetName() {
+			continue
+		}
+		switch keyField.GetDataType() {
+		case schemapb.DataType_Int64:
+			return fieldData.GetScalars().GetLongData().GetData(), nil
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+			data := fieldData.GetScalars().GetIntData().GetData()
+			keys := make([]int64, 0, len(data))
+			for _, v := range data {
+				keys = append(keys, int64(v))
+			}
+			return keys, nil
+		default:
+			return nil, fmt.Errorf("the data type of partition key should be integer, field name = %s", keyField.GetName())
+		}
+	}
+	return nil, fmt.Errorf("the data of partition key %s is missing", keyField.GetName())
+}
+
+// getPartitionKeyPartitionIDs returns the IDs of the partitions the partition keys are hashed into,
+// without duplication
+func getPartitionKeyPartitionIDs(ctx context.Context, collectionName string, keys []int64) ([]UniqueID, error) {
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	if len(partitionsMap) == 0 {
+		return nil, fmt.Errorf("collection %s has no partitions", collectionName)
+	}
+	partitionIDs := make([]UniqueID, 0)
+	used := make(map[UniqueID]struct{})
+	for _, key := range keys {
+		name := typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, partitionKeyIndex(key, len(partitionsMap)))
+		partitionID, ok := partitionsMap[name]
+		if !ok {
+			return nil, fmt.Errorf("partition %s of collection %s not found", name, collectionName)
+		}
+		if _, ok := used[partitionID]; !ok {
+			used[partitionID] = struct{}{}
+			partitionIDs = append(partitionIDs, partitionID)
+		}
+	}
+	return partitionIDs, nil
+}
+
+// getPartitionKeyValues returns the values the expression constrains the partition key to, it returns
+// false if rows with any values of the partition key may match the expression
+func getPartitionKeyValues(expr *planpb.Expr, keyFieldID int64) ([]int64, bool) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetColumnInfo().GetFieldId() != keyFieldID {
+			return nil, false
+		}
+		values := make([]int64, 0, len(e.TermExpr.GetValues()))
+		for _, v := range e.TermExpr.GetValues() {
+			val, ok := v.GetVal().(*planpb.GenericValue_Int64Val)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, val.Int64Val)
+		}
+		return values, true
+	case *planpb.Expr_UnaryRangeExpr:
+		if e.UnaryRangeExpr.GetColumnInfo().GetFieldId() != keyFieldID || e.UnaryRangeExpr.GetOp() != planpb.OpType_Equal {
+			return nil, false
+		}
+		val, ok := e.UnaryRangeExpr.GetValue().GetVal().(*planpb.GenericValue_Int64Val)
+		if !ok {
+			return nil, false
+		}
+		return []int64{val.Int64Val}, true
+	case *planpb.Expr_BinaryExpr:
+		left, leftOk := getPartitionKeyValues(e.BinaryExpr.GetLeft(), keyFieldID)
+		right, rightOk := getPartitionKeyValues(e.BinaryExpr.GetRight(), keyFieldID)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			switch {
+			case leftOk && rightOk:
+				rightSet := make(map[int64]struct{}, len(right))
+				for _, v := range right {
+					rightSet[v] = struct{}{}
+				}
+				values := make([]int64, 0)
+				for _, v := range left {
+					if _, ok := rightSet[v]; ok {
+						values = append(values, v)
+					}
+				}
+				return values, true
+			case leftOk:
+				return left, true
+			case rightOk:
+				return right, true
+			}
+		case planpb.BinaryExpr_LogicalOr:
+			if leftOk && rightOk {
+				return append(left, right...), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// getPartitionKeyPrunedPartitionIDs returns the partitions to be searched if the predicates constrain
+// the partition key to specific values, otherwise nil which means all the partitions
+func getPartitionKeyPrunedPartitionIDs(ctx context.Context, collectionName string, keyField *schemapb.FieldSchema, predicates *planpb.Expr) ([]UniqueID, error) {
+	if keyField == nil || predicates == nil {
+		return nil, nil
+	}
+	values, ok := getPartitionKeyValues(predicates, keyField.GetFieldID())
+	// no row matches if the values are empty, which is left to the query nodes
+	if !ok || len(values) == 0 {
+		return nil, nil
+	}
+	return getPartitionKeyPartitionIDs(ctx, collectionName, values)
+}
+
+// splitByPartitionKey splits the rows of the insert task into insert messages of the partitions
+// their partition keys are hashed into, the messages are ordered by partition ID
+func (it *insertTask) splitByPartitionKey(ctx context.Context, keyField *schemapb.FieldSchema) ([]*msgstream.InsertMsg, error) {
+	keys, err := getPartitionKeyData(it.req.GetFieldsData(), keyField)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) != len(it.RowData) {
+		return nil, fmt.Errorf("the number of partition keys %d doesn't match the number of rows %d", len(keys), len(it.RowData))
+	}
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, it.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	if len(partitionsMap) == 0 {
+		return nil, fmt.Errorf("collection %s has no partitions", it.CollectionName)
+	}
+
+	msgs := make(map[UniqueID]*msgstream.InsertMsg)
+	for i, key := range keys {
+		name := typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, partitionKeyIndex(key, len(partitionsMap)))
+		partitionID, ok := partitionsMap[name]
+		if !ok {
+			return nil, fmt.Errorf("partition %s of collection %s not found", name, it.CollectionName)
+		}
+		msg, ok := msgs[partitionID]
+		if !ok {
+			msg = &msgstream.InsertMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx:            it.BaseMsg.Ctx,
+					BeginTimestamp: it.BeginTimestamp,
+					EndTimestamp:   it.EndTimestamp,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base:           it.Base,
+					DbName:         it.DbName,
+					CollectionName: it.CollectionName,
+					PartitionName:  name,
+					DbID:           it.DbID,
+					CollectionID:   it.CollectionID,
+					PartitionID:    partitionID,
+				},
+			}
+			msgs[partitionID] = msg
+		}
+		msg.HashValues = append(msg.HashValues, it.HashValues[i])
+		msg.Timestamps = append(msg.Timestamps, it.Timestamps[i])
+		msg.RowIDs = append(msg.RowIDs, it.RowIDs[i])
+		msg.RowData = append(msg.RowData, it.RowData[i])
+	}
+
+	partitionIDs := make([]UniqueID, 0, len(msgs))
+	for partitionID := range msgs {
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+	sort.Slice(partitionIDs, func(i, j int) bool { return partitionIDs[i] < partitionIDs[j] })
+	result := make([]*msgstream.InsertMsg, 0, len(msgs))
+	for _, partitionID := range partitionIDs {
+		result = append(result, msgs[partitionID])
+	}
+	return result, nil
+}
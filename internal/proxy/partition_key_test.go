@@ -0,0 +1,315 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_partitionKeyIndex(t *testing.T) {
+	for _, key := range []int64{1, 2, 3, 100, 1000} {
+		hash, _ := typeutil.Hash32Int64(key)
+		assert.Equal(t, int64(hash%16), partitionKeyIndex(key, 16))
+	}
+}
+
+func Test_getPartitionKeyData(t *testing.T) {
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldName: "user",
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+				},
+			},
+		},
+		{
+			FieldName: "tenant",
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{4, 5, 6}}},
+				},
+			},
+		},
+	}
+
+	keys, err := getPartitionKeyData(fieldsData, &schemapb.FieldSchema{Name: "user", DataType: schemapb.DataType_Int64})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, keys)
+
+	keys, err = getPartitionKeyData(fieldsData, &schemapb.FieldSchema{Name: "tenant", DataType: schemapb.DataType_Int32})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 6}, keys)
+
+	_, err = getPartitionKeyData(fieldsData, &schemapb.FieldSchema{Name: "tenant", DataType: schemapb.DataType_Double})
+	assert.Error(t, err)
+
+	_, err = getPartitionKeyData(fieldsData, &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64})
+	assert.Error(t, err)
+}
+
+func Test_getPartitionKeyValues(t *testing.T) {
+	const keyFieldID = int64(101)
+	int64Value := func(v int64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+	}
+	termExpr := func(fieldID int64, values ...int64) *planpb.Expr {
+		genericValues := make([]*planpb.GenericValue, 0, len(values))
+		for _, v := range values {
+			genericValues = append(genericValues, int64Value(v))
+		}
+		return &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID, DataType: schemapb.DataType_Int64},
+			Values:     genericValues,
+		}}}
+	}
+	unaryRangeExpr := func(fieldID int64, op planpb.OpType, v int64) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID, DataType: schemapb.DataType_Int64},
+			Op:         op,
+			Value:      int64Value(v),
+		}}}
+	}
+	binaryExpr := func(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+			Op:    op,
+			Left:  left,
+			Right: right,
+		}}}
+	}
+
+	values, ok := getPartitionKeyValues(termExpr(keyFieldID, 1, 2, 3), keyFieldID)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []int64{1, 2, 3}, values)
+
+	_, ok = getPartitionKeyValues(termExpr(100, 1, 2, 3), keyFieldID)
+	assert.False(t, ok)
+
+	values, ok = getPartitionKeyValues(unaryRangeExpr(keyFieldID, planpb.OpType_Equal, 5), keyFieldID)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []int64{5}, values)
+
+	_, ok = getPartitionKeyValues(unaryRangeExpr(keyFieldID, planpb.OpType_GreaterThan, 5), keyFieldID)
+	assert.False(t, ok)
+
+	// one side constrains the partition key
+	values, ok = getPartitionKeyValues(binaryExpr(planpb.BinaryExpr_LogicalAnd,
+		termExpr(keyFieldID, 1, 2), unaryRangeExpr(100, planpb.OpType_GreaterThan, 5)), keyFieldID)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []int64{1, 2}, values)
+
+	values, ok = getPartitionKeyValues(binaryExpr(planpb.BinaryExpr_LogicalAnd,
+		termExpr(keyFieldID, 1, 2, 3), termExpr(keyFieldID, 2, 3, 4)), keyFieldID)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []int64{2, 3}, values)
+
+	values, ok = getPartitionKeyValues(binaryExpr(planpb.BinaryExpr_LogicalOr,
+		termExpr(keyFieldID, 1), unaryRangeExpr(keyFieldID, planpb.OpType_Equal, 2)), keyFieldID)
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []int64{1, 2}, values)
+
+	_, ok = getPartitionKeyValues(binaryExpr(planpb.BinaryExpr_LogicalOr,
+		termExpr(keyFieldID, 1), unaryRangeExpr(100, planpb.OpType_Equal, 2)), keyFieldID)
+	assert.False(t, ok)
+
+	_, ok = getPartitionKeyValues(nil, keyFieldID)
+	assert.False(t, ok)
+}
+
+func Test_getPartitionKeyPrunedPartitionIDs(t *testing.T) {
+	ctx := context.Background()
+	expr := &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+		ColumnInfo: &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_Int64},
+		Op:         planpb.OpType_GreaterThan,
+		Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 1}},
+	}}}
+	keyField := &schemapb.FieldSchema{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64, IsPartitionKey: true}
+
+	// all the partitions are searched without the partition key or constraints on it
+	partitionIDs, err := getPartitionKeyPrunedPartitionIDs(ctx, "coll", nil, expr)
+	assert.NoError(t, err)
+	assert.Nil(t, partitionIDs)
+
+	partitionIDs, err = getPartitionKeyPrunedPartitionIDs(ctx, "coll", keyField, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, partitionIDs)
+
+	partitionIDs, err = getPartitionKeyPrunedPartitionIDs(ctx, "coll", keyField, expr)
+	assert.NoError(t, err)
+	assert.Nil(t, partitionIDs)
+}
+
+func TestProxy_InsertWithPartitionKey(t *testing.T) {
+	Params.Init()
+	Params.SearchResultChannelNames = []string{funcutil.GenRandomStr()}
+	ctx := context.Background()
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	assert.NoError(t, InitMetaCache(rc))
+
+	const (
+		dim          = 8
+		nb           = 100
+		partitionNum = 4
+	)
+	collectionName := "TestProxy_InsertWithPartitionKey" + funcutil.GenRandomStr()
+	schema := constructCollectionSchema("int64", "fvec", dim, collectionName)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		Name:           "tenant",
+		DataType:       schemapb.DataType_Int64,
+		IsPartitionKey: true,
+	})
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	for i := 0; i < partitionNum; i++ {
+		status, err = rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreatePartition},
+			CollectionName: collectionName,
+			PartitionName:  typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, int64(i)),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	assert.NoError(t, err)
+
+	chMgr := newChannelsMgrImpl(getDmlChannelsFunc(ctx, rc), nil, newMockGetChannelsService().GetChannels, nil, newSimpleMockMsgStreamFactory())
+	defer chMgr.removeAllDMLStream()
+	assert.NoError(t, chMgr.createDMLMsgStream(collectionID))
+	pchans, err := chMgr.getChannels(collectionID)
+	assert.NoError(t, err)
+
+	tso := newMockTsoAllocator()
+	ticker := newChannelsTimeTicker(ctx, 10*time.Millisecond, []string{}, newGetStatisticsFunc(pchans), tso)
+	_ = ticker.start()
+	defer ticker.close()
+
+	idAllocator, err := allocator.NewIDAllocator(ctx, rc, Params.ProxyID)
+	assert.NoError(t, err)
+	_ = idAllocator.Start()
+	defer idAllocator.Close()
+
+	segAllocator, err := newSegIDAssigner(ctx, &mockDataCoord{expireTime: Timestamp(2500)}, getLastTick1)
+	assert.NoError(t, err)
+	segAllocator.Init()
+	_ = segAllocator.Start()
+	defer segAllocator.Close()
+
+	sched, err := newTaskScheduler(ctx, idAllocator, tso, newSimpleMockMsgStreamFactory())
+	assert.NoError(t, err)
+	assert.NoError(t, sched.Start())
+	defer sched.Close()
+
+	node := &Proxy{
+		ctx:         ctx,
+		chMgr:       chMgr,
+		sched:       sched,
+		chTicker:    ticker,
+		idAllocator: idAllocator,
+		segAssigner: segAllocator,
+	}
+	node.UpdateStateCode(internalpb.StateCode_Healthy)
+
+	tenants := make([]int64, 0, nb)
+	for i := 0; i < nb; i++ {
+		tenants = append(tenants, int64(i))
+	}
+	req := &milvuspb.InsertRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+		CollectionName: collectionName,
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_FloatVector,
+				FieldName: "fvec",
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Dim: dim,
+						Data: &schemapb.VectorField_FloatVector{
+							FloatVector: &schemapb.FloatArray{Data: generateFloatVectors(nb, dim)},
+						},
+					},
+				},
+			},
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "tenant",
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: tenants}},
+					},
+				},
+			},
+		},
+		HashKeys: generateHashKeys(nb),
+		NumRows:  nb,
+	}
+
+	t.Run("insert routed by the partition key", func(t *testing.T) {
+		resp, err := node.Insert(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode, resp.Status.Reason)
+		assert.Equal(t, int64(nb), resp.InsertCnt)
+
+		stream, err := chMgr.getDMLStream(collectionID)
+		assert.NoError(t, err)
+		pack := stream.(*simpleMockMsgStream).Consume()
+		assert.NotNil(t, pack)
+		rowNum := 0
+		for _, msg := range pack.Msgs {
+			insertMsg, ok := msg.(*msgstream.InsertMsg)
+			assert.True(t, ok)
+			assert.NotEqual(t, Params.DefaultPartitionName, insertMsg.PartitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, insertMsg.PartitionName)
+			assert.NoError(t, err)
+			assert.Equal(t, partitionID, insertMsg.PartitionID)
+			rowNum += len(insertMsg.RowData)
+		}
+		assert.Equal(t, nb, rowNum)
+	})
+
+	t.Run("insert with partition name", func(t *testing.T) {
+		req.PartitionName = typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 0)
+		defer func() { req.PartitionName = "" }()
+		resp, err := node.Insert(ctx, req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, nb, len(resp.ErrIndex))
+	})
+}
@@ -340,6 +340,25 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
 	}
 
+	// string fields are only filtered by their scalar index, which supports term queries
+	if typeutil.IsStringType(field.DataType) {
+		if op != planpb.OpType_Equal && op != planpb.OpType_NotEqual {
+			return nil, fmt.Errorf("string field %s only supports ==, !=, in and not in", field.Name)
+		}
+		expr := &planpb.Expr{
+			Expr: &planpb.Expr_TermExpr{
+				TermExpr: &planpb.TermExpr{
+					ColumnInfo: createColumnInfo(field),
+					Values:     []*planpb.GenericValue{val},
+				},
+			},
+		}
+		if op == planpb.OpType_NotEqual {
+			return pc.createNotExpr(expr)
+		}
+		return expr, nil
+	}
+
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_UnaryRangeExpr{
 			UnaryRangeExpr: &planpb.UnaryRangeExpr{
@@ -580,6 +599,16 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 		} else {
 			return nil, fmt.Errorf("type mismatch")
 		}
+	case *ant_ast.StringNode:
+		if typeutil.IsStringType(dataType) {
+			gv = &planpb.GenericValue{
+				Val: &planpb.GenericValue_StringVal{
+					StringVal: node.Value,
+				},
+			}
+		} else {
+			return nil, fmt.Errorf("type mismatch")
+		}
 	default:
 		return nil, fmt.Errorf("unsupported leaf node")
 	}
@@ -330,6 +330,59 @@ func TestExprFieldCompare_Str(t *testing.T) {
 	}
 }
 
+func TestExprString_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: "brand", DataType: schemapb.DataType_String},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      true,
+		Fields:      fields,
+	}
+
+	queryInfo := &planpb.QueryInfo{
+		Topk:         10,
+		MetricType:   "L2",
+		SearchParams: "{\"nprobe\": 10}",
+	}
+
+	planProto, err := createQueryPlan(schema, `brand in ["nike", "adidas"]`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	termExpr := planProto.GetVectorAnns().GetPredicates().GetTermExpr()
+	assert.NotNil(t, termExpr)
+	assert.Equal(t, int64(102), termExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, "nike", termExpr.GetValues()[0].GetStringVal())
+	assert.Equal(t, "adidas", termExpr.GetValues()[1].GetStringVal())
+
+	planProto, err = createQueryPlan(schema, `"nike" == brand`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	assert.Equal(t, "nike", planProto.GetVectorAnns().GetPredicates().GetTermExpr().GetValues()[0].GetStringVal())
+
+	validExprs := []string{
+		`brand not in ["nike"]`,
+		`brand != "nike"`,
+		`age > 10 && brand == "nike"`,
+	}
+	for _, exprStr := range validExprs {
+		_, err := createQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.Nil(t, err, exprStr)
+	}
+
+	invalidExprs := []string{
+		`brand > "nike"`,
+		`brand in [1, 2]`,
+		`age == "nike"`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err := createQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
 func TestPlanParseAPIs(t *testing.T) {
 	t.Run("get compare op type", func(t *testing.T) {
 		var op planpb.OpType
@@ -82,6 +82,8 @@ type Proxy struct {
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
+	slowQueries *slowQueryRecorder
+
 	session *sessionutil.Session
 
 	msFactory msgstream.Factory
@@ -221,6 +223,7 @@ func (node *Proxy) Init() error {
 	node.chTicker = newChannelsTimeTicker(node.ctx, channelMgrTickerInterval, []string{}, node.sched.getPChanStatistics, tsoAllocator)
 
 	node.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
+	node.slowQueries = newSlowQueryRecorder(Params.SlowQueryMaxRecords)
 
 	return nil
 }
@@ -761,6 +761,45 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("rename collection", func(t *testing.T) {
+		defer wg.Done()
+		newCollectionName := collectionName + "_renamed"
+		resp, err := proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			Base:    nil,
+			OldName: collectionName,
+			NewName: newCollectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		hasResp, err := proxy.HasCollection(ctx, &milvuspb.HasCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.False(t, hasResp.Value)
+
+		// the name collides with the alias
+		resp, err = proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			Base:    nil,
+			OldName: newCollectionName,
+			NewName: "alias",
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// rename it back for the following cases
+		resp, err = proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			Base:    nil,
+			OldName: newCollectionName,
+			NewName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("drop alias", func(t *testing.T) {
 		defer wg.Done()
@@ -1954,6 +1993,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Get fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Get(ctx, &milvuspb.GetRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("CreateAlias fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2235,6 +2282,16 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Get fail, dq queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Get(ctx, &milvuspb.GetRequest{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	proxy.sched.dqQueue.setMaxTaskNum(dqParallelism)
 
 	// timeout
@@ -2515,6 +2572,63 @@ func Test_GetCompactionStateWithPlans(t *testing.T) {
 	})
 }
 
+func Test_SetCompactionPolicy(t *testing.T) {
+	t.Run("test set compaction policy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.SetCompactionPolicy(context.TODO(), nil)
+		assert.EqualValues(t, &commonpb.Status{}, resp)
+		assert.Nil(t, err)
+	})
+	t.Run("test set compaction policy with unhealthy proxy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.SetCompactionPolicy(context.TODO(), nil)
+		assert.EqualValues(t, unhealthyStatus(), resp)
+		assert.Nil(t, err)
+	})
+}
+
+func Test_DryRunCompaction(t *testing.T) {
+	t.Run("test dry run compaction", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.DryRunCompaction(context.TODO(), nil)
+		assert.EqualValues(t, &milvuspb.DryRunCompactionResponse{}, resp)
+		assert.Nil(t, err)
+	})
+	t.Run("test dry run compaction with unhealthy proxy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.DryRunCompaction(context.TODO(), nil)
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
+func Test_GetCompactionHistory(t *testing.T) {
+	t.Run("test get compaction history", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.GetCompactionHistory(context.TODO(), nil)
+		assert.EqualValues(t, &milvuspb.GetCompactionHistoryResponse{}, resp)
+		assert.Nil(t, err)
+	})
+	t.Run("test get compaction history with unhealthy proxy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.GetCompactionHistory(context.TODO(), nil)
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
 func Test_GetFlushState(t *testing.T) {
 	t.Run("normal test", func(t *testing.T) {
 		datacoord := &DataCoordMock{}
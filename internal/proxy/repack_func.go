@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func insertRepackFunc(
@@ -81,3 +82,17 @@ func defaultInsertRepackFunc(
 	}
 	return pack, nil
 }
+
+// pkChannelIndexes returns the index of the DML channel each primary key is repacked into,
+// insert and delete messages are hashed by Hash32Int64(pk) and bucketed by hash % channelNum.
+func pkChannelIndexes(pks []int64, channelNum int) []int32 {
+	if channelNum <= 0 {
+		return nil
+	}
+	indexes := make([]int32, 0, len(pks))
+	for _, pk := range pks {
+		hash, _ := typeutil.Hash32Int64(pk)
+		indexes = append(indexes, int32(hash%uint32(channelNum)))
+	}
+	return indexes
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -194,3 +195,15 @@ func Test_defaultInsertRepackFunc(t *testing.T) {
 		assert.Equal(t, histogram[key], len(ret7[key].Msgs))
 	}
 }
+
+func Test_pkChannelIndexes(t *testing.T) {
+	assert.Nil(t, pkChannelIndexes([]int64{1, 2}, 0))
+
+	pks := []int64{1, 2, 3, 100, 1000}
+	indexes := pkChannelIndexes(pks, 4)
+	assert.Equal(t, len(pks), len(indexes))
+	for i, pk := range pks {
+		hash, _ := typeutil.Hash32Int64(pk)
+		assert.Equal(t, int32(hash%4), indexes[i])
+	}
+}
@@ -0,0 +1,242 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// prepareSearchByIDs fills the placeholder group of a search by primary keys with the vectors of these entities.
+// If the seeds are excluded, one more result is searched for each query, the seeds and the topk requested
+// are returned to trim the results then.
+func (node *Proxy) prepareSearchByIDs(ctx context.Context, request *milvuspb.SearchRequest) ([]int64, int64, error) {
+	pks := request.GetIds().GetIntId().GetData()
+	if len(pks) == 0 {
+		return nil, 0, errors.New("only int64 primary keys are supported to search by ids, and they should not be empty")
+	}
+	if len(request.PlaceholderGroup) > 0 {
+		return nil, 0, errors.New("placeholder_group and ids cannot be both specified")
+	}
+	annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, request.SearchParams)
+	if err != nil {
+		return nil, 0, errors.New(AnnsFieldKey + " not found in search_params")
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.CollectionName)
+	if err != nil {
+		return nil, 0, err
+	}
+	pkField, err := getSearchByIDsPkField(schema, annsField)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := node.Get(ctx, &milvuspb.GetRequest{
+		DbName:             request.DbName,
+		CollectionName:     request.CollectionName,
+		PartitionNames:     request.PartitionNames,
+		Ids:                request.Ids,
+		OutputFields:       []string{annsField},
+		TravelTimestamp:    request.TravelTimestamp,
+		GuaranteeTimestamp: request.GuaranteeTimestamp,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.Status.ErrorCode == commonpb.ErrorCode_EmptyCollection {
+		return nil, 0, fmt.Errorf("entities to search with are not found, ids: %v", pks)
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, 0, errors.New(resp.Status.Reason)
+	}
+	group, err := vectorsToPlaceholderGroup(resp.FieldsData, pkField, annsField, pks)
+	if err != nil {
+		return nil, 0, err
+	}
+	request.PlaceholderGroup, err = proto.Marshal(group)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !request.ExcludeSeed {
+		return nil, 0, nil
+	}
+	topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, request.SearchParams)
+	if err != nil {
+		return nil, 0, errors.New(TopKKey + " not found in search_params")
+	}
+	topK, err := strconv.ParseInt(topKStr, 10, 64)
+	if err != nil {
+		return nil, 0, errors.New(TopKKey + " " + topKStr + " is not invalid")
+	}
+	searchParams := make([]*commonpb.KeyValuePair, 0, len(request.SearchParams))
+	for _, kv := range request.SearchParams {
+		if kv.Key == TopKKey {
+			kv = &commonpb.KeyValuePair{Key: TopKKey, Value: strconv.FormatInt(topK+1, 10)}
+		}
+		searchParams = append(searchParams, kv)
+	}
+	request.SearchParams = searchParams
+	return pks, topK, nil
+}
+
+// getSearchByIDsPkField returns the primary key field to retrieve the seed vectors of annsField with.
+// Sparse float vectors can't be retrieved as output fields, so they can't be searched by ids.
+func getSearchByIDsPkField(schema *schemapb.CollectionSchema, annsField string) (string, error) {
+	pkField := ""
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey {
+			pkField = field.Name
+		}
+		if field.Name == annsField && field.DataType == schemapb.DataType_SparseFloatVector {
+			return "", fmt.Errorf("searching by ids is not supported on sparse float vector field %s", annsField)
+		}
+	}
+	return pkField, nil
+}
+
+// vectorsToPlaceholderGroup builds the placeholder group with the vectors of annsField in fieldsData,
+// the vectors are ordered as pks.
+func vectorsToPlaceholderGroup(fieldsData []*schemapb.FieldData, pkField string, annsField string, pks []int64) (*milvuspb.PlaceholderGroup, error) {
+	var pkData *schemapb.FieldData
+	var vectorData *schemapb.FieldData
+	for _, fd := range fieldsData {
+		switch fd.GetFieldName() {
+		case pkField:
+			pkData = fd
+		case annsField:
+			vectorData = fd
+		}
+	}
+	if pkData == nil || vectorData == nil {
+		return nil, fmt.Errorf("field %s or %s is missing in the retrieved entities", pkField, annsField)
+	}
+	rows := make(map[int64]int)
+	for i, pk := range pkData.GetScalars().GetLongData().GetData() {
+		rows[pk] = i
+	}
+
+	vectors := vectorData.GetVectors()
+	dim := int(vectors.GetDim())
+	values := make([][]byte, 0, len(pks))
+	for _, pk := range pks {
+		row, ok := rows[pk]
+		if !ok {
+			return nil, fmt.Errorf("entity with primary key %d is not found", pk)
+		}
+		var value []byte
+		switch vectorData.GetType() {
+		case schemapb.DataType_FloatVector:
+			data := vectors.GetFloatVector().GetData()
+			if (row+1)*dim > len(data) {
+				return nil, fmt.Errorf("vector of entity %d is incomplete", pk)
+			}
+			value = make([]byte, 0, dim*4)
+			for _, v := range data[row*dim : (row+1)*dim] {
+				value = append(value, typeutil.Float32ToBytes(v)...)
+			}
+		case schemapb.DataType_BinaryVector:
+			value, ok = vectorRowBytes(vectors.GetBinaryVector(), row, dim/8)
+		case schemapb.DataType_Float16Vector:
+			value, ok = vectorRowBytes(vectors.GetFloat16Vector(), row, dim*2)
+		case schemapb.DataType_BFloat16Vector:
+			value, ok = vectorRowBytes(vectors.GetBfloat16Vector(), row, dim*2)
+		default:
+			return nil, fmt.Errorf("field %s is not a vector field", annsField)
+		}
+		if !ok {
+			return nil, fmt.Errorf("vector of entity %d is incomplete", pk)
+		}
+		values = append(values, value)
+	}
+
+	return &milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{
+				Tag:    "$0",
+				Type:   milvuspb.PlaceholderType(vectorData.GetType()),
+				Values: values,
+			},
+		},
+	}, nil
+}
+
+func vectorRowBytes(data []byte, row int, width int) ([]byte, bool) {
+	if width <= 0 || (row+1)*width > len(data) {
+		return nil, false
+	}
+	return data[row*width : (row+1)*width], true
+}
+
+// excludeSeedResults removes the seed entity from the results of each query, and keeps at most topK results per query.
+func excludeSeedResults(result *schemapb.SearchResultData, seeds []int64, topK int64) *schemapb.SearchResultData {
+	ret := &schemapb.SearchResultData{
+		NumQueries: result.GetNumQueries(),
+		TopK:       topK,
+		FieldsData: make([]*schemapb.FieldData, len(result.GetFieldsData())),
+		Scores:     make([]float32, 0),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: make([]int64, 0),
+				},
+			},
+		},
+		Topks: make([]int64, 0, len(result.GetTopks())),
+	}
+
+	ids := result.GetIds().GetIntId().GetData()
+	scores := result.GetScores()
+	var offset int64
+	for i, k := range result.GetTopks() {
+		var kept int64
+		for j := offset; j < offset+k && j < int64(len(ids)); j++ {
+			if kept >= topK || (i < len(seeds) && ids[j] == seeds[i]) {
+				continue
+			}
+			ret.Ids.GetIntId().Data = append(ret.Ids.GetIntId().Data, ids[j])
+			ret.Scores = append(ret.Scores, scores[j])
+			typeutil.AppendFieldData(ret.FieldsData, result.GetFieldsData(), j)
+			kept++
+		}
+		ret.Topks = append(ret.Topks, kept)
+		offset += k
+	}
+
+	// keep the output fields even if every result is excluded
+	for i, fd := range ret.FieldsData {
+		if fd == nil {
+			src := result.GetFieldsData()[i]
+			ret.FieldsData[i] = &schemapb.FieldData{
+				Type:      src.GetType(),
+				FieldName: src.GetFieldName(),
+				FieldId:   src.GetFieldId(),
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestVectorsToPlaceholderGroup(t *testing.T) {
+	pkData := &schemapb.FieldData{
+		Type:      schemapb.DataType_Int64,
+		FieldName: "pk",
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{10, 20}}},
+		}},
+	}
+	floatData := &schemapb.FieldData{
+		Type:      schemapb.DataType_FloatVector,
+		FieldName: "vec",
+		Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  2,
+			Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{1, 2, 3, 4}}},
+		}},
+	}
+
+	group, err := vectorsToPlaceholderGroup([]*schemapb.FieldData{pkData, floatData}, "pk", "vec", []int64{20, 10})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(group.Placeholders))
+	assert.Equal(t, "$0", group.Placeholders[0].Tag)
+	assert.Equal(t, milvuspb.PlaceholderType_FloatVector, group.Placeholders[0].Type)
+	assert.Equal(t, 2, len(group.Placeholders[0].Values))
+	assert.Equal(t, float32(3), typeutil.BytesToFloat32(group.Placeholders[0].Values[0][0:4]))
+	assert.Equal(t, float32(2), typeutil.BytesToFloat32(group.Placeholders[0].Values[1][4:8]))
+
+	_, err = vectorsToPlaceholderGroup([]*schemapb.FieldData{pkData, floatData}, "pk", "vec", []int64{30})
+	assert.Error(t, err)
+
+	_, err = vectorsToPlaceholderGroup([]*schemapb.FieldData{pkData}, "pk", "vec", []int64{10})
+	assert.Error(t, err)
+
+	binaryData := &schemapb.FieldData{
+		Type:      schemapb.DataType_BinaryVector,
+		FieldName: "vec",
+		Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  16,
+			Data: &schemapb.VectorField_BinaryVector{BinaryVector: []byte{1, 2, 3, 4}},
+		}},
+	}
+	group, err = vectorsToPlaceholderGroup([]*schemapb.FieldData{pkData, binaryData}, "pk", "vec", []int64{20})
+	assert.NoError(t, err)
+	assert.Equal(t, milvuspb.PlaceholderType_BinaryVector, group.Placeholders[0].Type)
+	assert.Equal(t, [][]byte{{3, 4}}, group.Placeholders[0].Values)
+
+	_, err = vectorsToPlaceholderGroup([]*schemapb.FieldData{pkData, pkData}, "pk", "pk", []int64{10})
+	assert.Error(t, err)
+}
+
+func TestGetSearchByIDsPkField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{Name: "sparse", DataType: schemapb.DataType_SparseFloatVector},
+		},
+	}
+
+	pkField, err := getSearchByIDsPkField(schema, "vec")
+	assert.NoError(t, err)
+	assert.Equal(t, "pk", pkField)
+
+	_, err = getSearchByIDsPkField(schema, "sparse")
+	assert.Error(t, err)
+}
+
+func TestExcludeSeedResults(t *testing.T) {
+	result := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Scores:     []float32{0, 0.1, 0.2, 0, 0.3},
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{
+			Data: []int64{1, 5, 6, 2, 7},
+		}}},
+		Topks: []int64{3, 2},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "age",
+				FieldId:   101,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{10, 50, 60, 20, 70}}},
+				}},
+			},
+		},
+	}
+
+	ret := excludeSeedResults(result, []int64{1, 2}, 2)
+	assert.Equal(t, int64(2), ret.TopK)
+	assert.Equal(t, []int64{2, 1}, ret.Topks)
+	assert.Equal(t, []int64{5, 6, 7}, ret.Ids.GetIntId().GetData())
+	assert.Equal(t, []float32{0.1, 0.2, 0.3}, ret.Scores)
+	assert.Equal(t, []int64{50, 60, 70}, ret.FieldsData[0].GetScalars().GetLongData().GetData())
+
+	// the seed is not always in the results, then the extra one is trimmed
+	ret = excludeSeedResults(result, []int64{100, 100}, 2)
+	assert.Equal(t, []int64{2, 2}, ret.Topks)
+	assert.Equal(t, []int64{1, 5, 2, 7}, ret.Ids.GetIntId().GetData())
+
+	ret = excludeSeedResults(result, []int64{1, 2}, 0)
+	assert.Equal(t, []int64{0, 0}, ret.Topks)
+	assert.Equal(t, 1, len(ret.FieldsData))
+	assert.Equal(t, "age", ret.FieldsData[0].FieldName)
+}
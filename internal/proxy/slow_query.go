@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// slowQueryRecorder keeps the recent slow queries in a ring buffer, the oldest one is dropped when it's full.
+type slowQueryRecorder struct {
+	mu       sync.Mutex
+	queries  []metricsinfo.SlowQuery
+	next     int
+	capacity int
+}
+
+func newSlowQueryRecorder(capacity int) *slowQueryRecorder {
+	return &slowQueryRecorder{
+		queries:  make([]metricsinfo.SlowQuery, 0, capacity),
+		capacity: capacity,
+	}
+}
+
+func (r *slowQueryRecorder) add(query metricsinfo.SlowQuery) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.capacity <= 0 {
+		return
+	}
+	if len(r.queries) < r.capacity {
+		r.queries = append(r.queries, query)
+		return
+	}
+	r.queries[r.next] = query
+	r.next = (r.next + 1) % r.capacity
+}
+
+// list returns the recorded slow queries, the oldest first.
+func (r *slowQueryRecorder) list() []metricsinfo.SlowQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]metricsinfo.SlowQuery, 0, len(r.queries))
+	ret = append(ret, r.queries[r.next:]...)
+	ret = append(ret, r.queries[:r.next]...)
+	return ret
+}
+
+// recordSlowSearch logs and records the search task if it took longer than proxy.slowQuery.threshold.
+func (node *Proxy) recordSlowSearch(st *searchTask, traceID string, start time.Time) {
+	duration := time.Since(start)
+	if node.slowQueries == nil || Params.SlowQueryThreshold <= 0 || duration < Params.SlowQueryThreshold {
+		return
+	}
+
+	query := metricsinfo.SlowQuery{
+		TraceID:            traceID,
+		MsgID:              st.ID(),
+		Collection:         st.query.GetCollectionName(),
+		Partitions:         st.query.GetPartitionNames(),
+		Expr:               st.query.GetDsl(),
+		NQ:                 st.result.GetResults().GetNumQueries(),
+		TopK:               st.result.GetResults().GetTopK(),
+		GuaranteeTimestamp: st.SearchRequest.GetGuaranteeTimestamp(),
+		StartTime:          start.Format("2006-01-02 15:04:05.000"),
+		Duration:           duration.Milliseconds(),
+		ReduceDuration:     st.reduceDuration.Milliseconds(),
+		QueryNodes:         make([]metricsinfo.QueryNodeSearchCost, 0, len(st.searchCosts)),
+	}
+	for _, cost := range st.searchCosts {
+		query.QueryNodes = append(query.QueryNodes, metricsinfo.QueryNodeSearchCost{
+			NodeID:              cost.GetNodeID(),
+			WaitTSafeDuration:   cost.GetWaitTsafeDuration(),
+			SearchDuration:      cost.GetSearchDuration(),
+			ReduceDuration:      cost.GetReduceDuration(),
+			NumSegmentsSearched: cost.GetNumSegmentsSearched(),
+		})
+	}
+	node.slowQueries.add(query)
+	metrics.ProxySlowSearchCounter.Inc()
+
+	log.Warn("slow search",
+		zap.String("traceID", traceID),
+		zap.Int64("msgID", query.MsgID),
+		zap.String("collection", query.Collection),
+		zap.Strings("partitions", query.Partitions),
+		zap.String("expr", query.Expr),
+		zap.Int64("nq", query.NQ),
+		zap.Int64("topK", query.TopK),
+		zap.Uint64("guaranteeTimestamp", query.GuaranteeTimestamp),
+		zap.Duration("duration", duration),
+		zap.Duration("reduce", st.reduceDuration),
+		zap.Any("queryNodes", query.QueryNodes))
+}
+
+// getSlowQueryMetrics returns the recent slow queries of proxy.
+func getSlowQueryMetrics(node *Proxy) (*milvuspb.GetMetricsResponse, error) {
+	slowQueries := metricsinfo.SlowQueries{
+		Name:      metricsinfo.ConstructComponentName(typeutil.ProxyRole, Params.ProxyID),
+		Threshold: Params.SlowQueryThreshold.Milliseconds(),
+		Queries:   make([]metricsinfo.SlowQuery, 0),
+	}
+	if node.slowQueries != nil {
+		slowQueries.Queries = node.slowQueries.list()
+	}
+
+	resp, err := metricsinfo.MarshalComponentInfos(slowQueries)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			Response:      "",
+			ComponentName: slowQueries.Name,
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      resp,
+		ComponentName: slowQueries.Name,
+	}, nil
+}
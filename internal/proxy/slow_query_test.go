@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestSlowQueryRecorder(t *testing.T) {
+	recorder := newSlowQueryRecorder(3)
+	assert.Equal(t, 0, len(recorder.list()))
+
+	for i := 0; i < 5; i++ {
+		recorder.add(metricsinfo.SlowQuery{MsgID: int64(i)})
+	}
+	queries := recorder.list()
+	assert.Equal(t, 3, len(queries))
+	for i, query := range queries {
+		assert.Equal(t, int64(i+2), query.MsgID)
+	}
+
+	recorder = newSlowQueryRecorder(0)
+	recorder.add(metricsinfo.SlowQuery{})
+	assert.Equal(t, 0, len(recorder.list()))
+}
+
+func TestProxy_recordSlowSearch(t *testing.T) {
+	Params.Init()
+	node := &Proxy{slowQueries: newSlowQueryRecorder(Params.SlowQueryMaxRecords)}
+
+	st := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{
+			Base:               &commonpb.MsgBase{MsgID: 100},
+			GuaranteeTimestamp: 1000,
+		},
+		query: &milvuspb.SearchRequest{
+			CollectionName: "collection",
+			PartitionNames: []string{"partition"},
+			Dsl:            "age > 10",
+		},
+		result: &milvuspb.SearchResults{
+			Results: &schemapb.SearchResultData{NumQueries: 10, TopK: 5},
+		},
+		searchCosts: []*internalpb.SearchCost{
+			{NodeID: 1, WaitTsafeDuration: 3000, SearchDuration: 20, ReduceDuration: 1, NumSegmentsSearched: 4},
+			{NodeID: 2, WaitTsafeDuration: 0, SearchDuration: 30, ReduceDuration: 2, NumSegmentsSearched: 5},
+		},
+		reduceDuration: 5 * time.Millisecond,
+	}
+
+	// fast searches are not recorded
+	node.recordSlowSearch(st, "traceID", time.Now())
+	assert.Equal(t, 0, len(node.slowQueries.list()))
+
+	node.recordSlowSearch(st, "traceID", time.Now().Add(-Params.SlowQueryThreshold))
+	queries := node.slowQueries.list()
+	assert.Equal(t, 1, len(queries))
+	assert.Equal(t, "traceID", queries[0].TraceID)
+	assert.Equal(t, int64(100), queries[0].MsgID)
+	assert.Equal(t, "age > 10", queries[0].Expr)
+	assert.Equal(t, int64(10), queries[0].NQ)
+	assert.Equal(t, int64(5), queries[0].TopK)
+	assert.Equal(t, uint64(1000), queries[0].GuaranteeTimestamp)
+	assert.Equal(t, int64(5), queries[0].ReduceDuration)
+	assert.GreaterOrEqual(t, queries[0].Duration, Params.SlowQueryThreshold.Milliseconds())
+	assert.Equal(t, 2, len(queries[0].QueryNodes))
+	assert.Equal(t, int64(3000), queries[0].QueryNodes[0].WaitTSafeDuration)
+	assert.Equal(t, int64(5), queries[0].QueryNodes[1].NumSegmentsSearched)
+
+	// the failed searches have no result
+	st.result = nil
+	node.recordSlowSearch(st, "traceID", time.Now().Add(-Params.SlowQueryThreshold))
+	assert.Equal(t, 2, len(node.slowQueries.list()))
+
+	resp, err := getSlowQueryMetrics(node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	var slowQueries metricsinfo.SlowQueries
+	err = metricsinfo.UnmarshalComponentInfos(resp.Response, &slowQueries)
+	assert.NoError(t, err)
+	assert.Equal(t, Params.SlowQueryThreshold.Milliseconds(), slowQueries.Threshold)
+	assert.Equal(t, node.slowQueries.list(), slowQueries.Queries)
+
+	resp, err = getSlowQueryMetrics(&Proxy{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.Equal(t, fmt.Sprintf("{\"name\":\"%s\",\"threshold\":%d,\"queries\":[]}", slowQueries.Name, slowQueries.Threshold), resp.Response)
+}
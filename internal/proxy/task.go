@@ -28,8 +28,11 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unsafe"
 
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
@@ -66,6 +69,7 @@ const (
 	MetricTypeKey                   = "metric_type"
 	SearchParamsKey                 = "params"
 	RoundDecimalKey                 = "round_decimal"
+	IndexNameKey                    = "index_name"
 	HasCollectionTaskName           = "HasCollectionTask"
 	DescribeCollectionTaskName      = "DescribeCollectionTask"
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
@@ -89,6 +93,9 @@ const (
 	CreateAliasTaskName             = "CreateAliasTask"
 	DropAliasTaskName               = "DropAliasTask"
 	AlterAliasTaskName              = "AlterAliasTask"
+	RenameCollectionTaskName        = "RenameCollectionTask"
+	RecoverCollectionTaskName       = "RecoverCollectionTask"
+	RecoverPartitionTaskName        = "RecoverPartitionTask"
 
 	minFloat32 = -1 * float32(math.MaxFloat32)
 )
@@ -239,6 +246,17 @@ func getNumRowsOfBinaryVectorField(bDatas []byte, dim int64) (uint32, error) {
 	return uint32(int((8 * int64(l)) / dim)), nil
 }
 
+func getNumRowsOfHalfFloatVectorField(bDatas []byte, dim int64) (uint32, error) {
+	if dim <= 0 {
+		return 0, errDimLessThanOrEqualToZero(int(dim))
+	}
+	l := len(bDatas)
+	if int64(l)%(2*dim) != 0 {
+		return 0, fmt.Errorf("the length(%d) of half float data should divide twice the dim(%d)", l, dim)
+	}
+	return uint32(int(int64(l) / (2 * dim))), nil
+}
+
 func (it *insertTask) checkLengthOfFieldsData() error {
 	neededFieldsNum := 0
 	for _, field := range it.schema.Fields {
@@ -325,6 +343,33 @@ func (it *insertTask) checkRowNums() error {
 				if fieldNumRows != rowNums {
 					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
 				}
+			case *schemapb.VectorField_Float16Vector:
+				dim := vectorField.GetDim()
+				fieldNumRows, err := getNumRowsOfHalfFloatVectorField(vectorField.GetFloat16Vector(), dim)
+				if err != nil {
+					return err
+				}
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				dim := vectorField.GetDim()
+				fieldNumRows, err := getNumRowsOfHalfFloatVectorField(vectorField.GetBfloat16Vector(), dim)
+				if err != nil {
+					return err
+				}
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				contents := vectorField.GetSparseFloatVector().GetContents()
+				fieldNumRows := uint32(len(contents))
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+				if err := typeutil.ValidateSparseFloatRows(contents...); err != nil {
+					return err
+				}
 			case nil:
 				continue
 			default:
@@ -408,6 +453,39 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 		return nil
 	}
 
+	appendHalfFloatVectorField := func(bDatas []byte, dim int64) error {
+		l := len(bDatas)
+		if dim <= 0 || int64(l)%(2*dim) != 0 {
+			return errors.New("invalid vectors")
+		}
+		r := int64(l) / (2 * dim)
+		if rowNum != 0 && rowNum != int(r) {
+			return errors.New("the row num of different column is not equal")
+		}
+		rowNum = int(r)
+		datas = append(datas, make([]interface{}, 0, rowNum))
+		idx := len(datas) - 1
+		for i := int64(0); i < r; i++ {
+			datas[idx] = append(datas[idx], bDatas[i*2*dim:(i+1)*2*dim])
+		}
+
+		return nil
+	}
+
+	appendSparseFloatVectorField := func(contents [][]byte) error {
+		if rowNum != 0 && rowNum != len(contents) {
+			return errors.New("the row num of different column is not equal")
+		}
+		rowNum = len(contents)
+		datas = append(datas, make([]interface{}, 0, rowNum))
+		idx := len(datas) - 1
+		for _, row := range contents {
+			datas[idx] = append(datas[idx], row)
+		}
+
+		return nil
+	}
+
 	for _, field := range it.req.FieldsData {
 		switch field.Field.(type) {
 		case *schemapb.FieldData_Scalars:
@@ -474,6 +552,21 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 				if err != nil {
 					return err
 				}
+			case *schemapb.VectorField_Float16Vector:
+				err := appendHalfFloatVectorField(vectorField.GetFloat16Vector(), vectorField.GetDim())
+				if err != nil {
+					return err
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				err := appendHalfFloatVectorField(vectorField.GetBfloat16Vector(), vectorField.GetDim())
+				if err != nil {
+					return err
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				err := appendSparseFloatVectorField(vectorField.GetSparseFloatVector().GetContents())
+				if err != nil {
+					return err
+				}
 			case nil:
 				continue
 			default:
@@ -564,6 +657,18 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 					log.Warn("ConvertData", zap.Error(err))
 				}
 				blob.Value = append(blob.Value, buffer.Bytes()...)
+			case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+				// half float vectors are already encoded in little endian
+				blob.Value = append(blob.Value, datas[j][i].([]byte)...)
+			case schemapb.DataType_SparseFloatVector:
+				// sparse rows are variable-length, prefix them with the number of non-zero elements
+				d := datas[j][i].([]byte)
+				err := binary.Write(&buffer, endian, uint32(typeutil.SparseFloatRowElementCount(d)))
+				if err != nil {
+					log.Warn("ConvertData", zap.Error(err))
+				}
+				blob.Value = append(blob.Value, buffer.Bytes()...)
+				blob.Value = append(blob.Value, d...)
 			default:
 				log.Warn("unsupported data type")
 			}
@@ -715,7 +820,7 @@ func (it *insertTask) HashPK(pks []int64) {
 
 func (it *insertTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-PreExecute")
-	defer sp.Finish()
+	defer sp.End()
 	it.Base.MsgType = commonpb.MsgType_Insert
 	it.Base.SourceID = Params.ProxyID
 
@@ -734,11 +839,6 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	partitionTag := it.BaseInsertTask.PartitionName
-	if err := validatePartitionTag(partitionTag, true); err != nil {
-		return err
-	}
-
 	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
 	log.Debug("Proxy Insert PreExecute", zap.Any("collSchema", collSchema))
 	if err != nil {
@@ -746,6 +846,16 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 	it.schema = collSchema
 
+	// rows of collections with the partition key are routed to partitions by their keys
+	partitionTag := it.BaseInsertTask.PartitionName
+	if typeutil.GetPartitionKeyFieldSchema(collSchema) != nil {
+		if partitionTag != "" {
+			return fmt.Errorf("partition name can't be specified when collection %s has the partition key", collectionName)
+		}
+	} else if err := validatePartitionTag(partitionTag, true); err != nil {
+		return err
+	}
+
 	err = it.checkRowNums()
 	if err != nil {
 		return err
@@ -789,6 +899,8 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 	}
 	log.Debug("_assignSemgentID, produceChannels:", zap.Any("Channels", channelNames))
 
+	// segments are allocated in the partition of the msgs
+	partitionID := it.PartitionID
 	for i, request := range tsMsgs {
 		if request.Type() != commonpb.MsgType_Insert {
 			return nil, fmt.Errorf("msg's must be Insert")
@@ -797,6 +909,11 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		if !ok {
 			return nil, fmt.Errorf("msg's must be Insert")
 		}
+		if i == 0 {
+			partitionID = insertRequest.PartitionID
+		} else if insertRequest.PartitionID != partitionID {
+			return nil, fmt.Errorf("msg's must be in the same partition")
+		}
 
 		keys := hashKeys[i]
 		timestampLen := len(insertRequest.Timestamps)
@@ -830,7 +947,7 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		if channelName == "" {
 			return nil, fmt.Errorf("proxy, repack_func, can not found channelName")
 		}
-		mapInfo, err := it.segIDAssigner.GetSegmentID(it.CollectionID, it.PartitionID, channelName, count, ts)
+		mapInfo, err := it.segIDAssigner.GetSegmentID(it.CollectionID, partitionID, channelName, count, ts)
 		if err != nil {
 			log.Debug("insertTask.go", zap.Any("MapInfo", mapInfo),
 				zap.Error(err))
@@ -983,36 +1100,36 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 
 func (it *insertTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-Execute")
-	defer sp.Finish()
+	defer sp.End()
 	collectionName := it.BaseInsertTask.CollectionName
 	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
-	var partitionID UniqueID
-	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
-		if err != nil {
-			return err
+
+	it.BaseMsg.Ctx = ctx
+	insertMsgs := []*msgstream.InsertMsg{&it.BaseInsertTask}
+	if keyField := typeutil.GetPartitionKeyFieldSchema(it.schema); keyField == nil {
+		var partitionID UniqueID
+		if len(it.PartitionName) > 0 {
+			partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
+			if err != nil {
+				return err
+			}
+		} else {
+			partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.DefaultPartitionName)
+			if err != nil {
+				return err
+			}
 		}
+		it.PartitionID = partitionID
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.DefaultPartitionName)
+		insertMsgs, err = it.splitByPartitionKey(ctx, keyField)
 		if err != nil {
 			return err
 		}
 	}
-	it.PartitionID = partitionID
-
-	var tsMsg msgstream.TsMsg = &it.BaseInsertTask
-	it.BaseMsg.Ctx = ctx
-	msgPack := msgstream.MsgPack{
-		BeginTs: it.BeginTs(),
-		EndTs:   it.EndTs(),
-		Msgs:    make([]msgstream.TsMsg, 1),
-	}
-
-	msgPack.Msgs[0] = tsMsg
 
 	stream, err := it.chMgr.getDMLStream(collID)
 	if err != nil {
@@ -1030,11 +1147,22 @@ func (it *insertTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	// Assign SegmentID
-	var pack *msgstream.MsgPack
-	pack, err = it._assignSegmentID(stream, &msgPack)
-	if err != nil {
-		return err
+	// Assign SegmentID, segments are allocated per partition
+	pack := &msgstream.MsgPack{
+		BeginTs: it.BeginTs(),
+		EndTs:   it.EndTs(),
+	}
+	for _, insertMsg := range insertMsgs {
+		msgPack := msgstream.MsgPack{
+			BeginTs: it.BeginTs(),
+			EndTs:   it.EndTs(),
+			Msgs:    []msgstream.TsMsg{insertMsg},
+		}
+		partitionPack, err := it._assignSegmentID(stream, &msgPack)
+		if err != nil {
+			return err
+		}
+		pack.Msgs = append(pack.Msgs, partitionPack.Msgs...)
 	}
 
 	err = stream.Produce(pack)
@@ -1144,7 +1272,8 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		if err := validateFieldName(field.Name); err != nil {
 			return err
 		}
-		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
+		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector ||
+			typeutil.IsHalfFloatVectorType(field.DataType) {
 			exist := false
 			var dim int64 = 0
 			for _, param := range field.TypeParams {
@@ -1161,7 +1290,7 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 			if !exist {
 				return errors.New("dimension is not defined in field type params, check type param `dim` for vector field")
 			}
-			if field.DataType == schemapb.DataType_FloatVector {
+			if field.DataType != schemapb.DataType_BinaryVector {
 				if err := validateDimension(dim, false); err != nil {
 					return err
 				}
@@ -1171,12 +1300,29 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 				}
 			}
 		}
+		if field.DataType == schemapb.DataType_SparseFloatVector {
+			if err := validateSparseFloatVectorField(field); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err := validateMultipleVectorFields(cct.schema); err != nil {
 		return err
 	}
 
+	if err := validateClusteringKey(cct.schema); err != nil {
+		return err
+	}
+
+	if err := validatePartitionKey(cct.schema); err != nil {
+		return err
+	}
+
+	if cct.NumPartitions < 0 {
+		return fmt.Errorf("the number of partitions should not be negative, num_partitions = %d", cct.NumPartitions)
+	}
+
 	return nil
 }
 
@@ -1289,7 +1435,7 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 		if field.IsPrimaryKey {
 			primaryFieldName = field.Name
 		}
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsVectorType(field.DataType) {
 			vectorFieldNameMap[field.Name] = true
 		} else {
 			scalarFieldNameMap[field.Name] = true
@@ -1330,6 +1476,15 @@ type searchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+
+	// where the time was spent, for the slow query log
+	searchCosts    []*internalpb.SearchCost
+	reduceDuration time.Duration
+
+	// the seed entity of each query to exclude from its results when searching by ids,
+	// one more result is searched for each query and the results are trimmed to seedTopK
+	excludeSeeds []int64
+	seedTopK     int64
 }
 
 func (st *searchTask) TraceCtx() context.Context {
@@ -1409,9 +1564,22 @@ func (st *searchTask) getVChannels() ([]vChan, error) {
 	return channels, nil
 }
 
+// checkIndexName makes sure the index named indexName is built on the anns field,
+// a missing index is an error rather than searching with the default one
+func (st *searchTask) checkIndexName(ctx context.Context, annsField string, indexName string) error {
+	fieldName, err := globalMetaCache.GetIndexFieldName(ctx, st.query.CollectionName, indexName)
+	if err != nil {
+		return err
+	}
+	if fieldName != annsField {
+		return fmt.Errorf("index %s not found on field %s", indexName, annsField)
+	}
+	return nil
+}
+
 func (st *searchTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(st.TraceCtx(), "Proxy-Search-PreExecute")
-	defer sp.Finish()
+	defer sp.End()
 	st.Base.MsgType = commonpb.MsgType_Search
 	st.Base.SourceID = Params.ProxyID
 
@@ -1473,9 +1641,19 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if err = validateSparseOutputFields(schema, outputFields); err != nil {
+		return err
+	}
 	log.Debug("translate output fields", zap.Any("OutputFields", outputFields))
 	st.query.OutputFields = outputFields
 
+	// partitions of collections with the partition key are pruned by the predicates of the plan
+	keyField := typeutil.GetPartitionKeyFieldSchema(schema)
+	if keyField != nil && len(st.query.PartitionNames) > 0 {
+		return fmt.Errorf("partition names can't be specified when collection %s has the partition key", collectionName)
+	}
+	var predicates *planpb.Expr
+
 	if st.query.GetDslType() == commonpb.DslType_BoolExprV1 {
 		annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, st.query.SearchParams)
 		if err != nil {
@@ -1513,6 +1691,15 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(RoundDecimalKey + " " + roundDecimalStr + " is not invalid")
 		}
 
+		// search with a particular index of the anns field, the default one is used if it's not specified
+		indexName, err := funcutil.GetAttrByKeyFromRepeatedKV(IndexNameKey, st.query.SearchParams)
+		if err == nil && indexName != "" {
+			if err := st.checkIndexName(ctx, annsField, indexName); err != nil {
+				return err
+			}
+			st.SearchRequest.IndexName = indexName
+		}
+
 		queryInfo := &planpb.QueryInfo{
 			Topk:         int64(topK),
 			MetricType:   metricType,
@@ -1537,11 +1724,15 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 
 			return fmt.Errorf("failed to create query plan: %v", err)
 		}
+		if err := validateSparseSearch(schema, annsField, plan, st.query.OutputFields); err != nil {
+			return err
+		}
+		predicates = plan.GetVectorAnns().GetPredicates()
 		for _, name := range st.query.OutputFields {
 			hitField := false
 			for _, field := range schema.Fields {
 				if field.Name == name {
-					if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+					if typeutil.IsVectorType(field.DataType) {
 						return errors.New("search doesn't support vector field as output_fields")
 					}
 
@@ -1609,6 +1800,15 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	prunedPartitionIDs, err := getPartitionKeyPrunedPartitionIDs(ctx, collectionName, keyField, predicates)
+	if err != nil {
+		return err
+	}
+	if len(prunedPartitionIDs) > 0 {
+		st.PartitionIDs = prunedPartitionIDs
+		log.Debug("search partitions pruned by partition key", zap.Int64s("partitionIDs", prunedPartitionIDs))
+	}
+
 	st.SearchRequest.Dsl = st.query.Dsl
 	st.SearchRequest.PlaceholderGroup = st.query.PlaceholderGroup
 
@@ -1617,7 +1817,7 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 
 func (st *searchTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(st.TraceCtx(), "Proxy-Search-Execute")
-	defer sp.Finish()
+	defer sp.End()
 	var tsMsg msgstream.TsMsg = &msgstream.SearchMsg{
 		SearchRequest: *st.SearchRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -1859,7 +2059,7 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 
 func (st *searchTask) PostExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(st.TraceCtx(), "Proxy-Search-PostExecute")
-	defer sp.Finish()
+	defer sp.End()
 	tr := timerecord.NewTimeRecorder("searchTask PostExecute")
 	defer func() {
 		tr.Elapse("done")
@@ -1874,6 +2074,9 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 			filterSearchResults := make([]*internalpb.SearchResults, 0)
 			var filterReason string
 			for _, partialSearchResult := range searchResults {
+				if partialSearchResult.GetCost() != nil {
+					st.searchCosts = append(st.searchCosts, partialSearchResult.GetCost())
+				}
 				if partialSearchResult.Status.ErrorCode == commonpb.ErrorCode_Success {
 					filterSearchResults = append(filterSearchResults, partialSearchResult)
 					// For debugging, please don't delete.
@@ -1919,10 +2122,21 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 				return nil
 			}
 
+			tr.Record("decode search results done")
+			reduceSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Search-Reduce", oteltrace.WithAttributes(
+				attribute.Int("results", len(validSearchResults)),
+				attribute.Int64("nq", searchResults[0].NumQueries),
+				attribute.Int64("topK", searchResults[0].TopK)))
 			st.result, err = reduceSearchResultData(validSearchResults, searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType)
+			st.reduceDuration = tr.Record("reduce search result done")
+			trace.LogError(reduceSp, err)
+			reduceSp.End()
 			if err != nil {
 				return err
 			}
+			if len(st.excludeSeeds) > 0 {
+				st.result.Results = excludeSeedResults(st.result.Results, st.excludeSeeds, st.seedTopK)
+			}
 
 			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
 			if err != nil {
@@ -2031,6 +2245,15 @@ func (qt *queryTask) getVChannels() ([]vChan, error) {
 	return channels, nil
 }
 
+// getUsedVChannels returns the vchannels the results are collected from, a query by primary keys
+// only uses the dml channels owning the keys.
+func (qt *queryTask) getUsedVChannels() ([]vChan, error) {
+	if len(qt.DmlChannels) > 0 {
+		return qt.DmlChannels, nil
+	}
+	return qt.getVChannels()
+}
+
 func IDs2Expr(fieldName string, ids []int64) string {
 	idsStr := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids)), ", "), "[]")
 	return fieldName + " in [ " + idsStr + " ]"
@@ -2102,6 +2325,12 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 
 	schema, _ := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
 
+	// partitions of collections with the partition key are pruned by the predicates of the plan
+	keyField := typeutil.GetPartitionKeyFieldSchema(schema)
+	if keyField != nil && len(qt.query.PartitionNames) > 0 {
+		return fmt.Errorf("partition names can't be specified when collection %s has the partition key", collectionName)
+	}
+
 	if qt.ids != nil {
 		pkField := ""
 		for _, field := range schema.Fields {
@@ -2109,7 +2338,28 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 				pkField = field.Name
 			}
 		}
-		qt.query.Expr = IDs2Expr(pkField, qt.ids.GetIntId().Data)
+		pks := qt.ids.GetIntId().GetData()
+		if len(pks) == 0 {
+			return errors.New("primary keys are empty")
+		}
+		qt.query.Expr = IDs2Expr(pkField, pks)
+
+		// only the dml channels owning the primary keys need to be retrieved
+		vchans, err := qt.getVChannels()
+		if err != nil {
+			return err
+		}
+		qt.Ids = qt.ids
+		qt.DmlChannels = make([]string, 0)
+		used := make(map[int32]struct{})
+		for _, idx := range pkChannelIndexes(pks, len(vchans)) {
+			if _, ok := used[idx]; !ok {
+				used[idx] = struct{}{}
+				qt.DmlChannels = append(qt.DmlChannels, vchans[idx])
+			}
+		}
+		log.Debug("Query by primary keys", zap.Int("pks", len(pks)), zap.Strings("dmlChannels", qt.DmlChannels),
+			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 	}
 
 	if qt.query.Expr == "" {
@@ -2125,10 +2375,13 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if err = validateSparseOutputFields(schema, qt.query.OutputFields); err != nil {
+		return err
+	}
 	log.Debug("translate output fields", zap.Any("OutputFields", qt.query.OutputFields))
 	if len(qt.query.OutputFields) == 0 {
 		for _, field := range schema.Fields {
-			if field.FieldID >= 100 && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
+			if field.FieldID >= 100 && !typeutil.IsVectorType(field.DataType) {
 				qt.OutputFieldsId = append(qt.OutputFieldsId, field.FieldID)
 			}
 		}
@@ -2217,6 +2470,16 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	prunedPartitionIDs, err := getPartitionKeyPrunedPartitionIDs(ctx, collectionName, keyField, plan.GetPredicates())
+	if err != nil {
+		return err
+	}
+	if len(prunedPartitionIDs) > 0 {
+		qt.PartitionIDs = prunedPartitionIDs
+		log.Debug("query partitions pruned by partition key", zap.Int64s("partitionIDs", prunedPartitionIDs),
+			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
+	}
+
 	log.Info("Query PreExecute done.",
 		zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 	return nil
@@ -3206,6 +3469,26 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		return errors.New(respFromRootCoord.Status.Reason)
 	}
 
+	partitionKey, err := hasPartitionKey(ctx, spt.CollectionName)
+	if err != nil {
+		return err
+	}
+	if partitionKey {
+		if spt.GetType() == milvuspb.ShowType_InMemory && len(spt.PartitionNames) > 0 {
+			return fmt.Errorf("partition names can't be specified when collection %s has the partition key", spt.CollectionName)
+		}
+		// the partitions created for the partition key are hidden
+		spt.result = &milvuspb.ShowPartitionsResponse{
+			Status:               respFromRootCoord.Status,
+			PartitionNames:       []string{},
+			PartitionIDs:         []int64{},
+			CreatedTimestamps:    []uint64{},
+			CreatedUtcTimestamps: []uint64{},
+			InMemoryPercentages:  []int64{},
+		}
+		return nil
+	}
+
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
 		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
@@ -3351,6 +3634,12 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if cit.IndexName != "" {
+		if err := validateIndexName(cit.IndexName); err != nil {
+			return err
+		}
+	}
+
 	// check index param, not accurate, only some static rules
 	indexParams := make(map[string]string)
 	for _, kv := range cit.CreateIndexRequest.ExtraParams {
@@ -3371,8 +3660,38 @@ func (cit *createIndexTask) PreExecute(ctx context.Context) error {
 	}
 
 	indexType, exist := indexParams["index_type"] // TODO(dragondriver): change `index_type` to const variable
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, collName)
+	if err != nil {
+		return err
+	}
+	var field *schemapb.FieldSchema
+	for _, f := range schema.Fields {
+		if f.Name == fieldName {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return fmt.Errorf("field %s not found in collection %s", fieldName, collName)
+	}
+	isVectorField := field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector ||
+		typeutil.IsHalfFloatVectorType(field.DataType)
+
 	if !exist {
-		indexType = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
+		if isVectorField {
+			indexType = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
+		} else {
+			// SORT is the default index type of scalar fields, pass it on since the index builder can't guess it
+			indexType = indexparamcheck.IndexScalarSort
+			indexParams["index_type"] = indexType
+			cit.CreateIndexRequest.ExtraParams = append(cit.CreateIndexRequest.ExtraParams,
+				&commonpb.KeyValuePair{Key: "index_type", Value: indexType})
+		}
+	}
+
+	if err := validateIndexFieldType(field, indexType); err != nil {
+		return err
 	}
 
 	adapter, err := indexparamcheck.GetConfAdapterMgrInstance().GetAdapter(indexType)
@@ -3538,8 +3857,11 @@ func (dit *dropIndexTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	if err := validateFieldName(fieldName); err != nil {
-		return err
+	// the field can be omitted when dropping a named index
+	if fieldName != "" || dit.IndexName == "" {
+		if err := validateFieldName(fieldName); err != nil {
+			return err
+		}
 	}
 
 	if dit.IndexName == "" {
@@ -3562,6 +3884,7 @@ func (dit *dropIndexTask) Execute(ctx context.Context) error {
 }
 
 func (dit *dropIndexTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveIndex(ctx, dit.CollectionName, dit.IndexName)
 	return nil
 }
 
@@ -3976,6 +4299,22 @@ func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 		return nil
 	}
 
+	// a failed build takes precedence over the unfinished ones, so that the failure reasons are not hidden
+	var failReasons []string
+	for _, state := range states.States {
+		if state.State == commonpb.IndexState_Failed {
+			failReasons = append(failReasons, fmt.Sprintf("index build %d failed: %s", state.IndexBuildID, state.Reason))
+		}
+	}
+	if len(failReasons) > 0 {
+		gist.result = &milvuspb.GetIndexStateResponse{
+			Status:     states.Status,
+			State:      commonpb.IndexState_Failed,
+			FailReason: strings.Join(failReasons, "; "),
+		}
+		return nil
+	}
+
 	for _, state := range states.States {
 		if state.State != commonpb.IndexState_Finished {
 			gist.result = &milvuspb.GetIndexStateResponse{
@@ -4335,6 +4674,9 @@ func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if typeutil.GetPartitionKeyFieldSchema(collSchema) != nil {
+		return fmt.Errorf("can't load partitions of collection %s which has a partition key", lpt.CollectionName)
+	}
 	for _, partitionName := range lpt.PartitionNames {
 		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
 		if err != nil {
@@ -4426,6 +4768,13 @@ func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	partitionKey, err := hasPartitionKey(ctx, rpt.CollectionName)
+	if err != nil {
+		return err
+	}
+	if partitionKey {
+		return fmt.Errorf("can't release partitions of collection %s which has a partition key", rpt.CollectionName)
+	}
 	for _, partitionName := range rpt.PartitionNames {
 		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
 		if err != nil {
@@ -4642,7 +4991,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 
 func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(dt.ctx, "Proxy-Delete-Execute")
-	defer sp.Finish()
+	defer sp.End()
 
 	var tsMsg msgstream.TsMsg = &dt.BaseDeleteTask
 	msgPack := msgstream.MsgPack{
@@ -4963,3 +5312,206 @@ func (a *AlterAliasTask) Execute(ctx context.Context) error {
 func (a *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+type renameCollectionTask struct {
+	Condition
+	*milvuspb.RenameCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (rct *renameCollectionTask) TraceCtx() context.Context {
+	return rct.ctx
+}
+
+func (rct *renameCollectionTask) ID() UniqueID {
+	return rct.Base.MsgID
+}
+
+func (rct *renameCollectionTask) SetID(uid UniqueID) {
+	rct.Base.MsgID = uid
+}
+
+func (rct *renameCollectionTask) Name() string {
+	return RenameCollectionTaskName
+}
+
+func (rct *renameCollectionTask) Type() commonpb.MsgType {
+	return rct.Base.MsgType
+}
+
+func (rct *renameCollectionTask) BeginTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *renameCollectionTask) EndTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *renameCollectionTask) SetTs(ts Timestamp) {
+	rct.Base.Timestamp = ts
+}
+
+func (rct *renameCollectionTask) OnEnqueue() error {
+	rct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (rct *renameCollectionTask) PreExecute(ctx context.Context) error {
+	rct.Base.MsgType = commonpb.MsgType_RenameCollection
+	rct.Base.SourceID = Params.ProxyID
+
+	if err := validateCollectionName(rct.OldName); err != nil {
+		return err
+	}
+	if err := validateCollectionName(rct.NewName); err != nil {
+		return err
+	}
+	if rct.OldName == rct.NewName {
+		return fmt.Errorf("the new collection name is the same as the old one, name = %s", rct.NewName)
+	}
+
+	return nil
+}
+
+func (rct *renameCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	rct.result, err = rct.rootCoord.RenameCollection(ctx, rct.RenameCollectionRequest)
+	return err
+}
+
+func (rct *renameCollectionTask) PostExecute(ctx context.Context) error {
+	// the old name no longer refers to the collection
+	globalMetaCache.RemoveCollection(ctx, rct.OldName)
+	return nil
+}
+
+type recoverCollectionTask struct {
+	Condition
+	*milvuspb.RecoverCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (rct *recoverCollectionTask) TraceCtx() context.Context {
+	return rct.ctx
+}
+
+func (rct *recoverCollectionTask) ID() UniqueID {
+	return rct.Base.MsgID
+}
+
+func (rct *recoverCollectionTask) SetID(uid UniqueID) {
+	rct.Base.MsgID = uid
+}
+
+func (rct *recoverCollectionTask) Name() string {
+	return RecoverCollectionTaskName
+}
+
+func (rct *recoverCollectionTask) Type() commonpb.MsgType {
+	return rct.Base.MsgType
+}
+
+func (rct *recoverCollectionTask) BeginTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *recoverCollectionTask) EndTs() Timestamp {
+	return rct.Base.Timestamp
+}
+
+func (rct *recoverCollectionTask) SetTs(ts Timestamp) {
+	rct.Base.Timestamp = ts
+}
+
+func (rct *recoverCollectionTask) OnEnqueue() error {
+	rct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (rct *recoverCollectionTask) PreExecute(ctx context.Context) error {
+	rct.Base.MsgType = commonpb.MsgType_RecoverCollection
+	rct.Base.SourceID = Params.ProxyID
+
+	return validateCollectionName(rct.CollectionName)
+}
+
+func (rct *recoverCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	rct.result, err = rct.rootCoord.RecoverCollection(ctx, rct.RecoverCollectionRequest)
+	return err
+}
+
+func (rct *recoverCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type recoverPartitionTask struct {
+	Condition
+	*milvuspb.RecoverPartitionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (rpt *recoverPartitionTask) TraceCtx() context.Context {
+	return rpt.ctx
+}
+
+func (rpt *recoverPartitionTask) ID() UniqueID {
+	return rpt.Base.MsgID
+}
+
+func (rpt *recoverPartitionTask) SetID(uid UniqueID) {
+	rpt.Base.MsgID = uid
+}
+
+func (rpt *recoverPartitionTask) Name() string {
+	return RecoverPartitionTaskName
+}
+
+func (rpt *recoverPartitionTask) Type() commonpb.MsgType {
+	return rpt.Base.MsgType
+}
+
+func (rpt *recoverPartitionTask) BeginTs() Timestamp {
+	return rpt.Base.Timestamp
+}
+
+func (rpt *recoverPartitionTask) EndTs() Timestamp {
+	return rpt.Base.Timestamp
+}
+
+func (rpt *recoverPartitionTask) SetTs(ts Timestamp) {
+	rpt.Base.Timestamp = ts
+}
+
+func (rpt *recoverPartitionTask) OnEnqueue() error {
+	rpt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (rpt *recoverPartitionTask) PreExecute(ctx context.Context) error {
+	rpt.Base.MsgType = commonpb.MsgType_RecoverPartition
+	rpt.Base.SourceID = Params.ProxyID
+
+	if err := validateCollectionName(rpt.CollectionName); err != nil {
+		return err
+	}
+	return validatePartitionTag(rpt.PartitionName, true)
+}
+
+func (rpt *recoverPartitionTask) Execute(ctx context.Context) error {
+	var err error
+	rpt.result, err = rpt.rootCoord.RecoverPartition(ctx, rpt.RecoverPartitionRequest)
+	return err
+}
+
+func (rpt *recoverPartitionTask) PostExecute(ctx context.Context) error {
+	// the cached partitions of the collection are out of date
+	globalMetaCache.RemoveCollection(ctx, rpt.CollectionName)
+	return nil
+}
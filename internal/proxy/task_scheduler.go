@@ -34,8 +34,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type taskQueue interface {
@@ -426,21 +426,20 @@ func (sched *taskScheduler) getTaskByReqID(collMeta UniqueID) task {
 
 func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.TraceCtx(),
-		opentracing.Tags{
-			"Type": t.Name(),
-			"ID":   t.ID(),
-		})
-	defer span.Finish()
+		oteltrace.WithAttributes(
+			attribute.String("Type", t.Name()),
+			attribute.Int64("ID", t.ID())))
+	defer span.End()
 	traceID, _, _ := trace.InfoFromSpan(span)
 
-	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
+	span.AddEvent("scheduler process AddActiveTask")
 	q.AddActiveTask(t)
 
 	defer func() {
-		span.LogFields(oplog.Int64("scheduler process PopActiveTask", t.ID()))
+		span.AddEvent("scheduler process PopActiveTask")
 		q.PopActiveTask(t.ID())
 	}()
-	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
+	span.AddEvent("scheduler process PreExecute")
 
 	err := t.PreExecute(ctx)
 
@@ -454,7 +453,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.Int64("scheduler process Execute", t.ID()))
+	span.AddEvent("scheduler process Execute")
 	err = t.Execute(ctx)
 	if err != nil {
 		trace.LogError(span, err)
@@ -463,7 +462,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.Int64("scheduler process PostExecute", t.ID()))
+	span.AddEvent("scheduler process PostExecute")
 	err = t.PostExecute(ctx)
 
 	if err != nil {
@@ -741,7 +740,7 @@ func (sched *taskScheduler) collectResultLoop() {
 						delete(searchResultBufs, reqID)
 					}
 
-					sp.Finish()
+					sp.End()
 				}
 				if queryResultMsg, rtOk := tsMsg.(*msgstream.RetrieveResultMsg); rtOk {
 					//reqID := retrieveResultMsg.Base.MsgID
@@ -806,7 +805,7 @@ func (sched *taskScheduler) collectResultLoop() {
 					resultBuf, ok := queryResultBufs[reqID]
 					if !ok {
 						resultBuf = newQueryResultBuf()
-						vchans, err := st.getVChannels()
+						vchans, err := st.getUsedVChannels()
 						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("vchans", vchans),
 							zap.Error(err))
 						if err != nil {
@@ -839,7 +838,7 @@ func (sched *taskScheduler) collectResultLoop() {
 						st.resultBuf <- resultBuf.resultBuf
 						delete(queryResultBufs, reqID)
 					}
-					sp.Finish()
+					sp.End()
 				}
 			}
 		case <-sched.ctx.Done():
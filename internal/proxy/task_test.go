@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 	"github.com/stretchr/testify/assert"
 )
@@ -379,6 +380,35 @@ func TestGetNumRowsOfBinaryVectorField(t *testing.T) {
 	}
 }
 
+func TestGetNumRowsOfHalfFloatVectorField(t *testing.T) {
+	cases := []struct {
+		bDatas   []byte
+		dim      int64
+		want     uint32
+		errIsNil bool
+	}{
+		{[]byte{}, -1, 0, false},       // dim <= 0
+		{[]byte{}, 0, 0, false},        // dim <= 0
+		{[]byte{1, 2}, 2, 0, false},    // length % (2*dim) != 0
+		{[]byte{1, 2, 3}, 1, 0, false}, // length % (2*dim) != 0
+		{[]byte{}, 128, 0, true},
+		{[]byte{1, 2, 3, 4}, 2, 1, true},
+		{[]byte{1, 2, 3, 4}, 1, 2, true},
+	}
+
+	for _, test := range cases {
+		got, err := getNumRowsOfHalfFloatVectorField(test.bDatas, test.dim)
+		if test.errIsNil {
+			assert.Equal(t, nil, err)
+			if got != test.want {
+				t.Errorf("getNumRowsOfHalfFloatVectorField(%v, %v) = %v, %v", test.bDatas, test.dim, test.want, nil)
+			}
+		} else {
+			assert.NotEqual(t, nil, err)
+		}
+	}
+}
+
 func TestInsertTask_checkLengthOfFieldsData(t *testing.T) {
 	var err error
 
@@ -500,6 +530,56 @@ func TestInsertTask_checkLengthOfFieldsData(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestInsertTask_SparseFloatVector(t *testing.T) {
+	rows := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1, 100}, []float32{0.5, 1}),
+		typeutil.CreateSparseFloatRow(nil, nil),
+	}
+	it := insertTask{
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Int64, "Int64", 2),
+				newSparseFloatVectorFieldData("SparseFloatVector", rows),
+			},
+		},
+		schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{DataType: schemapb.DataType_Int64},
+				{DataType: schemapb.DataType_SparseFloatVector},
+			},
+		},
+	}
+	err := it.checkRowNums()
+	assert.NoError(t, err)
+
+	err = it.transferColumnBasedRequestToRowBasedData()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(it.RowData))
+	// int64, number of non-zero elements, sparse row
+	assert.Equal(t, 8+4+16, len(it.RowData[0].Value))
+	assert.Equal(t, uint32(2), common.Endian.Uint32(it.RowData[0].Value[8:12]))
+	assert.Equal(t, rows[0], it.RowData[0].Value[12:])
+	assert.Equal(t, 8+4, len(it.RowData[1].Value))
+	assert.Equal(t, uint32(0), common.Endian.Uint32(it.RowData[1].Value[8:12]))
+
+	// row num mismatch
+	it.req.FieldsData[1] = newSparseFloatVectorFieldData("SparseFloatVector", rows[:1])
+	err = it.checkRowNums()
+	assert.Error(t, err)
+
+	// unsorted indices
+	it.req.FieldsData[1] = newSparseFloatVectorFieldData("SparseFloatVector", [][]byte{
+		rows[0],
+		typeutil.CreateSparseFloatRow([]uint32{5, 3}, []float32{1, 1}),
+	})
+	err = it.checkRowNums()
+	assert.Error(t, err)
+}
+
 func TestInsertTask_checkRowNums(t *testing.T) {
 	var err error
 
@@ -1706,6 +1786,78 @@ func TestShowPartitionsTask(t *testing.T) {
 
 }
 
+func TestPartitionKeyPartitionsTask(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	InitMetaCache(rc)
+
+	prefix := "TestPartitionKeyPartitionsTask"
+	collectionName := prefix + funcutil.GenRandomStr()
+	schema := constructCollectionSchema("int64", "fvec", 128, collectionName)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		Name:           "tenant",
+		DataType:       schemapb.DataType_Int64,
+		IsPartitionKey: true,
+	})
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	// the partitions created for the partition key are hidden
+	showTask := &showPartitionsTask{
+		Condition: NewTaskCondition(ctx),
+		ShowPartitionsRequest: &milvuspb.ShowPartitionsRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_ShowPartitions},
+			CollectionName: collectionName,
+			Type:           milvuspb.ShowType_All,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	assert.NoError(t, showTask.PreExecute(ctx))
+	assert.NoError(t, showTask.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, showTask.result.Status.ErrorCode)
+	assert.Empty(t, showTask.result.PartitionNames)
+	assert.Empty(t, showTask.result.PartitionIDs)
+
+	showTask.ShowPartitionsRequest.Type = milvuspb.ShowType_InMemory
+	showTask.PartitionNames = []string{Params.DefaultPartitionName}
+	assert.Error(t, showTask.Execute(ctx))
+
+	loadTask := &loadPartitionsTask{
+		Condition: NewTaskCondition(ctx),
+		LoadPartitionsRequest: &milvuspb.LoadPartitionsRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_LoadPartitions},
+			CollectionName: collectionName,
+			PartitionNames: []string{Params.DefaultPartitionName},
+		},
+		ctx: ctx,
+	}
+	assert.NoError(t, loadTask.PreExecute(ctx))
+	assert.Error(t, loadTask.Execute(ctx))
+
+	releaseTask := &releasePartitionsTask{
+		Condition: NewTaskCondition(ctx),
+		ReleasePartitionsRequest: &milvuspb.ReleasePartitionsRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_ReleasePartitions},
+			CollectionName: collectionName,
+			PartitionNames: []string{Params.DefaultPartitionName},
+		},
+		ctx: ctx,
+	}
+	assert.NoError(t, releaseTask.PreExecute(ctx))
+	assert.Error(t, releaseTask.Execute(ctx))
+}
+
 func TestSearchTask_all(t *testing.T) {
 	var err error
 
@@ -2669,6 +2821,74 @@ func TestSearchTask_Ts(t *testing.T) {
 	assert.Equal(t, ts, task.EndTs())
 }
 
+func TestSearchTask_checkIndexName(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	task := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{
+			Base: &commonpb.MsgBase{},
+		},
+		query: &milvuspb.SearchRequest{
+			CollectionName: "coll",
+		},
+	}
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+
+	// no index is built on the field
+	err := InitMetaCache(rc)
+	assert.NoError(t, err)
+	assert.Error(t, task.checkIndexName(ctx, "vec", "hnsw"))
+
+	// the missing index is named in the error
+	err = InitMetaCache(&describeIndexRootCoord{
+		RootCoordMock: rc,
+		resp: &milvuspb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist, Reason: "index not exist"},
+		},
+	})
+	assert.NoError(t, err)
+	err = task.checkIndexName(ctx, "vec", "hnsw")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "hnsw")
+
+	// the index is built on another field
+	err = InitMetaCache(&describeIndexRootCoord{
+		RootCoordMock: rc,
+		resp: &milvuspb.DescribeIndexResponse{
+			Status:            &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexDescriptions: []*milvuspb.IndexDescription{{IndexName: "hnsw", FieldName: "vec2"}},
+		},
+	})
+	assert.NoError(t, err)
+	err = task.checkIndexName(ctx, "vec", "hnsw")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "hnsw")
+
+	err = InitMetaCache(&describeIndexRootCoord{
+		RootCoordMock: rc,
+		resp: &milvuspb.DescribeIndexResponse{
+			Status:            &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			IndexDescriptions: []*milvuspb.IndexDescription{{IndexName: "hnsw", FieldName: "vec"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, task.checkIndexName(ctx, "vec", "hnsw"))
+}
+
+// describeIndexRootCoord returns resp for every DescribeIndex request
+type describeIndexRootCoord struct {
+	*RootCoordMock
+	resp *milvuspb.DescribeIndexResponse
+}
+
+func (coord *describeIndexRootCoord) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	return coord.resp, nil
+}
+
 func TestSearchTask_Channels(t *testing.T) {
 	var err error
 
@@ -3935,3 +4155,155 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.Execute(ctx))
 	assert.NoError(t, task.PostExecute(ctx))
 }
+
+func TestRenameCollectionTask(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	InitMetaCache(rc)
+
+	prefix := "TestRenameCollectionTask"
+	collectionName := prefix + funcutil.GenRandomStr()
+	newCollectionName := prefix + funcutil.GenRandomStr()
+	schema := constructCollectionSchema("int64", "fvec", 128, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	task := &renameCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		RenameCollectionRequest: &milvuspb.RenameCollectionRequest{
+			Base:    nil,
+			OldName: collectionName,
+			NewName: newCollectionName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, RenameCollectionTaskName, task.Name())
+
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_RenameCollection, task.Type())
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+	assert.NoError(t, task.PostExecute(ctx))
+
+	_, err = globalMetaCache.GetCollectionID(ctx, collectionName)
+	assert.Error(t, err)
+	_, err = globalMetaCache.GetCollectionID(ctx, newCollectionName)
+	assert.NoError(t, err)
+
+	// the new name is the same as the old one
+	task.OldName = newCollectionName
+	assert.Error(t, task.PreExecute(ctx))
+
+	// invalid collection name
+	task.NewName = "invalid name"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestRecoverCollectionTask(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+
+	collectionName := "TestRecoverCollectionTask" + funcutil.GenRandomStr()
+	task := &recoverCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		RecoverCollectionRequest: &milvuspb.RecoverCollectionRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, RecoverCollectionTaskName, task.Name())
+
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_RecoverCollection, task.Type())
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+	assert.NoError(t, task.PostExecute(ctx))
+
+	// invalid collection name
+	task.CollectionName = "invalid name"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestRecoverPartitionTask(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	InitMetaCache(rc)
+
+	collectionName := "TestRecoverPartitionTask" + funcutil.GenRandomStr()
+	task := &recoverPartitionTask{
+		Condition: NewTaskCondition(ctx),
+		RecoverPartitionRequest: &milvuspb.RecoverPartitionRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+			PartitionName:  "partition",
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, RecoverPartitionTaskName, task.Name())
+
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_RecoverPartition, task.Type())
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+	assert.NoError(t, task.PostExecute(ctx))
+
+	// invalid partition name
+	task.PartitionName = "invalid name"
+	assert.Error(t, task.PreExecute(ctx))
+}
@@ -23,11 +23,17 @@ import (
 	"strings"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const enableMultipleVectorFields = false
 
+// sparse float vectors are never returned as output fields, for search, query or get
+const sparseOutputFieldsErrFmt = "output fields are not supported with sparse float vector field %s"
+
 func isAlpha(c uint8) bool {
 	if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
 		return false
@@ -147,6 +153,36 @@ func validateFieldName(fieldName string) error {
 	return nil
 }
 
+func validateIndexName(indexName string) error {
+	indexName = strings.TrimSpace(indexName)
+
+	if indexName == "" {
+		return errors.New("index name should not be empty")
+	}
+
+	invalidMsg := "Invalid index name: " + indexName + ". "
+	if int64(len(indexName)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of an index name must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := indexName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of an index name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(indexName); i++ {
+		c := indexName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Index name can only contain numbers, letters, and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
 func validateDimension(dim int64, isBinary bool) error {
 	if dim <= 0 || dim > Params.MaxDimension {
 		return fmt.Errorf("invalid dimension: %d. should be in range 1 ~ %d", dim, Params.MaxDimension)
@@ -157,8 +193,68 @@ func validateDimension(dim int64, isBinary bool) error {
 	return nil
 }
 
+// validateSparseFloatVectorField checks a sparse float vector field, which has no fixed dimension
+// and only supports the IP metric.
+func validateSparseFloatVectorField(field *schemapb.FieldSchema) error {
+	for _, param := range field.TypeParams {
+		if param.Key == "dim" {
+			return fmt.Errorf("dim should not be specified for sparse float vector field %s", field.Name)
+		}
+	}
+	for _, param := range field.IndexParams {
+		if param.Key == "metric_type" {
+			if err := validateMetricType(field.DataType, param.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateSparseSearch checks the limitations of searching a sparse float vector field, which is done by
+// brute force on query nodes: only the IP metric is supported, without filter expression or output fields.
+func validateSparseSearch(schema *schemapb.CollectionSchema, annsField string, plan *planpb.PlanNode, outputFields []string) error {
+	var field *schemapb.FieldSchema
+	for _, f := range schema.Fields {
+		if f.Name == annsField {
+			field = f
+			break
+		}
+	}
+	if field == nil || field.DataType != schemapb.DataType_SparseFloatVector {
+		return nil
+	}
+	if err := validateMetricType(field.DataType, plan.GetVectorAnns().GetQueryInfo().GetMetricType()); err != nil {
+		return err
+	}
+	if plan.GetVectorAnns().GetPredicates() != nil {
+		return fmt.Errorf("filter expression is not supported when searching sparse float vector field %s", annsField)
+	}
+	if len(outputFields) > 0 {
+		return fmt.Errorf(sparseOutputFieldsErrFmt, annsField)
+	}
+	return nil
+}
+
+// validateSparseOutputFields checks that no sparse float vector field is retrieved as an output field,
+// outputFields should have been translated from the wildcards
+func validateSparseOutputFields(schema *schemapb.CollectionSchema, outputFields []string) error {
+	for _, field := range schema.Fields {
+		if field.DataType != schemapb.DataType_SparseFloatVector {
+			continue
+		}
+		for _, name := range outputFields {
+			if name == field.Name {
+				return fmt.Errorf(sparseOutputFieldsErrFmt, field.Name)
+			}
+		}
+	}
+	return nil
+}
+
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
-	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
+	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) &&
+		!typeutil.IsHalfFloatVectorType(field.DataType) {
 		return nil
 	}
 	for _, params := range field.IndexParams {
@@ -169,6 +265,27 @@ func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	return errors.New("vector float without metric_type")
 }
 
+// validateIndexFieldType checks that scalar index types are only built on scalar fields and vice versa.
+func validateIndexFieldType(field *schemapb.FieldSchema, indexType string) error {
+	if field.DataType == schemapb.DataType_SparseFloatVector {
+		return fmt.Errorf("index is not supported on sparse float vector field %s, it's searched by brute force", field.Name)
+	}
+	if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector ||
+		typeutil.IsHalfFloatVectorType(field.DataType) {
+		if indexparamcheck.IsScalarIndexType(indexType) {
+			return fmt.Errorf("index type %s can't be built on vector field %s", indexType, field.Name)
+		}
+		return nil
+	}
+	if !indexparamcheck.IsScalarIndexType(indexType) {
+		return fmt.Errorf("index type %s can't be built on scalar field %s", indexType, field.Name)
+	}
+	if err := indexparamcheck.CheckScalarIndexDataType(field.DataType); err != nil {
+		return fmt.Errorf("field %s: %s", field.Name, err.Error())
+	}
+	return nil
+}
+
 func validateDuplicatedFieldName(fields []*schemapb.FieldSchema) error {
 	names := make(map[string]bool)
 	for _, field := range fields {
@@ -217,6 +334,47 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validateClusteringKey checks at most one field is the clustering key, and its values can be ordered
+// as ranges, which are integers and floating point numbers
+func validateClusteringKey(coll *schemapb.CollectionSchema) error {
+	idx := -1
+	for i, field := range coll.Fields {
+		if !field.IsClusteringKey {
+			continue
+		}
+		if idx != -1 {
+			return fmt.Errorf("there are more than one clustering key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
+		}
+		switch field.DataType {
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+			schemapb.DataType_Float, schemapb.DataType_Double:
+		default:
+			return fmt.Errorf("the data type of clustering key should be integer or floating point, field name = %s", field.Name)
+		}
+		idx = i
+	}
+	return nil
+}
+
+// validatePartitionKey checks at most one field is the partition key, and its values are integers
+// which can be hashed into partitions
+func validatePartitionKey(coll *schemapb.CollectionSchema) error {
+	idx := -1
+	for i, field := range coll.Fields {
+		if !field.IsPartitionKey {
+			continue
+		}
+		if idx != -1 {
+			return fmt.Errorf("there are more than one partition key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
+		}
+		if !typeutil.IsIntegerType(field.DataType) {
+			return fmt.Errorf("the data type of partition key should be integer, field name = %s", field.Name)
+		}
+		idx = i
+	}
+	return nil
+}
+
 // RepeatedKeyValToMap transfer the kv pairs to map.
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
@@ -238,7 +396,8 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 		schemapb.DataType_Float, schemapb.DataType_Double:
 		return false, nil
 
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_SparseFloatVector,
+		schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
 		return true, nil
 	}
 
@@ -249,7 +408,10 @@ func validateMetricType(dataType schemapb.DataType, metricTypeStrRaw string) err
 	metricTypeStr := strings.ToUpper(metricTypeStrRaw)
 	switch metricTypeStr {
 	case "L2", "IP":
-		if dataType == schemapb.DataType_FloatVector {
+		if dataType == schemapb.DataType_FloatVector || typeutil.IsHalfFloatVectorType(dataType) {
+			return nil
+		}
+		if dataType == schemapb.DataType_SparseFloatVector && metricTypeStr == "IP" {
 			return nil
 		}
 	case "JACCARD", "HAMMING", "TANIMOTO", "SUBSTRUCTURE", "SUBPERSTURCTURE":
@@ -301,7 +463,11 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			return err3
 		}
 
-		if isVec {
+		if field.DataType == schemapb.DataType_SparseFloatVector {
+			if err := validateSparseFloatVectorField(field); err != nil {
+				return err
+			}
+		} else if isVec {
 			indexKv, err1 := RepeatedKeyValToMap(field.IndexParams)
 			if err1 != nil {
 				return err1
@@ -353,7 +519,8 @@ func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 	for i := range schema.Fields {
 		name := schema.Fields[i].Name
 		dType := schema.Fields[i].DataType
-		isVec := (dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector)
+		isVec := (dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector ||
+			typeutil.IsHalfFloatVectorType(dType))
 		if isVec && vecExist && !enableMultipleVectorFields {
 			return fmt.Errorf(
 				"multiple vector fields is not supported, fields name: %s, %s",
@@ -17,9 +17,11 @@
 package proxy
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/stretchr/testify/assert"
 )
@@ -100,6 +102,59 @@ func TestValidateFieldName(t *testing.T) {
 	}
 }
 
+func TestValidateIndexFieldType(t *testing.T) {
+	vecField := &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector}
+	assert.Nil(t, validateIndexFieldType(vecField, "IVF_FLAT"))
+	assert.NotNil(t, validateIndexFieldType(vecField, "SORT"))
+	assert.NotNil(t, validateIndexFieldType(vecField, "INVERTED"))
+
+	int64Field := &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}
+	assert.Nil(t, validateIndexFieldType(int64Field, "SORT"))
+	assert.Nil(t, validateIndexFieldType(int64Field, "INVERTED"))
+	assert.NotNil(t, validateIndexFieldType(int64Field, "IVF_FLAT"))
+
+	doubleField := &schemapb.FieldSchema{Name: "price", DataType: schemapb.DataType_Double}
+	assert.Nil(t, validateIndexFieldType(doubleField, "INVERTED"))
+
+	boolField := &schemapb.FieldSchema{Name: "flag", DataType: schemapb.DataType_Bool}
+	assert.NotNil(t, validateIndexFieldType(boolField, "SORT"))
+
+	strField := &schemapb.FieldSchema{Name: "name", DataType: schemapb.DataType_String}
+	assert.Nil(t, validateIndexFieldType(strField, "INVERTED"))
+	assert.NotNil(t, validateIndexFieldType(strField, "IVF_FLAT"))
+
+	halfField := &schemapb.FieldSchema{Name: "half", DataType: schemapb.DataType_BFloat16Vector}
+	assert.Nil(t, validateIndexFieldType(halfField, "IVF_FLAT"))
+	assert.NotNil(t, validateIndexFieldType(halfField, "SORT"))
+
+	sparseField := &schemapb.FieldSchema{Name: "sparse", DataType: schemapb.DataType_SparseFloatVector}
+	assert.NotNil(t, validateIndexFieldType(sparseField, "IVF_FLAT"))
+	assert.NotNil(t, validateIndexFieldType(sparseField, "SORT"))
+}
+
+func TestValidateIndexName(t *testing.T) {
+	assert.Nil(t, validateIndexName("ivf_pq"))
+	assert.Nil(t, validateIndexName("_default_idx"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"$abc",
+		"hnsw-1",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, validateIndexName(name))
+	}
+}
+
 func TestValidateDimension(t *testing.T) {
 	assert.Nil(t, validateDimension(1, false))
 	assert.Nil(t, validateDimension(Params.MaxDimension, false))
@@ -138,6 +193,79 @@ func TestValidateVectorFieldMetricType(t *testing.T) {
 	assert.Nil(t, validateVectorFieldMetricType(field1))
 }
 
+func TestValidateMetricType(t *testing.T) {
+	assert.Nil(t, validateMetricType(schemapb.DataType_FloatVector, "l2"))
+	assert.Nil(t, validateMetricType(schemapb.DataType_Float16Vector, "L2"))
+	assert.Nil(t, validateMetricType(schemapb.DataType_BFloat16Vector, "IP"))
+	assert.NotNil(t, validateMetricType(schemapb.DataType_Float16Vector, "HAMMING"))
+	assert.Nil(t, validateMetricType(schemapb.DataType_BinaryVector, "JACCARD"))
+	assert.NotNil(t, validateMetricType(schemapb.DataType_SparseFloatVector, "L2"))
+}
+
+func TestValidateSparseFloatVectorField(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:     "sparse",
+		DataType: schemapb.DataType_SparseFloatVector,
+	}
+	assert.Nil(t, validateSparseFloatVectorField(field))
+
+	field.IndexParams = []*commonpb.KeyValuePair{{Key: "metric_type", Value: "IP"}}
+	assert.Nil(t, validateSparseFloatVectorField(field))
+
+	field.IndexParams = []*commonpb.KeyValuePair{{Key: "metric_type", Value: "L2"}}
+	assert.NotNil(t, validateSparseFloatVectorField(field))
+
+	field.IndexParams = nil
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}}
+	assert.NotNil(t, validateSparseFloatVectorField(field))
+}
+
+func TestValidateSparseSearch(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "dense", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "sparse", DataType: schemapb.DataType_SparseFloatVector},
+		},
+	}
+	newPlan := func(metricType string, predicates *planpb.Expr) *planpb.PlanNode {
+		return &planpb.PlanNode{
+			Node: &planpb.PlanNode_VectorAnns{
+				VectorAnns: &planpb.VectorANNS{
+					Predicates: predicates,
+					QueryInfo:  &planpb.QueryInfo{MetricType: metricType},
+				},
+			},
+		}
+	}
+	assert.Nil(t, validateSparseSearch(schema, "sparse", newPlan("IP", nil), nil))
+	assert.NotNil(t, validateSparseSearch(schema, "sparse", newPlan("L2", nil), nil))
+	assert.NotNil(t, validateSparseSearch(schema, "sparse", newPlan("IP", &planpb.Expr{}), nil))
+	assert.NotNil(t, validateSparseSearch(schema, "sparse", newPlan("IP", nil), []string{"pk"}))
+	// limitations don't apply to dense vector fields
+	assert.Nil(t, validateSparseSearch(schema, "dense", newPlan("L2", &planpb.Expr{}), []string{"pk"}))
+}
+
+func TestValidateSparseOutputFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "dense", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "sparse", DataType: schemapb.DataType_SparseFloatVector},
+		},
+	}
+	assert.Nil(t, validateSparseOutputFields(schema, nil))
+	assert.Nil(t, validateSparseOutputFields(schema, []string{"pk", "dense"}))
+	err := validateSparseOutputFields(schema, []string{"pk", "sparse"})
+	assert.EqualError(t, err, fmt.Sprintf(sparseOutputFieldsErrFmt, "sparse"))
+
+	// the sparse field is retrieved through the vector wildcard as well
+	outputFields, err := translateOutputFields([]string{"%"}, schema, true)
+	assert.Nil(t, err)
+	err = validateSparseOutputFields(schema, outputFields)
+	assert.EqualError(t, err, fmt.Sprintf(sparseOutputFieldsErrFmt, "sparse"))
+}
+
 func TestValidateDuplicatedFieldName(t *testing.T) {
 	fields := []*schemapb.FieldSchema{
 		{Name: "abc"},
@@ -408,3 +536,53 @@ func TestValidateMultipleVectorFields(t *testing.T) {
 		assert.Error(t, validateMultipleVectorFields(schema3))
 	}
 }
+
+func TestValidateClusteringKey(t *testing.T) {
+	coll := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", FieldID: 100, IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{Name: "tenant", FieldID: 101, DataType: schemapb.DataType_Int32},
+			{Name: "score", FieldID: 102, DataType: schemapb.DataType_Double},
+			{Name: "flag", FieldID: 103, DataType: schemapb.DataType_Bool},
+		},
+	}
+	assert.NoError(t, validateClusteringKey(coll))
+
+	coll.Fields[1].IsClusteringKey = true
+	assert.NoError(t, validateClusteringKey(coll))
+
+	coll.Fields[2].IsClusteringKey = true
+	assert.Error(t, validateClusteringKey(coll))
+
+	coll.Fields[1].IsClusteringKey = false
+	assert.NoError(t, validateClusteringKey(coll))
+
+	coll.Fields[2].IsClusteringKey = false
+	coll.Fields[3].IsClusteringKey = true
+	assert.Error(t, validateClusteringKey(coll))
+}
+
+func TestValidatePartitionKey(t *testing.T) {
+	coll := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", FieldID: 100, IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{Name: "tenant", FieldID: 101, DataType: schemapb.DataType_Int32},
+			{Name: "user", FieldID: 102, DataType: schemapb.DataType_Int64},
+			{Name: "score", FieldID: 103, DataType: schemapb.DataType_Double},
+		},
+	}
+	assert.NoError(t, validatePartitionKey(coll))
+
+	coll.Fields[1].IsPartitionKey = true
+	assert.NoError(t, validatePartitionKey(coll))
+
+	coll.Fields[2].IsPartitionKey = true
+	assert.Error(t, validatePartitionKey(coll))
+
+	coll.Fields[1].IsPartitionKey = false
+	assert.NoError(t, validatePartitionKey(coll))
+
+	coll.Fields[2].IsPartitionKey = false
+	coll.Fields[3].IsPartitionKey = true
+	assert.Error(t, validatePartitionKey(coll))
+}
@@ -30,7 +30,6 @@ import (
 
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -137,7 +136,8 @@ func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv *etcdkv.EtcdK
 		return nil, err
 	}
 
-	option := &minioKV.Option{
+	option := &storage.Option{
+		StorageConfig:     Params.Storage,
 		Address:           Params.MinioEndPoint,
 		AccessKeyID:       Params.MinioAccessKeyID,
 		SecretAccessKeyID: Params.MinioSecretAccessKey,
@@ -146,10 +146,11 @@ func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv *etcdkv.EtcdK
 		BucketName:        Params.MinioBucketName,
 	}
 
-	c.dataKV, err = minioKV.NewMinIOKV(ctx, option)
+	cm, err := storage.NewChunkManager(ctx, option)
 	if err != nil {
 		return nil, err
 	}
+	c.dataKV = storage.NewChunkManagerKV(cm)
 
 	return c, nil
 }
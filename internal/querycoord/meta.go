@@ -53,7 +53,7 @@ type col2SealedSegmentChangeInfos = map[UniqueID]*querypb.SealedSegmentsChangeIn
 // Meta contains information about all loaded collections and partitions, including segment information and vchannel information
 type Meta interface {
 	reloadFromKV() error
-	setKvClient(kv kv.MetaKv)
+	setKvClient(kv kv.TxnKV)
 
 	showCollections() []*querypb.CollectionInfo
 	hasCollection(collectionID UniqueID) bool
@@ -98,7 +98,7 @@ type Meta interface {
 type MetaReplica struct {
 	ctx         context.Context
 	cancel      context.CancelFunc
-	client      kv.MetaKv // client of a reliable kv service, i.e. etcd client
+	client      kv.TxnKV // client of the metastore, i.e. etcd or sql
 	msFactory   msgstream.Factory
 	idAllocator func() (UniqueID, error)
 
@@ -118,7 +118,7 @@ type MetaReplica struct {
 	//partitionStates map[UniqueID]*querypb.PartitionStates
 }
 
-func newMeta(ctx context.Context, kv kv.MetaKv, factory msgstream.Factory, idAllocator func() (UniqueID, error)) (Meta, error) {
+func newMeta(ctx context.Context, kv kv.TxnKV, factory msgstream.Factory, idAllocator func() (UniqueID, error)) (Meta, error) {
 	childCtx, cancel := context.WithCancel(ctx)
 	collectionInfos := make(map[UniqueID]*querypb.CollectionInfo)
 	segmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
@@ -236,7 +236,7 @@ func (m *MetaReplica) reloadFromKV() error {
 	return nil
 }
 
-func (m *MetaReplica) setKvClient(kv kv.MetaKv) {
+func (m *MetaReplica) setKvClient(kv kv.TxnKV) {
 	m.client = kv
 }
 
@@ -419,6 +419,7 @@ func (m *MetaReplica) saveGlobalSealedSegInfos(saves col2SegmentInfos) (col2Seal
 	col2SegmentChangeInfos := make(col2SealedSegmentChangeInfos)
 
 	segmentsCompactionFrom := make([]UniqueID, 0)
+	compactedSegments := make(map[UniqueID]struct{})
 	// get segmentInfos to colSegmentInfos
 	for collectionID, onlineInfos := range saves {
 		segmentsChangeInfo := &querypb.SealedSegmentsChangeInfo{
@@ -445,8 +446,15 @@ func (m *MetaReplica) saveGlobalSealedSegInfos(saves col2SegmentInfos) (col2Seal
 			}
 			segmentsChangeInfo.Infos = append(segmentsChangeInfo.Infos, changeInfo)
 
-			// generate offline segment change info if the loaded segment is compacted from other sealed segments
+			// generate offline segment change info if the loaded segment is compacted from other sealed segments,
+			// the compacted segments are released only after all the segments written by the compaction are loaded
+			if !m.compactionOutputsLoaded(info, onlineInfos) {
+				continue
+			}
 			for _, compactionSegmentID := range info.CompactionFrom {
+				if _, ok := compactedSegments[compactionSegmentID]; ok {
+					continue
+				}
 				compactionSegmentInfo, err := m.getSegmentInfoByID(compactionSegmentID)
 				if err == nil && compactionSegmentInfo.SegmentState == querypb.SegmentState_sealed {
 					segmentsChangeInfo.Infos = append(segmentsChangeInfo.Infos, &querypb.SegmentChangeInfo{
@@ -454,6 +462,7 @@ func (m *MetaReplica) saveGlobalSealedSegInfos(saves col2SegmentInfos) (col2Seal
 						OfflineSegments: []*querypb.SegmentInfo{compactionSegmentInfo},
 					})
 					segmentsCompactionFrom = append(segmentsCompactionFrom, compactionSegmentID)
+					compactedSegments[compactionSegmentID] = struct{}{}
 				} else {
 					return nil, fmt.Errorf("saveGlobalSealedSegInfos: the compacted segment %d has not been loaded into memory", compactionSegmentID)
 				}
@@ -590,6 +599,28 @@ func (m *MetaReplica) saveGlobalSealedSegInfos(saves col2SegmentInfos) (col2Seal
 	return col2SegmentChangeInfos, nil
 }
 
+// compactionOutputsLoaded returns whether all the segments written by the same compaction as the segment of info
+// are loaded, either saved in meta or being saved together with info.
+func (m *MetaReplica) compactionOutputsLoaded(info *querypb.SegmentInfo, saves []*querypb.SegmentInfo) bool {
+	for _, segmentID := range info.GetCompactionOutputs() {
+		loaded := false
+		for _, save := range saves {
+			if save.GetSegmentID() == segmentID {
+				loaded = true
+				break
+			}
+		}
+		if !loaded {
+			segmentInfo, err := m.getSegmentInfoByID(segmentID)
+			loaded = err == nil && segmentInfo.SegmentState == querypb.SegmentState_sealed
+		}
+		if !loaded {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *MetaReplica) removeGlobalSealedSegInfos(collectionID UniqueID, partitionIDs []UniqueID) (col2SealedSegmentChangeInfos, error) {
 	removes := m.showSegmentInfos(collectionID, partitionIDs)
 	if len(removes) == 0 {
@@ -1167,7 +1198,7 @@ func (m *MetaReplica) setLoadPercentage(collectionID UniqueID, partitionID Uniqu
 //	}
 //}
 
-func saveGlobalCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, kv kv.MetaKv) error {
+func saveGlobalCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, kv kv.TxnKV) error {
 	infoBytes, err := proto.Marshal(info)
 	if err != nil {
 		return err
@@ -1177,12 +1208,12 @@ func saveGlobalCollectionInfo(collectionID UniqueID, info *querypb.CollectionInf
 	return kv.Save(key, string(infoBytes))
 }
 
-func removeGlobalCollectionInfo(collectionID UniqueID, kv kv.MetaKv) error {
+func removeGlobalCollectionInfo(collectionID UniqueID, kv kv.TxnKV) error {
 	key := fmt.Sprintf("%s/%d", collectionMetaPrefix, collectionID)
 	return kv.Remove(key)
 }
 
-func multiSaveSegmentInfos(segmentInfos map[UniqueID]*querypb.SegmentInfo, kv kv.MetaKv) error {
+func multiSaveSegmentInfos(segmentInfos map[UniqueID]*querypb.SegmentInfo, kv kv.TxnKV) error {
 	kvs := make(map[string]string)
 	for segmentID, info := range segmentInfos {
 		infoBytes, err := proto.Marshal(info)
@@ -1196,7 +1227,7 @@ func multiSaveSegmentInfos(segmentInfos map[UniqueID]*querypb.SegmentInfo, kv kv
 	return kv.MultiSave(kvs)
 }
 
-func multiRemoveSegmentInfo(segmentIDs []UniqueID, kv kv.MetaKv) error {
+func multiRemoveSegmentInfo(segmentIDs []UniqueID, kv kv.TxnKV) error {
 	keys := make([]string, 0)
 	for _, segmentID := range segmentIDs {
 		key := fmt.Sprintf("%s/%d", util.SegmentMetaPrefix, segmentID)
@@ -1206,7 +1237,7 @@ func multiRemoveSegmentInfo(segmentIDs []UniqueID, kv kv.MetaKv) error {
 	return kv.MultiRemove(keys)
 }
 
-func saveQueryChannelInfo(collectionID UniqueID, info *querypb.QueryChannelInfo, kv kv.MetaKv) error {
+func saveQueryChannelInfo(collectionID UniqueID, info *querypb.QueryChannelInfo, kv kv.TxnKV) error {
 	infoBytes, err := proto.Marshal(info)
 	if err != nil {
 		return err
@@ -1216,7 +1247,7 @@ func saveQueryChannelInfo(collectionID UniqueID, info *querypb.QueryChannelInfo,
 	return kv.Save(key, string(infoBytes))
 }
 
-func saveDeltaChannelInfo(collectionID UniqueID, infos []*datapb.VchannelInfo, kv kv.MetaKv) error {
+func saveDeltaChannelInfo(collectionID UniqueID, infos []*datapb.VchannelInfo, kv kv.TxnKV) error {
 	kvs := make(map[string]string)
 	for _, info := range infos {
 		infoBytes, err := proto.Marshal(info)
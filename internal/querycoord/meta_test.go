@@ -309,6 +309,46 @@ func TestMetaFunc(t *testing.T) {
 	})
 }
 
+func TestCompactionOutputsLoaded(t *testing.T) {
+	meta := &MetaReplica{
+		segmentInfos: map[UniqueID]*querypb.SegmentInfo{
+			defaultSegmentID: {
+				SegmentID:    defaultSegmentID,
+				SegmentState: querypb.SegmentState_sealed,
+			},
+			defaultSegmentID + 1: {
+				SegmentID:    defaultSegmentID + 1,
+				SegmentState: querypb.SegmentState_Growing,
+			},
+		},
+	}
+	newInfo := func(segmentID UniqueID, outputs ...UniqueID) *querypb.SegmentInfo {
+		return &querypb.SegmentInfo{
+			SegmentID:         segmentID,
+			SegmentState:      querypb.SegmentState_sealed,
+			CompactionFrom:    []UniqueID{defaultSegmentID + 10},
+			CompactionOutputs: outputs,
+		}
+	}
+
+	// the segment written by merge compaction is the only output
+	info := newInfo(defaultSegmentID + 2)
+	assert.True(t, meta.compactionOutputsLoaded(info, []*querypb.SegmentInfo{info}))
+
+	// the other output has been loaded
+	info = newInfo(defaultSegmentID+2, defaultSegmentID, defaultSegmentID+2)
+	assert.True(t, meta.compactionOutputsLoaded(info, []*querypb.SegmentInfo{info}))
+
+	// the other output is being loaded together
+	info = newInfo(defaultSegmentID+2, defaultSegmentID+2, defaultSegmentID+3)
+	assert.False(t, meta.compactionOutputsLoaded(info, []*querypb.SegmentInfo{info}))
+	assert.True(t, meta.compactionOutputsLoaded(info, []*querypb.SegmentInfo{info, newInfo(defaultSegmentID + 3)}))
+
+	// the other output is still growing
+	info = newInfo(defaultSegmentID+2, defaultSegmentID+1, defaultSegmentID+2)
+	assert.False(t, meta.compactionOutputsLoaded(info, []*querypb.SegmentInfo{info}))
+}
+
 func TestReloadMetaFromKV(t *testing.T) {
 	refreshParams()
 	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
@@ -17,12 +17,14 @@
 package querycoord
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -57,12 +59,18 @@ type ParamTable struct {
 	MetaRootPath  string
 	KvRootPath    string
 
+	// --- Metastore ---
+	MetaStoreType      string
+	MetaStoreSQLDriver string
+	MetaStoreSQLDSN    string
+
 	//--- Minio ---
 	MinioEndPoint        string
 	MinioAccessKeyID     string
 	MinioSecretAccessKey string
 	MinioUseSSLStr       bool
 	MinioBucketName      string
+	Storage              paramtable.StorageConfig
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -111,6 +119,7 @@ func (p *ParamTable) Init() {
 	p.initEtcdEndpoints()
 	p.initMetaRootPath()
 	p.initKvRootPath()
+	p.initMetaStore()
 
 	//--- Minio ----
 	p.initMinioEndPoint()
@@ -118,6 +127,7 @@ func (p *ParamTable) Init() {
 	p.initMinioSecretAccessKey()
 	p.initMinioUseSSLStr()
 	p.initMinioBucketName()
+	p.initStorage()
 
 	//--- Pulsar ----
 	p.initPulsarAddress()
@@ -200,6 +210,16 @@ func (p *ParamTable) initMetaRootPath() {
 	p.MetaRootPath = path.Join(rootPath, subPath)
 }
 
+// the metastore of queryCoord falls back to the global one if not set
+func (p *ParamTable) initMetaStore() {
+	p.MetaStoreType = p.LoadWithDefault("queryCoord.metastore", p.LoadWithDefault("metastore.type", kv.MetaStoreEtcd))
+	if p.MetaStoreType != kv.MetaStoreEtcd && p.MetaStoreType != kv.MetaStoreSQL {
+		panic(fmt.Sprintf("unsupported metastore type %s", p.MetaStoreType))
+	}
+	p.MetaStoreSQLDriver = p.LoadWithDefault("metastore.sql.driver", "mysql")
+	p.MetaStoreSQLDSN = p.LoadWithDefault("metastore.sql.dsn", "")
+}
+
 func (p *ParamTable) initKvRootPath() {
 	rootPath, err := p.Load("etcd.rootPath")
 	if err != nil {
@@ -256,6 +276,10 @@ func (p *ParamTable) initMinioBucketName() {
 	p.MinioBucketName = bucketName
 }
 
+func (p *ParamTable) initStorage() {
+	p.Storage = p.LoadStorageConfig()
+}
+
 func (p *ParamTable) initRoleName() {
 	p.RoleName = "querycoord"
 }
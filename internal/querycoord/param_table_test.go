@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/kv"
 )
 
 //TODO add more test for other parameters
@@ -37,4 +39,11 @@ func TestParamTable(t *testing.T) {
 
 	assert.Equal(t, Params.TimeTickChannelName, "by-dev-queryTimeTick")
 	t.Logf("query coord  time tick channel = %s", Params.TimeTickChannelName)
+
+	assert.Equal(t, kv.MetaStoreEtcd, Params.MetaStoreType)
+	t.Logf("query coord metastore = %s", Params.MetaStoreType)
+
+	Params.Save("queryCoord.metastore", "unknown")
+	assert.Panics(t, func() { Params.initMetaStore() })
+	Params.Remove("queryCoord.metastore")
 }
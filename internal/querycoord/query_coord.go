@@ -35,7 +35,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv/metastore"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -67,6 +69,8 @@ type QueryCoord struct {
 	loopCancel context.CancelFunc
 	loopWg     sync.WaitGroup
 	kvClient   *etcdkv.EtcdKV
+	// metaKV keeps the collection meta, node sessions, tasks and handoff requests are always in etcd
+	metaKV kv.TxnKV
 
 	initOnce sync.Once
 
@@ -113,6 +117,11 @@ func (qc *QueryCoord) Init() error {
 			return err
 		}
 		qc.kvClient = etcdKV
+		metaKV, err := metastore.NewMetaKV(Params.MetaStoreType, Params.MetaStoreSQLDriver, Params.MetaStoreSQLDSN, Params.EtcdEndpoints, Params.MetaRootPath)
+		if err != nil {
+			return err
+		}
+		qc.metaKV = metaKV
 		return nil
 	}
 	var initError error = nil
@@ -142,7 +151,7 @@ func (qc *QueryCoord) Init() error {
 		}
 
 		// init meta
-		qc.meta, initError = newMeta(qc.loopCtx, qc.kvClient, qc.msFactory, qc.idAllocator)
+		qc.meta, initError = newMeta(qc.loopCtx, qc.metaKV, qc.msFactory, qc.idAllocator)
 		if initError != nil {
 			log.Error("query coordinator init meta failed", zap.Error(initError))
 			return
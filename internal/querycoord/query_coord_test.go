@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -29,7 +30,11 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -143,6 +148,36 @@ func startUnHealthyQueryCoord(ctx context.Context) (*QueryCoord, error) {
 	return coord, nil
 }
 
+func TestQueryCoord_SQLMetaStore(t *testing.T) {
+	refreshParams()
+	Params.MetaStoreType = kv.MetaStoreSQL
+	Params.MetaStoreSQLDriver = "sqlite3"
+	Params.MetaStoreSQLDSN = filepath.Join(t.TempDir(), "meta.db")
+	defer func() {
+		Params.MetaStoreType = kv.MetaStoreEtcd
+	}()
+	baseCtx := context.Background()
+
+	queryCoord, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+	defer queryCoord.Stop()
+
+	err = queryCoord.meta.addCollection(defaultCollectionID, genCollectionSchema(defaultCollectionID, false))
+	assert.Nil(t, err)
+
+	// the collection meta is kept in sql rather than etcd
+	metaKV, err := sqlkv.NewSQLKV("sqlite3", Params.MetaStoreSQLDSN, Params.MetaRootPath)
+	assert.Nil(t, err)
+	defer metaKV.Close()
+	meta, err := newMeta(baseCtx, metaKV, nil, nil)
+	assert.Nil(t, err)
+	assert.True(t, meta.hasCollection(defaultCollectionID))
+
+	_, values, err := queryCoord.kvClient.LoadWithPrefix(collectionMetaPrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+}
+
 func TestWatchNodeLoop(t *testing.T) {
 	baseCtx := context.Background()
 
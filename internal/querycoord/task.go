@@ -36,7 +36,6 @@ import (
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
 )
 
 const timeoutForRPC = 10 * time.Second
@@ -389,6 +388,8 @@ func (lct *loadCollectionTask) execute(ctx context.Context) error {
 				NumOfRows:    segmentBingLog.NumOfRows,
 				Statslogs:    segmentBingLog.Statslogs,
 				Deltalogs:    segmentBingLog.Deltalogs,
+
+				ClusteringKeyRange: segmentBingLog.ClusteringKeyRange,
 			}
 
 			indexInfo, err := getIndexInfo(ctx, &querypb.SegmentInfo{
@@ -763,6 +764,8 @@ func (lpt *loadPartitionTask) execute(ctx context.Context) error {
 				NumOfRows:    segmentBingLog.NumOfRows,
 				Statslogs:    segmentBingLog.Statslogs,
 				Deltalogs:    segmentBingLog.Deltalogs,
+
+				ClusteringKeyRange: segmentBingLog.ClusteringKeyRange,
 			}
 
 			indexInfo, err := getIndexInfo(ctx, &querypb.SegmentInfo{
@@ -1582,6 +1585,9 @@ func (ht *handoffTask) execute(ctx context.Context) error {
 						CompactionFrom: segmentInfo.CompactionFrom,
 						EnableIndex:    segmentInfo.EnableIndex,
 						IndexPathInfos: segmentInfo.IndexPathInfos,
+
+						ClusteringKeyRange: segmentBinlogs.ClusteringKeyRange,
+						CompactionOutputs:  segmentInfo.CompactionOutputs,
 					}
 
 					msgBase := proto.Clone(ht.Base).(*commonpb.MsgBase)
@@ -1765,6 +1771,8 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 							NumOfRows:    segmentBingLog.NumOfRows,
 							Statslogs:    segmentBingLog.Statslogs,
 							Deltalogs:    segmentBingLog.Deltalogs,
+
+							ClusteringKeyRange: segmentBingLog.ClusteringKeyRange,
 						}
 						indexInfo, err := getIndexInfo(ctx, &querypb.SegmentInfo{
 							CollectionID: collectionID,
@@ -1974,6 +1982,8 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 						NumOfRows:    segmentBingLog.NumOfRows,
 						Statslogs:    segmentBingLog.Statslogs,
 						Deltalogs:    segmentBingLog.Deltalogs,
+
+						ClusteringKeyRange: segmentBingLog.ClusteringKeyRange,
 					}
 
 					indexInfo, err := getIndexInfo(ctx, &querypb.SegmentInfo{
@@ -2105,7 +2115,7 @@ func assignInternalTask(ctx context.Context,
 	watchDeltaChannelRequest *querypb.WatchDeltaChannelsRequest,
 	wait bool, excludeNodeIDs []int64, includeNodeIDs []int64) ([]task, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	log.Debug("assignInternalTask: start assign task to query node")
 	internalTasks := make([]task, 0)
 	err := cluster.allocateSegmentsToQueryNode(ctx, loadSegmentRequests, wait, excludeNodeIDs, includeNodeIDs)
@@ -2152,7 +2162,7 @@ func assignInternalTask(ctx context.Context,
 
 	for nodeID, loadSegmentsReqs := range node2Segments {
 		for _, req := range loadSegmentsReqs {
-			ctx = opentracing.ContextWithSpan(context.Background(), sp)
+			ctx = trace.ContextWithSpan(context.Background(), sp)
 			baseTask := newBaseTask(ctx, parentTask.getTriggerCondition())
 			baseTask.setParentTask(parentTask)
 			loadSegmentTask := &loadSegmentTask{
@@ -2166,7 +2176,7 @@ func assignInternalTask(ctx context.Context,
 		}
 
 		if watchDeltaChannelRequest != nil && len(loadSegmentsReqs) != 0 {
-			ctx = opentracing.ContextWithSpan(context.Background(), sp)
+			ctx = trace.ContextWithSpan(context.Background(), sp)
 			watchDeltaRequest := proto.Clone(watchDeltaChannelRequest).(*querypb.WatchDeltaChannelsRequest)
 			watchDeltaRequest.NodeID = nodeID
 			baseTask := newBaseTask(ctx, parentTask.getTriggerCondition())
@@ -2184,7 +2194,7 @@ func assignInternalTask(ctx context.Context,
 
 	for _, req := range watchDmChannelRequests {
 		nodeID := req.NodeID
-		ctx = opentracing.ContextWithSpan(context.Background(), sp)
+		ctx = trace.ContextWithSpan(context.Background(), sp)
 		baseTask := newBaseTask(ctx, parentTask.getTriggerCondition())
 		baseTask.setParentTask(parentTask)
 		watchDmChannelTask := &watchDmChannelTask{
@@ -2205,7 +2215,7 @@ func assignInternalTask(ctx context.Context,
 
 	for nodeID, watched := range watchQueryChannelInfo {
 		if !watched {
-			ctx = opentracing.ContextWithSpan(context.Background(), sp)
+			ctx = trace.ContextWithSpan(context.Background(), sp)
 			queryChannelInfo, err := meta.getQueryChannelInfoByID(collectionID)
 			if err != nil {
 				return nil, err
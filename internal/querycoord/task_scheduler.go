@@ -26,7 +26,8 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
@@ -36,7 +37,6 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	oplog "github.com/opentracing/opentracing-go/log"
 )
 
 // TaskQueue is used to cache triggerTasks
@@ -521,21 +521,20 @@ func (scheduler *TaskScheduler) processTask(t task) error {
 	}
 
 	span, ctx := trace.StartSpanFromContext(t.traceCtx(),
-		opentracing.Tags{
-			"Type": t.msgType(),
-			"ID":   t.getTaskID(),
-		})
+		oteltrace.WithAttributes(
+			attribute.String("Type", t.msgType().String()),
+			attribute.Int64("ID", t.getTaskID())))
 	var err error
-	defer span.Finish()
+	defer span.End()
 
 	defer func() {
 		//task postExecute
-		span.LogFields(oplog.Int64("processTask: scheduler process PostExecute", t.getTaskID()))
+		span.AddEvent("processTask: scheduler process PostExecute")
 		t.postExecute(ctx)
 	}()
 
 	// task preExecute
-	span.LogFields(oplog.Int64("processTask: scheduler process PreExecute", t.getTaskID()))
+	span.AddEvent("processTask: scheduler process PreExecute")
 	t.preExecute(ctx)
 	taskInfoKey = fmt.Sprintf("%s/%d", taskInfoPrefix, t.getTaskID())
 	err = scheduler.client.Save(taskInfoKey, strconv.Itoa(int(taskDoing)))
@@ -547,7 +546,7 @@ func (scheduler *TaskScheduler) processTask(t task) error {
 	t.setState(taskDoing)
 
 	// task execute
-	span.LogFields(oplog.Int64("processTask: scheduler process Execute", t.getTaskID()))
+	span.AddEvent("processTask: scheduler process Execute")
 	err = t.execute(ctx)
 	if err != nil {
 		trace.LogError(span, err)
@@ -900,12 +899,13 @@ func updateSegmentInfoFromTask(ctx context.Context, triggerTask task, meta Meta)
 					collectionID := loadInfo.CollectionID
 					segmentID := loadInfo.SegmentID
 					segmentInfo := &querypb.SegmentInfo{
-						SegmentID:      segmentID,
-						CollectionID:   loadInfo.CollectionID,
-						PartitionID:    loadInfo.PartitionID,
-						NodeID:         dstNodeID,
-						SegmentState:   querypb.SegmentState_sealed,
-						CompactionFrom: loadInfo.CompactionFrom,
+						SegmentID:         segmentID,
+						CollectionID:      loadInfo.CollectionID,
+						PartitionID:       loadInfo.PartitionID,
+						NodeID:            dstNodeID,
+						SegmentState:      querypb.SegmentState_sealed,
+						CompactionFrom:    loadInfo.CompactionFrom,
+						CompactionOutputs: loadInfo.CompactionOutputs,
 					}
 					if _, ok := segmentInfosToSave[collectionID]; !ok {
 						segmentInfosToSave[collectionID] = make([]*querypb.SegmentInfo, 0)
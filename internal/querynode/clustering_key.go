@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// mayMatchClusteringKey returns false only if no entity in the clustering key range can satisfy the predicates,
+// so that the segment can be skipped. The parts of the predicates not on the clustering key may always match.
+func mayMatchClusteringKey(expr *planpb.Expr, keyRange *datapb.ClusteringKeyRange) bool {
+	if expr == nil || keyRange == nil {
+		return true
+	}
+
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		left := mayMatchClusteringKey(e.BinaryExpr.GetLeft(), keyRange)
+		right := mayMatchClusteringKey(e.BinaryExpr.GetRight(), keyRange)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return left && right
+		case planpb.BinaryExpr_LogicalOr:
+			return left || right
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		column := e.UnaryRangeExpr.GetColumnInfo()
+		if column.GetFieldId() != keyRange.GetFieldID() {
+			return true
+		}
+		toMin, ok1 := compareWithKeyBound(e.UnaryRangeExpr.GetValue(), column.GetDataType(), keyRange, false)
+		toMax, ok2 := compareWithKeyBound(e.UnaryRangeExpr.GetValue(), column.GetDataType(), keyRange, true)
+		if !ok1 || !ok2 {
+			return true
+		}
+		switch e.UnaryRangeExpr.GetOp() {
+		case planpb.OpType_GreaterThan:
+			return toMax < 0
+		case planpb.OpType_GreaterEqual:
+			return toMax <= 0
+		case planpb.OpType_LessThan:
+			return toMin > 0
+		case planpb.OpType_LessEqual:
+			return toMin >= 0
+		case planpb.OpType_Equal:
+			return toMin >= 0 && toMax <= 0
+		case planpb.OpType_NotEqual:
+			return toMin != 0 || toMax != 0
+		}
+	case *planpb.Expr_BinaryRangeExpr:
+		column := e.BinaryRangeExpr.GetColumnInfo()
+		if column.GetFieldId() != keyRange.GetFieldID() {
+			return true
+		}
+		lowerToMax, ok1 := compareWithKeyBound(e.BinaryRangeExpr.GetLowerValue(), column.GetDataType(), keyRange, true)
+		upperToMin, ok2 := compareWithKeyBound(e.BinaryRangeExpr.GetUpperValue(), column.GetDataType(), keyRange, false)
+		if !ok1 || !ok2 {
+			return true
+		}
+		lowerMatch := lowerToMax < 0 || (lowerToMax == 0 && e.BinaryRangeExpr.GetLowerInclusive())
+		upperMatch := upperToMin > 0 || (upperToMin == 0 && e.BinaryRangeExpr.GetUpperInclusive())
+		return lowerMatch && upperMatch
+	case *planpb.Expr_TermExpr:
+		column := e.TermExpr.GetColumnInfo()
+		if column.GetFieldId() != keyRange.GetFieldID() {
+			return true
+		}
+		for _, v := range e.TermExpr.GetValues() {
+			toMin, ok1 := compareWithKeyBound(v, column.GetDataType(), keyRange, false)
+			toMax, ok2 := compareWithKeyBound(v, column.GetDataType(), keyRange, true)
+			if !ok1 || !ok2 || (toMin >= 0 && toMax <= 0) {
+				return true
+			}
+		}
+		return false
+	}
+	// the other expressions, such as not, are not pruned
+	return true
+}
+
+// compareWithKeyBound compares the value with the min or max bound of the key range, returns -1, 0 or 1 if the value is
+// less than, equal to or greater than the bound, ok is false if they can't be compared
+func compareWithKeyBound(v *planpb.GenericValue, dataType schemapb.DataType, keyRange *datapb.ClusteringKeyRange, max bool) (int, bool) {
+	isInt := dataType == schemapb.DataType_Int8 || dataType == schemapb.DataType_Int16 ||
+		dataType == schemapb.DataType_Int32 || dataType == schemapb.DataType_Int64
+	if !isInt && dataType != schemapb.DataType_Float && dataType != schemapb.DataType_Double {
+		return 0, false
+	}
+	boundInt, boundFloat := keyRange.GetMinInt(), keyRange.GetMinFloat()
+	if max {
+		boundInt, boundFloat = keyRange.GetMaxInt(), keyRange.GetMaxFloat()
+	}
+
+	var value float64
+	switch val := v.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		if isInt {
+			return compareInt64(val.Int64Val, boundInt), true
+		}
+		value = float64(val.Int64Val)
+	case *planpb.GenericValue_FloatVal:
+		// how a floating point value is compared with integers is up to segcore, don't prune by it
+		if isInt {
+			return 0, false
+		}
+		value = val.FloatVal
+	default:
+		return 0, false
+	}
+	// float fields are compared in single precision
+	if dataType == schemapb.DataType_Float {
+		value = float64(float32(value))
+	}
+	return compareFloat64(value, boundFloat)
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(a, b float64) (int, bool) {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return 0, false
+	}
+	switch {
+	case a < b:
+		return -1, true
+	case a > b:
+		return 1, true
+	}
+	return 0, true
+}
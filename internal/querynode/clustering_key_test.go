@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestMayMatchClusteringKey(t *testing.T) {
+	intColumn := &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_Int64}
+	otherColumn := &planpb.ColumnInfo{FieldId: 102, DataType: schemapb.DataType_Int64}
+	intRange := &datapb.ClusteringKeyRange{FieldID: 101, MinInt: 10, MaxInt: 20}
+
+	intValue := func(v int64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+	}
+	floatValue := func(v float64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+	}
+	unary := func(column *planpb.ColumnInfo, op planpb.OpType, v *planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: column,
+			Op:         op,
+			Value:      v,
+		}}}
+	}
+	binary := func(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+			Op:    op,
+			Left:  left,
+			Right: right,
+		}}}
+	}
+
+	t.Run("no predicates or range", func(t *testing.T) {
+		assert.True(t, mayMatchClusteringKey(nil, intRange))
+		assert.True(t, mayMatchClusteringKey(unary(intColumn, planpb.OpType_Equal, intValue(1)), nil))
+	})
+
+	t.Run("unary range", func(t *testing.T) {
+		cases := []struct {
+			op    planpb.OpType
+			value int64
+			match bool
+		}{
+			{planpb.OpType_GreaterThan, 20, false},
+			{planpb.OpType_GreaterThan, 19, true},
+			{planpb.OpType_GreaterEqual, 20, true},
+			{planpb.OpType_GreaterEqual, 21, false},
+			{planpb.OpType_LessThan, 10, false},
+			{planpb.OpType_LessThan, 11, true},
+			{planpb.OpType_LessEqual, 10, true},
+			{planpb.OpType_LessEqual, 9, false},
+			{planpb.OpType_Equal, 15, true},
+			{planpb.OpType_Equal, 21, false},
+			{planpb.OpType_NotEqual, 15, true},
+		}
+		for _, c := range cases {
+			assert.Equal(t, c.match, mayMatchClusteringKey(unary(intColumn, c.op, intValue(c.value)), intRange), "%v %d", c.op, c.value)
+		}
+		assert.True(t, mayMatchClusteringKey(unary(otherColumn, planpb.OpType_Equal, intValue(100)), intRange))
+		// comparing integers with floating point values is not pruned
+		assert.True(t, mayMatchClusteringKey(unary(intColumn, planpb.OpType_Equal, floatValue(100)), intRange))
+
+		single := &datapb.ClusteringKeyRange{FieldID: 101, MinInt: 5, MaxInt: 5}
+		assert.False(t, mayMatchClusteringKey(unary(intColumn, planpb.OpType_NotEqual, intValue(5)), single))
+	})
+
+	t.Run("binary range", func(t *testing.T) {
+		binaryRange := func(lower, upper int64, lowerInclusive, upperInclusive bool) *planpb.Expr {
+			return &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+				ColumnInfo:     intColumn,
+				LowerInclusive: lowerInclusive,
+				UpperInclusive: upperInclusive,
+				LowerValue:     intValue(lower),
+				UpperValue:     intValue(upper),
+			}}}
+		}
+		assert.True(t, mayMatchClusteringKey(binaryRange(0, 30, false, false), intRange))
+		assert.True(t, mayMatchClusteringKey(binaryRange(12, 13, false, false), intRange))
+		assert.True(t, mayMatchClusteringKey(binaryRange(20, 30, true, false), intRange))
+		assert.False(t, mayMatchClusteringKey(binaryRange(20, 30, false, false), intRange))
+		assert.True(t, mayMatchClusteringKey(binaryRange(0, 10, false, true), intRange))
+		assert.False(t, mayMatchClusteringKey(binaryRange(0, 10, false, false), intRange))
+		assert.False(t, mayMatchClusteringKey(binaryRange(21, 30, true, true), intRange))
+	})
+
+	t.Run("term", func(t *testing.T) {
+		term := func(values ...int64) *planpb.Expr {
+			expr := &planpb.TermExpr{ColumnInfo: intColumn}
+			for _, v := range values {
+				expr.Values = append(expr.Values, intValue(v))
+			}
+			return &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: expr}}
+		}
+		assert.True(t, mayMatchClusteringKey(term(1, 15), intRange))
+		assert.False(t, mayMatchClusteringKey(term(1, 25), intRange))
+		assert.False(t, mayMatchClusteringKey(term(), intRange))
+	})
+
+	t.Run("logical", func(t *testing.T) {
+		match := unary(intColumn, planpb.OpType_Equal, intValue(15))
+		mismatch := unary(intColumn, planpb.OpType_Equal, intValue(25))
+		other := unary(otherColumn, planpb.OpType_Equal, intValue(25))
+		assert.False(t, mayMatchClusteringKey(binary(planpb.BinaryExpr_LogicalAnd, match, mismatch), intRange))
+		assert.True(t, mayMatchClusteringKey(binary(planpb.BinaryExpr_LogicalOr, match, mismatch), intRange))
+		assert.False(t, mayMatchClusteringKey(binary(planpb.BinaryExpr_LogicalAnd, other, mismatch), intRange))
+		assert.True(t, mayMatchClusteringKey(binary(planpb.BinaryExpr_LogicalOr, other, mismatch), intRange))
+
+		not := &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{
+			Op:    planpb.UnaryExpr_Not,
+			Child: mismatch,
+		}}}
+		assert.True(t, mayMatchClusteringKey(not, intRange))
+	})
+
+	t.Run("float key", func(t *testing.T) {
+		floatColumn := &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_Float}
+		floatRange := &datapb.ClusteringKeyRange{FieldID: 101, MinFloat: float64(float32(0.1)), MaxFloat: 2.5}
+		// the value is compared in single precision as the field
+		assert.True(t, mayMatchClusteringKey(unary(floatColumn, planpb.OpType_Equal, floatValue(0.1)), floatRange))
+		assert.True(t, mayMatchClusteringKey(unary(floatColumn, planpb.OpType_GreaterThan, intValue(2)), floatRange))
+		assert.False(t, mayMatchClusteringKey(unary(floatColumn, planpb.OpType_GreaterThan, floatValue(2.5)), floatRange))
+		assert.True(t, mayMatchClusteringKey(unary(floatColumn, planpb.OpType_LessThan, floatValue(math.NaN())), floatRange))
+
+		doubleColumn := &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_Double}
+		assert.False(t, mayMatchClusteringKey(unary(doubleColumn, planpb.OpType_Equal, floatValue(0.1)), floatRange))
+	})
+}
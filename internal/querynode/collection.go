@@ -324,7 +324,7 @@ func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Co
 		CCollection
 		NewCollection(const char* schema_proto_blob);
 	*/
-	schemaBlob := proto.MarshalTextString(schema)
+	schemaBlob := proto.MarshalTextString(stripSparseFloatVectorFields(schema))
 
 	cSchemaBlob := C.CString(schemaBlob)
 	collection := C.NewCollection(cSchemaBlob)
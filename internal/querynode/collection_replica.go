@@ -43,6 +43,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // ReplicaInterface specifies all the methods that the Collection object needs to implement in QueryNode.
@@ -66,6 +68,8 @@ type ReplicaInterface interface {
 	getPartitionIDs(collectionID UniqueID) ([]UniqueID, error)
 	// getVecFieldIDsByCollectionID returns vector field ids of collection
 	getVecFieldIDsByCollectionID(collectionID UniqueID) ([]FieldID, error)
+	// getScalarIndexFieldIDsByCollectionID returns ids of the scalar fields which scalar indexes can be built on
+	getScalarIndexFieldIDsByCollectionID(collectionID UniqueID) ([]FieldID, error)
 	// getPKFieldIDsByCollectionID returns vector field ids of collection
 	getPKFieldIDByCollectionID(collectionID UniqueID) (FieldID, error)
 	// getSegmentInfosByColID return segments info by collectionID
@@ -303,13 +307,36 @@ func (colReplica *collectionReplica) getVecFieldIDsByCollectionID(collectionID U
 
 	vecFields := make([]FieldID, 0)
 	for _, field := range fields {
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector ||
+			typeutil.IsHalfFloatVectorType(field.DataType) {
 			vecFields = append(vecFields, field.FieldID)
 		}
 	}
 	return vecFields, nil
 }
 
+// getScalarIndexFieldIDsByCollectionID returns ids of the scalar fields which scalar indexes can be built on
+func (colReplica *collectionReplica) getScalarIndexFieldIDsByCollectionID(collectionID UniqueID) ([]FieldID, error) {
+	colReplica.mu.RLock()
+	defer colReplica.mu.RUnlock()
+
+	fields, err := colReplica.getFieldsByCollectionIDPrivate(collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	scalarFields := make([]FieldID, 0)
+	for _, field := range fields {
+		if field.FieldID < common.StartOfUserFieldID {
+			continue
+		}
+		if indexparamcheck.CheckScalarIndexDataType(field.DataType) == nil {
+			scalarFields = append(scalarFields, field.FieldID)
+		}
+	}
+	return scalarFields, nil
+}
+
 // getPKFieldIDsByCollectionID returns vector field ids of collection
 func (colReplica *collectionReplica) getPKFieldIDByCollectionID(collectionID UniqueID) (FieldID, error) {
 	colReplica.mu.RLock()
@@ -62,6 +62,20 @@ func TestCollectionReplica_getCollectionByID(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCollectionReplica_getScalarIndexFieldIDsByCollectionID(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+	fieldIDs, err := node.historical.replica.getScalarIndexFieldIDsByCollectionID(collectionID)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []FieldID{101}, fieldIDs)
+
+	_, err = node.historical.replica.getScalarIndexFieldIDsByCollectionID(collectionID + 1)
+	assert.Error(t, err)
+	err = node.Stop()
+	assert.NoError(t, err)
+}
+
 func TestCollectionReplica_hasCollection(t *testing.T) {
 	node := newQueryNodeMock()
 	collectionID := UniqueID(0)
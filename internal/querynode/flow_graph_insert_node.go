@@ -25,7 +25,6 @@ import (
 	"strconv"
 	"sync"
 
-	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -35,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type insertNode struct {
@@ -86,7 +86,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		return []Msg{}
 	}
 
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range iMsg.insertMessages {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -192,7 +192,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		timeRange: iMsg.timeRange,
 	}
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 
 	return []Msg{res}
@@ -245,6 +245,10 @@ func filterSegmentsByPKs(pks []int64, segment *Segment) ([]int64, error) {
 	if segment == nil {
 		return nil, fmt.Errorf("segments is nil when getSegmentsByPKs")
 	}
+	// all the pks may be in a segment whose filter is incomplete
+	if !segment.pkFilterComplete {
+		return pks, nil
+	}
 	buf := make([]byte, 8)
 	res := make([]int64, 0)
 	for _, pk := range pks {
@@ -330,8 +334,23 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface)
 		log.Warn(err.Error())
 		return nil, err
 	}
+
+	rowData := msg.RowData
+	if typeutil.HasSparseVectorField(collection.schema) {
+		rowData, _, err = splitSparseFloatVectorRows(collection.schema, msg.RowData)
+		if err != nil {
+			log.Warn(err.Error())
+			return nil, err
+		}
+	}
+	return getPrimaryKeysFromRows(collection.schema, rowData)
+}
+
+// getPrimaryKeysFromRows reads the primary keys from row based data, sparse float vector fields should have
+// been stripped from the rows.
+func getPrimaryKeysFromRows(schema *schemapb.CollectionSchema, rowData []*commonpb.Blob) ([]int64, error) {
 	offset := 0
-	for _, field := range collection.schema.Fields {
+	for _, field := range schema.Fields {
 		if field.IsPrimaryKey {
 			break
 		}
@@ -362,6 +381,18 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface)
 					break
 				}
 			}
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			for _, t := range field.TypeParams {
+				if t.Key == "dim" {
+					dim, err := strconv.Atoi(t.Value)
+					if err != nil {
+						log.Error("strconv wrong on get dim", zap.Error(err))
+						return nil, err
+					}
+					offset += dim * 2
+					break
+				}
+			}
 		case schemapb.DataType_BinaryVector:
 			for _, t := range field.TypeParams {
 				if t.Key == "dim" {
@@ -377,8 +408,8 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface)
 		}
 	}
 
-	blobReaders := make([]io.Reader, len(msg.RowData))
-	for i, blob := range msg.RowData {
+	blobReaders := make([]io.Reader, len(rowData))
+	for i, blob := range rowData {
 		blobReaders[i] = bytes.NewReader(blob.GetValue()[offset : offset+8])
 	}
 	pks := make([]int64, len(blobReaders))
@@ -374,13 +374,21 @@ func TestGetSegmentsByPKs(t *testing.T) {
 		filter.Add(buf)
 	}
 	segment := &Segment{
-		segmentID: 1,
-		pkFilter:  filter,
+		segmentID:        1,
+		pkFilter:         filter,
+		pkFilterComplete: true,
 	}
 	pks, err := filterSegmentsByPKs([]int64{0, 1, 2, 3, 4}, segment)
 	assert.Nil(t, err)
 	assert.Equal(t, len(pks), 3)
 
+	// an incomplete filter excludes no pks
+	segment.pkFilterComplete = false
+	pks, err = filterSegmentsByPKs([]int64{0, 1, 2, 3, 4}, segment)
+	assert.Nil(t, err)
+	assert.Equal(t, len(pks), 5)
+	segment.pkFilterComplete = true
+
 	pks, err = filterSegmentsByPKs([]int64{}, segment)
 	assert.Nil(t, err)
 	assert.Equal(t, len(pks), 0)
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// Float16 and bfloat16 vectors are kept in segcore as they are, 2 bytes per dimension like in the binlogs.
+// Segcore searches them with float vector queries, so the half float vector queries are converted to
+// float32 before they are passed to segcore.
+
+func halfFloatBytesToFloat32s(dataType schemapb.DataType, data []byte) []float32 {
+	if dataType == schemapb.DataType_BFloat16Vector {
+		return typeutil.BFloat16BytesToFloat32s(data)
+	}
+	return typeutil.Float16BytesToFloat32s(data)
+}
+
+// newHalfFloatVectorField returns the vector field of dataType holding the encoded half float vectors
+func newHalfFloatVectorField(dataType schemapb.DataType, dim int64, data []byte) *schemapb.VectorField {
+	if dataType == schemapb.DataType_BFloat16Vector {
+		return &schemapb.VectorField{
+			Dim: dim,
+			Data: &schemapb.VectorField_Bfloat16Vector{
+				Bfloat16Vector: data,
+			},
+		}
+	}
+	return &schemapb.VectorField{
+		Dim: dim,
+		Data: &schemapb.VectorField_Float16Vector{
+			Float16Vector: data,
+		},
+	}
+}
+
+func float32sToBytes(data []float32) []byte {
+	result := make([]byte, 0, len(data)*4)
+	for _, f := range data {
+		result = append(result, typeutil.Float32ToBytes(f)...)
+	}
+	return result
+}
+
+// convertHalfFloatVectorPlaceholderGroup converts the half float vector queries to float vector queries
+func convertHalfFloatVectorPlaceholderGroup(blob []byte) ([]byte, error) {
+	group := &milvuspb.PlaceholderGroup{}
+	if err := proto.Unmarshal(blob, group); err != nil {
+		return nil, err
+	}
+	converted := false
+	for _, placeholder := range group.Placeholders {
+		var dataType schemapb.DataType
+		switch placeholder.Type {
+		case milvuspb.PlaceholderType_Float16Vector:
+			dataType = schemapb.DataType_Float16Vector
+		case milvuspb.PlaceholderType_BFloat16Vector:
+			dataType = schemapb.DataType_BFloat16Vector
+		default:
+			continue
+		}
+		for i, value := range placeholder.Values {
+			if len(value)%2 != 0 {
+				return nil, fmt.Errorf("invalid %s query of length %d", dataType.String(), len(value))
+			}
+			placeholder.Values[i] = float32sToBytes(halfFloatBytesToFloat32s(dataType, value))
+		}
+		placeholder.Type = milvuspb.PlaceholderType_FloatVector
+		converted = true
+	}
+	if !converted {
+		return blob, nil
+	}
+	return proto.Marshal(group)
+}
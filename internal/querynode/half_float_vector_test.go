@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func genHalfFloatVectorSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "half",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:  100,
+				Name:     "fp16",
+				DataType: schemapb.DataType_Float16Vector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "2"},
+				},
+			},
+			{
+				FieldID:      101,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  102,
+				Name:     "sparse",
+				DataType: schemapb.DataType_SparseFloatVector,
+			},
+			{
+				FieldID:  103,
+				Name:     "bf16",
+				DataType: schemapb.DataType_BFloat16Vector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "2"},
+				},
+			},
+		},
+	}
+}
+
+func TestHalfFloatVectorRows(t *testing.T) {
+	schema := genHalfFloatVectorSchema()
+	sparse := typeutil.CreateSparseFloatRow([]uint32{1}, []float32{1})
+	float16Vector := typeutil.Float32sToFloat16Bytes([]float32{1, 2})
+	bfloat16Vector := typeutil.Float32sToBFloat16Bytes([]float32{-1, 0.5})
+
+	var buffer bytes.Buffer
+	buffer.Write(float16Vector)
+	assert.NoError(t, binary.Write(&buffer, common.Endian, int64(7)))
+	assert.NoError(t, binary.Write(&buffer, common.Endian, uint32(1)))
+	buffer.Write(sparse)
+	buffer.Write(bfloat16Vector)
+	records := []*commonpb.Blob{{Value: buffer.Bytes()}}
+
+	pks, err := getPrimaryKeysFromRows(schema, records)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{7}, pks)
+
+	// the half float vectors are passed to segcore as they are
+	denseRows, sparseRows, err := splitSparseFloatVectorRows(schema, records)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{sparse}, sparseRows[102])
+
+	var expected bytes.Buffer
+	expected.Write(float16Vector)
+	assert.NoError(t, binary.Write(&expected, common.Endian, int64(7)))
+	expected.Write(bfloat16Vector)
+	assert.Equal(t, expected.Bytes(), denseRows[0].Value)
+
+	segcoreSchema := stripSparseFloatVectorFields(schema)
+	assert.Equal(t, 3, len(segcoreSchema.Fields))
+	assert.Equal(t, schemapb.DataType_Float16Vector, segcoreSchema.Fields[0].DataType)
+	assert.Equal(t, schemapb.DataType_BFloat16Vector, segcoreSchema.Fields[2].DataType)
+}
+
+func TestNewHalfFloatVectorField(t *testing.T) {
+	data := typeutil.Float32sToFloat16Bytes([]float32{1, 2, 3, 4})
+	field := newHalfFloatVectorField(schemapb.DataType_Float16Vector, 2, data)
+	assert.Equal(t, int64(2), field.GetDim())
+	assert.Equal(t, data, field.GetFloat16Vector())
+	assert.Nil(t, field.GetBfloat16Vector())
+
+	data = typeutil.Float32sToBFloat16Bytes([]float32{-1, 0.5})
+	field = newHalfFloatVectorField(schemapb.DataType_BFloat16Vector, 2, data)
+	assert.Equal(t, data, field.GetBfloat16Vector())
+	assert.Nil(t, field.GetFloat16Vector())
+}
+
+func TestConvertHalfFloatVectorPlaceholderGroup(t *testing.T) {
+	marshal := func(group *milvuspb.PlaceholderGroup) []byte {
+		blob, err := proto.Marshal(group)
+		assert.NoError(t, err)
+		return blob
+	}
+
+	blob := marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{
+				Tag:    "$0",
+				Type:   milvuspb.PlaceholderType_BFloat16Vector,
+				Values: [][]byte{typeutil.Float32sToBFloat16Bytes([]float32{1, -2})},
+			},
+		},
+	})
+	converted, err := convertHalfFloatVectorPlaceholderGroup(blob)
+	assert.NoError(t, err)
+	group := &milvuspb.PlaceholderGroup{}
+	assert.NoError(t, proto.Unmarshal(converted, group))
+	assert.Equal(t, milvuspb.PlaceholderType_FloatVector, group.Placeholders[0].Type)
+	assert.Equal(t, float32sToBytes([]float32{1, -2}), group.Placeholders[0].Values[0])
+
+	// float vector queries are untouched
+	blob = marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{
+				Tag:    "$0",
+				Type:   milvuspb.PlaceholderType_FloatVector,
+				Values: [][]byte{float32sToBytes([]float32{1, -2})},
+			},
+		},
+	})
+	converted, err = convertHalfFloatVectorPlaceholderGroup(blob)
+	assert.NoError(t, err)
+	assert.Equal(t, blob, converted)
+
+	blob = marshal(&milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{
+				Tag:    "$0",
+				Type:   milvuspb.PlaceholderType_Float16Vector,
+				Values: [][]byte{{1, 2, 3}},
+			},
+		},
+	})
+	_, err = convertHalfFloatVectorPlaceholderGroup(blob)
+	assert.Error(t, err)
+
+	_, err = convertHalfFloatVectorPlaceholderGroup([]byte{0xff})
+	assert.Error(t, err)
+}
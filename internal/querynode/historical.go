@@ -30,6 +30,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -47,6 +48,8 @@ type historical struct {
 	globalSealedSegments map[UniqueID]*querypb.SegmentInfo
 
 	etcdKV *etcdkv.EtcdKV
+
+	indexLoader *indexLoader
 }
 
 // newHistorical returns a new historical
@@ -172,8 +175,11 @@ func (h *historical) removeGlobalSegmentIDsByPartitionIds(partitionIDs []UniqueI
 	}
 }
 
-func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.ChunkManager,
-	plan *RetrievePlan) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
+// retrieve retrieves the sealed segments of the partitions, if pks is not nil, the segments
+// whose pk bloom filter excludes all the pks are skipped but still reported as retrieved.
+// So are the segments whose clustering key range can't match the predicates.
+func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, pks []int64, vcm storage.ChunkManager,
+	plan *RetrievePlan, predicates *planpb.Expr) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
 
 	retrieveResults := make([]*segcorepb.RetrieveResults, 0)
 	retrieveSegmentIDs := make([]UniqueID, 0)
@@ -205,6 +211,10 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
 			}
+			if (pks != nil && !seg.mayContainPKs(pks)) || !mayMatchClusteringKey(predicates, seg.clusteringKeyRange) {
+				retrieveSegmentIDs = append(retrieveSegmentIDs, segID)
+				continue
+			}
 			result, err := seg.retrieve(plan)
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
@@ -220,9 +230,44 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 	return retrieveResults, retrieveSegmentIDs, nil
 }
 
-// search will search all the target segments in historical
+// search will search all the target segments in historical, the segments whose clustering key range
+// can't match the predicates are skipped but still reported as searched
+// loadNamedIndex loads the index named indexName onto the sealed segments to search if it was built after they were loaded
+func (h *historical) loadNamedIndex(collID UniqueID, partIDs []UniqueID, fieldID FieldID, indexName string) error {
+	if h.indexLoader == nil {
+		return errors.New("null index loader, collectionID = " + fmt.Sprintln(collID))
+	}
+	var err error
+	if len(partIDs) == 0 {
+		partIDs, err = h.replica.getPartitionIDs(collID)
+		if err != nil {
+			return err
+		}
+	}
+	for _, partID := range partIDs {
+		segIDs, err := h.replica.getSegmentIDs(partID)
+		if err != nil {
+			// the partition may have been released, which is checked by search
+			continue
+		}
+		for _, segID := range segIDs {
+			seg, err := h.replica.getSegmentByID(segID)
+			if err != nil {
+				return err
+			}
+			if !seg.getOnService() {
+				continue
+			}
+			if err = h.indexLoader.loadMissingNamedIndex(seg, fieldID, indexName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, plan *SearchPlan,
-	searchTs Timestamp) ([]*SearchResult, []UniqueID, error) {
+	searchTs Timestamp, predicates *planpb.Expr) ([]*SearchResult, []UniqueID, error) {
 
 	searchResults := make([]*SearchResult, 0)
 	searchSegmentIDs := make([]UniqueID, 0)
@@ -298,6 +343,12 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 				if !seg.getOnService() {
 					return
 				}
+				if !mayMatchClusteringKey(predicates, seg.clusteringKeyRange) {
+					segmentLock.Lock()
+					searchSegmentIDs = append(searchSegmentIDs, seg.segmentID)
+					segmentLock.Unlock()
+					return
+				}
 				searchResult, err := seg.search(plan, searchReqs, []Timestamp{searchTs})
 				if err != nil {
 					err2 = err
@@ -23,6 +23,8 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -95,6 +97,33 @@ func TestHistorical_GlobalSealedSegments(t *testing.T) {
 	emptySegmentCheck()
 }
 
+func TestHistorical_Retrieve(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tSafe := newTSafeReplica(ctx)
+	his, err := genSimpleHistorical(ctx, tSafe)
+	assert.NoError(t, err)
+
+	plan, err := genSimpleRetrievePlan()
+	assert.NoError(t, err)
+	defer plan.delete()
+
+	// the segment is loaded without stats logs, it's not pruned by its empty bloom filter
+	res, ids, err := his.retrieve(defaultCollectionID, []UniqueID{defaultPartitionID}, []int64{1}, nil, plan, nil)
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, []UniqueID{defaultSegmentID}, ids)
+
+	seg, err := his.replica.getSegmentByID(defaultSegmentID)
+	assert.NoError(t, err)
+	seg.pkFilterComplete = true
+	res, ids, err = his.retrieve(defaultCollectionID, []UniqueID{defaultPartitionID}, []int64{1}, nil, plan, nil)
+	assert.NoError(t, err)
+	assert.Len(t, res, 0)
+	assert.Equal(t, []UniqueID{defaultSegmentID}, ids)
+}
+
 func TestHistorical_Search(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -107,8 +136,31 @@ func TestHistorical_Search(t *testing.T) {
 		plan, searchReqs, err := genSimpleSearchPlanAndRequests()
 		assert.NoError(t, err)
 
-		_, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
+		_, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test search pruned by clustering key", func(t *testing.T) {
+		tSafe := newTSafeReplica(ctx)
+		his, err := genSimpleHistorical(ctx, tSafe)
+		assert.NoError(t, err)
+
+		plan, searchReqs, err := genSimpleSearchPlanAndRequests()
+		assert.NoError(t, err)
+
+		seg, err := his.replica.getSegmentByID(defaultSegmentID)
+		assert.NoError(t, err)
+		seg.clusteringKeyRange = &datapb.ClusteringKeyRange{FieldID: simpleConstField.id, MinInt: 0, MaxInt: 10}
+
+		predicates := &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: simpleConstField.id, DataType: simpleConstField.dataType},
+			Op:         planpb.OpType_GreaterThan,
+			Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 100}},
+		}}}
+		res, ids, err := his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0), predicates)
 		assert.NoError(t, err)
+		assert.Equal(t, 0, len(res))
+		assert.Equal(t, []UniqueID{defaultSegmentID}, ids)
 	})
 
 	t.Run("test no collection - search partitions", func(t *testing.T) {
@@ -122,7 +174,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removeCollection(defaultCollectionID)
 		assert.NoError(t, err)
 
-		_, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		_, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0), nil)
 		assert.Error(t, err)
 	})
 
@@ -137,7 +189,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removeCollection(defaultCollectionID)
 		assert.NoError(t, err)
 
-		_, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
+		_, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0), nil)
 		assert.Error(t, err)
 	})
 
@@ -156,7 +208,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		_, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		_, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0), nil)
 		assert.Error(t, err)
 	})
 
@@ -171,7 +223,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		res, ids, err := his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		res, ids, err := his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0), nil)
 		assert.Nil(t, res)
 		assert.Nil(t, ids)
 		assert.NoError(t, err)
@@ -192,7 +244,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		res, ids, err := his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
+		res, ids, err := his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0), nil)
 		assert.Nil(t, res)
 		assert.Nil(t, ids)
 		assert.Error(t, err)
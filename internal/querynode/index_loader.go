@@ -21,12 +21,12 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/retry"
 )
 
@@ -51,6 +52,8 @@ type indexLoader struct {
 	indexCoord types.IndexCoord
 
 	kv kv.DataKV // minio kv
+
+	namedIndexMu sync.Mutex // serializes loading named indexes onto loaded segments
 }
 
 func (loader *indexLoader) loadIndex(segment *Segment, fieldID FieldID) error {
@@ -92,10 +95,12 @@ func (loader *indexLoader) loadIndex(segment *Segment, fieldID FieldID) error {
 	if err != nil {
 		return err
 	}
-	// 3. drop vector field data if index loaded successfully
-	err = segment.dropFieldData(fieldID)
-	if err != nil {
-		return err
+	// 3. drop vector field data if index loaded successfully, scalar field data is still needed by output fields
+	if !isScalarIndexParam(indexParams) {
+		err = segment.dropFieldData(fieldID)
+		if err != nil {
+			return err
+		}
 	}
 	log.Debug("load index done")
 	return nil
@@ -151,9 +156,13 @@ func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexPa
 	return index, indexParams, indexName, nil
 }
 
+// estimateIndexBinlogSize estimates the size of the default and the named indexes of the field
 func (loader *indexLoader) estimateIndexBinlogSize(segment *Segment, fieldID FieldID) (int64, error) {
 	indexSize := int64(0)
-	indexPaths := segment.getIndexPaths(fieldID)
+	indexPaths := append([]string{}, segment.getIndexPaths(fieldID)...)
+	for _, info := range segment.getNamedIndexInfos(fieldID) {
+		indexPaths = append(indexPaths, info.getIndexPaths()...)
+	}
 	for _, p := range indexPaths {
 		logSize, err := storage.EstimateMemorySize(loader.kv, p)
 		if err != nil {
@@ -173,7 +182,9 @@ func (loader *indexLoader) estimateIndexBinlogSize(segment *Segment, fieldID Fie
 	return indexSize, nil
 }
 
-func (loader *indexLoader) getIndexInfo(collectionID UniqueID, segment *Segment) (*indexInfo, error) {
+// getIndexInfo gets the info of the index named indexName on the field of the segment,
+// the default index of the field is used if indexName is empty
+func (loader *indexLoader) getIndexInfo(collectionID UniqueID, segment *Segment, fieldID FieldID, indexName string) (*indexInfo, error) {
 	if loader.indexCoord == nil || loader.rootCoord == nil {
 		return nil, errors.New("null indexcoord client or rootcoord client, collectionID = " +
 			fmt.Sprintln(collectionID))
@@ -186,6 +197,8 @@ func (loader *indexLoader) getIndexInfo(collectionID UniqueID, segment *Segment)
 		},
 		CollectionID: collectionID,
 		SegmentID:    segment.segmentID,
+		FieldID:      fieldID,
+		IndexName:    indexName,
 	}
 	resp, err := loader.rootCoord.DescribeSegment(loader.ctx, req)
 	if err != nil {
@@ -238,20 +251,114 @@ func (loader *indexLoader) setIndexInfo(segment *Segment, info *indexInfo) {
 	segment.setIndexInfo(info.fieldID, info)
 }
 
-func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface) *indexLoader {
-	option := &minioKV.Option{
-		Address:           Params.MinioEndPoint,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSLStr,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
+// getNamedIndexInfos gets the infos of the indexes on the vector field of the segment other than the
+// default one, they are loaded besides the default index so that a search could choose one by name
+func (loader *indexLoader) getNamedIndexInfos(collection *Collection, segment *Segment, defaultInfo *indexInfo) ([]*indexInfo, error) {
+	var fieldName string
+	for _, field := range collection.schema.Fields {
+		if field.FieldID == defaultInfo.fieldID {
+			fieldName = field.Name
+		}
+	}
+	req := &milvuspb.DescribeIndexRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_DescribeIndex,
+		},
+		CollectionName: collection.schema.Name,
+		FieldName:      fieldName,
+	}
+	resp, err := loader.rootCoord.DescribeIndex(loader.ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+
+	infos := make([]*indexInfo, 0)
+	for _, desc := range resp.IndexDescriptions {
+		if desc.FieldName != fieldName {
+			continue
+		}
+		if desc.IndexID == defaultInfo.indexID {
+			// recorded so that a search naming the default index doesn't load it again
+			defaultInfo.setIndexName(desc.IndexName)
+			continue
+		}
+		info, err := loader.getIndexInfo(collection.id, segment, defaultInfo.fieldID, desc.IndexName)
+		if err != nil {
+			// the index may not be built on the segment yet, it is loaded when a search names it
+			log.Warn("get named index info failed", zap.Int64("segmentID", segment.segmentID),
+				zap.String("indexName", desc.IndexName), zap.Error(err))
+			continue
+		}
+		if info.indexID != desc.IndexID || info.fieldID != defaultInfo.fieldID {
+			continue
+		}
+		info.setIndexName(desc.IndexName)
+		infos = append(infos, info)
 	}
+	return infos, nil
+}
 
-	client, err := minioKV.NewMinIOKV(ctx, option)
+// loadNamedIndex loads a named index besides the default index of the field, which must have been loaded
+func (loader *indexLoader) loadNamedIndex(segment *Segment, info *indexInfo) error {
+	var indexBuffer [][]byte
+	var indexParams indexParam
+	fn := func() error {
+		var err error
+		indexBuffer, indexParams, _, err = loader.getIndexBinlog(info.getIndexPaths())
+		return err
+	}
+	err := retry.Do(loader.ctx, fn, retry.Attempts(10),
+		retry.Sleep(time.Second*1), retry.MaxSleepTime(time.Second*10))
+	if err != nil {
+		return err
+	}
+	info.setIndexParams(indexParams)
+	if err = segment.updateSegmentNamedIndex(indexBuffer, info); err != nil {
+		return err
+	}
+	log.Debug("load named index done", zap.Int64("segmentID", segment.segmentID),
+		zap.Int64("fieldID", info.fieldID), zap.String("indexName", info.getIndexName()))
+	return nil
+}
+
+// loadMissingNamedIndex loads the index named indexName onto a segment whose default index has been loaded,
+// the index may be built after the segment was loaded. The raw data of an indexed field is not loaded, so
+// the search could not fall back to it and an error is returned if the index is not built on the segment yet
+func (loader *indexLoader) loadMissingNamedIndex(segment *Segment, fieldID FieldID, indexName string) error {
+	loader.namedIndexMu.Lock()
+	defer loader.namedIndexMu.Unlock()
+
+	if !segment.checkIndexReady(fieldID) || segment.hasIndex(fieldID, indexName) {
+		return nil
+	}
+	info, err := loader.getIndexInfo(segment.collectionID, segment, fieldID, indexName)
+	if err != nil {
+		return fmt.Errorf("index %s is not loaded on segment %d, it may still be building, error = %w",
+			indexName, segment.segmentID, err)
+	}
+	if info.fieldID != fieldID {
+		return fmt.Errorf("index %s is not built on field %d", indexName, fieldID)
+	}
+	if info.indexID == segment.getIndexID(fieldID) {
+		return segment.setIndexName(fieldID, indexName)
+	}
+	info.setIndexName(indexName)
+	if err = loader.loadNamedIndex(segment, info); err != nil {
+		return err
+	}
+	segment.setNamedIndexInfo(fieldID, info)
+	return nil
+}
+
+func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface) *indexLoader {
+	cm, err := newChunkManager(ctx)
 	if err != nil {
 		panic(err)
 	}
+	client := storage.NewChunkManagerKV(cm)
 
 	return &indexLoader{
 		ctx:     ctx,
@@ -431,3 +538,8 @@ func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord t
 //	log.Debug("sent field stats")
 //	return nil
 //}
+
+// isScalarIndexParam returns true if the index params belong to an index on a scalar field
+func isScalarIndexParam(indexParams indexParam) bool {
+	return indexparamcheck.IsScalarIndexType(indexParams["index_type"])
+}
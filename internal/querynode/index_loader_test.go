@@ -41,7 +41,7 @@ func TestIndexLoader_setIndexInfo(t *testing.T) {
 		loader.indexLoader.rootCoord = newMockRootCoord()
 		loader.indexLoader.indexCoord = newMockIndexCoord()
 
-		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment)
+		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment, simpleVecField.id, "")
 		assert.NoError(t, err)
 		loader.indexLoader.setIndexInfo(segment, info)
 	})
@@ -55,7 +55,7 @@ func TestIndexLoader_setIndexInfo(t *testing.T) {
 		segment, err := genSimpleSealedSegment()
 		assert.NoError(t, err)
 
-		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment)
+		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment, simpleVecField.id, "")
 		assert.NoError(t, err)
 		loader.indexLoader.setIndexInfo(segment, info)
 	})
@@ -123,7 +123,7 @@ func TestIndexLoader_loadIndex(t *testing.T) {
 		loader.indexLoader.rootCoord = newMockRootCoord()
 		loader.indexLoader.indexCoord = newMockIndexCoord()
 
-		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment)
+		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment, simpleVecField.id, "")
 		assert.NoError(t, err)
 		loader.indexLoader.setIndexInfo(segment, info)
 
@@ -131,6 +131,86 @@ func TestIndexLoader_loadIndex(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test loadNamedIndex", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+		loader := node.loader
+		assert.NotNil(t, loader)
+
+		segment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+
+		loader.indexLoader.rootCoord = newMockRootCoord()
+		loader.indexLoader.indexCoord = newMockIndexCoord()
+
+		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment, simpleVecField.id, "")
+		assert.NoError(t, err)
+		loader.indexLoader.setIndexInfo(segment, info)
+
+		err = loader.indexLoader.loadIndex(segment, simpleVecField.id)
+		assert.NoError(t, err)
+
+		collection, err := node.historical.replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		infos, err := loader.indexLoader.getNamedIndexInfos(collection, segment, info)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(infos))
+		assert.Equal(t, namedIndexName, infos[0].getIndexName())
+		assert.Equal(t, namedIndexID, infos[0].getIndexID())
+		segment.setNamedIndexInfo(simpleVecField.id, infos[0])
+
+		// the named index is loaded besides the default one
+		err = loader.indexLoader.loadNamedIndex(segment, infos[0])
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(segment.getNamedIndexInfos(simpleVecField.id)))
+		// loading a named index twice fails
+		err = loader.indexLoader.loadNamedIndex(segment, infos[0])
+		assert.Error(t, err)
+	})
+
+	t.Run("test loadMissingNamedIndex", func(t *testing.T) {
+		node, err := genSimpleQueryNode(ctx)
+		assert.NoError(t, err)
+		loader := node.loader
+		assert.NotNil(t, loader)
+
+		segment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+
+		loader.indexLoader.rootCoord = newMockRootCoord()
+		loader.indexLoader.indexCoord = newMockIndexCoord()
+
+		// only the default index is loaded, the named one is built afterwards
+		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment, simpleVecField.id, "")
+		assert.NoError(t, err)
+		loader.indexLoader.setIndexInfo(segment, info)
+		err = loader.indexLoader.loadIndex(segment, simpleVecField.id)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(segment.getNamedIndexInfos(simpleVecField.id)))
+
+		err = loader.indexLoader.loadMissingNamedIndex(segment, simpleVecField.id, namedIndexName)
+		assert.NoError(t, err)
+		infos := segment.getNamedIndexInfos(simpleVecField.id)
+		assert.Equal(t, 1, len(infos))
+		assert.Equal(t, namedIndexID, infos[0].getIndexID())
+		assert.True(t, segment.hasIndex(simpleVecField.id, namedIndexName))
+
+		// loading it again is a no-op
+		err = loader.indexLoader.loadMissingNamedIndex(segment, simpleVecField.id, namedIndexName)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(segment.getNamedIndexInfos(simpleVecField.id)))
+
+		// naming the default index doesn't load it as a named one
+		err = loader.indexLoader.loadMissingNamedIndex(segment, simpleVecField.id, indexName)
+		assert.NoError(t, err)
+		assert.Equal(t, indexName, segment.getIndexName(simpleVecField.id))
+		assert.Equal(t, 1, len(segment.getNamedIndexInfos(simpleVecField.id)))
+
+		// an index not built on the segment yet fails the search
+		err = loader.indexLoader.loadMissingNamedIndex(segment, simpleVecField.id, "not-built-index")
+		assert.Error(t, err)
+	})
+
 	t.Run("test get indexinfo with empty indexFilePath", func(t *testing.T) {
 		node, err := genSimpleQueryNode(ctx)
 		assert.NoError(t, err)
@@ -146,7 +226,7 @@ func TestIndexLoader_loadIndex(t *testing.T) {
 
 		loader.indexLoader.indexCoord = ic
 
-		_, err = loader.indexLoader.getIndexInfo(defaultCollectionID, segment)
+		_, err = loader.indexLoader.getIndexInfo(defaultCollectionID, segment, simpleVecField.id, "")
 		assert.Error(t, err)
 	})
 
@@ -176,7 +256,7 @@ func TestIndexLoader_loadIndex(t *testing.T) {
 		loader.indexLoader.rootCoord = newMockRootCoord()
 		loader.indexLoader.indexCoord = newMockIndexCoord()
 
-		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment)
+		info, err := loader.indexLoader.getIndexInfo(defaultCollectionID, segment, simpleVecField.id, "")
 		assert.NoError(t, err)
 
 		vecFieldID := UniqueID(101)
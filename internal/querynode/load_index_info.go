@@ -61,6 +61,13 @@ func (li *LoadIndexInfo) appendFieldInfo(fieldID FieldID) error {
 	return HandleCStatus(&status, "AppendFieldInfo failed")
 }
 
+func (li *LoadIndexInfo) appendIndexName(indexName string) error {
+	cIndexName := C.CString(indexName)
+	defer C.free(unsafe.Pointer(cIndexName))
+	status := C.AppendIndexName(li.cLoadIndexInfo, cIndexName)
+	return HandleCStatus(&status, "AppendIndexName failed")
+}
+
 func (li *LoadIndexInfo) appendIndex(bytesIndex [][]byte, indexKeys []string) error {
 	var cBinarySet C.CBinarySet
 	status := C.NewBinarySet(&cBinarySet)
@@ -43,6 +43,18 @@ func (m *mockRootCoord) AlterAlias(ctx context.Context, req *milvuspb.AlterAlias
 	panic("implement me")
 }
 
+func (m *mockRootCoord) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoord) RecoverCollection(ctx context.Context, req *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoord) RecoverPartition(ctx context.Context, req *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func newMockRootCoord() *mockRootCoord {
 	return &mockRootCoord{
 		state: internalpb.StateCode_Healthy,
@@ -119,7 +131,15 @@ func (m *mockRootCoord) CreateIndex(ctx context.Context, req *milvuspb.CreateInd
 }
 
 func (m *mockRootCoord) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
-	panic("not implemented") // TODO: Implement
+	return &milvuspb.DescribeIndexResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IndexDescriptions: []*milvuspb.IndexDescription{
+			{IndexName: indexName, IndexID: indexID, FieldName: req.FieldName},
+			{IndexName: namedIndexName, IndexID: namedIndexID, FieldName: req.FieldName},
+		},
+	}, nil
 }
 
 func (m *mockRootCoord) DropIndex(ctx context.Context, req *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
@@ -135,11 +155,25 @@ func (m *mockRootCoord) AllocID(ctx context.Context, req *rootcoordpb.AllocIDReq
 }
 
 func (m *mockRootCoord) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	// the named index is built with the same files as the default one
+	segIndexID := indexID
+	switch req.IndexName {
+	case "", indexName:
+	case namedIndexName:
+		segIndexID = namedIndexID
+	default:
+		return &milvuspb.DescribeSegmentResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "index " + req.IndexName + " not found",
+			},
+		}, nil
+	}
 	return &milvuspb.DescribeSegmentResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
 		},
-		IndexID:     indexID,
+		IndexID:     segIndexID,
 		BuildID:     buildID,
 		EnableIndex: true,
 		FieldID:     fieldID,
@@ -220,3 +254,15 @@ func (m *mockIndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.Get
 func (m *mockIndexCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	panic("not implemented") // TODO: Implement
 }
+
+func (m *mockIndexCoord) ListIndexBuildTasks(ctx context.Context, req *indexpb.ListIndexBuildTasksRequest) (*indexpb.ListIndexBuildTasksResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockIndexCoord) CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockIndexCoord) RetryIndexBuild(ctx context.Context, req *indexpb.RetryIndexBuildRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
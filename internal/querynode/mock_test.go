@@ -86,6 +86,9 @@ const (
 	indexID   = UniqueID(0)
 	fieldID   = UniqueID(100)
 	indexName = "query-node-index-0"
+
+	namedIndexName = "query-node-named-index"
+	namedIndexID   = UniqueID(1)
 )
 
 // ---------- unittest util functions ----------
@@ -514,6 +517,15 @@ func genInsertData(msgLength int, schema *schemapb.CollectionSchema) (*storage.I
 				NumRows: []int64{int64(msgLength)},
 				Data:    data,
 			}
+		case schemapb.DataType_String:
+			data := make([]string, msgLength)
+			for i := 0; i < msgLength; i++ {
+				data[i] = strconv.Itoa(i)
+			}
+			insertData.Data[f.FieldID] = &storage.StringFieldData{
+				NumRows: []int64{int64(msgLength)},
+				Data:    data,
+			}
 		case schemapb.DataType_FloatVector:
 			dim := simpleVecField.dim // if no dim specified, use simpleVecField's dim
 			for _, p := range f.TypeParams {
@@ -1336,6 +1348,7 @@ func genSimpleQueryNode(ctx context.Context) (*QueryNode, error) {
 		return nil, err
 	}
 	node.loader = loader
+	node.historical.indexLoader = loader.indexLoader
 
 	// start task scheduler
 	go node.scheduler.Start()
@@ -57,6 +57,7 @@ type ParamTable struct {
 	MinioSecretAccessKey string
 	MinioUseSSLStr       bool
 	MinioBucketName      string
+	Storage              paramtable.StorageConfig
 
 	// search
 	SearchChannelNames         []string
@@ -120,6 +121,7 @@ func (p *ParamTable) Init() {
 	p.initMinioSecretAccessKey()
 	p.initMinioUseSSLStr()
 	p.initMinioBucketName()
+	p.initStorage()
 
 	p.initPulsarAddress()
 	p.initRocksmqPath()
@@ -227,6 +229,10 @@ func (p *ParamTable) initMinioBucketName() {
 	p.MinioBucketName = bucketName
 }
 
+func (p *ParamTable) initStorage() {
+	p.Storage = p.LoadStorageConfig()
+}
+
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
 	if err != nil {
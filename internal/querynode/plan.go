@@ -79,6 +79,14 @@ func (plan *SearchPlan) getMetricType() string {
 	return metricType
 }
 
+// setIndexName makes the plan search sealed segments with the index named name,
+// segments which don't have the index are searched with their default index
+func (plan *SearchPlan) setIndexName(name string) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	C.SetIndexName(plan.cSearchPlan, cName)
+}
+
 func (plan *SearchPlan) delete() {
 	C.DeleteSearchPlan(plan.cSearchPlan)
 }
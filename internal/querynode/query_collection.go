@@ -17,10 +17,12 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/golang/protobuf/proto"
-	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -31,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -54,8 +57,10 @@ type queryCollection struct {
 	historical   *historical
 	streaming    *streaming
 
-	unsolvedMsgMu sync.Mutex // guards unsolvedMsg
+	unsolvedMsgMu sync.Mutex // guards unsolvedMsg and unsolvedMsgTime
 	unsolvedMsg   []queryMsg
+	// the time when the unsolved msgs started waiting for tSafe, keyed by msgID
+	unsolvedMsgTime map[UniqueID]time.Time
 
 	tSafeWatchersMu sync.RWMutex // guards tSafeWatchers
 	tSafeWatchers   map[Channel]*tSafeWatcher
@@ -106,7 +111,8 @@ func newQueryCollection(releaseCtx context.Context,
 		tSafeUpdate:   false,
 		watcherCond:   sync.NewCond(&condMu),
 
-		unsolvedMsg: unsolvedMsg,
+		unsolvedMsg:     unsolvedMsg,
+		unsolvedMsgTime: make(map[UniqueID]time.Time),
 
 		queryMsgStream:       queryStream,
 		queryResultMsgStream: queryResultStream,
@@ -238,6 +244,9 @@ func (q *queryCollection) addToUnsolvedMsg(msg queryMsg) {
 	q.unsolvedMsgMu.Lock()
 	defer q.unsolvedMsgMu.Unlock()
 	q.unsolvedMsg = append(q.unsolvedMsg, msg)
+	if _, ok := q.unsolvedMsgTime[msg.ID()]; !ok {
+		q.unsolvedMsgTime[msg.ID()] = time.Now()
+	}
 }
 
 func (q *queryCollection) popAllUnsolvedMsg() []queryMsg {
@@ -249,6 +258,19 @@ func (q *queryCollection) popAllUnsolvedMsg() []queryMsg {
 	return ret
 }
 
+// popWaitTSafeDuration returns how long the msg has been waiting for tSafe in the unsolved msgs,
+// it's 0 if the msg is served as soon as it's received.
+func (q *queryCollection) popWaitTSafeDuration(msgID UniqueID) time.Duration {
+	q.unsolvedMsgMu.Lock()
+	defer q.unsolvedMsgMu.Unlock()
+	start, ok := q.unsolvedMsgTime[msgID]
+	if !ok {
+		return 0
+	}
+	delete(q.unsolvedMsgTime, msgID)
+	return time.Since(start)
+}
+
 func (q *queryCollection) waitNewTSafe() (Timestamp, error) {
 	q.watcherCond.L.Lock()
 	for !q.tSafeUpdate {
@@ -471,13 +493,12 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 			zap.String("msgType", msgTypeStr),
 		)
 		q.addToUnsolvedMsg(msg)
-		sp.LogFields(
-			oplog.String("send to unsolved buffer", "send to unsolved buffer"),
-			oplog.Object("guarantee ts", gt),
-			oplog.Object("serviceTime", st),
-			oplog.Float64("delta seconds", float64(guaranteeTs-serviceTime)/(1000.0*1000.0*1000.0)),
-		)
-		sp.Finish()
+		sp.AddEvent("send to unsolved buffer", oteltrace.WithAttributes(
+			attribute.String("guarantee ts", gt.String()),
+			attribute.String("serviceTime", st.String()),
+			attribute.Float64("delta seconds", float64(guaranteeTs-serviceTime)/(1000.0*1000.0*1000.0)),
+		))
+		sp.End()
 		return nil
 	}
 	tr.Record("get searchable time done")
@@ -520,7 +541,7 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		zap.String("msgType", msgTypeStr),
 	)
 	tr.Elapse("all done")
-	sp.Finish()
+	sp.End()
 	return nil
 }
 
@@ -610,7 +631,7 @@ func (q *queryCollection) doUnsolvedQueryMsg() {
 						)
 					}
 				}
-				sp.Finish()
+				sp.End()
 				log.Debug("do query done in doUnsolvedMsg",
 					zap.Int64("collectionID", q.collectionID),
 					zap.Int64("msgID", m.ID()),
@@ -866,6 +887,26 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
 			blobOffset += blobLen
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			dim, err := schema.GetVectorDimFromID(fieldID)
+			if err != nil {
+				return nil, err
+			}
+			blobLen := dim * 2
+			var colData []byte
+			for _, hit := range hits {
+				for _, row := range hit.RowData {
+					dataBlob := row[blobOffset : blobOffset+blobLen]
+					colData = append(colData, dataBlob...)
+				}
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: newHalfFloatVectorField(fieldMeta.DataType, int64(dim), colData),
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+			blobOffset += blobLen
 		case schemapb.DataType_BinaryVector:
 			dim, err := schema.GetVectorDimFromID(fieldID)
 			if err != nil {
@@ -909,9 +950,13 @@ func (q *queryCollection) search(msg queryMsg) error {
 
 	searchMsg := msg.(*msgstream.SearchMsg)
 	sp, ctx := trace.StartSpanFromContext(searchMsg.TraceCtx())
-	defer sp.Finish()
+	defer sp.End()
 	searchMsg.SetTraceCtx(ctx)
 	searchTimestamp := searchMsg.BeginTs()
+	cost := &internalpb.SearchCost{
+		NodeID:            Params.QueryNodeID,
+		WaitTsafeDuration: q.popWaitTSafeDuration(searchMsg.ID()).Milliseconds(),
+	}
 	travelTimestamp := searchMsg.TravelTimestamp
 
 	collection, err := q.streaming.replica.getCollectionByID(searchMsg.CollectionID)
@@ -924,6 +969,21 @@ func (q *queryCollection) search(msg queryMsg) error {
 		return err
 	}
 
+	var planNode *planpb.PlanNode
+	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
+		planNode = &planpb.PlanNode{}
+		if err = proto.Unmarshal(searchMsg.SerializedExprPlan, planNode); err != nil {
+			return err
+		}
+	}
+
+	// sparse float vector field is searched by brute force in go, segcore doesn't know it
+	if planNode != nil && typeutil.HasSparseVectorField(collection.schema) {
+		if getSparseFloatVectorField(collection.schema, planNode.GetVectorAnns().GetFieldId()) != nil {
+			return q.searchSparseFloatVector(searchMsg, collection, planNode, cost)
+		}
+	}
+
 	var plan *SearchPlan
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
 		expr := searchMsg.SerializedExprPlan
@@ -938,6 +998,18 @@ func (q *queryCollection) search(msg queryMsg) error {
 			return err
 		}
 	}
+	// the named indexes are loaded with sealed segments, the one specified by user is searched,
+	// it's loaded here if it was built after the segments were loaded
+	if searchMsg.IndexName != "" {
+		if planNode != nil {
+			err = q.historical.loadNamedIndex(collection.id, searchMsg.PartitionIDs,
+				planNode.GetVectorAnns().GetFieldId(), searchMsg.IndexName)
+			if err != nil {
+				return err
+			}
+		}
+		plan.setIndexName(searchMsg.IndexName)
+	}
 	topK := plan.getTopK()
 	if topK == 0 {
 		return fmt.Errorf("limit must be greater than 0")
@@ -946,6 +1018,12 @@ func (q *queryCollection) search(msg queryMsg) error {
 		return fmt.Errorf("limit %d is too large", topK)
 	}
 	searchRequestBlob := searchMsg.PlaceholderGroup
+	if typeutil.HasHalfFloatVectorField(collection.schema) {
+		searchRequestBlob, err = convertHalfFloatVectorPlaceholderGroup(searchRequestBlob)
+		if err != nil {
+			return err
+		}
+	}
 	searchReq, err := parseSearchRequest(plan, searchRequestBlob)
 	if err != nil {
 		return err
@@ -954,14 +1032,12 @@ func (q *queryCollection) search(msg queryMsg) error {
 	searchRequests := make([]*searchRequest, 0)
 	searchRequests = append(searchRequests, searchReq)
 
+	sp.SetAttributes(attribute.Int64("collectionID", searchMsg.CollectionID),
+		attribute.Int64("nq", queryNum), attribute.Int64("topK", topK))
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
-		sp.LogFields(oplog.String("statistical time", "stats start"),
-			oplog.Object("nq", queryNum),
-			oplog.Object("expr", searchMsg.SerializedExprPlan))
+		sp.SetAttributes(attribute.String("expr", string(searchMsg.SerializedExprPlan)))
 	} else {
-		sp.LogFields(oplog.String("statistical time", "stats start"),
-			oplog.Object("nq", queryNum),
-			oplog.Object("dsl", searchMsg.Dsl))
+		sp.SetAttributes(attribute.String("dsl", searchMsg.Dsl))
 	}
 
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("search %d(nq=%d, k=%d)", searchMsg.CollectionID, queryNum, topK))
@@ -977,24 +1053,36 @@ func (q *queryCollection) search(msg queryMsg) error {
 	searchResults := make([]*SearchResult, 0)
 
 	// historical search
-	hisSearchResults, sealedSegmentSearched, err := q.historical.search(searchRequests, collection.id, searchMsg.PartitionIDs, plan, travelTimestamp)
+	searchSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "QueryNode-Search-Historical")
+	hisSearchResults, sealedSegmentSearched, err := q.historical.search(searchRequests, collection.id, searchMsg.PartitionIDs, plan, travelTimestamp,
+		planNode.GetVectorAnns().GetPredicates())
+	searchSp.SetAttributes(attribute.Int("segments", len(hisSearchResults)))
+	trace.LogError(searchSp, err)
+	searchSp.End()
 	if err != nil {
 		return err
 	}
 	searchResults = append(searchResults, hisSearchResults...)
-	tr.Record("historical search done")
+	searchDuration := tr.Record("historical search done")
 
 	for _, channel := range collection.getVChannels() {
 		var strSearchResults []*SearchResult
+		searchSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "QueryNode-Search-Streaming",
+			oteltrace.WithAttributes(attribute.String("channel", channel)))
 		strSearchResults, err := q.streaming.search(searchRequests, collection.id, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
+		searchSp.SetAttributes(attribute.Int("segments", len(strSearchResults)))
+		trace.LogError(searchSp, err)
+		searchSp.End()
 		if err != nil {
 			return err
 		}
 		searchResults = append(searchResults, strSearchResults...)
 	}
-	tr.Record("streaming search done")
+	searchDuration += tr.Record("streaming search done")
+	cost.SearchDuration = searchDuration.Milliseconds()
+	cost.NumSegmentsSearched = int64(len(searchResults))
 
-	sp.LogFields(oplog.String("statistical time", "segment search end"))
+	sp.AddEvent("segment search end")
 	if len(searchResults) <= 0 {
 		for range searchRequests {
 			resultChannelInt := 0
@@ -1018,6 +1106,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 					SealedSegmentIDsSearched: sealedSegmentSearched,
 					ChannelIDsSearched:       collection.getVChannels(),
 					GlobalSealedSegmentIDs:   globalSealedSegments,
+					Cost:                     cost,
 				},
 			}
 			log.Debug("QueryNode Empty SearchResultMsg",
@@ -1038,23 +1127,30 @@ func (q *queryCollection) search(msg queryMsg) error {
 
 	numSegment := int64(len(searchResults))
 	var marshaledHits *MarshaledHits = nil
+	reduceSp, _ := trace.StartSpanFromContextWithOperationName(ctx, "QueryNode-Search-Reduce",
+		oteltrace.WithAttributes(attribute.Int64("segments", numSegment)))
 	err = reduceSearchResultsAndFillData(plan, searchResults, numSegment)
-	sp.LogFields(oplog.String("statistical time", "reduceSearchResults end"))
+	reduceSp.AddEvent("reduceSearchResults end")
 	if err != nil {
+		trace.LogError(reduceSp, err)
+		reduceSp.End()
 		return err
 	}
 	marshaledHits, err = reorganizeSearchResults(searchResults, numSegment)
-	sp.LogFields(oplog.String("statistical time", "reorganizeSearchResults end"))
+	reduceSp.AddEvent("reorganizeSearchResults end")
 	if err != nil {
+		trace.LogError(reduceSp, err)
+		reduceSp.End()
 		return err
 	}
 
 	hitsBlob, err := marshaledHits.getHitsBlob()
-	sp.LogFields(oplog.String("statistical time", "getHitsBlob end"))
+	trace.LogError(reduceSp, err)
+	reduceSp.End()
 	if err != nil {
 		return err
 	}
-	tr.Record("reduce result done")
+	cost.ReduceDuration = tr.Record("reduce result done").Milliseconds()
 
 	var offset int64 = 0
 	for index := range searchRequests {
@@ -1110,6 +1206,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 				SealedSegmentIDsSearched: sealedSegmentSearched,
 				ChannelIDsSearched:       collection.getVChannels(),
 				GlobalSealedSegmentIDs:   globalSealedSegments,
+				Cost:                     cost,
 			},
 		}
 		log.Debug("QueryNode SearchResultMsg",
@@ -1137,10 +1234,10 @@ func (q *queryCollection) search(msg queryMsg) error {
 		tr.Record("publish search result")
 	}
 
-	sp.LogFields(oplog.String("statistical time", "before free c++ memory"))
+	sp.AddEvent("before free c++ memory")
 	deleteSearchResults(searchResults)
 	deleteMarshaledHits(marshaledHits)
-	sp.LogFields(oplog.String("statistical time", "stats done"))
+	sp.AddEvent("stats done")
 	plan.delete()
 	searchReq.delete()
 	tr.Elapse("all done")
@@ -1156,9 +1253,10 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	// retrieveProtoBlob, err := proto.Marshal(&retrieveMsg.RetrieveRequest)
 	retrieveMsg := msg.(*msgstream.RetrieveMsg)
 	sp, ctx := trace.StartSpanFromContext(retrieveMsg.TraceCtx())
-	defer sp.Finish()
+	defer sp.End()
 	retrieveMsg.SetTraceCtx(ctx)
 	timestamp := retrieveMsg.RetrieveRequest.TravelTimestamp
+	waitTSafeDuration := q.popWaitTSafeDuration(retrieveMsg.ID())
 
 	collectionID := retrieveMsg.CollectionID
 	collection, err := q.streaming.replica.getCollectionByID(collectionID)
@@ -1173,6 +1271,12 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	}
 	defer plan.delete()
 
+	// the predicates prune the sealed segments by their clustering key ranges
+	planNode := &planpb.PlanNode{}
+	if err = proto.Unmarshal(expr, planNode); err != nil {
+		return err
+	}
+
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("retrieve %d", retrieveMsg.CollectionID))
 
 	var globalSealedSegments []UniqueID
@@ -1198,8 +1302,12 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 			}, q.localCacheEnabled)
 	}
 
+	// a retrieve by primary keys skips the segments by pk bloom filters and the channels not owning the keys
+	pks := retrieveMsg.Ids.GetIntId().GetData()
+
 	// historical retrieve
-	hisRetrieveResults, sealedSegmentRetrieved, err := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, q.vectorChunkManager, plan)
+	hisRetrieveResults, sealedSegmentRetrieved, err := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, pks, q.vectorChunkManager, plan,
+		planNode.GetPredicates())
 	if err != nil {
 		return err
 	}
@@ -1207,7 +1315,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	tr.Record("historical retrieve done")
 
 	// streaming retrieve
-	strRetrieveResults, _, err := q.streaming.retrieve(collectionID, retrieveMsg.PartitionIDs, plan)
+	strRetrieveResults, _, err := q.streaming.retrieve(collectionID, retrieveMsg.PartitionIDs, pks, retrieveMsg.DmlChannels, plan)
 	if err != nil {
 		return err
 	}
@@ -1247,6 +1355,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		zap.Any("vChannels", collection.getVChannels()),
 		zap.Any("collectionID", collection.ID()),
 		zap.Any("sealedSegmentRetrieved", sealedSegmentRetrieved),
+		zap.Duration("waitTSafe", waitTSafeDuration),
 	)
 	tr.Elapse("all done")
 	return nil
@@ -1308,7 +1417,7 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 
 func (q *queryCollection) publishQueryResult(msg msgstream.TsMsg, collectionID UniqueID) error {
 	span, ctx := trace.StartSpanFromContext(msg.TraceCtx())
-	defer span.Finish()
+	defer span.End()
 	msg.SetTraceCtx(ctx)
 	msgPack := msgstream.MsgPack{}
 	msgPack.Msgs = append(msgPack.Msgs, msg)
@@ -1323,7 +1432,7 @@ func (q *queryCollection) publishQueryResult(msg msgstream.TsMsg, collectionID U
 func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg string) error {
 	msgType := msg.Type()
 	span, ctx := trace.StartSpanFromContext(msg.TraceCtx())
-	defer span.Finish()
+	defer span.End()
 	msg.SetTraceCtx(ctx)
 	msgPack := msgstream.MsgPack{}
 
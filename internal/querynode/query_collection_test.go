@@ -251,6 +251,12 @@ func TestQueryCollection_unsolvedMsg(t *testing.T) {
 	res := queryCollection.popAllUnsolvedMsg()
 	assert.NotNil(t, res)
 	assert.Len(t, res, 1)
+
+	// the msg keeps the time it started waiting when it's added back
+	time.Sleep(10 * time.Millisecond)
+	queryCollection.addToUnsolvedMsg(qm)
+	assert.GreaterOrEqual(t, queryCollection.popWaitTSafeDuration(qm.ID()), 10*time.Millisecond)
+	assert.Equal(t, time.Duration(0), queryCollection.popWaitTSafeDuration(qm.ID()))
 }
 
 func TestQueryCollection_consumeQuery(t *testing.T) {
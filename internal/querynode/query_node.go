@@ -215,6 +215,7 @@ func (node *QueryNode) Init() error {
 			node.streaming.replica,
 			node.etcdKV,
 			node.msFactory)
+		node.historical.indexLoader = node.loader.indexLoader
 
 		node.statsService = newStatsService(node.queryNodeLoopCtx, node.historical.replica, node.loader.indexLoader.fieldStatsChan, node.msFactory)
 		node.dataSyncService = newDataSyncService(node.queryNodeLoopCtx, streamingReplica, historicalReplica, node.tSafeReplica, node.msFactory)
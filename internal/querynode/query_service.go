@@ -21,7 +21,6 @@ import (
 
 	"go.uber.org/zap"
 
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -44,6 +43,19 @@ type queryService struct {
 	localCacheEnabled  bool
 }
 
+// newChunkManager creates the ChunkManager binlogs and index files are kept in.
+func newChunkManager(ctx context.Context) (storage.ChunkManager, error) {
+	return storage.NewChunkManager(ctx, &storage.Option{
+		StorageConfig:     Params.Storage,
+		Address:           Params.MinioEndPoint,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSLStr,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	})
+}
+
 func newQueryService(ctx context.Context,
 	historical *historical,
 	streaming *streaming,
@@ -61,20 +73,10 @@ func newQueryService(ctx context.Context,
 
 	localChunkManager := storage.NewLocalChunkManager(path)
 
-	option := &miniokv.Option{
-		Address:           Params.MinioEndPoint,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSLStr,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
-	}
-
-	client, err := miniokv.NewMinIOKV(ctx, option)
+	remoteChunkManager, err := newChunkManager(ctx)
 	if err != nil {
 		panic(err)
 	}
-	remoteChunkManager := storage.NewMinioChunkManager(client)
 
 	return &queryService{
 		ctx:    queryServiceCtx,
@@ -95,6 +95,8 @@ type Segment struct {
 
 	paramMutex sync.RWMutex // guards index
 	indexInfos map[FieldID]*indexInfo
+	// namedIndexInfos are the named indexes loaded besides the default index of a vector field
+	namedIndexInfos map[FieldID]map[string]*indexInfo
 
 	idBinlogRowSizes []int64
 
@@ -102,6 +104,14 @@ type Segment struct {
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+	// pkFilterComplete is true if all the pks of the segment are added to pkFilter, segments are only
+	// pruned by the filter if it's complete. Growing segments add pks on insert, the loaded segments
+	// add pks from their stats logs, which may be missing.
+	pkFilterComplete bool
+
+	clusteringKeyRange *datapb.ClusteringKeyRange // value range of the clustering key, nil if the segment is not clustered
+
+	sparseStore *sparseFloatVectorStore // sparse float vector rows, nil if there is no sparse float vector field
 }
 
 // ID returns the identity number.
@@ -206,9 +216,14 @@ func newSegment(collection *Collection, segmentID UniqueID, partitionID UniqueID
 		vChannelID:       vChannelID,
 		onService:        onService,
 		indexInfos:       make(map[int64]*indexInfo),
+		namedIndexInfos:  make(map[int64]map[string]*indexInfo),
 		vectorFieldInfos: make(map[UniqueID]*VectorFieldInfo),
 
-		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		pkFilter:         bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		pkFilterComplete: segType == segmentTypeGrowing,
+	}
+	if typeutil.HasSparseVectorField(collection.schema) {
+		segment.sparseStore = newSparseFloatVectorStore(collection.schema)
 	}
 
 	return segment
@@ -387,6 +402,19 @@ func (s *Segment) fillVectorFieldsData(collectionID UniqueID,
 				}
 				resultLen := dim / 8
 				copy(x.BinaryVector[i*int(resultLen):(i+1)*int(resultLen)], content)
+			case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+				rowBytes := dim * 2
+				content := make([]byte, rowBytes)
+				if _, err = vcm.ReadAt(vecPath, content, offset*rowBytes); err != nil {
+					return err
+				}
+				var vectors []byte
+				if fieldData.Type == schemapb.DataType_Float16Vector {
+					vectors = fieldData.GetVectors().GetFloat16Vector()
+				} else {
+					vectors = fieldData.GetVectors().GetBfloat16Vector()
+				}
+				copy(vectors[i*int(rowBytes):(i+1)*int(rowBytes)], content)
 			case schemapb.DataType_FloatVector:
 				x := fieldData.GetVectors().GetData().(*schemapb.VectorField_FloatVector)
 				rowBytes := dim * 4
@@ -531,6 +559,36 @@ func (s *Segment) matchIndexParam(fieldID int64, indexParams indexParam) bool {
 	return paramSize == matchCount
 }
 
+func (s *Segment) setNamedIndexInfo(fieldID int64, info *indexInfo) {
+	s.paramMutex.Lock()
+	defer s.paramMutex.Unlock()
+	if _, ok := s.namedIndexInfos[fieldID]; !ok {
+		s.namedIndexInfos[fieldID] = make(map[string]*indexInfo)
+	}
+	s.namedIndexInfos[fieldID][info.getIndexName()] = info
+}
+
+func (s *Segment) getNamedIndexInfos(fieldID int64) []*indexInfo {
+	s.paramMutex.RLock()
+	defer s.paramMutex.RUnlock()
+	infos := make([]*indexInfo, 0, len(s.namedIndexInfos[fieldID]))
+	for _, info := range s.namedIndexInfos[fieldID] {
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// hasIndex checks whether the index named indexName has been loaded on the field, as the default index or a named one
+func (s *Segment) hasIndex(fieldID int64, indexName string) bool {
+	s.paramMutex.RLock()
+	defer s.paramMutex.RUnlock()
+	if info, ok := s.indexInfos[fieldID]; ok && info.getIndexName() == indexName {
+		return true
+	}
+	_, ok := s.namedIndexInfos[fieldID][indexName]
+	return ok
+}
+
 func (s *Segment) setIndexInfo(fieldID int64, info *indexInfo) {
 	s.paramMutex.Lock()
 	defer s.paramMutex.Unlock()
@@ -554,6 +612,22 @@ func (s *Segment) updateBloomFilter(pks []int64) {
 	}
 }
 
+// mayContainPKs returns false if the pk bloom filter of the segment excludes all the pks,
+// a segment whose filter is incomplete may contain any pk
+func (s *Segment) mayContainPKs(pks []int64) bool {
+	if !s.pkFilterComplete {
+		return len(pks) > 0
+	}
+	buf := make([]byte, 8)
+	for _, pk := range pks {
+		common.Endian.PutUint64(buf, uint64(pk))
+		if s.pkFilter.Test(buf) {
+			return true
+		}
+	}
+	return false
+}
+
 //-------------------------------------------------------------------------------------- interfaces for growing segment
 func (s *Segment) segmentPreInsert(numOfRecords int) (int64, error) {
 	/*
@@ -611,17 +685,32 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 
 	// Blobs to one big blob
 	var numOfRow = len(*entityIDs)
-	var sizeofPerRow = len((*records)[0].Value)
-
 	assert.Equal(nil, numOfRow, len(*records))
 	if numOfRow != len(*records) {
 		return errors.New("entityIDs row num not equal to length of records")
 	}
 
+	rows := *records
+	if s.sparseStore != nil {
+		denseRows, sparseRows, err := splitSparseFloatVectorRows(s.sparseStore.schema, rows)
+		if err != nil {
+			return err
+		}
+		pks, err := getPrimaryKeysFromRows(s.sparseStore.schema, denseRows)
+		if err != nil {
+			return err
+		}
+		if err = s.sparseStore.insert(pks, *timestamps, sparseRows); err != nil {
+			return err
+		}
+		rows = denseRows
+	}
+
+	var sizeofPerRow = len(rows[0].Value)
 	var rawData = make([]byte, numOfRow*sizeofPerRow)
 	var copyOffset = 0
-	for i := 0; i < len(*records); i++ {
-		copy(rawData[copyOffset:], (*records)[i].Value)
+	for i := 0; i < len(rows); i++ {
+		copy(rawData[copyOffset:], rows[i].Value)
 		copyOffset += sizeofPerRow
 	}
 
@@ -676,6 +765,9 @@ func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps
 	if err := HandleCStatus(&status, "Delete failed"); err != nil {
 		return err
 	}
+	if s.sparseStore != nil {
+		s.sparseStore.delete(*entityIDs, *timestamps)
+	}
 
 	return nil
 }
@@ -698,6 +790,7 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 
 	// data interface check
 	var dataPointer unsafe.Pointer
+	var dataSize int
 	emptyErr := errors.New("null field data to be loaded")
 	switch d := data.(type) {
 	case []bool:
@@ -741,8 +834,16 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 		}
 		dataPointer = unsafe.Pointer(&d[0])
 	case []string:
-		// TODO: support string type
-		return errors.New("we cannot support string type now")
+		if len(d) <= 0 {
+			return emptyErr
+		}
+		// strings are not fixed-size, they are passed as a serialized schema.StringArray
+		blob, err := proto.Marshal(&schemapb.StringArray{Data: d})
+		if err != nil {
+			return err
+		}
+		dataPointer = unsafe.Pointer(&blob[0])
+		dataSize = len(blob)
 	default:
 		return errors.New("illegal field data type")
 	}
@@ -752,12 +853,14 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 		    int64_t field_id;
 		    void* blob;
 		    int64_t row_count;
+		    int64_t blob_size;
 		} CLoadFieldDataInfo;
 	*/
 	loadInfo := C.CLoadFieldDataInfo{
 		field_id:  C.int64_t(fieldID),
 		blob:      dataPointer,
 		row_count: C.int64_t(rowCount),
+		blob_size: C.int64_t(dataSize),
 	}
 
 	status := C.LoadFieldData(s.segmentPtr, loadInfo)
@@ -796,6 +899,9 @@ func (s *Segment) segmentLoadDeletedRecord(primaryKeys []IntPrimaryKey, timestam
 	if err := HandleCStatus(&status, "LoadDeletedRecord failed"); err != nil {
 		return err
 	}
+	if s.sparseStore != nil {
+		s.sparseStore.delete(primaryKeys, timestamps)
+	}
 
 	log.Debug("load deleted record done",
 		zap.Int64("row count", rowCount),
@@ -829,6 +935,19 @@ func (s *Segment) dropFieldData(fieldID int64) error {
 }
 
 func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) error {
+	return s.loadSegmentIndex(bytesIndex, fieldID, s.getIndexName(fieldID), s.getIndexParams(fieldID), s.getIndexPaths(fieldID))
+}
+
+// updateSegmentNamedIndex loads a named index besides the default index of the field,
+// which must have been loaded
+func (s *Segment) updateSegmentNamedIndex(bytesIndex [][]byte, info *indexInfo) error {
+	if info.getIndexName() == "" {
+		return errors.New("empty index name, segmentID = " + fmt.Sprintln(s.ID()))
+	}
+	return s.loadSegmentIndex(bytesIndex, info.getFieldID(), info.getIndexName(), info.getIndexParams(), info.getIndexPaths())
+}
+
+func (s *Segment) loadSegmentIndex(bytesIndex [][]byte, fieldID UniqueID, indexName string, indexParams map[string]string, indexPaths []string) error {
 	loadIndexInfo, err := newLoadIndexInfo()
 	defer deleteLoadIndexInfo(loadIndexInfo)
 	if err != nil {
@@ -838,14 +957,18 @@ func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) erro
 	if err != nil {
 		return err
 	}
-	indexParams := s.getIndexParams(fieldID)
+	if indexName != "" {
+		err = loadIndexInfo.appendIndexName(indexName)
+		if err != nil {
+			return err
+		}
+	}
 	for k, v := range indexParams {
 		err = loadIndexInfo.appendIndexParam(k, v)
 		if err != nil {
 			return err
 		}
 	}
-	indexPaths := s.getIndexPaths(fieldID)
 	err = loadIndexInfo.appendIndex(bytesIndex, indexPaths)
 	if err != nil {
 		return err
@@ -857,7 +980,8 @@ func (s *Segment) updateSegmentIndex(bytesIndex [][]byte, fieldID UniqueID) erro
 		return errors.New("null seg core pointer")
 	}
 
-	if s.segmentType != segmentTypeSealed {
+	// a segment can be indexing already if another field of it has an index
+	if s.segmentType != segmentTypeSealed && s.segmentType != segmentTypeIndexing {
 		errMsg := fmt.Sprintln("updateSegmentIndex failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
 		return errors.New(errMsg)
 	}
@@ -892,6 +1016,10 @@ func (s *Segment) dropSegmentIndex(fieldID int64) error {
 	if err := HandleCStatus(&status, "DropSealedSegmentIndex failed"); err != nil {
 		return err
 	}
+	// the named indexes of the field are dropped as well
+	s.paramMutex.Lock()
+	delete(s.namedIndexInfos, fieldID)
+	s.paramMutex.Unlock()
 
 	log.Debug("dropSegmentIndex done", zap.Int64("fieldID", fieldID), zap.Int64("segmentID", s.ID()))
 
@@ -25,7 +25,6 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -36,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const timeoutForEachRead = 10 * time.Second
@@ -90,6 +90,7 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 			return err
 		}
 		segment := newSegment(collection, segmentID, partitionID, collectionID, "", segmentType, true)
+		segment.clusteringKeyRange = info.GetClusteringKeyRange()
 		newSegments[segmentID] = segment
 		fieldBinlog, indexedFieldID, err := loader.getFieldAndIndexInfo(segment, info)
 		if err != nil {
@@ -201,6 +202,12 @@ func (loader *segmentLoader) loadSegmentInternal(segment *Segment,
 		if err != nil {
 			return err
 		}
+		for _, info := range segment.getNamedIndexInfos(id) {
+			err = loader.indexLoader.loadNamedIndex(segment, info)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -332,10 +339,15 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 		zap.Any("segmentID", segment.ID()),
 		zap.Any("numFields", len(insertData.Data)),
 	)
+	sparseRows := make(map[FieldID][][]byte)
 	for fieldID, value := range insertData.Data {
 		var numRows []int64
 		var data interface{}
 		switch fieldData := value.(type) {
+		case *storage.SparseFloatVectorFieldData:
+			// sparse float vector field is unknown to segcore
+			sparseRows[fieldID] = fieldData.Contents
+			continue
 		case *storage.BoolFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
@@ -366,6 +378,12 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 		case *storage.BinaryVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
+		case *storage.Float16VectorFieldData:
+			numRows = fieldData.NumRows
+			data = fieldData.Data
+		case *storage.BFloat16VectorFieldData:
+			numRows = fieldData.NumRows
+			data = fieldData.Data
 		default:
 			return errors.New("unexpected field data type")
 		}
@@ -382,10 +400,42 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 			return err
 		}
 	}
+	if segment.sparseStore != nil && len(sparseRows) > 0 {
+		return loadSealedSparseFloatVectorRows(segment, insertData, sparseRows)
+	}
 	return nil
 }
 
+// loadSealedSparseFloatVectorRows keeps the sparse float vector rows of sealed segment with their primary keys
+// and timestamps, which are read from the binlogs of primary key field and timestamp field.
+func loadSealedSparseFloatVectorRows(segment *Segment, insertData *storage.InsertData, sparseRows map[FieldID][][]byte) error {
+	helper, err := typeutil.CreateSchemaHelper(segment.sparseStore.schema)
+	if err != nil {
+		return err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return err
+	}
+	pkData, ok := insertData.Data[pkField.FieldID].(*storage.Int64FieldData)
+	if !ok {
+		return fmt.Errorf("primary key data not found when load sparse float vector, segmentID = %d", segment.ID())
+	}
+	tsData, ok := insertData.Data[common.TimeStampField].(*storage.Int64FieldData)
+	if !ok {
+		return fmt.Errorf("timestamp data not found when load sparse float vector, segmentID = %d", segment.ID())
+	}
+	timestamps := make([]Timestamp, len(tsData.Data))
+	for i, ts := range tsData.Data {
+		timestamps[i] = Timestamp(ts)
+	}
+	return segment.sparseStore.insert(pkData.Data, timestamps, sparseRows)
+}
+
+// loadSegmentBloomFilter adds the pks in the stats logs to the bloom filter of the segment, the filter is
+// marked complete only if every stats log carries a bloom filter
 func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPaths []string) error {
+	segment.pkFilterComplete = false
 	if len(binlogPaths) == 0 {
 		log.Info("there are no stats logs saved with segment", zap.Any("segmentID", segment.segmentID))
 		return nil
@@ -404,9 +454,11 @@ func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPath
 	if err != nil {
 		return err
 	}
+	complete := true
 	for _, stat := range stats {
 		if stat.BF == nil {
 			log.Warn("stat log with nil bloom filter", zap.Int64("segmentID", segment.segmentID), zap.Any("stat", stat))
+			complete = false
 			continue
 		}
 		err = segment.pkFilter.Merge(stat.BF)
@@ -414,6 +466,7 @@ func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPath
 			return err
 		}
 	}
+	segment.pkFilterComplete = complete
 	return nil
 }
 
@@ -550,7 +603,7 @@ func (loader *segmentLoader) getFieldAndIndexInfo(segment *Segment,
 	if err != nil {
 		return nil, nil, err
 	}
-	if len(vectorFieldIDs) <= 0 {
+	if len(vectorFieldIDs) <= 0 && segment.sparseStore == nil {
 		return nil, nil, fmt.Errorf("no vector field in collection %d", collectionID)
 	}
 
@@ -562,16 +615,53 @@ func (loader *segmentLoader) getFieldAndIndexInfo(segment *Segment,
 		}
 	}
 
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// load the default index and the other named indexes of every vector field,
+	// searches choose one of them by name without reloading
 	indexedFieldIDs := make([]FieldID, 0)
-	if idxInfo, err := loader.indexLoader.getIndexInfo(collectionID, segment); err != nil {
-		log.Warn(err.Error())
-	} else {
+	for _, fieldID := range vectorFieldIDs {
+		idxInfo, err := loader.indexLoader.getIndexInfo(collectionID, segment, fieldID, "")
+		if err != nil {
+			log.Warn(err.Error())
+			continue
+		}
+		if funcutil.SliceContain(indexedFieldIDs, idxInfo.fieldID) {
+			continue
+		}
 		loader.indexLoader.setIndexInfo(segment, idxInfo)
 		indexedFieldIDs = append(indexedFieldIDs, idxInfo.fieldID)
+
+		namedInfos, err := loader.indexLoader.getNamedIndexInfos(collection, segment, idxInfo)
+		if err != nil {
+			log.Warn("get named indexes failed, only the default index is loaded",
+				zap.Int64("segmentID", segment.segmentID), zap.Int64("fieldID", idxInfo.fieldID), zap.Error(err))
+			continue
+		}
+		for _, info := range namedInfos {
+			segment.setNamedIndexInfo(idxInfo.fieldID, info)
+		}
 	}
 
 	// we don't need to load raw data for indexed vector field
 	fieldBinlogs := loader.filterFieldBinlogs(segmentLoadInfo.BinlogPaths, indexedFieldIDs)
+
+	// load the default index of every indexed scalar field, the raw data is kept for output fields
+	scalarFieldIDs, err := loader.historicalReplica.getScalarIndexFieldIDsByCollectionID(collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, fieldID := range scalarFieldIDs {
+		idxInfo, err := loader.indexLoader.getIndexInfo(collectionID, segment, fieldID, "")
+		if err != nil || idxInfo.fieldID != fieldID {
+			continue
+		}
+		loader.indexLoader.setIndexInfo(segment, idxInfo)
+		indexedFieldIDs = append(indexedFieldIDs, idxInfo.fieldID)
+	}
 	return fieldBinlogs, indexedFieldIDs, nil
 }
 
@@ -656,19 +746,11 @@ func newSegmentLoader(ctx context.Context,
 	streamingReplica ReplicaInterface,
 	etcdKV *etcdkv.EtcdKV,
 	factory msgstream.Factory) *segmentLoader {
-	option := &minioKV.Option{
-		Address:           Params.MinioEndPoint,
-		AccessKeyID:       Params.MinioAccessKeyID,
-		SecretAccessKeyID: Params.MinioSecretAccessKey,
-		UseSSL:            Params.MinioUseSSLStr,
-		CreateBucket:      true,
-		BucketName:        Params.MinioBucketName,
-	}
-
-	client, err := minioKV.NewMinIOKV(ctx, option)
+	cm, err := newChunkManager(ctx)
 	if err != nil {
 		panic(err)
 	}
+	client := storage.NewChunkManagerKV(cm)
 
 	iLoader := newIndexLoader(ctx, rootCoord, indexCoord, historicalReplica)
 	return &segmentLoader{
@@ -58,6 +58,28 @@ func TestSegment_newSegment(t *testing.T) {
 	})
 }
 
+func TestSegment_mayContainPKs(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	segment := newSegment(collection, defaultSegmentID, defaultPartitionID, collectionID, "", segmentTypeSealed, true)
+	defer deleteCollection(collection)
+	defer deleteSegment(segment)
+
+	// the filter of a sealed segment is incomplete until it's loaded from stats logs
+	assert.True(t, segment.mayContainPKs([]int64{1, 2}))
+	segment.pkFilterComplete = true
+	assert.False(t, segment.mayContainPKs([]int64{1, 2}))
+	segment.updateBloomFilter([]int64{2, 3})
+	assert.True(t, segment.mayContainPKs([]int64{1, 2}))
+	assert.False(t, segment.mayContainPKs([]int64{}))
+
+	growing := newSegment(collection, defaultSegmentID+1, defaultPartitionID, collectionID, "", segmentTypeGrowing, true)
+	defer deleteSegment(growing)
+	assert.False(t, growing.mayContainPKs([]int64{1, 2}))
+}
+
 func TestSegment_deleteSegment(t *testing.T) {
 	collectionID := UniqueID(0)
 	collectionMeta := genTestCollectionMeta(collectionID, false)
@@ -847,6 +869,27 @@ func TestSegment_segmentLoadFieldData(t *testing.T) {
 			0)
 		assert.Error(t, err)
 	})
+
+	t.Run("test string", func(t *testing.T) {
+		schemaForCreate, schemaForLoad := genSchemas(schemapb.DataType_String)
+		_, err := genSealedSegment(schemaForCreate,
+			schemaForLoad,
+			defaultCollectionID,
+			defaultPartitionID,
+			defaultSegmentID,
+			defaultVChannel,
+			defaultMsgLength)
+		assert.NoError(t, err)
+
+		_, err = genSealedSegment(schemaForCreate,
+			schemaForCreate,
+			defaultCollectionID,
+			defaultPartitionID,
+			defaultSegmentID,
+			defaultVChannel,
+			0)
+		assert.Error(t, err)
+	})
 }
 
 func TestSegment_ConcurrentOperation(t *testing.T) {
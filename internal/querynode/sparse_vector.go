@@ -0,0 +1,441 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// Sparse float vector fields are unknown to segcore, they are stripped from the schema and the row data
+// passed to segcore, and kept by a sparseFloatVectorStore of the segment instead. Searching a sparse float
+// vector field is done by brute force inner product in go.
+
+// stripSparseFloatVectorFields returns a copy of schema without sparse float vector fields
+func stripSparseFloatVectorFields(schema *schemapb.CollectionSchema) *schemapb.CollectionSchema {
+	if !typeutil.HasSparseVectorField(schema) {
+		return schema
+	}
+	stripped := proto.Clone(schema).(*schemapb.CollectionSchema)
+	stripped.Fields = make([]*schemapb.FieldSchema, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		if !typeutil.IsSparseVectorType(field.DataType) {
+			stripped.Fields = append(stripped.Fields, proto.Clone(field).(*schemapb.FieldSchema))
+		}
+	}
+	return stripped
+}
+
+func getSparseFloatVectorField(schema *schemapb.CollectionSchema, fieldID FieldID) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.FieldID == fieldID && typeutil.IsSparseVectorType(field.DataType) {
+			return field
+		}
+	}
+	return nil
+}
+
+func getVectorDim(field *schemapb.FieldSchema) (int, error) {
+	for _, t := range field.TypeParams {
+		if t.Key == "dim" {
+			dim, err := strconv.Atoi(t.Value)
+			if err != nil {
+				return 0, fmt.Errorf("invalid dim of field %s: %s", field.Name, t.Value)
+			}
+			return dim, nil
+		}
+	}
+	return 0, fmt.Errorf("dim not found in field %s", field.Name)
+}
+
+// getRowFieldSize returns the size of a fixed-length field in the row based insert data
+func getRowFieldSize(field *schemapb.FieldSchema) (int, error) {
+	switch field.DataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8:
+		return 1, nil
+	case schemapb.DataType_Int16:
+		return 2, nil
+	case schemapb.DataType_Int32, schemapb.DataType_Float:
+		return 4, nil
+	case schemapb.DataType_Int64, schemapb.DataType_Double:
+		return 8, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getVectorDim(field)
+		if err != nil {
+			return 0, err
+		}
+		return dim * 4, nil
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		dim, err := getVectorDim(field)
+		if err != nil {
+			return 0, err
+		}
+		return dim * 2, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getVectorDim(field)
+		if err != nil {
+			return 0, err
+		}
+		return dim / 8, nil
+	default:
+		return 0, fmt.Errorf("unsupported data type %s in row data", field.DataType.String())
+	}
+}
+
+// splitSparseFloatVectorRows splits the row based insert data into the rows of the other fields and the
+// sparse float vector rows of every sparse float vector field. A sparse float vector in a row is encoded as
+// the number of its elements (uint32) followed by the elements.
+func splitSparseFloatVectorRows(schema *schemapb.CollectionSchema, records []*commonpb.Blob) ([]*commonpb.Blob, map[FieldID][][]byte, error) {
+	denseRows := make([]*commonpb.Blob, 0, len(records))
+	sparseRows := make(map[FieldID][][]byte)
+	for _, record := range records {
+		value := record.GetValue()
+		dense := make([]byte, 0, len(value))
+		offset := 0
+		for _, field := range schema.Fields {
+			if field.FieldID < common.StartOfUserFieldID {
+				continue
+			}
+			if field.DataType == schemapb.DataType_SparseFloatVector {
+				if offset+4 > len(value) {
+					return nil, nil, fmt.Errorf("invalid row data of sparse float vector field %s", field.Name)
+				}
+				nnz := int(common.Endian.Uint32(value[offset:]))
+				offset += 4
+				end := offset + nnz*8
+				if end > len(value) {
+					return nil, nil, fmt.Errorf("invalid row data of sparse float vector field %s", field.Name)
+				}
+				row := make([]byte, nnz*8)
+				copy(row, value[offset:end])
+				sparseRows[field.FieldID] = append(sparseRows[field.FieldID], row)
+				offset = end
+				continue
+			}
+			size, err := getRowFieldSize(field)
+			if err != nil {
+				return nil, nil, err
+			}
+			if offset+size > len(value) {
+				return nil, nil, fmt.Errorf("invalid row data of field %s", field.Name)
+			}
+			dense = append(dense, value[offset:offset+size]...)
+			offset += size
+		}
+		denseRows = append(denseRows, &commonpb.Blob{Value: dense})
+	}
+	return denseRows, sparseRows, nil
+}
+
+// sparseFloatVectorStore keeps the sparse float vector rows of a segment with the primary keys and timestamps
+// of the rows, and the deleted primary keys.
+type sparseFloatVectorStore struct {
+	mu         sync.RWMutex
+	schema     *schemapb.CollectionSchema
+	pks        []int64
+	timestamps []Timestamp
+	rows       map[FieldID][][]byte
+	deleted    map[int64][]Timestamp
+}
+
+func newSparseFloatVectorStore(schema *schemapb.CollectionSchema) *sparseFloatVectorStore {
+	return &sparseFloatVectorStore{
+		schema:  schema,
+		rows:    make(map[FieldID][][]byte),
+		deleted: make(map[int64][]Timestamp),
+	}
+}
+
+func (s *sparseFloatVectorStore) insert(pks []int64, timestamps []Timestamp, rows map[FieldID][][]byte) error {
+	if len(pks) != len(timestamps) {
+		return fmt.Errorf("length of primary keys %d not equal to length of timestamps %d", len(pks), len(timestamps))
+	}
+	for fieldID, fieldRows := range rows {
+		if len(fieldRows) != len(pks) {
+			return fmt.Errorf("row num %d of sparse float vector field %d not equal to %d", len(fieldRows), fieldID, len(pks))
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pks = append(s.pks, pks...)
+	s.timestamps = append(s.timestamps, timestamps...)
+	for fieldID, fieldRows := range rows {
+		s.rows[fieldID] = append(s.rows[fieldID], fieldRows...)
+	}
+	return nil
+}
+
+func (s *sparseFloatVectorStore) delete(pks []int64, timestamps []Timestamp) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, pk := range pks {
+		s.deleted[pk] = append(s.deleted[pk], timestamps[i])
+	}
+}
+
+// isDeleted returns true if the row inserted at ts with primary key pk is deleted before travelTs
+func (s *sparseFloatVectorStore) isDeleted(pk int64, ts Timestamp, travelTs Timestamp) bool {
+	for _, deleteTs := range s.deleted[pk] {
+		if deleteTs >= ts && deleteTs <= travelTs {
+			return true
+		}
+	}
+	return false
+}
+
+type sparseSearchHit struct {
+	id    int64
+	score float32
+}
+
+// search returns at most topK hits of every query, sorted by inner product in descending order
+func (s *sparseFloatVectorStore) search(fieldID FieldID, queries [][]byte, topK int64, travelTs Timestamp) [][]sparseSearchHit {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	hits := make([][]sparseSearchHit, len(queries))
+	rows := s.rows[fieldID]
+	for i, query := range queries {
+		for j, row := range rows {
+			if s.timestamps[j] > travelTs || s.isDeleted(s.pks[j], s.timestamps[j], travelTs) {
+				continue
+			}
+			hits[i] = append(hits[i], sparseSearchHit{
+				id:    s.pks[j],
+				score: typeutil.SparseFloatRowInnerProduct(query, row),
+			})
+		}
+		hits[i] = truncateSparseSearchHits(hits[i], topK)
+	}
+	return hits
+}
+
+func truncateSparseSearchHits(hits []sparseSearchHit, topK int64) []sparseSearchHit {
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].score > hits[j].score
+	})
+	if int64(len(hits)) > topK {
+		hits = hits[:topK]
+	}
+	return hits
+}
+
+// parseSparseFloatVectorPlaceholderGroup returns the sparse float vector queries in the placeholder group
+func parseSparseFloatVectorPlaceholderGroup(blob []byte) ([][]byte, error) {
+	group := &milvuspb.PlaceholderGroup{}
+	if err := proto.Unmarshal(blob, group); err != nil {
+		return nil, err
+	}
+	if len(group.Placeholders) != 1 {
+		return nil, fmt.Errorf("invalid placeholder group, expect 1 placeholder, got %d", len(group.Placeholders))
+	}
+	placeholder := group.Placeholders[0]
+	if placeholder.Type != milvuspb.PlaceholderType_SparseFloatVector {
+		return nil, fmt.Errorf("invalid placeholder type %s for sparse float vector field", placeholder.Type.String())
+	}
+	if err := typeutil.ValidateSparseFloatRows(placeholder.Values...); err != nil {
+		return nil, err
+	}
+	return placeholder.Values, nil
+}
+
+// searchSparseFloatVector searches the segments and merges the hits into a SearchResultData, the hits of every
+// query are padded with -1 to topK as the results reduced by segcore.
+func searchSparseFloatVector(segments []*Segment, fieldID FieldID, queries [][]byte, topK int64, travelTs Timestamp) *schemapb.SearchResultData {
+	merged := make([][]sparseSearchHit, len(queries))
+	for _, segment := range segments {
+		if segment.sparseStore == nil {
+			continue
+		}
+		hits := segment.sparseStore.search(fieldID, queries, topK, travelTs)
+		for i := range queries {
+			merged[i] = truncateSparseSearchHits(append(merged[i], hits[i]...), topK)
+		}
+	}
+
+	nq := int64(len(queries))
+	ids := make([]int64, 0, nq*topK)
+	scores := make([]float32, 0, nq*topK)
+	topks := make([]int64, 0, nq)
+	for _, hits := range merged {
+		for _, hit := range hits {
+			ids = append(ids, hit.id)
+			scores = append(scores, hit.score)
+		}
+		for i := int64(len(hits)); i < topK; i++ {
+			ids = append(ids, -1)
+			scores = append(scores, -1)
+		}
+		topks = append(topks, int64(len(hits)))
+	}
+	return &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topK,
+		Scores:     scores,
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: ids,
+				},
+			},
+		},
+		Topks: topks,
+	}
+}
+
+// searchSparseFloatVector searches the sparse float vector field of the historical and streaming segments,
+// the caller should hold the query lock of both replicas.
+func (q *queryCollection) searchSparseFloatVector(searchMsg *msgstream.SearchMsg, collection *Collection, planNode *planpb.PlanNode, cost *internalpb.SearchCost) error {
+	queryInfo := planNode.GetVectorAnns().GetQueryInfo()
+	topK := queryInfo.GetTopk()
+	if topK <= 0 {
+		return fmt.Errorf("limit must be greater than 0")
+	}
+	if topK >= 16385 {
+		return fmt.Errorf("limit %d is too large", topK)
+	}
+	queries, err := parseSparseFloatVectorPlaceholderGroup(searchMsg.PlaceholderGroup)
+	if err != nil {
+		return err
+	}
+
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("search sparse %d(nq=%d, k=%d)", searchMsg.CollectionID, len(queries), topK))
+
+	var globalSealedSegments []UniqueID
+	if len(searchMsg.PartitionIDs) > 0 {
+		globalSealedSegments = q.historical.getGlobalSegmentIDsByPartitionIds(searchMsg.PartitionIDs)
+	} else {
+		globalSealedSegments = q.historical.getGlobalSegmentIDsByCollectionID(collection.id)
+	}
+
+	sealedSegments, err := getSparseSearchSegments(q.historical.replica, collection.id, searchMsg.PartitionIDs, "")
+	if err != nil {
+		return err
+	}
+	sealedSegmentSearched := make([]UniqueID, 0, len(sealedSegments))
+	for _, segment := range sealedSegments {
+		sealedSegmentSearched = append(sealedSegmentSearched, segment.ID())
+	}
+	segments := sealedSegments
+	for _, channel := range collection.getVChannels() {
+		growingSegments, err := getSparseSearchSegments(q.streaming.replica, collection.id, searchMsg.PartitionIDs, channel)
+		if err != nil {
+			return err
+		}
+		segments = append(segments, growingSegments...)
+	}
+
+	fieldID := planNode.GetVectorAnns().GetFieldId()
+	result := searchSparseFloatVector(segments, fieldID, queries, topK, searchMsg.TravelTimestamp)
+	// the hits are merged while searching, there is no separate reduce
+	cost.SearchDuration = tr.Record("brute force search done").Milliseconds()
+	cost.NumSegmentsSearched = int64(len(segments))
+
+	slicedBlob, err := proto.Marshal(result)
+	if err != nil {
+		return err
+	}
+	searchResultMsg := &msgstream.SearchResultMsg{
+		BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{0}},
+		SearchResults: internalpb.SearchResults{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_SearchResult,
+				MsgID:     searchMsg.Base.MsgID,
+				Timestamp: searchMsg.BeginTs(),
+				SourceID:  searchMsg.Base.SourceID,
+			},
+			Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			ResultChannelID:          searchMsg.ResultChannelID,
+			MetricType:               queryInfo.GetMetricType(),
+			NumQueries:               int64(len(queries)),
+			TopK:                     topK,
+			SlicedBlob:               slicedBlob,
+			SlicedOffset:             1,
+			SlicedNumCount:           1,
+			SealedSegmentIDsSearched: sealedSegmentSearched,
+			ChannelIDsSearched:       collection.getVChannels(),
+			GlobalSealedSegmentIDs:   globalSealedSegments,
+			Cost:                     cost,
+		},
+	}
+	log.Debug("QueryNode sparse float vector SearchResultMsg",
+		zap.Any("collectionID", collection.id),
+		zap.Any("msgID", searchMsg.ID()),
+		zap.Any("vChannels", collection.getVChannels()),
+		zap.Any("sealedSegmentSearched", sealedSegmentSearched),
+	)
+	if err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID); err != nil {
+		return err
+	}
+	tr.Elapse("all done")
+	return nil
+}
+
+// getSparseSearchSegments returns the on service segments of the partitions, all the loaded partitions are
+// searched if partIDs is empty. Segments of every virtual channel are returned if vChannel is empty.
+func getSparseSearchSegments(replica ReplicaInterface, collID UniqueID, partIDs []UniqueID, vChannel Channel) ([]*Segment, error) {
+	searchPartIDs := make([]UniqueID, 0)
+	if len(partIDs) == 0 {
+		ids, err := replica.getPartitionIDs(collID)
+		if err != nil {
+			return nil, err
+		}
+		searchPartIDs = ids
+	} else {
+		for _, id := range partIDs {
+			if _, err := replica.getPartitionByID(id); err == nil {
+				searchPartIDs = append(searchPartIDs, id)
+			}
+		}
+	}
+
+	segments := make([]*Segment, 0)
+	for _, partID := range searchPartIDs {
+		var segIDs []UniqueID
+		var err error
+		if vChannel == "" {
+			segIDs, err = replica.getSegmentIDs(partID)
+		} else {
+			segIDs, err = replica.getSegmentIDsByVChannel(partID, vChannel)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, segID := range segIDs {
+			segment, err := replica.getSegmentByID(segID)
+			if err != nil {
+				return nil, err
+			}
+			if segment.getOnService() {
+				segments = append(segments, segment)
+			}
+		}
+	}
+	return segments, nil
+}
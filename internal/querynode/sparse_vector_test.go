@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func genSparseFloatVectorSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "sparse",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "sparse",
+				DataType: schemapb.DataType_SparseFloatVector,
+			},
+			{
+				FieldID:  102,
+				Name:     "vec",
+				DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "2"},
+				},
+			},
+		},
+	}
+}
+
+func genSparseFloatVectorRowData(t *testing.T, pk int64, sparse []byte, vec []float32) *commonpb.Blob {
+	var buffer bytes.Buffer
+	assert.NoError(t, binary.Write(&buffer, common.Endian, pk))
+	assert.NoError(t, binary.Write(&buffer, common.Endian, uint32(typeutil.SparseFloatRowElementCount(sparse))))
+	buffer.Write(sparse)
+	assert.NoError(t, binary.Write(&buffer, common.Endian, vec))
+	return &commonpb.Blob{Value: buffer.Bytes()}
+}
+
+func TestStripSparseFloatVectorFields(t *testing.T) {
+	schema := genSparseFloatVectorSchema()
+	stripped := stripSparseFloatVectorFields(schema)
+	assert.Equal(t, 2, len(stripped.Fields))
+	assert.Equal(t, int64(100), stripped.Fields[0].FieldID)
+	assert.Equal(t, int64(102), stripped.Fields[1].FieldID)
+	assert.Equal(t, 3, len(schema.Fields))
+
+	assert.NotNil(t, getSparseFloatVectorField(schema, 101))
+	assert.Nil(t, getSparseFloatVectorField(schema, 102))
+}
+
+func TestSplitSparseFloatVectorRows(t *testing.T) {
+	schema := genSparseFloatVectorSchema()
+	sparse0 := typeutil.CreateSparseFloatRow([]uint32{1, 8}, []float32{1, 2})
+	sparse1 := typeutil.CreateSparseFloatRow(nil, nil)
+	records := []*commonpb.Blob{
+		genSparseFloatVectorRowData(t, 1, sparse0, []float32{0.5, 1}),
+		genSparseFloatVectorRowData(t, 2, sparse1, []float32{2, 4}),
+	}
+
+	denseRows, sparseRows, err := splitSparseFloatVectorRows(schema, records)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(denseRows))
+	assert.Equal(t, 16, len(denseRows[0].Value))
+	assert.Equal(t, [][]byte{sparse0, sparse1}, sparseRows[101])
+
+	pks, err := getPrimaryKeysFromRows(schema, denseRows)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, pks)
+
+	t.Run("invalid row", func(t *testing.T) {
+		invalid := []*commonpb.Blob{{Value: records[0].Value[:12]}}
+		_, _, err := splitSparseFloatVectorRows(schema, invalid)
+		assert.Error(t, err)
+	})
+}
+
+func TestSparseFloatVectorStore(t *testing.T) {
+	store := newSparseFloatVectorStore(genSparseFloatVectorSchema())
+	rows := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1}, []float32{1}),
+		typeutil.CreateSparseFloatRow([]uint32{1, 2}, []float32{2, 1}),
+		typeutil.CreateSparseFloatRow([]uint32{2}, []float32{3}),
+	}
+	err := store.insert([]int64{1, 2, 3}, []Timestamp{10, 20, 30}, map[FieldID][][]byte{101: rows})
+	assert.NoError(t, err)
+
+	err = store.insert([]int64{4}, []Timestamp{40}, map[FieldID][][]byte{101: rows})
+	assert.Error(t, err)
+
+	query := typeutil.CreateSparseFloatRow([]uint32{1, 2}, []float32{1, 1})
+	hits := store.search(101, [][]byte{query}, 2, 100)
+	assert.Equal(t, []sparseSearchHit{{id: 2, score: 3}, {id: 3, score: 3}}, hits[0])
+
+	// rows inserted after the travel timestamp are invisible
+	hits = store.search(101, [][]byte{query}, 3, 25)
+	assert.Equal(t, []sparseSearchHit{{id: 2, score: 3}, {id: 1, score: 1}}, hits[0])
+
+	store.delete([]int64{2}, []Timestamp{50})
+	hits = store.search(101, [][]byte{query}, 3, 100)
+	assert.Equal(t, []sparseSearchHit{{id: 3, score: 3}, {id: 1, score: 1}}, hits[0])
+
+	// the deletion is invisible before it happens
+	hits = store.search(101, [][]byte{query}, 1, 45)
+	assert.Equal(t, []sparseSearchHit{{id: 2, score: 3}}, hits[0])
+}
+
+func TestSearchSparseFloatVector(t *testing.T) {
+	schema := genSparseFloatVectorSchema()
+	seg1 := &Segment{sparseStore: newSparseFloatVectorStore(schema)}
+	seg2 := &Segment{sparseStore: newSparseFloatVectorStore(schema)}
+	err := seg1.sparseStore.insert([]int64{1}, []Timestamp{1}, map[FieldID][][]byte{
+		101: {typeutil.CreateSparseFloatRow([]uint32{0}, []float32{1})},
+	})
+	assert.NoError(t, err)
+	err = seg2.sparseStore.insert([]int64{2}, []Timestamp{1}, map[FieldID][][]byte{
+		101: {typeutil.CreateSparseFloatRow([]uint32{0}, []float32{2})},
+	})
+	assert.NoError(t, err)
+
+	queries := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{0}, []float32{1}),
+		typeutil.CreateSparseFloatRow([]uint32{0}, []float32{-1}),
+	}
+	result := searchSparseFloatVector([]*Segment{seg1, seg2, {}}, 101, queries, 3, 10)
+	assert.Equal(t, int64(2), result.NumQueries)
+	assert.Equal(t, int64(3), result.TopK)
+	assert.Equal(t, []int64{2, 1, -1, 1, 2, -1}, result.GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{2, 1, -1, -1, -2, -1}, result.Scores)
+	assert.Equal(t, []int64{2, 2}, result.Topks)
+}
+
+func TestParseSparseFloatVectorPlaceholderGroup(t *testing.T) {
+	row := typeutil.CreateSparseFloatRow([]uint32{3}, []float32{1})
+	genBlob := func(placeholderType milvuspb.PlaceholderType, values ...[]byte) []byte {
+		blob, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+			Placeholders: []*milvuspb.PlaceholderValue{
+				{Tag: "$0", Type: placeholderType, Values: values},
+			},
+		})
+		assert.NoError(t, err)
+		return blob
+	}
+
+	queries, err := parseSparseFloatVectorPlaceholderGroup(genBlob(milvuspb.PlaceholderType_SparseFloatVector, row))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{row}, queries)
+
+	_, err = parseSparseFloatVectorPlaceholderGroup(genBlob(milvuspb.PlaceholderType_FloatVector, row))
+	assert.Error(t, err)
+
+	_, err = parseSparseFloatVectorPlaceholderGroup(genBlob(milvuspb.PlaceholderType_SparseFloatVector, []byte{1}))
+	assert.Error(t, err)
+}
@@ -54,9 +54,16 @@ func (s *streaming) close() {
 	s.replica.freeAll()
 }
 
-func (s *streaming) retrieve(collID UniqueID, partIDs []UniqueID, plan *RetrievePlan) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
+// retrieve retrieves the growing segments of the partitions. If vChannels is not empty, only the segments
+// of these channels are retrieved; if pks is not nil, the segments whose pk bloom filter excludes all the pks are skipped.
+func (s *streaming) retrieve(collID UniqueID, partIDs []UniqueID, pks []int64, vChannels []Channel,
+	plan *RetrievePlan) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
 	retrieveResults := make([]*segcorepb.RetrieveResults, 0)
 	retrieveSegmentIDs := make([]UniqueID, 0)
+	channelSet := make(map[Channel]struct{}, len(vChannels))
+	for _, vChannel := range vChannels {
+		channelSet[vChannel] = struct{}{}
+	}
 
 	var retrievePartIDs []UniqueID
 	if len(partIDs) == 0 {
@@ -84,6 +91,12 @@ func (s *streaming) retrieve(collID UniqueID, partIDs []UniqueID, plan *Retrieve
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
 			}
+			if _, ok := channelSet[seg.vChannelID]; len(vChannels) > 0 && !ok {
+				continue
+			}
+			if pks != nil && !seg.mayContainPKs(pks) {
+				continue
+			}
 			result, err := seg.retrieve(plan)
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
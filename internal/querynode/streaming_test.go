@@ -196,7 +196,7 @@ func TestStreaming_retrieve(t *testing.T) {
 	t.Run("test retrieve", func(t *testing.T) {
 		res, ids, err := streaming.retrieve(defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
-			plan)
+			nil, nil, plan)
 		assert.NoError(t, err)
 		assert.Len(t, res, 1)
 		assert.Len(t, ids, 1)
@@ -208,9 +208,35 @@ func TestStreaming_retrieve(t *testing.T) {
 	t.Run("test empty partition", func(t *testing.T) {
 		res, ids, err := streaming.retrieve(defaultCollectionID,
 			[]UniqueID{},
-			plan)
+			nil, nil, plan)
 		assert.NoError(t, err)
 		assert.Len(t, res, 1)
 		assert.Len(t, ids, 1)
 	})
+
+	t.Run("test retrieve by pks", func(t *testing.T) {
+		segment.updateBloomFilter([]int64{1, 2})
+		res, ids, err := streaming.retrieve(defaultCollectionID,
+			[]UniqueID{defaultPartitionID},
+			[]int64{2}, nil, plan)
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Len(t, ids, 1)
+
+		res, ids, err = streaming.retrieve(defaultCollectionID,
+			[]UniqueID{defaultPartitionID},
+			[]int64{-100}, nil, plan)
+		assert.NoError(t, err)
+		assert.Len(t, res, 0)
+		assert.Len(t, ids, 0)
+	})
+
+	t.Run("test retrieve other channels", func(t *testing.T) {
+		res, ids, err := streaming.retrieve(defaultCollectionID,
+			[]UniqueID{defaultPartitionID},
+			nil, []Channel{"other-channel"}, plan)
+		assert.NoError(t, err)
+		assert.Len(t, res, 0)
+		assert.Len(t, ids, 0)
+	})
 }
@@ -28,6 +28,9 @@ import (
 const (
 	// RequestTimeout timeout for request
 	RequestTimeout = 10 * time.Second
+
+	// maxTxnOps is the max number of operations in one etcd txn
+	maxTxnOps = 128
 )
 
 type rtPair struct {
@@ -47,6 +50,8 @@ type metaSnapshot struct {
 	numTs  int
 }
 
+var _ snapshotPruner = (*metaSnapshot)(nil)
+
 func newMetaSnapshot(cli *clientv3.Client, root, tsKey string, bufSize int) (*metaSnapshot, error) {
 	if bufSize <= 0 {
 		bufSize = 1024
@@ -403,3 +408,47 @@ func (ms *metaSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string, re
 	ms.putTs(resp.Header.Revision, ts)
 	return nil
 }
+
+// Prune drops cached revisions which are not visible at or after provided ts
+// the revision of ts is kept, so that loading at ts still works
+// etcd revisions are never compacted here, the keyspace is shared with other components
+// and its compaction is left to etcd auto-compaction
+// returns 0 since no key is removed from etcd
+func (ms *metaSnapshot) Prune(ts typeutil.Timestamp) (int, error) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	if ms.numTs == 0 {
+		return 0, nil
+	}
+	rev, err := ms.getRev(ts)
+	if err != nil {
+		return 0, err
+	}
+
+	// drop cached revisions before rev, the entry of rev itself is kept
+	for ms.numTs > 1 && ms.ts2Rev[ms.minPos].rev < rev {
+		ms.minPos++
+		if ms.minPos == len(ms.ts2Rev) {
+			ms.minPos = 0
+		}
+		ms.numTs--
+	}
+	return 0, nil
+}
+
+// Stats returns the number and total size of keys stored under the root path
+func (ms *metaSnapshot) Stats() (int, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+
+	resp, err := ms.cli.Get(ctx, ms.root, clientv3.WithPrefix())
+	if err != nil {
+		return 0, 0, err
+	}
+	size := 0
+	for _, kv := range resp.Kvs {
+		size += len(kv.Key) + len(kv.Value)
+	}
+	return len(resp.Kvs), size, nil
+}
@@ -452,3 +452,56 @@ func TestFix7150(t *testing.T) {
 	err = kv.loadTs()
 	assert.Nil(t, err)
 }
+
+func TestPrune(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+	tsKey := "timestamp"
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	ms, err := newMetaSnapshot(etcdCli, rootPath, tsKey, 32)
+	assert.Nil(t, err)
+	assert.NotNil(t, ms)
+
+	pruned, err := ms.Prune(100)
+	assert.Nil(t, err)
+	assert.Zero(t, pruned)
+
+	for i := 0; i < 20; i++ {
+		err = ms.Save("key", fmt.Sprintf("value-%d", i), typeutil.Timestamp(100+i*5))
+		assert.Nil(t, err)
+	}
+
+	keyNum, keySize, err := ms.Stats()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, keyNum)
+	assert.NotZero(t, keySize)
+
+	// only the cached revisions are dropped, no key is deleted from etcd
+	pruned, err = ms.Prune(150)
+	assert.Nil(t, err)
+	assert.Zero(t, pruned)
+	assert.Equal(t, typeutil.Timestamp(150), ms.minTs())
+	assert.Equal(t, 10, ms.numTs)
+
+	for i := 10; i < 20; i++ {
+		val, err := ms.Load("key", typeutil.Timestamp(100+i*5+2))
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), val)
+	}
+	val, err := ms.Load("key", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "value-19", val)
+
+	// revisions already dropped
+	pruned, err = ms.Prune(150)
+	assert.Nil(t, err)
+	assert.Zero(t, pruned)
+	assert.Equal(t, 10, ms.numTs)
+}
@@ -60,6 +60,12 @@ const (
 	// DDMsgSendPrefix prefix to indicate whether DD msg has been send
 	DDMsgSendPrefix = ComponentPrefix + "/dd-msg-send"
 
+	// DroppedCollectionMetaPrefix prefix for the records of dropped collections
+	DroppedCollectionMetaPrefix = ComponentPrefix + "/dropped-collection"
+
+	// DroppedPartitionMetaPrefix prefix for the records of dropped partitions
+	DroppedPartitionMetaPrefix = ComponentPrefix + "/dropped-partition"
+
 	// CreateCollectionDDType name of DD type for create collection
 	CreateCollectionDDType = "CreateCollection"
 
@@ -282,9 +288,10 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
+	// only the collection with a partition key has more than one partition when it's created
 	if len(coll.PartitionIDs) != len(coll.PartitionNames) ||
 		len(coll.PartitionIDs) != len(coll.PartitionCreatedTimestamps) ||
-		(len(coll.PartitionIDs) != 1 && len(coll.PartitionIDs) != 0) {
+		(len(coll.PartitionIDs) > 1 && typeutil.GetPartitionKeyFieldSchema(coll.Schema) == nil) {
 		return fmt.Errorf("partition parameters' length mis-match when creating collection")
 	}
 	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
@@ -295,8 +302,8 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 	}
 
 	coll.CreateTime = ts
-	if len(coll.PartitionCreatedTimestamps) == 1 {
-		coll.PartitionCreatedTimestamps[0] = ts
+	for i := range coll.PartitionCreatedTimestamps {
+		coll.PartitionCreatedTimestamps[i] = ts
 	}
 	mt.collID2Meta[coll.ID] = *coll
 	mt.collName2ID[coll.Schema.Name] = coll.ID
@@ -347,6 +354,19 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 		return fmt.Errorf("can't find collection. id = %d", collID)
 	}
 
+	// keep a record of the dropped collection, so that it could be recovered before garbage collected
+	droppedKey := fmt.Sprintf("%s/%d", DroppedCollectionMetaPrefix, collID)
+	droppedVal, err := proto.Marshal(&pb.DroppedCollectionInfo{
+		CollectionID:   collID,
+		CollectionName: collMeta.Schema.Name,
+		DropTime:       ts,
+	})
+	if err != nil {
+		log.Error("MetaTable DeleteCollection Marshal DroppedCollectionInfo fail",
+			zap.String("key", droppedKey), zap.Error(err))
+		return fmt.Errorf("metaTable DeleteCollection Marshal DroppedCollectionInfo fail key:%s, err:%w", droppedKey, err)
+	}
+
 	delete(mt.collID2Meta, collID)
 	delete(mt.collName2ID, collMeta.Schema.Name)
 
@@ -386,6 +406,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	delMetaKeysTxn := []string{
 		fmt.Sprintf("%s/%d", SegmentIndexMetaPrefix, collID),
 		fmt.Sprintf("%s/%d", IndexMetaPrefix, collID),
+		fmt.Sprintf("%s/%d/", DroppedPartitionMetaPrefix, collID),
 	}
 
 	for _, alias := range aliases {
@@ -399,9 +420,10 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	var saveMeta = map[string]string{
 		DDMsgSendPrefix:   "false",
 		DDOperationPrefix: ddOpStr,
+		droppedKey:        string(droppedVal),
 	}
 
-	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(map[string]string{}, delMetakeysSnap, ts)
+	err = mt.snapshot.MultiSaveAndRemoveWithPrefix(map[string]string{}, delMetakeysSnap, ts)
 	if err != nil {
 		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
 		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
@@ -765,10 +787,25 @@ func (mt *MetaTable) DeletePartition(collID typeutil.UniqueID, partitionName str
 		delMetaKeys = append(delMetaKeys, k)
 	}
 
+	// keep a record of the dropped partition, so that it could be recovered later
+	droppedKey := fmt.Sprintf("%s/%d/%d", DroppedPartitionMetaPrefix, collID, partID)
+	droppedVal, err := proto.Marshal(&pb.DroppedPartitionInfo{
+		CollectionID:  collID,
+		PartitionID:   partID,
+		PartitionName: partitionName,
+		DropTime:      ts,
+	})
+	if err != nil {
+		log.Error("MetaTable DeletePartition Marshal DroppedPartitionInfo fail",
+			zap.String("key", droppedKey), zap.Error(err))
+		return 0, fmt.Errorf("metaTable DeletePartition Marshal DroppedPartitionInfo fail key:%s, err:%w", droppedKey, err)
+	}
+
 	metaTxn := make(map[string]string)
 	// save ddOpStr into etcd
 	metaTxn[DDMsgSendPrefix] = "false"
 	metaTxn[DDOperationPrefix] = ddOpStr
+	metaTxn[droppedKey] = string(droppedVal)
 
 	err = mt.snapshot.Save(k, string(v), ts)
 	if err != nil {
@@ -860,14 +897,19 @@ func (mt *MetaTable) DropIndex(collName, fieldName, indexName string) (typeutil.
 	if !ok {
 		return 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
-	if err != nil {
-		return 0, false, err
+	// field name is optional since index name is unique in the collection
+	var fieldID typeutil.UniqueID = -1
+	if fieldName != "" {
+		fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+		if err != nil {
+			return 0, false, err
+		}
+		fieldID = fieldSch.FieldID
 	}
 	fieldIdxInfo := make([]*pb.FieldIndexInfo, 0, len(collMeta.FieldIndexes))
 	var dropIdxID typeutil.UniqueID
 	for i, info := range collMeta.FieldIndexes {
-		if info.FiledID != fieldSch.FieldID {
+		if fieldID != -1 && info.FiledID != fieldID {
 			fieldIdxInfo = append(fieldIdxInfo, info)
 			continue
 		}
@@ -946,12 +988,26 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, fieldID in
 		return pb.SegmentIndexInfo{}, fmt.Errorf("segment id %d not has any index", segID)
 	}
 
-	if fieldID == -1 && idxName == "" { // return default index
-		for _, seg := range segIdxMap {
-			info, ok := mt.indexID2Meta[seg.IndexID]
-			if ok && info.IndexName == Params.DefaultIndexName {
+	if idxName == "" { // return default index, or the earliest created one if there is no default index
+		var earliest *pb.SegmentIndexInfo
+		for idxID, seg := range segIdxMap {
+			if fieldID != -1 && seg.FieldID != fieldID {
+				continue
+			}
+			info, ok := mt.indexID2Meta[idxID]
+			if !ok {
+				continue
+			}
+			if info.IndexName == Params.DefaultIndexName {
 				return seg, nil
 			}
+			if earliest == nil || seg.IndexID < earliest.IndexID {
+				s := seg
+				earliest = &s
+			}
+		}
+		if earliest != nil {
+			return *earliest, nil
 		}
 	} else {
 		for idxID, seg := range segIdxMap {
@@ -960,7 +1016,7 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, fieldID in
 				if idxMeta.IndexName != idxName {
 					continue
 				}
-				if seg.FieldID != fieldID {
+				if fieldID != -1 && seg.FieldID != fieldID {
 					continue
 				}
 				return seg, nil
@@ -1163,6 +1219,44 @@ func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	return rstID, fieldSchema, nil
 }
 
+// CheckIndexName checks whether the named index can be created on the field, index name is unique in the
+// collection, and there can't be two indexes with the same params on one field
+func (mt *MetaTable) CheckIndexName(collName string, fieldName string, idxInfo *pb.IndexInfo) error {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	collID, ok := mt.collName2ID[collName]
+	if !ok {
+		collID, ok = mt.collAlias2ID[collName]
+		if !ok {
+			return fmt.Errorf("collection %s not found", collName)
+		}
+	}
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("collection %s not found", collName)
+	}
+	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range collMeta.FieldIndexes {
+		info, ok := mt.indexID2Meta[f.IndexID]
+		if !ok {
+			return fmt.Errorf("index id = %d not found", f.IndexID)
+		}
+		sameIndex := f.FiledID == fieldSchema.FieldID && EqualKeyPairArray(info.IndexParams, idxInfo.IndexParams)
+		if info.IndexName == idxInfo.IndexName && !sameIndex {
+			return fmt.Errorf("index name = %s already exists in collection %s", idxInfo.IndexName, collName)
+		}
+		if info.IndexName != idxInfo.IndexName && sameIndex {
+			return fmt.Errorf("index %s on field %s has the same params", info.IndexName, fieldName)
+		}
+	}
+	return nil
+}
+
 // GetIndexByName return index info by index name
 func (mt *MetaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
@@ -1316,6 +1410,161 @@ func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, t
 	return nil
 }
 
+// RenameCollection rename collection, the old name is still found in the snapshot at timestamps before the rename
+func (mt *MetaTable) RenameCollection(oldName string, newName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	collID, ok := mt.collName2ID[oldName]
+	if !ok {
+		return fmt.Errorf("collection name does not exist, name = %s", oldName)
+	}
+	if _, ok := mt.collName2ID[newName]; ok {
+		return fmt.Errorf("collection name already exists, name = %s", newName)
+	}
+	if _, ok := mt.collAlias2ID[newName]; ok {
+		return fmt.Errorf("collection name collides with existing collection alias, name = %s", newName)
+	}
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection %s with id %d", oldName, collID)
+	}
+	collMeta.Schema = proto.Clone(collMeta.Schema).(*schemapb.CollectionSchema)
+	collMeta.Schema.Name = newName
+
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v, err := proto.Marshal(&collMeta)
+	if err != nil {
+		log.Error("MetaTable RenameCollection Marshal CollectionInfo fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable RenameCollection Marshal CollectionInfo fail key:%s, err:%w", k, err)
+	}
+
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+
+	mt.collID2Meta[collID] = collMeta
+	delete(mt.collName2ID, oldName)
+	mt.collName2ID[newName] = collID
+	return nil
+}
+
+// GetDroppedCollection return the record of the latest dropped collection with the name
+func (mt *MetaTable) GetDroppedCollection(collectionName string) (*pb.DroppedCollectionInfo, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	_, values, err := mt.txn.LoadWithPrefix(DroppedCollectionMetaPrefix)
+	if err != nil {
+		return nil, err
+	}
+	var dropped *pb.DroppedCollectionInfo
+	for _, value := range values {
+		info := pb.DroppedCollectionInfo{}
+		if err := proto.Unmarshal([]byte(value), &info); err != nil {
+			return nil, fmt.Errorf("metaTable GetDroppedCollection Unmarshal fail, err:%w", err)
+		}
+		if info.CollectionName == collectionName && (dropped == nil || info.DropTime > dropped.DropTime) {
+			dropped = &info
+		}
+	}
+	if dropped == nil {
+		return nil, fmt.Errorf("can't find dropped collection: %s", collectionName)
+	}
+	return dropped, nil
+}
+
+// GetDroppedPartition return the record of the latest dropped partition with the name
+func (mt *MetaTable) GetDroppedPartition(collID typeutil.UniqueID, partitionName string) (*pb.DroppedPartitionInfo, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	_, values, err := mt.txn.LoadWithPrefix(fmt.Sprintf("%s/%d/", DroppedPartitionMetaPrefix, collID))
+	if err != nil {
+		return nil, err
+	}
+	var dropped *pb.DroppedPartitionInfo
+	for _, value := range values {
+		info := pb.DroppedPartitionInfo{}
+		if err := proto.Unmarshal([]byte(value), &info); err != nil {
+			return nil, fmt.Errorf("metaTable GetDroppedPartition Unmarshal fail, err:%w", err)
+		}
+		if info.PartitionName == partitionName && (dropped == nil || info.DropTime > dropped.DropTime) {
+			dropped = &info
+		}
+	}
+	if dropped == nil {
+		return nil, fmt.Errorf("can't find dropped partition %s of collection %d", partitionName, collID)
+	}
+	return dropped, nil
+}
+
+// RecoverCollection restore a dropped collection with its original id and channels
+func (mt *MetaTable) RecoverCollection(coll *pb.CollectionInfo, ts typeutil.Timestamp, ddOpStr string) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.collID2Meta[coll.ID]; ok {
+		return fmt.Errorf("collection id = %d exist", coll.ID)
+	}
+	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
+		return fmt.Errorf("collection %s exist", coll.Schema.Name)
+	}
+	if _, ok := mt.collAlias2ID[coll.Schema.Name]; ok {
+		return fmt.Errorf("collection name collides with existing collection alias, name = %s", coll.Schema.Name)
+	}
+
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, coll.ID)
+	v, err := proto.Marshal(coll)
+	if err != nil {
+		log.Error("MetaTable RecoverCollection Marshal CollectionInfo fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable RecoverCollection Marshal CollectionInfo fail key:%s, err:%w", k, err)
+	}
+
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.collID2Meta[coll.ID] = *coll
+	mt.collName2ID[coll.Schema.Name] = coll.ID
+
+	// save ddOpStr into etcd, the record of the dropped collection is kept
+	// until the recovery restores the segments of the collection
+	saveMeta := map[string]string{
+		DDMsgSendPrefix:   "false",
+		DDOperationPrefix: ddOpStr,
+	}
+	err = mt.txn.MultiSave(saveMeta)
+	if err != nil {
+		// will not panic, missing create msg
+		log.Warn("TxnKV MultiSave fail", zap.Error(err))
+	}
+	return nil
+}
+
+// RemoveDroppedCollection remove the record of the dropped collection once it is recovered
+func (mt *MetaTable) RemoveDroppedCollection(collID typeutil.UniqueID) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	return mt.txn.Remove(fmt.Sprintf("%s/%d", DroppedCollectionMetaPrefix, collID))
+}
+
+// RecoverPartition restore a dropped partition with its original id
+func (mt *MetaTable) RecoverPartition(collID typeutil.UniqueID, partitionName string, partitionID typeutil.UniqueID, ts typeutil.Timestamp, ddOpStr string) error {
+	err := mt.AddPartition(collID, partitionName, partitionID, ts, ddOpStr)
+	if err != nil {
+		return err
+	}
+	err = mt.txn.Remove(fmt.Sprintf("%s/%d/%d", DroppedPartitionMetaPrefix, collID, partitionID))
+	if err != nil {
+		log.Warn("TxnKV Remove fail", zap.Error(err))
+		// will not panic, the stale record of the dropped partition is rejected by the recovery anyway
+	}
+	return nil
+}
+
 // IsAlias returns true if specific `collectionAlias` is an alias of collection.
 func (mt *MetaTable) IsAlias(collectionAlias string) bool {
 	mt.ddLock.RLock()
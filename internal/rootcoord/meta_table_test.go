@@ -874,6 +874,72 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, 11, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find index name = %s on segment = %d, with filed id = 11", idxInfo[0].IndexName, segIdxInfo.SegmentID))
+
+		// there is no default index, the earliest one is returned
+		idx, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, -1, "")
+		assert.Nil(t, err)
+		assert.Equal(t, segIdxInfo.IndexID, idx.IndexID)
+
+		idx, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, -1, idxInfo[0].IndexName)
+		assert.Nil(t, err)
+		assert.Equal(t, segIdxInfo.IndexID, idx.IndexID)
+
+		_, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, 11, "")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("check index name", func(t *testing.T) {
+		mockKV.loadWithPrefix = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return nil, nil, nil
+		}
+		mockTxnKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string) error {
+			return nil
+		}
+		err := mt.reloadFromKV()
+		assert.Nil(t, err)
+
+		collInfo.PartitionIDs = nil
+		collInfo.PartitionNames = nil
+		collInfo.PartitionCreatedTimestamps = nil
+		ts := ftso()
+		err = mt.AddCollection(collInfo, ts, idxInfo, "")
+		assert.Nil(t, err)
+
+		fieldName := collInfo.Schema.Fields[0].Name
+		hnswParams := []*commonpb.KeyValuePair{
+			{
+				Key:   "index_type",
+				Value: "HNSW",
+			},
+		}
+
+		err = mt.CheckIndexName("abc", fieldName, idxInfo[0])
+		assert.NotNil(t, err)
+		assert.EqualError(t, err, "collection abc not found")
+
+		err = mt.CheckIndexName(collInfo.Schema.Name, "no-field", idxInfo[0])
+		assert.NotNil(t, err)
+
+		// create the same index again
+		err = mt.CheckIndexName(collInfo.Schema.Name, fieldName, idxInfo[0])
+		assert.Nil(t, err)
+
+		err = mt.CheckIndexName(collInfo.Schema.Name, fieldName, &pb.IndexInfo{IndexName: "hnsw", IndexParams: hnswParams})
+		assert.Nil(t, err)
+
+		err = mt.CheckIndexName(collInfo.Schema.Name, fieldName, &pb.IndexInfo{IndexName: idxInfo[0].IndexName, IndexParams: hnswParams})
+		assert.NotNil(t, err)
+		assert.EqualError(t, err, fmt.Sprintf("index name = %s already exists in collection %s", idxInfo[0].IndexName, collInfo.Schema.Name))
+
+		err = mt.CheckIndexName(collInfo.Schema.Name, fieldName, &pb.IndexInfo{IndexName: "hnsw", IndexParams: idxInfo[0].IndexParams})
+		assert.NotNil(t, err)
+		assert.EqualError(t, err, fmt.Sprintf("index %s on field %s has the same params", idxInfo[0].IndexName, fieldName))
+
+		// drop the index by name only
+		idxID, isDropped, err := mt.DropIndex(collInfo.Schema.Name, "", idxInfo[0].IndexName)
+		assert.Nil(t, err)
+		assert.True(t, isDropped)
+		assert.Equal(t, idxInfo[0].IndexID, idxID)
 	})
 
 	t.Run("get field schema failed", func(t *testing.T) {
@@ -1257,3 +1323,221 @@ func TestFixIssue10540(t *testing.T) {
 	_, err = NewMetaTable(txnKV, skv)
 	assert.Nil(t, err)
 }
+
+func TestMetaTable_AddCollectionWithPartitionKey(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKVWithClient(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	newCollInfo := func(collID typeutil.UniqueID, name string, isPartitionKey bool) *pb.CollectionInfo {
+		return &pb.CollectionInfo{
+			ID: collID,
+			Schema: &schemapb.CollectionSchema{
+				Name: name,
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+					{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64, IsPartitionKey: isPartitionKey},
+				},
+			},
+			PartitionIDs: []typeutil.UniqueID{10, 11},
+			PartitionNames: []string{
+				typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 0),
+				typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, 1),
+			},
+			PartitionCreatedTimestamps: []uint64{0, 0},
+		}
+	}
+
+	// only the collection with a partition key has more than one partition when it's created
+	err = mt.AddCollection(newCollInfo(1, "coll1", false), 1, nil, "")
+	assert.NotNil(t, err)
+
+	err = mt.AddCollection(newCollInfo(2, "coll2", true), 2, nil, "")
+	assert.Nil(t, err)
+	collMeta, err := mt.GetCollectionByName("coll2", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []typeutil.UniqueID{10, 11}, collMeta.PartitionIDs)
+	assert.Equal(t, []uint64{2, 2}, collMeta.PartitionCreatedTimestamps)
+}
+
+func TestMetaTable_RenameCollection(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKVWithClient(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	for i, name := range []string{"coll1", "coll2"} {
+		collInfo := &pb.CollectionInfo{
+			ID:                         typeutil.UniqueID(i + 1),
+			Schema:                     &schemapb.CollectionSchema{Name: name},
+			PartitionIDs:               []typeutil.UniqueID{typeutil.UniqueID(i + 10)},
+			PartitionNames:             []string{Params.DefaultPartitionName},
+			PartitionCreatedTimestamps: []uint64{0},
+		}
+		err = mt.AddCollection(collInfo, typeutil.Timestamp(i+1), nil, "")
+		assert.Nil(t, err)
+	}
+	err = mt.AddAlias("alias1", "coll1", 3)
+	assert.Nil(t, err)
+
+	// conflicts with the existing names and aliases
+	assert.NotNil(t, mt.RenameCollection("coll3", "coll4", 4))
+	assert.NotNil(t, mt.RenameCollection("coll1", "coll2", 4))
+	assert.NotNil(t, mt.RenameCollection("coll2", "alias1", 4))
+	assert.NotNil(t, mt.RenameCollection("alias1", "coll4", 4))
+
+	err = mt.RenameCollection("coll1", "coll4", 5)
+	assert.Nil(t, err)
+
+	collMeta, err := mt.GetCollectionByName("coll4", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collMeta.ID)
+	assert.Equal(t, "coll4", collMeta.Schema.Name)
+	_, err = mt.GetCollectionByName("coll1", 0)
+	assert.NotNil(t, err)
+	collMeta, err = mt.GetCollectionByName("alias1", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collMeta.ID)
+
+	// time travel to the timestamps before the rename
+	collMeta, err = mt.GetCollectionByName("coll1", 4)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collMeta.ID)
+	_, err = mt.GetCollectionByName("coll4", 4)
+	assert.NotNil(t, err)
+	collMeta, err = mt.GetCollectionByName("coll4", 5)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collMeta.ID)
+
+	// the rename survives reloading
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	collMeta, err = mt.GetCollectionByName("coll4", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collMeta.ID)
+	_, err = mt.GetCollectionByName("coll1", 0)
+	assert.NotNil(t, err)
+}
+
+func TestMetaTable_RecoverCollection(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKVWithClient(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	collInfo := &pb.CollectionInfo{
+		ID:                         1,
+		Schema:                     &schemapb.CollectionSchema{Name: "coll1"},
+		PartitionIDs:               []typeutil.UniqueID{10},
+		PartitionNames:             []string{Params.DefaultPartitionName},
+		PartitionCreatedTimestamps: []uint64{0},
+	}
+	err = mt.AddCollection(collInfo, 1, nil, "")
+	assert.Nil(t, err)
+	err = mt.AddPartition(1, "part1", 11, 2, "")
+	assert.Nil(t, err)
+
+	// partition
+	_, err = mt.GetDroppedPartition(1, "part1")
+	assert.NotNil(t, err)
+	_, err = mt.DeletePartition(1, "part1", 3, "")
+	assert.Nil(t, err)
+	droppedPart, err := mt.GetDroppedPartition(1, "part1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(11), droppedPart.PartitionID)
+	assert.Equal(t, typeutil.Timestamp(3), droppedPart.DropTime)
+
+	err = mt.RecoverPartition(1, "part1", droppedPart.PartitionID, 4, "")
+	assert.Nil(t, err)
+	assert.True(t, mt.HasPartition(1, "part1", 0))
+	_, err = mt.GetDroppedPartition(1, "part1")
+	assert.NotNil(t, err)
+	assert.NotNil(t, mt.RecoverPartition(1, "part1", droppedPart.PartitionID, 5, ""))
+
+	// collection, the latest dropped one with the name is found
+	_, err = mt.DeletePartition(1, "part1", 5, "")
+	assert.Nil(t, err)
+	err = mt.DeleteCollection(1, 6, "")
+	assert.Nil(t, err)
+	collInfo.ID = 2
+	err = mt.AddCollection(collInfo, 7, nil, "")
+	assert.Nil(t, err)
+	err = mt.DeleteCollection(2, 8, "")
+	assert.Nil(t, err)
+
+	dropped, err := mt.GetDroppedCollection("coll1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), dropped.CollectionID)
+	assert.Equal(t, typeutil.Timestamp(8), dropped.DropTime)
+	_, err = mt.GetDroppedCollection("coll2")
+	assert.NotNil(t, err)
+	// the records of the dropped partitions are removed along with the collection
+	_, err = mt.GetDroppedPartition(1, "part1")
+	assert.NotNil(t, err)
+
+	collMeta, err := mt.GetCollectionByID(1, 5)
+	assert.Nil(t, err)
+	err = mt.RecoverCollection(collMeta, 9, "")
+	assert.Nil(t, err)
+	collMeta, err = mt.GetCollectionByName("coll1", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collMeta.ID)
+	dropped, err = mt.GetDroppedCollection("coll1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), dropped.CollectionID)
+
+	// the record of the recovered collection is kept until it is removed explicitly
+	err = mt.RemoveDroppedCollection(2)
+	assert.Nil(t, err)
+	dropped, err = mt.GetDroppedCollection("coll1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), dropped.CollectionID)
+	err = mt.RemoveDroppedCollection(1)
+	assert.Nil(t, err)
+	_, err = mt.GetDroppedCollection("coll1")
+	assert.NotNil(t, err)
+
+	// conflicts with the existing collection
+	collMeta, err = mt.GetCollectionByID(2, 7)
+	assert.Nil(t, err)
+	assert.NotNil(t, mt.RecoverCollection(collMeta, 10, ""))
+	err = mt.AddAlias("coll3", "coll1", 10)
+	assert.Nil(t, err)
+	collMeta.Schema.Name = "coll3"
+	assert.NotNil(t, mt.RecoverCollection(collMeta, 11, ""))
+
+	// the recovery survives reloading
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	collMeta, err = mt.GetCollectionByName("coll1", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), collMeta.ID)
+}
@@ -12,10 +12,12 @@
 package rootcoord
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -35,6 +37,10 @@ type ParamTable struct {
 	MetaRootPath  string
 	KvRootPath    string
 
+	MetaStoreType      string
+	MetaStoreSQLDriver string
+	MetaStoreSQLDSN    string
+
 	ClusterChannelPrefix string
 	MsgChannelSubName    string
 	TimeTickChannel      string
@@ -47,6 +53,9 @@ type ParamTable struct {
 	DefaultPartitionName        string
 	DefaultIndexName            string
 	MinSegmentSizeToEnableIndex int64
+	DropRetention               time.Duration
+	SnapshotRetention           time.Duration
+	SnapshotPruneInterval       time.Duration
 
 	Timeout          int
 	TimeTickInterval int
@@ -71,6 +80,7 @@ func (p *ParamTable) Init() {
 	p.initEtcdEndpoints()
 	p.initMetaRootPath()
 	p.initKvRootPath()
+	p.initMetaStore()
 
 	// Has to init global msgchannel prefix before other channel names
 	p.initClusterMsgChannelPrefix()
@@ -85,6 +95,9 @@ func (p *ParamTable) Init() {
 	p.initMinSegmentSizeToEnableIndex()
 	p.initDefaultPartitionName()
 	p.initDefaultIndexName()
+	p.initDropRetention()
+	p.initSnapshotRetention()
+	p.initSnapshotPruneInterval()
 
 	p.initTimeout()
 	p.initTimeTickInterval()
@@ -207,6 +220,34 @@ func (p *ParamTable) initDefaultIndexName() {
 	p.DefaultIndexName = name
 }
 
+// dropped collections and partitions could be recovered until datacoord garbage collects their segments
+func (p *ParamTable) initDropRetention() {
+	p.DropRetention = time.Duration(p.ParseInt64WithDefault("dataCoord.gc.dropTolerance", 24*60*60)) * time.Second
+}
+
+// snapshot versions older than the retention are pruned, 0 means keeping all of them
+// dropped collections are loaded from the snapshot, so it must not be shorter than DropRetention
+func (p *ParamTable) initSnapshotRetention() {
+	p.SnapshotRetention = time.Duration(p.ParseInt64WithDefault("rootCoord.snapshot.retention", 7*24*60*60)) * time.Second
+	if p.SnapshotRetention > 0 && p.SnapshotRetention < p.DropRetention {
+		p.SnapshotRetention = p.DropRetention
+	}
+}
+
+func (p *ParamTable) initSnapshotPruneInterval() {
+	p.SnapshotPruneInterval = time.Duration(p.ParseInt64WithDefault("rootCoord.snapshot.pruneInterval", 60*60)) * time.Second
+}
+
+// the metastore of rootCoord falls back to the global one if not set
+func (p *ParamTable) initMetaStore() {
+	p.MetaStoreType = p.LoadWithDefault("rootCoord.metastore", p.LoadWithDefault("metastore.type", kv.MetaStoreEtcd))
+	if p.MetaStoreType != kv.MetaStoreEtcd && p.MetaStoreType != kv.MetaStoreSQL {
+		panic(fmt.Sprintf("unsupported metastore type %s", p.MetaStoreType))
+	}
+	p.MetaStoreSQLDriver = p.LoadWithDefault("metastore.sql.driver", "mysql")
+	p.MetaStoreSQLDSN = p.LoadWithDefault("metastore.sql.dsn", "")
+}
+
 func (p *ParamTable) initTimeout() {
 	p.Timeout = p.ParseIntWithDefault("rootCoord.timeout", 3600)
 }
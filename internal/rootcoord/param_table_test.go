@@ -15,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,6 +34,9 @@ func TestParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.KvRootPath, "")
 	t.Logf("kv root path = %s", Params.KvRootPath)
 
+	assert.Equal(t, kv.MetaStoreEtcd, Params.MetaStoreType)
+	t.Logf("metastore = %s", Params.MetaStoreType)
+
 	assert.Equal(t, Params.MsgChannelSubName, "by-dev-rootCoord")
 	t.Logf("msg channel sub name = %s", Params.MsgChannelSubName)
 
@@ -60,6 +64,15 @@ func TestParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.DefaultIndexName, "")
 	t.Logf("default index name = %s", Params.DefaultIndexName)
 
+	assert.NotZero(t, Params.DropRetention)
+	t.Logf("drop retention = %v", Params.DropRetention)
+
+	assert.GreaterOrEqual(t, Params.SnapshotRetention, Params.DropRetention)
+	t.Logf("snapshot retention = %v", Params.SnapshotRetention)
+
+	assert.NotZero(t, Params.SnapshotPruneInterval)
+	t.Logf("snapshot prune interval = %v", Params.SnapshotPruneInterval)
+
 	assert.NotZero(t, Params.Timeout)
 	t.Logf("master timeout = %d", Params.Timeout)
 
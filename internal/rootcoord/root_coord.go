@@ -27,7 +27,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv/metastore"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	ms "github.com/milvus-io/milvus/internal/msgstream"
@@ -129,6 +129,9 @@ type Core struct {
 
 	CallWatchChannels func(ctx context.Context, collectionID int64, channelNames []string) error
 
+	//data service interface, notify data service to restore the segments of a recovered collection
+	CallRecoverDroppedSegments func(ctx context.Context, collectionID int64) error
+
 	//Proxy manager
 	proxyManager *proxyManager
 
@@ -250,6 +253,9 @@ func (c *Core) checkInit() error {
 	if c.CallWatchChannels == nil {
 		return fmt.Errorf("callWatchChannels is nil")
 	}
+	if c.CallRecoverDroppedSegments == nil {
+		return fmt.Errorf("callRecoverDroppedSegments is nil")
+	}
 	if c.NewProxyClient == nil {
 		return fmt.Errorf("newProxyClient is nil")
 	}
@@ -321,6 +327,55 @@ func (c *Core) checkFlushedSegmentsLoop() {
 	}
 }
 
+// snapshotPruner is implemented by the SnapShotKV which supports dropping history versions
+type snapshotPruner interface {
+	// Prune removes versions not visible at or after ts, returns the number of keys deleted from the kv
+	Prune(ts typeutil.Timestamp) (int, error)
+	// Stats returns the number and total size of stored keys
+	Stats() (int, int, error)
+}
+
+func (c *Core) pruneSnapshotLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(Params.SnapshotPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done, exit prune snapshot loop")
+			return
+		case <-ticker.C:
+			c.pruneSnapshot()
+		}
+	}
+}
+
+// pruneSnapshot drops meta snapshot versions out of the retention, and reports the size of the snapshot
+func (c *Core) pruneSnapshot() {
+	pruner, ok := c.MetaTable.snapshot.(snapshotPruner)
+	if !ok {
+		return
+	}
+	if Params.SnapshotRetention > 0 {
+		physical := time.Now().Add(-Params.SnapshotRetention).UnixNano() / int64(time.Millisecond)
+		ts := tsoutil.ComposeTS(physical, 0)
+		pruned, err := pruner.Prune(ts)
+		if err != nil {
+			log.Warn("failed to prune meta snapshot", zap.Uint64("ts", ts), zap.Error(err))
+		} else {
+			log.Debug("prune meta snapshot", zap.Uint64("ts", ts), zap.Int("pruned", pruned))
+			metrics.RootCoordSnapshotPrunedCounter.Add(float64(pruned))
+		}
+	}
+	keyNum, keySize, err := pruner.Stats()
+	if err != nil {
+		log.Warn("failed to get meta snapshot stats", zap.Error(err))
+		return
+	}
+	metrics.RootCoordSnapshotKeyNum.Set(float64(keyNum))
+	metrics.RootCoordSnapshotKeySize.Set(float64(keySize))
+}
+
 func (c *Core) checkFlushedSegments(ctx context.Context) {
 	collID2Meta, segID2IndexMeta, indexID2Meta := c.MetaTable.dupMeta()
 	for _, collMeta := range collID2Meta {
@@ -708,6 +763,30 @@ func (c *Core) SetDataCoord(ctx context.Context, s types.DataCoord) error {
 		}
 		return nil
 	}
+
+	c.CallRecoverDroppedSegments = func(ctx context.Context, collectionID int64) (retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("recover dropped segments panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &datapb.RecoverDroppedSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_RecoverCollection,
+				SourceID: c.session.ServerID,
+			},
+			CollectionID: collectionID,
+		}
+		rsp, err := s.RecoverDroppedSegments(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("data coord recover dropped segments failed, reason = %s", rsp.Reason)
+		}
+		return nil
+	}
 	return nil
 }
 
@@ -848,7 +927,7 @@ func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *s
 	log.Debug("start build index", zap.String("index name", idxInfo.IndexName),
 		zap.String("field name", field.Name), zap.Int64("segment id", segID))
 	sp, ctx := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	if c.MetaTable.IsSegmentIndexed(segID, field, idxInfo.IndexParams) {
 		return 0, nil
 	}
@@ -924,7 +1003,7 @@ func (c *Core) Init() error {
 	var initError error = nil
 	if c.kvBaseCreate == nil {
 		c.kvBaseCreate = func(root string) (kv.TxnKV, error) {
-			return etcdkv.NewEtcdKV(Params.EtcdEndpoints, root)
+			return metastore.NewMetaKV(Params.MetaStoreType, Params.MetaStoreSQLDriver, Params.MetaStoreSQLDSN, Params.EtcdEndpoints, root)
 		}
 	}
 	c.initOnce.Do(func() {
@@ -1164,11 +1243,12 @@ func (c *Core) Start() error {
 			log.Fatal("RootCoord Start reSendDdMsg failed", zap.Error(err))
 			panic(err)
 		}
-		c.wg.Add(4)
+		c.wg.Add(5)
 		go c.startTimeTickLoop()
 		go c.tsLoop()
 		go c.chanTimeTick.startWatch(&c.wg)
 		go c.checkFlushedSegmentsLoop()
+		go c.pruneSnapshotLoop()
 		go c.session.LivenessCheck(c.ctx, func() {
 			log.Error("Root Coord disconnected from etcd, process will exit", zap.Int64("Server Id", c.session.ServerID))
 			if err := c.Stop(); err != nil {
@@ -2038,3 +2118,93 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 
 	return succStatus(), nil
 }
+
+// RenameCollection rename collection
+func (c *Core) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("RenameCollection", zap.String("role", Params.RoleName),
+		zap.String("old name", in.OldName), zap.String("new name", in.NewName),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &RenameCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("RenameCollection failed", zap.String("role", Params.RoleName),
+			zap.String("old name", in.OldName), zap.String("new name", in.NewName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "RenameCollection failed: "+err.Error()), nil
+	}
+	log.Debug("RenameCollection success", zap.String("role", Params.RoleName),
+		zap.String("old name", in.OldName), zap.String("new name", in.NewName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// RecoverCollection restores a dropped collection within the retention of the dropped segments
+func (c *Core) RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("RecoverCollection", zap.String("role", Params.RoleName),
+		zap.String("collection name", in.CollectionName),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &RecoverCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("RecoverCollection failed", zap.String("role", Params.RoleName),
+			zap.String("collection name", in.CollectionName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "RecoverCollection failed: "+err.Error()), nil
+	}
+	log.Debug("RecoverCollection success", zap.String("role", Params.RoleName),
+		zap.String("collection name", in.CollectionName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// RecoverPartition restores a dropped partition within the retention
+func (c *Core) RecoverPartition(ctx context.Context, in *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("RecoverPartition", zap.String("role", Params.RoleName),
+		zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &RecoverPartitionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("RecoverPartition failed", zap.String("role", Params.RoleName),
+			zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "RecoverPartition failed: "+err.Error()), nil
+	}
+	log.Debug("RecoverPartition success", zap.String("role", Params.RoleName),
+		zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
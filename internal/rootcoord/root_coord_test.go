@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"math/rand"
 	"path"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -159,6 +161,12 @@ func (d *dataMock) WatchChannels(ctx context.Context, req *datapb.WatchChannelsR
 		}}, nil
 }
 
+func (d *dataMock) RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 type queryMock struct {
 	types.QueryCoord
 	collID []typeutil.UniqueID
@@ -515,6 +523,54 @@ func TestRootCoordInit(t *testing.T) {
 
 }
 
+func TestRootCoordInit_SQLMetaStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	coreFactory := msgstream.NewPmsFactory()
+	Params.Init()
+	defer Params.Init()
+	Params.DmlChannelNum = TestDMLChannelNum
+	Params.MetaStoreType = kv.MetaStoreSQL
+	Params.MetaStoreSQLDriver = "sqlite3"
+	Params.MetaStoreSQLDSN = filepath.Join(t.TempDir(), "meta.db")
+	core, err := NewCore(ctx, coreFactory)
+	require.Nil(t, err)
+	randVal := rand.Int()
+
+	Params.MetaRootPath = fmt.Sprintf("/%d/%s", randVal, Params.MetaRootPath)
+	Params.KvRootPath = fmt.Sprintf("/%d/%s", randVal, Params.KvRootPath)
+
+	err = core.Register()
+	assert.Nil(t, err)
+	err = core.Init()
+	assert.Nil(t, err)
+
+	collInfo := &etcdpb.CollectionInfo{
+		ID:     1,
+		Schema: &schemapb.CollectionSchema{Name: "sql_coll"},
+	}
+	err = core.MetaTable.AddCollection(collInfo, 100, nil, "")
+	assert.Nil(t, err)
+
+	// the collection meta is kept in sql rather than etcd
+	metaKV, err := sqlkv.NewSQLKV("sqlite3", Params.MetaStoreSQLDSN, Params.MetaRootPath)
+	require.Nil(t, err)
+	defer metaKV.Close()
+	ss, err := newSuffixSnapshot(metaKV, "_ts", Params.MetaRootPath, "snapshots")
+	require.Nil(t, err)
+	mt, err := NewMetaTable(metaKV, ss)
+	require.Nil(t, err)
+	assert.True(t, mt.HasCollection(collInfo.ID, 0))
+
+	etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	require.Nil(t, err)
+	defer etcdKV.Close()
+	_, values, err := etcdKV.LoadWithPrefix(CollectionMetaPrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+}
+
 func TestRootCoord(t *testing.T) {
 	const (
 		dbName    = "testDb"
@@ -1346,6 +1402,218 @@ func TestRootCoord(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("recover collection", func(t *testing.T) {
+		dropped, err := core.MetaTable.GetDroppedCollection(collName)
+		assert.Nil(t, err)
+		req := &milvuspb.RecoverCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_RecoverCollection,
+				MsgID:     240,
+				Timestamp: 240,
+				SourceID:  240,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+		}
+		status, err := core.RecoverCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, dropped.CollectionID, collMeta.ID)
+		assert.Equal(t, 1, len(collMeta.PartitionIDs))
+		assert.Empty(t, collMeta.FieldIndexes)
+		assert.Equal(t, len(collMeta.PhysicalChannelNames), len(collMeta.StartPositions))
+
+		msgs := getNotTtMsg(ctx, 1, dmlStream.Chan())
+		assert.Equal(t, 1, len(msgs))
+		cmsg, ok := (msgs[0]).(*msgstream.CreateCollectionMsg)
+		assert.True(t, ok)
+		assert.Equal(t, collMeta.ID, cmsg.CollectionID)
+
+		// the record of the dropped collection is removed
+		_, err = core.MetaTable.GetDroppedCollection(collName)
+		assert.NotNil(t, err)
+		status, err = core.RecoverCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+		req.CollectionName = "not_dropped"
+		status, err = core.RecoverCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
+	t.Run("recover partition", func(t *testing.T) {
+		const recoverPartName = "recover_partition"
+		status, err := core.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreatePartition,
+				MsgID:     241,
+				Timestamp: 241,
+				SourceID:  241,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			PartitionName:  recoverPartName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		partID, err := core.MetaTable.GetPartitionByName(collMeta.ID, recoverPartName, 0)
+		assert.Nil(t, err)
+
+		status, err = core.DropPartition(ctx, &milvuspb.DropPartitionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropPartition,
+				MsgID:     242,
+				Timestamp: 242,
+				SourceID:  242,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			PartitionName:  recoverPartName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		clearMsgChan(10*time.Millisecond, dmlStream.Chan())
+
+		req := &milvuspb.RecoverPartitionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_RecoverPartition,
+				MsgID:     243,
+				Timestamp: 243,
+				SourceID:  243,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+			PartitionName:  recoverPartName,
+		}
+		status, err = core.RecoverPartition(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		recoveredID, err := core.MetaTable.GetPartitionByName(collMeta.ID, recoverPartName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, partID, recoveredID)
+
+		msgs := getNotTtMsg(ctx, 1, dmlStream.Chan())
+		assert.Equal(t, 1, len(msgs))
+		pmsg, ok := (msgs[0]).(*msgstream.CreatePartitionMsg)
+		assert.True(t, ok)
+		assert.Equal(t, partID, pmsg.PartitionID)
+
+		status, err = core.RecoverPartition(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+		// drop the recovered collection again
+		status, err = core.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropCollection,
+				MsgID:     244,
+				Timestamp: 244,
+				SourceID:  244,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		clearMsgChan(10*time.Millisecond, dmlStream.Chan())
+	})
+
+	t.Run("recover collection with index", func(t *testing.T) {
+		req := &milvuspb.RecoverCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_RecoverCollection,
+				MsgID:     245,
+				Timestamp: 245,
+				SourceID:  245,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+		}
+		status, err := core.RecoverCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		clearMsgChan(10*time.Millisecond, dmlStream.Chan())
+
+		rsp, err := core.CreateIndex(ctx, &milvuspb.CreateIndexRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_CreateIndex,
+				MsgID:     246,
+				Timestamp: 246,
+				SourceID:  246,
+			},
+			CollectionName: collName,
+			FieldName:      "vector",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(collMeta.FieldIndexes))
+
+		status, err = core.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropCollection,
+				MsgID:     247,
+				Timestamp: 247,
+				SourceID:  247,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		clearMsgChan(10*time.Millisecond, dmlStream.Chan())
+
+		// the segments fail to be restored, the collection meta is restored without the dropped indexes
+		callRecoverDroppedSegments := core.CallRecoverDroppedSegments
+		core.CallRecoverDroppedSegments = func(ctx context.Context, collectionID int64) error {
+			return errors.New("mock recover dropped segments error")
+		}
+		req.Base.MsgID, req.Base.Timestamp, req.Base.SourceID = 248, 248, 248
+		status, err = core.RecoverCollection(ctx, req)
+		core.CallRecoverDroppedSegments = callRecoverDroppedSegments
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		assert.Empty(t, collMeta.FieldIndexes)
+		clearMsgChan(10*time.Millisecond, dmlStream.Chan())
+
+		// the recovery can be retried while the record of the dropped collection is kept
+		dropped, err := core.MetaTable.GetDroppedCollection(collName)
+		assert.Nil(t, err)
+		assert.Equal(t, collMeta.ID, dropped.CollectionID)
+		req.Base.MsgID, req.Base.Timestamp, req.Base.SourceID = 249, 249, 249
+		status, err = core.RecoverCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		_, err = core.MetaTable.GetDroppedCollection(collName)
+		assert.NotNil(t, err)
+		recovered, err := core.MetaTable.GetCollectionByName(collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collMeta.ID, recovered.ID)
+
+		status, err = core.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropCollection,
+				MsgID:     250,
+				Timestamp: 250,
+				SourceID:  250,
+			},
+			DbName:         dbName,
+			CollectionName: collName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		clearMsgChan(10*time.Millisecond, dmlStream.Chan())
+	})
+
 	t.Run("context_cancel", func(t *testing.T) {
 		ctx2, cancel2 := context.WithTimeout(ctx, time.Millisecond*100)
 		defer cancel2()
@@ -1885,6 +2153,37 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 	})
 
+	t.Run("rename collection", func(t *testing.T) {
+		newCollName := collName2 + "_renamed"
+		req := &milvuspb.RenameCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_RenameCollection,
+				MsgID:     3015,
+				Timestamp: 3015,
+				SourceID:  3015,
+			},
+			OldName: collName2,
+			NewName: newCollName,
+		}
+		rsp, err := core.RenameCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+		_, err = core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NotNil(t, err)
+
+		// the new name collides with the alias
+		req.OldName = newCollName
+		req.NewName = aliasName
+		rsp, err = core.RenameCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		req.NewName = collName2
+		rsp, err = core.RenameCollection(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+	})
+
 	t.Run("drop collection with alias", func(t *testing.T) {
 		req := &milvuspb.DropCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -2452,6 +2751,12 @@ func TestCheckInit(t *testing.T) {
 		return nil
 	}
 	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.CallRecoverDroppedSegments = func(ctx context.Context, collectionID int64) error {
+		return nil
+	}
+	err = c.checkInit()
 	assert.Nil(t, err)
 
 	err = c.Stop()
@@ -2527,6 +2832,20 @@ func TestCheckFlushedSegments(t *testing.T) {
 	timeTickStream.Start()
 
 	time.Sleep(100 * time.Millisecond)
+	t.Run("prune snapshot", func(t *testing.T) {
+		retention := Params.SnapshotRetention
+		defer func() { Params.SnapshotRetention = retention }()
+		// only report stats
+		Params.SnapshotRetention = 0
+		core.pruneSnapshot()
+
+		Params.SnapshotRetention = retention
+		core.pruneSnapshot()
+		ss, ok := core.MetaTable.snapshot.(*suffixSnapshot)
+		assert.True(t, ok)
+		assert.NotZero(t, ss.minTs)
+	})
+
 	t.Run("check flushed segments", func(t *testing.T) {
 		ctx := context.Background()
 		var collID int64 = 1
@@ -34,6 +34,10 @@ var (
 	suffixSnapshotTombstone = []byte{0xE2, 0x9B, 0xBC}
 )
 
+// suffixSnapshotMinTSKey is the key under snapshot prefix which persists minTs
+// it never matches the ts-key format since it does not end with digits
+const suffixSnapshotMinTSKey = "min-ts"
+
 // suffixSnapshot implements SnapshotKV
 // this is a simple replacement for metaSnapshot, which is not available due to etcd compaction
 // suffixSnapshot record timestamp as prefix of a key under the snapshot prefix path
@@ -62,6 +66,8 @@ type suffixSnapshot struct {
 	// exp is the shortcut format checker for ts-key
 	// composed with separator only
 	exp *regexp.Regexp
+	// minTs is the earliest timestamp which could still be loaded after history pruning
+	minTs typeutil.Timestamp
 }
 
 // tsv struct stores kv with timestamp
@@ -72,6 +78,7 @@ type tsv struct {
 
 // type conversion make sure implementation
 var _ kv.SnapShotKV = (*suffixSnapshot)(nil)
+var _ snapshotPruner = (*suffixSnapshot)(nil)
 
 // newSuffixSnapshot creates a newSuffixSnapshot with provided kv
 func newSuffixSnapshot(txnKV kv.TxnKV, sep, root, snapshot string) (*suffixSnapshot, error) {
@@ -87,7 +94,7 @@ func newSuffixSnapshot(txnKV kv.TxnKV, sep, root, snapshot string) (*suffixSnaps
 	tk = path.Join(root, "k")
 	rootLen := len(tk) - 1
 
-	return &suffixSnapshot{
+	ss := &suffixSnapshot{
 		TxnKV:          txnKV,
 		lastestTS:      make(map[string]typeutil.Timestamp),
 		separator:      sep,
@@ -96,7 +103,36 @@ func newSuffixSnapshot(txnKV kv.TxnKV, sep, root, snapshot string) (*suffixSnaps
 		snapshotLen:    snapshotLen,
 		rootPrefix:     root,
 		rootLen:        rootLen,
-	}, nil
+	}
+	if err := ss.loadMinTS(); err != nil {
+		return nil, err
+	}
+	return ss, nil
+}
+
+// minTSKey returns the key which persists minTs
+func (ss *suffixSnapshot) minTSKey() string {
+	return path.Join(ss.snapshotPrefix, suffixSnapshotMinTSKey)
+}
+
+// loadMinTS restores minTs persisted by previous prunes
+func (ss *suffixSnapshot) loadMinTS() error {
+	// load with prefix since a missing key is an error for some kv implementations
+	keys, values, err := ss.TxnKV.LoadWithPrefix(ss.minTSKey())
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if ss.hideRootPrefix(key) != ss.minTSKey() {
+			continue
+		}
+		minTs, err := strconv.ParseUint(values[i], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid snapshot min ts %s: %w", values[i], err)
+		}
+		ss.minTs = minTs
+	}
+	return nil
 }
 
 // isTombstone helper function to check whether is tombstone mark
@@ -125,18 +161,33 @@ func (ss *suffixSnapshot) composeTSKey(key string, ts typeutil.Timestamp) string
 // isTSKey checks whether a key is in ts-key format
 // if true, also returns parsed ts value
 func (ss *suffixSnapshot) isTSKey(key string) (typeutil.Timestamp, bool) {
+	_, ts, ok := ss.splitTSKey(key)
+	return ts, ok
+}
+
+// splitTSKey checks whether a key is in ts-key format
+// if true, also returns the original key and parsed ts value
+func (ss *suffixSnapshot) splitTSKey(key string) (string, typeutil.Timestamp, bool) {
 	// not in snapshot path
 	if !strings.HasPrefix(key, ss.snapshotPrefix) {
-		return 0, false
+		return "", 0, false
 	}
 	key = key[ss.snapshotLen:]
 	matches := ss.exp.FindStringSubmatch(key)
 	if len(matches) < 3 {
-		return 0, false
+		return "", 0, false
 	}
 	// err ignores since it's protected by the regexp
 	ts, _ := strconv.ParseUint(matches[2], 10, 64)
-	return ts, true
+	return matches[1], ts, true
+}
+
+// checkMinTS checks provided ts is not before the pruned history
+func (ss *suffixSnapshot) checkMinTS(ts typeutil.Timestamp) error {
+	if ts < ss.minTs {
+		return fmt.Errorf("ts %d is before the earliest kept snapshot ts %d", ts, ss.minTs)
+	}
+	return nil
 }
 
 // isTSOfKey check whether a key is in ts-key format of provided group key
@@ -294,6 +345,9 @@ func (ss *suffixSnapshot) Load(key string, ts typeutil.Timestamp) (string, error
 	if err != nil {
 		return "", err
 	}
+	if err := ss.checkMinTS(ts); err != nil {
+		return "", err
+	}
 	if after {
 		value, err := ss.TxnKV.Load(key)
 		if ss.isTombstone(value) {
@@ -418,6 +472,10 @@ func (ss *suffixSnapshot) LoadWithPrefix(key string, ts typeutil.Timestamp) ([]s
 	ss.Lock()
 	defer ss.Unlock()
 
+	if err := ss.checkMinTS(ts); err != nil {
+		return nil, nil, err
+	}
+
 	keys, values, err := ss.TxnKV.LoadWithPrefix(key)
 	if err != nil {
 		return nil, nil, err
@@ -520,3 +578,96 @@ func (ss *suffixSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string,
 	}
 	return err
 }
+
+// Prune removes ts-keys which are not visible at or after provided ts
+// for each key, the latest version before ts is kept so that loading at ts still works,
+// unless it is a tombstone, in which case the key is removed entirely
+// returns the number of removed keys
+func (ss *suffixSnapshot) Prune(ts typeutil.Timestamp) (int, error) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	keys, values, err := ss.TxnKV.LoadWithPrefix(ss.snapshotPrefix)
+	if err != nil {
+		log.Warn("suffixSnapshot TxnKV LoadWithPrefix failed", zap.String("prefix", ss.snapshotPrefix), zap.Error(err))
+		return 0, err
+	}
+
+	// group ts-keys by the original key
+	type tsRecord struct {
+		tsKey string
+		tsv
+	}
+	groups := make(map[string][]tsRecord)
+	for i, key := range keys {
+		tsKey := ss.hideRootPrefix(key)
+		origin, recordTs, ok := ss.splitTSKey(tsKey)
+		if !ok {
+			continue
+		}
+		groups[origin] = append(groups[origin], tsRecord{tsKey: tsKey, tsv: tsv{value: values[i], ts: recordTs}})
+	}
+
+	removals := make([]string, 0)
+	removedKeys := make([]string, 0)
+	for origin, records := range groups {
+		sort.Slice(records, func(i, j int) bool {
+			return records[i].ts < records[j].ts
+		})
+		// find the latest version visible at ts
+		idx := sort.Search(len(records), func(i int) bool {
+			return records[i].ts > ts
+		}) - 1
+		if idx < 0 {
+			continue
+		}
+		for _, record := range records[:idx] {
+			removals = append(removals, record.tsKey)
+		}
+		// key dropped before ts and never saved again
+		if idx == len(records)-1 && ss.isTombstone(records[idx].value) {
+			removals = append(removals, records[idx].tsKey, origin)
+			removedKeys = append(removedKeys, origin)
+		}
+	}
+
+	removed := len(removals)
+	// the new minTs is persisted with the first batch of removals,
+	// so that loading pruned history is rejected even if a later batch fails or after restart
+	saves := make(map[string]string)
+	if ts > ss.minTs {
+		saves[ss.minTSKey()] = strconv.FormatUint(ts, 10)
+	}
+	for len(saves) > 0 || len(removals) > 0 {
+		batch := removals
+		if len(batch) > maxTxnOps-len(saves) {
+			batch = removals[:maxTxnOps-len(saves)]
+		}
+		if err := ss.TxnKV.MultiSaveAndRemove(saves, batch); err != nil {
+			log.Warn("suffixSnapshot TxnKV MultiSaveAndRemove failed", zap.Error(err))
+			return 0, err
+		}
+		if len(saves) > 0 {
+			ss.minTs = ts
+			saves = make(map[string]string)
+		}
+		removals = removals[len(batch):]
+	}
+	for _, key := range removedKeys {
+		delete(ss.lastestTS, key)
+	}
+	return removed, nil
+}
+
+// Stats returns the number and total size of keys stored under the root prefix
+func (ss *suffixSnapshot) Stats() (int, int, error) {
+	keys, values, err := ss.TxnKV.LoadWithPrefix("")
+	if err != nil {
+		return 0, 0, err
+	}
+	size := 0
+	for i, key := range keys {
+		size += len(key) + len(values[i])
+	}
+	return len(keys), size, nil
+}
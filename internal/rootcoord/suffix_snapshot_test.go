@@ -3,10 +3,14 @@ package rootcoord
 import (
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"testing"
 	"time"
 
+	_ "github.com/mattn/go-sqlite3"
+
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -447,3 +451,148 @@ func Test_SuffixSnapshotMultiSaveAndRemoveWithPrefix(t *testing.T) {
 	// cleanup
 	ss.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{""}, 0)
 }
+
+func Test_SuffixSnapshotPrune(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+	sep := "_ts"
+
+	etcdkv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, rootPath)
+	require.Nil(t, err)
+	defer etcdkv.Close()
+
+	ss, err := newSuffixSnapshot(etcdkv, sep, rootPath, snapshotPrefix)
+	assert.Nil(t, err)
+	assert.NotNil(t, ss)
+
+	for i := 0; i < 20; i++ {
+		err = ss.Save("key", fmt.Sprintf("value-%d", i), typeutil.Timestamp(100+i*5))
+		assert.Nil(t, err)
+	}
+	err = ss.Save("kd", "value", 100)
+	assert.Nil(t, err)
+	err = ss.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{"kd"}, 110)
+	assert.Nil(t, err)
+
+	keyNum, keySize, err := ss.Stats()
+	assert.Nil(t, err)
+	assert.Equal(t, 24, keyNum)
+	assert.NotZero(t, keySize)
+
+	// 10 versions of key, 2 versions of kd and kd itself
+	pruned, err := ss.Prune(150)
+	assert.Nil(t, err)
+	assert.Equal(t, 13, pruned)
+
+	// 10 versions of key, key itself and the persisted min ts
+	keyNum, _, err = ss.Stats()
+	assert.Nil(t, err)
+	assert.Equal(t, 12, keyNum)
+
+	for i := 10; i < 20; i++ {
+		val, err := ss.Load("key", typeutil.Timestamp(100+i*5+2))
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), val)
+	}
+	_, err = ss.Load("key", 147)
+	assert.NotNil(t, err)
+	_, _, err = ss.LoadWithPrefix("key", 147)
+	assert.NotNil(t, err)
+	val, err := ss.Load("key", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "value-19", val)
+	_, err = ss.Load("kd", 0)
+	assert.NotNil(t, err)
+
+	// nothing left to prune
+	pruned, err = ss.Prune(150)
+	assert.Nil(t, err)
+	assert.Zero(t, pruned)
+
+	// min ts survives restart
+	ss, err = newSuffixSnapshot(etcdkv, sep, rootPath, snapshotPrefix)
+	assert.Nil(t, err)
+	_, err = ss.Load("key", 147)
+	assert.NotNil(t, err)
+	val, err = ss.Load("key", 152)
+	assert.Nil(t, err)
+	assert.Equal(t, "value-10", val)
+
+	ss.RemoveWithPrefix("")
+}
+
+func Test_SuffixSnapshotSQLKV(t *testing.T) {
+	rootPath := "/test/meta"
+	sep := "_ts"
+	dsn := filepath.Join(t.TempDir(), "meta.db")
+
+	sqlKV, err := sqlkv.NewSQLKV("sqlite3", dsn, rootPath)
+	require.Nil(t, err)
+	defer sqlKV.Close()
+
+	ss, err := newSuffixSnapshot(sqlKV, sep, rootPath, snapshotPrefix)
+	require.Nil(t, err)
+
+	for i := 0; i < 20; i++ {
+		err = ss.Save("key", fmt.Sprintf("value-%d", i), typeutil.Timestamp(100+i*5))
+		assert.Nil(t, err)
+	}
+	err = ss.MultiSave(map[string]string{"kd/1": "v1", "kd/2": "v2"}, 100)
+	assert.Nil(t, err)
+	err = ss.MultiSaveAndRemoveWithPrefix(map[string]string{"ke": "value"}, []string{"kd"}, 110)
+	assert.Nil(t, err)
+
+	for i := 0; i < 20; i++ {
+		val, err := ss.Load("key", typeutil.Timestamp(100+i*5+2))
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), val)
+	}
+	keys, vals, err := ss.LoadWithPrefix("kd", 105)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"kd/1", "kd/2"}, keys)
+	assert.Equal(t, []string{"v1", "v2"}, vals)
+	keys, _, err = ss.LoadWithPrefix("kd", 0)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	// reopen the database, the snapshot is rebuilt from the stored versions
+	sqlKV2, err := sqlkv.NewSQLKV("sqlite3", dsn, rootPath)
+	require.Nil(t, err)
+	defer sqlKV2.Close()
+	ss, err = newSuffixSnapshot(sqlKV2, sep, rootPath, snapshotPrefix)
+	require.Nil(t, err)
+	val, err := ss.Load("key", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "value-19", val)
+	val, err = ss.Load("ke", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "value", val)
+
+	pruned, err := ss.Prune(150)
+	assert.Nil(t, err)
+	assert.NotZero(t, pruned)
+	for i := 10; i < 20; i++ {
+		val, err := ss.Load("key", typeutil.Timestamp(100+i*5+2))
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("value-%d", i), val)
+	}
+	_, err = ss.Load("key", 147)
+	assert.NotNil(t, err)
+
+	// min ts is persisted with the pruned versions
+	sqlKV3, err := sqlkv.NewSQLKV("sqlite3", dsn, rootPath)
+	require.Nil(t, err)
+	defer sqlKV3.Close()
+	ss, err = newSuffixSnapshot(sqlKV3, sep, rootPath, snapshotPrefix)
+	require.Nil(t, err)
+	_, err = ss.Load("key", 147)
+	assert.NotNil(t, err)
+	_, _, err = ss.LoadWithPrefix("k", 147)
+	assert.NotNil(t, err)
+	val, err = ss.Load("key", 152)
+	assert.Nil(t, err)
+	assert.Equal(t, "value-10", val)
+}
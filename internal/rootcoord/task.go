@@ -122,15 +122,39 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("alloc collection id error = %w", err)
 	}
-	partID, _, err := t.core.IDAllocator(1)
+	// the collection with a partition key has the partitions the rows are hashed into instead of the default one
+	hasPartitionKey := typeutil.GetPartitionKeyFieldSchema(&schema) != nil
+	partNum := int64(1)
+	if hasPartitionKey {
+		partNum = t.Req.NumPartitions
+		if partNum <= 0 {
+			partNum = common.DefaultPartitionsWithPartitionKey
+		}
+		if partNum > Params.MaxPartitionNum {
+			return fmt.Errorf("the number of partitions %d exceeds the max partition number %d", partNum, Params.MaxPartitionNum)
+		}
+	}
+	partID, _, err := t.core.IDAllocator(uint32(partNum))
 	if err != nil {
 		return fmt.Errorf("alloc partition id error = %w", err)
 	}
+	partIDs := make([]typeutil.UniqueID, 0, partNum)
+	partNames := make([]string, 0, partNum)
+	if !hasPartitionKey {
+		partIDs = append(partIDs, partID)
+		partNames = append(partNames, Params.DefaultPartitionName)
+	} else {
+		for i := int64(0); i < partNum; i++ {
+			partIDs = append(partIDs, partID+i)
+			partNames = append(partNames, typeutil.PartitionKeyPartitionName(Params.DefaultPartitionName, i))
+		}
+	}
 
 	log.Debug("collection name -> id",
 		zap.String("collection name", t.Req.CollectionName),
 		zap.Int64("collection_id", collID),
-		zap.Int64("default partition id", partID))
+		zap.Int64("default partition id", partID),
+		zap.Int64("partition num", partNum))
 
 	vchanNames := make([]string, t.Req.ShardsNum)
 	chanNames := make([]string, t.Req.ShardsNum)
@@ -149,13 +173,13 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	collInfo := etcdpb.CollectionInfo{
 		ID:                         collID,
 		Schema:                     &schema,
-		PartitionIDs:               []typeutil.UniqueID{partID},
-		PartitionNames:             []string{Params.DefaultPartitionName},
+		PartitionIDs:               partIDs,
+		PartitionNames:             partNames,
 		FieldIndexes:               make([]*etcdpb.FieldIndexInfo, 0, 16),
 		VirtualChannelNames:        vchanNames,
 		PhysicalChannelNames:       chanNames,
 		ShardsNum:                  t.Req.ShardsNum,
-		PartitionCreatedTimestamps: []uint64{0},
+		PartitionCreatedTimestamps: make([]uint64, partNum),
 	}
 
 	idxInfo := make([]*etcdpb.IndexInfo, 0, 16)
@@ -171,7 +195,7 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		Base:                 t.Req.Base,
 		DbName:               t.Req.DbName,
 		CollectionName:       t.Req.CollectionName,
-		PartitionName:        Params.DefaultPartitionName,
+		PartitionName:        partNames[0],
 		DbID:                 0, //TODO,not used
 		CollectionID:         collID,
 		PartitionID:          partID,
@@ -487,6 +511,9 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if typeutil.GetPartitionKeyFieldSchema(collMeta.Schema) != nil {
+		return fmt.Errorf("can't create partition of collection %s which has a partition key", t.Req.CollectionName)
+	}
 	partID, _, err := t.core.IDAllocator(1)
 	if err != nil {
 		return err
@@ -572,6 +599,9 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if typeutil.GetPartitionKeyFieldSchema(collInfo.Schema) != nil {
+		return fmt.Errorf("can't drop partition of collection %s which has a partition key", t.Req.CollectionName)
+	}
 	partID, err := t.core.MetaTable.GetPartitionByName(collInfo.ID, t.Req.PartitionName, 0)
 	if err != nil {
 		return err
@@ -745,8 +775,11 @@ func (t *DescribeSegmentReqTask) Execute(ctx context.Context) error {
 	if !exist {
 		return fmt.Errorf("segment id %d not belong to collection id %d", t.Req.SegmentID, t.Req.CollectionID)
 	}
-	//TODO, get filed_id and index_name from request
-	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, -1, "")
+	fieldID := t.Req.FieldID
+	if fieldID == 0 {
+		fieldID = -1
+	}
+	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, fieldID, t.Req.IndexName)
 	log.Debug("RootCoord DescribeSegmentReqTask, MetaTable.GetSegmentIndexInfoByID", zap.Any("SegmentID", t.Req.SegmentID),
 		zap.Any("segIdxInfo", segIdxInfo), zap.Error(err))
 	if err != nil {
@@ -816,7 +849,10 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreateIndex {
 		return fmt.Errorf("create index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	indexName := Params.DefaultIndexName //TODO, get name from request
+	indexName := t.Req.IndexName
+	if indexName == "" {
+		indexName = Params.DefaultIndexName
+	}
 	indexID, _, err := t.core.IDAllocator(1)
 	log.Debug("RootCoord CreateIndexReqTask", zap.Any("indexID", indexID), zap.Error(err))
 	if err != nil {
@@ -831,6 +867,11 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if indexName != Params.DefaultIndexName {
+		if err := t.core.MetaTable.CheckIndexName(t.Req.CollectionName, t.Req.FieldName, idxInfo); err != nil {
+			return err
+		}
+	}
 	segID2PartID, err := t.core.getSegments(ctx, collMeta.ID)
 	flushedSegs := make([]typeutil.UniqueID, 0, len(segID2PartID))
 	for k := range segID2PartID {
@@ -846,8 +887,8 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
 	}
-	if field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
-		return fmt.Errorf("field name = %s, data type = %s", t.Req.FieldName, schemapb.DataType_name[int32(field.DataType)])
+	if err := checkIndexFieldType(&field, idxInfo.IndexParams); err != nil {
+		return err
 	}
 
 	for _, segID := range segIDs {
@@ -1025,3 +1066,295 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 
 	return nil
 }
+
+// RenameCollectionReqTask rename collection request task
+type RenameCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.RenameCollectionRequest
+}
+
+// Type return msg type
+func (t *RenameCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *RenameCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RenameCollection {
+		return fmt.Errorf("rename collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.OldName, 0)
+	if err != nil {
+		return err
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.RenameCollection(t.Req.OldName, t.Req.NewName, ts)
+	if err != nil {
+		return fmt.Errorf("meta table rename collection failed, error = %w", err)
+	}
+
+	// proxies cache the collection by the old name and the aliases of it
+	aliases := t.core.MetaTable.ListAliases(collMeta.ID)
+	t.core.ExpireMetaCache(ctx, append(aliases, t.Req.OldName), ts)
+
+	return nil
+}
+
+// RecoverCollectionReqTask recover collection request task
+type RecoverCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.RecoverCollectionRequest
+}
+
+// Type return msg type
+func (t *RecoverCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *RecoverCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RecoverCollection {
+		return fmt.Errorf("recover collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	dropped, err := t.core.MetaTable.GetDroppedCollection(t.Req.CollectionName)
+	if err != nil {
+		return err
+	}
+	if err = checkDropRetention(dropped.DropTime, Params.DropRetention); err != nil {
+		return fmt.Errorf("collection %s can't be recovered, error = %w", t.Req.CollectionName, err)
+	}
+
+	// the record of the dropped collection is kept until its segments are restored,
+	// so a recovery which restored the meta but failed to restore the segments can be retried
+	var ts typeutil.Timestamp
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	if err == nil {
+		if collInfo.ID != dropped.CollectionID {
+			return fmt.Errorf("collection %s exist", t.Req.CollectionName)
+		}
+		if ts, err = t.core.TSOAllocator(1); err != nil {
+			return fmt.Errorf("TSO alloc fail, error = %w", err)
+		}
+	} else if collInfo, ts, err = t.recoverCollectionMeta(ctx, dropped); err != nil {
+		return err
+	}
+
+	// datacoord loads the new start positions of the recovered collection to restore the segments
+	err = t.core.CallRecoverDroppedSegments(ctx, collInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	err = t.core.CallWatchChannels(ctx, collInfo.ID, collInfo.VirtualChannelNames)
+	if err != nil {
+		return err
+	}
+
+	err = t.core.MetaTable.RemoveDroppedCollection(collInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
+
+	// Update DDOperation in etcd
+	return t.core.setDdMsgSendFlag(true)
+}
+
+// recoverCollectionMeta restores the meta of the dropped collection and sends the create collection message
+func (t *RecoverCollectionReqTask) recoverCollectionMeta(ctx context.Context, dropped *etcdpb.DroppedCollectionInfo) (*etcdpb.CollectionInfo, typeutil.Timestamp, error) {
+	// the collection meta right before it was dropped
+	collInfo, err := t.core.MetaTable.GetCollectionByID(dropped.CollectionID, dropped.DropTime-1)
+	if err != nil {
+		return nil, 0, err
+	}
+	// the indexes are dropped along with the collection and their files are recycled by indexcoord,
+	// so the collection is restored without indexes and they shall be built again from the binlogs,
+	// the aliases are removed along with the collection as well and shall be created again
+	collInfo.FieldIndexes = nil
+	// the start positions are replaced by the positions of the create collection message sent below
+	collInfo.StartPositions = nil
+
+	deltaChanNames := make([]string, len(collInfo.PhysicalChannelNames))
+	for i, chanName := range collInfo.PhysicalChannelNames {
+		if deltaChanNames[i], err = ConvertChannelName(chanName, Params.DmlChannelName, Params.DeltaChannelName); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	schemaBytes, err := proto.Marshal(collInfo.Schema)
+	if err != nil {
+		return nil, 0, fmt.Errorf("marshal schema error = %w", err)
+	}
+
+	ddCollReq := internalpb.CreateCollectionRequest{
+		Base:                 t.Req.Base,
+		DbName:               t.Req.DbName,
+		CollectionName:       t.Req.CollectionName,
+		PartitionName:        collInfo.PartitionNames[0],
+		DbID:                 0, //TODO,not used
+		CollectionID:         collInfo.ID,
+		PartitionID:          collInfo.PartitionIDs[0],
+		Schema:               schemaBytes,
+		VirtualChannelNames:  collInfo.VirtualChannelNames,
+		PhysicalChannelNames: collInfo.PhysicalChannelNames,
+	}
+
+	reason := fmt.Sprintf("recover collection %d", collInfo.ID)
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return nil, 0, fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// build DdOperation and save it into etcd, when ddmsg send fail,
+	// system can restore ddmsg from etcd and re-send
+	ddCollReq.Base.Timestamp = ts
+	ddOpStr, err := EncodeDdOperation(&ddCollReq, CreateCollectionDDType)
+	if err != nil {
+		return nil, 0, fmt.Errorf("encodeDdOperation fail, error = %w", err)
+	}
+
+	// use lambda function here to guarantee all resources to be released
+	recoverCollectionFn := func() error {
+		// lock for ddl operation
+		t.core.ddlLock.Lock()
+		defer t.core.ddlLock.Unlock()
+
+		t.core.chanTimeTick.addDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer t.core.chanTimeTick.removeDdlTimeTick(ts, reason)
+
+		// add dml channel before send dd msg
+		t.core.chanTimeTick.addDmlChannels(collInfo.PhysicalChannelNames...)
+
+		// also add delta channels
+		t.core.chanTimeTick.addDeltaChannels(deltaChanNames...)
+
+		// the new start positions are after the drop collection message,
+		// datanodes watching the channels again won't consume the drop collection message
+		ids, err := t.core.SendDdCreateCollectionReq(ctx, &ddCollReq, collInfo.PhysicalChannelNames)
+		if err != nil {
+			return fmt.Errorf("send dd create collection req failed, error = %w", err)
+		}
+		for _, pchan := range collInfo.PhysicalChannelNames {
+			collInfo.StartPositions = append(collInfo.StartPositions, &commonpb.KeyDataPair{
+				Key:  pchan,
+				Data: ids[pchan],
+			})
+		}
+		err = t.core.MetaTable.RecoverCollection(collInfo, ts, ddOpStr)
+		if err != nil {
+			t.core.chanTimeTick.removeDmlChannels(collInfo.PhysicalChannelNames...)
+			t.core.chanTimeTick.removeDeltaChannels(deltaChanNames...)
+			return fmt.Errorf("meta table recover collection failed, error = %w", err)
+		}
+
+		t.core.chanTimeTick.removeDdlTimeTick(ts, reason)
+		t.core.SendTimeTick(ts, reason)
+
+		return nil
+	}
+
+	if err = recoverCollectionFn(); err != nil {
+		return nil, 0, err
+	}
+	return collInfo, ts, nil
+}
+
+// RecoverPartitionReqTask recover partition request task
+type RecoverPartitionReqTask struct {
+	baseReqTask
+	Req *milvuspb.RecoverPartitionRequest
+}
+
+// Type return msg type
+func (t *RecoverPartitionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *RecoverPartitionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RecoverPartition {
+		return fmt.Errorf("recover partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	if typeutil.GetPartitionKeyFieldSchema(collMeta.Schema) != nil {
+		return fmt.Errorf("can't recover partition of collection %s which has a partition key", t.Req.CollectionName)
+	}
+	dropped, err := t.core.MetaTable.GetDroppedPartition(collMeta.ID, t.Req.PartitionName)
+	if err != nil {
+		return err
+	}
+	if err = checkDropRetention(dropped.DropTime, Params.DropRetention); err != nil {
+		return fmt.Errorf("partition %s can't be recovered, error = %w", t.Req.PartitionName, err)
+	}
+
+	// the segments of the dropped partition are kept by datacoord, the partition is recovered with its original id
+	ddReq := internalpb.CreatePartitionRequest{
+		Base:           t.Req.Base,
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		PartitionName:  t.Req.PartitionName,
+		DbID:           0, // todo, not used
+		CollectionID:   collMeta.ID,
+		PartitionID:    dropped.PartitionID,
+	}
+
+	reason := fmt.Sprintf("recover partition %s", t.Req.PartitionName)
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// build DdOperation and save it into etcd, when ddmsg send fail,
+	// system can restore ddmsg from etcd and re-send
+	ddReq.Base.Timestamp = ts
+	ddOpStr, err := EncodeDdOperation(&ddReq, CreatePartitionDDType)
+	if err != nil {
+		return fmt.Errorf("encodeDdOperation fail, error = %w", err)
+	}
+
+	// use lambda function here to guarantee all resources to be released
+	recoverPartitionFn := func() error {
+		// lock for ddl operation
+		t.core.ddlLock.Lock()
+		defer t.core.ddlLock.Unlock()
+
+		t.core.chanTimeTick.addDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer t.core.chanTimeTick.removeDdlTimeTick(ts, reason)
+
+		err = t.core.MetaTable.RecoverPartition(collMeta.ID, t.Req.PartitionName, dropped.PartitionID, ts, ddOpStr)
+		if err != nil {
+			return err
+		}
+
+		err = t.core.SendDdCreatePartitionReq(ctx, &ddReq, collMeta.PhysicalChannelNames)
+		if err != nil {
+			return err
+		}
+
+		t.core.chanTimeTick.removeDdlTimeTick(ts, reason)
+		t.core.SendTimeTick(ts, reason)
+		return nil
+	}
+
+	err = recoverPartitionFn()
+	if err != nil {
+		return err
+	}
+
+	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
+
+	// Update DDOperation in etcd
+	return t.core.setDdMsgSendFlag(true)
+}
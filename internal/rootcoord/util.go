@@ -14,12 +14,15 @@ package rootcoord
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -152,3 +155,40 @@ func ConvertChannelName(chanName string, tokenFrom string, tokenTo string) (stri
 	}
 	return "", fmt.Errorf("cannot find token '%s' in '%s'", tokenFrom, chanName)
 }
+
+// checkIndexFieldType checks vector fields get vector indexes and numeric scalar fields get scalar indexes
+func checkIndexFieldType(field *schemapb.FieldSchema, indexParams []*commonpb.KeyValuePair) error {
+	indexType := ""
+	for _, kv := range indexParams {
+		if kv.Key == "index_type" {
+			indexType = kv.Value
+		}
+	}
+	if field.DataType == schemapb.DataType_SparseFloatVector {
+		return fmt.Errorf("field name = %s, index is not supported on sparse float vector field", field.Name)
+	}
+	if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector ||
+		typeutil.IsHalfFloatVectorType(field.DataType) {
+		if indexparamcheck.IsScalarIndexType(indexType) {
+			return fmt.Errorf("field name = %s, data type = %s, index type = %s", field.Name, schemapb.DataType_name[int32(field.DataType)], indexType)
+		}
+		return nil
+	}
+	if !indexparamcheck.IsScalarIndexType(indexType) {
+		return fmt.Errorf("field name = %s, data type = %s, index type = %s", field.Name, schemapb.DataType_name[int32(field.DataType)], indexType)
+	}
+	if err := indexparamcheck.CheckScalarIndexDataType(field.DataType); err != nil {
+		return fmt.Errorf("field name = %s, %s", field.Name, err.Error())
+	}
+	return nil
+}
+
+// checkDropRetention checks whether a dropped collection or partition could still be recovered,
+// since the dropped segments are garbage collected by datacoord once the retention passes
+func checkDropRetention(dropTime typeutil.Timestamp, retention time.Duration) error {
+	physical, _ := tsoutil.ParseTS(dropTime)
+	if time.Since(physical) > retention {
+		return fmt.Errorf("dropped at %v, longer than the retention %v", physical, retention)
+	}
+	return nil
+}
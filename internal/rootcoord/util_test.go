@@ -13,11 +13,13 @@ package rootcoord
 
 import (
 	"testing"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -151,3 +153,36 @@ func Test_ConvertChannelName(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, deltaChanName, str)
 }
+
+func Test_checkIndexFieldType(t *testing.T) {
+	indexType := func(t string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: "index_type", Value: t}}
+	}
+	vecField := &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector}
+	assert.Nil(t, checkIndexFieldType(vecField, indexType("IVF_FLAT")))
+	assert.Nil(t, checkIndexFieldType(vecField, nil))
+	assert.NotNil(t, checkIndexFieldType(vecField, indexType("SORT")))
+
+	halfField := &schemapb.FieldSchema{Name: "half", DataType: schemapb.DataType_Float16Vector}
+	assert.Nil(t, checkIndexFieldType(halfField, indexType("IVF_FLAT")))
+	assert.NotNil(t, checkIndexFieldType(halfField, indexType("SORT")))
+
+	int32Field := &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int32}
+	assert.Nil(t, checkIndexFieldType(int32Field, indexType("SORT")))
+	assert.Nil(t, checkIndexFieldType(int32Field, indexType("INVERTED")))
+	assert.NotNil(t, checkIndexFieldType(int32Field, indexType("IVF_FLAT")))
+	assert.NotNil(t, checkIndexFieldType(int32Field, nil))
+
+	strField := &schemapb.FieldSchema{Name: "name", DataType: schemapb.DataType_String}
+	assert.Nil(t, checkIndexFieldType(strField, indexType("INVERTED")))
+	assert.NotNil(t, checkIndexFieldType(strField, indexType("IVF_FLAT")))
+
+	sparseField := &schemapb.FieldSchema{Name: "sparse", DataType: schemapb.DataType_SparseFloatVector}
+	assert.NotNil(t, checkIndexFieldType(sparseField, indexType("IVF_FLAT")))
+	assert.NotNil(t, checkIndexFieldType(sparseField, nil))
+}
+
+func Test_checkDropRetention(t *testing.T) {
+	assert.Nil(t, checkDropRetention(tsoutil.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0), time.Hour))
+	assert.NotNil(t, checkDropRetention(tsoutil.ComposeTS(time.Now().Add(-2*time.Hour).UnixNano()/int64(time.Millisecond), 0), time.Hour))
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	azurekv "github.com/milvus-io/milvus/internal/kv/azure"
+	gcskv "github.com/milvus-io/milvus/internal/kv/gcs"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// Option is the option to create a ChunkManager with NewChunkManager.
+type Option struct {
+	paramtable.StorageConfig
+
+	// --- MinIO ---
+	Address           string
+	AccessKeyID       string
+	SecretAccessKeyID string
+	UseSSL            bool
+
+	// BucketName is the bucket or container of all remote storages
+	BucketName   string
+	CreateBucket bool
+}
+
+// NewChunkManager creates the ChunkManager of storage @option.Type, an empty type means MinIO.
+func NewChunkManager(ctx context.Context, option *Option) (ChunkManager, error) {
+	switch option.Type {
+	case paramtable.StorageLocal:
+		return NewLocalChunkManager(option.LocalPath), nil
+	case paramtable.StorageAzure:
+		kv, err := azurekv.NewAzureKV(ctx, &azurekv.Option{
+			Endpoint:        option.AzureEndpoint,
+			AccountName:     option.AzureAccountName,
+			AccountKey:      option.AzureAccountKey,
+			ContainerName:   option.BucketName,
+			CreateContainer: option.CreateBucket,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewAzureChunkManager(kv), nil
+	case paramtable.StorageGCS:
+		kv, err := gcskv.NewGCSKV(ctx, &gcskv.Option{
+			Endpoint:        option.GCSEndpoint,
+			CredentialsFile: option.GCSCredentialsFile,
+			BucketName:      option.BucketName,
+			CreateBucket:    option.CreateBucket,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewGCSChunkManager(kv), nil
+	case paramtable.StorageMinIO, "":
+		kv, err := miniokv.NewMinIOKV(ctx, &miniokv.Option{
+			Address:           option.Address,
+			AccessKeyID:       option.AccessKeyID,
+			SecretAccessKeyID: option.SecretAccessKeyID,
+			UseSSL:            option.UseSSL,
+			BucketName:        option.BucketName,
+			CreateBucket:      option.CreateBucket,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewMinioChunkManager(kv), nil
+	}
+	return nil, fmt.Errorf("unsupported storage type %s", option.Type)
+}
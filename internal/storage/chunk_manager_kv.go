@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/milvus-io/milvus/internal/kv"
+)
+
+// chunkManagerKV adapts a ChunkManager to kv.DataKV, for the code paths
+// which keep binlogs and index files through a kv.
+type chunkManagerKV struct {
+	ChunkManager
+}
+
+// NewChunkManagerKV returns a kv.DataKV which reads and writes through @cm.
+func NewChunkManagerKV(cm ChunkManager) kv.DataKV {
+	return &chunkManagerKV{ChunkManager: cm}
+}
+
+func (ckv *chunkManagerKV) Load(key string) (string, error) {
+	content, err := ckv.Read(key)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func (ckv *chunkManagerKV) MultiLoad(keys []string) ([]string, error) {
+	contents, err := ckv.MultiRead(keys)
+	values := make([]string, len(contents))
+	for i, content := range contents {
+		values[i] = string(content)
+	}
+	return values, err
+}
+
+func (ckv *chunkManagerKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	keys, _, err := ckv.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err := ckv.MultiLoad(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+func (ckv *chunkManagerKV) Save(key, value string) error {
+	return ckv.Write(key, []byte(value))
+}
+
+func (ckv *chunkManagerKV) MultiSave(kvs map[string]string) error {
+	contents := make(map[string][]byte, len(kvs))
+	for key, value := range kvs {
+		contents[key] = []byte(value)
+	}
+	return ckv.MultiWrite(contents)
+}
+
+// LoadPartial loads partial data ranged in [start, end) with @key,
+// the range is truncated at the end of the data.
+func (ckv *chunkManagerKV) LoadPartial(key string, start, end int64) ([]byte, error) {
+	if start < 0 || end < 0 || start >= end {
+		return nil, fmt.Errorf("invalid range specified: start=%d end=%d",
+			start, end)
+	}
+	p := make([]byte, end-start)
+	n, err := ckv.ReadAt(key, p, start)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return p[:n], nil
+}
+
+func (ckv *chunkManagerKV) GetSize(key string) (int64, error) {
+	return ckv.Size(key)
+}
+
+func (ckv *chunkManagerKV) Close() {
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestNewChunkManager(t *testing.T) {
+	cm, err := NewChunkManager(context.TODO(), &Option{
+		StorageConfig: paramtable.StorageConfig{
+			Type:      paramtable.StorageLocal,
+			LocalPath: localPath,
+		},
+	})
+	assert.Nil(t, err)
+	_, ok := cm.(*LocalChunkManager)
+	assert.True(t, ok)
+
+	_, err = NewChunkManager(context.TODO(), &Option{
+		StorageConfig: paramtable.StorageConfig{Type: "s4"},
+	})
+	assert.Error(t, err)
+}
+
+func TestChunkManagerKV(t *testing.T) {
+	cmKV := NewChunkManagerKV(NewLocalChunkManager(path.Join(localPath, "kv")))
+	defer cmKV.Close()
+	defer cmKV.RemoveWithPrefix("")
+
+	err := cmKV.Save("a/1", "123")
+	assert.Nil(t, err)
+	err = cmKV.MultiSave(map[string]string{
+		"a/2": "4567",
+		"b":   "89",
+	})
+	assert.Nil(t, err)
+
+	value, err := cmKV.Load("a/1")
+	assert.Nil(t, err)
+	assert.Equal(t, "123", value)
+	_, err = cmKV.Load("not_exist")
+	assert.Error(t, err)
+
+	values, err := cmKV.MultiLoad([]string{"a/2", "b"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"4567", "89"}, values)
+
+	keys, values, err := cmKV.LoadWithPrefix("a/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a/1", "a/2"}, keys)
+	assert.Equal(t, []string{"123", "4567"}, values)
+
+	size, err := cmKV.GetSize("a/2")
+	assert.Nil(t, err)
+	assert.EqualValues(t, 4, size)
+
+	data, err := cmKV.LoadPartial("a/2", 1, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("56"), data)
+	data, err = cmKV.LoadPartial("a/2", 2, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("67"), data)
+	_, err = cmKV.LoadPartial("a/2", 3, 1)
+	assert.Error(t, err)
+
+	err = cmKV.MultiRemove([]string{"a/1", "b"})
+	assert.Nil(t, err)
+	err = cmKV.RemoveWithPrefix("a/")
+	assert.Nil(t, err)
+	keys, _, err = cmKV.LoadWithPrefix("")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+}
@@ -21,7 +21,10 @@ enum ColumnType : int {
   DOUBLE = 11,
   STRING = 20,
   VECTOR_BINARY = 100,
-  VECTOR_FLOAT = 101
+  VECTOR_FLOAT = 101,
+  VECTOR_FLOAT16 = 102,
+  VECTOR_BFLOAT16 = 103,
+  VECTOR_SPARSE_FLOAT = 104
 };
 
 enum ErrorCode : int {
@@ -87,6 +87,22 @@ CPayloadWriter NewPayloadWriter(int columnType) {
       p->dimension = wrapper::EMPTY_DIMENSION;
       break;
     }
+    case ColumnType::VECTOR_FLOAT16 : {
+      p->columnType = ColumnType::VECTOR_FLOAT16;
+      p->dimension = wrapper::EMPTY_DIMENSION;
+      break;
+    }
+    case ColumnType::VECTOR_BFLOAT16 : {
+      p->columnType = ColumnType::VECTOR_BFLOAT16;
+      p->dimension = wrapper::EMPTY_DIMENSION;
+      break;
+    }
+    case ColumnType::VECTOR_SPARSE_FLOAT : {
+      p->columnType = ColumnType::VECTOR_SPARSE_FLOAT;
+      p->builder = std::make_shared<arrow::BinaryBuilder>();
+      p->schema = arrow::schema({arrow::field("val", arrow::binary())});
+      break;
+    }
     default: {
       delete p;
       return nullptr;
@@ -286,6 +302,92 @@ CStatus AddFloatVectorToPayload(CPayloadWriter payloadWriter, float *values, int
   return st;
 }
 
+// float16 and bfloat16 vectors are stored as fixed size binary of 2 bytes per element
+CStatus AddHalfFloatVectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  if (length <= 0) return st;
+
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  if (p->dimension == wrapper::EMPTY_DIMENSION) {
+    if (p->builder != nullptr) {
+      st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+      st.error_msg = ErrorMsg("incorrect data type");
+      return st;
+    }
+    p->builder = std::make_shared<arrow::FixedSizeBinaryBuilder>(arrow::fixed_size_binary(dimension * 2));
+    p->schema = arrow::schema({arrow::field("val", arrow::fixed_size_binary(dimension * 2))});
+    p->dimension = dimension;
+  } else if (p->dimension != dimension) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("dimension changed");
+    return st;
+  }
+  auto builder = std::dynamic_pointer_cast<arrow::FixedSizeBinaryBuilder>(p->builder);
+  if (builder == nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect data type");
+    return st;
+  }
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  auto ast = builder->AppendValues(values, length);
+  if (!ast.ok()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg(ast.message());
+    return st;
+  }
+  p->rows += length;
+  return st;
+}
+
+extern "C"
+CStatus AddFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length) {
+  return AddHalfFloatVectorToPayload(payloadWriter, values, dimension, length);
+}
+
+extern "C"
+CStatus AddBFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length) {
+  return AddHalfFloatVectorToPayload(payloadWriter, values, dimension, length);
+}
+
+extern "C"
+CStatus AddOneSparseFloatVectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int size) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  auto builder = std::dynamic_pointer_cast<arrow::BinaryBuilder>(p->builder);
+  if (builder == nullptr || p->columnType != ColumnType::VECTOR_SPARSE_FLOAT) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect data type");
+    return st;
+  }
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  if (size < 0) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("incorrect sparse float vector size");
+    return st;
+  }
+  auto ast = builder->Append(values, size);
+  if (!ast.ok()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg(ast.message());
+    return st;
+  }
+  p->rows++;
+  return st;
+}
+
 extern "C"
 CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
   CStatus st;
@@ -377,7 +479,10 @@ CPayloadReader NewPayloadReader(int columnType, uint8_t *buffer, int64_t buf_siz
     case ColumnType::DOUBLE :
     case ColumnType::STRING :
     case ColumnType::VECTOR_BINARY :
-    case ColumnType::VECTOR_FLOAT : {
+    case ColumnType::VECTOR_FLOAT :
+    case ColumnType::VECTOR_FLOAT16 :
+    case ColumnType::VECTOR_BFLOAT16 :
+    case ColumnType::VECTOR_SPARSE_FLOAT : {
       break;
     }
     default: {
@@ -518,6 +623,56 @@ CStatus GetFloatVectorFromPayload(CPayloadReader payloadReader, float **values,
   return st;
 }
 
+CStatus GetHalfFloatVectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
+  auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(p->array);
+  if (array == nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("Incorrect data type");
+    return st;
+  }
+  *dimension = array->byte_width() / 2;
+  *length = array->length();
+  *values = (uint8_t *) array->raw_values();
+  return st;
+}
+
+extern "C"
+CStatus GetFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length) {
+  return GetHalfFloatVectorFromPayload(payloadReader, values, dimension, length);
+}
+
+extern "C"
+CStatus GetBFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length) {
+  return GetHalfFloatVectorFromPayload(payloadReader, values, dimension, length);
+}
+
+extern "C"
+CStatus GetOneSparseFloatVectorFromPayload(CPayloadReader payloadReader, int idx, uint8_t **values, int *size) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
+  auto array = std::dynamic_pointer_cast<arrow::BinaryArray>(p->array);
+  if (array == nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("Incorrect data type");
+    return st;
+  }
+  if (idx >= array->length()) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("memory overflow");
+    return st;
+  }
+  arrow::BinaryArray::offset_type length;
+  *values = (uint8_t *) array->GetValue(idx, &length);
+  *size = length;
+  return st;
+}
+
 extern "C"
 int GetPayloadLengthFromReader(CPayloadReader payloadReader) {
   auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
@@ -41,6 +41,9 @@ CStatus AddDoubleToPayload(CPayloadWriter payloadWriter, double *values, int len
 CStatus AddOneStringToPayload(CPayloadWriter payloadWriter, char *cstr, int str_size);
 CStatus AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length);
 CStatus AddFloatVectorToPayload(CPayloadWriter payloadWriter, float *values, int dimension, int length);
+CStatus AddFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length);
+CStatus AddBFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length);
+CStatus AddOneSparseFloatVectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int size);
 
 CStatus FinishPayloadWriter(CPayloadWriter payloadWriter);
 CBuffer GetPayloadBufferFromWriter(CPayloadWriter payloadWriter);
@@ -60,6 +63,9 @@ CStatus GetDoubleFromPayload(CPayloadReader payloadReader, double **values, int
 CStatus GetOneStringFromPayload(CPayloadReader payloadReader, int idx, char **cstr, int *str_size);
 CStatus GetBinaryVectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length);
 CStatus GetFloatVectorFromPayload(CPayloadReader payloadReader, float **values, int *dimension, int *length);
+CStatus GetFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length);
+CStatus GetBFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length);
+CStatus GetOneSparseFloatVectorFromPayload(CPayloadReader payloadReader, int idx, uint8_t **values, int *size);
 
 int GetPayloadLengthFromReader(CPayloadReader payloadReader);
 CStatus ReleasePayloadReader(CPayloadReader payloadReader);
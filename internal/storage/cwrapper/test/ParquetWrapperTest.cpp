@@ -281,6 +281,94 @@ TEST(wrapper, float_vector) {
   ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
 }
 
+TEST(wrapper, sparse_float_vector) {
+  auto payload = NewPayloadWriter(ColumnType::VECTOR_SPARSE_FLOAT);
+  // (uint32 index, float32 value) pairs
+  uint8_t row0[16] = {1, 0, 0, 0, 0, 0, 0x80, 0x3F, 5, 0, 0, 0, 0, 0, 0, 0x40};
+  uint8_t row1[8] = {3, 0, 0, 0, 0, 0, 0x40, 0x40};
+
+  auto st = AddOneSparseFloatVectorToPayload(payload, row0, 16);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddOneSparseFloatVectorToPayload(payload, row1, 8);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddOneSparseFloatVectorToPayload(payload, nullptr, 0);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddOneStringToPayload(payload, (char *) "1234", 4);
+  ASSERT_NE(st.error_code, ErrorCode::SUCCESS);
+
+  st = FinishPayloadWriter(payload);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  auto cb = GetPayloadBufferFromWriter(payload);
+  ASSERT_GT(cb.length, 0);
+  ASSERT_NE(cb.data, nullptr);
+  auto nums = GetPayloadLengthFromWriter(payload);
+  ASSERT_EQ(nums, 3);
+
+  auto reader = NewPayloadReader(ColumnType::VECTOR_SPARSE_FLOAT, (uint8_t *) cb.data, cb.length);
+  int length = GetPayloadLengthFromReader(reader);
+  ASSERT_EQ(length, 3);
+  uint8_t *v0, *v1, *v2;
+  int s0, s1, s2;
+  st = GetOneSparseFloatVectorFromPayload(reader, 0, &v0, &s0);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  ASSERT_EQ(s0, 16);
+  for (int i = 0; i < 16; i++) {
+    ASSERT_EQ(v0[i], row0[i]);
+  }
+  st = GetOneSparseFloatVectorFromPayload(reader, 1, &v1, &s1);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  ASSERT_EQ(s1, 8);
+  for (int i = 0; i < 8; i++) {
+    ASSERT_EQ(v1[i], row1[i]);
+  }
+  st = GetOneSparseFloatVectorFromPayload(reader, 2, &v2, &s2);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  ASSERT_EQ(s2, 0);
+  st = GetOneSparseFloatVectorFromPayload(reader, 3, &v2, &s2);
+  ASSERT_NE(st.error_code, ErrorCode::SUCCESS);
+
+  st = ReleasePayloadWriter(payload);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = ReleasePayloadReader(reader);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+}
+
+TEST(wrapper, float16_vector) {
+  for (auto column_type : {ColumnType::VECTOR_FLOAT16, ColumnType::VECTOR_BFLOAT16}) {
+    auto payload = NewPayloadWriter(column_type);
+    // 2 rows of dim 2, 2 bytes per element
+    uint8_t data[] = {0, 0x3C, 0, 0x40, 0, 0xBC, 0, 0xC0};
+    auto add = column_type == ColumnType::VECTOR_FLOAT16 ? AddFloat16VectorToPayload : AddBFloat16VectorToPayload;
+    auto get = column_type == ColumnType::VECTOR_FLOAT16 ? GetFloat16VectorFromPayload : GetBFloat16VectorFromPayload;
+
+    auto st = add(payload, data, 2, 2);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    st = add(payload, data, 4, 1);
+    ASSERT_NE(st.error_code, ErrorCode::SUCCESS);
+    st = FinishPayloadWriter(payload);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    auto cb = GetPayloadBufferFromWriter(payload);
+    ASSERT_GT(cb.length, 0);
+    ASSERT_NE(cb.data, nullptr);
+    ASSERT_EQ(GetPayloadLengthFromWriter(payload), 2);
+
+    auto reader = NewPayloadReader(column_type, (uint8_t *) cb.data, cb.length);
+    uint8_t *values;
+    int dim, length;
+    st = get(reader, &values, &dim, &length);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    ASSERT_EQ(dim, 2);
+    ASSERT_EQ(length, 2);
+    for (int i = 0; i < 8; i++) {
+      ASSERT_EQ(values[i], data[i]);
+    }
+    st = ReleasePayloadWriter(payload);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+    st = ReleasePayloadReader(reader);
+    ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  }
+}
+
 TEST(wrapper, int8_2) {
   auto payload = NewPayloadWriter(ColumnType::INT8);
   int8_t data[] = {-1, 1, -100, 100};
@@ -149,6 +149,36 @@ type FloatVectorFieldData struct {
 	Dim     int
 }
 
+// Float16VectorFieldData stores float16 vectors, every element takes 2 bytes in little endian
+type Float16VectorFieldData struct {
+	NumRows []int64
+	Data    []byte
+	Dim     int
+}
+
+// BFloat16VectorFieldData stores bfloat16 vectors, every element takes 2 bytes in little endian
+type BFloat16VectorFieldData struct {
+	NumRows []int64
+	Data    []byte
+	Dim     int
+}
+
+// SparseFloatVectorFieldData stores sparse float vector rows, see typeutil.CreateSparseFloatRow for the row format.
+// Dim is the max dimension of all rows.
+type SparseFloatVectorFieldData struct {
+	NumRows  []int64
+	Contents [][]byte
+	Dim      int
+}
+
+// AppendRow appends a sparse float vector row and updates the dimension
+func (data *SparseFloatVectorFieldData) AppendRow(row []byte) {
+	data.Contents = append(data.Contents, row)
+	if rowDim := int(typeutil.SparseFloatRowDim(row)); rowDim > data.Dim {
+		data.Dim = rowDim
+	}
+}
+
 // RowNum implements FieldData.RowNum
 func (data *BoolFieldData) RowNum() int         { return len(data.Data) }
 func (data *Int8FieldData) RowNum() int         { return len(data.Data) }
@@ -160,6 +190,15 @@ func (data *DoubleFieldData) RowNum() int       { return len(data.Data) }
 func (data *StringFieldData) RowNum() int       { return len(data.Data) }
 func (data *BinaryVectorFieldData) RowNum() int { return len(data.Data) * 8 / data.Dim }
 func (data *FloatVectorFieldData) RowNum() int  { return len(data.Data) / data.Dim }
+func (data *Float16VectorFieldData) RowNum() int {
+	return len(data.Data) / (data.Dim * 2)
+}
+func (data *BFloat16VectorFieldData) RowNum() int {
+	return len(data.Data) / (data.Dim * 2)
+}
+func (data *SparseFloatVectorFieldData) RowNum() int {
+	return len(data.Contents)
+}
 
 // GetRow implements FieldData.GetRow
 func (data *BoolFieldData) GetRow(i int) interface{}   { return data.Data[i] }
@@ -176,6 +215,15 @@ func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim : (i+1)*data.Dim]
 }
+func (data *Float16VectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i*data.Dim*2 : (i+1)*data.Dim*2]
+}
+func (data *BFloat16VectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i*data.Dim*2 : (i+1)*data.Dim*2]
+}
+func (data *SparseFloatVectorFieldData) GetRow(i int) interface{} {
+	return data.Contents[i]
+}
 
 // why not binary.Size(data) directly? binary.Size(data) return -1
 // binary.Size returns how many bytes Write would generate to encode the value v, which
@@ -223,6 +271,22 @@ func (data *FloatVectorFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
 }
 
+func (data *Float16VectorFieldData) GetMemorySize() int {
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
+}
+
+func (data *BFloat16VectorFieldData) GetMemorySize() int {
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
+}
+
+func (data *SparseFloatVectorFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.Dim)
+	for _, row := range data.Contents {
+		size += len(row)
+	}
+	return size
+}
+
 // system filed id:
 // 0: unique row id
 // 1: timestamp
@@ -327,6 +391,20 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		case schemapb.DataType_FloatVector:
 			err = eventWriter.AddFloatVectorToPayload(singleData.(*FloatVectorFieldData).Data, singleData.(*FloatVectorFieldData).Dim)
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
+		case schemapb.DataType_Float16Vector:
+			err = eventWriter.AddFloat16VectorToPayload(singleData.(*Float16VectorFieldData).Data, singleData.(*Float16VectorFieldData).Dim)
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Float16VectorFieldData).GetMemorySize()))
+		case schemapb.DataType_BFloat16Vector:
+			err = eventWriter.AddBFloat16VectorToPayload(singleData.(*BFloat16VectorFieldData).Data, singleData.(*BFloat16VectorFieldData).Dim)
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BFloat16VectorFieldData).GetMemorySize()))
+		case schemapb.DataType_SparseFloatVector:
+			for _, row := range singleData.(*SparseFloatVectorFieldData).Contents {
+				err = eventWriter.AddOneSparseFloatVectorToPayload(row)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*SparseFloatVectorFieldData).GetMemorySize()))
 		default:
 			return nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
 		}
@@ -583,6 +661,57 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				totalLength += length
 				floatVectorFieldData.NumRows = append(floatVectorFieldData.NumRows, int64(length))
 				resultData.Data[fieldID] = floatVectorFieldData
+			case schemapb.DataType_Float16Vector:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &Float16VectorFieldData{}
+				}
+				float16VectorFieldData := resultData.Data[fieldID].(*Float16VectorFieldData)
+				var singleData []byte
+				singleData, float16VectorFieldData.Dim, err = eventReader.GetFloat16VectorFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				float16VectorFieldData.Data = append(float16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				float16VectorFieldData.NumRows = append(float16VectorFieldData.NumRows, int64(length))
+				resultData.Data[fieldID] = float16VectorFieldData
+			case schemapb.DataType_BFloat16Vector:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &BFloat16VectorFieldData{}
+				}
+				bfloat16VectorFieldData := resultData.Data[fieldID].(*BFloat16VectorFieldData)
+				var singleData []byte
+				singleData, bfloat16VectorFieldData.Dim, err = eventReader.GetBFloat16VectorFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				bfloat16VectorFieldData.Data = append(bfloat16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				bfloat16VectorFieldData.NumRows = append(bfloat16VectorFieldData.NumRows, int64(length))
+				resultData.Data[fieldID] = bfloat16VectorFieldData
+			case schemapb.DataType_SparseFloatVector:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &SparseFloatVectorFieldData{}
+				}
+				sparseFloatVectorFieldData := resultData.Data[fieldID].(*SparseFloatVectorFieldData)
+				rows, _, err := eventReader.GetSparseFloatVectorFromPayload()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				for _, row := range rows {
+					sparseFloatVectorFieldData.AppendRow(row)
+				}
+				totalLength += len(rows)
+				sparseFloatVectorFieldData.NumRows = append(sparseFloatVectorFieldData.NumRows, int64(len(rows)))
+				resultData.Data[fieldID] = sparseFloatVectorFieldData
 			default:
 				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("undefined data type %d", dataType)
 			}
@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -26,21 +27,24 @@ import (
 )
 
 const (
-	CollectionID      = 1
-	PartitionID       = 1
-	SegmentID         = 1
-	RowIDField        = 0
-	TimestampField    = 1
-	BoolField         = 100
-	Int8Field         = 101
-	Int16Field        = 102
-	Int32Field        = 103
-	Int64Field        = 104
-	FloatField        = 105
-	DoubleField       = 106
-	StringField       = 107
-	BinaryVectorField = 108
-	FloatVectorField  = 109
+	CollectionID           = 1
+	PartitionID            = 1
+	SegmentID              = 1
+	RowIDField             = 0
+	TimestampField         = 1
+	BoolField              = 100
+	Int8Field              = 101
+	Int16Field             = 102
+	Int32Field             = 103
+	Int64Field             = 104
+	FloatField             = 105
+	DoubleField            = 106
+	StringField            = 107
+	BinaryVectorField      = 108
+	FloatVectorField       = 109
+	SparseFloatVectorField = 110
+	Float16VectorField     = 111
+	BFloat16VectorField    = 112
 )
 
 func TestInsertCodec(t *testing.T) {
@@ -138,6 +142,27 @@ func TestInsertCodec(t *testing.T) {
 					Description:  "float_vector",
 					DataType:     schemapb.DataType_FloatVector,
 				},
+				{
+					FieldID:      SparseFloatVectorField,
+					Name:         "field_sparse_float_vector",
+					IsPrimaryKey: false,
+					Description:  "sparse_float_vector",
+					DataType:     schemapb.DataType_SparseFloatVector,
+				},
+				{
+					FieldID:      Float16VectorField,
+					Name:         "field_float16_vector",
+					IsPrimaryKey: false,
+					Description:  "float16_vector",
+					DataType:     schemapb.DataType_Float16Vector,
+				},
+				{
+					FieldID:      BFloat16VectorField,
+					Name:         "field_bfloat16_vector",
+					IsPrimaryKey: false,
+					Description:  "bfloat16_vector",
+					DataType:     schemapb.DataType_BFloat16Vector,
+				},
 			},
 		},
 	}
@@ -194,6 +219,24 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{4, 5, 6, 7, 4, 5, 6, 7},
 				Dim:     4,
 			},
+			SparseFloatVectorField: &SparseFloatVectorFieldData{
+				NumRows: []int64{2},
+				Contents: [][]byte{
+					typeutil.CreateSparseFloatRow([]uint32{30}, []float32{3}),
+					typeutil.CreateSparseFloatRow(nil, nil),
+				},
+				Dim: 31,
+			},
+			Float16VectorField: &Float16VectorFieldData{
+				NumRows: []int64{2},
+				Data:    []byte{4, 5, 6, 7, 4, 5, 6, 7},
+				Dim:     2,
+			},
+			BFloat16VectorField: &BFloat16VectorFieldData{
+				NumRows: []int64{2},
+				Data:    []byte{4, 5, 6, 7, 4, 5, 6, 7},
+				Dim:     2,
+			},
 		},
 	}
 
@@ -249,23 +292,44 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{0, 1, 2, 3, 0, 1, 2, 3},
 				Dim:     4,
 			},
+			SparseFloatVectorField: &SparseFloatVectorFieldData{
+				NumRows: []int64{2},
+				Contents: [][]byte{
+					typeutil.CreateSparseFloatRow([]uint32{1, 5}, []float32{1, 5}),
+					typeutil.CreateSparseFloatRow([]uint32{2}, []float32{2}),
+				},
+				Dim: 6,
+			},
+			Float16VectorField: &Float16VectorFieldData{
+				NumRows: []int64{2},
+				Data:    []byte{0, 1, 2, 3, 0, 1, 2, 3},
+				Dim:     2,
+			},
+			BFloat16VectorField: &BFloat16VectorFieldData{
+				NumRows: []int64{2},
+				Data:    []byte{0, 1, 2, 3, 0, 1, 2, 3},
+				Dim:     2,
+			},
 		},
 	}
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}, []int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}, []int64{}},
-			BoolField:         &BoolFieldData{[]int64{}, []bool{}},
-			Int8Field:         &Int8FieldData{[]int64{}, []int8{}},
-			Int16Field:        &Int16FieldData{[]int64{}, []int16{}},
-			Int32Field:        &Int32FieldData{[]int64{}, []int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}, []int64{}},
-			FloatField:        &FloatFieldData{[]int64{}, []float32{}},
-			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}},
-			StringField:       &StringFieldData{[]int64{}, []string{}},
-			BinaryVectorField: &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
-			FloatVectorField:  &FloatVectorFieldData{[]int64{}, []float32{}, 4},
+			RowIDField:             &Int64FieldData{[]int64{}, []int64{}},
+			TimestampField:         &Int64FieldData{[]int64{}, []int64{}},
+			BoolField:              &BoolFieldData{[]int64{}, []bool{}},
+			Int8Field:              &Int8FieldData{[]int64{}, []int8{}},
+			Int16Field:             &Int16FieldData{[]int64{}, []int16{}},
+			Int32Field:             &Int32FieldData{[]int64{}, []int32{}},
+			Int64Field:             &Int64FieldData{[]int64{}, []int64{}},
+			FloatField:             &FloatFieldData{[]int64{}, []float32{}},
+			DoubleField:            &DoubleFieldData{[]int64{}, []float64{}},
+			StringField:            &StringFieldData{[]int64{}, []string{}},
+			BinaryVectorField:      &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
+			FloatVectorField:       &FloatVectorFieldData{[]int64{}, []float32{}, 4},
+			SparseFloatVectorField: &SparseFloatVectorFieldData{[]int64{}, [][]byte{}, 0},
+			Float16VectorField:     &Float16VectorFieldData{[]int64{}, []byte{}, 2},
+			BFloat16VectorField:    &BFloat16VectorFieldData{[]int64{}, []byte{}, 2},
 		},
 	}
 	b, s, err := insertCodec.Serialize(PartitionID, SegmentID, insertDataEmpty)
@@ -303,6 +367,9 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []int64{2, 2}, resultData.Data[StringField].(*StringFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[SparseFloatVectorField].(*SparseFloatVectorFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[Float16VectorField].(*Float16VectorFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[BFloat16VectorField].(*BFloat16VectorFieldData).NumRows)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[RowIDField].(*Int64FieldData).Data)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[TimestampField].(*Int64FieldData).Data)
 	assert.Equal(t, []bool{true, false, true, false}, resultData.Data[BoolField].(*BoolFieldData).Data)
@@ -315,6 +382,17 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []string{"1", "2", "3", "4"}, resultData.Data[StringField].(*StringFieldData).Data)
 	assert.Equal(t, []byte{0, 255, 0, 255}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
 	assert.Equal(t, []float32{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Equal(t, [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1, 5}, []float32{1, 5}),
+		typeutil.CreateSparseFloatRow([]uint32{2}, []float32{2}),
+		typeutil.CreateSparseFloatRow([]uint32{30}, []float32{3}),
+		{},
+	}, resultData.Data[SparseFloatVectorField].(*SparseFloatVectorFieldData).Contents)
+	assert.Equal(t, 31, resultData.Data[SparseFloatVectorField].(*SparseFloatVectorFieldData).Dim)
+	assert.Equal(t, []byte{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[Float16VectorField].(*Float16VectorFieldData).Data)
+	assert.Equal(t, 2, resultData.Data[Float16VectorField].(*Float16VectorFieldData).Dim)
+	assert.Equal(t, []byte{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[BFloat16VectorField].(*BFloat16VectorFieldData).Data)
+	assert.Equal(t, 2, resultData.Data[BFloat16VectorField].(*BFloat16VectorFieldData).Dim)
 	log.Debug("Data", zap.Any("Data", resultData.Data))
 	log.Debug("Infos", zap.Any("Infos", resultData.Infos))
 
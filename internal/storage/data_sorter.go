@@ -87,6 +87,21 @@ func (ds *DataSorter) Swap(i, j int) {
 			for i := 0; i < dim; i++ {
 				data[i], data[i+dim] = data[i+dim], data[i]
 			}
+		case schemapb.DataType_Float16Vector:
+			data := singleData.(*Float16VectorFieldData).Data
+			rowBytes := singleData.(*Float16VectorFieldData).Dim * 2
+			for k := 0; k < rowBytes; k++ {
+				data[i*rowBytes+k], data[j*rowBytes+k] = data[j*rowBytes+k], data[i*rowBytes+k]
+			}
+		case schemapb.DataType_BFloat16Vector:
+			data := singleData.(*BFloat16VectorFieldData).Data
+			rowBytes := singleData.(*BFloat16VectorFieldData).Dim * 2
+			for k := 0; k < rowBytes; k++ {
+				data[i*rowBytes+k], data[j*rowBytes+k] = data[j*rowBytes+k], data[i*rowBytes+k]
+			}
+		case schemapb.DataType_SparseFloatVector:
+			data := singleData.(*SparseFloatVectorFieldData).Contents
+			data[i], data[j] = data[j], data[i]
 		default:
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
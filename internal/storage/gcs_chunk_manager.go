@@ -0,0 +1,28 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	gcskv "github.com/milvus-io/milvus/internal/kv/gcs"
+)
+
+// GCSChunkManager is a RemoteChunkManager over GCSKV.
+type GCSChunkManager struct {
+	*RemoteChunkManager
+}
+
+// NewGCSChunkManager create a new gcs manager object.
+func NewGCSChunkManager(kv *gcskv.GCSKV) *GCSChunkManager {
+	return &GCSChunkManager{
+		RemoteChunkManager: NewRemoteChunkManager(kv),
+	}
+}
@@ -13,9 +13,13 @@ package storage
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"golang.org/x/exp/mmap"
 
@@ -90,9 +94,9 @@ func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, er
 	at, err := mmap.Open(path)
 	defer func() {
 		if at != nil {
-			err = at.Close()
-			if err != nil {
-				log.Error(err.Error())
+			// the error of ReadAt is returned, the one of Close is only logged
+			if closeErr := at.Close(); closeErr != nil {
+				log.Error(closeErr.Error())
 			}
 		}
 	}()
@@ -102,3 +106,109 @@ func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, er
 
 	return at.ReadAt(p, off)
 }
+
+// Size returns the size of local file.
+func (lcm *LocalChunkManager) Size(key string) (int64, error) {
+	info, err := os.Stat(path.Join(lcm.localPath, key))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// MultiWrite writes the data to local storage.
+func (lcm *LocalChunkManager) MultiWrite(contents map[string][]byte) error {
+	var resultErr error
+	for key, content := range contents {
+		err := lcm.Write(key, content)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
+
+// Reader returns a reader of local file, the reader must be closed after use.
+func (lcm *LocalChunkManager) Reader(key string) (io.ReadCloser, error) {
+	return os.Open(path.Clean(path.Join(lcm.localPath, key)))
+}
+
+// MultiRead reads the local storage data of @keys.
+func (lcm *LocalChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	var resultErr error
+	results := make([][]byte, len(keys))
+	for i, key := range keys {
+		content, err := lcm.Read(key)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+		results[i] = content
+	}
+	return results, resultErr
+}
+
+// ListWithPrefix walks the directory holding @prefix, so a prefix like "a/b"
+// matches both "a/b/c" and "a/bc" as object storages do.
+func (lcm *LocalChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	dir := path.Join(lcm.localPath, prefix)
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		dir = path.Dir(dir)
+	}
+	var keys []string
+	var modTimes []time.Time
+	err := filepath.Walk(dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		key, err := filepath.Rel(lcm.localPath, filePath)
+		if err != nil {
+			return err
+		}
+		key = filepath.ToSlash(key)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+			modTimes = append(modTimes, info.ModTime())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, modTimes, nil
+}
+
+// Remove deletes the local file, deleting a non-existent file is not an error.
+func (lcm *LocalChunkManager) Remove(key string) error {
+	err := os.Remove(path.Join(lcm.localPath, key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// MultiRemove deletes the local files of @keys.
+func (lcm *LocalChunkManager) MultiRemove(keys []string) error {
+	var resultErr error
+	for _, key := range keys {
+		err := lcm.Remove(key)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
+
+// RemoveWithPrefix deletes all local files with @prefix.
+func (lcm *LocalChunkManager) RemoveWithPrefix(prefix string) error {
+	keys, _, err := lcm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return lcm.MultiRemove(keys)
+}
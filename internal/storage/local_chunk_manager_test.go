@@ -12,6 +12,9 @@
 package storage
 
 import (
+	"io"
+	"io/ioutil"
+	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -49,4 +52,66 @@ func TestLocalChunkManager_Read(t *testing.T) {
 	res, err := lcm.Read("1")
 	assert.Nil(t, err)
 	assert.Equal(t, len(res), len(bin))
+
+	// the error of reading past the end is not overwritten by closing the file
+	n, err = lcm.ReadAt("1", content, 0)
+	assert.Equal(t, len(bin), n)
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestLocalChunkManager_List(t *testing.T) {
+	lcm := NewLocalChunkManager(path.Join(localPath, "list"))
+	defer lcm.RemoveWithPrefix("")
+
+	err := lcm.MultiWrite(map[string][]byte{
+		"a/b/1": {1},
+		"a/b/2": {1, 2},
+		"a/bc":  {1, 2, 3},
+		"d":     {4},
+	})
+	assert.Nil(t, err)
+
+	keys, modTimes, err := lcm.ListWithPrefix("a/b")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a/b/1", "a/b/2", "a/bc"}, keys)
+	assert.Equal(t, 3, len(modTimes))
+
+	keys, _, err = lcm.ListWithPrefix("a/b/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a/b/1", "a/b/2"}, keys)
+
+	keys, _, err = lcm.ListWithPrefix("")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(keys))
+
+	keys, _, err = lcm.ListWithPrefix("not_exist/")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	size, err := lcm.Size("a/bc")
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, size)
+
+	contents, err := lcm.MultiRead([]string{"a/b/2", "d"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{{1, 2}, {4}}, contents)
+
+	reader, err := lcm.Reader("a/bc")
+	assert.Nil(t, err)
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, content)
+	assert.Nil(t, reader.Close())
+
+	err = lcm.Remove("d")
+	assert.Nil(t, err)
+	assert.False(t, lcm.Exist("d"))
+	err = lcm.Remove("d")
+	assert.Nil(t, err)
+
+	err = lcm.RemoveWithPrefix("a/b/")
+	assert.Nil(t, err)
+	keys, _, err = lcm.ListWithPrefix("")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a/bc"}, keys)
 }
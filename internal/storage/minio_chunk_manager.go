@@ -12,62 +12,19 @@
 package storage
 
 import (
-	"errors"
-	"io"
-
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 )
 
 // MinioChunkManager is responsible for read and write data stored in minio.
 type MinioChunkManager struct {
+	*RemoteChunkManager
 	minio *miniokv.MinIOKV
 }
 
-// NewMinioChunkManager create a new local manager object.
+// NewMinioChunkManager create a new minio manager object.
 func NewMinioChunkManager(minio *miniokv.MinIOKV) *MinioChunkManager {
 	return &MinioChunkManager{
-		minio: minio,
+		RemoteChunkManager: NewRemoteChunkManager(minio),
+		minio:              minio,
 	}
 }
-
-// GetPath returns the path of minio data if exist.
-func (mcm *MinioChunkManager) GetPath(key string) (string, error) {
-	if !mcm.Exist(key) {
-		return "", errors.New("minio file manage cannot be found with key:" + key)
-	}
-	return key, nil
-}
-
-// Write writes the data to minio storage.
-func (mcm *MinioChunkManager) Write(key string, content []byte) error {
-	return mcm.minio.Save(key, string(content))
-}
-
-// Exist checks whether chunk is saved to minio storage.
-func (mcm *MinioChunkManager) Exist(key string) bool {
-	return mcm.minio.Exist(key)
-}
-
-// Read reads the minio storage data if exist.
-func (mcm *MinioChunkManager) Read(key string) ([]byte, error) {
-	results, err := mcm.minio.Load(key)
-	return []byte(results), err
-}
-
-// ReadAt reads specific position data of minio storage if exist.
-func (mcm *MinioChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
-	results, err := mcm.minio.Load(key)
-	if err != nil {
-		return -1, err
-	}
-
-	if off < 0 || int64(len([]byte(results))) < off {
-		return 0, errors.New("MinioChunkManager: invalid offset")
-	}
-	n := copy(p, []byte(results)[off:])
-	if n < len(p) {
-		return n, io.EOF
-	}
-
-	return n, nil
-}
@@ -13,6 +13,7 @@ package storage
 
 import (
 	"context"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -69,3 +70,49 @@ func TestMinioChunkManager_ReadAt(t *testing.T) {
 		assert.Equal(t, content[i-offset], bin[i])
 	}
 }
+
+func TestMinioChunkManager_List(t *testing.T) {
+	bucketName := "minio-chunk-manager"
+	kv, err := newMinIOKVClient(context.TODO(), bucketName)
+	assert.Nil(t, err)
+
+	minioMgr := NewMinioChunkManager(kv)
+	defer minioMgr.RemoveWithPrefix("list/")
+
+	err = minioMgr.MultiWrite(map[string][]byte{
+		"list/a/1": {1},
+		"list/a/2": {1, 2},
+		"list/b":   {1, 2, 3},
+	})
+	assert.Nil(t, err)
+
+	keys, modTimes, err := minioMgr.ListWithPrefix("list/a")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"list/a/1", "list/a/2"}, keys)
+	assert.Equal(t, 2, len(modTimes))
+
+	size, err := minioMgr.Size("list/b")
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, size)
+
+	contents, err := minioMgr.MultiRead([]string{"list/a/2", "list/b"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{{1, 2}, {1, 2, 3}}, contents)
+
+	reader, err := minioMgr.Reader("list/b")
+	assert.Nil(t, err)
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, content)
+	assert.Nil(t, reader.Close())
+
+	err = minioMgr.Remove("list/b")
+	assert.Nil(t, err)
+	assert.False(t, minioMgr.Exist("list/b"))
+	err = minioMgr.MultiRemove([]string{"list/a/1", "list/b"})
+	assert.Nil(t, err)
+
+	keys, _, err = minioMgr.ListWithPrefix("list/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"list/a/2"}, keys)
+}
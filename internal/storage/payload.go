@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type PayloadWriterInterface interface {
@@ -42,6 +43,9 @@ type PayloadWriterInterface interface {
 	AddOneStringToPayload(msgs string) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
+	AddFloat16VectorToPayload(vec []byte, dim int) error
+	AddBFloat16VectorToPayload(vec []byte, dim int) error
+	AddOneSparseFloatVectorToPayload(row []byte) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
@@ -61,6 +65,10 @@ type PayloadReaderInterface interface {
 	GetOneStringFromPayload(idx int) (string, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
+	GetFloat16VectorFromPayload() ([]byte, int, error)
+	GetBFloat16VectorFromPayload() ([]byte, int, error)
+	GetOneSparseFloatVectorFromPayload(idx int) ([]byte, error)
+	GetSparseFloatVectorFromPayload() ([][]byte, int, error)
 	GetPayloadLengthFromReader() (int, error)
 	ReleasePayloadReader() error
 	Close() error
@@ -136,6 +144,12 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case schemapb.DataType_SparseFloatVector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneSparseFloatVectorToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -153,6 +167,18 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddFloatVectorToPayload(val, dim[0])
+		case schemapb.DataType_Float16Vector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddFloat16VectorToPayload(val, dim[0])
+		case schemapb.DataType_BFloat16Vector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddBFloat16VectorToPayload(val, dim[0])
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -301,6 +327,54 @@ func (w *PayloadWriter) AddFloatVectorToPayload(floatVec []float32, dim int) err
 	return HandleCStatus(&status, "AddFloatVectorToPayload failed")
 }
 
+// AddFloat16VectorToPayload adds float16 vectors encoded as 2 bytes per element
+func (w *PayloadWriter) AddFloat16VectorToPayload(vec []byte, dim int) error {
+	length := len(vec)
+	if length <= 0 {
+		return errors.New("can't add empty float16Vec into payload")
+	}
+	if dim <= 0 {
+		return errors.New("dimension should be greater than 0")
+	}
+
+	cVec := (*C.uint8_t)(&vec[0])
+	cDim := C.int(dim)
+	cLength := C.int(length / (dim * 2))
+
+	status := C.AddFloat16VectorToPayload(w.payloadWriterPtr, cVec, cDim, cLength)
+	return HandleCStatus(&status, "AddFloat16VectorToPayload failed")
+}
+
+// AddBFloat16VectorToPayload adds bfloat16 vectors encoded as 2 bytes per element
+func (w *PayloadWriter) AddBFloat16VectorToPayload(vec []byte, dim int) error {
+	length := len(vec)
+	if length <= 0 {
+		return errors.New("can't add empty bfloat16Vec into payload")
+	}
+	if dim <= 0 {
+		return errors.New("dimension should be greater than 0")
+	}
+
+	cVec := (*C.uint8_t)(&vec[0])
+	cDim := C.int(dim)
+	cLength := C.int(length / (dim * 2))
+
+	status := C.AddBFloat16VectorToPayload(w.payloadWriterPtr, cVec, cDim, cLength)
+	return HandleCStatus(&status, "AddBFloat16VectorToPayload failed")
+}
+
+// AddOneSparseFloatVectorToPayload adds one sparse float vector row, an empty row is allowed
+func (w *PayloadWriter) AddOneSparseFloatVectorToPayload(row []byte) error {
+	var cRow *C.uint8_t
+	if len(row) > 0 {
+		cRow = (*C.uint8_t)(&row[0])
+	}
+	cSize := C.int(len(row))
+
+	status := C.AddOneSparseFloatVectorToPayload(w.payloadWriterPtr, cRow, cSize)
+	return HandleCStatus(&status, "AddOneSparseFloatVectorToPayload failed")
+}
+
 func (w *PayloadWriter) FinishPayloadWriter() error {
 	status := C.FinishPayloadWriter(w.payloadWriterPtr)
 	return HandleCStatus(&status, "FinishPayloadWriter failed")
@@ -361,6 +435,9 @@ func (r *PayloadReader) GetDataFromPayload(idx ...int) (interface{}, int, error)
 		case schemapb.DataType_String:
 			val, err := r.GetOneStringFromPayload(idx[0])
 			return val, 0, err
+		case schemapb.DataType_SparseFloatVector:
+			val, err := r.GetOneSparseFloatVectorFromPayload(idx[0])
+			return val, 0, err
 		default:
 			return nil, 0, errors.New("unknown type")
 		}
@@ -391,6 +468,12 @@ func (r *PayloadReader) GetDataFromPayload(idx ...int) (interface{}, int, error)
 			return r.GetBinaryVectorFromPayload()
 		case schemapb.DataType_FloatVector:
 			return r.GetFloatVectorFromPayload()
+		case schemapb.DataType_Float16Vector:
+			return r.GetFloat16VectorFromPayload()
+		case schemapb.DataType_BFloat16Vector:
+			return r.GetBFloat16VectorFromPayload()
+		case schemapb.DataType_SparseFloatVector:
+			return r.GetSparseFloatVectorFromPayload()
 		default:
 			return nil, 0, errors.New("unknown type")
 		}
@@ -578,6 +661,86 @@ func (r *PayloadReader) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return slice, int(cDim), nil
 }
 
+// GetFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReader) GetFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != schemapb.DataType_Float16Vector {
+		return nil, 0, errors.New("incorrect data type")
+	}
+
+	var cMsg *C.uint8_t
+	var cDim C.int
+	var cLen C.int
+
+	status := C.GetFloat16VectorFromPayload(r.payloadReaderPtr, &cMsg, &cDim, &cLen)
+	if err := HandleCStatus(&status, "GetFloat16VectorFromPayload failed"); err != nil {
+		return nil, 0, err
+	}
+	length := cDim * 2 * cLen
+
+	slice := (*[1 << 28]byte)(unsafe.Pointer(cMsg))[:length:length]
+	return slice, int(cDim), nil
+}
+
+// GetBFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReader) GetBFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != schemapb.DataType_BFloat16Vector {
+		return nil, 0, errors.New("incorrect data type")
+	}
+
+	var cMsg *C.uint8_t
+	var cDim C.int
+	var cLen C.int
+
+	status := C.GetBFloat16VectorFromPayload(r.payloadReaderPtr, &cMsg, &cDim, &cLen)
+	if err := HandleCStatus(&status, "GetBFloat16VectorFromPayload failed"); err != nil {
+		return nil, 0, err
+	}
+	length := cDim * 2 * cLen
+
+	slice := (*[1 << 28]byte)(unsafe.Pointer(cMsg))[:length:length]
+	return slice, int(cDim), nil
+}
+
+// GetOneSparseFloatVectorFromPayload returns a copy of the idx-th sparse float vector row
+func (r *PayloadReader) GetOneSparseFloatVectorFromPayload(idx int) ([]byte, error) {
+	if r.colType != schemapb.DataType_SparseFloatVector {
+		return nil, errors.New("incorrect data type")
+	}
+
+	var cRow *C.uint8_t
+	var cSize C.int
+
+	status := C.GetOneSparseFloatVectorFromPayload(r.payloadReaderPtr, C.int(idx), &cRow, &cSize)
+	if err := HandleCStatus(&status, "GetOneSparseFloatVectorFromPayload failed"); err != nil {
+		return nil, err
+	}
+	if cSize == 0 {
+		return []byte{}, nil
+	}
+	return C.GoBytes(unsafe.Pointer(cRow), cSize), nil
+}
+
+// GetSparseFloatVectorFromPayload returns all sparse float vector rows and the max dimension of them
+func (r *PayloadReader) GetSparseFloatVectorFromPayload() ([][]byte, int, error) {
+	length, err := r.GetPayloadLengthFromReader()
+	if err != nil {
+		return nil, 0, err
+	}
+	rows := make([][]byte, 0, length)
+	var dim int64
+	for i := 0; i < length; i++ {
+		row, err := r.GetOneSparseFloatVectorFromPayload(i)
+		if err != nil {
+			return nil, 0, err
+		}
+		if rowDim := typeutil.SparseFloatRowDim(row); rowDim > dim {
+			dim = rowDim
+		}
+		rows = append(rows, row)
+	}
+	return rows, int(dim), nil
+}
+
 func (r *PayloadReader) GetPayloadLengthFromReader() (int, error) {
 	length := C.GetPayloadLengthFromReader(r.payloadReaderPtr)
 	return int(length), nil
@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -435,6 +436,111 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		defer r.ReleasePayloadReader()
 	})
 
+	t.Run("TestFloat16Vector", func(t *testing.T) {
+		for _, dataType := range []schemapb.DataType{schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector} {
+			w, err := NewPayloadWriter(dataType)
+			require.Nil(t, err)
+			require.NotNil(t, w)
+
+			vec := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+			if dataType == schemapb.DataType_Float16Vector {
+				err = w.AddFloat16VectorToPayload(vec[:4], 2)
+			} else {
+				err = w.AddBFloat16VectorToPayload(vec[:4], 2)
+			}
+			assert.Nil(t, err)
+			err = w.AddDataToPayload(vec[4:], 2)
+			assert.Nil(t, err)
+			err = w.AddDataToPayload(vec[4:], 1)
+			assert.NotNil(t, err)
+			err = w.FinishPayloadWriter()
+			assert.Nil(t, err)
+
+			length, err := w.GetPayloadLengthFromWriter()
+			assert.Nil(t, err)
+			assert.Equal(t, 2, length)
+			defer w.ReleasePayloadWriter()
+
+			buffer, err := w.GetPayloadBufferFromWriter()
+			assert.Nil(t, err)
+
+			r, err := NewPayloadReader(dataType, buffer)
+			require.Nil(t, err)
+			length, err = r.GetPayloadLengthFromReader()
+			assert.Nil(t, err)
+			assert.Equal(t, 2, length)
+
+			var vecs []byte
+			var dim int
+			if dataType == schemapb.DataType_Float16Vector {
+				vecs, dim, err = r.GetFloat16VectorFromPayload()
+				assert.Nil(t, err)
+				_, _, err = r.GetBFloat16VectorFromPayload()
+				assert.NotNil(t, err)
+			} else {
+				vecs, dim, err = r.GetBFloat16VectorFromPayload()
+				assert.Nil(t, err)
+				_, _, err = r.GetFloat16VectorFromPayload()
+				assert.NotNil(t, err)
+			}
+			assert.Equal(t, 2, dim)
+			assert.Equal(t, vec, vecs)
+
+			ivecs, dim, err := r.GetDataFromPayload()
+			assert.Nil(t, err)
+			assert.Equal(t, 2, dim)
+			assert.Equal(t, vec, ivecs.([]byte))
+			defer r.ReleasePayloadReader()
+		}
+	})
+
+	t.Run("TestSparseFloatVector", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_SparseFloatVector)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		row0 := typeutil.CreateSparseFloatRow([]uint32{1, 10}, []float32{1.0, 2.0})
+		row1 := typeutil.CreateSparseFloatRow([]uint32{3}, []float32{3.0})
+		err = w.AddOneSparseFloatVectorToPayload(row0)
+		assert.Nil(t, err)
+		err = w.AddDataToPayload(row1)
+		assert.Nil(t, err)
+		err = w.AddOneSparseFloatVectorToPayload(nil)
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, length)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_SparseFloatVector, buffer)
+		require.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 3)
+
+		row, err := r.GetOneSparseFloatVectorFromPayload(1)
+		assert.Nil(t, err)
+		assert.Equal(t, row1, row)
+		irow, _, err := r.GetDataFromPayload(0)
+		assert.Nil(t, err)
+		assert.Equal(t, row0, irow.([]byte))
+
+		rows, dim, err := r.GetSparseFloatVectorFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 11, dim)
+		assert.Equal(t, [][]byte{row0, row1, {}}, rows)
+
+		_, err = r.GetOneSparseFloatVectorFromPayload(3)
+		assert.NotNil(t, err)
+		defer r.ReleasePayloadReader()
+	})
+
 	t.Run("TestAddDataToPayload", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_Bool)
 		w.colType = 999
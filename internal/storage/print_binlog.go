@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PrintBinlogFiles call printBinlogFile in turn for the file list specified by parameter fileList.
@@ -311,6 +312,42 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 			}
 			fmt.Println()
 		}
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		var val []byte
+		var dim int
+		var err error
+		var floats []float32
+		if colType == schemapb.DataType_Float16Vector {
+			val, dim, err = reader.GetFloat16VectorFromPayload()
+			floats = typeutil.Float16BytesToFloat32s(val)
+		} else {
+			val, dim, err = reader.GetBFloat16VectorFromPayload()
+			floats = typeutil.BFloat16BytesToFloat32s(val)
+		}
+		if err != nil {
+			return err
+		}
+		length := len(floats) / dim
+		for i := 0; i < length; i++ {
+			fmt.Printf("\t\t%d :", i)
+			for j := 0; j < dim; j++ {
+				idx := i*dim + j
+				fmt.Printf(" %f", floats[idx])
+			}
+			fmt.Println()
+		}
+	case schemapb.DataType_SparseFloatVector:
+		rows, _, err := reader.GetSparseFloatVectorFromPayload()
+		if err != nil {
+			return err
+		}
+		for i, row := range rows {
+			fmt.Printf("\t\t%d :", i)
+			for j := 0; j < typeutil.SparseFloatRowElementCount(row); j++ {
+				fmt.Printf(" %d:%f", typeutil.SparseFloatRowIndexAt(row, j), typeutil.SparseFloatRowValueAt(row, j))
+			}
+			fmt.Println()
+		}
 	default:
 		return errors.New("undefined data type")
 	}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/kv"
+)
+
+// ObjectKV is a DataKV backed by an object storage, which is able to list and
+// stream objects. MinIOKV, AzureKV and GCSKV implement it.
+type ObjectKV interface {
+	kv.DataKV
+	Exist(key string) bool
+	ListWithPrefix(prefix string) ([]string, []time.Time, error)
+	Reader(key string) (io.ReadCloser, error)
+}
+
+// RemoteChunkManager is a ChunkManager over an ObjectKV, keys are object names in the bucket.
+type RemoteChunkManager struct {
+	kv ObjectKV
+}
+
+// NewRemoteChunkManager creates a RemoteChunkManager over @kv.
+func NewRemoteChunkManager(kv ObjectKV) *RemoteChunkManager {
+	return &RemoteChunkManager{
+		kv: kv,
+	}
+}
+
+// GetPath returns the path of remote data if exist.
+func (rcm *RemoteChunkManager) GetPath(key string) (string, error) {
+	if !rcm.Exist(key) {
+		return "", errors.New("remote file cannot be found with key:" + key)
+	}
+	return key, nil
+}
+
+// Size returns the size of remote data.
+func (rcm *RemoteChunkManager) Size(key string) (int64, error) {
+	return rcm.kv.GetSize(key)
+}
+
+// Write writes the data to remote storage.
+func (rcm *RemoteChunkManager) Write(key string, content []byte) error {
+	return rcm.kv.Save(key, string(content))
+}
+
+// MultiWrite writes the data of @contents to remote storage.
+func (rcm *RemoteChunkManager) MultiWrite(contents map[string][]byte) error {
+	kvs := make(map[string]string, len(contents))
+	for key, content := range contents {
+		kvs[key] = string(content)
+	}
+	return rcm.kv.MultiSave(kvs)
+}
+
+// Exist checks whether chunk is saved to remote storage.
+func (rcm *RemoteChunkManager) Exist(key string) bool {
+	return rcm.kv.Exist(key)
+}
+
+// Read reads the remote storage data if exist.
+func (rcm *RemoteChunkManager) Read(key string) ([]byte, error) {
+	results, err := rcm.kv.Load(key)
+	return []byte(results), err
+}
+
+// Reader returns a reader of remote data, the reader must be closed after use.
+func (rcm *RemoteChunkManager) Reader(key string) (io.ReadCloser, error) {
+	return rcm.kv.Reader(key)
+}
+
+// MultiRead reads the remote storage data of @keys.
+func (rcm *RemoteChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	values, err := rcm.kv.MultiLoad(keys)
+	results := make([][]byte, len(values))
+	for i, value := range values {
+		results[i] = []byte(value)
+	}
+	return results, err
+}
+
+// ListWithPrefix lists the remote files with @prefix.
+func (rcm *RemoteChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	return rcm.kv.ListWithPrefix(prefix)
+}
+
+// ReadAt only downloads the range [off, off+len(p)) of the object.
+func (rcm *RemoteChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
+	size, err := rcm.kv.GetSize(key)
+	if err != nil {
+		return -1, err
+	}
+
+	if off < 0 || size < off {
+		return 0, errors.New("RemoteChunkManager: invalid offset")
+	}
+	end := off + int64(len(p))
+	if end > size {
+		end = size
+	}
+	n := 0
+	if end > off {
+		results, err := rcm.kv.LoadPartial(key, off, end)
+		if err != nil {
+			return -1, err
+		}
+		n = copy(p, results)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+// Remove deletes the remote data, deleting a non-existent key is not an error.
+func (rcm *RemoteChunkManager) Remove(key string) error {
+	return rcm.kv.Remove(key)
+}
+
+// MultiRemove deletes the remote data of @keys.
+func (rcm *RemoteChunkManager) MultiRemove(keys []string) error {
+	return rcm.kv.MultiRemove(keys)
+}
+
+// RemoveWithPrefix deletes all remote data with @prefix.
+func (rcm *RemoteChunkManager) RemoveWithPrefix(prefix string) error {
+	return rcm.kv.RemoveWithPrefix(prefix)
+}
@@ -11,19 +11,40 @@
 
 package storage
 
+import (
+	"io"
+	"time"
+)
+
 // ChunkManager is to manager chunks.
 // Include Read, Write, Remove chunks.
 type ChunkManager interface {
 	// GetPath returns path of @key
 	GetPath(key string) (string, error)
+	// Size returns the size of @key
+	Size(key string) (int64, error)
 	// Write writes @content to @key
 	Write(key string, content []byte) error
+	// MultiWrite writes multi @content to @key
+	MultiWrite(contents map[string][]byte) error
 	// Exist returns true if @key exists
 	Exist(key string) bool
 	// Read reads @key and returns content
 	Read(key string) ([]byte, error)
+	// Reader returns a reader of @key, the reader must be closed after use
+	Reader(key string) (io.ReadCloser, error)
+	// MultiRead reads @keys and returns contents
+	MultiRead(keys []string) ([][]byte, error)
+	// ListWithPrefix returns all keys with @prefix and their last modified time
+	ListWithPrefix(prefix string) ([]string, []time.Time, error)
 	// ReadAt reads @key by offset @off, content stored in @p, return @n as the number of bytes read
 	// if all bytes are read, @err is io.EOF
 	// return other error if read failed
 	ReadAt(key string, p []byte, off int64) (n int, err error)
+	// Remove deletes @key, deleting a non-existent key is not an error
+	Remove(key string) error
+	// MultiRemove deletes @keys
+	MultiRemove(keys []string) error
+	// RemoveWithPrefix deletes all keys with @prefix
+	RemoveWithPrefix(prefix string) error
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 
 	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // GetBinlogSize get size of a binlog file.
@@ -211,6 +212,15 @@ func TransferColumnBasedInsertDataToRowBased(data *InsertData) (
 
 		for j := 0; j < ls.Len(); j++ {
 			d := ls.datas[j].GetRow(i)
+			// sparse float vector rows are variable length, prefixed with the number of elements
+			if _, ok := ls.datas[j].(*SparseFloatVectorFieldData); ok {
+				row := d.([]byte)
+				err := binary.Write(&buffer, common.Endian, uint32(typeutil.SparseFloatRowElementCount(row)))
+				if err != nil {
+					return nil, nil, nil,
+						fmt.Errorf("failed to get binary row, err: %v", err)
+				}
+			}
 			err := binary.Write(&buffer, common.Endian, d)
 			if err != nil {
 				return nil, nil, nil,
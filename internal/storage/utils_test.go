@@ -22,6 +22,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
 	"github.com/milvus-io/milvus/internal/kv"
@@ -582,3 +583,39 @@ func TestTransferColumnBasedInsertDataToRowBased(t *testing.T) {
 			rows[2].Value)
 	}
 }
+
+func TestTransferColumnBasedInsertDataToRowBased_SparseFloatVector(t *testing.T) {
+	data := &InsertData{
+		Data: map[FieldID]FieldData{
+			common.TimeStampField: &Int64FieldData{Data: []int64{1, 2}},
+			common.RowIDField:     &Int64FieldData{Data: []int64{1, 2}},
+			101:                   &Int64FieldData{Data: []int64{10, 20}},
+			102: &SparseFloatVectorFieldData{
+				Contents: [][]byte{
+					typeutil.CreateSparseFloatRow([]uint32{3}, []float32{1}),
+					typeutil.CreateSparseFloatRow(nil, nil),
+				},
+				Dim: 4,
+			},
+		},
+	}
+
+	_, _, rows, err := TransferColumnBasedInsertDataToRowBased(data)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rows))
+	if common.Endian == binary.LittleEndian {
+		assert.Equal(t,
+			[]byte{
+				10, 0, 0, 0, 0, 0, 0, 0, // 10
+				1, 0, 0, 0, // nnz
+				3, 0, 0, 0, 0, 0, 0x80, 0x3f, // (3, 1.0)
+			},
+			rows[0].Value)
+		assert.Equal(t,
+			[]byte{
+				20, 0, 0, 0, 0, 0, 0, 0, // 20
+				0, 0, 0, 0, // nnz
+			},
+			rows[1].Value)
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+	"io/ioutil"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -70,6 +72,15 @@ func (vcm *VectorChunkManager) downloadVectorFile(key string) ([]byte, error) {
 		if ok {
 			results = binaryVector.Data
 		}
+		// half float vectors are cached as they are
+		float16Vector, ok := singleData.(*Float16VectorFieldData)
+		if ok {
+			results = float16Vector.Data
+		}
+		bfloat16Vector, ok := singleData.(*BFloat16VectorFieldData)
+		if ok {
+			results = bfloat16Vector.Data
+		}
 		floatVector, ok := singleData.(*FloatVectorFieldData)
 		if ok {
 			buf := new(bytes.Buffer)
@@ -159,3 +170,65 @@ func (vcm *VectorChunkManager) ReadAt(key string, p []byte, off int64) (int, err
 
 	return n, nil
 }
+
+// Size returns the size of the pure vector data.
+func (vcm *VectorChunkManager) Size(key string) (int64, error) {
+	if vcm.localCacheEnable && vcm.localChunkManager.Exist(key) {
+		return vcm.localChunkManager.Size(key)
+	}
+	data, err := vcm.Read(key)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(data)), nil
+}
+
+// MultiWrite writes the vector data to local cache if cache enabled.
+func (vcm *VectorChunkManager) MultiWrite(contents map[string][]byte) error {
+	if !vcm.localCacheEnable {
+		return errors.New("Cannot write local file for local cache is not allowed")
+	}
+	return vcm.localChunkManager.MultiWrite(contents)
+}
+
+// Reader returns a reader of the pure vector data.
+func (vcm *VectorChunkManager) Reader(key string) (io.ReadCloser, error) {
+	data, err := vcm.Read(key)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+// MultiRead reads the pure vector data of @keys.
+func (vcm *VectorChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	results := make([][]byte, len(keys))
+	for i, key := range keys {
+		data, err := vcm.Read(key)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = data
+	}
+	return results, nil
+}
+
+// ListWithPrefix lists the vector files in remote storage.
+func (vcm *VectorChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	return vcm.remoteChunkManager.ListWithPrefix(prefix)
+}
+
+// Remove removes the vector data from local cache, remote files are left untouched.
+func (vcm *VectorChunkManager) Remove(key string) error {
+	return vcm.localChunkManager.Remove(key)
+}
+
+// MultiRemove removes the vector data of @keys from local cache.
+func (vcm *VectorChunkManager) MultiRemove(keys []string) error {
+	return vcm.localChunkManager.MultiRemove(keys)
+}
+
+// RemoveWithPrefix removes the vector data with @prefix from local cache.
+func (vcm *VectorChunkManager) RemoveWithPrefix(prefix string) error {
+	return vcm.localChunkManager.RemoveWithPrefix(prefix)
+}
@@ -229,6 +229,12 @@ type DataCoord interface {
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	// GetCompactionStateWithPlans get the state of requested plan id
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	// SetCompactionPolicy sets the compaction policy of a collection
+	SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error)
+	// DryRunCompaction returns the compaction plans a policy would generate for a collection without executing them
+	DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error)
+	// GetCompactionHistory returns the records of completed compaction plans of a collection
+	GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error)
 
 	// WatchChannels notifies DataCoord to watch vchannels of a collection
 	WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error)
@@ -245,6 +251,16 @@ type DataCoord interface {
 	// response status contains the status/error code and failing reason if any
 	// error is returned only when some communication issue occurs
 	DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)
+
+	// RecoverDroppedSegments notifies DataCoord to restore the segments dropped with the channels of a recovered collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the ID of the recovered collection
+	//
+	// response status contains the status/error code and failing reason if any, it fails if the dropped segments
+	// have been garbage collected
+	// error is returned only when some communication issue occurs
+	RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest) (*commonpb.Status, error)
 }
 
 // IndexNode is the interface `indexnode` package implements
@@ -256,6 +272,10 @@ type IndexNode interface {
 	// Index building is asynchronous, so when an index building request comes, IndexNode records the task and returns.
 	CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error)
 
+	// CancelIndexBuild stops the index build task with the IndexBuildID in the request. A task that is still
+	// queued is dropped, and a running task has its context canceled so that it does not write any index files.
+	CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error)
+
 	// GetMetrics gets the metrics about IndexNode.
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -284,6 +304,17 @@ type IndexCoord interface {
 
 	// GetMetrics gets the metrics about IndexCoord.
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+
+	// ListIndexBuildTasks lists the index build tasks recorded in IndexCoord, with the IndexNode they are assigned to,
+	// their state, retry count and failure reason. The tasks can be filtered by IndexID and by state.
+	ListIndexBuildTasks(ctx context.Context, req *indexpb.ListIndexBuildTasksRequest) (*indexpb.ListIndexBuildTasksResponse, error)
+
+	// CancelIndexBuild aborts a single index build task. The task is marked as failed with the reason in the request,
+	// and the IndexNode which is running the task is notified to stop it.
+	CancelIndexBuild(ctx context.Context, req *indexpb.CancelIndexBuildRequest) (*commonpb.Status, error)
+
+	// RetryIndexBuild requeues failed index build tasks, they will be assigned to IndexNode again by assignTaskLoop.
+	RetryIndexBuild(ctx context.Context, req *indexpb.RetryIndexBuildRequest) (*commonpb.Status, error)
 }
 
 // RootCoord is the interface `rootcoord` package implements
@@ -456,6 +487,36 @@ type RootCoord interface {
 	// error is always nil
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// RenameCollection notifies RootCoord to rename a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the old and the new collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// RecoverCollection notifies RootCoord to recover a dropped collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the name of the dropped collection
+	//
+	// The `ErrorCode` of `Status` is `Success` if recover collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RecoverCollection(ctx context.Context, req *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error)
+
+	// RecoverPartition notifies RootCoord to recover a dropped partition
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name and the name of the dropped partition
+	//
+	// The `ErrorCode` of `Status` is `Success` if recover partition successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RecoverPartition(ctx context.Context, req *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error)
+
 	// AllocTimestamp notifies RootCoord to alloc timestamps
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -884,6 +945,17 @@ type ProxyComponent interface {
 	// error is always nil
 	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
 
+	// Get notifies Proxy to retrieve rows by primary keys
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition names(optional), primary keys, output fields
+	//
+	// Only the DML channels owning the primary keys are queried.
+	// The `Status` in response struct `QueryResults` indicates if this operation is processed successfully or fail cause;
+	// the `FieldsData` in `QueryResults` return the rows found.
+	// error is always nil
+	Get(ctx context.Context, request *milvuspb.GetRequest) (*milvuspb.QueryResults, error)
+
 	// CalcDistance notifies Proxy to calculate distance between specified vectors
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -970,9 +1042,45 @@ type ProxyComponent interface {
 	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
 	// error is always nil
 	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	// RenameCollection notifies Proxy to rename a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), the old and the new collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// RecoverCollection notifies Proxy to recover a dropped collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), the name of the dropped collection
+	//
+	// The `ErrorCode` of `Status` is `Success` if recover collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RecoverCollection(ctx context.Context, request *milvuspb.RecoverCollectionRequest) (*commonpb.Status, error)
+
+	// RecoverPartition notifies Proxy to recover a dropped partition
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, the name of the dropped partition
+	//
+	// The `ErrorCode` of `Status` is `Success` if recover partition successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RecoverPartition(ctx context.Context, request *milvuspb.RecoverPartitionRequest) (*commonpb.Status, error)
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	// SetCompactionPolicy sets the compaction policy of a collection
+	SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest) (*commonpb.Status, error)
+	// DryRunCompaction returns the compaction plans a policy would generate for a collection without executing them
+	DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest) (*milvuspb.DryRunCompactionResponse, error)
+	// GetCompactionHistory returns the records of completed compaction plans of a collection
+	GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest) (*milvuspb.GetCompactionHistoryResponse, error)
 	// GetFlushState gets the flush state of multiple segments
 	GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
 }
@@ -8,7 +8,6 @@ import (
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -116,7 +115,6 @@ func (c *ClientBase) connect(ctx context.Context, retryOptions ...retry.Option)
 			log.Debug(c.GetRole()+" client getAddr failed", zap.Error(err))
 			return err
 		}
-		opts := trace.GetInterceptorOpts()
 		ctx1, cancel := context.WithTimeout(ctx, 15*time.Second)
 		defer cancel()
 		conn, err2 := grpc.DialContext(ctx1, addr,
@@ -131,14 +129,14 @@ func (c *ClientBase) connect(ctx context.Context, retryOptions ...retry.Option)
 						grpcretry.WithMax(3),
 						grpcretry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpcopentracing.UnaryClientInterceptor(opts...),
+					trace.UnaryClientInterceptor(),
 				)),
 			grpc.WithStreamInterceptor(
 				grpcmiddleware.ChainStreamClient(
 					grpcretry.StreamClientInterceptor(grpcretry.WithMax(3),
 						grpcretry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpcopentracing.StreamClientInterceptor(opts...),
+					trace.StreamClientInterceptor(),
 				)),
 		)
 		if err2 != nil {
@@ -484,3 +484,20 @@ func (adapter *NGTONNGConfAdapter) CheckTrain(params map[string]string) bool {
 func newNGTONNGConfAdapter() *NGTONNGConfAdapter {
 	return &NGTONNGConfAdapter{}
 }
+
+// ScalarConfAdapter checks if a scalar index can be built.
+type ScalarConfAdapter struct {
+}
+
+// CheckTrain returns false if the params are meant for a vector index.
+func (adapter *ScalarConfAdapter) CheckTrain(params map[string]string) bool {
+	if _, ok := params[Metric]; ok {
+		return false
+	}
+	_, ok := params[DIM]
+	return !ok
+}
+
+func newScalarConfAdapter() *ScalarConfAdapter {
+	return &ScalarConfAdapter{}
+}
@@ -55,6 +55,8 @@ func (mgr *ConfAdapterMgrImpl) registerConfAdapter() {
 	mgr.adapters[IndexRHNSWSQ] = newRHNSWSQConfAdapter()
 	mgr.adapters[IndexNGTPANNG] = newNGTPANNGConfAdapter()
 	mgr.adapters[IndexNGTONNG] = newNGTONNGConfAdapter()
+	mgr.adapters[IndexScalarSort] = newScalarConfAdapter()
+	mgr.adapters[IndexScalarInverted] = newScalarConfAdapter()
 }
 
 func newConfAdapterMgrImpl() *ConfAdapterMgrImpl {
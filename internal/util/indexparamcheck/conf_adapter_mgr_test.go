@@ -118,6 +118,18 @@ func Test_GetConfAdapterMgrInstance(t *testing.T) {
 	assert.NotEqual(t, nil, adapter)
 	_, ok = adapter.(*NGTONNGConfAdapter)
 	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexScalarSort)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*ScalarConfAdapter)
+	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexScalarInverted)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*ScalarConfAdapter)
+	assert.Equal(t, true, ok)
 }
 
 func TestConfAdapterMgrImpl_GetAdapter(t *testing.T) {
@@ -14,6 +14,8 @@ package indexparamcheck
 import (
 	"strconv"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 // TODO: add more test cases which `ConfAdapter.CheckTrain` return false,
@@ -595,3 +597,26 @@ func TestNGTONNGConfAdapter_CheckTrain(t *testing.T) {
 		}
 	}
 }
+
+func TestScalarConfAdapter_CheckTrain(t *testing.T) {
+	cases := []struct {
+		params map[string]string
+		want   bool
+	}{
+		{map[string]string{}, true},
+		{map[string]string{"index_type": IndexScalarSort}, true},
+		{map[string]string{Metric: L2}, false},
+		{map[string]string{DIM: "128"}, false},
+	}
+
+	adapter := newScalarConfAdapter()
+	for _, test := range cases {
+		if got := adapter.CheckTrain(test.params); got != test.want {
+			t.Errorf("ScalarConfAdapter.CheckTrain(%v) = %v", test.params, test.want)
+		}
+	}
+
+	assert.True(t, IsScalarIndexType(IndexScalarSort))
+	assert.True(t, IsScalarIndexType(IndexScalarInverted))
+	assert.False(t, IsScalarIndexType(IndexFaissIvfFlat))
+}
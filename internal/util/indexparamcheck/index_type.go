@@ -31,4 +31,14 @@ const (
 	IndexANNOY           IndexType = "ANNOY"
 	IndexNGTPANNG        IndexType = "NGT_PANNG"
 	IndexNGTONNG         IndexType = "NGT_ONNG"
+
+	// IndexScalarSort keeps the values of a scalar field in sorted order.
+	IndexScalarSort IndexType = "SORT"
+	// IndexScalarInverted maps every distinct value of a scalar field to the rows holding it.
+	IndexScalarInverted IndexType = "INVERTED"
 )
+
+// IsScalarIndexType returns true if the index type can only be built on scalar fields.
+func IsScalarIndexType(indexType IndexType) bool {
+	return indexType == IndexScalarSort || indexType == IndexScalarInverted
+}
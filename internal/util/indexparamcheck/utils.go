@@ -1,8 +1,10 @@
 package indexparamcheck
 
 import (
+	"fmt"
 	"strconv"
 
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 )
 
@@ -39,3 +41,14 @@ func CheckStrByValues(params map[string]string, key string, container []string)
 
 	return funcutil.SliceContain(container, value)
 }
+
+// CheckScalarIndexDataType returns an error if a scalar index can't be built on the data type.
+func CheckScalarIndexDataType(dataType schemapb.DataType) error {
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String:
+		return nil
+	default:
+		return fmt.Errorf("scalar index can't be built on %s field", dataType.String())
+	}
+}
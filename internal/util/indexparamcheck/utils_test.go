@@ -3,6 +3,9 @@ package indexparamcheck
 import (
 	"strconv"
 	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_CheckIntByRange(t *testing.T) {
@@ -85,3 +88,26 @@ func Test_CheckStrByValues(t *testing.T) {
 		}
 	}
 }
+
+func Test_CheckScalarIndexDataType(t *testing.T) {
+	cases := []struct {
+		dataType schemapb.DataType
+		want     bool
+	}{
+		{schemapb.DataType_Int8, true},
+		{schemapb.DataType_Int16, true},
+		{schemapb.DataType_Int32, true},
+		{schemapb.DataType_Int64, true},
+		{schemapb.DataType_Float, true},
+		{schemapb.DataType_Double, true},
+		{schemapb.DataType_Bool, false},
+		{schemapb.DataType_String, true},
+		{schemapb.DataType_FloatVector, false},
+		{schemapb.DataType_BinaryVector, false},
+	}
+
+	for _, test := range cases {
+		err := CheckScalarIndexDataType(test.dataType)
+		assert.Equal(t, test.want, err == nil, test.dataType.String())
+	}
+}
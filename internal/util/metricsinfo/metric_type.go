@@ -25,6 +25,12 @@ const (
 
 	// SystemInfoMetrics means users request for system information metrics.
 	SystemInfoMetrics = "system_info"
+
+	// SlowQueryMetrics means users request for the recent slow queries of proxy.
+	SlowQueryMetrics = "slow_queries"
+
+	// ChannelAssignmentMetrics means users request for the channels assigned to data nodes by data coordinator.
+	ChannelAssignmentMetrics = "channel_assignments"
 )
 
 // ParseMetricType returns the metric type of req
@@ -179,6 +179,26 @@ type DataCoordInfos struct {
 	SystemConfigurations DataCoordConfiguration `json:"system_configurations"`
 }
 
+// ChannelAssignment records a virtual channel watched by data node.
+type ChannelAssignment struct {
+	Name         string `json:"name"`
+	CollectionID int64  `json:"collection_id"`
+}
+
+// NodeChannelAssignments records the channels assigned to a data node.
+type NodeChannelAssignments struct {
+	NodeID   int64               `json:"node_id"`
+	Channels []ChannelAssignment `json:"channels"`
+}
+
+// ChannelAssignments implements ComponentInfos, it records the channels assigned to data nodes by data coordinator,
+// the channels waiting for an online data node are kept in the buffer.
+type ChannelAssignments struct {
+	Name   string                   `json:"name"`
+	Nodes  []NodeChannelAssignments `json:"nodes"`
+	Buffer []ChannelAssignment      `json:"buffer"`
+}
+
 // RootCoordConfiguration records the configuration of root coordinator.
 type RootCoordConfiguration struct {
 	MinSegmentSizeToEnableIndex int64 `json:"min_segment_size_to_enable_index"`
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+package metricsinfo
+
+// QueryNodeSearchCost records where a query node spent the time of a search, the durations are in milliseconds.
+type QueryNodeSearchCost struct {
+	NodeID              int64 `json:"node_id"`
+	WaitTSafeDuration   int64 `json:"wait_tsafe_duration"`
+	SearchDuration      int64 `json:"search_duration"`
+	ReduceDuration      int64 `json:"reduce_duration"`
+	NumSegmentsSearched int64 `json:"num_segments_searched"`
+}
+
+// SlowQuery records a search which took longer than the slow query threshold of proxy, the durations are in
+// milliseconds.
+type SlowQuery struct {
+	TraceID            string                `json:"trace_id"`
+	MsgID              int64                 `json:"msg_id"`
+	Collection         string                `json:"collection"`
+	Partitions         []string              `json:"partitions"`
+	Expr               string                `json:"expr"`
+	NQ                 int64                 `json:"nq"`
+	TopK               int64                 `json:"topk"`
+	GuaranteeTimestamp uint64                `json:"guarantee_timestamp"`
+	StartTime          string                `json:"start_time"`
+	Duration           int64                 `json:"duration"`
+	ReduceDuration     int64                 `json:"reduce_duration"`
+	QueryNodes         []QueryNodeSearchCost `json:"query_nodes"`
+}
+
+// SlowQueries implements ComponentInfos, it lists the recent slow queries of a proxy, the oldest first.
+type SlowQueries struct {
+	Name      string      `json:"name"`
+	Threshold int64       `json:"threshold"`
+	Queries   []SlowQuery `json:"queries"`
+}
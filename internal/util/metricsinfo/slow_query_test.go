@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+package metricsinfo
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlowQueries_Codec(t *testing.T) {
+	queries1 := SlowQueries{
+		Name:      ConstructComponentName(typeutil.ProxyRole, 1),
+		Threshold: 3000,
+		Queries: []SlowQuery{
+			{
+				TraceID:            "4bf92f3577b34da6a3ce929d0e0e4736",
+				MsgID:              1,
+				Collection:         "collection",
+				Partitions:         []string{"_default"},
+				Expr:               "age > 10",
+				NQ:                 10,
+				TopK:               100,
+				GuaranteeTimestamp: 429735887316828161,
+				StartTime:          "2021-11-24 11:37:25",
+				Duration:           3200,
+				ReduceDuration:     5,
+				QueryNodes: []QueryNodeSearchCost{
+					{
+						NodeID:              1,
+						WaitTSafeDuration:   3000,
+						SearchDuration:      150,
+						ReduceDuration:      10,
+						NumSegmentsSearched: 12,
+					},
+				},
+			},
+		},
+	}
+	s, err := MarshalComponentInfos(queries1)
+	assert.Equal(t, nil, err)
+	var queries2 SlowQueries
+	err = UnmarshalComponentInfos(s, &queries2)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, queries1, queries2)
+}
@@ -107,6 +107,22 @@ func (m *DataCoordClient) GetCompactionStateWithPlans(ctx context.Context, req *
 	return &milvuspb.GetCompactionPlansResponse{}, m.Err
 }
 
+func (m *DataCoordClient) SetCompactionPolicy(ctx context.Context, req *milvuspb.SetCompactionPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *DataCoordClient) DryRunCompaction(ctx context.Context, req *milvuspb.DryRunCompactionRequest, opts ...grpc.CallOption) (*milvuspb.DryRunCompactionResponse, error) {
+	return &milvuspb.DryRunCompactionResponse{}, m.Err
+}
+
+func (m *DataCoordClient) GetCompactionHistory(ctx context.Context, req *milvuspb.GetCompactionHistoryRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionHistoryResponse, error) {
+	return &milvuspb.GetCompactionHistoryResponse{}, m.Err
+}
+
+func (m *DataCoordClient) RecoverDroppedSegments(ctx context.Context, req *datapb.RecoverDroppedSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *DataCoordClient) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest, opts ...grpc.CallOption) (*datapb.WatchChannelsResponse, error) {
 	return &datapb.WatchChannelsResponse{}, m.Err
 }
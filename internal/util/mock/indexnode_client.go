@@ -47,6 +47,10 @@ func (m *IndexNodeClient) CreateIndex(ctx context.Context, in *indexpb.CreateInd
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *IndexNodeClient) CancelIndexBuild(ctx context.Context, in *indexpb.CancelIndexBuildRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *IndexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.Err
 }
@@ -71,6 +71,18 @@ func (m *RootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAlia
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *RootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) RecoverCollection(ctx context.Context, in *milvuspb.RecoverCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *RootCoordClient) RecoverPartition(ctx context.Context, in *milvuspb.RecoverPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *RootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	return &milvuspb.ShowCollectionsResponse{}, m.Err
 }
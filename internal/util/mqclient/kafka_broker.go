@@ -0,0 +1,323 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	// KafkaOffsetNewest stands for the offset of the next message produced to a partition
+	KafkaOffsetNewest int64 = -1
+
+	// KafkaOffsetOldest stands for the offset of the earliest message kept by a partition
+	KafkaOffsetOldest int64 = -2
+)
+
+// KafkaRecord is a message stored in a kafka partition
+type KafkaRecord struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Headers   map[string]string
+	Value     []byte
+}
+
+// KafkaPartitionConsumer delivers the records of a partition in order
+type KafkaPartitionConsumer interface {
+	// Records returns the channel of records, it's closed after the consumer is closed
+	Records() <-chan *KafkaRecord
+
+	// Close stops delivering records
+	Close()
+}
+
+// KafkaOffsetManager manages the offset committed by a consumer group on a partition
+type KafkaOffsetManager interface {
+	// NextOffset returns the committed offset of the next record to consume, or KafkaOffsetNewest if the
+	// consumer group has not committed any offset
+	NextOffset() int64
+
+	// MarkOffset commits the offset of the next record to consume, it never moves the offset backward
+	MarkOffset(offset int64)
+
+	// ResetOffset commits the offset of the next record to consume, even if it moves the offset backward
+	ResetOffset(offset int64)
+
+	// Close flushes the offset and releases the partition
+	Close()
+}
+
+// KafkaBroker is the subset of kafka operations used by the kafka client
+type KafkaBroker interface {
+	// CreateTopic creates a topic with a single partition, it does nothing if the topic exists
+	CreateTopic(topic string) error
+
+	// Produce appends a record to a partition and returns its offset
+	Produce(topic string, partition int32, value []byte, headers map[string]string) (int64, error)
+
+	// Consume starts delivering the records of a partition from offset, which could be KafkaOffsetOldest
+	// or KafkaOffsetNewest
+	Consume(topic string, partition int32, offset int64) (KafkaPartitionConsumer, error)
+
+	// GetOffset resolves KafkaOffsetOldest or KafkaOffsetNewest to the offset in a partition
+	GetOffset(topic string, partition int32, position int64) (int64, error)
+
+	// ManageOffset returns the offset manager of a consumer group on a partition
+	ManageOffset(group string, topic string, partition int32) (KafkaOffsetManager, error)
+
+	// Close releases the connections to the kafka cluster
+	Close()
+}
+
+// KafkaBrokerOptions are the options to connect a kafka cluster
+type KafkaBrokerOptions struct {
+	// Brokers are the addresses of the kafka brokers
+	Brokers []string
+
+	// ReplicationFactor is the replication factor of the created topics
+	ReplicationFactor int16
+
+	// MaxMessageBytes is the max size of a produced message, kafka default is used if it's not positive
+	MaxMessageBytes int
+}
+
+var _ KafkaBroker = (*saramaKafkaBroker)(nil)
+
+// saramaKafkaBroker implements KafkaBroker over a kafka cluster with sarama
+type saramaKafkaBroker struct {
+	client            sarama.Client
+	admin             sarama.ClusterAdmin
+	producer          sarama.SyncProducer
+	replicationFactor int16
+
+	mu             sync.Mutex
+	offsetManagers map[string]sarama.OffsetManager
+}
+
+// NewKafkaBroker connects the kafka cluster
+func NewKafkaBroker(opts KafkaBrokerOptions) (KafkaBroker, error) {
+	if len(opts.Brokers) == 0 {
+		return nil, errors.New("kafka brokers are empty")
+	}
+	config := sarama.NewConfig()
+	// message headers are supported since kafka 0.11
+	config.Version = sarama.V2_0_0_0
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Partitioner = sarama.NewManualPartitioner
+	if opts.MaxMessageBytes > 0 {
+		config.Producer.MaxMessageBytes = opts.MaxMessageBytes
+	}
+	// NextOffset of an offset manager returns OffsetNewest if the consumer group has not committed
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+
+	client, err := sarama.NewClient(opts.Brokers, config)
+	if err != nil {
+		log.Error("Failed to connect kafka", zap.Strings("brokers", opts.Brokers), zap.Error(err))
+		return nil, err
+	}
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		admin.Close()
+		return nil, err
+	}
+	replicationFactor := opts.ReplicationFactor
+	if replicationFactor <= 0 {
+		replicationFactor = 1
+	}
+	return &saramaKafkaBroker{
+		client:            client,
+		admin:             admin,
+		producer:          producer,
+		replicationFactor: replicationFactor,
+		offsetManagers:    make(map[string]sarama.OffsetManager),
+	}, nil
+}
+
+func (kb *saramaKafkaBroker) CreateTopic(topic string) error {
+	err := kb.admin.CreateTopic(topic, &sarama.TopicDetail{
+		NumPartitions:     1,
+		ReplicationFactor: kb.replicationFactor,
+	}, false)
+	var topicErr *sarama.TopicError
+	if err != nil && !(errors.As(err, &topicErr) && topicErr.Err == sarama.ErrTopicAlreadyExists) {
+		return err
+	}
+	return kb.client.RefreshMetadata(topic)
+}
+
+func (kb *saramaKafkaBroker) Produce(topic string, partition int32, value []byte, headers map[string]string) (int64, error) {
+	msg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Partition: partition,
+		Value:     sarama.ByteEncoder(value),
+	}
+	for k, v := range headers {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+	}
+	_, offset, err := kb.producer.SendMessage(msg)
+	return offset, err
+}
+
+func (kb *saramaKafkaBroker) Consume(topic string, partition int32, offset int64) (KafkaPartitionConsumer, error) {
+	// a sarama consumer consumes a partition only once, so every partition consumer has its own
+	consumer, err := sarama.NewConsumerFromClient(kb.client)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := consumer.ConsumePartition(topic, partition, offset)
+	if err != nil {
+		consumer.Close()
+		return nil, err
+	}
+	spc := &saramaPartitionConsumer{
+		consumer: consumer,
+		pc:       pc,
+		records:  make(chan *KafkaRecord),
+		closeCh:  make(chan struct{}),
+	}
+	spc.wg.Add(1)
+	go spc.deliver()
+	return spc, nil
+}
+
+func (kb *saramaKafkaBroker) GetOffset(topic string, partition int32, position int64) (int64, error) {
+	return kb.client.GetOffset(topic, partition, position)
+}
+
+func (kb *saramaKafkaBroker) ManageOffset(group string, topic string, partition int32) (KafkaOffsetManager, error) {
+	kb.mu.Lock()
+	defer kb.mu.Unlock()
+	om, ok := kb.offsetManagers[group]
+	if !ok {
+		var err error
+		om, err = sarama.NewOffsetManagerFromClient(group, kb.client)
+		if err != nil {
+			return nil, err
+		}
+		kb.offsetManagers[group] = om
+	}
+	pom, err := om.ManagePartition(topic, partition)
+	if err != nil {
+		return nil, err
+	}
+	return &saramaOffsetManager{pom: pom}, nil
+}
+
+func (kb *saramaKafkaBroker) Close() {
+	if err := kb.producer.Close(); err != nil {
+		log.Warn("Failed to close kafka producer", zap.Error(err))
+	}
+	kb.mu.Lock()
+	for _, om := range kb.offsetManagers {
+		om.Close()
+	}
+	kb.offsetManagers = make(map[string]sarama.OffsetManager)
+	kb.mu.Unlock()
+	// closing the admin closes the client as well
+	if err := kb.admin.Close(); err != nil {
+		log.Warn("Failed to close kafka client", zap.Error(err))
+	}
+}
+
+type saramaPartitionConsumer struct {
+	consumer  sarama.Consumer
+	pc        sarama.PartitionConsumer
+	records   chan *KafkaRecord
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func (spc *saramaPartitionConsumer) deliver() {
+	defer spc.wg.Done()
+	defer close(spc.records)
+	for {
+		select {
+		case msg, ok := <-spc.pc.Messages():
+			if !ok {
+				return
+			}
+			record := &KafkaRecord{
+				Topic:     msg.Topic,
+				Partition: msg.Partition,
+				Offset:    msg.Offset,
+				Value:     msg.Value,
+			}
+			if len(msg.Headers) > 0 {
+				record.Headers = make(map[string]string, len(msg.Headers))
+				for _, header := range msg.Headers {
+					record.Headers[string(header.Key)] = string(header.Value)
+				}
+			}
+			select {
+			case spc.records <- record:
+			case <-spc.closeCh:
+				return
+			}
+		case <-spc.closeCh:
+			return
+		}
+	}
+}
+
+func (spc *saramaPartitionConsumer) Records() <-chan *KafkaRecord {
+	return spc.records
+}
+
+func (spc *saramaPartitionConsumer) Close() {
+	spc.closeOnce.Do(func() {
+		close(spc.closeCh)
+		spc.wg.Wait()
+		if err := spc.pc.Close(); err != nil {
+			log.Warn("Failed to close kafka partition consumer", zap.Error(err))
+		}
+		if err := spc.consumer.Close(); err != nil {
+			log.Warn("Failed to close kafka consumer", zap.Error(err))
+		}
+	})
+}
+
+type saramaOffsetManager struct {
+	pom sarama.PartitionOffsetManager
+}
+
+func (som *saramaOffsetManager) NextOffset() int64 {
+	offset, _ := som.pom.NextOffset()
+	return offset
+}
+
+func (som *saramaOffsetManager) MarkOffset(offset int64) {
+	som.pom.MarkOffset(offset, "")
+}
+
+func (som *saramaOffsetManager) ResetOffset(offset int64) {
+	som.pom.ResetOffset(offset, "")
+}
+
+func (som *saramaOffsetManager) Close() {
+	if err := som.pom.Close(); err != nil {
+		log.Warn("Failed to close kafka offset manager", zap.Error(err))
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var _ Client = (*kafkaClient)(nil)
+
+type kafkaClient struct {
+	broker KafkaBroker
+}
+
+// NewKafkaClient creates a client over broker, the broker is not closed when the client is closed
+func NewKafkaClient(broker KafkaBroker) (*kafkaClient, error) {
+	if broker == nil {
+		return nil, errors.New("kafka broker is nil")
+	}
+	return &kafkaClient{broker: broker}, nil
+}
+
+func (kc *kafkaClient) CreateProducer(options ProducerOptions) (Producer, error) {
+	if err := kc.broker.CreateTopic(options.Topic); err != nil {
+		return nil, err
+	}
+	return &kafkaProducer{broker: kc.broker, topic: options.Topic}, nil
+}
+
+func (kc *kafkaClient) CreateReader(options ReaderOptions) (Reader, error) {
+	if err := kc.broker.CreateTopic(options.Topic); err != nil {
+		return nil, err
+	}
+	kid, ok := options.StartMessageID.(*kafkaID)
+	if !ok {
+		return nil, errors.New("invalid kafka start message id")
+	}
+	offset := kid.offset
+	if offset >= 0 && !options.StartMessageIDInclusive {
+		offset++
+	}
+	reader := &kafkaReader{broker: kc.broker, topic: options.Topic}
+	if err := reader.seek(offset); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (kc *kafkaClient) Subscribe(options ConsumerOptions) (Consumer, error) {
+	if options.SubscriptionName == "" {
+		return nil, errors.New("kafka subscription name is empty")
+	}
+	if err := kc.broker.CreateTopic(options.Topic); err != nil {
+		return nil, err
+	}
+	offsets, err := kc.broker.ManageOffset(options.SubscriptionName, options.Topic, 0)
+	if err != nil {
+		return nil, err
+	}
+	// resume from the committed offset of the subscription
+	offset := offsets.NextOffset()
+	if offset < 0 {
+		if options.SubscriptionInitialPosition == SubscriptionPositionEarliest {
+			offset = KafkaOffsetOldest
+		} else {
+			offset = KafkaOffsetNewest
+		}
+	}
+	pc, err := kc.broker.Consume(options.Topic, 0, offset)
+	if err != nil {
+		offsets.Close()
+		return nil, err
+	}
+	return &kafkaConsumer{
+		broker:           kc.broker,
+		topic:            options.Topic,
+		subscriptionName: options.SubscriptionName,
+		offsets:          offsets,
+		pc:               pc,
+		replaced:         make(chan struct{}),
+		msgChannel:       make(chan Message, 256),
+		closeCh:          make(chan struct{}),
+	}, nil
+}
+
+func (kc *kafkaClient) EarliestMessageID() MessageID {
+	return &kafkaID{offset: KafkaOffsetOldest}
+}
+
+// StringToMsgID parses the message id formatted as topic:partition:offset
+func (kc *kafkaClient) StringToMsgID(id string) (MessageID, error) {
+	s := strings.Split(id, ":")
+	if len(s) < 3 {
+		return nil, fmt.Errorf("invalid kafka message id %s", id)
+	}
+	offset, err := strconv.ParseInt(s[len(s)-1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	partition, err := strconv.ParseInt(s[len(s)-2], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	// topic may contain colons
+	topic := strings.Join(s[:len(s)-2], ":")
+	return &kafkaID{topic: topic, partition: int32(partition), offset: offset}, nil
+}
+
+func (kc *kafkaClient) BytesToMsgID(id []byte) (MessageID, error) {
+	topic, partition, offset, err := DeserializeKafkaID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{topic: topic, partition: partition, offset: offset}, nil
+}
+
+func (kc *kafkaClient) Close() {
+	// the broker is shared by all the clients created by the factory
+}
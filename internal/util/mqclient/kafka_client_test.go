@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func produceKafkaMessages(t *testing.T, producer Producer, n int) []MessageID {
+	ids := make([]MessageID, 0, n)
+	for i := 0; i < n; i++ {
+		id, err := producer.Send(context.TODO(), &ProducerMessage{
+			Payload:    []byte(strconv.Itoa(i)),
+			Properties: map[string]string{"index": strconv.Itoa(i)},
+		})
+		assert.Nil(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consumeKafkaMessage(t *testing.T, consumer Consumer) Message {
+	select {
+	case msg, ok := <-consumer.Chan():
+		assert.True(t, ok)
+		return msg
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "consume kafka message timeout")
+	}
+	return nil
+}
+
+func TestKafkaClient(t *testing.T) {
+	_, err := NewKafkaClient(nil)
+	assert.Error(t, err)
+
+	client, err := NewKafkaClient(NewMemoryKafkaBroker())
+	assert.Nil(t, err)
+	defer client.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{Topic: "TestKafkaClient"})
+	assert.Nil(t, err)
+	defer producer.Close()
+	assert.Equal(t, "TestKafkaClient", producer.(*kafkaProducer).Topic())
+
+	_, err = client.CreateProducer(ProducerOptions{Topic: ""})
+	assert.Error(t, err)
+
+	ids := produceKafkaMessages(t, producer, 2)
+	assert.Equal(t, int64(1), ids[1].EntryID())
+
+	id, err := client.BytesToMsgID(ids[1].Serialize())
+	assert.Nil(t, err)
+	assert.Equal(t, ids[1], id)
+	_, err = client.BytesToMsgID([]byte{1})
+	assert.Error(t, err)
+
+	id, err = client.StringToMsgID("a:b:0:1")
+	assert.Nil(t, err)
+	assert.Equal(t, &kafkaID{topic: "a:b", partition: 0, offset: 1}, id)
+	_, err = client.StringToMsgID("0:1")
+	assert.Error(t, err)
+	_, err = client.StringToMsgID("a:0:b")
+	assert.Error(t, err)
+	_, err = client.StringToMsgID("a:b:1")
+	assert.Error(t, err)
+
+	assert.Equal(t, KafkaOffsetOldest, client.EarliestMessageID().(*kafkaID).offset)
+}
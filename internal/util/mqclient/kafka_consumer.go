@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"errors"
+	"sync"
+)
+
+var _ Consumer = (*kafkaConsumer)(nil)
+
+// kafkaConsumer consumes the single partition of a kafka topic, the subscription name is used as the
+// consumer group whose committed offset is moved by Ack and Seek
+type kafkaConsumer struct {
+	broker           KafkaBroker
+	topic            string
+	subscriptionName string
+	offsets          KafkaOffsetManager
+
+	// pc is replaced by Seek, records of the replaced partition consumer are dropped, and replaced is
+	// closed before pc is replaced to stop forwarding its records
+	seekMu   sync.Mutex
+	mu       sync.Mutex
+	pc       KafkaPartitionConsumer
+	replaced chan struct{}
+
+	msgChannel chan Message
+	closeCh    chan struct{}
+	once       sync.Once
+	closeOnce  sync.Once
+}
+
+func (kc *kafkaConsumer) Subscription() string {
+	return kc.subscriptionName
+}
+
+func (kc *kafkaConsumer) currentPartitionConsumer() KafkaPartitionConsumer {
+	kc.mu.Lock()
+	defer kc.mu.Unlock()
+	return kc.pc
+}
+
+// forward sends the record of pc to msgChannel unless pc is replaced, it returns false if the consumer
+// is closed
+func (kc *kafkaConsumer) forward(pc KafkaPartitionConsumer, record *KafkaRecord) bool {
+	kc.mu.Lock()
+	defer kc.mu.Unlock()
+	if pc != kc.pc {
+		return true
+	}
+	select {
+	case kc.msgChannel <- &kafkaMessage{record: record}:
+	case <-kc.replaced:
+	case <-kc.closeCh:
+		return false
+	}
+	return true
+}
+
+// Chan returns a channel to read messages from kafka
+func (kc *kafkaConsumer) Chan() <-chan Message {
+	kc.once.Do(func() {
+		go func() {
+			defer close(kc.msgChannel)
+			for {
+				pc := kc.currentPartitionConsumer()
+				select {
+				case record, ok := <-pc.Records():
+					if pc != kc.currentPartitionConsumer() {
+						// the partition consumer is replaced by Seek
+						continue
+					}
+					if !ok {
+						return
+					}
+					if !kc.forward(pc, record) {
+						return
+					}
+				case <-kc.closeCh:
+					return
+				}
+			}
+		}()
+	})
+	return kc.msgChannel
+}
+
+// Seek moves the consumer to the position of id, the message of id is consumed only if inclusive is true
+func (kc *kafkaConsumer) Seek(id MessageID, inclusive bool) error {
+	kid, ok := id.(*kafkaID)
+	if !ok {
+		return errors.New("invalid kafka message id")
+	}
+	offset := kid.offset
+	if offset >= 0 && !inclusive {
+		offset++
+	}
+	pc, err := kc.broker.Consume(kc.topic, 0, offset)
+	if err != nil {
+		return err
+	}
+	if offset < 0 {
+		offset, err = kc.broker.GetOffset(kc.topic, 0, offset)
+		if err != nil {
+			pc.Close()
+			return err
+		}
+	}
+	kc.offsets.ResetOffset(offset)
+
+	kc.seekMu.Lock()
+	defer kc.seekMu.Unlock()
+	close(kc.replaced)
+	kc.mu.Lock()
+	old := kc.pc
+	kc.pc = pc
+	kc.replaced = make(chan struct{})
+	// drop the messages received before seeking
+	for drained := false; !drained; {
+		select {
+		case _, ok := <-kc.msgChannel:
+			drained = !ok
+		default:
+			drained = true
+		}
+	}
+	kc.mu.Unlock()
+	old.Close()
+	return nil
+}
+
+// Ack commits the offset of the message next to message
+func (kc *kafkaConsumer) Ack(message Message) {
+	kc.offsets.MarkOffset(message.ID().EntryID() + 1)
+}
+
+// Close is used to free the resources of this consumer
+func (kc *kafkaConsumer) Close() {
+	kc.closeOnce.Do(func() {
+		close(kc.closeCh)
+		kc.currentPartitionConsumer().Close()
+		kc.offsets.Close()
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaConsumer_Subscribe(t *testing.T) {
+	client, err := NewKafkaClient(NewMemoryKafkaBroker())
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := "TestKafkaConsumer_Subscribe"
+	producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	produceKafkaMessages(t, producer, 2)
+
+	_, err = client.Subscribe(ConsumerOptions{Topic: topic})
+	assert.Error(t, err)
+
+	earliest, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "earliest",
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "earliest", earliest.Subscription())
+	latest, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "latest",
+		SubscriptionInitialPosition: SubscriptionPositionLatest,
+	})
+	assert.Nil(t, err)
+	defer latest.Close()
+
+	produceKafkaMessages(t, producer, 3)
+	msg := consumeKafkaMessage(t, earliest)
+	assert.Equal(t, []byte("0"), msg.Payload())
+	assert.Equal(t, topic, msg.Topic())
+	assert.Equal(t, map[string]string{"index": "0"}, msg.Properties())
+	earliest.Ack(msg)
+	msg = consumeKafkaMessage(t, latest)
+	assert.Equal(t, []byte("0"), msg.Payload())
+	assert.Equal(t, int64(2), msg.ID().EntryID())
+
+	// the subscription resumes from the acked message
+	earliest.Close()
+	for range earliest.Chan() {
+	}
+	earliest.Close()
+	resumed, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "earliest",
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer resumed.Close()
+	msg = consumeKafkaMessage(t, resumed)
+	assert.Equal(t, int64(1), msg.ID().EntryID())
+}
+
+func TestKafkaConsumer_Seek(t *testing.T) {
+	client, err := NewKafkaClient(NewMemoryKafkaBroker())
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := "TestKafkaConsumer_Seek"
+	producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	ids := produceKafkaMessages(t, producer, 5)
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: SubscriptionPositionLatest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	assert.Nil(t, consumer.Seek(ids[1], true))
+	msg := consumeKafkaMessage(t, consumer)
+	assert.Equal(t, ids[1].EntryID(), msg.ID().EntryID())
+
+	assert.Nil(t, consumer.Seek(ids[3], false))
+	msg = consumeKafkaMessage(t, consumer)
+	assert.Equal(t, ids[4].EntryID(), msg.ID().EntryID())
+
+	assert.Nil(t, consumer.Seek(client.EarliestMessageID(), false))
+	msg = consumeKafkaMessage(t, consumer)
+	assert.Equal(t, ids[0].EntryID(), msg.ID().EntryID())
+
+	assert.Error(t, consumer.Seek(&rmqID{messageID: 1}, true))
+	assert.Error(t, consumer.Seek(&kafkaID{offset: 10}, true))
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"errors"
+
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+// kafkaID wraps the position of a message in kafka
+type kafkaID struct {
+	topic     string
+	partition int32
+	offset    int64
+}
+
+// Check if kafkaID implements MessageID interface
+var _ MessageID = &kafkaID{}
+
+func (kid *kafkaID) Serialize() []byte {
+	return SerializeKafkaID(kid.topic, kid.partition, kid.offset)
+}
+
+func (kid *kafkaID) LedgerID() int64 {
+	return 0
+}
+
+func (kid *kafkaID) EntryID() int64 {
+	return kid.offset
+}
+
+func (kid *kafkaID) BatchIdx() int32 {
+	return 0
+}
+
+func (kid *kafkaID) PartitionIdx() int32 {
+	return kid.partition
+}
+
+// SerializeKafkaID is used to serialize the position of a kafka message to byte array
+func SerializeKafkaID(topic string, partition int32, offset int64) []byte {
+	b := make([]byte, 12+len(topic))
+	common.Endian.PutUint64(b, uint64(offset))
+	common.Endian.PutUint32(b[8:], uint32(partition))
+	copy(b[12:], topic)
+	return b
+}
+
+// DeserializeKafkaID is used to deserialize the position of a kafka message from byte array
+func DeserializeKafkaID(messageID []byte) (topic string, partition int32, offset int64, err error) {
+	if len(messageID) < 12 {
+		return "", 0, 0, errors.New("invalid kafka message id")
+	}
+	offset = int64(common.Endian.Uint64(messageID))
+	partition = int32(common.Endian.Uint32(messageID[8:]))
+	topic = string(messageID[12:])
+	return topic, partition, offset, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaID_Serialize(t *testing.T) {
+	kid := &kafkaID{
+		topic:     "topic",
+		partition: 1,
+		offset:    8,
+	}
+
+	bin := kid.Serialize()
+	assert.NotNil(t, bin)
+	assert.Equal(t, 12+len("topic"), len(bin))
+
+	assert.Equal(t, int64(0), kid.LedgerID())
+	assert.Equal(t, int64(8), kid.EntryID())
+	assert.Equal(t, int32(0), kid.BatchIdx())
+	assert.Equal(t, int32(1), kid.PartitionIdx())
+}
+
+func Test_DeserializeKafkaID(t *testing.T) {
+	bin := SerializeKafkaID("topic", 2, 5)
+	topic, partition, offset, err := DeserializeKafkaID(bin)
+	assert.Nil(t, err)
+	assert.Equal(t, "topic", topic)
+	assert.Equal(t, int32(2), partition)
+	assert.Equal(t, int64(5), offset)
+
+	_, _, _, err = DeserializeKafkaID(bin[:11])
+	assert.Error(t, err)
+}
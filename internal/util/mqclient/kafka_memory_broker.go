@@ -0,0 +1,227 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"fmt"
+	"sync"
+)
+
+var _ KafkaBroker = (*memoryKafkaBroker)(nil)
+
+// memoryKafkaBroker is an in-process stand-in of a kafka cluster, every topic has a single partition
+// and records are never deleted
+type memoryKafkaBroker struct {
+	mu      sync.Mutex
+	topics  map[string]*memoryKafkaTopic
+	offsets map[string]int64
+}
+
+type memoryKafkaTopic struct {
+	records []*KafkaRecord
+	// notify is closed and replaced when a record is appended
+	notify chan struct{}
+}
+
+// NewMemoryKafkaBroker creates an in-process kafka broker, which is used by tests and standalone tools
+func NewMemoryKafkaBroker() KafkaBroker {
+	return &memoryKafkaBroker{
+		topics:  make(map[string]*memoryKafkaTopic),
+		offsets: make(map[string]int64),
+	}
+}
+
+func (mb *memoryKafkaBroker) getTopic(topic string, partition int32) (*memoryKafkaTopic, error) {
+	t, ok := mb.topics[topic]
+	if !ok {
+		return nil, fmt.Errorf("kafka topic %s does not exist", topic)
+	}
+	if partition != 0 {
+		return nil, fmt.Errorf("kafka topic %s does not have partition %d", topic, partition)
+	}
+	return t, nil
+}
+
+func (mb *memoryKafkaBroker) CreateTopic(topic string) error {
+	if topic == "" {
+		return fmt.Errorf("kafka topic is empty")
+	}
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	if _, ok := mb.topics[topic]; !ok {
+		mb.topics[topic] = &memoryKafkaTopic{notify: make(chan struct{})}
+	}
+	return nil
+}
+
+func (mb *memoryKafkaBroker) Produce(topic string, partition int32, value []byte, headers map[string]string) (int64, error) {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	t, err := mb.getTopic(topic, partition)
+	if err != nil {
+		return 0, err
+	}
+	record := &KafkaRecord{
+		Topic:     topic,
+		Partition: partition,
+		Offset:    int64(len(t.records)),
+		Value:     append([]byte(nil), value...),
+	}
+	if len(headers) > 0 {
+		record.Headers = make(map[string]string, len(headers))
+		for k, v := range headers {
+			record.Headers[k] = v
+		}
+	}
+	t.records = append(t.records, record)
+	close(t.notify)
+	t.notify = make(chan struct{})
+	return record.Offset, nil
+}
+
+func (mb *memoryKafkaBroker) Consume(topic string, partition int32, offset int64) (KafkaPartitionConsumer, error) {
+	mb.mu.Lock()
+	t, err := mb.getTopic(topic, partition)
+	if err != nil {
+		mb.mu.Unlock()
+		return nil, err
+	}
+	switch offset {
+	case KafkaOffsetOldest:
+		offset = 0
+	case KafkaOffsetNewest:
+		offset = int64(len(t.records))
+	}
+	if offset < 0 || offset > int64(len(t.records)) {
+		mb.mu.Unlock()
+		return nil, fmt.Errorf("kafka offset %d is out of range of topic %s", offset, topic)
+	}
+	mb.mu.Unlock()
+
+	pc := &memoryPartitionConsumer{
+		records: make(chan *KafkaRecord),
+		closeCh: make(chan struct{}),
+	}
+	pc.wg.Add(1)
+	go pc.deliver(mb, t, offset)
+	return pc, nil
+}
+
+func (mb *memoryKafkaBroker) GetOffset(topic string, partition int32, position int64) (int64, error) {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	t, err := mb.getTopic(topic, partition)
+	if err != nil {
+		return 0, err
+	}
+	switch position {
+	case KafkaOffsetOldest:
+		return 0, nil
+	case KafkaOffsetNewest:
+		return int64(len(t.records)), nil
+	}
+	return 0, fmt.Errorf("invalid kafka offset position %d", position)
+}
+
+func (mb *memoryKafkaBroker) ManageOffset(group string, topic string, partition int32) (KafkaOffsetManager, error) {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	if _, err := mb.getTopic(topic, partition); err != nil {
+		return nil, err
+	}
+	return &memoryOffsetManager{
+		broker: mb,
+		key:    fmt.Sprintf("%s/%s/%d", group, topic, partition),
+	}, nil
+}
+
+func (mb *memoryKafkaBroker) Close() {
+}
+
+type memoryPartitionConsumer struct {
+	records   chan *KafkaRecord
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func (pc *memoryPartitionConsumer) deliver(mb *memoryKafkaBroker, t *memoryKafkaTopic, offset int64) {
+	defer pc.wg.Done()
+	defer close(pc.records)
+	for {
+		mb.mu.Lock()
+		var record *KafkaRecord
+		if offset < int64(len(t.records)) {
+			record = t.records[offset]
+		}
+		notify := t.notify
+		mb.mu.Unlock()
+
+		if record == nil {
+			select {
+			case <-notify:
+				continue
+			case <-pc.closeCh:
+				return
+			}
+		}
+		select {
+		case pc.records <- record:
+			offset++
+		case <-pc.closeCh:
+			return
+		}
+	}
+}
+
+func (pc *memoryPartitionConsumer) Records() <-chan *KafkaRecord {
+	return pc.records
+}
+
+func (pc *memoryPartitionConsumer) Close() {
+	pc.closeOnce.Do(func() {
+		close(pc.closeCh)
+		pc.wg.Wait()
+	})
+}
+
+type memoryOffsetManager struct {
+	broker *memoryKafkaBroker
+	key    string
+}
+
+func (om *memoryOffsetManager) NextOffset() int64 {
+	om.broker.mu.Lock()
+	defer om.broker.mu.Unlock()
+	offset, ok := om.broker.offsets[om.key]
+	if !ok {
+		return KafkaOffsetNewest
+	}
+	return offset
+}
+
+func (om *memoryOffsetManager) MarkOffset(offset int64) {
+	om.broker.mu.Lock()
+	defer om.broker.mu.Unlock()
+	if committed, ok := om.broker.offsets[om.key]; !ok || committed < offset {
+		om.broker.offsets[om.key] = offset
+	}
+}
+
+func (om *memoryOffsetManager) ResetOffset(offset int64) {
+	om.broker.mu.Lock()
+	defer om.broker.mu.Unlock()
+	om.broker.offsets[om.key] = offset
+}
+
+func (om *memoryOffsetManager) Close() {
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+)
+
+var _ Producer = (*kafkaProducer)(nil)
+
+type kafkaProducer struct {
+	broker KafkaBroker
+	topic  string
+}
+
+func (kp *kafkaProducer) Topic() string {
+	return kp.topic
+}
+
+func (kp *kafkaProducer) Send(ctx context.Context, message *ProducerMessage) (MessageID, error) {
+	offset, err := kp.broker.Produce(kp.topic, 0, message.Payload, message.Properties)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{topic: kp.topic, offset: offset}, nil
+}
+
+func (kp *kafkaProducer) Close() {
+	// the broker is shared by all the producers of the client
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"errors"
+)
+
+var _ Reader = (*kafkaReader)(nil)
+
+type kafkaReader struct {
+	broker KafkaBroker
+	topic  string
+	pc     KafkaPartitionConsumer
+	// next is the offset of the next message returned by Next
+	next int64
+}
+
+func (kr *kafkaReader) Topic() string {
+	return kr.topic
+}
+
+func (kr *kafkaReader) Next(ctx context.Context) (Message, error) {
+	select {
+	case record, ok := <-kr.pc.Records():
+		if !ok {
+			return nil, errors.New("kafka reader is closed")
+		}
+		kr.next = record.Offset + 1
+		return &kafkaMessage{record: record}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (kr *kafkaReader) HasNext() bool {
+	newest, err := kr.broker.GetOffset(kr.topic, 0, KafkaOffsetNewest)
+	if err != nil {
+		return false
+	}
+	return kr.next < newest
+}
+
+// Seek moves the reader to the position of id, the message of id is the next message to read
+func (kr *kafkaReader) Seek(id MessageID) error {
+	kid, ok := id.(*kafkaID)
+	if !ok {
+		return errors.New("invalid kafka message id")
+	}
+	return kr.seek(kid.offset)
+}
+
+// seek moves the reader to offset, which could be KafkaOffsetOldest or KafkaOffsetNewest
+func (kr *kafkaReader) seek(offset int64) error {
+	if offset < 0 {
+		var err error
+		offset, err = kr.broker.GetOffset(kr.topic, 0, offset)
+		if err != nil {
+			return err
+		}
+	}
+	pc, err := kr.broker.Consume(kr.topic, 0, offset)
+	if err != nil {
+		return err
+	}
+	if kr.pc != nil {
+		kr.pc.Close()
+	}
+	kr.pc = pc
+	kr.next = offset
+	return nil
+}
+
+func (kr *kafkaReader) Close() {
+	if kr.pc != nil {
+		kr.pc.Close()
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaReader(t *testing.T) {
+	client, err := NewKafkaClient(NewMemoryKafkaBroker())
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := "TestKafkaReader"
+	producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	ids := produceKafkaMessages(t, producer, 3)
+
+	reader, err := client.CreateReader(ReaderOptions{
+		Topic:          topic,
+		StartMessageID: client.EarliestMessageID(),
+	})
+	assert.Nil(t, err)
+	defer reader.Close()
+	assert.Equal(t, topic, reader.Topic())
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, reader.HasNext())
+		msg, err := reader.Next(context.TODO())
+		assert.Nil(t, err)
+		assert.Equal(t, ids[i].EntryID(), msg.ID().EntryID())
+	}
+	assert.False(t, reader.HasNext())
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	_, err = reader.Next(ctx)
+	assert.Error(t, err)
+
+	assert.Nil(t, reader.Seek(ids[1]))
+	msg, err := reader.Next(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, ids[1].EntryID(), msg.ID().EntryID())
+	assert.Error(t, reader.Seek(&rmqID{messageID: 1}))
+
+	exclusive, err := client.CreateReader(ReaderOptions{
+		Topic:          topic,
+		StartMessageID: ids[1],
+	})
+	assert.Nil(t, err)
+	defer exclusive.Close()
+	msg, err = exclusive.Next(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, ids[2].EntryID(), msg.ID().EntryID())
+
+	_, err = client.CreateReader(ReaderOptions{
+		Topic:          topic,
+		StartMessageID: &rmqID{messageID: 1},
+	})
+	assert.Error(t, err)
+
+	inclusive, err := client.CreateReader(ReaderOptions{
+		Topic:                   topic,
+		StartMessageID:          ids[2],
+		StartMessageIDInclusive: true,
+	})
+	assert.Nil(t, err)
+	defer inclusive.Close()
+	msg, err = inclusive.Next(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, ids[2].EntryID(), msg.ID().EntryID())
+}
@@ -19,10 +19,13 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/rocksmq/client/rocksmq"
+	server "github.com/milvus-io/milvus/internal/util/rocksmq/server/rocksmq"
 )
 
 type rmqClient struct {
 	client rocksmq.Client
+	// remote is the connection to a rocksmq server, it's owned by the client
+	remote rocksmq.RocksMQ
 }
 
 // NewRmqClient returns a new rmqClient object
@@ -35,6 +38,22 @@ func NewRmqClient(opts rocksmq.ClientOptions) (*rmqClient, error) {
 	return &rmqClient{client: c}, nil
 }
 
+// NewRemoteRmqClient returns a rmqClient which accesses the rocksmq server of another process with grpc,
+// the first reachable address is connected
+func NewRemoteRmqClient(addresses []string) (*rmqClient, error) {
+	remote, err := server.NewRemoteRocksMQ(addresses)
+	if err != nil {
+		log.Error("Failed to connect rocksmq server", zap.Strings("addresses", addresses), zap.Error(err))
+		return nil, err
+	}
+	c, err := rocksmq.NewClient(rocksmq.ClientOptions{Server: remote})
+	if err != nil {
+		remote.Close()
+		return nil, err
+	}
+	return &rmqClient{client: c, remote: remote}, nil
+}
+
 // CreateProducer creates a producer for rocksmq client
 func (rc *rmqClient) CreateProducer(options ProducerOptions) (Producer, error) {
 	rmqOpts := rocksmq.ProducerOptions{Topic: options.Topic}
@@ -111,4 +130,8 @@ func (rc *rmqClient) BytesToMsgID(id []byte) (MessageID, error) {
 func (rc *rmqClient) Close() {
 	// TODO(yukun): What to do here?
 	// rc.client.Close()
+	// the remote rocksmq is owned by the client, it's closed by the client as its server
+	if rc.remote != nil {
+		rc.client.Close()
+	}
 }
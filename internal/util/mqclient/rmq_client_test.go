@@ -142,6 +142,44 @@ func TestRmqClient_Subscribe(t *testing.T) {
 	}
 }
 
+func TestRmqClient_Remote(t *testing.T) {
+	_, err := NewRemoteRmqClient(nil)
+	assert.NotNil(t, err)
+
+	address := "127.0.0.1:19541"
+	err = rocksmq1.StartRocksMQService(address)
+	assert.Nil(t, err)
+
+	client, err := NewRemoteRmqClient([]string{address})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := "TestRmqClient_Remote"
+	producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "subName",
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	_, err = producer.Send(context.TODO(), &ProducerMessage{Payload: []byte{1}})
+	assert.Nil(t, err)
+	select {
+	case msg := <-consumer.Chan():
+		consumer.Ack(msg)
+		assert.Equal(t, []byte{1}, msg.Payload())
+		assert.Equal(t, topic, msg.Topic())
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "message is not received from the rocksmq server")
+	}
+}
+
 func TestRmqClient_EarliestMessageID(t *testing.T) {
 	opts := rocksmq.ClientOptions{}
 	client, _ := NewRmqClient(opts)
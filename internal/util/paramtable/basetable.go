@@ -169,6 +169,12 @@ func (gp *BaseTable) tryloadFromEnv() {
 	}
 	gp.Save("_PulsarAddress", pulsarAddress)
 
+	kafkaBrokerList := os.Getenv("KAFKA_BROKER_LIST")
+	if kafkaBrokerList == "" {
+		kafkaBrokerList = gp.LoadWithDefault("kafka.brokerList", "")
+	}
+	gp.Save("_KafkaBrokerList", kafkaBrokerList)
+
 	rocksmqPath := os.Getenv("ROCKSMQ_PATH")
 	if rocksmqPath == "" {
 		path, err := gp.Load("rocksmq.path")
@@ -179,6 +185,36 @@ func (gp *BaseTable) tryloadFromEnv() {
 	}
 	gp.Save("_RocksmqPath", rocksmqPath)
 
+	rocksmqServerAddress := os.Getenv("ROCKSMQ_SERVER_ADDRESS")
+	if rocksmqServerAddress == "" {
+		rocksmqServerAddress = gp.LoadWithDefault("rocksmq.server.address", "")
+	}
+	gp.Save("_RocksmqServerAddress", rocksmqServerAddress)
+
+	rocksmqRaftNodeID := os.Getenv("ROCKSMQ_RAFT_NODE_ID")
+	if rocksmqRaftNodeID == "" {
+		rocksmqRaftNodeID = gp.LoadWithDefault("rocksmq.raft.nodeID", "0")
+	}
+	gp.Save("_RocksmqRaftNodeID", rocksmqRaftNodeID)
+
+	rocksmqRaftPeers := os.Getenv("ROCKSMQ_RAFT_PEERS")
+	if rocksmqRaftPeers == "" {
+		rocksmqRaftPeers = gp.LoadWithDefault("rocksmq.raft.peers", "")
+	}
+	gp.Save("_RocksmqRaftPeers", rocksmqRaftPeers)
+
+	traceExporter := os.Getenv("TRACE_EXPORTER")
+	if traceExporter == "" {
+		traceExporter = gp.LoadWithDefault("trace.exporter", "none")
+	}
+	gp.Save("_TraceExporter", traceExporter)
+
+	traceOTLPEndpoint := os.Getenv("TRACE_OTLP_ENDPOINT")
+	if traceOTLPEndpoint == "" {
+		traceOTLPEndpoint = gp.LoadWithDefault("trace.otlp.endpoint", "localhost:4317")
+	}
+	gp.Save("_TraceOTLPEndpoint", traceOTLPEndpoint)
+
 	insertBufferFlushSize := os.Getenv("DATA_NODE_IBUFSIZE")
 	if insertBufferFlushSize == "" {
 		insertBufferFlushSize = gp.LoadWithDefault("datanode.flush.insertBufSize", "16777216")
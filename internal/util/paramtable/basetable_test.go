@@ -286,3 +286,20 @@ func Test_SetLogger(t *testing.T) {
 		assert.Equal(t, "datanode-0.log", baseParams.Log.File.Filename)
 	})
 }
+
+func TestBaseTable_LoadStorageConfig(t *testing.T) {
+	cfg := baseParams.LoadStorageConfig()
+	assert.Equal(t, StorageMinIO, cfg.Type)
+	assert.NotEqual(t, "", cfg.LocalPath)
+
+	baseParams.Save("storage.type", StorageAzure)
+	baseParams.Save("storage.azure.accountName", "devstoreaccount1")
+	defer baseParams.Remove("storage.type")
+	defer baseParams.Remove("storage.azure.accountName")
+	cfg = baseParams.LoadStorageConfig()
+	assert.Equal(t, StorageAzure, cfg.Type)
+	assert.Equal(t, "devstoreaccount1", cfg.AzureAccountName)
+
+	baseParams.Save("storage.type", "s4")
+	assert.Panics(t, func() { baseParams.LoadStorageConfig() })
+}
@@ -12,7 +12,9 @@
 package paramtable
 
 import (
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -40,6 +42,29 @@ type BaseParamTable struct {
 	EtcdConfigPath string
 	EtcdDataDir    string
 
+	// --- Kafka ---
+	KafkaBrokerList        []string
+	KafkaReplicationFactor int16
+	KafkaMaxMessageSize    int
+
+	// --- Remote RocksMQ ---
+	RocksmqServerAddress string
+
+	// --- Replicated RocksMQ ---
+	RocksmqRaftNodeID  uint64
+	RocksmqRaftPeers   map[uint64]string
+	RocksmqRaftDataDir string
+
+	// --- Trace ---
+	TraceExporter       string
+	TraceSampleFraction float64
+	TraceOTLPEndpoint   string
+	TraceOTLPInsecure   bool
+
+	// --- Management ---
+	ManagementEnabled        bool
+	ManagementActionsEnabled bool
+
 	initOnce sync.Once
 
 	LogConfig *log.Config
@@ -59,6 +84,11 @@ func (p *BaseParamTable) LoadCfgToMemory() {
 	p.initEtcdConf()
 	p.initMetaRootPath()
 	p.initKvRootPath()
+	p.initKafkaConf()
+	p.initRocksmqServerAddress()
+	p.initRocksmqRaftConf()
+	p.initTraceConf()
+	p.initManagementConf()
 	p.initLogCfg()
 }
 
@@ -121,6 +151,69 @@ func (p *BaseParamTable) initKvRootPath() {
 	p.KvRootPath = rootPath + "/" + subPath
 }
 
+func (p *BaseParamTable) initKafkaConf() {
+	p.KafkaBrokerList = nil
+	brokerList := p.LoadWithDefault("_KafkaBrokerList", "")
+	for _, broker := range strings.Split(brokerList, ",") {
+		if broker = strings.TrimSpace(broker); broker != "" {
+			p.KafkaBrokerList = append(p.KafkaBrokerList, broker)
+		}
+	}
+	p.KafkaReplicationFactor = int16(p.ParseIntWithDefault("kafka.replicationFactor", 1))
+	p.KafkaMaxMessageSize = p.ParseIntWithDefault("kafka.maxMessageSize", 5242880)
+}
+
+func (p *BaseParamTable) initRocksmqServerAddress() {
+	p.RocksmqServerAddress = strings.TrimSpace(p.LoadWithDefault("_RocksmqServerAddress", ""))
+}
+
+// initRocksmqRaftConf parses the raft group of the replicated rocksmq, peers are listed as
+// "id=address" separated by commas
+func (p *BaseParamTable) initRocksmqRaftConf() {
+	nodeID, err := strconv.ParseUint(p.LoadWithDefault("_RocksmqRaftNodeID", "0"), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	p.RocksmqRaftNodeID = nodeID
+
+	p.RocksmqRaftPeers = make(map[uint64]string)
+	peers := p.LoadWithDefault("_RocksmqRaftPeers", "")
+	for _, peer := range strings.Split(peers, ",") {
+		if peer = strings.TrimSpace(peer); peer == "" {
+			continue
+		}
+		kv := strings.SplitN(peer, "=", 2)
+		if len(kv) != 2 {
+			panic(fmt.Errorf("invalid rocksmq raft peer %s", peer))
+		}
+		id, err := strconv.ParseUint(strings.TrimSpace(kv[0]), 10, 64)
+		if err != nil || id == 0 {
+			panic(fmt.Errorf("invalid rocksmq raft peer id %s", kv[0]))
+		}
+		p.RocksmqRaftPeers[id] = strings.TrimSpace(kv[1])
+	}
+
+	p.RocksmqRaftDataDir = p.LoadWithDefault("rocksmq.raft.dataDir", "/var/lib/milvus/rdb_raft")
+}
+
+func (p *BaseParamTable) initTraceConf() {
+	p.TraceExporter = strings.ToLower(strings.TrimSpace(p.LoadWithDefault("_TraceExporter", "none")))
+	switch p.TraceExporter {
+	case "", "none", "stdout", "otlp":
+	default:
+		panic(fmt.Errorf("invalid trace exporter %s", p.TraceExporter))
+	}
+	p.TraceSampleFraction = p.ParseFloatWithDefault("trace.sampleFraction", 1)
+	p.TraceOTLPEndpoint = p.LoadWithDefault("_TraceOTLPEndpoint", "localhost:4317")
+	p.TraceOTLPInsecure = p.ParseBool("trace.otlp.insecure", true)
+}
+
+func (p *BaseParamTable) initManagementConf() {
+	p.ManagementEnabled = p.ParseBool("management.enabled", false)
+	// actions change the cluster, so they are never served without the read-only views
+	p.ManagementActionsEnabled = p.ManagementEnabled && p.ParseBool("management.actions.enabled", false)
+}
+
 func (p *BaseParamTable) initLogCfg() {
 	p.LogConfig = &log.Config{}
 	format, err := p.Load("log.format")
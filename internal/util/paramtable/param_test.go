@@ -32,6 +32,59 @@ func TestBaseParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.KvRootPath, "")
 	t.Logf("kv root path = %s", Params.KvRootPath)
 
+	assert.Zero(t, len(Params.KafkaBrokerList))
+	assert.Equal(t, int16(1), Params.KafkaReplicationFactor)
+	Params.Save("_KafkaBrokerList", "localhost:9092, localhost:9093,")
+	Params.initKafkaConf()
+	assert.Equal(t, []string{"localhost:9092", "localhost:9093"}, Params.KafkaBrokerList)
+	Params.Save("_KafkaBrokerList", "")
+	Params.initKafkaConf()
+
+	assert.Equal(t, "", Params.RocksmqServerAddress)
+	Params.Save("_RocksmqServerAddress", " localhost:19540 ")
+	Params.initRocksmqServerAddress()
+	assert.Equal(t, "localhost:19540", Params.RocksmqServerAddress)
+	Params.Save("_RocksmqServerAddress", "")
+	Params.initRocksmqServerAddress()
+
+	assert.Zero(t, Params.RocksmqRaftNodeID)
+	assert.Zero(t, len(Params.RocksmqRaftPeers))
+	Params.Save("_RocksmqRaftNodeID", "2")
+	Params.Save("_RocksmqRaftPeers", "1=host1:19530, 2=host2:19530,")
+	Params.initRocksmqRaftConf()
+	assert.Equal(t, uint64(2), Params.RocksmqRaftNodeID)
+	assert.Equal(t, map[uint64]string{1: "host1:19530", 2: "host2:19530"}, Params.RocksmqRaftPeers)
+	Params.Save("_RocksmqRaftPeers", "host1:19530")
+	assert.Panics(t, func() { Params.initRocksmqRaftConf() })
+	Params.Save("_RocksmqRaftNodeID", "0")
+	Params.Save("_RocksmqRaftPeers", "")
+	Params.initRocksmqRaftConf()
+
+	assert.Equal(t, "none", Params.TraceExporter)
+	assert.Equal(t, float64(1), Params.TraceSampleFraction)
+	assert.Equal(t, "localhost:4317", Params.TraceOTLPEndpoint)
+	assert.True(t, Params.TraceOTLPInsecure)
+	Params.Save("_TraceExporter", "OTLP")
+	Params.initTraceConf()
+	assert.Equal(t, "otlp", Params.TraceExporter)
+	Params.Save("_TraceExporter", "jaeger")
+	assert.Panics(t, func() { Params.initTraceConf() })
+	Params.Save("_TraceExporter", "none")
+	Params.initTraceConf()
+
+	assert.False(t, Params.ManagementEnabled)
+	assert.False(t, Params.ManagementActionsEnabled)
+	Params.Save("management.actions.enabled", "true")
+	Params.initManagementConf()
+	assert.False(t, Params.ManagementActionsEnabled)
+	Params.Save("management.enabled", "true")
+	Params.initManagementConf()
+	assert.True(t, Params.ManagementEnabled)
+	assert.True(t, Params.ManagementActionsEnabled)
+	Params.Save("management.enabled", "false")
+	Params.Save("management.actions.enabled", "false")
+	Params.initManagementConf()
+
 	// test UseEmbedEtcd
 	Params.Save("etcd.use.embed", "true")
 	assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode))
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package paramtable
+
+import "fmt"
+
+// Types of the storage binlogs and index files are kept in.
+const (
+	StorageMinIO = "minio"
+	StorageLocal = "local"
+	StorageAzure = "azure"
+	StorageGCS   = "gcs"
+)
+
+// StorageConfig is the storage section of the configuration. The bucket name is
+// shared by all remote storages and read from minio.bucketName.
+type StorageConfig struct {
+	Type string
+
+	// --- Local ---
+	LocalPath string
+
+	// --- Azure Blob ---
+	AzureEndpoint    string
+	AzureAccountName string
+	AzureAccountKey  string
+
+	// --- Google Cloud Storage ---
+	GCSEndpoint        string
+	GCSCredentialsFile string
+}
+
+// LoadStorageConfig loads the storage section, it panics if the storage type is unknown.
+func (gp *BaseTable) LoadStorageConfig() StorageConfig {
+	cfg := StorageConfig{
+		Type:               gp.LoadWithDefault("storage.type", StorageMinIO),
+		LocalPath:          gp.LoadWithDefault("storage.local.path", "/var/lib/milvus/storage"),
+		AzureEndpoint:      gp.LoadWithDefault("storage.azure.endpoint", ""),
+		AzureAccountName:   gp.LoadWithDefault("storage.azure.accountName", ""),
+		AzureAccountKey:    gp.LoadWithDefault("storage.azure.accountKey", ""),
+		GCSEndpoint:        gp.LoadWithDefault("storage.gcs.endpoint", ""),
+		GCSCredentialsFile: gp.LoadWithDefault("storage.gcs.credentialsFile", ""),
+	}
+	switch cfg.Type {
+	case StorageMinIO, StorageLocal, StorageAzure, StorageGCS:
+	default:
+		panic(fmt.Sprintf("unsupported storage type %s", cfg.Type))
+	}
+	return cfg
+}
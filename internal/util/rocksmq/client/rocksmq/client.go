@@ -21,7 +21,7 @@ type RocksMQ = server.RocksMQ
 // NewClient returns a rocksmq client
 func NewClient(options ClientOptions) (Client, error) {
 	if options.Server == nil {
-		options.Server = server.Rmq
+		options.Server = server.GetRocksMQ()
 	}
 	return newClient(options)
 }
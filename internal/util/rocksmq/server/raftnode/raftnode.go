@@ -0,0 +1,535 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package raftnode
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.etcd.io/etcd/raft/v3"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+	"go.etcd.io/etcd/server/v3/wal"
+	"go.etcd.io/etcd/server/v3/wal/walpb"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// ErrStopped is returned by Propose if the node is stopped
+var ErrStopped = errors.New("raft node is stopped")
+
+// StateMachine is the replicated state of a raft group, it should be durable by itself, because the
+// raft log is compacted without taking data snapshots. The log is only compacted up to the index which
+// every node of the group has replicated, so a node that restarts or lags behind always catches up
+// from the log.
+type StateMachine interface {
+	// Apply applies a committed command, commands are applied in the order of index. data is nil for
+	// the raft internal entries, which only advance the applied index.
+	Apply(index uint64, data []byte) (interface{}, error)
+
+	// AppliedIndex returns the index of the last applied command, commands at or before it
+	// are not applied again when the node restarts
+	AppliedIndex() uint64
+}
+
+// Transport sends raft messages to the other nodes of the group
+type Transport interface {
+	Send(msgs []raftpb.Message)
+}
+
+// Options are the options of a raft node
+type Options struct {
+	// ID is the id of this node, it must be one of Peers
+	ID uint64
+	// Peers are the ids of all the nodes of the group, the group membership is static
+	Peers []uint64
+	// DataDir is the directory of the raft log and snapshots
+	DataDir string
+
+	TickInterval  time.Duration
+	ElectionTick  int
+	HeartbeatTick int
+
+	// SnapshotCount is the number of compactable commands that triggers a log compaction
+	SnapshotCount uint64
+	// CatchUpEntries is the number of applied commands kept after a log compaction
+	CatchUpEntries uint64
+}
+
+const (
+	defaultTickInterval   = 100 * time.Millisecond
+	defaultElectionTick   = 10
+	defaultHeartbeatTick  = 1
+	defaultSnapshotCount  = 10000
+	defaultCatchUpEntries = 50000
+
+	// compactRequestID is the request id of the entries carrying a compact index, the ids of the
+	// proposals never collide with it
+	compactRequestID      = 0
+	compactProposeTimeout = 10 * time.Second
+)
+
+func (opts *Options) fillDefaults() {
+	if opts.TickInterval <= 0 {
+		opts.TickInterval = defaultTickInterval
+	}
+	if opts.ElectionTick <= 0 {
+		opts.ElectionTick = defaultElectionTick
+	}
+	if opts.HeartbeatTick <= 0 {
+		opts.HeartbeatTick = defaultHeartbeatTick
+	}
+	if opts.SnapshotCount == 0 {
+		opts.SnapshotCount = defaultSnapshotCount
+	}
+	if opts.CatchUpEntries == 0 {
+		opts.CatchUpEntries = defaultCatchUpEntries
+	}
+}
+
+type proposeResult struct {
+	result interface{}
+	err    error
+}
+
+// Node is a member of a raft group which replicates the commands of a StateMachine
+type Node struct {
+	opts      Options
+	sm        StateMachine
+	transport Transport
+
+	node        raft.Node
+	storage     *raft.MemoryStorage
+	wal         *wal.WAL
+	snapshotter *snap.Snapshotter
+
+	confState     raftpb.ConfState
+	snapshotIndex uint64
+	appliedIndex  uint64
+	// compactIndex is the index replicated to every node, the log is never compacted past it
+	compactIndex uint64
+	// proposedCompactIndex is the last compact index proposed by this node as the leader
+	proposedCompactIndex uint64
+
+	reqID   uint64
+	waitMu  sync.Mutex
+	waiters map[uint64]chan proposeResult
+
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewNode creates a raft node, the raft log in DataDir is replayed if it exists
+func NewNode(opts Options, sm StateMachine, transport Transport) (*Node, error) {
+	opts.fillDefaults()
+	if opts.ID == 0 {
+		return nil, errors.New("raft node id must not be 0")
+	}
+	isPeer := false
+	for _, id := range opts.Peers {
+		if id == opts.ID {
+			isPeer = true
+		}
+	}
+	if !isPeer {
+		return nil, fmt.Errorf("raft node %d is not one of the peers %v", opts.ID, opts.Peers)
+	}
+
+	walDir := path.Join(opts.DataDir, "wal")
+	snapDir := path.Join(opts.DataDir, "snap")
+	if err := os.MkdirAll(snapDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	n := &Node{
+		opts:        opts,
+		sm:          sm,
+		transport:   transport,
+		storage:     raft.NewMemoryStorage(),
+		snapshotter: snap.New(log.L(), snapDir),
+		// request ids of different nodes and different runs never collide in practice
+		reqID:   opts.ID<<48 | uint64(time.Now().UnixNano()/int64(time.Millisecond))<<8&(1<<48-1),
+		waiters: make(map[uint64]chan proposeResult),
+		stopCh:  make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+	config := &raft.Config{
+		ID:              opts.ID,
+		ElectionTick:    opts.ElectionTick,
+		HeartbeatTick:   opts.HeartbeatTick,
+		Storage:         n.storage,
+		MaxSizePerMsg:   1024 * 1024,
+		MaxInflightMsgs: 256,
+		CheckQuorum:     true,
+		PreVote:         true,
+		Logger:          etcdserver.NewRaftLoggerZap(log.L().With(zap.Uint64("raftNodeID", opts.ID))),
+	}
+
+	if wal.Exist(walDir) {
+		if err := n.replayWAL(walDir); err != nil {
+			return nil, err
+		}
+		// the entries after the snapshot are delivered again to restore the membership, and the
+		// commands already applied by the state machine are skipped
+		n.node = raft.RestartNode(config)
+		log.Debug("raft node restarted", zap.Uint64("id", opts.ID), zap.Uint64("snapshot", n.snapshotIndex),
+			zap.Uint64("applied", sm.AppliedIndex()))
+		return n, nil
+	}
+
+	w, err := wal.Create(log.L(), walDir, nil)
+	if err != nil {
+		return nil, err
+	}
+	n.wal = w
+	peers := make([]raft.Peer, 0, len(opts.Peers))
+	for _, id := range opts.Peers {
+		peers = append(peers, raft.Peer{ID: id})
+	}
+	n.node = raft.StartNode(config, peers)
+	log.Debug("raft node started", zap.Uint64("id", opts.ID), zap.Uint64s("peers", opts.Peers))
+	return n, nil
+}
+
+func (n *Node) replayWAL(walDir string) error {
+	walSnaps, err := wal.ValidSnapshotEntries(log.L(), walDir)
+	if err != nil {
+		return err
+	}
+	snapshot, err := n.snapshotter.LoadNewestAvailable(walSnaps)
+	if err != nil && err != snap.ErrNoSnapshot {
+		return err
+	}
+	walSnap := walpb.Snapshot{}
+	if snapshot != nil {
+		walSnap.Index, walSnap.Term = snapshot.Metadata.Index, snapshot.Metadata.Term
+		if err := n.storage.ApplySnapshot(*snapshot); err != nil {
+			return err
+		}
+		n.confState = snapshot.Metadata.ConfState
+		n.snapshotIndex = snapshot.Metadata.Index
+		n.appliedIndex = snapshot.Metadata.Index
+		n.compactIndex = snapshot.Metadata.Index
+	}
+
+	w, err := wal.Open(log.L(), walDir, walSnap)
+	if err != nil {
+		return err
+	}
+	_, hardState, entries, err := w.ReadAll()
+	if err != nil {
+		w.Close()
+		return err
+	}
+	n.wal = w
+	if err := n.storage.SetHardState(hardState); err != nil {
+		return err
+	}
+	if err := n.storage.Append(entries); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Start starts ticking and processing the raft log
+func (n *Node) Start() {
+	go n.run()
+}
+
+// Stop stops the node and fails the pending proposals
+func (n *Node) Stop() {
+	n.stopOnce.Do(func() {
+		close(n.stopCh)
+		<-n.doneCh
+	})
+}
+
+// ID returns the id of this node
+func (n *Node) ID() uint64 {
+	return n.opts.ID
+}
+
+// Leader returns the id of the current leader, 0 if there is no leader
+func (n *Node) Leader() uint64 {
+	return n.node.Status().Lead
+}
+
+// Step delivers a raft message received from another node
+func (n *Node) Step(ctx context.Context, msg raftpb.Message) error {
+	return n.node.Step(ctx, msg)
+}
+
+// ReportUnreachable reports that the node id could not be reached by the transport
+func (n *Node) ReportUnreachable(id uint64) {
+	n.node.ReportUnreachable(id)
+}
+
+// Propose replicates data to the group and returns the result of applying it on this node
+func (n *Node) Propose(ctx context.Context, data []byte) (interface{}, error) {
+	reqID := atomic.AddUint64(&n.reqID, 1)
+	ch := make(chan proposeResult, 1)
+	n.waitMu.Lock()
+	n.waiters[reqID] = ch
+	n.waitMu.Unlock()
+	defer func() {
+		n.waitMu.Lock()
+		delete(n.waiters, reqID)
+		n.waitMu.Unlock()
+	}()
+
+	entry := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint64(entry, reqID)
+	copy(entry[8:], data)
+	if err := n.node.Propose(ctx, entry); err != nil {
+		if err == raft.ErrStopped {
+			return nil, ErrStopped
+		}
+		return nil, err
+	}
+	select {
+	case res := <-ch:
+		return res.result, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-n.doneCh:
+		return nil, ErrStopped
+	}
+}
+
+func (n *Node) run() {
+	defer close(n.doneCh)
+	defer n.wal.Close()
+	defer n.node.Stop()
+
+	ticker := time.NewTicker(n.opts.TickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			n.node.Tick()
+		case rd := <-n.node.Ready():
+			if err := n.handleReady(rd); err != nil {
+				log.Error("raft node stopped", zap.Uint64("id", n.opts.ID), zap.Error(err))
+				return
+			}
+			n.node.Advance()
+		case <-n.stopCh:
+			return
+		}
+	}
+}
+
+func (n *Node) handleReady(rd raft.Ready) error {
+	if !raft.IsEmptySnap(rd.Snapshot) {
+		if err := n.installSnapshot(rd.Snapshot); err != nil {
+			return err
+		}
+	}
+	if err := n.wal.Save(rd.HardState, rd.Entries); err != nil {
+		return err
+	}
+	if err := n.storage.Append(rd.Entries); err != nil {
+		return err
+	}
+	n.transport.Send(rd.Messages)
+	for _, msg := range rd.Messages {
+		if msg.Type == raftpb.MsgSnap {
+			// snapshots carry no data, the follower decides whether it could accept it
+			n.node.ReportSnapshot(msg.To, raft.SnapshotFinish)
+		}
+	}
+	if err := n.applyEntries(rd.CommittedEntries); err != nil {
+		return err
+	}
+	return n.maybeCompact()
+}
+
+// installSnapshot accepts a snapshot only if the state machine has already applied it, because the
+// state machine data is not transferred by snapshots. The log is never compacted past the other nodes,
+// so snapshots are only sent to a node whose raft log was lost.
+func (n *Node) installSnapshot(snapshot raftpb.Snapshot) error {
+	if snapshot.Metadata.Index > n.sm.AppliedIndex() {
+		return fmt.Errorf("raft node %d fell behind the compacted raft log (snapshot index %d, applied index %d), "+
+			"its data should be rebuilt from a healthy replica", n.opts.ID, snapshot.Metadata.Index, n.sm.AppliedIndex())
+	}
+	if err := n.saveSnapshot(snapshot); err != nil {
+		return err
+	}
+	if err := n.storage.ApplySnapshot(snapshot); err != nil {
+		return err
+	}
+	n.confState = snapshot.Metadata.ConfState
+	n.snapshotIndex = snapshot.Metadata.Index
+	if n.appliedIndex < snapshot.Metadata.Index {
+		n.appliedIndex = snapshot.Metadata.Index
+	}
+	return nil
+}
+
+func (n *Node) saveSnapshot(snapshot raftpb.Snapshot) error {
+	walSnap := walpb.Snapshot{
+		Index:     snapshot.Metadata.Index,
+		Term:      snapshot.Metadata.Term,
+		ConfState: &snapshot.Metadata.ConfState,
+	}
+	// the snapshot must be saved before the wal to be found when replaying the wal
+	if err := n.snapshotter.SaveSnap(snapshot); err != nil {
+		return err
+	}
+	if err := n.wal.SaveSnapshot(walSnap); err != nil {
+		return err
+	}
+	return n.wal.ReleaseLockTo(snapshot.Metadata.Index)
+}
+
+func (n *Node) applyEntries(entries []raftpb.Entry) error {
+	smApplied := n.sm.AppliedIndex()
+	for _, entry := range entries {
+		if entry.Index <= n.appliedIndex {
+			continue
+		}
+		// the leader appends an empty entry when it's elected
+		if entry.Type == raftpb.EntryNormal && len(entry.Data) >= 8 {
+			reqID := binary.BigEndian.Uint64(entry.Data)
+			if reqID == compactRequestID {
+				n.applyCompactIndex(entry.Data[8:])
+			}
+			if entry.Index <= smApplied {
+				n.appliedIndex = entry.Index
+				continue
+			}
+			if reqID == compactRequestID {
+				if _, err := n.sm.Apply(entry.Index, nil); err != nil {
+					return err
+				}
+				n.appliedIndex = entry.Index
+				continue
+			}
+			result, err := n.sm.Apply(entry.Index, entry.Data[8:])
+			n.waitMu.Lock()
+			if ch, ok := n.waiters[reqID]; ok {
+				ch <- proposeResult{result: result, err: err}
+			}
+			n.waitMu.Unlock()
+		} else {
+			if entry.Type == raftpb.EntryConfChange {
+				var cc raftpb.ConfChange
+				if err := cc.Unmarshal(entry.Data); err != nil {
+					return err
+				}
+				n.confState = *n.node.ApplyConfChange(cc)
+			}
+			if entry.Index > smApplied {
+				if _, err := n.sm.Apply(entry.Index, nil); err != nil {
+					return err
+				}
+			}
+		}
+		n.appliedIndex = entry.Index
+	}
+	return nil
+}
+
+// applyCompactIndex raises the compact index agreed by the group
+func (n *Node) applyCompactIndex(data []byte) {
+	if len(data) != 8 {
+		return
+	}
+	if index := binary.BigEndian.Uint64(data); index > n.compactIndex {
+		n.compactIndex = index
+	}
+}
+
+// maybeProposeCompactIndex proposes the index replicated to every node as the new compact index if it's
+// the leader. The index is replicated in the log, so that the followers, which don't know the progress
+// of the other nodes, never compact their logs past it either.
+func (n *Node) maybeProposeCompactIndex() {
+	proposed := n.compactIndex
+	if n.proposedCompactIndex > proposed {
+		proposed = n.proposedCompactIndex
+	}
+	if n.appliedIndex <= proposed+n.opts.SnapshotCount {
+		return
+	}
+	status := n.node.Status()
+	if status.RaftState != raft.StateLeader {
+		return
+	}
+	minMatch := n.appliedIndex
+	for _, id := range n.opts.Peers {
+		pr, ok := status.Progress[id]
+		if !ok {
+			return
+		}
+		if pr.Match < minMatch {
+			minMatch = pr.Match
+		}
+	}
+	if minMatch <= proposed+n.opts.SnapshotCount {
+		return
+	}
+	n.proposedCompactIndex = minMatch
+
+	entry := make([]byte, 16)
+	binary.BigEndian.PutUint64(entry, compactRequestID)
+	binary.BigEndian.PutUint64(entry[8:], minMatch)
+	// the run loop must not wait for its own proposal
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), compactProposeTimeout)
+		defer cancel()
+		if err := n.node.Propose(ctx, entry); err != nil {
+			log.Warn("raft node failed to propose compact index", zap.Uint64("id", n.opts.ID),
+				zap.Uint64("index", minMatch), zap.Error(err))
+		}
+	}()
+}
+
+func (n *Node) maybeCompact() error {
+	n.maybeProposeCompactIndex()
+
+	compactIndex := n.compactIndex
+	if n.appliedIndex <= n.opts.CatchUpEntries {
+		return nil
+	}
+	if keepFrom := n.appliedIndex - n.opts.CatchUpEntries; keepFrom < compactIndex {
+		compactIndex = keepFrom
+	}
+	if compactIndex <= n.snapshotIndex+n.opts.SnapshotCount {
+		return nil
+	}
+	// the snapshot is taken at the compact index, so that the log replayed on restart still holds
+	// the entries which the other nodes may need
+	snapshot, err := n.storage.CreateSnapshot(compactIndex, &n.confState, nil)
+	if err != nil {
+		return err
+	}
+	if err := n.saveSnapshot(snapshot); err != nil {
+		return err
+	}
+	n.snapshotIndex = compactIndex
+	if err := n.storage.Compact(compactIndex); err != nil && err != raft.ErrCompacted {
+		return err
+	}
+	log.Debug("raft log compacted", zap.Uint64("id", n.opts.ID), zap.Uint64("index", compactIndex))
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package raftnode
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+)
+
+// testStateMachine appends the commands to a slice
+type testStateMachine struct {
+	mu       sync.Mutex
+	commands []string
+	applied  uint64
+}
+
+func (sm *testStateMachine) Apply(index uint64, data []byte) (interface{}, error) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.applied = index
+	if data == nil {
+		return nil, nil
+	}
+	if string(data) == "error" {
+		return nil, fmt.Errorf("apply error")
+	}
+	sm.commands = append(sm.commands, string(data))
+	return len(sm.commands), nil
+}
+
+func (sm *testStateMachine) AppliedIndex() uint64 {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return sm.applied
+}
+
+func (sm *testStateMachine) getCommands() []string {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return append([]string(nil), sm.commands...)
+}
+
+// testNetwork delivers the raft messages between the nodes in process
+type testNetwork struct {
+	mu    sync.RWMutex
+	nodes map[uint64]*Node
+	down  map[uint64]bool
+}
+
+type testTransport struct {
+	network *testNetwork
+	from    uint64
+}
+
+func (t *testTransport) Send(msgs []raftpb.Message) {
+	t.network.mu.RLock()
+	defer t.network.mu.RUnlock()
+	for _, msg := range msgs {
+		if t.network.down[msg.To] || t.network.down[t.from] {
+			continue
+		}
+		if node, ok := t.network.nodes[msg.To]; ok {
+			go node.Step(context.Background(), msg)
+		}
+	}
+}
+
+func newTestNode(t *testing.T, network *testNetwork, dir string, id uint64, sm *testStateMachine) *Node {
+	node, err := NewNode(Options{
+		ID:            id,
+		Peers:         []uint64{1, 2, 3},
+		DataDir:       path.Join(dir, fmt.Sprint(id)),
+		TickInterval:  10 * time.Millisecond,
+		SnapshotCount: 5,
+		// keep no entries to catch up
+		CatchUpEntries: 1,
+	}, sm, &testTransport{network: network, from: id})
+	assert.Nil(t, err)
+	network.mu.Lock()
+	network.nodes[id] = node
+	network.mu.Unlock()
+	node.Start()
+	return node
+}
+
+func waitLeader(t *testing.T, nodes ...*Node) uint64 {
+	for i := 0; i < 500; i++ {
+		for _, node := range nodes {
+			if leader := node.Leader(); leader != 0 {
+				return leader
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.FailNow(t, "no raft leader is elected")
+	return 0
+}
+
+func waitCommands(t *testing.T, sm *testStateMachine, expected []string) {
+	for i := 0; i < 500; i++ {
+		if assert.ObjectsAreEqual(expected, sm.getCommands()) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, expected, sm.getCommands())
+}
+
+func waitCompacted(t *testing.T, node *Node, minFirstIndex uint64) {
+	for i := 0; i < 500; i++ {
+		if firstIndex, _ := node.storage.FirstIndex(); firstIndex > minFirstIndex {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	firstIndex, _ := node.storage.FirstIndex()
+	assert.Greater(t, firstIndex, minFirstIndex)
+}
+
+func proposeCommands(t *testing.T, ctx context.Context, node *Node, expected []string, num int) []string {
+	for i := 0; i < num; i++ {
+		command := fmt.Sprint(len(expected))
+		_, err := node.Propose(ctx, []byte(command))
+		assert.Nil(t, err)
+		expected = append(expected, command)
+	}
+	return expected
+}
+
+func TestNewNode(t *testing.T) {
+	_, err := NewNode(Options{Peers: []uint64{1}}, &testStateMachine{}, nil)
+	assert.Error(t, err)
+	_, err = NewNode(Options{ID: 2, Peers: []uint64{1}}, &testStateMachine{}, nil)
+	assert.Error(t, err)
+}
+
+func TestNode_Replicate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raftnode")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	network := &testNetwork{nodes: make(map[uint64]*Node), down: make(map[uint64]bool)}
+	sms := map[uint64]*testStateMachine{1: {}, 2: {}, 3: {}}
+	nodes := map[uint64]*Node{}
+	for id, sm := range sms {
+		nodes[id] = newTestNode(t, network, dir, id, sm)
+	}
+	leader := waitLeader(t, nodes[1], nodes[2], nodes[3])
+	var follower uint64 = 1
+	if leader == 1 {
+		follower = 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// proposals of followers are forwarded to the leader
+	result, err := nodes[follower].Propose(ctx, []byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result)
+	result, err = nodes[leader].Propose(ctx, []byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result)
+	_, err = nodes[leader].Propose(ctx, []byte("error"))
+	assert.Error(t, err)
+	for _, sm := range sms {
+		waitCommands(t, sm, []string{"a", "b"})
+	}
+
+	// the log is compacted once every node has replicated it
+	expected := []string{"a", "b"}
+	expected = proposeCommands(t, ctx, nodes[leader], expected, 10)
+	for _, sm := range sms {
+		waitCommands(t, sm, expected)
+	}
+	for id := range nodes {
+		waitCompacted(t, nodes[id], 2)
+	}
+
+	// the log is not compacted past a node which is down
+	network.mu.Lock()
+	network.down[follower] = true
+	network.mu.Unlock()
+	nodes[follower].Stop()
+	_, err = nodes[follower].Propose(ctx, []byte("c"))
+	assert.Equal(t, ErrStopped, err)
+	followerApplied := sms[follower].AppliedIndex()
+	expected = proposeCommands(t, ctx, nodes[leader], expected, 20)
+	waitCommands(t, sms[leader], expected)
+	firstIndex, err := nodes[leader].storage.FirstIndex()
+	assert.Nil(t, err)
+	assert.LessOrEqual(t, firstIndex, followerApplied+1)
+
+	// the restarted node replays its raft log without applying the commands again,
+	// and catches up with the entries kept by the leader
+	network.mu.Lock()
+	network.down[follower] = false
+	network.mu.Unlock()
+	nodes[follower] = newTestNode(t, network, dir, follower, sms[follower])
+	waitCommands(t, sms[follower], expected)
+
+	// the log is compacted again after the node caught up
+	expected = proposeCommands(t, ctx, nodes[leader], expected, 10)
+	for _, sm := range sms {
+		waitCommands(t, sm, expected)
+	}
+	waitCompacted(t, nodes[leader], followerApplied+1)
+
+	for id, node := range nodes {
+		node.Stop()
+		// the state machine of a stopped node is durable, the raft log is replayed on restart
+		nodes[id] = newTestNode(t, network, dir, id, sms[id])
+	}
+	leader = waitLeader(t, nodes[1], nodes[2], nodes[3])
+	result, err = nodes[leader].Propose(ctx, []byte("d"))
+	assert.Nil(t, err)
+	assert.Equal(t, len(expected)+1, result)
+	for _, sm := range sms {
+		waitCommands(t, sm, append(expected, "d"))
+	}
+	for _, node := range nodes {
+		node.Stop()
+	}
+}
@@ -13,6 +13,7 @@ package rocksmq
 
 import (
 	"errors"
+	"net"
 	"os"
 	"strconv"
 	"sync"
@@ -29,9 +30,24 @@ import (
 // Rmq is global rocksmq instance that will be initialized only once
 var Rmq *rocksmq
 
+// rmqServer is the RocksMQ used by this process if rocksmq is replicated by raft, it's Rmq replicated
+// by this node, or the RocksMQ served by the raft group if this process is not a member
+var rmqServer RocksMQ
+
+// rmqService serves Rmq to the other nodes of the raft group and the other processes
+var rmqService *Service
+
 // once is used to init global rocksmq
 var once sync.Once
 
+// GetRocksMQ returns the global RocksMQ used by this process
+func GetRocksMQ() RocksMQ {
+	if rmqServer != nil {
+		return rmqServer
+	}
+	return Rmq
+}
+
 // Params provide params that rocksmq needs
 var params paramtable.BaseTable
 
@@ -47,6 +63,18 @@ func InitRocksMQ() error {
 	var finalErr error
 	once.Do(func() {
 		params.Init()
+		paramtable.Params.Init()
+		raftPeers := paramtable.Params.RocksmqRaftPeers
+		raftNodeID := paramtable.Params.RocksmqRaftNodeID
+		if len(raftPeers) > 0 && raftNodeID == 0 {
+			addresses := make([]string, 0, len(raftPeers))
+			for _, address := range raftPeers {
+				addresses = append(addresses, address)
+			}
+			rmqServer, finalErr = NewRemoteRocksMQ(addresses)
+			return
+		}
+
 		rocksdbName, _ := params.Load("_RocksmqPath")
 		log.Debug("RocksmqPath=" + rocksdbName)
 		var fi os.FileInfo
@@ -103,13 +131,59 @@ func InitRocksMQ() error {
 		log.Debug("", zap.Any("RocksmqRetentionTimeInMinutes", RocksmqRetentionTimeInMinutes),
 			zap.Any("RocksmqRetentionSizeInMB", RocksmqRetentionSizeInMB), zap.Any("RocksmqPageSize", RocksmqPageSize))
 		Rmq, finalErr = NewRocksMQ(rocksdbName, idAllocator)
+		if finalErr != nil || len(raftPeers) == 0 {
+			return
+		}
+
+		var rrmq *raftRocksMQ
+		rrmq, finalErr = NewRaftRocksMQ(Rmq, RaftOptions{
+			NodeID:  raftNodeID,
+			Peers:   raftPeers,
+			DataDir: paramtable.Params.RocksmqRaftDataDir,
+		})
+		if finalErr != nil {
+			return
+		}
+		// listen on all the interfaces, the address of the peer may not be resolved to a local interface
+		var port string
+		if _, port, finalErr = net.SplitHostPort(raftPeers[raftNodeID]); finalErr != nil {
+			rrmq.Close()
+			return
+		}
+		rmqService = NewService(rrmq)
+		if finalErr = rmqService.Start(":" + port); finalErr != nil {
+			rrmq.Close()
+			return
+		}
+		rmqServer = rrmq
 	})
 	return finalErr
 }
 
+// StartRocksMQService serves the global rocksmq on address, so that the processes on other hosts could
+// access it. It does nothing if the rocksmq is already served to its raft group.
+func StartRocksMQService(address string) error {
+	if rmqService != nil {
+		return nil
+	}
+	service := NewService(GetRocksMQ())
+	if err := service.Start(address); err != nil {
+		return err
+	}
+	rmqService = service
+	return nil
+}
+
 // CloseRocksMQ is used to close global rocksmq
 func CloseRocksMQ() {
 	log.Debug("Close Rocksmq!")
+	if rmqService != nil {
+		rmqService.Stop()
+	}
+	if rmqServer != nil {
+		rmqServer.Close()
+		return
+	}
 	if Rmq != nil && Rmq.store != nil {
 		Rmq.Close()
 	}
@@ -56,3 +56,52 @@ type RocksMQ interface {
 	HasNext(topicName string, readerName string, messageIDInclusive bool) bool
 	CloseReader(topicName string, readerName string)
 }
+
+// PageStats is a page of messages, the messages of a page are deleted together by retention
+type PageStats struct {
+	EndID UniqueID
+	Size  int64
+	// AckedTs is the time in seconds the page is acked by all the consumer groups, it's 0 if not acked
+	AckedTs int64
+}
+
+// ConsumerGroupStats is the position of a consumer group
+type ConsumerGroupStats struct {
+	Group string
+	// CurrentID is the id of the next message to consume, it's -1 if the group consumes nothing
+	CurrentID UniqueID
+	// AckedID is the id of the last acked message, it's -1 if the group acks nothing
+	AckedID UniqueID
+}
+
+// TopicStats describes the messages, the retention and the consumer groups of a topic
+type TopicStats struct {
+	Topic                  string
+	RetentionTimeInMinutes int64
+	RetentionSizeInMB      int64
+	RetentionOverridden    bool
+	MessageCount           int64
+	MessageSize            int64
+	AckedSize              int64
+	// DiskUsage is the approximate size of the messages on disk
+	DiskUsage int64
+	Pages     []PageStats
+	Groups    []ConsumerGroupStats
+}
+
+// DiskUsage is the sizes of the sst files of the message store and of the meta kv
+type DiskUsage struct {
+	StoreSize int64
+	MetaSize  int64
+}
+
+// RocksMQAdmin is implemented by the RocksMQ which could be inspected and whose retention could be
+// managed per topic
+type RocksMQAdmin interface {
+	ListTopics() ([]string, error)
+	GetTopicStats(topicName string) (*TopicStats, error)
+	// SetTopicRetention overrides the retention of a topic, -1 means the messages are retained forever.
+	// The retention of the topic is reset to the global retention if both of the values are 0.
+	SetTopicRetention(topicName string, retentionTimeInMinutes, retentionSizeInMB int64) error
+	GetDiskUsage() (*DiskUsage, error)
+}
@@ -0,0 +1,269 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
+	"github.com/milvus-io/milvus/internal/log"
+
+	"github.com/tecbot/gorocksdb"
+	"go.uber.org/zap"
+)
+
+var _ RocksMQAdmin = (*rocksmq)(nil)
+
+// ListTopics returns the names of all the topics in order
+func (rmq *rocksmq) ListTopics() ([]string, error) {
+	if rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	var topics []string
+	rmq.retentionInfo.topics.Range(func(k, v interface{}) bool {
+		topics = append(topics, k.(string))
+		return true
+	})
+	sort.Strings(topics)
+	return topics, nil
+}
+
+// SetTopicRetention overrides the retention of a topic, the override is removed if both of the values are 0
+func (rmq *rocksmq) SetTopicRetention(topicName string, retentionTimeInMinutes, retentionSizeInMB int64) error {
+	if rmq.isClosed() {
+		return errors.New(RmqNotServingErrMsg)
+	}
+	if _, ok := topicMu.Load(topicName); !ok {
+		return fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	if retentionTimeInMinutes < -1 || retentionSizeInMB < -1 {
+		return fmt.Errorf("invalid retention of topic %s, time = %d minutes, size = %d MB",
+			topicName, retentionTimeInMinutes, retentionSizeInMB)
+	}
+
+	// a value of 0 falls back to the global retention
+	saves := make(map[string]string)
+	var removals []string
+	retentionTimeKey := RetentionTimeTitle + topicName
+	if retentionTimeInMinutes != 0 {
+		saves[retentionTimeKey] = strconv.FormatInt(retentionTimeInMinutes, 10)
+	} else {
+		removals = append(removals, retentionTimeKey)
+	}
+	retentionSizeKey := RetentionSizeTitle + topicName
+	if retentionSizeInMB != 0 {
+		saves[retentionSizeKey] = strconv.FormatInt(retentionSizeInMB, 10)
+	} else {
+		removals = append(removals, retentionSizeKey)
+	}
+	err := rmq.kv.(*rocksdbkv.RocksdbKV).MultiSaveAndRemove(saves, removals)
+	if err != nil {
+		return err
+	}
+	log.Debug("Rocksmq set topic retention successfully", zap.String("topic", topicName),
+		zap.Int64("retentionTimeInMinutes", retentionTimeInMinutes), zap.Int64("retentionSizeInMB", retentionSizeInMB))
+	return nil
+}
+
+// GetTopicStats collects the messages, the pages, the retention and the consumer groups of a topic.
+// All the messages of the topic are iterated to count them, so it's not supposed to be called frequently.
+func (rmq *rocksmq) GetTopicStats(topicName string) (*TopicStats, error) {
+	if rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	if _, ok := topicMu.Load(topicName); !ok {
+		return nil, fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	stats := &TopicStats{Topic: topicName}
+	stats.RetentionTimeInMinutes, stats.RetentionSizeInMB, stats.RetentionOverridden = rmq.retentionInfo.topicRetention(topicName)
+
+	fixChanName, err := fixChannelName(topicName)
+	if err != nil {
+		return nil, err
+	}
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	readOpts.SetPrefixSameAsStart(true)
+	iter := rmq.store.NewIterator(readOpts)
+	defer iter.Close()
+	for iter.Seek([]byte(fixChanName + "/")); iter.Valid(); iter.Next() {
+		val := iter.Value()
+		stats.MessageCount++
+		stats.MessageSize += int64(val.Size())
+		val.Free()
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	// 0 is the ASC value of "/" + 1
+	sizes := rmq.store.GetApproximateSizes([]gorocksdb.Range{{
+		Start: []byte(fixChanName + "/"),
+		Limit: []byte(fixChanName + "0"),
+	}})
+	stats.DiskUsage = int64(sizes[0])
+
+	ackedSizeVal, err := rmq.kv.Load(AckedSizeTitle + topicName)
+	if err != nil {
+		return nil, err
+	}
+	if ackedSizeVal != "" {
+		stats.AckedSize, err = strconv.ParseInt(ackedSizeVal, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if stats.Pages, err = rmq.getPageStats(topicName); err != nil {
+		return nil, err
+	}
+	if stats.Groups, err = rmq.getConsumerGroupStats(topicName); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// getPageStats returns the full pages of a topic with their acked ts
+func (rmq *rocksmq) getPageStats(topicName string) ([]PageStats, error) {
+	pageMsgPrefix, err := constructKey(PageMsgSizeTitle, topicName)
+	if err != nil {
+		return nil, err
+	}
+	fixedAckedTsKey, err := constructKey(AckedTsTitle, topicName)
+	if err != nil {
+		return nil, err
+	}
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	readOpts.SetPrefixSameAsStart(true)
+	iter := rmq.kv.(*rocksdbkv.RocksdbKV).DB.NewIterator(readOpts)
+	defer iter.Close()
+
+	pages := make([]PageStats, 0)
+	for iter.Seek([]byte(pageMsgPrefix + "/")); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		val := iter.Value()
+		pageID, err := strconv.ParseInt(string(key.Data())[FixedChannelNameLen+1:], 10, 64)
+		key.Free()
+		if err != nil {
+			val.Free()
+			return nil, err
+		}
+		size, err := strconv.ParseInt(string(val.Data()), 10, 64)
+		val.Free()
+		if err != nil {
+			return nil, err
+		}
+		page := PageStats{EndID: pageID, Size: size}
+		ackedTsVal, err := rmq.kv.Load(fixedAckedTsKey + "/" + strconv.FormatInt(pageID, 10))
+		if err != nil {
+			return nil, err
+		}
+		if ackedTsVal != "" {
+			if page.AckedTs, err = strconv.ParseInt(ackedTsVal, 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		pages = append(pages, page)
+	}
+	return pages, iter.Err()
+}
+
+// getConsumerGroupStats returns the positions of the consumer groups of a topic. The consumer groups
+// are the ones registered on this rocksmq and the ones which have acked messages.
+func (rmq *rocksmq) getConsumerGroupStats(topicName string) ([]ConsumerGroupStats, error) {
+	fixedBeginIDKey, err := constructKey(BeginIDTitle, topicName)
+	if err != nil {
+		return nil, err
+	}
+
+	// group name -> acked id
+	ackedIDs := make(map[string]UniqueID)
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	readOpts.SetPrefixSameAsStart(true)
+	iter := rmq.kv.(*rocksdbkv.RocksdbKV).DB.NewIterator(readOpts)
+	defer iter.Close()
+	for iter.Seek([]byte(fixedBeginIDKey + "/")); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		val := iter.Value()
+		groupName := string(key.Data())[FixedChannelNameLen+1:]
+		ackedID, err := strconv.ParseInt(string(val.Data()), 10, 64)
+		key.Free()
+		val.Free()
+		if err != nil {
+			return nil, err
+		}
+		ackedIDs[groupName] = ackedID
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	if vals, ok := rmq.consumers.Load(topicName); ok {
+		for _, v := range vals.([]*Consumer) {
+			if _, ok := ackedIDs[v.GroupName]; !ok {
+				ackedIDs[v.GroupName] = -1
+			}
+		}
+	}
+
+	groups := make([]ConsumerGroupStats, 0, len(ackedIDs))
+	for groupName, ackedID := range ackedIDs {
+		currentIDVal, err := rmq.kv.Load(constructCurrentID(topicName, groupName))
+		if err != nil {
+			return nil, err
+		}
+		// the acked id is kept after the consumer group is destroyed
+		if currentIDVal == "" {
+			continue
+		}
+		currentID, err := strconv.ParseInt(currentIDVal, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, ConsumerGroupStats{
+			Group:     groupName,
+			CurrentID: currentID,
+			AckedID:   ackedID,
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Group < groups[j].Group
+	})
+	return groups, nil
+}
+
+// GetDiskUsage returns the sizes of the sst files of the message store and of the meta kv
+func (rmq *rocksmq) GetDiskUsage() (*DiskUsage, error) {
+	if rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	storeSize, err := getTotalSstFilesSize(rmq.store)
+	if err != nil {
+		return nil, err
+	}
+	metaSize, err := getTotalSstFilesSize(rmq.kv.(*rocksdbkv.RocksdbKV).DB)
+	if err != nil {
+		return nil, err
+	}
+	return &DiskUsage{StoreSize: storeSize, MetaSize: metaSize}, nil
+}
+
+func getTotalSstFilesSize(db *gorocksdb.DB) (int64, error) {
+	val := db.GetProperty("rocksdb.total-sst-files-size")
+	if val == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
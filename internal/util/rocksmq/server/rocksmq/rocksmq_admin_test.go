@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRocksmq_Admin(t *testing.T) {
+	atomic.StoreInt64(&RocksmqPageSize, 10)
+	// the acked messages are not deleted by retention during the test
+	retentionTime := atomic.SwapInt64(&RocksmqRetentionTimeInMinutes, 10080)
+	defer atomic.StoreInt64(&RocksmqRetentionTimeInMinutes, retentionTime)
+	retentionSize := atomic.SwapInt64(&RocksmqRetentionSizeInMB, 8192)
+	defer atomic.StoreInt64(&RocksmqRetentionSizeInMB, retentionSize)
+	suffix := "_admin"
+	kvPath := rmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := rmqPath + dbPathSuffix + suffix
+	defer os.RemoveAll(rocksdbPath)
+	defer os.RemoveAll(rocksdbPath + kvSuffix)
+
+	rmq, err := NewRocksMQ(rocksdbPath, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+
+	topicName := "topic_admin"
+	groupName := "group_admin"
+	err = rmq.CreateTopic(topicName)
+	assert.Nil(t, err)
+	defer rmq.DestroyTopic(topicName)
+
+	topics, err := rmq.ListTopics()
+	assert.Nil(t, err)
+	assert.Contains(t, topics, topicName)
+
+	_, err = rmq.GetTopicStats("topic_not_exist")
+	assert.Error(t, err)
+
+	msgNum := 10
+	pMsgs := make([]ProducerMessage, msgNum)
+	msgSize := int64(0)
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+		msgSize += int64(len(pMsgs[i].Payload))
+	}
+	ids, err := rmq.Produce(topicName, pMsgs)
+	assert.Nil(t, err)
+
+	err = rmq.CreateConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+	rmq.RegisterConsumer(&Consumer{
+		Topic:     topicName,
+		GroupName: groupName,
+		MsgMutex:  make(chan struct{}, 1),
+	})
+	stats, err := rmq.GetTopicStats(topicName)
+	assert.Nil(t, err)
+	assert.Equal(t, topicName, stats.Topic)
+	assert.Equal(t, int64(msgNum), stats.MessageCount)
+	assert.Equal(t, msgSize, stats.MessageSize)
+	assert.False(t, stats.RetentionOverridden)
+	// every page is full after two messages
+	assert.Equal(t, msgNum/2, len(stats.Pages))
+	assert.Equal(t, ids[1], stats.Pages[0].EndID)
+	assert.Equal(t, int64(0), stats.Pages[0].AckedTs)
+	assert.Equal(t, 1, len(stats.Groups))
+	assert.Equal(t, groupName, stats.Groups[0].Group)
+	assert.Equal(t, int64(-1), stats.Groups[0].CurrentID)
+	assert.Equal(t, int64(-1), stats.Groups[0].AckedID)
+
+	_, err = rmq.Consume(topicName, groupName, 5)
+	assert.Nil(t, err)
+	// the acked info is updated asynchronously
+	assert.Eventually(t, func() bool {
+		stats, err = rmq.GetTopicStats(topicName)
+		return err == nil && len(stats.Groups) == 1 && stats.Groups[0].AckedID == ids[4]
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, ids[5], stats.Groups[0].CurrentID)
+	assert.NotEqual(t, int64(0), stats.Pages[1].AckedTs)
+	assert.Equal(t, int64(0), stats.Pages[2].AckedTs)
+	assert.Less(t, int64(0), stats.AckedSize)
+
+	err = rmq.SetTopicRetention(topicName, 60, -1)
+	assert.Nil(t, err)
+	stats, err = rmq.GetTopicStats(topicName)
+	assert.Nil(t, err)
+	assert.True(t, stats.RetentionOverridden)
+	assert.Equal(t, int64(60), stats.RetentionTimeInMinutes)
+	assert.Equal(t, int64(-1), stats.RetentionSizeInMB)
+
+	usage, err := rmq.GetDiskUsage()
+	assert.Nil(t, err)
+	assert.LessOrEqual(t, int64(0), usage.StoreSize)
+	assert.LessOrEqual(t, int64(0), usage.MetaSize)
+
+	err = rmq.DestroyConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+	stats, err = rmq.GetTopicStats(topicName)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(stats.Groups))
+}
@@ -127,8 +127,9 @@ func constructKey(metaName, topic string) (string, error) {
 	return metaName + topic + string(nameBytes), nil
 }
 
+// checkRetention returns false if the global retention is disabled by setting either dimension to -1
 func checkRetention() bool {
-	return RocksmqRetentionTimeInMinutes != -1 && RocksmqRetentionSizeInMB != -1
+	return atomic.LoadInt64(&RocksmqRetentionTimeInMinutes) != -1 && atomic.LoadInt64(&RocksmqRetentionSizeInMB) != -1
 }
 
 func getNowTs(idAllocator allocator.GIDAllocator) (int64, error) {
@@ -200,9 +201,9 @@ func NewRocksMQ(name string, idAllocator allocator.GIDAllocator) (*rocksmq, erro
 	}
 	rmq.retentionInfo = ri
 
-	if checkRetention() {
-		rmq.retentionInfo.startRetentionInfo()
-	}
+	// the retention of a topic could be overridden even if the global retention is disabled,
+	// topics without overrides retain all messages in that case
+	rmq.retentionInfo.startRetentionInfo()
 	atomic.StoreInt64(&rmq.state, RmqStateHealthy)
 	return rmq, nil
 }
@@ -337,7 +338,11 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	lastRetTsKey := LastRetTsTitle + topicName
 	msgSizeKey := MessageSizeTitle + topicName
 
-	removedKeys = append(removedKeys, beginKey, endKey, ackedSizeKey, topicBeginIDKey, lastRetTsKey, msgSizeKey)
+	retentionTimeKey := RetentionTimeTitle + topicName
+	retentionSizeKey := RetentionSizeTitle + topicName
+
+	removedKeys = append(removedKeys, beginKey, endKey, ackedSizeKey, topicBeginIDKey, lastRetTsKey, msgSizeKey,
+		retentionTimeKey, retentionSizeKey)
 	// Batch remove, atomic operation
 	err := rmq.kv.MultiRemove(removedKeys)
 	if err != nil {
@@ -484,12 +489,32 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) ([]Uni
 		return []UniqueID{}, errors.New("Obtained id length is not equal that of message")
 	}
 
+	msgIDs, err := rmq.writeMessages(topicName, messages, idStart, nil)
+	if err != nil {
+		return []UniqueID{}, err
+	}
+
+	getProduceTime := time.Since(start).Milliseconds()
+	if getLockTime > 200 || getProduceTime > 200 {
+		log.Warn("rocksmq produce too slowly", zap.String("topic", topicName),
+			zap.Int64("get lock elapse", getLockTime), zap.Int64("produce elapse", getProduceTime))
+	}
+	return msgIDs, nil
+}
+
+// writeMessages writes messages with consecutive ids starting from idStart, notifies the consumers
+// and readers, and updates page infos for retention. The caller should hold the topic lock.
+// extraKVs are written to the store in the same batch as the messages.
+func (rmq *rocksmq) writeMessages(topicName string, messages []ProducerMessage, idStart UniqueID, extraKVs map[string]string) ([]UniqueID, error) {
+	msgLen := len(messages)
+	idEnd := idStart + UniqueID(msgLen)
+
 	/* Step I: Insert data to store system */
 	batch := gorocksdb.NewWriteBatch()
 	defer batch.Destroy()
 	msgSizes := make(map[UniqueID]int64)
 	msgIDs := make([]UniqueID, msgLen)
-	for i := 0; i < msgLen && idStart+UniqueID(i) < idEnd; i++ {
+	for i := 0; i < msgLen; i++ {
 		msgID := idStart + UniqueID(i)
 		key, err := combKey(topicName, msgID)
 		if err != nil {
@@ -500,10 +525,13 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) ([]Uni
 		msgIDs[i] = msgID
 		msgSizes[msgID] = int64(len(messages[i].Payload))
 	}
+	for k, v := range extraKVs {
+		batch.Put([]byte(k), []byte(v))
+	}
 
 	opts := gorocksdb.NewDefaultWriteOptions()
 	defer opts.Destroy()
-	err = rmq.store.Write(opts, batch)
+	err := rmq.store.Write(opts, batch)
 	if err != nil {
 		log.Debug("RocksMQ: write batch failed")
 		return []UniqueID{}, err
@@ -559,12 +587,6 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) ([]Uni
 	if err != nil {
 		return []UniqueID{}, err
 	}
-
-	getProduceTime := time.Since(start).Milliseconds()
-	if getLockTime > 200 || getProduceTime > 200 {
-		log.Warn("rocksmq produce too slowly", zap.String("topic", topicName),
-			zap.Int64("get lock elapse", getLockTime), zap.Int64("produce elapse", getProduceTime))
-	}
 	return msgIDs, nil
 }
 
@@ -625,6 +647,39 @@ func (rmq *rocksmq) Consume(topicName string, groupName string, n int) ([]Consum
 	defer lock.Unlock()
 	getLockTime := time.Since(start).Milliseconds()
 
+	consumerMessage, err := rmq.readMessages(topicName, groupName, n)
+	if err != nil {
+		return nil, err
+	}
+
+	// When already consume to last mes, an empty slice will be returned
+	if len(consumerMessage) == 0 {
+		// log.Debug("RocksMQ: consumerMessage is empty")
+		return consumerMessage, nil
+	}
+
+	consumedIDs := make([]UniqueID, 0, len(consumerMessage))
+	for _, msg := range consumerMessage {
+		consumedIDs = append(consumedIDs, msg.MsgID)
+	}
+	newID := consumedIDs[len(consumedIDs)-1]
+	err = rmq.moveConsumePos(topicName, groupName, newID+1)
+	if err != nil {
+		return nil, err
+	}
+
+	go rmq.updateAckedInfo(topicName, groupName, consumedIDs)
+	getConsumeTime := time.Since(start).Milliseconds()
+	if getLockTime > 200 || getConsumeTime > 200 {
+		log.Warn("rocksmq consume too slowly", zap.String("topic", topicName),
+			zap.Int64("get lock elapse", getLockTime), zap.Int64("consume elapse", getConsumeTime))
+	}
+	return consumerMessage, nil
+}
+
+// readMessages reads at most n messages from the current position of the consumer group without
+// moving the position. The caller should hold the topic lock.
+func (rmq *rocksmq) readMessages(topicName string, groupName string, n int) ([]ConsumerMessage, error) {
 	metaKey := constructCurrentID(topicName, groupName)
 	currentID, err := rmq.kv.Load(metaKey)
 	if err != nil {
@@ -684,29 +739,6 @@ func (rmq *rocksmq) Consume(topicName string, groupName string, n int) ([]Consum
 		consumerMessage = append(consumerMessage, msg)
 		val.Free()
 	}
-
-	// When already consume to last mes, an empty slice will be returned
-	if len(consumerMessage) == 0 {
-		// log.Debug("RocksMQ: consumerMessage is empty")
-		return consumerMessage, nil
-	}
-
-	consumedIDs := make([]UniqueID, 0, len(consumerMessage))
-	for _, msg := range consumerMessage {
-		consumedIDs = append(consumedIDs, msg.MsgID)
-	}
-	newID := consumedIDs[len(consumedIDs)-1]
-	err = rmq.moveConsumePos(topicName, groupName, newID+1)
-	if err != nil {
-		return nil, err
-	}
-
-	go rmq.updateAckedInfo(topicName, groupName, consumedIDs)
-	getConsumeTime := time.Since(start).Milliseconds()
-	if getLockTime > 200 || getConsumeTime > 200 {
-		log.Warn("rocksmq consume too slowly", zap.String("topic", topicName),
-			zap.Int64("get lock elapse", getLockTime), zap.Int64("consume elapse", getConsumeTime))
-	}
 	return consumerMessage, nil
 }
 
@@ -0,0 +1,475 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/tecbot/gorocksdb"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/rocksmqpb"
+	"github.com/milvus-io/milvus/internal/util/rocksmq/server/raftnode"
+)
+
+// the raft state is kept in the store with the messages, so that they are written atomically
+const (
+	raftAppliedIndexKey = "raft/applied_index"
+	raftLastIDKey       = "raft/last_id"
+
+	raftProposeTimeout = 10 * time.Second
+)
+
+// RaftOptions are the options of a replicated rocksmq
+type RaftOptions struct {
+	// NodeID is the id of this node, it must be one of Peers
+	NodeID uint64
+	// Peers are the grpc addresses of all the nodes of the raft group
+	Peers map[uint64]string
+	// DataDir is the directory of the raft log
+	DataDir string
+}
+
+var _ RocksMQ = (*raftRocksMQ)(nil)
+var _ RocksMQAdmin = (*raftRocksMQ)(nil)
+
+// raftRocksMQ replicates the changes of a rocksmq to a raft group. Every node applies the raft log
+// to its own rocksmq, the readers, the consumer registrations and the retention are local to a node.
+//
+// The raft log is compacted without taking snapshots of the rocksmq, but only up to the index which
+// every node has replicated, so a node that restarts or falls behind catches up from the log. The log
+// grows while a node is down, a node which is removed for good should be replaced by a rebuilt one.
+type raftRocksMQ struct {
+	rmq       *rocksmq
+	node      *raftnode.Node
+	transport *raftTransport
+
+	appliedIndex uint64
+	// lastID is the id of the last produced message, it's only accessed by the raft apply loop
+	lastID UniqueID
+
+	// consumeMu serializes the consumes of a consumer group on this node
+	consumeMu sync.Map
+}
+
+// NewRaftRocksMQ replicates rmq with a raft group, rmq should not be used directly after that
+func NewRaftRocksMQ(rmq *rocksmq, opts RaftOptions) (*raftRocksMQ, error) {
+	if _, ok := opts.Peers[opts.NodeID]; !ok {
+		return nil, fmt.Errorf("rocksmq raft node %d is not one of the peers", opts.NodeID)
+	}
+	rrmq := &raftRocksMQ{rmq: rmq}
+	val, err := rrmq.loadRaftState(raftAppliedIndexKey)
+	if err != nil {
+		return nil, err
+	}
+	if val != "" {
+		if rrmq.appliedIndex, err = strconv.ParseUint(val, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	val, err = rrmq.loadRaftState(raftLastIDKey)
+	if err != nil {
+		return nil, err
+	}
+	if val != "" {
+		if rrmq.lastID, err = strconv.ParseInt(val, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	peerIDs := make([]uint64, 0, len(opts.Peers))
+	for id := range opts.Peers {
+		peerIDs = append(peerIDs, id)
+	}
+	sort.Slice(peerIDs, func(i, j int) bool { return peerIDs[i] < peerIDs[j] })
+	rrmq.transport = newRaftTransport(opts.NodeID, opts.Peers)
+	rrmq.node, err = raftnode.NewNode(raftnode.Options{
+		ID:      opts.NodeID,
+		Peers:   peerIDs,
+		DataDir: opts.DataDir,
+	}, rrmq, rrmq.transport)
+	if err != nil {
+		return nil, err
+	}
+	rrmq.transport.node = rrmq.node
+	rrmq.node.Start()
+	log.Debug("Rocksmq raft node started", zap.Uint64("nodeID", opts.NodeID), zap.Any("peers", opts.Peers),
+		zap.Uint64("appliedIndex", rrmq.appliedIndex))
+	return rrmq, nil
+}
+
+// AppliedIndex implements raftnode.StateMachine
+func (rrmq *raftRocksMQ) AppliedIndex() uint64 {
+	return atomic.LoadUint64(&rrmq.appliedIndex)
+}
+
+// Apply implements raftnode.StateMachine, the applied index is saved with the rocksmq meta
+func (rrmq *raftRocksMQ) Apply(index uint64, data []byte) (interface{}, error) {
+	var result interface{}
+	var err error
+	saved := false
+	if data != nil {
+		cmd := &rocksmqpb.RaftCommand{}
+		if err = proto.Unmarshal(data, cmd); err == nil {
+			if cmd.Type == rocksmqpb.RaftCommandType_Produce {
+				// the produced messages are written with the applied index, so they are never applied twice
+				result, err = rrmq.applyProduce(cmd, index)
+				saved = err == nil
+			} else {
+				result, err = rrmq.applyCommand(cmd)
+			}
+		}
+	}
+	if !saved {
+		if saveErr := rrmq.saveRaftState(index); saveErr != nil {
+			log.Error("Rocksmq failed to save raft applied index", zap.Uint64("index", index), zap.Error(saveErr))
+			return nil, saveErr
+		}
+	}
+	atomic.StoreUint64(&rrmq.appliedIndex, index)
+	return result, err
+}
+
+// raftState returns the applied index and the id of the last produced message to be saved
+func (rrmq *raftRocksMQ) raftState(index uint64, lastID UniqueID) map[string]string {
+	return map[string]string{
+		raftAppliedIndexKey: strconv.FormatUint(index, 10),
+		raftLastIDKey:       strconv.FormatInt(lastID, 10),
+	}
+}
+
+// saveRaftState writes the applied index and the id of the last produced message to the store
+func (rrmq *raftRocksMQ) saveRaftState(index uint64) error {
+	batch := gorocksdb.NewWriteBatch()
+	defer batch.Destroy()
+	for k, v := range rrmq.raftState(index, rrmq.lastID) {
+		batch.Put([]byte(k), []byte(v))
+	}
+	opts := gorocksdb.NewDefaultWriteOptions()
+	defer opts.Destroy()
+	return rrmq.rmq.store.Write(opts, batch)
+}
+
+// loadRaftState reads a key of the raft state from the store, returns "" if it doesn't exist
+func (rrmq *raftRocksMQ) loadRaftState(key string) (string, error) {
+	opts := gorocksdb.NewDefaultReadOptions()
+	defer opts.Destroy()
+	val, err := rrmq.rmq.store.Get(opts, []byte(key))
+	if err != nil {
+		return "", err
+	}
+	defer val.Free()
+	return string(val.Data()), nil
+}
+
+func (rrmq *raftRocksMQ) applyCommand(cmd *rocksmqpb.RaftCommand) (interface{}, error) {
+	switch cmd.Type {
+	case rocksmqpb.RaftCommandType_CreateTopic:
+		return nil, rrmq.rmq.CreateTopic(cmd.Topic)
+	case rocksmqpb.RaftCommandType_DestroyTopic:
+		return nil, rrmq.rmq.DestroyTopic(cmd.Topic)
+	case rocksmqpb.RaftCommandType_CreateConsumerGroup:
+		return nil, rrmq.rmq.CreateConsumerGroup(cmd.Topic, cmd.Group)
+	case rocksmqpb.RaftCommandType_DestroyConsumerGroup:
+		return nil, rrmq.rmq.DestroyConsumerGroup(cmd.Topic, cmd.Group)
+	case rocksmqpb.RaftCommandType_Seek:
+		return nil, rrmq.rmq.Seek(cmd.Topic, cmd.Group, cmd.MsgID)
+	case rocksmqpb.RaftCommandType_SeekToLatest:
+		return nil, rrmq.rmq.SeekToLatest(cmd.Topic, cmd.Group)
+	case rocksmqpb.RaftCommandType_Ack:
+		return nil, rrmq.applyAck(cmd)
+	case rocksmqpb.RaftCommandType_SetTopicRetention:
+		return nil, rrmq.rmq.SetTopicRetention(cmd.Topic, cmd.RetentionTimeInMinutes, cmd.RetentionSizeInMb)
+	}
+	return nil, fmt.Errorf("unknown rocksmq raft command %s", cmd.Type.String())
+}
+
+// applyProduce writes the messages with the same ids on every node, the ids are allocated by the
+// proposer and moved after the last produced message if they are not increasing.
+// The raft state of index is written in the same batch as the messages.
+func (rrmq *raftRocksMQ) applyProduce(cmd *rocksmqpb.RaftCommand, index uint64) ([]UniqueID, error) {
+	if len(cmd.Payloads) == 0 {
+		return []UniqueID{}, rrmq.saveRaftState(index)
+	}
+	ll, ok := topicMu.Load(cmd.Topic)
+	if !ok {
+		return nil, fmt.Errorf("topic name = %s not exist", cmd.Topic)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return nil, fmt.Errorf("get mutex failed, topic name = %s", cmd.Topic)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	idStart := cmd.MsgID
+	if idStart <= rrmq.lastID {
+		idStart = rrmq.lastID + 1
+	}
+	messages := make([]ProducerMessage, len(cmd.Payloads))
+	for i, payload := range cmd.Payloads {
+		messages[i] = ProducerMessage{Payload: payload}
+	}
+	lastID := idStart + UniqueID(len(messages)) - 1
+	msgIDs, err := rrmq.rmq.writeMessages(cmd.Topic, messages, idStart, rrmq.raftState(index, lastID))
+	// the messages may be written even if an error is returned, skipping the ids is harmless
+	rrmq.lastID = lastID
+	if err != nil {
+		return nil, err
+	}
+	return msgIDs, nil
+}
+
+func (rrmq *raftRocksMQ) applyAck(cmd *rocksmqpb.RaftCommand) error {
+	ll, ok := topicMu.Load(cmd.Topic)
+	if !ok {
+		return fmt.Errorf("topic name = %s not exist", cmd.Topic)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return fmt.Errorf("get mutex failed, topic name = %s", cmd.Topic)
+	}
+	lock.Lock()
+	err := rrmq.rmq.moveConsumePos(cmd.Topic, cmd.Group, cmd.MsgID)
+	lock.Unlock()
+	if err != nil {
+		return err
+	}
+	return rrmq.rmq.updateAckedInfo(cmd.Topic, cmd.Group, []UniqueID{cmd.FirstAckedID, cmd.LastAckedID})
+}
+
+func (rrmq *raftRocksMQ) propose(cmd *rocksmqpb.RaftCommand) (interface{}, error) {
+	if rrmq.rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	data, err := proto.Marshal(cmd)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), raftProposeTimeout)
+	defer cancel()
+	return rrmq.node.Propose(ctx, data)
+}
+
+// CreateTopic creates a topic on every node
+func (rrmq *raftRocksMQ) CreateTopic(topicName string) error {
+	_, err := rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:  rocksmqpb.RaftCommandType_CreateTopic,
+		Topic: topicName,
+	})
+	return err
+}
+
+// DestroyTopic destroys a topic on every node
+func (rrmq *raftRocksMQ) DestroyTopic(topicName string) error {
+	_, err := rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:  rocksmqpb.RaftCommandType_DestroyTopic,
+		Topic: topicName,
+	})
+	return err
+}
+
+// CreateConsumerGroup creates a consumer group on every node
+func (rrmq *raftRocksMQ) CreateConsumerGroup(topicName string, groupName string) error {
+	_, err := rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:  rocksmqpb.RaftCommandType_CreateConsumerGroup,
+		Topic: topicName,
+		Group: groupName,
+	})
+	return err
+}
+
+// DestroyConsumerGroup destroys a consumer group on every node
+func (rrmq *raftRocksMQ) DestroyConsumerGroup(topicName string, groupName string) error {
+	_, err := rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:  rocksmqpb.RaftCommandType_DestroyConsumerGroup,
+		Topic: topicName,
+		Group: groupName,
+	})
+	return err
+}
+
+// Close stops the raft node and closes the local rocksmq. Unlike rocksmq.Close, the topics and consumer
+// groups are kept, because they are replicated and would diverge from the other nodes if destroyed locally.
+func (rrmq *raftRocksMQ) Close() {
+	rrmq.node.Stop()
+	rrmq.transport.Close()
+	atomic.StoreInt64(&rrmq.rmq.state, RmqStateStopped)
+	rrmq.rmq.stopRetention()
+	rrmq.rmq.storeMu.Lock()
+	defer rrmq.rmq.storeMu.Unlock()
+	rrmq.rmq.store.Close()
+	rrmq.rmq.kv.Close()
+}
+
+// RegisterConsumer registers a consumer on this node
+func (rrmq *raftRocksMQ) RegisterConsumer(consumer *Consumer) {
+	rrmq.rmq.RegisterConsumer(consumer)
+}
+
+// Produce produces messages to every node, the ids are returned after the messages are written
+// to this node
+func (rrmq *raftRocksMQ) Produce(topicName string, messages []ProducerMessage) ([]UniqueID, error) {
+	if rrmq.rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	idStart, _, err := rrmq.rmq.idAllocator.Alloc(uint32(len(messages)))
+	if err != nil {
+		log.Error("RocksMQ: alloc id failed.", zap.Error(err))
+		return []UniqueID{}, err
+	}
+	payloads := make([][]byte, len(messages))
+	for i, msg := range messages {
+		payloads[i] = msg.Payload
+	}
+	result, err := rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:     rocksmqpb.RaftCommandType_Produce,
+		Topic:    topicName,
+		Payloads: payloads,
+		MsgID:    idStart,
+	})
+	if err != nil {
+		return []UniqueID{}, err
+	}
+	return result.([]UniqueID), nil
+}
+
+// Consume reads messages from this node and replicates the new consume position
+func (rrmq *raftRocksMQ) Consume(topicName string, groupName string, n int) ([]ConsumerMessage, error) {
+	if rrmq.rmq.isClosed() {
+		return nil, errors.New(RmqNotServingErrMsg)
+	}
+	mu, _ := rrmq.consumeMu.LoadOrStore(constructCurrentID(topicName, groupName), new(sync.Mutex))
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	ll, ok := topicMu.Load(topicName)
+	if !ok {
+		return nil, fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return nil, fmt.Errorf("get mutex failed, topic name = %s", topicName)
+	}
+	lock.Lock()
+	consumerMessage, err := rrmq.rmq.readMessages(topicName, groupName, n)
+	lock.Unlock()
+	if err != nil || len(consumerMessage) == 0 {
+		return consumerMessage, err
+	}
+
+	firstID := consumerMessage[0].MsgID
+	lastID := consumerMessage[len(consumerMessage)-1].MsgID
+	_, err = rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:         rocksmqpb.RaftCommandType_Ack,
+		Topic:        topicName,
+		Group:        groupName,
+		MsgID:        lastID + 1,
+		FirstAckedID: firstID,
+		LastAckedID:  lastID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return consumerMessage, nil
+}
+
+// Seek moves the consume position of a consumer group on every node
+func (rrmq *raftRocksMQ) Seek(topicName string, groupName string, msgID UniqueID) error {
+	_, err := rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:  rocksmqpb.RaftCommandType_Seek,
+		Topic: topicName,
+		Group: groupName,
+		MsgID: msgID,
+	})
+	return err
+}
+
+// SeekToLatest moves the consume position of a consumer group after the latest message on every node
+func (rrmq *raftRocksMQ) SeekToLatest(topicName, groupName string) error {
+	_, err := rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:  rocksmqpb.RaftCommandType_SeekToLatest,
+		Topic: topicName,
+		Group: groupName,
+	})
+	return err
+}
+
+// ExistConsumerGroup checks the consumer group registered on this node
+func (rrmq *raftRocksMQ) ExistConsumerGroup(topicName string, groupName string) (bool, *Consumer) {
+	return rrmq.rmq.ExistConsumerGroup(topicName, groupName)
+}
+
+// Notify notifies the consumers registered on this node
+func (rrmq *raftRocksMQ) Notify(topicName, groupName string) {
+	rrmq.rmq.Notify(topicName, groupName)
+}
+
+// CreateReader creates a reader on this node
+func (rrmq *raftRocksMQ) CreateReader(topicName string, startMsgID UniqueID, messageIDInclusive bool, subscriptionRolePrefix string) (string, error) {
+	return rrmq.rmq.CreateReader(topicName, startMsgID, messageIDInclusive, subscriptionRolePrefix)
+}
+
+// ReaderSeek seeks a reader on this node
+func (rrmq *raftRocksMQ) ReaderSeek(topicName string, readerName string, msgID UniqueID) {
+	rrmq.rmq.ReaderSeek(topicName, readerName, msgID)
+}
+
+// Next gets the next message of a reader on this node
+func (rrmq *raftRocksMQ) Next(ctx context.Context, topicName string, readerName string, messageIDInclusive bool) (*ConsumerMessage, error) {
+	return rrmq.rmq.Next(ctx, topicName, readerName, messageIDInclusive)
+}
+
+// HasNext checks whether a reader on this node has next message
+func (rrmq *raftRocksMQ) HasNext(topicName string, readerName string, messageIDInclusive bool) bool {
+	return rrmq.rmq.HasNext(topicName, readerName, messageIDInclusive)
+}
+
+// CloseReader closes a reader on this node
+func (rrmq *raftRocksMQ) CloseReader(topicName string, readerName string) {
+	rrmq.rmq.CloseReader(topicName, readerName)
+}
+
+// ListTopics lists the topics on this node
+func (rrmq *raftRocksMQ) ListTopics() ([]string, error) {
+	return rrmq.rmq.ListTopics()
+}
+
+// GetTopicStats collects the stats of a topic on this node
+func (rrmq *raftRocksMQ) GetTopicStats(topicName string) (*TopicStats, error) {
+	return rrmq.rmq.GetTopicStats(topicName)
+}
+
+// SetTopicRetention overrides the retention of a topic on every node
+func (rrmq *raftRocksMQ) SetTopicRetention(topicName string, retentionTimeInMinutes, retentionSizeInMB int64) error {
+	_, err := rrmq.propose(&rocksmqpb.RaftCommand{
+		Type:                   rocksmqpb.RaftCommandType_SetTopicRetention,
+		Topic:                  topicName,
+		RetentionTimeInMinutes: retentionTimeInMinutes,
+		RetentionSizeInMb:      retentionSizeInMB,
+	})
+	return err
+}
+
+// GetDiskUsage returns the disk usage of this node
+func (rrmq *raftRocksMQ) GetDiskUsage() (*DiskUsage, error) {
+	return rrmq.rmq.GetDiskUsage()
+}
@@ -0,0 +1,225 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/allocator"
+)
+
+func getFreeAddress(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer lis.Close()
+	return lis.Addr().String()
+}
+
+func newTestRaftRocksMQ(t *testing.T, suffix string, idAllocator allocator.GIDAllocator, id uint64, peers map[uint64]string) (*raftRocksMQ, *Service) {
+	rocksdbPath := rmqPath + dbPathSuffix + suffix
+	rmq, err := NewRocksMQ(rocksdbPath, idAllocator)
+	assert.Nil(t, err)
+
+	rrmq, err := NewRaftRocksMQ(rmq, RaftOptions{
+		NodeID:  id,
+		Peers:   peers,
+		DataDir: rmqPath + "_raft" + suffix,
+	})
+	assert.Nil(t, err)
+	service := NewService(rrmq)
+	err = service.Start(peers[id])
+	assert.Nil(t, err)
+	return rrmq, service
+}
+
+func removeTestRaftRocksMQ(suffix string) {
+	os.RemoveAll(rmqPath + kvPathSuffix + suffix)
+	os.RemoveAll(rmqPath + dbPathSuffix + suffix)
+	os.RemoveAll(rmqPath + dbPathSuffix + suffix + kvSuffix)
+	os.RemoveAll(rmqPath + "_raft" + suffix)
+}
+
+func waitRaftLeader(t *testing.T, rrmqs ...*raftRocksMQ) {
+	for i := 0; i < 100; i++ {
+		for _, rrmq := range rrmqs {
+			if rrmq.node.Leader() != 0 {
+				return
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.FailNow(t, "no rocksmq raft leader is elected")
+}
+
+func TestRaftRocksMQ_NewRaftRocksMQ(t *testing.T) {
+	suffix := "_raft_new"
+	defer removeTestRaftRocksMQ(suffix)
+	idAllocator := InitIDAllocator(rmqPath + kvPathSuffix + suffix)
+	rmq, err := NewRocksMQ(rmqPath+dbPathSuffix+suffix, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+
+	_, err = NewRaftRocksMQ(rmq, RaftOptions{
+		NodeID:  2,
+		Peers:   map[uint64]string{1: getFreeAddress(t)},
+		DataDir: rmqPath + "_raft" + suffix,
+	})
+	assert.Error(t, err)
+}
+
+func TestRaftRocksMQ_SingleNode(t *testing.T) {
+	suffix := "_raft_single"
+	removeTestRaftRocksMQ(suffix)
+	defer removeTestRaftRocksMQ(suffix)
+	peers := map[uint64]string{1: getFreeAddress(t)}
+	idAllocator := InitIDAllocator(rmqPath + kvPathSuffix + suffix)
+	rrmq, service := newTestRaftRocksMQ(t, suffix, idAllocator, 1, peers)
+	waitRaftLeader(t, rrmq)
+
+	topicName := newChanName()
+	groupName := newGroupName()
+	err := rrmq.CreateTopic(topicName)
+	assert.Nil(t, err)
+	err = rrmq.CreateConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+
+	msgs := make([]ProducerMessage, 10)
+	for i := range msgs {
+		msgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	ids, err := rrmq.Produce(topicName, msgs[:5])
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(ids))
+	ids2, err := rrmq.Produce(topicName, msgs[5:])
+	assert.Nil(t, err)
+	ids = append(ids, ids2...)
+	for i := 1; i < len(ids); i++ {
+		assert.Less(t, ids[i-1], ids[i])
+	}
+
+	cMsgs, err := rrmq.Consume(topicName, groupName, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(cMsgs))
+	assert.Equal(t, ids[0], cMsgs[0].MsgID)
+	assert.Equal(t, "message_2", string(cMsgs[2].Payload))
+
+	err = rrmq.Seek(topicName, groupName, ids[8])
+	assert.Nil(t, err)
+	cMsgs, err = rrmq.Consume(topicName, groupName, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cMsgs))
+	assert.Equal(t, ids[8], cMsgs[0].MsgID)
+
+	readerName, err := rrmq.CreateReader(topicName, ids[0], true, "")
+	assert.Nil(t, err)
+	assert.True(t, rrmq.HasNext(topicName, readerName, true))
+	rrmq.CloseReader(topicName, readerName)
+
+	// the topics and the consume positions are restored after restart
+	service.Stop()
+	rrmq.Close()
+	rrmq, service = newTestRaftRocksMQ(t, suffix, idAllocator, 1, peers)
+	defer rrmq.Close()
+	defer service.Stop()
+	waitRaftLeader(t, rrmq)
+	assert.Less(t, uint64(0), rrmq.AppliedIndex())
+	// the raft state is restored from the store
+	assert.Equal(t, ids[len(ids)-1], rrmq.lastID)
+	val, err := rrmq.loadRaftState(raftAppliedIndexKey)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, val)
+
+	ids2, err = rrmq.Produce(topicName, msgs[:1])
+	assert.Nil(t, err)
+	assert.Less(t, ids[len(ids)-1], ids2[0])
+	cMsgs, err = rrmq.Consume(topicName, groupName, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(cMsgs))
+	assert.Equal(t, ids2[0], cMsgs[0].MsgID)
+
+	err = rrmq.SeekToLatest(topicName, groupName)
+	assert.Nil(t, err)
+	err = rrmq.DestroyConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+	err = rrmq.DestroyTopic(topicName)
+	assert.Nil(t, err)
+	_, err = rrmq.Produce(topicName, msgs[:1])
+	assert.Error(t, err)
+}
+
+func TestRaftRocksMQ_Replicate(t *testing.T) {
+	peers := map[uint64]string{}
+	for id := uint64(1); id <= 3; id++ {
+		peers[id] = getFreeAddress(t)
+	}
+	rrmqs := make(map[uint64]*raftRocksMQ)
+	for id := range peers {
+		suffix := fmt.Sprintf("_raft_replicate_%d", id)
+		removeTestRaftRocksMQ(suffix)
+		defer removeTestRaftRocksMQ(suffix)
+		idAllocator := InitIDAllocator(rmqPath + kvPathSuffix + suffix)
+		rrmq, service := newTestRaftRocksMQ(t, suffix, idAllocator, id, peers)
+		defer rrmq.Close()
+		defer service.Stop()
+		rrmqs[id] = rrmq
+	}
+	waitRaftLeader(t, rrmqs[1], rrmqs[2], rrmqs[3])
+
+	topicName := newChanName()
+	groupName := newGroupName()
+	err := rrmqs[1].CreateTopic(topicName)
+	assert.Nil(t, err)
+	err = rrmqs[2].CreateConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+	ids, err := rrmqs[3].Produce(topicName, []ProducerMessage{{Payload: []byte("a")}, {Payload: []byte("b")}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ids))
+
+	// the consume position is replicated, so the group continues on another node
+	cMsgs, err := rrmqs[2].Consume(topicName, groupName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(cMsgs))
+	assert.Equal(t, ids[0], cMsgs[0].MsgID)
+
+	// wait for the other nodes to apply the consume position
+	index := rrmqs[2].AppliedIndex()
+	for _, rrmq := range rrmqs {
+		for rrmq.AppliedIndex() < index {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	cMsgs, err = rrmqs[1].Consume(topicName, groupName, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(cMsgs))
+	assert.Equal(t, ids[1], cMsgs[0].MsgID)
+	assert.Equal(t, "b", string(cMsgs[0].Payload))
+
+	// the retention of the topic is replicated too
+	err = rrmqs[1].SetTopicRetention(topicName, -1, -1)
+	assert.Nil(t, err)
+	index = rrmqs[1].AppliedIndex()
+	for rrmqs[3].AppliedIndex() < index {
+		time.Sleep(10 * time.Millisecond)
+	}
+	stats, err := rrmqs[3].GetTopicStats(topicName)
+	assert.Nil(t, err)
+	assert.True(t, stats.RetentionOverridden)
+	assert.Equal(t, int64(-1), stats.RetentionTimeInMinutes)
+	assert.Equal(t, int64(2), stats.MessageCount)
+}
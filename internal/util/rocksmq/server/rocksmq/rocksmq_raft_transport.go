@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rocksmqpb"
+	"github.com/milvus-io/milvus/internal/util/rocksmq/server/raftnode"
+)
+
+const (
+	raftSendTimeout = 5 * time.Second
+	// raftSendBufSize is the number of messages buffered for a peer, messages are dropped when the
+	// buffer is full and raft retries them
+	raftSendBufSize = 4096
+)
+
+var _ raftnode.Transport = (*raftTransport)(nil)
+
+// raftTransport sends raft messages to the peers with the SendRaftMessage rpc of RocksMQService
+type raftTransport struct {
+	nodeID uint64
+	peers  map[uint64]string
+	node   *raftnode.Node
+
+	mu      sync.Mutex
+	senders map[uint64]*raftPeerSender
+	closed  bool
+}
+
+type raftPeerSender struct {
+	id      uint64
+	address string
+	msgs    chan raftpb.Message
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func newRaftTransport(nodeID uint64, peers map[uint64]string) *raftTransport {
+	return &raftTransport{
+		nodeID:  nodeID,
+		peers:   peers,
+		senders: make(map[uint64]*raftPeerSender),
+	}
+}
+
+// Send queues the messages to the senders of the peers, the messages of a peer are sent in order
+func (t *raftTransport) Send(msgs []raftpb.Message) {
+	for _, msg := range msgs {
+		sender := t.getSender(msg.To)
+		if sender == nil {
+			continue
+		}
+		select {
+		case sender.msgs <- msg:
+		default:
+			log.Warn("Rocksmq raft message dropped", zap.Uint64("to", msg.To), zap.String("type", msg.Type.String()))
+			t.node.ReportUnreachable(msg.To)
+		}
+	}
+}
+
+func (t *raftTransport) getSender(id uint64) *raftPeerSender {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return nil
+	}
+	if sender, ok := t.senders[id]; ok {
+		return sender
+	}
+	address, ok := t.peers[id]
+	if !ok {
+		log.Warn("Rocksmq raft peer not exist", zap.Uint64("id", id))
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	sender := &raftPeerSender{
+		id:      id,
+		address: address,
+		msgs:    make(chan raftpb.Message, raftSendBufSize),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	sender.wg.Add(1)
+	go sender.run(t.node)
+	t.senders[id] = sender
+	return sender
+}
+
+// Close stops all the senders
+func (t *raftTransport) Close() {
+	t.mu.Lock()
+	t.closed = true
+	senders := t.senders
+	t.senders = make(map[uint64]*raftPeerSender)
+	t.mu.Unlock()
+	for _, sender := range senders {
+		sender.cancel()
+		sender.wg.Wait()
+	}
+}
+
+func (s *raftPeerSender) run(node *raftnode.Node) {
+	defer s.wg.Done()
+	// the connection is established lazily and reconnected by grpc
+	conn, err := grpc.DialContext(s.ctx, s.address, grpc.WithInsecure())
+	if err != nil {
+		log.Error("Rocksmq failed to dial raft peer", zap.Uint64("id", s.id), zap.String("address", s.address), zap.Error(err))
+		return
+	}
+	defer conn.Close()
+	client := rocksmqpb.NewRocksMQServiceClient(conn)
+	for {
+		select {
+		case msg := <-s.msgs:
+			if err := s.send(client, msg); err != nil {
+				// raft sends heartbeats continuously, don't flood the log
+				log.Debug("Rocksmq failed to send raft message", zap.Uint64("to", s.id), zap.Error(err))
+				node.ReportUnreachable(s.id)
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *raftPeerSender) send(client rocksmqpb.RocksMQServiceClient, msg raftpb.Message) error {
+	data, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, raftSendTimeout)
+	defer cancel()
+	status, err := client.SendRaftMessage(ctx, &rocksmqpb.RaftMessage{Data: data})
+	if err != nil {
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,378 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/rocksmqpb"
+)
+
+const (
+	remoteDialTimeout  = 5 * time.Second
+	remoteWatchBackoff = time.Second
+)
+
+var _ RocksMQ = (*remoteRocksMQ)(nil)
+var _ RocksMQAdmin = (*remoteRocksMQ)(nil)
+
+// remoteRocksMQ accesses a RocksMQ served by Service on another host. The consumers are registered
+// in this process, and notified by the watches of their consumer groups.
+type remoteRocksMQ struct {
+	conn   *grpc.ClientConn
+	client rocksmqpb.RocksMQServiceClient
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu        sync.Mutex
+	consumers map[string]*remoteConsumer
+	wg        sync.WaitGroup
+}
+
+type remoteConsumer struct {
+	consumer *Consumer
+	cancel   context.CancelFunc
+}
+
+// NewRemoteRocksMQ connects the first reachable address of the RocksMQ services
+func NewRemoteRocksMQ(addresses []string) (*remoteRocksMQ, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("rocksmq service addresses are empty")
+	}
+	var conn *grpc.ClientConn
+	var err error
+	for _, address := range addresses {
+		ctx, cancel := context.WithTimeout(context.Background(), remoteDialTimeout)
+		conn, err = grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock(),
+			grpc.WithDefaultCallOptions(
+				grpc.MaxCallRecvMsgSize(math.MaxInt32),
+				grpc.MaxCallSendMsgSize(math.MaxInt32)))
+		cancel()
+		if err == nil {
+			log.Debug("Rocksmq connected remote service", zap.String("address", address))
+			break
+		}
+		log.Warn("Rocksmq failed to connect remote service", zap.String("address", address), zap.Error(err))
+	}
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &remoteRocksMQ{
+		conn:      conn,
+		client:    rocksmqpb.NewRocksMQServiceClient(conn),
+		ctx:       ctx,
+		cancel:    cancel,
+		consumers: make(map[string]*remoteConsumer),
+	}, nil
+}
+
+// CreateTopic creates a topic
+func (r *remoteRocksMQ) CreateTopic(topicName string) error {
+	status, err := r.client.CreateTopic(r.ctx, &rocksmqpb.TopicRequest{Topic: topicName})
+	if err != nil {
+		return err
+	}
+	return errorFromStatus(status)
+}
+
+// DestroyTopic destroys a topic and unregisters its consumers in this process
+func (r *remoteRocksMQ) DestroyTopic(topicName string) error {
+	status, err := r.client.DestroyTopic(r.ctx, &rocksmqpb.TopicRequest{Topic: topicName})
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	for key, rc := range r.consumers {
+		if rc.consumer.Topic == topicName {
+			rc.cancel()
+			delete(r.consumers, key)
+		}
+	}
+	r.mu.Unlock()
+	return errorFromStatus(status)
+}
+
+// CreateConsumerGroup creates a consumer group
+func (r *remoteRocksMQ) CreateConsumerGroup(topicName string, groupName string) error {
+	status, err := r.client.CreateConsumerGroup(r.ctx, &rocksmqpb.ConsumerGroupRequest{Topic: topicName, Group: groupName})
+	if err != nil {
+		return err
+	}
+	return errorFromStatus(status)
+}
+
+// DestroyConsumerGroup destroys a consumer group, the consumer registered in this process is closed
+func (r *remoteRocksMQ) DestroyConsumerGroup(topicName string, groupName string) error {
+	status, err := r.client.DestroyConsumerGroup(r.ctx, &rocksmqpb.ConsumerGroupRequest{Topic: topicName, Group: groupName})
+	if err != nil {
+		return err
+	}
+	if err := errorFromStatus(status); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	key := constructCurrentID(topicName, groupName)
+	if rc, ok := r.consumers[key]; ok {
+		rc.cancel()
+		close(rc.consumer.MsgMutex)
+		delete(r.consumers, key)
+	}
+	r.mu.Unlock()
+	return nil
+}
+
+// Close stops the watches and closes the connection, the remote RocksMQ is not closed
+func (r *remoteRocksMQ) Close() {
+	r.cancel()
+	r.wg.Wait()
+	if err := r.conn.Close(); err != nil {
+		log.Warn("Rocksmq failed to close remote connection", zap.Error(err))
+	}
+}
+
+// RegisterConsumer registers a consumer in this process, and watches its consumer group
+func (r *remoteRocksMQ) RegisterConsumer(consumer *Consumer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := constructCurrentID(consumer.Topic, consumer.GroupName)
+	if _, ok := r.consumers[key]; ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(r.ctx)
+	r.consumers[key] = &remoteConsumer{consumer: consumer, cancel: cancel}
+	r.wg.Add(1)
+	go r.watch(ctx, consumer)
+}
+
+// watch forwards the notifications of a consumer group to the consumer, and watches again if the
+// stream is broken
+func (r *remoteRocksMQ) watch(ctx context.Context, consumer *Consumer) {
+	defer r.wg.Done()
+	for {
+		stream, err := r.client.Watch(ctx, &rocksmqpb.ConsumerGroupRequest{Topic: consumer.Topic, Group: consumer.GroupName})
+		if err == nil {
+			for {
+				if _, err = stream.Recv(); err != nil {
+					break
+				}
+				r.Notify(consumer.Topic, consumer.GroupName)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(remoteWatchBackoff):
+			log.Debug("Rocksmq watch consumer group again", zap.String("topic", consumer.Topic),
+				zap.String("group", consumer.GroupName), zap.Error(err))
+		}
+	}
+}
+
+// Produce produces messages
+func (r *remoteRocksMQ) Produce(topicName string, messages []ProducerMessage) ([]UniqueID, error) {
+	payloads := make([][]byte, len(messages))
+	for i, msg := range messages {
+		payloads[i] = msg.Payload
+	}
+	resp, err := r.client.Produce(r.ctx, &rocksmqpb.ProduceRequest{Topic: topicName, Payloads: payloads})
+	if err != nil {
+		return []UniqueID{}, err
+	}
+	if err := errorFromStatus(resp.Status); err != nil {
+		return []UniqueID{}, err
+	}
+	return resp.MsgIDs, nil
+}
+
+// Consume consumes messages of a consumer group
+func (r *remoteRocksMQ) Consume(topicName string, groupName string, n int) ([]ConsumerMessage, error) {
+	resp, err := r.client.Consume(r.ctx, &rocksmqpb.ConsumeRequest{Topic: topicName, Group: groupName, N: int64(n)})
+	if err != nil {
+		return nil, err
+	}
+	if err := errorFromStatus(resp.Status); err != nil {
+		return nil, err
+	}
+	msgs := make([]ConsumerMessage, 0, len(resp.Messages))
+	for _, msg := range resp.Messages {
+		msgs = append(msgs, ConsumerMessage{MsgID: msg.MsgID, Payload: msg.Payload})
+	}
+	return msgs, nil
+}
+
+// Seek moves the consume position of a consumer group
+func (r *remoteRocksMQ) Seek(topicName string, groupName string, msgID UniqueID) error {
+	status, err := r.client.Seek(r.ctx, &rocksmqpb.SeekRequest{Topic: topicName, Group: groupName, MsgID: msgID})
+	if err != nil {
+		return err
+	}
+	return errorFromStatus(status)
+}
+
+// SeekToLatest moves the consume position of a consumer group after the latest message
+func (r *remoteRocksMQ) SeekToLatest(topicName, groupName string) error {
+	status, err := r.client.SeekToLatest(r.ctx, &rocksmqpb.ConsumerGroupRequest{Topic: topicName, Group: groupName})
+	if err != nil {
+		return err
+	}
+	return errorFromStatus(status)
+}
+
+// ExistConsumerGroup checks whether the consumer group exists, the consumer registered in this process
+// is returned, it's registered if the group exists but it's only registered by other processes
+func (r *remoteRocksMQ) ExistConsumerGroup(topicName string, groupName string) (bool, *Consumer) {
+	resp, err := r.client.ExistConsumerGroup(r.ctx, &rocksmqpb.ConsumerGroupRequest{Topic: topicName, Group: groupName})
+	if err != nil || errorFromStatus(resp.Status) != nil || !resp.Value {
+		return false, nil
+	}
+	r.mu.Lock()
+	rc, ok := r.consumers[constructCurrentID(topicName, groupName)]
+	r.mu.Unlock()
+	if ok {
+		return true, rc.consumer
+	}
+	consumer := &Consumer{
+		Topic:     topicName,
+		GroupName: groupName,
+		MsgMutex:  make(chan struct{}, 1),
+	}
+	r.RegisterConsumer(consumer)
+	return true, consumer
+}
+
+// Notify notifies the consumer registered in this process
+func (r *remoteRocksMQ) Notify(topicName, groupName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if rc, ok := r.consumers[constructCurrentID(topicName, groupName)]; ok {
+		select {
+		case rc.consumer.MsgMutex <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// CreateReader creates a reader
+func (r *remoteRocksMQ) CreateReader(topicName string, startMsgID UniqueID, messageIDInclusive bool, subscriptionRolePrefix string) (string, error) {
+	resp, err := r.client.CreateReader(r.ctx, &rocksmqpb.CreateReaderRequest{
+		Topic:                  topicName,
+		StartMsgID:             startMsgID,
+		Inclusive:              messageIDInclusive,
+		SubscriptionRolePrefix: subscriptionRolePrefix,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := errorFromStatus(resp.Status); err != nil {
+		return "", err
+	}
+	return resp.ReaderName, nil
+}
+
+// ReaderSeek seeks a reader
+func (r *remoteRocksMQ) ReaderSeek(topicName string, readerName string, msgID UniqueID) {
+	_, err := r.client.ReaderSeek(r.ctx, &rocksmqpb.ReaderSeekRequest{Topic: topicName, ReaderName: readerName, MsgID: msgID})
+	if err != nil {
+		log.Warn("Rocksmq failed to seek remote reader", zap.String("topic", topicName), zap.String("readerName", readerName), zap.Error(err))
+	}
+}
+
+// Next gets the next message of a reader
+func (r *remoteRocksMQ) Next(ctx context.Context, topicName string, readerName string, messageIDInclusive bool) (*ConsumerMessage, error) {
+	resp, err := r.client.Next(ctx, &rocksmqpb.ReaderRequest{Topic: topicName, ReaderName: readerName, Inclusive: messageIDInclusive})
+	if err != nil {
+		return nil, err
+	}
+	if err := errorFromStatus(resp.Status); err != nil {
+		return nil, err
+	}
+	if resp.Message == nil {
+		return nil, nil
+	}
+	return &ConsumerMessage{MsgID: resp.Message.MsgID, Payload: resp.Message.Payload}, nil
+}
+
+// HasNext checks whether a reader has next message
+func (r *remoteRocksMQ) HasNext(topicName string, readerName string, messageIDInclusive bool) bool {
+	resp, err := r.client.HasNext(r.ctx, &rocksmqpb.ReaderRequest{Topic: topicName, ReaderName: readerName, Inclusive: messageIDInclusive})
+	if err != nil || errorFromStatus(resp.Status) != nil {
+		return false
+	}
+	return resp.Value
+}
+
+// CloseReader closes a reader
+func (r *remoteRocksMQ) CloseReader(topicName string, readerName string) {
+	_, err := r.client.CloseReader(r.ctx, &rocksmqpb.ReaderRequest{Topic: topicName, ReaderName: readerName})
+	if err != nil {
+		log.Warn("Rocksmq failed to close remote reader", zap.String("topic", topicName), zap.String("readerName", readerName), zap.Error(err))
+	}
+}
+
+// ListTopics lists the topics
+func (r *remoteRocksMQ) ListTopics() ([]string, error) {
+	resp, err := r.client.ListTopics(r.ctx, &rocksmqpb.ListTopicsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := errorFromStatus(resp.Status); err != nil {
+		return nil, err
+	}
+	return resp.Topics, nil
+}
+
+// GetTopicStats collects the stats of a topic
+func (r *remoteRocksMQ) GetTopicStats(topicName string) (*TopicStats, error) {
+	resp, err := r.client.GetTopicStats(r.ctx, &rocksmqpb.TopicRequest{Topic: topicName})
+	if err != nil {
+		return nil, err
+	}
+	if err := errorFromStatus(resp.Status); err != nil {
+		return nil, err
+	}
+	return topicStatsFromProto(resp.Stats), nil
+}
+
+// SetTopicRetention overrides the retention of a topic
+func (r *remoteRocksMQ) SetTopicRetention(topicName string, retentionTimeInMinutes, retentionSizeInMB int64) error {
+	status, err := r.client.SetTopicRetention(r.ctx, &rocksmqpb.SetTopicRetentionRequest{
+		Topic:                  topicName,
+		RetentionTimeInMinutes: retentionTimeInMinutes,
+		RetentionSizeInMb:      retentionSizeInMB,
+	})
+	if err != nil {
+		return err
+	}
+	return errorFromStatus(status)
+}
+
+// GetDiskUsage returns the disk usage of the remote RocksMQ
+func (r *remoteRocksMQ) GetDiskUsage() (*DiskUsage, error) {
+	resp, err := r.client.GetDiskUsage(r.ctx, &rocksmqpb.GetDiskUsageRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := errorFromStatus(resp.Status); err != nil {
+		return nil, err
+	}
+	return &DiskUsage{StoreSize: resp.StoreSize, MetaSize: resp.MetaSize}, nil
+}
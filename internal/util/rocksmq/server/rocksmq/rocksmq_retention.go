@@ -31,6 +31,13 @@ var RocksmqRetentionTimeInMinutes int64 = 10080
 // RocksmqRetentionSizeInMB is the size of retention
 var RocksmqRetentionSizeInMB int64 = 8192
 
+// The retention of a topic could be overridden by RetentionTimeTitle + topic and RetentionSizeTitle + topic
+// in kv, -1 means the messages of the topic are retained forever
+const (
+	RetentionTimeTitle = "retention_time/"
+	RetentionSizeTitle = "retention_size/"
+)
+
 // Const value that used to convert unit
 const (
 	MB     = 1024 * 1024
@@ -98,7 +105,6 @@ func (ri *retentionInfo) retention() error {
 			return nil
 		case t := <-ticker.C:
 			timeNow := t.Unix()
-			checkTime := atomic.LoadInt64(&RocksmqRetentionTimeInMinutes) * MINUTE / 10
 			ri.mutex.RLock()
 			ri.topics.Range(func(k, v interface{}) bool {
 				topic, _ := k.(string)
@@ -107,6 +113,14 @@ func (ri *retentionInfo) retention() error {
 					log.Warn("Can't parse lastRetention to int64", zap.String("topic", topic), zap.Any("value", v))
 					return true
 				}
+				retentionTime, retentionSize, _ := ri.topicRetention(topic)
+				if retentionTime == -1 && retentionSize == -1 {
+					return true
+				}
+				var checkTime int64
+				if retentionTime != -1 {
+					checkTime = retentionTime * MINUTE / 10
+				}
 				if lastRetentionTs+checkTime < timeNow {
 					err := ri.expiredCleanUp(topic)
 					if err != nil {
@@ -128,6 +142,31 @@ func (ri *retentionInfo) Stop() {
 	})
 }
 
+// topicRetention returns the retention time and size of topic, which are the global retention unless
+// they are overridden for the topic. Setting either global dimension to -1 disables the global retention
+// as a whole, while -1 in an override only disables that dimension of the topic.
+func (ri *retentionInfo) topicRetention(topic string) (int64, int64, bool) {
+	retentionTime, retentionSize := int64(-1), int64(-1)
+	if checkRetention() {
+		retentionTime = atomic.LoadInt64(&RocksmqRetentionTimeInMinutes)
+		retentionSize = atomic.LoadInt64(&RocksmqRetentionSizeInMB)
+	}
+	overridden := false
+	if val, err := ri.kv.Load(RetentionTimeTitle + topic); err == nil && val != "" {
+		if t, err := strconv.ParseInt(val, 10, 64); err == nil {
+			retentionTime = t
+			overridden = true
+		}
+	}
+	if val, err := ri.kv.Load(RetentionSizeTitle + topic); err == nil && val != "" {
+		if size, err := strconv.ParseInt(val, 10, 64); err == nil {
+			retentionSize = size
+			overridden = true
+		}
+	}
+	return retentionTime, retentionSize, overridden
+}
+
 // expiredCleanUp check message retention by page:
 // 1. check acked timestamp of each page id, if expired, the whole page is expired;
 // 2. check acked size from the last unexpired page id;
@@ -141,6 +180,8 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	var pageEndID UniqueID = 0
 	var err error
 
+	retentionTime, retentionSize, _ := ri.topicRetention(topic)
+
 	fixedAckedTsKey, _ := constructKey(AckedTsTitle, topic)
 
 	pageReadOpts := gorocksdb.NewDefaultReadOptions()
@@ -178,7 +219,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 			if err != nil {
 				return err
 			}
-			if msgTimeExpiredCheck(ackedTs) {
+			if msgTimeExpiredCheck(ackedTs, retentionTime) {
 				pageEndID = pageID
 				pValue := pageIter.Value()
 				size, err := strconv.ParseInt(string(pValue.Data()), 10, 64)
@@ -277,7 +318,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 			return err
 		}
 		curDeleteSize := deletedAckedSize + size
-		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize) {
+		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize, retentionSize) {
 			pageEndID, err = strconv.ParseInt(pKeyStr[FixedChannelNameLen+1:], 10, 64)
 			if err != nil {
 				return err
@@ -379,10 +420,16 @@ func DeleteMessages(db *gorocksdb.DB, topic string, startID, endID UniqueID) err
 	return nil
 }
 
-func msgTimeExpiredCheck(ackedTs int64) bool {
-	return ackedTs+atomic.LoadInt64(&RocksmqRetentionTimeInMinutes)*MINUTE < time.Now().Unix()
+func msgTimeExpiredCheck(ackedTs int64, retentionTimeInMinutes int64) bool {
+	if retentionTimeInMinutes == -1 {
+		return false
+	}
+	return ackedTs+retentionTimeInMinutes*MINUTE < time.Now().Unix()
 }
 
-func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64) bool {
-	return ackedSize-deletedAckedSize > atomic.LoadInt64(&RocksmqRetentionSizeInMB)*MB
+func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64, retentionSizeInMB int64) bool {
+	if retentionSizeInMB == -1 {
+		return false
+	}
+	return ackedSize-deletedAckedSize > retentionSizeInMB*MB
 }
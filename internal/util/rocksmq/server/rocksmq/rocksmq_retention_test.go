@@ -117,6 +117,164 @@ func TestRmqRetention(t *testing.T) {
 	time.Sleep(time.Duration(checkTimeInterval+1) * time.Second)
 }
 
+func TestRmqRetention_GlobalDisabled(t *testing.T) {
+	// the size limit alone doesn't delete anything once the global retention time is -1
+	atomic.StoreInt64(&RocksmqRetentionSizeInMB, 0)
+	atomic.StoreInt64(&RocksmqRetentionTimeInMinutes, -1)
+	defer atomic.StoreInt64(&RocksmqRetentionTimeInMinutes, 0)
+	atomic.StoreInt64(&RocksmqPageSize, 10)
+	atomic.StoreInt64(&TickerTimeInSeconds, 2)
+	defer atomic.StoreInt64(&TickerTimeInSeconds, 6)
+	suffix := "global_disabled"
+	kvPath := retentionPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := retentionPath + dbPathSuffix + suffix
+	defer os.RemoveAll(rocksdbPath)
+	metaPath := retentionPath + metaPathSuffix + suffix
+	defer os.RemoveAll(metaPath)
+
+	rmq, err := NewRocksMQ(rocksdbPath, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.stopRetention()
+
+	topicName := "topic_global_disabled"
+	err = rmq.CreateTopic(topicName)
+	assert.Nil(t, err)
+	defer rmq.DestroyTopic(topicName)
+
+	retentionTime, retentionSize, overridden := rmq.retentionInfo.topicRetention(topicName)
+	assert.Equal(t, int64(-1), retentionTime)
+	assert.Equal(t, int64(-1), retentionSize)
+	assert.False(t, overridden)
+
+	msgNum := 100
+	pMsgs := make([]ProducerMessage, msgNum)
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	ids, err := rmq.Produce(topicName, pMsgs)
+	assert.Nil(t, err)
+	assert.Equal(t, len(pMsgs), len(ids))
+
+	groupName := "test_group"
+	err = rmq.CreateConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+	rmq.RegisterConsumer(&Consumer{
+		Topic:     topicName,
+		GroupName: groupName,
+	})
+	for i := 0; i < msgNum; i++ {
+		cMsg, err := rmq.Consume(topicName, groupName, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(cMsg))
+	}
+
+	time.Sleep(3 * time.Second)
+	err = rmq.Seek(topicName, groupName, ids[0])
+	assert.Nil(t, err)
+	newRes, err := rmq.Consume(topicName, groupName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(newRes))
+	assert.Equal(t, ids[0], newRes[0].MsgID)
+
+	// a per-topic override still applies on its own
+	err = rmq.SetTopicRetention(topicName, -1, 1)
+	assert.Nil(t, err)
+	retentionTime, retentionSize, overridden = rmq.retentionInfo.topicRetention(topicName)
+	assert.Equal(t, int64(-1), retentionTime)
+	assert.Equal(t, int64(1), retentionSize)
+	assert.True(t, overridden)
+}
+
+func TestRmqRetention_TopicRetention(t *testing.T) {
+	atomic.StoreInt64(&RocksmqRetentionSizeInMB, 0)
+	atomic.StoreInt64(&RocksmqRetentionTimeInMinutes, 0)
+	atomic.StoreInt64(&RocksmqPageSize, 10)
+	atomic.StoreInt64(&TickerTimeInSeconds, 2)
+	defer atomic.StoreInt64(&TickerTimeInSeconds, 6)
+	suffix := "topic_retention"
+	kvPath := retentionPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := retentionPath + dbPathSuffix + suffix
+	defer os.RemoveAll(rocksdbPath)
+	metaPath := retentionPath + metaPathSuffix + suffix
+	defer os.RemoveAll(metaPath)
+
+	rmq, err := NewRocksMQ(rocksdbPath, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.stopRetention()
+
+	topicName := "topic_retained"
+	err = rmq.CreateTopic(topicName)
+	assert.Nil(t, err)
+	defer rmq.DestroyTopic(topicName)
+
+	err = rmq.SetTopicRetention("topic_not_exist", -1, -1)
+	assert.Error(t, err)
+	err = rmq.SetTopicRetention(topicName, -2, -1)
+	assert.Error(t, err)
+	// the messages of the topic are retained forever, though the global retention deletes all the acked messages
+	err = rmq.SetTopicRetention(topicName, -1, -1)
+	assert.Nil(t, err)
+	retentionTime, retentionSize, overridden := rmq.retentionInfo.topicRetention(topicName)
+	assert.Equal(t, int64(-1), retentionTime)
+	assert.Equal(t, int64(-1), retentionSize)
+	assert.True(t, overridden)
+
+	msgNum := 100
+	pMsgs := make([]ProducerMessage, msgNum)
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	ids, err := rmq.Produce(topicName, pMsgs)
+	assert.Nil(t, err)
+	assert.Equal(t, len(pMsgs), len(ids))
+
+	groupName := "test_group"
+	err = rmq.CreateConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+	rmq.RegisterConsumer(&Consumer{
+		Topic:     topicName,
+		GroupName: groupName,
+	})
+	for i := 0; i < msgNum; i++ {
+		cMsg, err := rmq.Consume(topicName, groupName, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(cMsg))
+	}
+
+	time.Sleep(3 * time.Second)
+	err = rmq.Seek(topicName, groupName, ids[msgNum/2])
+	assert.Nil(t, err)
+	newRes, err := rmq.Consume(topicName, groupName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(newRes))
+	assert.Equal(t, ids[msgNum/2], newRes[0].MsgID)
+
+	// reset to the global retention
+	err = rmq.SetTopicRetention(topicName, 0, 0)
+	assert.Nil(t, err)
+	retentionTime, retentionSize, overridden = rmq.retentionInfo.topicRetention(topicName)
+	assert.Equal(t, int64(0), retentionTime)
+	assert.Equal(t, int64(0), retentionSize)
+	assert.False(t, overridden)
+}
+
+func TestRmqRetention_ExpiredCheck(t *testing.T) {
+	now := time.Now().Unix()
+	assert.True(t, msgTimeExpiredCheck(now-2*MINUTE, 1))
+	assert.False(t, msgTimeExpiredCheck(now, 1))
+	assert.False(t, msgTimeExpiredCheck(0, -1))
+
+	assert.True(t, msgSizeExpiredCheck(0, 2*MB, 1))
+	assert.False(t, msgSizeExpiredCheck(MB, 2*MB, 1))
+	assert.False(t, msgSizeExpiredCheck(0, 2*MB, -1))
+}
+
 func TestRetentionInfo_InitRetentionInfo(t *testing.T) {
 	suffix := "init"
 	kvPath := retentionPath + kvPathSuffix + suffix
@@ -0,0 +1,342 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"sync"
+
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rocksmqpb"
+)
+
+var _ rocksmqpb.RocksMQServiceServer = (*Service)(nil)
+
+// Service serves a RocksMQ with grpc, so that the processes on other hosts could access it. If the
+// RocksMQ is replicated, the service also receives the raft messages from the other nodes.
+type Service struct {
+	rmq RocksMQ
+
+	mu         sync.Mutex
+	grpcServer *grpc.Server
+	wg         sync.WaitGroup
+}
+
+// NewService creates a grpc service of rmq
+func NewService(rmq RocksMQ) *Service {
+	return &Service{rmq: rmq}
+}
+
+// Start listens on address and serves the requests in background
+func (s *Service) Start(address string) error {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Error("Rocksmq service failed to listen", zap.String("address", address), zap.Error(err))
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.grpcServer = grpc.NewServer(
+		grpc.MaxRecvMsgSize(math.MaxInt32),
+		grpc.MaxSendMsgSize(math.MaxInt32))
+	rocksmqpb.RegisterRocksMQServiceServer(s.grpcServer, s)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if err := s.grpcServer.Serve(lis); err != nil {
+			log.Error("Rocksmq service stopped serving", zap.String("address", address), zap.Error(err))
+		}
+	}()
+	log.Debug("Rocksmq service started", zap.String("address", address))
+	return nil
+}
+
+// Stop stops serving, the RocksMQ is not closed
+func (s *Service) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+		s.wg.Wait()
+		s.grpcServer = nil
+	}
+}
+
+func statusFromError(err error) *commonpb.Status {
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func errorFromStatus(status *commonpb.Status) error {
+	if status == nil {
+		return errors.New("rocksmq service returned nil status")
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(status.Reason)
+	}
+	return nil
+}
+
+// CreateTopic creates a topic
+func (s *Service) CreateTopic(ctx context.Context, req *rocksmqpb.TopicRequest) (*commonpb.Status, error) {
+	return statusFromError(s.rmq.CreateTopic(req.Topic)), nil
+}
+
+// DestroyTopic destroys a topic
+func (s *Service) DestroyTopic(ctx context.Context, req *rocksmqpb.TopicRequest) (*commonpb.Status, error) {
+	return statusFromError(s.rmq.DestroyTopic(req.Topic)), nil
+}
+
+// CreateConsumerGroup creates a consumer group
+func (s *Service) CreateConsumerGroup(ctx context.Context, req *rocksmqpb.ConsumerGroupRequest) (*commonpb.Status, error) {
+	return statusFromError(s.rmq.CreateConsumerGroup(req.Topic, req.Group)), nil
+}
+
+// DestroyConsumerGroup destroys a consumer group, the watches of the group are finished
+func (s *Service) DestroyConsumerGroup(ctx context.Context, req *rocksmqpb.ConsumerGroupRequest) (*commonpb.Status, error) {
+	return statusFromError(s.rmq.DestroyConsumerGroup(req.Topic, req.Group)), nil
+}
+
+// ExistConsumerGroup checks whether a consumer group exists and is registered by a watch
+func (s *Service) ExistConsumerGroup(ctx context.Context, req *rocksmqpb.ConsumerGroupRequest) (*rocksmqpb.BoolResponse, error) {
+	exist, _ := s.rmq.ExistConsumerGroup(req.Topic, req.Group)
+	return &rocksmqpb.BoolResponse{Status: statusFromError(nil), Value: exist}, nil
+}
+
+// Produce produces messages
+func (s *Service) Produce(ctx context.Context, req *rocksmqpb.ProduceRequest) (*rocksmqpb.ProduceResponse, error) {
+	messages := make([]ProducerMessage, len(req.Payloads))
+	for i, payload := range req.Payloads {
+		messages[i] = ProducerMessage{Payload: payload}
+	}
+	msgIDs, err := s.rmq.Produce(req.Topic, messages)
+	return &rocksmqpb.ProduceResponse{Status: statusFromError(err), MsgIDs: msgIDs}, nil
+}
+
+// Consume consumes messages of a consumer group
+func (s *Service) Consume(ctx context.Context, req *rocksmqpb.ConsumeRequest) (*rocksmqpb.ConsumeResponse, error) {
+	msgs, err := s.rmq.Consume(req.Topic, req.Group, int(req.N))
+	resp := &rocksmqpb.ConsumeResponse{Status: statusFromError(err)}
+	for _, msg := range msgs {
+		resp.Messages = append(resp.Messages, &rocksmqpb.ConsumerMessage{MsgID: msg.MsgID, Payload: msg.Payload})
+	}
+	return resp, nil
+}
+
+// Seek moves the consume position of a consumer group
+func (s *Service) Seek(ctx context.Context, req *rocksmqpb.SeekRequest) (*commonpb.Status, error) {
+	return statusFromError(s.rmq.Seek(req.Topic, req.Group, req.MsgID)), nil
+}
+
+// SeekToLatest moves the consume position of a consumer group after the latest message
+func (s *Service) SeekToLatest(ctx context.Context, req *rocksmqpb.ConsumerGroupRequest) (*commonpb.Status, error) {
+	return statusFromError(s.rmq.SeekToLatest(req.Topic, req.Group)), nil
+}
+
+// Watch registers the consumer group and streams a notification whenever the group may have new
+// messages, the stream is finished when the group is destroyed
+func (s *Service) Watch(req *rocksmqpb.ConsumerGroupRequest, stream rocksmqpb.RocksMQService_WatchServer) error {
+	exist, consumer := s.rmq.ExistConsumerGroup(req.Topic, req.Group)
+	if !exist {
+		s.rmq.RegisterConsumer(&Consumer{
+			Topic:     req.Topic,
+			GroupName: req.Group,
+			MsgMutex:  make(chan struct{}, 1),
+		})
+		// another watch may have registered the group
+		if exist, consumer = s.rmq.ExistConsumerGroup(req.Topic, req.Group); !exist {
+			return errors.New("consumer group of " + req.Topic + " doesn't exist")
+		}
+	}
+	// notify once so that the messages produced before the watch are consumed
+	if err := stream.Send(&rocksmqpb.WatchResponse{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case _, ok := <-consumer.MsgMutex:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&rocksmqpb.WatchResponse{}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// CreateReader creates a reader
+func (s *Service) CreateReader(ctx context.Context, req *rocksmqpb.CreateReaderRequest) (*rocksmqpb.CreateReaderResponse, error) {
+	readerName, err := s.rmq.CreateReader(req.Topic, req.StartMsgID, req.Inclusive, req.SubscriptionRolePrefix)
+	return &rocksmqpb.CreateReaderResponse{Status: statusFromError(err), ReaderName: readerName}, nil
+}
+
+// ReaderSeek seeks a reader
+func (s *Service) ReaderSeek(ctx context.Context, req *rocksmqpb.ReaderSeekRequest) (*commonpb.Status, error) {
+	s.rmq.ReaderSeek(req.Topic, req.ReaderName, req.MsgID)
+	return statusFromError(nil), nil
+}
+
+// Next gets the next message of a reader, it blocks until a message is available or the request is canceled
+func (s *Service) Next(ctx context.Context, req *rocksmqpb.ReaderRequest) (*rocksmqpb.NextResponse, error) {
+	msg, err := s.rmq.Next(ctx, req.Topic, req.ReaderName, req.Inclusive)
+	resp := &rocksmqpb.NextResponse{Status: statusFromError(err)}
+	if msg != nil {
+		resp.Message = &rocksmqpb.ConsumerMessage{MsgID: msg.MsgID, Payload: msg.Payload}
+	}
+	return resp, nil
+}
+
+// HasNext checks whether a reader has next message
+func (s *Service) HasNext(ctx context.Context, req *rocksmqpb.ReaderRequest) (*rocksmqpb.BoolResponse, error) {
+	hasNext := s.rmq.HasNext(req.Topic, req.ReaderName, req.Inclusive)
+	return &rocksmqpb.BoolResponse{Status: statusFromError(nil), Value: hasNext}, nil
+}
+
+// CloseReader closes a reader
+func (s *Service) CloseReader(ctx context.Context, req *rocksmqpb.ReaderRequest) (*commonpb.Status, error) {
+	s.rmq.CloseReader(req.Topic, req.ReaderName)
+	return statusFromError(nil), nil
+}
+
+func (s *Service) admin() (RocksMQAdmin, error) {
+	admin, ok := s.rmq.(RocksMQAdmin)
+	if !ok {
+		return nil, errors.New("rocksmq doesn't support admin operations")
+	}
+	return admin, nil
+}
+
+// ListTopics lists the topics
+func (s *Service) ListTopics(ctx context.Context, req *rocksmqpb.ListTopicsRequest) (*rocksmqpb.ListTopicsResponse, error) {
+	admin, err := s.admin()
+	if err != nil {
+		return &rocksmqpb.ListTopicsResponse{Status: statusFromError(err)}, nil
+	}
+	topics, err := admin.ListTopics()
+	return &rocksmqpb.ListTopicsResponse{Status: statusFromError(err), Topics: topics}, nil
+}
+
+// GetTopicStats collects the stats of a topic
+func (s *Service) GetTopicStats(ctx context.Context, req *rocksmqpb.TopicRequest) (*rocksmqpb.GetTopicStatsResponse, error) {
+	admin, err := s.admin()
+	if err != nil {
+		return &rocksmqpb.GetTopicStatsResponse{Status: statusFromError(err)}, nil
+	}
+	stats, err := admin.GetTopicStats(req.Topic)
+	if err != nil {
+		return &rocksmqpb.GetTopicStatsResponse{Status: statusFromError(err)}, nil
+	}
+	return &rocksmqpb.GetTopicStatsResponse{Status: statusFromError(nil), Stats: topicStatsToProto(stats)}, nil
+}
+
+// SetTopicRetention overrides the retention of a topic
+func (s *Service) SetTopicRetention(ctx context.Context, req *rocksmqpb.SetTopicRetentionRequest) (*commonpb.Status, error) {
+	admin, err := s.admin()
+	if err != nil {
+		return statusFromError(err), nil
+	}
+	return statusFromError(admin.SetTopicRetention(req.Topic, req.RetentionTimeInMinutes, req.RetentionSizeInMb)), nil
+}
+
+// GetDiskUsage returns the disk usage of the RocksMQ
+func (s *Service) GetDiskUsage(ctx context.Context, req *rocksmqpb.GetDiskUsageRequest) (*rocksmqpb.GetDiskUsageResponse, error) {
+	admin, err := s.admin()
+	if err != nil {
+		return &rocksmqpb.GetDiskUsageResponse{Status: statusFromError(err)}, nil
+	}
+	usage, err := admin.GetDiskUsage()
+	if err != nil {
+		return &rocksmqpb.GetDiskUsageResponse{Status: statusFromError(err)}, nil
+	}
+	return &rocksmqpb.GetDiskUsageResponse{
+		Status:    statusFromError(nil),
+		StoreSize: usage.StoreSize,
+		MetaSize:  usage.MetaSize,
+	}, nil
+}
+
+func topicStatsToProto(stats *TopicStats) *rocksmqpb.TopicStats {
+	pb := &rocksmqpb.TopicStats{
+		Topic:                  stats.Topic,
+		RetentionTimeInMinutes: stats.RetentionTimeInMinutes,
+		RetentionSizeInMb:      stats.RetentionSizeInMB,
+		RetentionOverridden:    stats.RetentionOverridden,
+		MessageCount:           stats.MessageCount,
+		MessageSize:            stats.MessageSize,
+		AckedSize:              stats.AckedSize,
+		DiskUsage:              stats.DiskUsage,
+	}
+	for _, page := range stats.Pages {
+		pb.Pages = append(pb.Pages, &rocksmqpb.PageStats{EndID: page.EndID, Size: page.Size, AckedTs: page.AckedTs})
+	}
+	for _, group := range stats.Groups {
+		pb.Groups = append(pb.Groups, &rocksmqpb.ConsumerGroupStats{
+			Group:     group.Group,
+			CurrentID: group.CurrentID,
+			AckedID:   group.AckedID,
+		})
+	}
+	return pb
+}
+
+func topicStatsFromProto(pb *rocksmqpb.TopicStats) *TopicStats {
+	stats := &TopicStats{
+		Topic:                  pb.Topic,
+		RetentionTimeInMinutes: pb.RetentionTimeInMinutes,
+		RetentionSizeInMB:      pb.RetentionSizeInMb,
+		RetentionOverridden:    pb.RetentionOverridden,
+		MessageCount:           pb.MessageCount,
+		MessageSize:            pb.MessageSize,
+		AckedSize:              pb.AckedSize,
+		DiskUsage:              pb.DiskUsage,
+	}
+	for _, page := range pb.Pages {
+		stats.Pages = append(stats.Pages, PageStats{EndID: page.EndID, Size: page.Size, AckedTs: page.AckedTs})
+	}
+	for _, group := range pb.Groups {
+		stats.Groups = append(stats.Groups, ConsumerGroupStats{
+			Group:     group.Group,
+			CurrentID: group.CurrentID,
+			AckedID:   group.AckedID,
+		})
+	}
+	return stats
+}
+
+// SendRaftMessage delivers a raft message to the replicated RocksMQ
+func (s *Service) SendRaftMessage(ctx context.Context, req *rocksmqpb.RaftMessage) (*commonpb.Status, error) {
+	rrmq, ok := s.rmq.(*raftRocksMQ)
+	if !ok {
+		return statusFromError(errors.New("rocksmq is not replicated")), nil
+	}
+	var msg raftpb.Message
+	if err := msg.Unmarshal(req.Data); err != nil {
+		return statusFromError(err), nil
+	}
+	return statusFromError(rrmq.node.Step(ctx, msg)), nil
+}
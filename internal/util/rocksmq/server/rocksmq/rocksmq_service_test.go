@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/rocksmqpb"
+)
+
+func TestRemoteRocksMQ(t *testing.T) {
+	suffix := "_remote"
+	kvPath := rmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+	rocksdbPath := rmqPath + dbPathSuffix + suffix
+	defer os.RemoveAll(rocksdbPath)
+	defer os.RemoveAll(rocksdbPath + kvSuffix)
+
+	rmq, err := NewRocksMQ(rocksdbPath, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+
+	address := getFreeAddress(t)
+	service := NewService(rmq)
+	err = service.Start(address)
+	assert.Nil(t, err)
+	defer service.Stop()
+
+	_, err = NewRemoteRocksMQ(nil)
+	assert.Error(t, err)
+	remote, err := NewRemoteRocksMQ([]string{address})
+	assert.Nil(t, err)
+	defer remote.Close()
+
+	topicName := newChanName()
+	groupName := newGroupName()
+	err = remote.CreateTopic(topicName)
+	assert.Nil(t, err)
+	exist, _ := remote.ExistConsumerGroup(topicName, groupName)
+	assert.False(t, exist)
+	err = remote.CreateConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+	consumer := &Consumer{
+		Topic:     topicName,
+		GroupName: groupName,
+		MsgMutex:  make(chan struct{}, 1),
+	}
+	remote.RegisterConsumer(consumer)
+	// the watch notifies once it's established
+	select {
+	case <-consumer.MsgMutex:
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "consumer is not notified")
+	}
+	exist, existed := remote.ExistConsumerGroup(topicName, groupName)
+	assert.True(t, exist)
+	assert.Equal(t, consumer, existed)
+
+	ids, err := remote.Produce(topicName, []ProducerMessage{{Payload: []byte("a")}, {Payload: []byte("b")}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ids))
+	select {
+	case <-consumer.MsgMutex:
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "consumer is not notified")
+	}
+
+	cMsgs, err := remote.Consume(topicName, groupName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(cMsgs))
+	assert.Equal(t, ids[0], cMsgs[0].MsgID)
+	err = remote.Seek(topicName, groupName, ids[0])
+	assert.Nil(t, err)
+	cMsgs, err = remote.Consume(topicName, groupName, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cMsgs))
+	assert.Equal(t, "b", string(cMsgs[1].Payload))
+	err = remote.SeekToLatest(topicName, groupName)
+	assert.Nil(t, err)
+	cMsgs, err = remote.Consume(topicName, groupName, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(cMsgs))
+
+	readerName, err := remote.CreateReader(topicName, ids[0], true, "")
+	assert.Nil(t, err)
+	assert.True(t, remote.HasNext(topicName, readerName, true))
+	remote.ReaderSeek(topicName, readerName, ids[1])
+	assert.False(t, remote.HasNext(topicName, readerName, false))
+	remote.CloseReader(topicName, readerName)
+
+	topics, err := remote.ListTopics()
+	assert.Nil(t, err)
+	assert.Contains(t, topics, topicName)
+	err = remote.SetTopicRetention(topicName, -1, 1024)
+	assert.Nil(t, err)
+	stats, err := remote.GetTopicStats(topicName)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), stats.MessageCount)
+	assert.Equal(t, int64(-1), stats.RetentionTimeInMinutes)
+	assert.Equal(t, int64(1024), stats.RetentionSizeInMB)
+	assert.Equal(t, 1, len(stats.Groups))
+	_, err = remote.GetTopicStats("topic_not_exist")
+	assert.Error(t, err)
+	_, err = remote.GetDiskUsage()
+	assert.Nil(t, err)
+
+	err = remote.DestroyConsumerGroup(topicName, groupName)
+	assert.Nil(t, err)
+	// the consumer channel is closed after the pending notifications
+	for range consumer.MsgMutex {
+	}
+	err = remote.DestroyTopic(topicName)
+	assert.Nil(t, err)
+	_, err = remote.Produce(topicName, []ProducerMessage{{Payload: []byte("a")}})
+	assert.Error(t, err)
+
+	resp, err := service.SendRaftMessage(context.Background(), &rocksmqpb.RaftMessage{})
+	assert.Nil(t, err)
+	assert.Error(t, errorFromStatus(resp))
+}
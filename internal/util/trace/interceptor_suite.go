@@ -14,8 +14,7 @@ package trace
 import (
 	"context"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
-	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
@@ -35,12 +34,50 @@ var (
 	}
 )
 
-// GetInterceptorOpts returns the Option of gRPC open-tracing
-func GetInterceptorOpts() []grpc_opentracing.Option {
-	tracer := opentracing.GlobalTracer()
-	opts := []grpc_opentracing.Option{
-		grpc_opentracing.WithTracer(tracer),
-		grpc_opentracing.WithFilterFunc(filterFunc),
+// UnaryServerInterceptor returns the gRPC unary server interceptor which starts a span for each
+// traced method, with the parent span context extracted from the metadata of the request.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	interceptor := otelgrpc.UnaryServerInterceptor()
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !filterFunc(ctx, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// StreamServerInterceptor returns the gRPC stream server interceptor which starts a span for each
+// traced method.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	interceptor := otelgrpc.StreamServerInterceptor()
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !filterFunc(ss.Context(), info.FullMethod) {
+			return handler(srv, ss)
+		}
+		return interceptor(srv, ss, info, handler)
+	}
+}
+
+// UnaryClientInterceptor returns the gRPC unary client interceptor which starts a span for each
+// traced method and injects the span context into the metadata of the request.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	interceptor := otelgrpc.UnaryClientInterceptor()
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !filterFunc(ctx, method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return interceptor(ctx, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+// StreamClientInterceptor returns the gRPC stream client interceptor which starts a span for each
+// traced method.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	interceptor := otelgrpc.StreamClientInterceptor()
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !filterFunc(ctx, method) {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		return interceptor(ctx, desc, cc, method, streamer, opts...)
 	}
-	return opts
 }
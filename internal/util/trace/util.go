@@ -14,23 +14,75 @@ package trace
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"os"
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
-	slog "github.com/milvus-io/milvus/internal/log"
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/log"
-	"github.com/uber/jaeger-client-go"
-	"github.com/uber/jaeger-client-go/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
+const (
+	tracerName = "github.com/milvus-io/milvus"
+
+	// ExporterNone disables the tracing, the spans are not recorded
+	ExporterNone = "none"
+	// ExporterStdout prints the spans to stdout
+	ExporterStdout = "stdout"
+	// ExporterOTLP sends the spans to an OTLP gRPC receiver
+	ExporterOTLP = "otlp"
+
+	shutdownTimeout = 5 * time.Second
+)
+
+// Span is the span of OpenTelemetry
+type Span = oteltrace.Span
+
 var tracingCloserMtx sync.Mutex
 var tracingCloser io.Closer
 
-// InitTracing init global trace from env. If not specified, use default config.
+var propagator = propagation.TraceContext{}
+
+func init() {
+	otel.SetTextMapPropagator(propagator)
+}
+
+type tracerProviderCloser struct {
+	provider *sdktrace.TracerProvider
+}
+
+// Close flushes the spans in buffer and shuts the exporter down
+func (c *tracerProviderCloser) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return c.provider.Shutdown(ctx)
+}
+
+type noopCloser struct{}
+
+func (noopCloser) Close() error {
+	return nil
+}
+
+// InitTracing inits the global tracer provider with the exporter configured by trace.exporter, the
+// spans of a process are exported only once, so later calls return the closer of the first one.
 func InitTracing(serviceName string) io.Closer {
 	tracingCloserMtx.Lock()
 	defer tracingCloserMtx.Unlock()
@@ -39,46 +91,61 @@ func InitTracing(serviceName string) io.Closer {
 		return tracingCloser
 	}
 
-	cfg := &config.Configuration{
-		ServiceName: serviceName,
-		Sampler: &config.SamplerConfig{
-			Type:  "const",
-			Param: 0,
-		},
-	}
-	if true {
-		cfg = initFromEnv(serviceName)
-	}
-	tracer, closer, err := cfg.NewTracer()
-	tracingCloser = closer
+	paramtable.Params.Init()
+	exporter, err := newExporter(paramtable.Params.TraceExporter)
 	if err != nil {
-		log.Error(err)
-		tracingCloser = nil
+		log.Error("failed to create trace exporter", zap.String("exporter", paramtable.Params.TraceExporter), zap.Error(err))
+		return noopCloser{}
+	}
+	if exporter == nil {
+		tracingCloser = noopCloser{}
+		return tracingCloser
 	}
-	opentracing.SetGlobalTracer(tracer)
 
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(paramtable.Params.TraceSampleFraction))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.ServiceNameKey.String(serviceName),
+			attribute.Int("process.pid", os.Getpid()),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	tracingCloser = &tracerProviderCloser{provider: provider}
+	log.Debug("init tracing", zap.String("service", serviceName), zap.String("exporter", paramtable.Params.TraceExporter),
+		zap.Float64("sampleFraction", paramtable.Params.TraceSampleFraction))
 	return tracingCloser
 }
 
-func initFromEnv(serviceName string) *config.Configuration {
-	cfg, err := config.FromEnv()
-	if err != nil {
-		log.Error(err)
-		return nil
+func newExporter(exporter string) (sdktrace.SpanExporter, error) {
+	switch exporter {
+	case "", ExporterNone:
+		return nil, nil
+	case ExporterStdout:
+		return stdout.NewExporter(stdout.WithoutMetricExport(), stdout.WithPrettyPrint())
+	case ExporterOTLP:
+		opts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(paramtable.Params.TraceOTLPEndpoint)}
+		if paramtable.Params.TraceOTLPInsecure {
+			opts = append(opts, otlpgrpc.WithInsecure())
+		}
+		return otlp.NewExporter(context.Background(), otlpgrpc.NewDriver(opts...))
 	}
-	cfg.ServiceName = serviceName
-	return cfg
+	return nil, fmt.Errorf("unknown trace exporter %s", exporter)
+}
+
+func tracer() oteltrace.Tracer {
+	return otel.Tracer(tracerName)
 }
 
-// StartSpanFromContext starts a opentracing span. The default operation name is
+// StartSpanFromContext starts a span. The default operation name is
 // upper two call stacks of the function
-func StartSpanFromContext(ctx context.Context, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContext(ctx context.Context, opts ...oteltrace.SpanOption) (Span, context.Context) {
 	return StartSpanFromContextWithSkip(ctx, 3, opts...)
 }
 
-// StartSpanFromContextWithSkip starts a opentracing span with call skip. The operation
+// StartSpanFromContextWithSkip starts a span with call skip. The operation
 // name is upper @skip call stacks of the function
-func StartSpanFromContextWithSkip(ctx context.Context, skip int, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContextWithSkip(ctx context.Context, skip int, opts ...oteltrace.SpanOption) (Span, context.Context) {
 	if ctx == nil {
 		return NoopSpan(), nil
 	}
@@ -86,8 +153,8 @@ func StartSpanFromContextWithSkip(ctx context.Context, skip int, opts ...opentra
 	var pcs [1]uintptr
 	n := runtime.Callers(skip, pcs[:])
 	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, "unknown", opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
+		ctx, span := tracer().Start(ctx, "unknown", opts...)
+		span.RecordError(errors.New("runtime.Callers failed"))
 		return span, ctx
 	}
 	frames := runtime.CallersFrames(pcs[:])
@@ -97,26 +164,20 @@ func StartSpanFromContextWithSkip(ctx context.Context, skip int, opts ...opentra
 		name = name[lastSlash+1:]
 	}
 
-	if parent := opentracing.SpanFromContext(ctx); parent != nil {
-		opts = append(opts, opentracing.ChildOf(parent.Context()))
-	}
-	span := opentracing.StartSpan(name, opts...)
-
-	file, line := frame.File, frame.Line
-	span.LogFields(log.String("filename", file), log.Int("line", line))
-
-	return span, opentracing.ContextWithSpan(ctx, span)
+	opts = append(opts, oteltrace.WithAttributes(callerAttributes(frame.File, frame.Line)...))
+	ctx, span := tracer().Start(ctx, name, opts...)
+	return span, ctx
 }
 
-// StartSpanFromContextWithOperationName starts a opentracing span with specific operation name.
+// StartSpanFromContextWithOperationName starts a span with specific operation name.
 // And will log print the current call line number and file name.
-func StartSpanFromContextWithOperationName(ctx context.Context, operationName string, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContextWithOperationName(ctx context.Context, operationName string, opts ...oteltrace.SpanOption) (Span, context.Context) {
 	return StartSpanFromContextWithOperationNameWithSkip(ctx, operationName, 3, opts...)
 }
 
-// StartSpanFromContextWithOperationNameWithSkip starts a opentracing span with specific operation name.
+// StartSpanFromContextWithOperationNameWithSkip starts a span with specific operation name.
 // And will log print the current call line number and file name.
-func StartSpanFromContextWithOperationNameWithSkip(ctx context.Context, operationName string, skip int, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContextWithOperationNameWithSkip(ctx context.Context, operationName string, skip int, opts ...oteltrace.SpanOption) (Span, context.Context) {
 	if ctx == nil {
 		return NoopSpan(), nil
 	}
@@ -124,53 +185,51 @@ func StartSpanFromContextWithOperationNameWithSkip(ctx context.Context, operatio
 	var pcs [1]uintptr
 	n := runtime.Callers(skip, pcs[:])
 	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, operationName, opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
+		ctx, span := tracer().Start(ctx, operationName, opts...)
+		span.RecordError(errors.New("runtime.Callers failed"))
 		return span, ctx
 	}
 	frames := runtime.CallersFrames(pcs[:])
 	frame, _ := frames.Next()
-	file, line := frame.File, frame.Line
-
-	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
-		opts = append(opts, opentracing.ChildOf(parentSpan.Context()))
-	}
-	span := opentracing.StartSpan(operationName, opts...)
-	ctx = opentracing.ContextWithSpan(ctx, span)
-
-	span.LogFields(log.String("filename", file), log.Int("line", line))
 
+	opts = append(opts, oteltrace.WithAttributes(callerAttributes(frame.File, frame.Line)...))
+	ctx, span := tracer().Start(ctx, operationName, opts...)
 	return span, ctx
 }
 
+func callerAttributes(file string, line int) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.CodeFilepathKey.String(file),
+		semconv.CodeLineNumberKey.Int(line),
+	}
+}
+
 // LogError is a method to log error with span.
-func LogError(span opentracing.Span, err error) {
+func LogError(span Span, err error) {
 	if err == nil {
 		return
 	}
+	span.SetStatus(codes.Error, err.Error())
 
 	// Get caller frame.
 	var pcs [1]uintptr
 	n := runtime.Callers(2, pcs[:])
 	if n < 1 {
-		span.LogFields(log.Error(err))
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
-		slog.Warn("trace log error failed", zap.Error(err))
+		span.RecordError(err)
+		log.Warn("trace log error failed", zap.Error(err))
+		return
 	}
 
 	frames := runtime.CallersFrames(pcs[:])
 	frame, _ := frames.Next()
-	file, line := frame.File, frame.Line
-	span.LogFields(log.String("filename", file), log.Int("line", line), log.Error(err))
+	span.RecordError(err, oteltrace.WithAttributes(callerAttributes(frame.File, frame.Line)...))
 }
 
 // InfoFromSpan is a method return span details.
-func InfoFromSpan(span opentracing.Span) (traceID string, sampled, found bool) {
+func InfoFromSpan(span Span) (traceID string, sampled, found bool) {
 	if span != nil {
-		if spanContext, ok := span.Context().(jaeger.SpanContext); ok {
-			traceID = spanContext.TraceID().String()
-			sampled = spanContext.IsSampled()
-			return traceID, sampled, true
+		if spanContext := span.SpanContext(); spanContext.HasTraceID() {
+			return spanContext.TraceID().String(), spanContext.IsSampled(), true
 		}
 	}
 	return "", false, false
@@ -179,42 +238,63 @@ func InfoFromSpan(span opentracing.Span) (traceID string, sampled, found bool) {
 // InfoFromContext is a method return details of span associated with context.
 func InfoFromContext(ctx context.Context) (traceID string, sampled, found bool) {
 	if ctx != nil {
-		if span := opentracing.SpanFromContext(ctx); span != nil {
-			return InfoFromSpan(span)
-		}
+		return InfoFromSpan(oteltrace.SpanFromContext(ctx))
 	}
 	return "", false, false
 }
 
-// InjectContextToPulsarMsgProperties is a method inject span to pulsr message.
-func InjectContextToPulsarMsgProperties(sc opentracing.SpanContext, properties map[string]string) {
-	tracer := opentracing.GlobalTracer()
-	tracer.Inject(sc, opentracing.TextMap, PropertiesReaderWriter{properties})
+// ContextWithSpan returns a copy of ctx with span set as the current span.
+func ContextWithSpan(ctx context.Context, span Span) context.Context {
+	return oteltrace.ContextWithSpan(ctx, span)
 }
 
-// PropertiesReaderWriter is for saving trce in pulsar msg properties.
-// Implement Set and ForeachKey methods.
+// InjectContextToMsgProperties injects the span context of ctx into the properties of a message,
+// nothing is injected if ctx doesn't carry a valid span context.
+func InjectContextToMsgProperties(ctx context.Context, properties map[string]string) {
+	if ctx == nil || properties == nil {
+		return
+	}
+	propagator.Inject(ctx, PropertiesReaderWriter{PpMap: properties})
+}
+
+// ExtractContextFromMsgProperties returns a copy of ctx carrying the remote span context
+// extracted from the properties of a message.
+func ExtractContextFromMsgProperties(ctx context.Context, properties map[string]string) context.Context {
+	if len(properties) == 0 {
+		return ctx
+	}
+	return propagator.Extract(ctx, PropertiesReaderWriter{PpMap: properties})
+}
+
+// PropertiesReaderWriter is for saving trace in msg properties.
+// Implement Get, Set and Keys methods of propagation.TextMapCarrier.
 type PropertiesReaderWriter struct {
 	PpMap map[string]string
 }
 
+var _ propagation.TextMapCarrier = PropertiesReaderWriter{}
+
+// Get returns the value of key from PpMap.
+func (ppRW PropertiesReaderWriter) Get(key string) string {
+	return ppRW.PpMap[strings.ToLower(key)]
+}
+
 // Set sets key, value to PpMap.
 func (ppRW PropertiesReaderWriter) Set(key, val string) {
 	key = strings.ToLower(key)
 	ppRW.PpMap[key] = val
 }
 
-// ForeachKey iterates each key value of PpMap.
-func (ppRW PropertiesReaderWriter) ForeachKey(handler func(key, val string) error) error {
-	for k, val := range ppRW.PpMap {
-		if err := handler(k, val); err != nil {
-			return err
-		}
+// Keys lists the keys of PpMap.
+func (ppRW PropertiesReaderWriter) Keys() []string {
+	keys := make([]string, 0, len(ppRW.PpMap))
+	for k := range ppRW.PpMap {
+		keys = append(keys, k)
 	}
-	return nil
+	return keys
 }
 
 // NoopSpan is a minimal span to reduce overhead.
-func NoopSpan() opentracing.Span {
-	return opentracing.NoopTracer{}.StartSpan("Default-span")
+func NoopSpan() Span {
+	return oteltrace.SpanFromContext(context.Background())
 }
@@ -19,25 +19,31 @@ import (
 
 	"errors"
 
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
-type simpleStruct struct {
-	name  string
-	value string
-}
-
 func TestMain(m *testing.M) {
+	paramtable.Params.Init()
+	paramtable.Params.TraceExporter = ExporterStdout
 	closer := InitTracing("test")
 	defer closer.Close()
 	os.Exit(m.Run())
 }
 
 func TestInit(t *testing.T) {
-	cfg := initFromEnv("test")
-	assert.NotNil(t, cfg)
+	exporter, err := newExporter(ExporterNone)
+	assert.Nil(t, err)
+	assert.Nil(t, exporter)
+
+	exporter, err = newExporter(ExporterStdout)
+	assert.Nil(t, err)
+	assert.NotNil(t, exporter)
+
+	_, err = newExporter("jaeger")
+	assert.Error(t, err)
 }
 
 func TestTracing(t *testing.T) {
@@ -49,16 +55,13 @@ func TestTracing(t *testing.T) {
 	sp, ctx := StartSpanFromContext(ctx)
 	id, sampled, found := InfoFromContext(ctx)
 	fmt.Printf("traceID = %s, sampled = %t, found = %t", id, sampled, found)
-	sp.SetTag("tag1", "tag1")
+	assert.True(t, found)
+	sp.SetAttributes(attribute.String("tag1", "tag1"))
 	// use self-defined operation name for span
 	// sp, ctx := StartSpanFromContextWithOperationName(ctx, "self-defined name")
-	defer sp.Finish()
+	defer sp.End()
 
-	ss := &simpleStruct{
-		name:  "name",
-		value: "value",
-	}
-	sp.LogFields(oplog.String("key", "value"), oplog.Object("key", ss))
+	sp.AddEvent("event", oteltrace.WithAttributes(attribute.String("key", "value")))
 
 	err := caller(ctx)
 
@@ -71,9 +74,9 @@ func TestTracing(t *testing.T) {
 func caller(ctx context.Context) error {
 	for i := 0; i < 2; i++ {
 		// if span starts in a loop, defer is not allowed.
-		// manually call span.Finish() if error occurs or one loop ends
+		// manually call span.End() if error occurs or one loop ends
 		sp, _ := StartSpanFromContextWithOperationName(ctx, fmt.Sprintf("test:%d", i))
-		sp.SetTag(fmt.Sprintf("tags:%d", i), fmt.Sprintf("tags:%d", i))
+		sp.SetAttributes(attribute.String(fmt.Sprintf("tags:%d", i), fmt.Sprintf("tags:%d", i)))
 
 		var err error
 		if i == 1 {
@@ -82,11 +85,11 @@ func caller(ctx context.Context) error {
 
 		if err != nil {
 			LogError(sp, err)
-			sp.Finish()
+			sp.End()
 			return nil
 		}
 
-		sp.Finish()
+		sp.End()
 	}
 	return nil
 }
@@ -98,14 +101,27 @@ func TestInject(t *testing.T) {
 	//start span
 	//default use function name for operation name
 	sp, ctx := StartSpanFromContext(ctx)
+	defer sp.End()
 	id, sampled, found := InfoFromContext(ctx)
 	fmt.Printf("traceID = %s, sampled = %t, found = %t", id, sampled, found)
 	pp := PropertiesReaderWriter{PpMap: map[string]string{}}
-	InjectContextToPulsarMsgProperties(sp.Context(), pp.PpMap)
-	tracer := opentracing.GlobalTracer()
-	sc, _ := tracer.Extract(opentracing.TextMap, pp)
-	assert.NotNil(t, sc)
-
+	InjectContextToMsgProperties(ctx, pp.PpMap)
+	assert.NotEmpty(t, pp.Keys())
+
+	extracted := ExtractContextFromMsgProperties(context.Background(), pp.PpMap)
+	sc := oteltrace.SpanContextFromContext(extracted)
+	assert.True(t, sc.IsRemote())
+	assert.Equal(t, sp.SpanContext().TraceID(), sc.TraceID())
+	assert.Equal(t, sp.SpanContext().SpanID(), sc.SpanID())
+
+	// the span started from the extracted context is a child of the remote span
+	child, _ := StartSpanFromContext(extracted)
+	defer child.End()
+	traceID, _, found := InfoFromSpan(child)
+	assert.True(t, found)
+	assert.Equal(t, id, traceID)
+
+	assert.Equal(t, context.Background(), ExtractContextFromMsgProperties(context.Background(), nil))
 }
 
 func TestTraceError(t *testing.T) {
@@ -133,4 +149,9 @@ func TestTraceError(t *testing.T) {
 	assert.Equal(t, id, "")
 	assert.Equal(t, sampled, false)
 	assert.Equal(t, found, false)
+
+	id, sampled, found = InfoFromSpan(NoopSpan())
+	assert.Equal(t, id, "")
+	assert.Equal(t, sampled, false)
+	assert.Equal(t, found, false)
 }
@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// Float16 and BFloat16 vectors are encoded as 2 bytes per element in little endian.
+const halfFloatSize = 2
+
+// Float16ToFloat32 converts an IEEE 754 half precision value to float32
+func Float16ToFloat32(h uint16) float32 {
+	sign := uint32(h>>15) << 31
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h) & 0x3ff
+
+	switch {
+	case exp == 0 && mant == 0:
+		return math.Float32frombits(sign)
+	case exp == 0:
+		// subnormal, normalize it
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | e<<23 | mant<<13)
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0xff<<23 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// Float32ToFloat16 converts float32 to an IEEE 754 half precision value, rounding to nearest even
+func Float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	e := exp - 127 + 15
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+	if e <= 0 {
+		if e < -10 {
+			return sign
+		}
+		// subnormal
+		mant |= 0x800000
+		shift := uint32(14 - e)
+		half := uint16(mant >> shift)
+		rem := mant & (1<<shift - 1)
+		mid := uint32(1) << (shift - 1)
+		if rem > mid || (rem == mid && half&1 == 1) {
+			half++
+		}
+		return sign | half
+	}
+
+	half := uint16(e)<<10 | uint16(mant>>13)
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		// may carry into the exponent, which is still correct
+		half++
+	}
+	return sign | half
+}
+
+// BFloat16ToFloat32 converts a brain floating point value to float32
+func BFloat16ToFloat32(b uint16) float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// Float32ToBFloat16 converts float32 to a brain floating point value, rounding to nearest even
+func Float32ToBFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if math.IsNaN(float64(f)) {
+		return uint16(bits>>16) | 0x40
+	}
+	bits += 0x7fff + (bits>>16)&1
+	return uint16(bits >> 16)
+}
+
+// Float16BytesToFloat32s converts the encoded float16 vector data to float32 values
+func Float16BytesToFloat32s(data []byte) []float32 {
+	result := make([]float32, len(data)/halfFloatSize)
+	for i := range result {
+		result[i] = Float16ToFloat32(binary.LittleEndian.Uint16(data[i*halfFloatSize:]))
+	}
+	return result
+}
+
+// Float32sToFloat16Bytes encodes float32 values as float16 vector data
+func Float32sToFloat16Bytes(data []float32) []byte {
+	result := make([]byte, len(data)*halfFloatSize)
+	for i, f := range data {
+		binary.LittleEndian.PutUint16(result[i*halfFloatSize:], Float32ToFloat16(f))
+	}
+	return result
+}
+
+// BFloat16BytesToFloat32s converts the encoded bfloat16 vector data to float32 values
+func BFloat16BytesToFloat32s(data []byte) []float32 {
+	result := make([]float32, len(data)/halfFloatSize)
+	for i := range result {
+		result[i] = BFloat16ToFloat32(binary.LittleEndian.Uint16(data[i*halfFloatSize:]))
+	}
+	return result
+}
+
+// Float32sToBFloat16Bytes encodes float32 values as bfloat16 vector data
+func Float32sToBFloat16Bytes(data []float32) []byte {
+	result := make([]byte, len(data)*halfFloatSize)
+	for i, f := range data {
+		binary.LittleEndian.PutUint16(result[i*halfFloatSize:], Float32ToBFloat16(f))
+	}
+	return result
+}
+
+// HalfFloatVectorToFloatVector converts float16 or bfloat16 vectors to float vectors,
+// any other vectors are returned as is
+func HalfFloatVectorToFloatVector(vectors *schemapb.VectorField) *schemapb.VectorField {
+	var data []float32
+	switch vectors.GetData().(type) {
+	case *schemapb.VectorField_Float16Vector:
+		data = Float16BytesToFloat32s(vectors.GetFloat16Vector())
+	case *schemapb.VectorField_Bfloat16Vector:
+		data = BFloat16BytesToFloat32s(vectors.GetBfloat16Vector())
+	default:
+		return vectors
+	}
+	return &schemapb.VectorField{
+		Dim: vectors.GetDim(),
+		Data: &schemapb.VectorField_FloatVector{
+			FloatVector: &schemapb.FloatArray{
+				Data: data,
+			},
+		},
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestFloat16(t *testing.T) {
+	cases := []struct {
+		f float32
+		h uint16
+	}{
+		{0, 0x0000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.5, 0x3800},
+		{65504, 0x7bff},
+		{float32(math.Inf(1)), 0x7c00},
+		{float32(math.Inf(-1)), 0xfc00},
+		{5.960464477539063e-08, 0x0001}, // min subnormal
+		{6.103515625e-05, 0x0400},       // min normal
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.h, Float32ToFloat16(c.f), c.f)
+		assert.Equal(t, c.f, Float16ToFloat32(c.h), c.h)
+	}
+
+	// overflow and underflow
+	assert.Equal(t, uint16(0x7c00), Float32ToFloat16(1e6))
+	assert.Equal(t, uint16(0), Float32ToFloat16(1e-10))
+	// round to nearest even
+	assert.Equal(t, uint16(0x3c00), Float32ToFloat16(1+1.0/2048))
+	assert.Equal(t, uint16(0x3c02), Float32ToFloat16(1+3.0/2048))
+	assert.True(t, math.IsNaN(float64(Float16ToFloat32(Float32ToFloat16(float32(math.NaN()))))))
+}
+
+func TestBFloat16(t *testing.T) {
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16(1))
+	assert.Equal(t, uint16(0xc000), Float32ToBFloat16(-2))
+	assert.Equal(t, float32(1), BFloat16ToFloat32(0x3f80))
+	assert.Equal(t, float32(-2), BFloat16ToFloat32(0xc000))
+	// round to nearest even
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16(math.Float32frombits(0x3f808000)))
+	assert.Equal(t, uint16(0x3f82), Float32ToBFloat16(math.Float32frombits(0x3f818000)))
+	assert.True(t, math.IsNaN(float64(BFloat16ToFloat32(Float32ToBFloat16(float32(math.NaN()))))))
+}
+
+func TestHalfFloatBytes(t *testing.T) {
+	data := []float32{1, -2, 0.5, 0}
+	assert.Equal(t, data, Float16BytesToFloat32s(Float32sToFloat16Bytes(data)))
+	assert.Equal(t, data, BFloat16BytesToFloat32s(Float32sToBFloat16Bytes(data)))
+	assert.Equal(t, 8, len(Float32sToFloat16Bytes(data)))
+}
+
+func TestHalfFloatVectorToFloatVector(t *testing.T) {
+	data := []float32{1, -2, 0.5, 0}
+	vectors := HalfFloatVectorToFloatVector(&schemapb.VectorField{
+		Dim:  2,
+		Data: &schemapb.VectorField_Float16Vector{Float16Vector: Float32sToFloat16Bytes(data)},
+	})
+	assert.Equal(t, int64(2), vectors.GetDim())
+	assert.Equal(t, data, vectors.GetFloatVector().GetData())
+
+	vectors = HalfFloatVectorToFloatVector(&schemapb.VectorField{
+		Dim:  2,
+		Data: &schemapb.VectorField_Bfloat16Vector{Bfloat16Vector: Float32sToBFloat16Bytes(data)},
+	})
+	assert.Equal(t, data, vectors.GetFloatVector().GetData())
+
+	binary := &schemapb.VectorField{
+		Dim:  8,
+		Data: &schemapb.VectorField_BinaryVector{BinaryVector: []byte{1}},
+	}
+	assert.Same(t, binary, HalfFloatVectorToFloatVector(binary))
+	assert.Nil(t, HalfFloatVectorToFloatVector(nil))
+}
+
+func TestHasHalfFloatVectorField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	assert.False(t, HasHalfFloatVectorField(schema))
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, DataType: schemapb.DataType_BFloat16Vector})
+	assert.True(t, HasHalfFloatVectorField(schema))
+	assert.False(t, HasHalfFloatVectorField(nil))
+}
+
+func TestAppendFieldData_HalfFloatVector(t *testing.T) {
+	data := Float32sToFloat16Bytes([]float32{1, 2, 3, 4})
+	src := []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_Float16Vector,
+			FieldName: "fp16",
+			FieldId:   100,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 2,
+					Data: &schemapb.VectorField_Float16Vector{
+						Float16Vector: data,
+					},
+				},
+			},
+		},
+		{
+			Type:      schemapb.DataType_BFloat16Vector,
+			FieldName: "bf16",
+			FieldId:   101,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 2,
+					Data: &schemapb.VectorField_Bfloat16Vector{
+						Bfloat16Vector: data,
+					},
+				},
+			},
+		},
+	}
+	dst := make([]*schemapb.FieldData, 2)
+	AppendFieldData(dst, src, 1)
+	assert.Equal(t, data[4:8], dst[0].GetVectors().GetFloat16Vector())
+	assert.Equal(t, data[4:8], dst[1].GetVectors().GetBfloat16Vector())
+
+	AppendFieldData(dst, src, 0)
+	assert.Equal(t, append(append([]byte{}, data[4:8]...), data[0:4]...), dst[0].GetVectors().GetFloat16Vector())
+	assert.Equal(t, append(append([]byte{}, data[4:8]...), data[0:4]...), dst[1].GetVectors().GetBfloat16Vector())
+	// the source data is untouched
+	assert.Equal(t, Float32sToFloat16Bytes([]float32{1, 2, 3, 4}), data)
+}
@@ -59,6 +59,19 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 					break
 				}
 			}
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			for _, kv := range fs.TypeParams {
+				if kv.Key == "dim" {
+					v, err := strconv.Atoi(kv.Value)
+					if err != nil {
+						return -1, err
+					}
+					res += v * 2
+					break
+				}
+			}
+		case schemapb.DataType_SparseFloatVector:
+			res += 400 // todo find a better way to estimate sparse vector type, assume 50 non-zero elements
 		}
 	}
 	return res, nil
@@ -147,13 +160,59 @@ func (helper *SchemaHelper) GetVectorDimFromID(fieldID int64) (int, error) {
 // IsVectorType returns true if input is a vector type, otherwise false
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_SparseFloatVector,
+		schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
 		return true
 	default:
 		return false
 	}
 }
 
+// IsHalfFloatVectorType returns true if input is a float16 or bfloat16 vector type, otherwise false
+func IsHalfFloatVectorType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_Float16Vector || dataType == schemapb.DataType_BFloat16Vector
+}
+
+// IsSparseVectorType returns true if input is a sparse vector type, otherwise false
+func IsSparseVectorType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_SparseFloatVector
+}
+
+// HasSparseVectorField returns true if the collection schema contains a sparse vector field
+func HasSparseVectorField(schema *schemapb.CollectionSchema) bool {
+	for _, field := range schema.GetFields() {
+		if IsSparseVectorType(field.GetDataType()) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasHalfFloatVectorField returns true if the collection schema contains a float16 or bfloat16 vector field
+func HasHalfFloatVectorField(schema *schemapb.CollectionSchema) bool {
+	for _, field := range schema.GetFields() {
+		if IsHalfFloatVectorType(field.GetDataType()) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPartitionKeyFieldSchema returns the partition key field of the schema, nil if there's none
+func GetPartitionKeyFieldSchema(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.GetIsPartitionKey() {
+			return field
+		}
+	}
+	return nil
+}
+
+// PartitionKeyPartitionName returns the name of the idx-th partition created for the partition key
+func PartitionKeyPartitionName(defaultPartitionName string, idx int64) string {
+	return fmt.Sprintf("%s_%d", defaultPartitionName, idx)
+}
+
 // IsIntegerType returns true if input is a integer type, otherwise false
 func IsIntegerType(dataType schemapb.DataType) bool {
 	switch dataType {
@@ -185,6 +244,16 @@ func IsBoolType(dataType schemapb.DataType) bool {
 	}
 }
 
+// IsStringType returns true if input is a string type, otherwise false
+func IsStringType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_String:
+		return true
+	default:
+		return false
+	}
+}
+
 // AppendFieldData appends fields data of specified index from src to dst
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
 	for i, fieldData := range src {
@@ -251,6 +320,16 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstScalar.GetDoubleData().Data = append(dstScalar.GetDoubleData().Data, srcScalar.DoubleData.Data[idx])
 				}
+			case *schemapb.ScalarField_StringData:
+				if dstScalar.GetStringData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: []string{srcScalar.StringData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
@@ -288,6 +367,43 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstVector.GetFloatVector().Data = append(dstVector.GetFloatVector().Data, srcVector.FloatVector.Data[idx*dim:(idx+1)*dim]...)
 				}
+			case *schemapb.VectorField_Float16Vector:
+				row := srcVector.Float16Vector[idx*dim*2 : (idx+1)*dim*2]
+				if dstVector.GetFloat16Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Float16Vector{
+						Float16Vector: append([]byte{}, row...),
+					}
+				} else {
+					dstFloat16Vector := dstVector.Data.(*schemapb.VectorField_Float16Vector)
+					dstFloat16Vector.Float16Vector = append(dstFloat16Vector.Float16Vector, row...)
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				row := srcVector.Bfloat16Vector[idx*dim*2 : (idx+1)*dim*2]
+				if dstVector.GetBfloat16Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Bfloat16Vector{
+						Bfloat16Vector: append([]byte{}, row...),
+					}
+				} else {
+					dstBFloat16Vector := dstVector.Data.(*schemapb.VectorField_Bfloat16Vector)
+					dstBFloat16Vector.Bfloat16Vector = append(dstBFloat16Vector.Bfloat16Vector, row...)
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				row := srcVector.SparseFloatVector.Contents[idx]
+				if dstVector.GetSparseFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{
+							Contents: [][]byte{row},
+							Dim:      SparseFloatRowDim(row),
+						},
+					}
+				} else {
+					dstSparse := dstVector.GetSparseFloatVector()
+					dstSparse.Contents = append(dstSparse.Contents, row)
+					if rowDim := SparseFloatRowDim(row); rowDim > dstSparse.Dim {
+						dstSparse.Dim = rowDim
+					}
+				}
+				dstVector.Dim = dstVector.GetSparseFloatVector().Dim
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
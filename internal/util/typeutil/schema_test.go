@@ -184,6 +184,21 @@ func TestSchema(t *testing.T) {
 	})
 }
 
+func TestGetPartitionKeyFieldSchema(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64},
+		},
+	}
+	assert.Nil(t, GetPartitionKeyFieldSchema(schema))
+
+	schema.Fields[1].IsPartitionKey = true
+	assert.Equal(t, "tenant", GetPartitionKeyFieldSchema(schema).GetName())
+
+	assert.Equal(t, "_default_3", PartitionKeyPartitionName("_default", 3))
+}
+
 func TestSchema_invalid(t *testing.T) {
 	t.Run("Duplicate field name", func(t *testing.T) {
 		schema := &schemapb.CollectionSchema{
@@ -407,6 +422,21 @@ func genFieldData(fieldName string, fieldID int64, fieldType schemapb.DataType,
 			},
 			FieldId: fieldID,
 		}
+	case schemapb.DataType_String:
+		fieldData = &schemapb.FieldData{
+			Type:      schemapb.DataType_String,
+			FieldName: fieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: fieldValue.([]string),
+						},
+					},
+				},
+			},
+			FieldId: fieldID,
+		}
 	case schemapb.DataType_BinaryVector:
 		fieldData = &schemapb.FieldData{
 			Type:      schemapb.DataType_BinaryVector,
@@ -454,6 +484,7 @@ func TestAppendFieldData(t *testing.T) {
 		DoubleFieldName       = "DoubleField"
 		BinaryVectorFieldName = "BinaryVectorField"
 		FloatVectorFieldName  = "FloatVectorField"
+		StringFieldName       = "StringField"
 		BoolFieldID           = common.StartOfUserFieldID + 1
 		Int32FieldID          = common.StartOfUserFieldID + 2
 		Int64FieldID          = common.StartOfUserFieldID + 3
@@ -461,6 +492,7 @@ func TestAppendFieldData(t *testing.T) {
 		DoubleFieldID         = common.StartOfUserFieldID + 5
 		BinaryVectorFieldID   = common.StartOfUserFieldID + 6
 		FloatVectorFieldID    = common.StartOfUserFieldID + 7
+		StringFieldID         = common.StartOfUserFieldID + 8
 	)
 	BoolArray := []bool{true, false}
 	Int32Array := []int32{1, 2}
@@ -469,8 +501,9 @@ func TestAppendFieldData(t *testing.T) {
 	DoubleArray := []float64{11.0, 22.0}
 	BinaryVector := []byte{0x12, 0x34}
 	FloatVector := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 11.0, 22.0, 33.0, 44.0, 55.0, 66.0, 77.0, 88.0}
+	StringArray := []string{"a", "b"}
 
-	result := make([]*schemapb.FieldData, 7)
+	result := make([]*schemapb.FieldData, 8)
 	var fieldDataArray1 []*schemapb.FieldData
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(Int32FieldName, Int32FieldID, schemapb.DataType_Int32, Int32Array[0:1], 1))
@@ -479,6 +512,7 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[0:Dim/8], Dim))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[0:Dim], Dim))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(StringFieldName, StringFieldID, schemapb.DataType_String, StringArray[0:1], 1))
 
 	var fieldDataArray2 []*schemapb.FieldData
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[1:2], 1))
@@ -488,6 +522,7 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[1:2], 1))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[Dim/8:2*Dim/8], Dim))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[Dim:2*Dim], Dim))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(StringFieldName, StringFieldID, schemapb.DataType_String, StringArray[1:2], 1))
 
 	AppendFieldData(result, fieldDataArray1, 0)
 	AppendFieldData(result, fieldDataArray2, 0)
@@ -499,4 +534,5 @@ func TestAppendFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray, result[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector, result[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector, result[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, StringArray, result[7].GetScalars().GetStringData().Data)
 }
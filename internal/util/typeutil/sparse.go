@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// A sparse float vector row is encoded as little-endian (uint32 index, float32 value) pairs,
+// sorted by index in ascending order without duplication.
+const sparseFloatElementSize = 8
+
+// CreateSparseFloatRow encodes the given indices and values into a sparse float vector row.
+// The caller is responsible for the order of indices, use ValidateSparseFloatRows to check it.
+func CreateSparseFloatRow(indices []uint32, values []float32) []byte {
+	row := make([]byte, len(indices)*sparseFloatElementSize)
+	for i := 0; i < len(indices); i++ {
+		binary.LittleEndian.PutUint32(row[i*sparseFloatElementSize:], indices[i])
+		binary.LittleEndian.PutUint32(row[i*sparseFloatElementSize+4:], math.Float32bits(values[i]))
+	}
+	return row
+}
+
+// SparseFloatRowElementCount returns the number of non-zero elements in a sparse float vector row
+func SparseFloatRowElementCount(row []byte) int {
+	return len(row) / sparseFloatElementSize
+}
+
+// SparseFloatRowIndexAt returns the index of the i-th element in a sparse float vector row
+func SparseFloatRowIndexAt(row []byte, i int) uint32 {
+	return binary.LittleEndian.Uint32(row[i*sparseFloatElementSize:])
+}
+
+// SparseFloatRowValueAt returns the value of the i-th element in a sparse float vector row
+func SparseFloatRowValueAt(row []byte, i int) float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(row[i*sparseFloatElementSize+4:]))
+}
+
+// SparseFloatRowDim returns the dimension of a sparse float vector row, which is (max index + 1)
+func SparseFloatRowDim(row []byte) int64 {
+	n := SparseFloatRowElementCount(row)
+	if n == 0 {
+		return 0
+	}
+	return int64(SparseFloatRowIndexAt(row, n-1)) + 1
+}
+
+// ValidateSparseFloatRows checks that every row is well encoded, with strictly ascending indices
+// and finite values.
+func ValidateSparseFloatRows(rows ...[]byte) error {
+	for i, row := range rows {
+		if len(row)%sparseFloatElementSize != 0 {
+			return fmt.Errorf("invalid data length of sparse float vector row %d: %d", i, len(row))
+		}
+		n := SparseFloatRowElementCount(row)
+		for j := 0; j < n; j++ {
+			if j > 0 && SparseFloatRowIndexAt(row, j) <= SparseFloatRowIndexAt(row, j-1) {
+				return fmt.Errorf("indices of sparse float vector row %d should be unique and sorted in ascending order", i)
+			}
+			v := SparseFloatRowValueAt(row, j)
+			if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+				return fmt.Errorf("sparse float vector row %d contains invalid value: %v", i, v)
+			}
+		}
+	}
+	return nil
+}
+
+// SparseFloatRowInnerProduct returns the inner product of two sparse float vector rows
+func SparseFloatRowInnerProduct(a []byte, b []byte) float32 {
+	var res float32
+	na, nb := SparseFloatRowElementCount(a), SparseFloatRowElementCount(b)
+	i, j := 0, 0
+	for i < na && j < nb {
+		ia, ib := SparseFloatRowIndexAt(a, i), SparseFloatRowIndexAt(b, j)
+		switch {
+		case ia == ib:
+			res += SparseFloatRowValueAt(a, i) * SparseFloatRowValueAt(b, j)
+			i++
+			j++
+		case ia < ib:
+			i++
+		default:
+			j++
+		}
+	}
+	return res
+}
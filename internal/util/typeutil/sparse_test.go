@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestSparseFloatRow(t *testing.T) {
+	row := CreateSparseFloatRow([]uint32{1, 5, 100}, []float32{0.5, 1.5, -2})
+	assert.Equal(t, 24, len(row))
+	assert.Equal(t, 3, SparseFloatRowElementCount(row))
+	assert.Equal(t, uint32(5), SparseFloatRowIndexAt(row, 1))
+	assert.Equal(t, float32(-2), SparseFloatRowValueAt(row, 2))
+	assert.Equal(t, int64(101), SparseFloatRowDim(row))
+
+	empty := CreateSparseFloatRow(nil, nil)
+	assert.Equal(t, 0, SparseFloatRowElementCount(empty))
+	assert.Equal(t, int64(0), SparseFloatRowDim(empty))
+}
+
+func TestValidateSparseFloatRows(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		err := ValidateSparseFloatRows(
+			CreateSparseFloatRow([]uint32{0, 2, 4}, []float32{1, 2, 3}),
+			CreateSparseFloatRow(nil, nil),
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("bad length", func(t *testing.T) {
+		err := ValidateSparseFloatRows([]byte{1, 2, 3})
+		assert.Error(t, err)
+	})
+
+	t.Run("unsorted", func(t *testing.T) {
+		err := ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{3, 1}, []float32{1, 2}))
+		assert.Error(t, err)
+	})
+
+	t.Run("duplicated", func(t *testing.T) {
+		err := ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{1, 1}, []float32{1, 2}))
+		assert.Error(t, err)
+	})
+
+	t.Run("nan", func(t *testing.T) {
+		err := ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{1}, []float32{float32(math.NaN())}))
+		assert.Error(t, err)
+	})
+
+	t.Run("inf", func(t *testing.T) {
+		err := ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{1}, []float32{float32(math.Inf(1))}))
+		assert.Error(t, err)
+	})
+}
+
+func TestSparseFloatRowInnerProduct(t *testing.T) {
+	a := CreateSparseFloatRow([]uint32{1, 3, 5, 7}, []float32{1, 2, 3, 4})
+	b := CreateSparseFloatRow([]uint32{0, 3, 7, 9}, []float32{10, 0.5, 2, 1})
+	assert.Equal(t, float32(9), SparseFloatRowInnerProduct(a, b))
+	assert.Equal(t, float32(9), SparseFloatRowInnerProduct(b, a))
+	assert.Equal(t, float32(0), SparseFloatRowInnerProduct(a, nil))
+}
+
+func TestAppendFieldData_SparseFloatVector(t *testing.T) {
+	rows := [][]byte{
+		CreateSparseFloatRow([]uint32{1, 3}, []float32{1, 2}),
+		CreateSparseFloatRow([]uint32{10}, []float32{3}),
+	}
+	src := []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_SparseFloatVector,
+			FieldName: "sparse",
+			FieldId:   100,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 11,
+					Data: &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{
+							Contents: rows,
+							Dim:      11,
+						},
+					},
+				},
+			},
+		},
+	}
+	dst := make([]*schemapb.FieldData, 1)
+	AppendFieldData(dst, src, 0)
+	assert.Equal(t, [][]byte{rows[0]}, dst[0].GetVectors().GetSparseFloatVector().Contents)
+	assert.Equal(t, int64(4), dst[0].GetVectors().Dim)
+
+	AppendFieldData(dst, src, 1)
+	assert.Equal(t, rows, dst[0].GetVectors().GetSparseFloatVector().Contents)
+	assert.Equal(t, int64(11), dst[0].GetVectors().Dim)
+}
@@ -50,6 +50,7 @@ mkdir -p indexpb
 mkdir -p datapb
 mkdir -p querypb
 mkdir -p planpb
+mkdir -p rocksmqpb
 
 ${protoc} --go_out=plugins=grpc,paths=source_relative:./commonpb common.proto
 ${protoc} --go_out=plugins=grpc,paths=source_relative:./schemapb schema.proto
@@ -66,5 +67,6 @@ ${protoc} --go_out=plugins=grpc,paths=source_relative:./datapb data_coord.proto
 ${protoc} --go_out=plugins=grpc,paths=source_relative:./querypb query_coord.proto
 ${protoc} --go_out=plugins=grpc,paths=source_relative:./planpb plan.proto
 ${protoc} --go_out=plugins=grpc,paths=source_relative:./segcorepb segcore.proto
+${protoc} --go_out=plugins=grpc,paths=source_relative:./rocksmqpb rocksmq.proto
 
 popd